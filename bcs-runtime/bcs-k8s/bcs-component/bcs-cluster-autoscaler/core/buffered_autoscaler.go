@@ -16,6 +16,7 @@ package core
 
 import (
 	"fmt"
+	"sync"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -63,6 +64,9 @@ type BufferedAutoscaler struct {
 	ignoredTaints taintKeySet
 	ratio         float64
 	webhook       Webhook
+	// snapshot is the state of cluster observed by the last main loop, simulations run against it
+	snapshot     *clusterSnapshot
+	snapshotLock sync.RWMutex
 }
 
 type bufferedAutoscalerProcessorCallbacks struct {
@@ -208,6 +212,7 @@ func (b *BufferedAutoscaler) RunOnce(currentTime time.Time) errors.AutoscalerErr
 	if typedErr != nil {
 		return typedErr
 	}
+	b.updateSnapshot(allNodes, readyNodes, nodeInfosForGroups)
 	metrics.UpdateDurationFromStart(metrics.UpdateState, stateUpdateStart)
 
 	scaleUpStatus := &status.ScaleUpStatus{Result: status.ScaleUpNotTried}
@@ -273,20 +273,212 @@ func ScaleUp(context *contextinternal.Context, processors *ca_processors.Autosca
 		return &status.ScaleUpStatus{Result: status.ScaleUpNotNeeded}, nil
 	}
 
+	unschedulablePods := removeIPResources(originUnschedulablePods)
+
+	now := time.Now()
+
+	plan, typedErr := computeExpansionOptions(context, processors, clusterStateRegistry, unschedulablePods, nodes,
+		nodeInfos, existingNodeInfos, bufferNotEnough, now)
+	if typedErr != nil {
+		return &status.ScaleUpStatus{Result: status.ScaleUpError}, typedErr
+	}
+	nodeGroups := plan.nodeGroups
+	nodeInfos = plan.nodeInfos
+	expansionOptions := plan.expansionOptions
+	podsRemainUnschedulable := plan.podsRemainUnschedulable
+	skippedNodeGroups := plan.skippedNodeGroups
+	upcomingNodes := plan.upcomingNodes
+	scaleUpResourcesLeft := plan.scaleUpResourcesLeft
+	resourceLimiter := plan.resourceLimiter
+	getPodsPassingPredicates := plan.getPodsPassingPredicates
+	gpuLabel := context.CloudProvider.GPULabel()
+	availableGPUTypes := context.CloudProvider.GetAvailableGPUTypes()
+
+	if len(expansionOptions) == 0 {
+		klog.V(1).Info("No expansion options")
+		return &status.ScaleUpStatus{Result: status.ScaleUpNoOptionsAvailable,
+			PodsRemainUnschedulable: getRemainingPods(podsRemainUnschedulable, skippedNodeGroups),
+			ConsideredNodeGroups:    nodeGroups}, nil
+	}
+
+	// Pick some expansion option.
+	bestOption := context.ExpanderStrategy.BestOption(expansionOptions, nodeInfos)
+	if bestOption != nil && bestOption.NodeCount > 0 {
+		klog.V(1).Infof("Best option to resize: %s", bestOption.NodeGroup.Id())
+		if len(bestOption.Debug) > 0 {
+			klog.V(1).Info(bestOption.Debug)
+		}
+		klog.V(1).Infof("Estimated %d nodes needed in %s", bestOption.NodeCount, bestOption.NodeGroup.Id())
+
+		newNodes := bestOption.NodeCount
+
+		if context.MaxNodesTotal > 0 && len(nodes)+newNodes+len(upcomingNodes) > context.MaxNodesTotal {
+			klog.V(1).Infof("Capping size to max cluster total size (%d)", context.MaxNodesTotal)
+			newNodes = context.MaxNodesTotal - len(nodes) - len(upcomingNodes)
+			if newNodes < 1 {
+				return &status.ScaleUpStatus{Result: status.ScaleUpError}, errors.NewAutoscalerError(
+					errors.TransientError,
+					"max node total count already reached")
+			}
+		}
+
+		createNodeGroupResults := make([]nodegroups.CreateNodeGroupResult, 0)
+		if !bestOption.NodeGroup.Exist() {
+			oldID := bestOption.NodeGroup.Id()
+			createNodeGroupResult, asErr := processors.NodeGroupManager.CreateNodeGroup(context.AutoscalingContext,
+				bestOption.NodeGroup)
+			if asErr != nil {
+				return &status.ScaleUpStatus{Result: status.ScaleUpError}, asErr
+			}
+			createNodeGroupResults = append(createNodeGroupResults, createNodeGroupResult)
+			bestOption.NodeGroup = createNodeGroupResult.MainCreatedNodeGroup
+
+			// If possible replace candidate node-info with node info based on crated node group. The latter
+			// one should be more in line with nodes which will be created by node group.
+			mainCreatedNodeInfo, asErr := getNodeInfoFromTemplate(createNodeGroupResult.MainCreatedNodeGroup, daemonSets,
+				context.PredicateChecker, ignoredTaints)
+			if asErr == nil {
+				nodeInfos[createNodeGroupResult.MainCreatedNodeGroup.Id()] = mainCreatedNodeInfo
+			} else {
+				klog.Warningf("Cannot build node info for newly created main node group %v;"+
+					" balancing similar node groups may not work; err=%v", createNodeGroupResult.MainCreatedNodeGroup.Id(), asErr)
+				// Use node info based on expansion candidate but upadte Id which likely changed when node group was created.
+				nodeInfos[bestOption.NodeGroup.Id()] = nodeInfos[oldID]
+			}
+
+			if oldID != createNodeGroupResult.MainCreatedNodeGroup.Id() {
+				delete(nodeInfos, oldID)
+			}
+
+			for _, nodeGroup := range createNodeGroupResult.ExtraCreatedNodeGroups {
+				nodeInfo, asErr := getNodeInfoFromTemplate(nodeGroup, daemonSets, context.PredicateChecker, ignoredTaints)
+
+				if asErr != nil {
+					klog.Warningf("Cannot build node info for newly created extra node group %v;"+
+						" balancing similar node groups will not work; err=%v", nodeGroup.Id(), asErr)
+					continue
+				}
+				nodeInfos[nodeGroup.Id()] = nodeInfo
+			}
+
+			// Update ClusterStateRegistry so similar nodegroups rebalancing works.
+			// TODO(lukaszos) when pursuing scalability update this call with one which takes list of changed node groups so
+			//                we do not do extra API calls. (the call at the bottom of ScaleUp() could be also changed then)
+			clusterStateRegistry.Recalculate()
+		}
+
+		nodeInfo, found := nodeInfos[bestOption.NodeGroup.Id()]
+		if !found {
+			// This should never happen, as we already should have retrieved
+			// nodeInfo for any considered nodegroup.
+			klog.Errorf("No node info for: %s", bestOption.NodeGroup.Id())
+			return &status.ScaleUpStatus{Result: status.ScaleUpError, CreateNodeGroupResults: createNodeGroupResults},
+				errors.NewAutoscalerError(errors.CloudProviderError, "No node info for best expansion option!")
+		}
+
+		// apply upper limits for CPU and memory
+		newNodes, err := applyScaleUpResourcesLimits(context.CloudProvider, newNodes, scaleUpResourcesLeft, nodeInfo,
+			bestOption.NodeGroup, resourceLimiter)
+		if err != nil {
+			return &status.ScaleUpStatus{Result: status.ScaleUpError, CreateNodeGroupResults: createNodeGroupResults}, err
+		}
+
+		targetNodeGroups := []cloudprovider.NodeGroup{bestOption.NodeGroup}
+		if context.BalanceSimilarNodeGroups {
+			similarNodeGroups, typedErr := processors.NodeGroupSetProcessor.FindSimilarNodeGroups(context.AutoscalingContext,
+				bestOption.NodeGroup, nodeInfos)
+			if typedErr != nil {
+				return &status.ScaleUpStatus{Result: status.ScaleUpError, CreateNodeGroupResults: createNodeGroupResults},
+					typedErr.AddPrefix("Failed to find matching node groups: ")
+			}
+			similarNodeGroups = filterNodeGroupsByPods(similarNodeGroups, bestOption.Pods, getPodsPassingPredicates)
+			for _, ng := range similarNodeGroups {
+				if clusterStateRegistry.IsNodeGroupSafeToScaleUp(ng, now) {
+					targetNodeGroups = append(targetNodeGroups, ng)
+				} else {
+					// This should never happen, as we will filter out the node group earlier on
+					// because of missing entry in podsPassingPredicates, but double checking doesn't
+					// really cost us anything
+					klog.V(2).Infof("Ignoring node group %s when balancing: group is not ready for scaleup", ng.Id())
+				}
+			}
+			if len(targetNodeGroups) > 1 {
+				var buffer bytes.Buffer
+				for i, ng := range targetNodeGroups {
+					if i > 0 {
+						buffer.WriteString(", ")
+					}
+					buffer.WriteString(ng.Id())
+				}
+				klog.V(1).Infof("Splitting scale-up between %v similar node groups: {%v}", len(targetNodeGroups), buffer.String())
+			}
+		}
+		scaleUpInfos, typedErr := processors.NodeGroupSetProcessor.BalanceScaleUpBetweenGroups(
+			context.AutoscalingContext, targetNodeGroups, newNodes)
+		if typedErr != nil {
+			return &status.ScaleUpStatus{Result: status.ScaleUpError, CreateNodeGroupResults: createNodeGroupResults}, typedErr
+		}
+		klog.V(1).Infof("Final scale-up plan: %v", scaleUpInfos)
+		for _, info := range scaleUpInfos {
+			typedErr := executeScaleUp(context.AutoscalingContext, clusterStateRegistry, info,
+				gpu.GetGpuTypeForMetrics(gpuLabel, availableGPUTypes, nodeInfo.Node(), nil), now)
+			if typedErr != nil {
+				return &status.ScaleUpStatus{Result: status.ScaleUpError, CreateNodeGroupResults: createNodeGroupResults}, typedErr
+			}
+		}
+
+		clusterStateRegistry.Recalculate()
+		return &status.ScaleUpStatus{
+				Result:                  status.ScaleUpSuccessful,
+				ScaleUpInfos:            scaleUpInfos,
+				PodsRemainUnschedulable: getRemainingPods(podsRemainUnschedulable, skippedNodeGroups),
+				ConsideredNodeGroups:    nodeGroups,
+				CreateNodeGroupResults:  createNodeGroupResults,
+				PodsTriggeredScaleUp:    bestOption.Pods,
+				PodsAwaitEvaluation:     getPodsAwaitingEvaluation(unschedulablePods, podsRemainUnschedulable, bestOption.Pods)},
+			nil
+	}
+
+	return &status.ScaleUpStatus{Result: status.ScaleUpNoOptionsAvailable,
+		PodsRemainUnschedulable: getRemainingPods(podsRemainUnschedulable, skippedNodeGroups),
+		ConsideredNodeGroups:    nodeGroups}, nil
+}
+
+// removeIPResources returns copies of pods without ip resources requests, which are not provided by node template
+func removeIPResources(pods []*apiv1.Pod) []*apiv1.Pod {
 	// 去除 eip 资源
-	unschedulablePods := make([]*apiv1.Pod, 0)
-	for i := range originUnschedulablePods {
-		pod := originUnschedulablePods[i].DeepCopy()
+	result := make([]*apiv1.Pod, 0, len(pods))
+	for i := range pods {
+		pod := pods[i].DeepCopy()
 		for j := range pod.Spec.Containers {
 			delete(pod.Spec.Containers[j].Resources.Requests, "cloud.bkbcs.tencent.com/eip")
 			delete(pod.Spec.Containers[j].Resources.Requests, "tke.cloud.tencent.com/eni-ip")
 			delete(pod.Spec.Containers[j].Resources.Requests, "tke.cloud.tencent.com/direct-eni")
 		}
-		unschedulablePods = append(unschedulablePods, pod)
+		result = append(result, pod)
 	}
+	return result
+}
 
-	now := time.Now()
+// expansionPlan contains the expansion options computed for unschedulable pods
+type expansionPlan struct {
+	nodeGroups               []cloudprovider.NodeGroup
+	nodeInfos                map[string]*schedulernodeinfo.NodeInfo
+	expansionOptions         []expander.Option
+	podsRemainUnschedulable  map[*apiv1.Pod]map[string]status.Reasons
+	skippedNodeGroups        map[string]status.Reasons
+	upcomingNodes            []*schedulernodeinfo.NodeInfo
+	scaleUpResourcesLeft     scaleUpResourcesLimits
+	resourceLimiter          *cloudprovider.ResourceLimiter
+	getPodsPassingPredicates func(nodeGroupId string) ([]*apiv1.Pod, error)
+}
 
+// computeExpansionOptions checks every node group and estimates how many nodes are needed in the node group
+// for the unschedulable pods. Nothing is changed in the cloud provider.
+func computeExpansionOptions(context *contextinternal.Context, processors *ca_processors.AutoscalingProcessors,
+	clusterStateRegistry *clusterstate.ClusterStateRegistry, unschedulablePods []*apiv1.Pod, nodes []*apiv1.Node,
+	nodeInfos map[string]*schedulernodeinfo.NodeInfo, existingNodeInfos map[string]*schedulernodeinfo.NodeInfo,
+	bufferNotEnough bool, now time.Time) (*expansionPlan, errors.AutoscalerError) {
 	loggingQuota := glogx.PodsLoggingQuota()
 
 	podsRemainUnschedulable := make(map[*apiv1.Pod]map[string]status.Reasons)
@@ -299,17 +491,15 @@ func ScaleUp(context *contextinternal.Context, processors *ca_processors.Autosca
 
 	nodesFromNotAutoscaledGroups, filterErr := filterOutNodesFromNotAutoscaledGroups(nodes, context.CloudProvider)
 	if filterErr != nil {
-		return &status.ScaleUpStatus{Result: status.ScaleUpError}, filterErr.AddPrefix(
+		return nil, filterErr.AddPrefix(
 			"failed to filter out nodes which are from not autoscaled groups: ")
 	}
 
 	nodeGroups := context.CloudProvider.NodeGroups()
-	gpuLabel := context.CloudProvider.GPULabel()
-	availableGPUTypes := context.CloudProvider.GetAvailableGPUTypes()
 
 	resourceLimiter, errCP := context.CloudProvider.GetResourceLimiter()
 	if errCP != nil {
-		return &status.ScaleUpStatus{Result: status.ScaleUpError}, errors.ToAutoscalerError(
+		return nil, errors.ToAutoscalerError(
 			errors.CloudProviderError,
 			errCP)
 	}
@@ -317,14 +507,14 @@ func ScaleUp(context *contextinternal.Context, processors *ca_processors.Autosca
 	scaleUpResourcesLeft, errLimits := computeScaleUpResourcesLeftLimits(context.CloudProvider, nodeGroups,
 		nodeInfos, nodesFromNotAutoscaledGroups, resourceLimiter)
 	if errLimits != nil {
-		return &status.ScaleUpStatus{Result: status.ScaleUpError}, errLimits.AddPrefix("Could not compute total resources: ")
+		return nil, errLimits.AddPrefix("Could not compute total resources: ")
 	}
 
 	upcomingNodes := make([]*schedulernodeinfo.NodeInfo, 0)
 	for nodeGroup, numberOfNodes := range clusterStateRegistry.GetUpcomingNodes() {
 		nodeTemplate, found := nodeInfos[nodeGroup]
 		if !found {
-			return &status.ScaleUpStatus{Result: status.ScaleUpError}, errors.NewAutoscalerError(
+			return nil, errors.NewAutoscalerError(
 				errors.InternalError,
 				"failed to find template node for node group %s",
 				nodeGroup)
@@ -342,7 +532,7 @@ func ScaleUp(context *contextinternal.Context, processors *ca_processors.Autosca
 		nodeGroups, nodeInfos, errProc = processors.NodeGroupListProcessor.Process(context.AutoscalingContext,
 			nodeGroups, nodeInfos, unschedulablePods)
 		if errProc != nil {
-			return &status.ScaleUpStatus{Result: status.ScaleUpError}, errors.ToAutoscalerError(errors.InternalError, errProc)
+			return nil, errors.ToAutoscalerError(errors.InternalError, errProc)
 		}
 	}
 
@@ -454,154 +644,17 @@ func ScaleUp(context *contextinternal.Context, processors *ca_processors.Autosca
 		}
 	}
 
-	if len(expansionOptions) == 0 {
-		klog.V(1).Info("No expansion options")
-		return &status.ScaleUpStatus{Result: status.ScaleUpNoOptionsAvailable,
-			PodsRemainUnschedulable: getRemainingPods(podsRemainUnschedulable, skippedNodeGroups),
-			ConsideredNodeGroups:    nodeGroups}, nil
-	}
-
-	// Pick some expansion option.
-	bestOption := context.ExpanderStrategy.BestOption(expansionOptions, nodeInfos)
-	if bestOption != nil && bestOption.NodeCount > 0 {
-		klog.V(1).Infof("Best option to resize: %s", bestOption.NodeGroup.Id())
-		if len(bestOption.Debug) > 0 {
-			klog.V(1).Info(bestOption.Debug)
-		}
-		klog.V(1).Infof("Estimated %d nodes needed in %s", bestOption.NodeCount, bestOption.NodeGroup.Id())
-
-		newNodes := bestOption.NodeCount
-
-		if context.MaxNodesTotal > 0 && len(nodes)+newNodes+len(upcomingNodes) > context.MaxNodesTotal {
-			klog.V(1).Infof("Capping size to max cluster total size (%d)", context.MaxNodesTotal)
-			newNodes = context.MaxNodesTotal - len(nodes) - len(upcomingNodes)
-			if newNodes < 1 {
-				return &status.ScaleUpStatus{Result: status.ScaleUpError}, errors.NewAutoscalerError(
-					errors.TransientError,
-					"max node total count already reached")
-			}
-		}
-
-		createNodeGroupResults := make([]nodegroups.CreateNodeGroupResult, 0)
-		if !bestOption.NodeGroup.Exist() {
-			oldID := bestOption.NodeGroup.Id()
-			createNodeGroupResult, asErr := processors.NodeGroupManager.CreateNodeGroup(context.AutoscalingContext,
-				bestOption.NodeGroup)
-			if asErr != nil {
-				return &status.ScaleUpStatus{Result: status.ScaleUpError}, asErr
-			}
-			createNodeGroupResults = append(createNodeGroupResults, createNodeGroupResult)
-			bestOption.NodeGroup = createNodeGroupResult.MainCreatedNodeGroup
-
-			// If possible replace candidate node-info with node info based on crated node group. The latter
-			// one should be more in line with nodes which will be created by node group.
-			mainCreatedNodeInfo, asErr := getNodeInfoFromTemplate(createNodeGroupResult.MainCreatedNodeGroup, daemonSets,
-				context.PredicateChecker, ignoredTaints)
-			if asErr == nil {
-				nodeInfos[createNodeGroupResult.MainCreatedNodeGroup.Id()] = mainCreatedNodeInfo
-			} else {
-				klog.Warningf("Cannot build node info for newly created main node group %v;"+
-					" balancing similar node groups may not work; err=%v", createNodeGroupResult.MainCreatedNodeGroup.Id(), asErr)
-				// Use node info based on expansion candidate but upadte Id which likely changed when node group was created.
-				nodeInfos[bestOption.NodeGroup.Id()] = nodeInfos[oldID]
-			}
-
-			if oldID != createNodeGroupResult.MainCreatedNodeGroup.Id() {
-				delete(nodeInfos, oldID)
-			}
-
-			for _, nodeGroup := range createNodeGroupResult.ExtraCreatedNodeGroups {
-				nodeInfo, asErr := getNodeInfoFromTemplate(nodeGroup, daemonSets, context.PredicateChecker, ignoredTaints)
-
-				if asErr != nil {
-					klog.Warningf("Cannot build node info for newly created extra node group %v;"+
-						" balancing similar node groups will not work; err=%v", nodeGroup.Id(), asErr)
-					continue
-				}
-				nodeInfos[nodeGroup.Id()] = nodeInfo
-			}
-
-			// Update ClusterStateRegistry so similar nodegroups rebalancing works.
-			// TODO(lukaszos) when pursuing scalability update this call with one which takes list of changed node groups so
-			//                we do not do extra API calls. (the call at the bottom of ScaleUp() could be also changed then)
-			clusterStateRegistry.Recalculate()
-		}
-
-		nodeInfo, found := nodeInfos[bestOption.NodeGroup.Id()]
-		if !found {
-			// This should never happen, as we already should have retrieved
-			// nodeInfo for any considered nodegroup.
-			klog.Errorf("No node info for: %s", bestOption.NodeGroup.Id())
-			return &status.ScaleUpStatus{Result: status.ScaleUpError, CreateNodeGroupResults: createNodeGroupResults},
-				errors.NewAutoscalerError(errors.CloudProviderError, "No node info for best expansion option!")
-		}
-
-		// apply upper limits for CPU and memory
-		newNodes, err := applyScaleUpResourcesLimits(context.CloudProvider, newNodes, scaleUpResourcesLeft, nodeInfo,
-			bestOption.NodeGroup, resourceLimiter)
-		if err != nil {
-			return &status.ScaleUpStatus{Result: status.ScaleUpError, CreateNodeGroupResults: createNodeGroupResults}, err
-		}
-
-		targetNodeGroups := []cloudprovider.NodeGroup{bestOption.NodeGroup}
-		if context.BalanceSimilarNodeGroups {
-			similarNodeGroups, typedErr := processors.NodeGroupSetProcessor.FindSimilarNodeGroups(context.AutoscalingContext,
-				bestOption.NodeGroup, nodeInfos)
-			if typedErr != nil {
-				return &status.ScaleUpStatus{Result: status.ScaleUpError, CreateNodeGroupResults: createNodeGroupResults},
-					typedErr.AddPrefix("Failed to find matching node groups: ")
-			}
-			similarNodeGroups = filterNodeGroupsByPods(similarNodeGroups, bestOption.Pods, getPodsPassingPredicates)
-			for _, ng := range similarNodeGroups {
-				if clusterStateRegistry.IsNodeGroupSafeToScaleUp(ng, now) {
-					targetNodeGroups = append(targetNodeGroups, ng)
-				} else {
-					// This should never happen, as we will filter out the node group earlier on
-					// because of missing entry in podsPassingPredicates, but double checking doesn't
-					// really cost us anything
-					klog.V(2).Infof("Ignoring node group %s when balancing: group is not ready for scaleup", ng.Id())
-				}
-			}
-			if len(targetNodeGroups) > 1 {
-				var buffer bytes.Buffer
-				for i, ng := range targetNodeGroups {
-					if i > 0 {
-						buffer.WriteString(", ")
-					}
-					buffer.WriteString(ng.Id())
-				}
-				klog.V(1).Infof("Splitting scale-up between %v similar node groups: {%v}", len(targetNodeGroups), buffer.String())
-			}
-		}
-		scaleUpInfos, typedErr := processors.NodeGroupSetProcessor.BalanceScaleUpBetweenGroups(
-			context.AutoscalingContext, targetNodeGroups, newNodes)
-		if typedErr != nil {
-			return &status.ScaleUpStatus{Result: status.ScaleUpError, CreateNodeGroupResults: createNodeGroupResults}, typedErr
-		}
-		klog.V(1).Infof("Final scale-up plan: %v", scaleUpInfos)
-		for _, info := range scaleUpInfos {
-			typedErr := executeScaleUp(context.AutoscalingContext, clusterStateRegistry, info,
-				gpu.GetGpuTypeForMetrics(gpuLabel, availableGPUTypes, nodeInfo.Node(), nil), now)
-			if typedErr != nil {
-				return &status.ScaleUpStatus{Result: status.ScaleUpError, CreateNodeGroupResults: createNodeGroupResults}, typedErr
-			}
-		}
-
-		clusterStateRegistry.Recalculate()
-		return &status.ScaleUpStatus{
-				Result:                  status.ScaleUpSuccessful,
-				ScaleUpInfos:            scaleUpInfos,
-				PodsRemainUnschedulable: getRemainingPods(podsRemainUnschedulable, skippedNodeGroups),
-				ConsideredNodeGroups:    nodeGroups,
-				CreateNodeGroupResults:  createNodeGroupResults,
-				PodsTriggeredScaleUp:    bestOption.Pods,
-				PodsAwaitEvaluation:     getPodsAwaitingEvaluation(unschedulablePods, podsRemainUnschedulable, bestOption.Pods)},
-			nil
-	}
-
-	return &status.ScaleUpStatus{Result: status.ScaleUpNoOptionsAvailable,
-		PodsRemainUnschedulable: getRemainingPods(podsRemainUnschedulable, skippedNodeGroups),
-		ConsideredNodeGroups:    nodeGroups}, nil
+	return &expansionPlan{
+		nodeGroups:               nodeGroups,
+		nodeInfos:                nodeInfos,
+		expansionOptions:         expansionOptions,
+		podsRemainUnschedulable:  podsRemainUnschedulable,
+		skippedNodeGroups:        skippedNodeGroups,
+		upcomingNodes:            upcomingNodes,
+		scaleUpResourcesLeft:     scaleUpResourcesLeft,
+		resourceLimiter:          resourceLimiter,
+		getPodsPassingPredicates: getPodsPassingPredicates,
+	}, nil
 }
 
 type podsPredicatePassingCheckFunctions struct {
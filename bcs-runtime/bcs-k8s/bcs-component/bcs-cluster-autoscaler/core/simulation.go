@@ -0,0 +1,533 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package core
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider"
+	"k8s.io/autoscaler/cluster-autoscaler/utils/errors"
+	"k8s.io/klog"
+	schedulernodeinfo "k8s.io/kubernetes/pkg/scheduler/nodeinfo"
+
+	simulatorinternal "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-cluster-autoscaler/simulator"
+)
+
+const (
+	// SimulationPath is the http path of simulation api
+	SimulationPath = "/simulation"
+
+	// maxSimulationBodySize is the max size of simulation request body
+	maxSimulationBodySize = 10 << 20
+)
+
+// Simulator simulates the scaling decisions of autoscaler without changing anything
+type Simulator interface {
+	Simulate(req *SimulationRequest) (*SimulationResponse, errors.AutoscalerError)
+}
+
+// SimulationRequest defines a hypothetical change of the cluster
+type SimulationRequest struct {
+	// Pods are hypothetical pending pods.
+	Pods []*apiv1.Pod `json:"pods,omitempty"`
+	// IncludeUnschedulablePods indicates whether the unschedulable pods in cluster are taken into account.
+	IncludeUnschedulablePods bool `json:"includeUnschedulablePods,omitempty"`
+	// RemoveNodes contains the names of nodes to be removed.
+	RemoveNodes []string `json:"removeNodes,omitempty"`
+}
+
+// SimulationResponse defines the scaling decisions of the simulation
+type SimulationResponse struct {
+	// NodeGroups contains the node groups which would grow or shrink.
+	NodeGroups []*NodeGroupChange `json:"nodeGroups"`
+	// BufferNotEnough indicates whether the free resources of cluster are below the buffer ratio.
+	BufferNotEnough bool `json:"bufferNotEnough"`
+	// ExpansionOptions contains all the node groups which can help unschedulable pods.
+	ExpansionOptions []*ExpansionOption `json:"expansionOptions,omitempty"`
+	// SkippedNodeGroups contains the node groups not considered for scale up and the reasons.
+	SkippedNodeGroups map[string][]string `json:"skippedNodeGroups,omitempty"`
+	// SchedulablePods contains the pending pods which can be scheduled on existing nodes.
+	SchedulablePods []string `json:"schedulablePods,omitempty"`
+	// UnschedulablePods contains the pending pods which can not be helped by any node group.
+	// Key is pod, value is the reasons rejected by node groups.
+	UnschedulablePods map[string]map[string][]string `json:"unschedulablePods,omitempty"`
+	// RemovableNodes contains the nodes which can be removed.
+	RemovableNodes []*NodeRemoval `json:"removableNodes,omitempty"`
+	// UnremovableNodes contains the nodes which can not be removed.
+	UnremovableNodes []*NodeRemoval `json:"unremovableNodes,omitempty"`
+}
+
+// NodeGroupChange defines the size change of a node group
+type NodeGroupChange struct {
+	NodeGroup   string `json:"nodeGroup"`
+	CurrentSize int    `json:"currentSize"`
+	NewSize     int    `json:"newSize"`
+	Reason      string `json:"reason"`
+	// Pods contains the pending pods which trigger the scale up.
+	Pods []string `json:"pods,omitempty"`
+	// Nodes contains the nodes removed by the scale down.
+	Nodes []string `json:"nodes,omitempty"`
+}
+
+// ExpansionOption defines an expansion option of a node group estimated for pending pods
+type ExpansionOption struct {
+	NodeGroup string   `json:"nodeGroup"`
+	NodeCount int      `json:"nodeCount"`
+	Pods      []string `json:"pods"`
+}
+
+// NodeRemoval defines the simulation result of removing a node
+type NodeRemoval struct {
+	Node      string `json:"node"`
+	NodeGroup string `json:"nodeGroup,omitempty"`
+	// Reason is the reason why the node can not be removed.
+	Reason string `json:"reason,omitempty"`
+	// PodsToReschedule contains the pods to be moved, key is pod, value is the destination node.
+	PodsToReschedule map[string]string `json:"podsToReschedule,omitempty"`
+}
+
+// clusterSnapshot is the state of cluster observed by the last main loop. Simulations run against it
+// instead of holding the lock of autoscaler, so that they never block or are blocked by the main loop.
+type clusterSnapshot struct {
+	allNodes           []*apiv1.Node
+	readyNodes         []*apiv1.Node
+	nodeInfosForGroups map[string]*schedulernodeinfo.NodeInfo
+}
+
+// updateSnapshot saves the state of cluster observed by main loop for simulations
+func (b *BufferedAutoscaler) updateSnapshot(allNodes, readyNodes []*apiv1.Node,
+	nodeInfosForGroups map[string]*schedulernodeinfo.NodeInfo) {
+	snapshot := &clusterSnapshot{
+		allNodes:           allNodes,
+		readyNodes:         readyNodes,
+		nodeInfosForGroups: copyNodeInfos(nodeInfosForGroups),
+	}
+	b.snapshotLock.Lock()
+	defer b.snapshotLock.Unlock()
+	b.snapshot = snapshot
+}
+
+// getSnapshot returns the state of cluster observed by the last main loop, the node infos are copied
+// since they may be modified by processors during the simulation
+func (b *BufferedAutoscaler) getSnapshot() *clusterSnapshot {
+	b.snapshotLock.RLock()
+	defer b.snapshotLock.RUnlock()
+	if b.snapshot == nil {
+		return nil
+	}
+	return &clusterSnapshot{
+		allNodes:           b.snapshot.allNodes,
+		readyNodes:         b.snapshot.readyNodes,
+		nodeInfosForGroups: copyNodeInfos(b.snapshot.nodeInfosForGroups),
+	}
+}
+
+// Simulate runs the estimator and simulator against a snapshot of the cluster observed by the last main loop
+// with the hypothetical pending pods and node removals, and returns the node groups which would grow or shrink.
+// It only reads from cloud provider, nothing in the cluster or cloud provider is changed.
+func (b *BufferedAutoscaler) Simulate(req *SimulationRequest) (*SimulationResponse, errors.AutoscalerError) {
+	snapshot := b.getSnapshot()
+	if snapshot == nil {
+		return nil, errors.NewAutoscalerError(errors.TransientError,
+			"cluster state has not been observed by autoscaler yet")
+	}
+
+	now := time.Now()
+	allNodes, readyNodes := snapshot.allNodes, snapshot.readyNodes
+	scheduledPods, err := b.ScheduledPodLister().List()
+	if err != nil {
+		klog.Errorf("Failed to list scheduled pods: %v", err)
+		return nil, errors.ToAutoscalerError(errors.ApiCallError, err)
+	}
+
+	resp := &SimulationResponse{
+		NodeGroups:        make([]*NodeGroupChange, 0),
+		SkippedNodeGroups: make(map[string][]string),
+		UnschedulablePods: make(map[string]map[string][]string),
+	}
+	allNodes, readyNodes, scheduledPods, typedErr := b.simulateScaleDown(req.RemoveNodes, allNodes, readyNodes,
+		scheduledPods, now, resp)
+	if typedErr != nil {
+		return nil, typedErr
+	}
+
+	pendingPods := make([]*apiv1.Pod, 0, len(req.Pods))
+	for i, pod := range req.Pods {
+		pod = pod.DeepCopy()
+		if len(pod.Namespace) == 0 {
+			pod.Namespace = apiv1.NamespaceDefault
+		}
+		if len(pod.Name) == 0 {
+			pod.Name = fmt.Sprintf("simulated-pod-%d", i)
+		}
+		pod.Spec.NodeName = ""
+		pendingPods = append(pendingPods, pod)
+	}
+	if req.IncludeUnschedulablePods {
+		unschedulablePods, err := b.UnschedulablePodLister().List()
+		if err != nil {
+			klog.Errorf("Failed to list unscheduled pods: %v", err)
+			return nil, errors.ToAutoscalerError(errors.ApiCallError, err)
+		}
+		pendingPods = append(pendingPods, filterOutExpendablePods(unschedulablePods, b.ExpendablePodsPriorityCutoff)...)
+	}
+	typedErr = b.simulateScaleUp(pendingPods, allNodes, readyNodes, scheduledPods, snapshot.nodeInfosForGroups,
+		now, resp)
+	if typedErr != nil {
+		return nil, typedErr
+	}
+	return resp, nil
+}
+
+// simulateScaleDown simulates removing nodes one by one, the pods on removed nodes are moved to the remaining
+// nodes. It returns the snapshot of cluster after the removals.
+func (b *BufferedAutoscaler) simulateScaleDown(removeNodes []string, allNodes, readyNodes []*apiv1.Node,
+	scheduledPods []*apiv1.Pod, now time.Time, resp *SimulationResponse) ([]*apiv1.Node, []*apiv1.Node,
+	[]*apiv1.Pod, errors.AutoscalerError) {
+	if len(removeNodes) == 0 {
+		return allNodes, readyNodes, scheduledPods, nil
+	}
+	pdbs, err := b.PodDisruptionBudgetLister().List()
+	if err != nil {
+		klog.Errorf("Failed to list pod disruption budgets: %v", err)
+		return nil, nil, nil, errors.ToAutoscalerError(errors.ApiCallError, err)
+	}
+
+	nodesByName := make(map[string]*apiv1.Node, len(allNodes))
+	for _, node := range allNodes {
+		nodesByName[node.Name] = node
+	}
+	// pods are not moved to the nodes which are going to be removed
+	removing := make(map[string]bool, len(removeNodes))
+	for _, name := range removeNodes {
+		removing[name] = true
+	}
+	removed := make(map[string]bool, len(removeNodes))
+	changes := make(map[string]*NodeGroupChange)
+	pods := scheduledPods
+
+	for _, name := range removeNodes {
+		node, found := nodesByName[name]
+		if !found {
+			delete(removing, name)
+			resp.UnremovableNodes = append(resp.UnremovableNodes, &NodeRemoval{Node: name, Reason: "node not found"})
+			continue
+		}
+		removal := &NodeRemoval{Node: name}
+		reason, nodeGroup := b.checkNodeGroupForRemoval(node, changes)
+		if nodeGroup != nil {
+			removal.NodeGroup = nodeGroup.Id()
+		}
+		if len(reason) != 0 {
+			delete(removing, name)
+			removal.Reason = reason
+			resp.UnremovableNodes = append(resp.UnremovableNodes, removal)
+			continue
+		}
+
+		destinations := make([]*apiv1.Node, 0, len(allNodes))
+		for _, n := range allNodes {
+			if !removing[n.Name] {
+				destinations = append(destinations, n)
+			}
+		}
+		toRemove, hints, err := simulatorinternal.SimulateNodeRemoval(node, destinations, pods, b.ListerRegistry,
+			b.PredicateChecker, pdbs, now)
+		if err != nil {
+			delete(removing, name)
+			removal.Reason = err.Error()
+			resp.UnremovableNodes = append(resp.UnremovableNodes, removal)
+			continue
+		}
+
+		// move the pods to the destination nodes
+		removal.PodsToReschedule = make(map[string]string, len(toRemove.PodsToReschedule))
+		movedPods := make([]*apiv1.Pod, 0, len(pods))
+		for _, pod := range pods {
+			if pod.Spec.NodeName != name {
+				movedPods = append(movedPods, pod)
+				continue
+			}
+			// pods not to be rescheduled, e.g. daemonset pods, are removed with the node
+			if destination, ok := hints[podKey(pod)]; ok {
+				movedPod := pod.DeepCopy()
+				movedPod.Spec.NodeName = destination
+				movedPods = append(movedPods, movedPod)
+				removal.PodsToReschedule[podKey(pod)] = destination
+			}
+		}
+		pods = movedPods
+		removed[name] = true
+		resp.RemovableNodes = append(resp.RemovableNodes, removal)
+
+		change := changes[nodeGroup.Id()]
+		change.NewSize--
+		change.Nodes = append(change.Nodes, name)
+	}
+
+	for _, change := range changes {
+		if len(change.Nodes) == 0 {
+			continue
+		}
+		change.Reason = fmt.Sprintf("%d nodes can be removed, pods on them can be moved to other nodes",
+			len(change.Nodes))
+		resp.NodeGroups = append(resp.NodeGroups, change)
+	}
+	sort.Slice(resp.NodeGroups, func(i, j int) bool {
+		return resp.NodeGroups[i].NodeGroup < resp.NodeGroups[j].NodeGroup
+	})
+	return filterOutRemovedNodes(allNodes, removed), filterOutRemovedNodes(readyNodes, removed), pods, nil
+}
+
+// checkNodeGroupForRemoval checks whether the node group of node can shrink, and returns the reason if not
+func (b *BufferedAutoscaler) checkNodeGroupForRemoval(node *apiv1.Node,
+	changes map[string]*NodeGroupChange) (string, cloudprovider.NodeGroup) {
+	nodeGroup, err := b.CloudProvider.NodeGroupForNode(node)
+	if err != nil {
+		return fmt.Sprintf("failed to get node group: %v", err), nil
+	}
+	if nodeGroup == nil || reflect.ValueOf(nodeGroup).IsNil() {
+		return "node is not autoscaled", nil
+	}
+	if hasNoScaleDownAnnotation(node) {
+		return "node is marked as no scale down", nodeGroup
+	}
+	change, ok := changes[nodeGroup.Id()]
+	if !ok {
+		size, err := nodeGroup.TargetSize()
+		if err != nil {
+			return fmt.Sprintf("failed to get node group size: %v", err), nodeGroup
+		}
+		change = &NodeGroupChange{
+			NodeGroup:   nodeGroup.Id(),
+			CurrentSize: size,
+			NewSize:     size,
+		}
+		changes[nodeGroup.Id()] = change
+	}
+	if change.NewSize <= nodeGroup.MinSize() {
+		return "min node group size reached", nodeGroup
+	}
+	return "", nodeGroup
+}
+
+// simulateScaleUp estimates the node groups to scale up for pending pods and the buffer ratio
+func (b *BufferedAutoscaler) simulateScaleUp(pendingPods []*apiv1.Pod, allNodes, readyNodes []*apiv1.Node,
+	scheduledPods []*apiv1.Pod, nodeInfosForGroups map[string]*schedulernodeinfo.NodeInfo, now time.Time,
+	resp *SimulationResponse) errors.AutoscalerError {
+	// the node infos of ready nodes after the removals
+	nodeInfos := buildNodeInfos(readyNodes, getPodsInfos(scheduledPods))
+	resp.BufferNotEnough = checkResourceNotEnough(nodeInfos, b.ratio)
+
+	unschedulablePods := make([]*apiv1.Pod, 0)
+	if len(pendingPods) != 0 {
+		unschedulablePods = filterOutSchedulableByPacking(append([]*apiv1.Pod{}, pendingPods...), readyNodes,
+			scheduledPods, b.PredicateChecker, b.ExpendablePodsPriorityCutoff, true)
+		unschedulableSet := make(map[*apiv1.Pod]bool, len(unschedulablePods))
+		for _, pod := range unschedulablePods {
+			unschedulableSet[pod] = true
+		}
+		for _, pod := range pendingPods {
+			if !unschedulableSet[pod] {
+				resp.SchedulablePods = append(resp.SchedulablePods, podKey(pod))
+			}
+		}
+	}
+	if len(unschedulablePods) == 0 && !resp.BufferNotEnough {
+		return nil
+	}
+
+	plan, typedErr := computeExpansionOptions(b.Context, b.processors, b.clusterStateRegistry,
+		removeIPResources(unschedulablePods), readyNodes, nodeInfosForGroups, nodeInfos, resp.BufferNotEnough, now)
+	if typedErr != nil {
+		return typedErr
+	}
+	for id, reasons := range plan.skippedNodeGroups {
+		resp.SkippedNodeGroups[id] = reasons.Reasons()
+	}
+	for pod, rejected := range plan.podsRemainUnschedulable {
+		reasons := make(map[string][]string, len(rejected))
+		for id, r := range rejected {
+			reasons[id] = r.Reasons()
+		}
+		resp.UnschedulablePods[podKey(pod)] = reasons
+	}
+	for _, option := range plan.expansionOptions {
+		resp.ExpansionOptions = append(resp.ExpansionOptions, &ExpansionOption{
+			NodeGroup: option.NodeGroup.Id(),
+			NodeCount: option.NodeCount,
+			Pods:      podKeys(option.Pods),
+		})
+	}
+	if len(plan.expansionOptions) == 0 {
+		klog.V(4).Info("Simulation: no expansion options")
+		return nil
+	}
+
+	bestOption := b.ExpanderStrategy.BestOption(plan.expansionOptions, plan.nodeInfos)
+	if bestOption == nil || bestOption.NodeCount <= 0 {
+		return nil
+	}
+	newNodes := bestOption.NodeCount
+	if b.MaxNodesTotal > 0 && len(allNodes)+newNodes+len(plan.upcomingNodes) > b.MaxNodesTotal {
+		newNodes = b.MaxNodesTotal - len(allNodes) - len(plan.upcomingNodes)
+		if newNodes < 1 {
+			resp.SkippedNodeGroups[bestOption.NodeGroup.Id()] = []string{"max node total count already reached"}
+			return nil
+		}
+	}
+	reason := fmt.Sprintf("%d pending pods can be scheduled on new nodes", len(bestOption.Pods))
+	if len(bestOption.Pods) == 0 {
+		reason = "free resources of cluster are below the buffer ratio"
+	}
+	if !bestOption.NodeGroup.Exist() {
+		resp.NodeGroups = append(resp.NodeGroups, &NodeGroupChange{
+			NodeGroup: bestOption.NodeGroup.Id(),
+			NewSize:   newNodes,
+			Reason:    reason + ", node group would be created",
+			Pods:      podKeys(bestOption.Pods),
+		})
+		return nil
+	}
+
+	nodeInfo, found := plan.nodeInfos[bestOption.NodeGroup.Id()]
+	if !found {
+		return errors.NewAutoscalerError(errors.CloudProviderError, "No node info for best expansion option!")
+	}
+	newNodes, typedErr = applyScaleUpResourcesLimits(b.CloudProvider, newNodes, plan.scaleUpResourcesLeft, nodeInfo,
+		bestOption.NodeGroup, plan.resourceLimiter)
+	if typedErr != nil {
+		return typedErr
+	}
+	targetNodeGroups := []cloudprovider.NodeGroup{bestOption.NodeGroup}
+	if b.BalanceSimilarNodeGroups {
+		similarNodeGroups, typedErr := b.processors.NodeGroupSetProcessor.FindSimilarNodeGroups(
+			b.AutoscalingContext, bestOption.NodeGroup, plan.nodeInfos)
+		if typedErr != nil {
+			return typedErr.AddPrefix("Failed to find matching node groups: ")
+		}
+		similarNodeGroups = filterNodeGroupsByPods(similarNodeGroups, bestOption.Pods, plan.getPodsPassingPredicates)
+		for _, ng := range similarNodeGroups {
+			if b.clusterStateRegistry.IsNodeGroupSafeToScaleUp(ng, now) {
+				targetNodeGroups = append(targetNodeGroups, ng)
+			}
+		}
+	}
+	scaleUpInfos, typedErr := b.processors.NodeGroupSetProcessor.BalanceScaleUpBetweenGroups(
+		b.AutoscalingContext, targetNodeGroups, newNodes)
+	if typedErr != nil {
+		return typedErr
+	}
+	for _, info := range scaleUpInfos {
+		resp.NodeGroups = append(resp.NodeGroups, &NodeGroupChange{
+			NodeGroup:   info.Group.Id(),
+			CurrentSize: info.CurrentSize,
+			NewSize:     info.NewSize,
+			Reason:      reason,
+			Pods:        podKeys(bestOption.Pods),
+		})
+	}
+	return nil
+}
+
+// SimulationHandler serves the simulation api, requests must carry the bearer token
+type SimulationHandler struct {
+	simulator Simulator
+	token     string
+}
+
+// NewSimulationHandler returns a handler of simulation api, token must not be empty
+func NewSimulationHandler(simulator Simulator, token string) *SimulationHandler {
+	return &SimulationHandler{simulator: simulator, token: token}
+}
+
+// ServeHTTP implements http.Handler
+func (h *SimulationHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !h.authorized(r) {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	if r.Method != http.MethodPost {
+		http.Error(w, "only POST is supported", http.StatusMethodNotAllowed)
+		return
+	}
+	req := &SimulationRequest{}
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxSimulationBodySize)).Decode(req); err != nil {
+		http.Error(w, fmt.Sprintf("invalid simulation request: %v", err), http.StatusBadRequest)
+		return
+	}
+	resp, typedErr := h.simulator.Simulate(req)
+	if typedErr != nil {
+		klog.Errorf("Failed to simulate: %v", typedErr)
+		http.Error(w, typedErr.Error(), http.StatusInternalServerError)
+		return
+	}
+	data, err := json.Marshal(resp)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(data); err != nil {
+		klog.Errorf("Failed to write simulation response: %v", err)
+	}
+}
+
+// authorized checks the bearer token of request
+func (h *SimulationHandler) authorized(r *http.Request) bool {
+	const prefix = "Bearer "
+	auth := r.Header.Get("Authorization")
+	if len(h.token) == 0 || !strings.HasPrefix(auth, prefix) {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(strings.TrimPrefix(auth, prefix)), []byte(h.token)) == 1
+}
+
+func copyNodeInfos(nodeInfos map[string]*schedulernodeinfo.NodeInfo) map[string]*schedulernodeinfo.NodeInfo {
+	result := make(map[string]*schedulernodeinfo.NodeInfo, len(nodeInfos))
+	for id, nodeInfo := range nodeInfos {
+		result[id] = nodeInfo
+	}
+	return result
+}
+
+func filterOutRemovedNodes(nodes []*apiv1.Node, removed map[string]bool) []*apiv1.Node {
+	result := make([]*apiv1.Node, 0, len(nodes))
+	for _, node := range nodes {
+		if !removed[node.Name] {
+			result = append(result, node)
+		}
+	}
+	return result
+}
+
+func podKey(pod *apiv1.Pod) string {
+	return fmt.Sprintf("%s/%s", pod.Namespace, pod.Name)
+}
+
+func podKeys(pods []*apiv1.Pod) []string {
+	keys := make([]string, 0, len(pods))
+	for _, pod := range pods {
+		keys = append(keys, podKey(pod))
+	}
+	return keys
+}
@@ -0,0 +1,240 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	testprovider "k8s.io/autoscaler/cluster-autoscaler/cloudprovider/test"
+	"k8s.io/autoscaler/cluster-autoscaler/clusterstate"
+	"k8s.io/autoscaler/cluster-autoscaler/config"
+	"k8s.io/autoscaler/cluster-autoscaler/estimator"
+	"k8s.io/autoscaler/cluster-autoscaler/utils/errors"
+	"k8s.io/autoscaler/cluster-autoscaler/utils/kubernetes"
+	kube_util "k8s.io/autoscaler/cluster-autoscaler/utils/kubernetes"
+	. "k8s.io/autoscaler/cluster-autoscaler/utils/test"
+
+	appsv1 "k8s.io/api/apps/v1"
+	apiv1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1beta1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes/fake"
+	schedulernodeinfo "k8s.io/kubernetes/pkg/scheduler/nodeinfo"
+
+	"github.com/stretchr/testify/assert"
+
+	simulatorinternal "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-cluster-autoscaler/simulator"
+)
+
+type simulationTestNodeGroup struct {
+	id    string
+	min   int
+	max   int
+	nodes []*apiv1.Node
+}
+
+func newSimulationTestAutoscaler(t *testing.T, groups []simulationTestNodeGroup,
+	scheduledPods []*apiv1.Pod) *BufferedAutoscaler {
+	onScaleUpMock := &onScaleUpMock{}
+	onScaleDownMock := &onScaleDownMock{}
+	// nothing should be changed in cloud provider, the mocks panic if they are called
+	provider := testprovider.NewTestCloudProvider(
+		func(id string, delta int) error {
+			return onScaleUpMock.ScaleUp(id, delta)
+		}, func(id string, name string) error {
+			return onScaleDownMock.ScaleDown(id, name)
+		})
+	nodes := make([]*apiv1.Node, 0)
+	for _, group := range groups {
+		provider.AddNodeGroup(group.id, group.min, group.max, len(group.nodes))
+		for _, node := range group.nodes {
+			SetNodeReadyState(node, true, time.Now())
+			provider.AddNode(group.id, node)
+			nodes = append(nodes, node)
+		}
+	}
+
+	readyNodeLister := kubernetes.NewTestNodeLister(nodes)
+	allNodeLister := kubernetes.NewTestNodeLister(nodes)
+	scheduledPodMock := &podListerMock{}
+	unschedulablePodMock := &podListerMock{}
+	podDisruptionBudgetListerMock := &podDisruptionBudgetListerMock{}
+	daemonSetListerMock := &daemonSetListerMock{}
+	scheduledPodMock.On("List").Return(scheduledPods, nil)
+	podDisruptionBudgetListerMock.On("List").Return([]*policyv1.PodDisruptionBudget{}, nil)
+	daemonSetListerMock.On("List", labels.Everything()).Return([]*appsv1.DaemonSet{}, nil)
+
+	options := config.AutoscalingOptions{
+		EstimatorName:                 estimator.BinpackingEstimatorName,
+		ScaleDownUtilizationThreshold: 0.5,
+		MaxNodesTotal:                 10,
+		MaxCoresTotal:                 10,
+		MaxMemoryTotal:                100000,
+		ExpendablePodsPriorityCutoff:  10,
+	}
+	processorCallbacks := newBufferedAutoscalerProcessorCallbacks()
+	context := NewScaleTestAutoscalingContext(options, &fake.Clientset{}, nil, provider, processorCallbacks)
+	context.ListerRegistry = kube_util.NewListerRegistry(allNodeLister, readyNodeLister, scheduledPodMock,
+		unschedulablePodMock, podDisruptionBudgetListerMock, daemonSetListerMock,
+		nil, nil, nil, nil)
+
+	clusterStateConfig := clusterstate.ClusterStateRegistryConfig{
+		OkTotalUnreadyCount:  1,
+		MaxNodeProvisionTime: 10 * time.Second,
+	}
+	clusterState := clusterstate.NewClusterStateRegistry(provider, clusterStateConfig, context.LogRecorder, newBackoff())
+	// cluster state is updated by main loop
+	nodeInfos, err := getNodeInfosForGroups(nodes, make(map[string]*schedulernodeinfo.NodeInfo), provider,
+		context.ListerRegistry, []*appsv1.DaemonSet{}, context.PredicateChecker, nil)
+	assert.NoError(t, err)
+	assert.NoError(t, clusterState.UpdateNodes(nodes, nodeInfos, time.Now()))
+
+	autoscaler := &BufferedAutoscaler{
+		Context:              &context,
+		clusterStateRegistry: clusterState,
+		scaleDown:            NewScaleDown(&context, clusterState, 0),
+		processors:           NewTestProcessors(),
+		processorCallbacks:   processorCallbacks,
+		nodeInfoCache:        make(map[string]*schedulernodeinfo.NodeInfo),
+	}
+	// snapshot is updated by main loop
+	autoscaler.updateSnapshot(nodes, nodes, nodeInfos)
+	return autoscaler
+}
+
+func TestBufferedAutoscalerSimulateWithoutSnapshot(t *testing.T) {
+	autoscaler := newSimulationTestAutoscaler(t, []simulationTestNodeGroup{}, []*apiv1.Pod{})
+	autoscaler.snapshot = nil
+
+	_, err := autoscaler.Simulate(&SimulationRequest{})
+	if assert.Error(t, err) {
+		assert.Equal(t, errors.TransientError, err.Type())
+	}
+}
+
+func TestBufferedAutoscalerSimulateScaleUp(t *testing.T) {
+	n1 := BuildTestNode("n1", 2000, 1000)
+	n2 := BuildTestNode("n2", 2000, 1000)
+	p1 := BuildTestPod("p1", 1400, 0)
+	p1.Spec.NodeName = "n1"
+	p2 := BuildTestPod("p2", 1400, 0)
+	p2.Spec.NodeName = "n2"
+
+	autoscaler := newSimulationTestAutoscaler(t, []simulationTestNodeGroup{
+		{id: "ng1", min: 0, max: 10, nodes: []*apiv1.Node{n1}},
+		{id: "ng2", min: 0, max: 10, nodes: []*apiv1.Node{n2}},
+	}, []*apiv1.Pod{p1, p2})
+
+	// pending pod which can not be scheduled on existing nodes
+	resp, err := autoscaler.Simulate(&SimulationRequest{
+		Pods: []*apiv1.Pod{BuildTestPod("p3", 1400, 0)},
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, resp.SchedulablePods)
+	assert.NotEmpty(t, resp.ExpansionOptions)
+	if assert.Len(t, resp.NodeGroups, 1) {
+		assert.Contains(t, []string{"ng1", "ng2"}, resp.NodeGroups[0].NodeGroup)
+		assert.Equal(t, 1, resp.NodeGroups[0].CurrentSize)
+		assert.Equal(t, 2, resp.NodeGroups[0].NewSize)
+		assert.Equal(t, []string{"default/p3"}, resp.NodeGroups[0].Pods)
+	}
+
+	// pending pod which can be scheduled on existing nodes
+	resp, err = autoscaler.Simulate(&SimulationRequest{
+		Pods: []*apiv1.Pod{BuildTestPod("p4", 500, 0)},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"default/p4"}, resp.SchedulablePods)
+	assert.Empty(t, resp.NodeGroups)
+}
+
+func TestBufferedAutoscalerSimulateScaleDown(t *testing.T) {
+	n1 := BuildTestNode("n1", 2000, 1000)
+	n2 := BuildTestNode("n2", 2000, 1000)
+	n3 := BuildTestNode("n3", 2000, 1000)
+	p1 := BuildTestPod("p1", 1400, 0)
+	p1.Spec.NodeName = "n1"
+	p2 := BuildTestPod("p2", 1400, 0)
+	p2.Annotations = map[string]string{simulatorinternal.PodSafeToEvictKey: "true"}
+	p2.Spec.NodeName = "n2"
+
+	autoscaler := newSimulationTestAutoscaler(t, []simulationTestNodeGroup{
+		{id: "ng1", min: 0, max: 10, nodes: []*apiv1.Node{n1, n3}},
+		{id: "ng2", min: 1, max: 10, nodes: []*apiv1.Node{n2}},
+	}, []*apiv1.Pod{p1, p2})
+
+	resp, err := autoscaler.Simulate(&SimulationRequest{
+		RemoveNodes: []string{"n3", "n2", "n4"},
+	})
+	assert.NoError(t, err)
+	if assert.Len(t, resp.NodeGroups, 1) {
+		assert.Equal(t, &NodeGroupChange{
+			NodeGroup:   "ng1",
+			CurrentSize: 2,
+			NewSize:     1,
+			Reason:      resp.NodeGroups[0].Reason,
+			Nodes:       []string{"n3"},
+		}, resp.NodeGroups[0])
+	}
+	if assert.Len(t, resp.RemovableNodes, 1) {
+		assert.Equal(t, "n3", resp.RemovableNodes[0].Node)
+	}
+	if assert.Len(t, resp.UnremovableNodes, 2) {
+		assert.Equal(t, &NodeRemoval{Node: "n2", NodeGroup: "ng2", Reason: "min node group size reached"},
+			resp.UnremovableNodes[0])
+		assert.Equal(t, &NodeRemoval{Node: "n4", Reason: "node not found"}, resp.UnremovableNodes[1])
+	}
+}
+
+func TestSimulationHandler(t *testing.T) {
+	n1 := BuildTestNode("n1", 2000, 1000)
+	autoscaler := newSimulationTestAutoscaler(t, []simulationTestNodeGroup{
+		{id: "ng1", min: 0, max: 10, nodes: []*apiv1.Node{n1}},
+	}, []*apiv1.Pod{})
+	handler := NewSimulationHandler(autoscaler, "token")
+	newRequest := func(method, token string, body *bytes.Buffer) *http.Request {
+		req := httptest.NewRequest(method, SimulationPath, body)
+		if len(token) != 0 {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		return req
+	}
+
+	for _, token := range []string{"", "invalid"} {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, newRequest(http.MethodPost, token, bytes.NewBufferString("{}")))
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+	}
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, newRequest(http.MethodGet, "token", bytes.NewBuffer(nil)))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, newRequest(http.MethodPost, "token", bytes.NewBufferString("{")))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	body, _ := json.Marshal(&SimulationRequest{Pods: []*apiv1.Pod{BuildTestPod("p1", 500, 0)}})
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, newRequest(http.MethodPost, "token", bytes.NewBuffer(body)))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	resp := &SimulationResponse{}
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), resp))
+	assert.Equal(t, []string{"default/p1"}, resp.SchedulablePods)
+}
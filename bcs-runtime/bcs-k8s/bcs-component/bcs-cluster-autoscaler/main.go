@@ -18,6 +18,7 @@ import (
 	ctx "context"
 	"flag"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 	"os"
@@ -221,6 +222,14 @@ var (
 	webhookMode       = flag.String("webhook-mode", "", "Webhook Mode. Available values: [ Web, ConfigMap ]")
 	webhookModeConfig = flag.String("webhook-mode-config", "", "Configuration of webhook mode."+
 		" It is a url for web, or namespace/name for configmap")
+	simulationAddress = flag.String("simulation-address", "",
+		"The address to serve simulation api. Simulation api is disabled if empty.")
+	simulationTokenFile = flag.String("simulation-token-file", "",
+		"File containing the bearer token to access simulation api, required if simulation api is enabled.")
+	simulationTLSCertFile = flag.String("simulation-tls-cert-file", "",
+		"File containing the x509 certificate for serving simulation api over HTTPS.")
+	simulationTLSKeyFile = flag.String("simulation-tls-key-file", "",
+		"File containing the x509 private key matching --simulation-tls-cert-file.")
 )
 
 func createAutoscalingOptions() scalingconfig.Options {
@@ -373,6 +382,11 @@ func run(healthCheck *metrics.HealthCheck) {
 		klog.Fatalf("Failed to create autoscaler: %v", err)
 	}
 
+	// Serve simulation api on a separate server which requires authentication.
+	if sim, ok := autoscaler.(coreinternal.Simulator); ok && len(*simulationAddress) != 0 {
+		go serveSimulation(sim)
+	}
+
 	// Register signal handlers for graceful shutdown.
 	registerSignalHandlers(autoscaler)
 
@@ -409,6 +423,30 @@ func run(healthCheck *metrics.HealthCheck) {
 	}
 }
 
+func serveSimulation(sim coreinternal.Simulator) {
+	if len(*simulationTokenFile) == 0 {
+		klog.Fatalf("--simulation-token-file is required if simulation api is enabled")
+	}
+	token, err := ioutil.ReadFile(*simulationTokenFile)
+	if err != nil {
+		klog.Fatalf("Failed to read simulation token file: %v", err)
+	}
+	if len(strings.TrimSpace(string(token))) == 0 {
+		klog.Fatalf("Simulation token is empty")
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(coreinternal.SimulationPath,
+		coreinternal.NewSimulationHandler(sim, strings.TrimSpace(string(token))))
+	server := &http.Server{Addr: *simulationAddress, Handler: mux}
+	if len(*simulationTLSCertFile) != 0 || len(*simulationTLSKeyFile) != 0 {
+		err = server.ListenAndServeTLS(*simulationTLSCertFile, *simulationTLSKeyFile)
+	} else {
+		err = server.ListenAndServe()
+	}
+	klog.Fatalf("Failed to serve simulation api: %v", err)
+}
+
 func main() {
 	klog.InitFlags(nil)
 
@@ -139,6 +139,38 @@ candidateloop:
 	return result, unremovable, newHints, nil
 }
 
+// SimulateNodeRemoval checks whether the node can be removed with a detailed evaluation. The pods on the node
+// are rescheduled to destinationNodes, and the rescheduling location of each pod is returned. Returns the reason
+// if the node can not be removed.
+func SimulateNodeRemoval(node *apiv1.Node, destinationNodes []*apiv1.Node, pods []*apiv1.Pod,
+	listers kube_util.ListerRegistry, predicateChecker *simulatorinternal.PredicateChecker,
+	podDisruptionBudgets []*policyv1.PodDisruptionBudget, timestamp time.Time) (*NodeToBeRemoved,
+	map[string]string, error) {
+	nodes := make([]*apiv1.Node, 0, len(destinationNodes)+1)
+	nodes = append(nodes, destinationNodes...)
+	nodes = append(nodes, node)
+	nodeNameToNodeInfo := scheduler_util.CreateNodeNameToInfoMap(pods, nodes)
+
+	var podsToRemove []*apiv1.Pod
+	if nodeInfo, found := nodeNameToNodeInfo[node.Name]; found {
+		var err error
+		podsToRemove, err = DetailedGetPodsForMove(nodeInfo, skipNodesWithSystemPods, skipNodesWithLocalStorage,
+			listers, int32(minReplicaCount), podDisruptionBudgets)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	hints := make(map[string]string)
+	if err := findPlaceFor(node.Name, podsToRemove, destinationNodes, nodeNameToNodeInfo, predicateChecker,
+		map[string]string{}, hints, simulatorinternal.NewUsageTracker(), timestamp); err != nil {
+		return nil, nil, err
+	}
+	return &NodeToBeRemoved{
+		Node:             node,
+		PodsToReschedule: podsToRemove,
+	}, hints, nil
+}
+
 // FindEmptyNodesToRemove finds empty nodes that can be removed.
 func FindEmptyNodesToRemove(candidates []*apiv1.Node, pods []*apiv1.Pod) []*apiv1.Node {
 	nodeNameToNodeInfo := scheduler_util.CreateNodeNameToInfoMap(pods, candidates)
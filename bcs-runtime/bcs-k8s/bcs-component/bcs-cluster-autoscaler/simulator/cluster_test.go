@@ -323,3 +323,70 @@ func TestFindNodesToRemove(t *testing.T) {
 	}
 
 }
+
+func TestSimulateNodeRemoval(t *testing.T) {
+	emptyNode := BuildTestNode("n1", 1000, 2000000)
+	drainableNode := BuildTestNode("n2", 1000, 2000000)
+	nonDrainableNode := BuildTestNode("n3", 1000, 2000000)
+	destinationNode := BuildTestNode("n4", 1000, 2000000)
+	SetNodeReadyState(emptyNode, true, time.Time{})
+	SetNodeReadyState(drainableNode, true, time.Time{})
+	SetNodeReadyState(nonDrainableNode, true, time.Time{})
+	SetNodeReadyState(destinationNode, true, time.Time{})
+
+	pod1 := BuildTestPod("p1", 600, 100000)
+	pod1.Annotations = map[string]string{PodSafeToEvictKey: "true"}
+	pod1.Spec.NodeName = "n2"
+	pod2 := BuildTestPod("p2", 100, 100000)
+	pod2.Spec.NodeName = "n3"
+	pods := []*apiv1.Pod{pod1, pod2}
+	predicateChecker := simulatorinternal.NewTestPredicateChecker()
+
+	tests := []struct {
+		name         string
+		node         *apiv1.Node
+		destinations []*apiv1.Node
+		removable    bool
+		hints        map[string]string
+	}{
+		{
+			name:         "empty node can be removed",
+			node:         emptyNode,
+			destinations: []*apiv1.Node{},
+			removable:    true,
+			hints:        map[string]string{},
+		},
+		{
+			name:         "pods on drainable node are moved to destination",
+			node:         drainableNode,
+			destinations: []*apiv1.Node{destinationNode},
+			removable:    true,
+			hints:        map[string]string{pod1.Namespace + "/" + pod1.Name: "n4"},
+		},
+		{
+			name:         "no place for pods on drainable node",
+			node:         drainableNode,
+			destinations: []*apiv1.Node{},
+			removable:    false,
+		},
+		{
+			name:         "pod on node is not replicated",
+			node:         nonDrainableNode,
+			destinations: []*apiv1.Node{destinationNode},
+			removable:    false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			toRemove, hints, err := SimulateNodeRemoval(test.node, test.destinations, pods, nil,
+				predicateChecker, []*policyv1.PodDisruptionBudget{}, time.Now())
+			if !test.removable {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.node, toRemove.Node)
+			assert.Equal(t, test.hints, hints)
+		})
+	}
+}
@@ -28,6 +28,29 @@ type ControllerRef struct {
 	Name string `json:"name"`
 }
 
+//RateLimit token bucket definition for rule rate limiting
+type RateLimit struct {
+	//Rate is requests per second for http rule, or new connections per second for tcp rule
+	// +kubebuilder:validation:Minimum=1
+	Rate uint `json:"rate"`
+	//Burst is the max tokens of bucket, default equals to Rate
+	// +optional
+	Burst uint `json:"burst,omitempty"`
+}
+
+//TLSOrigination mTLS origination to remote destination, proxy holds
+//client certificate and initiates TLS connection instead of business containers
+type TLSOrigination struct {
+	//SecretName refers to a secret in the same namespace of BCSEgress, which
+	//contains tls.crt & tls.key for client certificate, and optional ca.crt for
+	//server certificate verification, system ca bundle is used if ca.crt is absent
+	// +kubebuilder:validation:Required
+	SecretName string `json:"secretName"`
+	//SNI for TLS handshake, use destination domain if empty
+	// +optional
+	SNI string `json:"sni,omitempty"`
+}
+
 // HTTP http egress definition
 type HTTP struct {
 	//Name for http management
@@ -39,6 +62,18 @@ type HTTP struct {
 	//Destination port for remote host
 	// +kubebuilder:default=80
 	DestPort uint `json:"destport"`
+	//Methods allowed HTTP methods, all methods are allowed if empty
+	// +optional
+	Methods []string `json:"methods,omitempty"`
+	//Paths allowed HTTP path prefixes, all paths are allowed if empty
+	// +optional
+	Paths []string `json:"paths,omitempty"`
+	//RateLimit for http requests, only available for envoy proxy
+	// +optional
+	RateLimit *RateLimit `json:"rateLimit,omitempty"`
+	//TLS origination to remote host, only available for envoy proxy
+	// +optional
+	TLS *TLSOrigination `json:"tls,omitempty"`
 }
 
 // TCP tcp egress definition
@@ -59,6 +94,12 @@ type TCP struct {
 	// +kubebuilder:validation:Enum=roundrobin;least_conn;hash
 	// +kubebuilder:default=roundrobin
 	Algorithm string `json:"algorithm"`
+	//RateLimit for new tcp connections, only available for envoy proxy
+	// +optional
+	RateLimit *RateLimit `json:"rateLimit,omitempty"`
+	//TLS origination to remote destination, only available for envoy proxy
+	// +optional
+	TLS *TLSOrigination `json:"tls,omitempty"`
 }
 
 // BCSEgressSpec defines the desired state of BCSEgress
@@ -80,6 +121,27 @@ const (
 	EgressStateSynced = "Synced"
 )
 
+const (
+	//RuleProtocolHTTP protocol for http rule status
+	RuleProtocolHTTP = "http"
+	//RuleProtocolTCP protocol for tcp rule status
+	RuleProtocolTCP = "tcp"
+)
+
+//RuleStatus network flow statistics for single rule
+type RuleStatus struct {
+	//Name of http or tcp rule
+	Name string `json:"name"`
+	//Protocol of rule, http or tcp
+	Protocol string `json:"protocol"`
+	//ActiveConnections active upstream connections
+	ActiveConnections uint64 `json:"activeConnections"`
+	//BytesSent total bytes sent to remote destination
+	BytesSent uint64 `json:"bytesSent"`
+	//BytesReceived total bytes received from remote destination
+	BytesReceived uint64 `json:"bytesReceived"`
+}
+
 // BCSEgressStatus defines the observed state of BCSEgress
 type BCSEgressStatus struct {
 	// State refference EgressState above
@@ -91,6 +153,9 @@ type BCSEgressStatus struct {
 	Reason string `json:"reason"`
 	//all egress sync timestamp
 	SyncedAt metav1.Time `json:"syncedat"`
+	//Rules statistics for every rule, only available when proxy supports
+	// +optional
+	Rules []RuleStatus `json:"rules,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -15,15 +15,22 @@ package bcsegress
 
 import (
 	"context"
+	"crypto/md5"
+	"encoding/hex"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
 	"strings"
 	"time"
 
 	bkbcsv1alpha1 "bcs-egress/pkg/apis/bkbcs/v1alpha1"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/klog"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -38,6 +45,11 @@ import (
 const (
 	labelReference           = "bcsegress"
 	defaultReconcileInterval = time.Second * 5
+	//defaultStatsInterval interval for refreshing rule statistics in BCSEgress status
+	defaultStatsInterval = time.Second * 30
+	//certificate directory under generate directory for TLS origination
+	certDirectory = "certs"
+	tlsCAKey      = "ca.crt"
 )
 
 // Add creates a new BCSEgress Controller and adds it to the Manager. The Manager will set fields on the Controller
@@ -54,7 +66,7 @@ func Add(mgr manager.Manager, option *EgressOption) error {
 // NewBCSEgressReconciler returns a new reconcile.Reconciler
 // *config: configuration file for Reconciler
 func NewBCSEgressReconciler(mgr manager.Manager, option *EgressOption) *ReconcileBCSEgress {
-	p, err := NewNginx(option)
+	p, err := NewProxy(option)
 	if err != nil {
 		klog.Errorf("init BCSEgressReconciler failed, %s", err.Error())
 		return nil
@@ -131,11 +143,11 @@ func (r *ReconcileBCSEgress) Reconcile(request reconcile.Request) (reconcile.Res
 	// do nothing until client fix them all.
 	tcps, https, err := r.fromBCSEgressToList(instance)
 	if err != nil {
-		klog.Errorf("BCSEgress %s port definition conflicts: %s, just update Egress status.", request.String(), err.Error())
+		klog.Errorf("BCSEgress %s rule definition is invalid: %s, just update Egress status.", request.String(), err.Error())
 		instance.Status.Reason = err.Error()
 		instance.Status.State = bkbcsv1alpha1.EgressStateError
 		instance.Status.SyncedAt = metav1.Now()
-		if err = r.client.Update(context.TODO(), instance); err != nil {
+		if err = r.client.Status().Update(context.TODO(), instance); err != nil {
 			klog.Errorf("update BCSEgress %s port conflict status failed, %s, #now drop reconcile until client fix#", request.String(), err.Error())
 			//todo(DeveloperJim): evaluate necessary of reconcile
 		} else {
@@ -174,10 +186,15 @@ func (r *ReconcileBCSEgress) Reconcile(request reconcile.Request) (reconcile.Res
 		instance.Status.Reason = "all rules SYNCED"
 		instance.Status.State = bkbcsv1alpha1.EgressStateSynced
 		instance.Status.SyncedAt = metav1.Now()
-		if err = r.client.Update(context.TODO(), instance); err != nil {
+		statsEnabled := r.refreshRuleStatus(instance, tcps, https)
+		if err = r.client.Status().Update(context.TODO(), instance); err != nil {
 			klog.Errorf("update BCSEgress %s last Reconcile status[%s] failed, %s. push to ReconcileQueue for updating again in 5 seconds", request.String(), instance.Status.State, err.Error())
 			return reconcile.Result{RequeueAfter: time.Second * 5}, nil
 		}
+		if statsEnabled {
+			//refresh rule statistics periodically
+			return reconcile.Result{RequeueAfter: defaultStatsInterval}, nil
+		}
 		return reconcile.Result{}, nil
 	}
 	if err := r.proxy.Reload(request.String()); err != nil {
@@ -186,7 +203,7 @@ func (r *ReconcileBCSEgress) Reconcile(request reconcile.Request) (reconcile.Res
 		instance.Status.Reason = fmt.Sprintf("EgressController reload internal failed: %s", err.Error())
 		instance.Status.State = bkbcsv1alpha1.EgressStateError
 		instance.Status.SyncedAt = metav1.Now()
-		if err = r.client.Update(context.TODO(), instance); err != nil {
+		if err = r.client.Status().Update(context.TODO(), instance); err != nil {
 			klog.Errorf("update BCSEgress %s reload status failed, %s", request.String(), err.Error())
 		} else {
 			klog.Warningf("update BCSEgress %s reload failed status done, try to reload in 15 seconds", request.String())
@@ -197,11 +214,15 @@ func (r *ReconcileBCSEgress) Reconcile(request reconcile.Request) (reconcile.Res
 	instance.Status.Reason = "all rules SYNCED"
 	instance.Status.State = bkbcsv1alpha1.EgressStateSynced
 	instance.Status.SyncedAt = metav1.Now()
-	if err = r.client.Update(context.TODO(), instance); err != nil {
+	statsEnabled := r.refreshRuleStatus(instance, tcps, https)
+	if err = r.client.Status().Update(context.TODO(), instance); err != nil {
 		klog.Errorf("update BCSEgress %s last Reconcile status[%s] after proxy reload failed, %s. try to Update again in next reconciler", request.String(), instance.Status.State, err.Error())
 		return reconcile.Result{RequeueAfter: time.Second * 5}, nil
 	}
 	klog.Warningf(">update BCSEgress %s Reconcile status done, Status [%s]", request.String(), instance.Status.State)
+	if statsEnabled {
+		return reconcile.Result{RequeueAfter: defaultStatsInterval}, nil
+	}
 	return reconcile.Result{}, nil
 }
 
@@ -321,6 +342,9 @@ func (r *ReconcileBCSEgress) fromBCSEgressToList(egress *bkbcsv1alpha1.BCSEgress
 		//http rule must be unique in global scope
 		httpConfig := SimpleHTTPConfig(httprule.Name, httprule.Host, httprule.DestPort)
 		httpConfig.Label[labelReference] = egressIndexer
+		if err := r.convertHTTPPolicy(egress, &httprule, httpConfig); err != nil {
+			return nil, nil, err
+		}
 		destConfig, err := r.proxy.GetHTTPRule(httpConfig.Key())
 		if err != nil {
 			klog.Errorf("EgressController get HTTPRule [%s] error when formating BCSEgress %s: %s", httpConfig.Key(), egressIndexer, err.Error())
@@ -372,6 +396,17 @@ func (r *ReconcileBCSEgress) fromBCSEgressToList(egress *bkbcsv1alpha1.BCSEgress
 			tcpConfig.HasBackend = true
 			tcpConfig.SortIPs()
 		}
+		if tcprule.RateLimit != nil || tcprule.TLS != nil {
+			if !r.proxy.SupportPolicy() {
+				return nil, nil, fmt.Errorf("tcp rule %s rateLimit & tls are not supported by proxy %s", tcprule.Name, r.option.ProxyType)
+			}
+			tcpConfig.RateLimit = convertRateLimit(tcprule.RateLimit)
+			tlsConfig, err := r.loadTLSConfig(egress, tcprule.Name, tcprule.TLS)
+			if err != nil {
+				return nil, nil, err
+			}
+			tcpConfig.TLS = tlsConfig
+		}
 		//check proxy port is only maintained by this BCSEgress
 		destConfig, err := r.proxy.GetTCPRuleByPort(tcprule.SourcePort)
 		if err != nil {
@@ -398,6 +433,142 @@ func (r *ReconcileBCSEgress) fromBCSEgressToList(egress *bkbcsv1alpha1.BCSEgress
 	return tcpList, httpList, nil
 }
 
+//convertHTTPPolicy convert L7 policy, rate limit and TLS origination of http rule
+func (r *ReconcileBCSEgress) convertHTTPPolicy(egress *bkbcsv1alpha1.BCSEgress, rule *bkbcsv1alpha1.HTTP, config *HTTPConfig) error {
+	if len(rule.Methods) == 0 && len(rule.Paths) == 0 && rule.RateLimit == nil && rule.TLS == nil {
+		return nil
+	}
+	if !r.proxy.SupportPolicy() {
+		return fmt.Errorf("http rule %s methods, paths, rateLimit & tls are not supported by proxy %s", rule.Name, r.option.ProxyType)
+	}
+	for _, method := range rule.Methods {
+		config.Methods = append(config.Methods, strings.ToUpper(method))
+	}
+	for _, prefix := range rule.Paths {
+		if !strings.HasPrefix(prefix, "/") {
+			return fmt.Errorf("http rule %s path %s must start with /", rule.Name, prefix)
+		}
+		config.Paths = append(config.Paths, prefix)
+	}
+	config.RateLimit = convertRateLimit(rule.RateLimit)
+	tlsConfig, err := r.loadTLSConfig(egress, rule.Name, rule.TLS)
+	if err != nil {
+		return err
+	}
+	config.TLS = tlsConfig
+	return nil
+}
+
+func convertRateLimit(limit *bkbcsv1alpha1.RateLimit) *RateLimitConfig {
+	if limit == nil {
+		return nil
+	}
+	return &RateLimitConfig{
+		Rate:  limit.Rate,
+		Burst: limit.Burst,
+	}
+}
+
+//loadTLSConfig read client certificate from secret and store it in generate directory
+//for proxy. directory name contains content hash, so proxy will reload certificate
+//when secret changed
+func (r *ReconcileBCSEgress) loadTLSConfig(egress *bkbcsv1alpha1.BCSEgress, rule string, origination *bkbcsv1alpha1.TLSOrigination) (*TLSConfig, error) {
+	if origination == nil {
+		return nil, nil
+	}
+	secret := &corev1.Secret{}
+	key := types.NamespacedName{Namespace: egress.Namespace, Name: origination.SecretName}
+	if err := r.client.Get(context.TODO(), key, secret); err != nil {
+		klog.Errorf("EgressController get secret %s for rule %s failed, %s", key.String(), rule, err.Error())
+		return nil, fmt.Errorf("rule %s get tls secret %s failed: %s", rule, origination.SecretName, err.Error())
+	}
+	cert := secret.Data[corev1.TLSCertKey]
+	privateKey := secret.Data[corev1.TLSPrivateKeyKey]
+	if len(cert) == 0 || len(privateKey) == 0 {
+		return nil, fmt.Errorf("rule %s tls secret %s lost %s or %s", rule, origination.SecretName,
+			corev1.TLSCertKey, corev1.TLSPrivateKeyKey)
+	}
+	ca := secret.Data[tlsCAKey]
+	if len(ca) == 0 && len(r.option.SystemCAFile) == 0 {
+		return nil, fmt.Errorf("rule %s tls secret %s lost %s and no system ca bundle is configured", rule,
+			origination.SecretName, tlsCAKey)
+	}
+	md5Block := md5.New()
+	md5Block.Write(cert)
+	md5Block.Write(privateKey)
+	md5Block.Write(ca)
+	hash := hex.EncodeToString(md5Block.Sum(nil))
+	dir := path.Join(r.option.GenerateDir, certDirectory,
+		fmt.Sprintf("%s_%s_%s_%s", egress.Namespace, egress.Name, rule, hash[:8]))
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		klog.Errorf("EgressController create certificate directory %s failed, %s", dir, err.Error())
+		return nil, err
+	}
+	config := &TLSConfig{
+		CertFile: path.Join(dir, corev1.TLSCertKey),
+		KeyFile:  path.Join(dir, corev1.TLSPrivateKeyKey),
+		SNI:      origination.SNI,
+		Hash:     hash,
+	}
+	files := map[string][]byte{
+		config.CertFile: cert,
+		config.KeyFile:  privateKey,
+	}
+	if len(ca) != 0 {
+		config.CAFile = path.Join(dir, tlsCAKey)
+		files[config.CAFile] = ca
+	} else {
+		//destination is always validated, use system ca bundle if secret doesn't specify one
+		config.CAFile = r.option.SystemCAFile
+	}
+	for filename, content := range files {
+		if err := ioutil.WriteFile(filename, content, 0600); err != nil {
+			klog.Errorf("EgressController write certificate %s failed, %s", filename, err.Error())
+			return nil, err
+		}
+	}
+	return config, nil
+}
+
+//refreshRuleStatus fill statistics of all rules into BCSEgress status,
+//return false if proxy doesn't support rule statistics
+func (r *ReconcileBCSEgress) refreshRuleStatus(egress *bkbcsv1alpha1.BCSEgress, tcps []*TCPConfig, https []*HTTPConfig) bool {
+	stats, err := r.proxy.ListRuleStats()
+	if err != nil {
+		klog.Warningf("EgressController list rule statistics for %s/%s failed, %s", egress.Namespace, egress.Name, err.Error())
+		return true
+	}
+	if stats == nil {
+		return false
+	}
+	var rules []bkbcsv1alpha1.RuleStatus
+	var httpActives, tcpActives uint
+	for _, config := range https {
+		status := bkbcsv1alpha1.RuleStatus{Name: config.Name, Protocol: bkbcsv1alpha1.RuleProtocolHTTP}
+		if stat, ok := stats.HTTP[config.Key()]; ok {
+			status.ActiveConnections = stat.ActiveConnections
+			status.BytesSent = stat.BytesSent
+			status.BytesReceived = stat.BytesReceived
+			httpActives += uint(stat.ActiveConnections)
+		}
+		rules = append(rules, status)
+	}
+	for _, config := range tcps {
+		status := bkbcsv1alpha1.RuleStatus{Name: config.Name, Protocol: bkbcsv1alpha1.RuleProtocolTCP}
+		if stat, ok := stats.TCP[config.Key()]; ok {
+			status.ActiveConnections = stat.ActiveConnections
+			status.BytesSent = stat.BytesSent
+			status.BytesReceived = stat.BytesReceived
+			tcpActives += uint(stat.ActiveConnections)
+		}
+		rules = append(rules, status)
+	}
+	egress.Status.Rules = rules
+	egress.Status.HTTPActives = httpActives
+	egress.Status.TCPActives = tcpActives
+	return true
+}
+
 //reconcileHTTPRules try reconcile difference between these two HTTPConfig slices
 func (r *ReconcileBCSEgress) reconcileHTTPRules(https, cacheHTTPS []*HTTPConfig) (bool, error) {
 	isChanged := false
@@ -539,6 +710,10 @@ func (r *ReconcileBCSEgress) Update(e event.UpdateEvent) bool {
 	if egress.Spec.Controller.Name != r.option.Name {
 		return false
 	}
+	//status updated by controller itself, skip
+	if e.MetaOld != nil && e.MetaOld.GetGeneration() == e.MetaNew.GetGeneration() {
+		return false
+	}
 	return true
 }
 
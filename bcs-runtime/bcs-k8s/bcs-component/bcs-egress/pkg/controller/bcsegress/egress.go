@@ -15,9 +15,32 @@ package bcsegress
 
 import (
 	"fmt"
+	"reflect"
 	"sort"
 )
 
+//RateLimitConfig token bucket configuration for rule
+type RateLimitConfig struct {
+	//Rate tokens filled per second
+	Rate uint
+	//Burst max tokens of bucket
+	Burst uint
+}
+
+//TLSConfig mutual TLS origination configuration to destination
+type TLSConfig struct {
+	//CertFile client certificate file path
+	CertFile string
+	//KeyFile client key file path
+	KeyFile string
+	//CAFile ca certificate for destination validation, ca.crt in secret or system ca bundle
+	CAFile string
+	//SNI server name indication
+	SNI string
+	//Hash content hash of certification files, changed when secret updated
+	Hash string
+}
+
 //SimpleHTTPConfig init one simple config
 func SimpleHTTPConfig(name, domain string, port uint) *HTTPConfig {
 	c := &HTTPConfig{
@@ -38,6 +61,14 @@ type HTTPConfig struct {
 	//backend ip list, reserved for extention
 	IPs             []string
 	DestinationPort uint
+	//Methods allowed http methods, empty means all methods
+	Methods []string
+	//Paths allowed path prefixes, empty means all paths
+	Paths []string
+	//RateLimit request rate limit, nil means unlimited
+	RateLimit *RateLimitConfig
+	//TLS mutual TLS origination, nil means disabled
+	TLS *TLSConfig
 	//Label use for custom information storage
 	//all control informations are depend on Label,
 	//! Label is reqired
@@ -67,6 +98,12 @@ func (config *HTTPConfig) IsChanged(dest *HTTPConfig) bool {
 	if config.DestinationPort != dest.DestinationPort {
 		return true
 	}
+	if !reflect.DeepEqual(config.Methods, dest.Methods) || !reflect.DeepEqual(config.Paths, dest.Paths) {
+		return true
+	}
+	if !reflect.DeepEqual(config.RateLimit, dest.RateLimit) || !reflect.DeepEqual(config.TLS, dest.TLS) {
+		return true
+	}
 	return false
 }
 
@@ -107,6 +144,10 @@ type TCPConfig struct {
 	Algorithm string
 	//DestinationPort work for domain & iplist
 	DestinationPort uint
+	//RateLimit connection rate limit, nil means unlimited
+	RateLimit *RateLimitConfig
+	//TLS mutual TLS origination, nil means disabled
+	TLS *TLSConfig
 	//Label use for custom information storage
 	//all control informations are depend on Label,
 	//! Label is reqired
@@ -124,6 +165,9 @@ func (config *TCPConfig) IsChanged(dest *TCPConfig) bool {
 	if config.Algorithm != dest.Algorithm {
 		return true
 	}
+	if !reflect.DeepEqual(config.RateLimit, dest.RateLimit) || !reflect.DeepEqual(config.TLS, dest.TLS) {
+		return true
+	}
 	if len(config.IPs) != len(dest.IPs) {
 		return true
 	}
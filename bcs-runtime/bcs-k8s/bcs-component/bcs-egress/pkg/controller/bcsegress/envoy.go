@@ -0,0 +1,562 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package bcsegress
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/klog"
+)
+
+const (
+	defaultEnvoyAdmin    = "127.0.0.1:15000"
+	defaultEnvoyHTTPPort = 80
+	//envoy watches these files with filesystem xDS, path must be same with bootstrap
+	envoyListenerFile = "lds.json"
+	envoyClusterFile  = "cds.json"
+	envoyHTTPListener = "http_egress"
+	envoyConnTimeout  = "5s"
+
+	typeListener      = "type.googleapis.com/envoy.config.listener.v3.Listener"
+	typeCluster       = "type.googleapis.com/envoy.config.cluster.v3.Cluster"
+	typeTCPProxy      = "type.googleapis.com/envoy.extensions.filters.network.tcp_proxy.v3.TcpProxy"
+	typeTCPRateLimit  = "type.googleapis.com/envoy.extensions.filters.network.local_ratelimit.v3.LocalRateLimit"
+	typeHTTPManager   = "type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager"
+	typeHTTPRateLimit = "type.googleapis.com/envoy.extensions.filters.http.local_ratelimit.v3.LocalRateLimit"
+	typeHTTPRouter    = "type.googleapis.com/envoy.extensions.filters.http.router.v3.Router"
+	typeUpstreamTLS   = "type.googleapis.com/envoy.extensions.transport_sockets.tls.v3.UpstreamTlsContext"
+
+	statActiveConnections = "upstream_cx_active"
+	statBytesSent         = "upstream_cx_tx_bytes_total"
+	statBytesReceived     = "upstream_cx_rx_bytes_total"
+)
+
+var invalidClusterChars = regexp.MustCompile(`[^a-zA-Z0-9_\-]`)
+
+// envoyResource json object of envoy configuration
+type envoyResource map[string]interface{}
+
+// clusterReference reference from envoy cluster to egress rule
+type clusterReference struct {
+	protocol string
+	key      string
+}
+
+// NewEnvoy create envoy instance as proxy implementation
+func NewEnvoy(option *EgressOption) (Proxy, error) {
+	if len(option.GenerateDir) == 0 {
+		option.GenerateDir = defaultGenerateDirectory
+	}
+	if len(option.EnvoyAdmin) == 0 {
+		option.EnvoyAdmin = defaultEnvoyAdmin
+	}
+	if option.HTTPPort == 0 {
+		option.HTTPPort = defaultEnvoyHTTPPort
+	}
+	if err := os.MkdirAll(option.GenerateDir, os.ModePerm); err != nil {
+		klog.Warningf("mkdir %s failed, err %s", option.GenerateDir, err.Error())
+		return nil, err
+	}
+	envoy := &Envoy{
+		ruleCache: newRuleCache(),
+		option:    option,
+		clusters:  make(map[string]*clusterReference),
+		client:    &http.Client{Timeout: time.Second * 3},
+	}
+	return envoy, nil
+}
+
+// Envoy implementations for proxy interface, all rules are pushed
+// to envoy by filesystem xDS, so no reloading is needed
+type Envoy struct {
+	ruleCache
+	option *EgressOption
+	//clusterLock for clusters
+	clusterLock sync.RWMutex
+	//clusters mapping from envoy cluster name to rule, use for statistics
+	clusters map[string]*clusterReference
+	client   *http.Client
+}
+
+// SupportPolicy envoy supports L7 policy, rate limiting and TLS origination
+func (e *Envoy) SupportPolicy() bool {
+	return true
+}
+
+// Reload generate listeners & clusters for envoy, envoy watches
+// these files and applies them without reloading
+func (e *Envoy) Reload(egress string) error {
+	e.tcpLock.RLock()
+	defer e.tcpLock.RUnlock()
+	e.httpLock.RLock()
+	defer e.httpLock.RUnlock()
+	e.errorLock.Lock()
+	defer e.errorLock.Unlock()
+	listeners, clusters, references := e.dataGeneration()
+	//clusters must be ready before listeners refer to them
+	if err := e.writeResources(envoyClusterFile, typeCluster, clusters); err != nil {
+		klog.Errorf("proxy envoy generate clusters for egress %s failed, %s", egress, err.Error())
+		e.lastError[egress] = err
+		return err
+	}
+	if err := e.writeResources(envoyListenerFile, typeListener, listeners); err != nil {
+		klog.Errorf("proxy envoy generate listeners for egress %s failed, %s", egress, err.Error())
+		e.lastError[egress] = err
+		return err
+	}
+	e.clusterLock.Lock()
+	e.clusters = references
+	e.clusterLock.Unlock()
+	//envoy has switched to the new certificates, drop the ones no rule refers to
+	e.cleanCertificates()
+	//update successfully, clean relative last error
+	e.lastError = make(map[string]error)
+	return nil
+}
+
+// ListRuleStats list statistics of all rules from envoy admin api
+func (e *Envoy) ListRuleStats() (*ProxyStats, error) {
+	address := fmt.Sprintf("http://%s/stats?format=json&filter=%s", e.option.EnvoyAdmin, url.QueryEscape(`^cluster\.`))
+	response, err := e.client.Get(address)
+	if err != nil {
+		klog.Errorf("proxy envoy request stats from %s failed, %s", e.option.EnvoyAdmin, err.Error())
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("envoy admin stats response code %d", response.StatusCode)
+	}
+	stats := &struct {
+		Stats []struct {
+			Name  string `json:"name"`
+			Value uint64 `json:"value"`
+		} `json:"stats"`
+	}{}
+	if err := json.NewDecoder(response.Body).Decode(stats); err != nil {
+		klog.Errorf("proxy envoy decode stats failed, %s", err.Error())
+		return nil, err
+	}
+	result := &ProxyStats{
+		HTTP: make(map[string]*RuleStats),
+		TCP:  make(map[string]*RuleStats),
+	}
+	e.clusterLock.RLock()
+	defer e.clusterLock.RUnlock()
+	for _, stat := range stats.Stats {
+		//stat name format: cluster.{cluster_name}.{stat_name}
+		index := strings.LastIndex(stat.Name, ".")
+		if index <= len("cluster.") {
+			continue
+		}
+		ref, ok := e.clusters[stat.Name[len("cluster."):index]]
+		if !ok {
+			continue
+		}
+		rules := result.TCP
+		if ref.protocol == protocolHTTP {
+			rules = result.HTTP
+		}
+		rule, ok := rules[ref.key]
+		if !ok {
+			rule = &RuleStats{}
+			rules[ref.key] = rule
+		}
+		switch stat.Name[index+1:] {
+		case statActiveConnections:
+			rule.ActiveConnections = stat.Value
+		case statBytesSent:
+			rule.BytesSent = stat.Value
+		case statBytesReceived:
+			rule.BytesReceived = stat.Value
+		}
+	}
+	return result, nil
+}
+
+const (
+	protocolHTTP = "http"
+	protocolTCP  = "tcp"
+)
+
+// dataGeneration convert all cached rules to envoy listeners & clusters
+func (e *Envoy) dataGeneration() ([]envoyResource, []envoyResource, map[string]*clusterReference) {
+	var listeners, clusters []envoyResource
+	references := make(map[string]*clusterReference)
+	tcps := make(TCPList, 0, len(e.tcpKeyConfigs))
+	for _, tcp := range e.tcpKeyConfigs {
+		tcps = append(tcps, tcp)
+	}
+	sort.Sort(tcps)
+	for _, tcp := range tcps {
+		name := clusterName(protocolTCP, tcp.Key())
+		references[name] = &clusterReference{protocol: protocolTCP, key: tcp.Key()}
+		clusters = append(clusters, tcpCluster(name, tcp))
+		listeners = append(listeners, tcpListener(name, tcp))
+	}
+	if len(e.httpConfigs) == 0 {
+		return listeners, clusters, references
+	}
+	https := make(HTTPList, 0, len(e.httpConfigs))
+	for _, config := range e.httpConfigs {
+		https = append(https, config)
+	}
+	sort.Sort(https)
+	var virtualHosts []envoyResource
+	for _, config := range https {
+		name := clusterName(protocolHTTP, config.Key())
+		references[name] = &clusterReference{protocol: protocolHTTP, key: config.Key()}
+		clusters = append(clusters, httpCluster(name, config))
+		virtualHosts = append(virtualHosts, httpVirtualHost(name, config))
+	}
+	listeners = append(listeners, httpListener(e.option.HTTPPort, virtualHosts))
+	return listeners, clusters, references
+}
+
+// writeResources write resources as DiscoveryResponse, file is replaced
+// by renaming because envoy only watches file moving
+func (e *Envoy) writeResources(filename, resourceType string, resources []envoyResource) error {
+	for _, resource := range resources {
+		resource["@type"] = resourceType
+	}
+	if resources == nil {
+		resources = []envoyResource{}
+	}
+	content, err := json.MarshalIndent(map[string]interface{}{
+		"version_info": "0",
+		"type_url":     resourceType,
+		"resources":    resources,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	output := path.Join(e.option.GenerateDir, filename)
+	if old, err := ioutil.ReadFile(output); err == nil && bytes.Equal(old, content) {
+		klog.V(3).Infof("proxy envoy configuration %s nothing changed, skip updating", output)
+		return nil
+	}
+	tmp := output + ".tmp"
+	if err := ioutil.WriteFile(tmp, content, 0644); err != nil {
+		klog.Errorf("proxy envoy write temporary configuration %s failed, %s", tmp, err.Error())
+		return err
+	}
+	if err := os.Rename(tmp, output); err != nil {
+		klog.Errorf("proxy envoy replace configuration %s failed, %s", output, err.Error())
+		return err
+	}
+	klog.V(3).Infof("proxy envoy update configuration %s successfully", output)
+	return nil
+}
+
+// cleanCertificates remove certificate directories that are not referred by any cached rule,
+// a new directory is generated every time the secret rotates, so old ones would pile up.
+// reconciling is serial, so no directory is generated for rules that are not cached yet
+func (e *Envoy) cleanCertificates() {
+	inUse := make(map[string]bool)
+	for _, tcp := range e.tcpKeyConfigs {
+		if tcp.TLS != nil {
+			inUse[path.Dir(tcp.TLS.CertFile)] = true
+		}
+	}
+	for _, config := range e.httpConfigs {
+		if config.TLS != nil {
+			inUse[path.Dir(config.TLS.CertFile)] = true
+		}
+	}
+	root := path.Join(e.option.GenerateDir, certDirectory)
+	entries, err := ioutil.ReadDir(root)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			klog.Warningf("proxy envoy read certificate directory %s failed, %s", root, err.Error())
+		}
+		return
+	}
+	for _, entry := range entries {
+		dir := path.Join(root, entry.Name())
+		if !entry.IsDir() || inUse[dir] {
+			continue
+		}
+		if err := os.RemoveAll(dir); err != nil {
+			klog.Warningf("proxy envoy remove stale certificate directory %s failed, %s", dir, err.Error())
+			continue
+		}
+		klog.V(3).Infof("proxy envoy remove stale certificate directory %s", dir)
+	}
+}
+
+// clusterName envoy stats use dot as separator, so cluster name can not contain dot
+func clusterName(protocol, key string) string {
+	return protocol + "_" + invalidClusterChars.ReplaceAllString(key, "_")
+}
+
+func socketAddress(address string, port uint) envoyResource {
+	return envoyResource{
+		"socket_address": envoyResource{
+			"address":    address,
+			"port_value": port,
+		},
+	}
+}
+
+func tokenBucket(limit *RateLimitConfig) envoyResource {
+	burst := limit.Burst
+	if burst == 0 {
+		burst = limit.Rate
+	}
+	return envoyResource{
+		"max_tokens":      burst,
+		"tokens_per_fill": limit.Rate,
+		"fill_interval":   "1s",
+	}
+}
+
+func cluster(name string, endpoints []envoyResource, logicalDNS bool, lbPolicy string, tls *TLSConfig, sni string) envoyResource {
+	c := envoyResource{
+		"name":            name,
+		"connect_timeout": envoyConnTimeout,
+		"type":            "STATIC",
+		"lb_policy":       lbPolicy,
+		"load_assignment": envoyResource{
+			"cluster_name": name,
+			"endpoints": []envoyResource{
+				{"lb_endpoints": endpoints},
+			},
+		},
+	}
+	if logicalDNS {
+		c["type"] = "LOGICAL_DNS"
+		c["dns_lookup_family"] = "V4_ONLY"
+	}
+	if tls != nil {
+		if len(tls.SNI) != 0 {
+			sni = tls.SNI
+		}
+		common := envoyResource{
+			"tls_certificates": []envoyResource{
+				{
+					"certificate_chain": envoyResource{"filename": tls.CertFile},
+					"private_key":       envoyResource{"filename": tls.KeyFile},
+				},
+			},
+		}
+		common["validation_context"] = validationContext(tls.CAFile, sni)
+		c["transport_socket"] = envoyResource{
+			"name": "envoy.transport_sockets.tls",
+			"typed_config": envoyResource{
+				"@type":              typeUpstreamTLS,
+				"sni":                sni,
+				"common_tls_context": common,
+			},
+		}
+	}
+	return c
+}
+
+// validationContext validates destination certificate with ca, and the subject
+// alt names of certificate must match the sni
+func validationContext(caFile, sni string) envoyResource {
+	validation := envoyResource{
+		"trusted_ca": envoyResource{"filename": caFile},
+	}
+	if len(sni) != 0 {
+		sanType := "DNS"
+		if net.ParseIP(sni) != nil {
+			sanType = "IP_ADDRESS"
+		}
+		validation["match_typed_subject_alt_names"] = []envoyResource{
+			{
+				"san_type": sanType,
+				"matcher":  envoyResource{"exact": sni},
+			},
+		}
+	}
+	return validation
+}
+
+func endpoint(address string, port uint) envoyResource {
+	return envoyResource{
+		"endpoint": envoyResource{
+			"address": socketAddress(address, port),
+		},
+	}
+}
+
+func tcpCluster(name string, config *TCPConfig) envoyResource {
+	if !config.HasBackend {
+		endpoints := []envoyResource{endpoint(config.Domain, config.DestinationPort)}
+		return cluster(name, endpoints, true, "ROUND_ROBIN", config.TLS, config.Domain)
+	}
+	var endpoints []envoyResource
+	for _, ip := range config.IPs {
+		endpoints = append(endpoints, endpoint(ip, config.DestinationPort))
+	}
+	lbPolicy := "ROUND_ROBIN"
+	switch config.Algorithm {
+	case "least_conn":
+		lbPolicy = "LEAST_REQUEST"
+	case "hash":
+		lbPolicy = "RING_HASH"
+	}
+	return cluster(name, endpoints, false, lbPolicy, config.TLS, config.Domain)
+}
+
+func tcpListener(name string, config *TCPConfig) envoyResource {
+	proxy := envoyResource{
+		"@type":       typeTCPProxy,
+		"stat_prefix": name,
+		"cluster":     name,
+	}
+	if config.HasBackend && config.Algorithm == "hash" {
+		proxy["hash_policy"] = []envoyResource{{"source_ip": envoyResource{}}}
+	}
+	var filters []envoyResource
+	if config.RateLimit != nil {
+		filters = append(filters, envoyResource{
+			"name": "envoy.filters.network.local_ratelimit",
+			"typed_config": envoyResource{
+				"@type":        typeTCPRateLimit,
+				"stat_prefix":  name,
+				"token_bucket": tokenBucket(config.RateLimit),
+			},
+		})
+	}
+	filters = append(filters, envoyResource{
+		"name":         "envoy.filters.network.tcp_proxy",
+		"typed_config": proxy,
+	})
+	return envoyResource{
+		"name":          name,
+		"address":       socketAddress("0.0.0.0", config.ProxyPort),
+		"filter_chains": []envoyResource{{"filters": filters}},
+	}
+}
+
+func httpCluster(name string, config *HTTPConfig) envoyResource {
+	endpoints := []envoyResource{endpoint(config.Domain, config.DestinationPort)}
+	return cluster(name, endpoints, true, "ROUND_ROBIN", config.TLS, config.Domain)
+}
+
+// httpVirtualHost one virtual host for every http rule, requests that
+// don't match allowed methods & paths are denied with 403
+func httpVirtualHost(name string, config *HTTPConfig) envoyResource {
+	domains := []string{config.Key()}
+	if config.DestinationPort == 80 {
+		domains = append(domains, fmt.Sprintf("%s:%d", config.Domain, config.DestinationPort))
+	}
+	paths := config.Paths
+	if len(paths) == 0 {
+		paths = []string{"/"}
+	}
+	var routes []envoyResource
+	for _, prefix := range paths {
+		match := envoyResource{"prefix": prefix}
+		if len(config.Methods) != 0 {
+			match["headers"] = []envoyResource{
+				{
+					"name": ":method",
+					"string_match": envoyResource{
+						"safe_regex": envoyResource{
+							"regex": fmt.Sprintf("^(%s)$", strings.Join(config.Methods, "|")),
+						},
+					},
+				},
+			}
+		}
+		routes = append(routes, envoyResource{
+			"match": match,
+			"route": envoyResource{"cluster": name},
+		})
+	}
+	if len(config.Methods) != 0 || len(config.Paths) != 0 {
+		routes = append(routes, envoyResource{
+			"match":           envoyResource{"prefix": "/"},
+			"direct_response": envoyResource{"status": http.StatusForbidden},
+		})
+	}
+	vhost := envoyResource{
+		"name":    name,
+		"domains": domains,
+		"routes":  routes,
+	}
+	if config.RateLimit != nil {
+		enabled := envoyResource{
+			"runtime_key": name + "_ratelimit",
+			"default_value": envoyResource{
+				"numerator":   100,
+				"denominator": "HUNDRED",
+			},
+		}
+		vhost["typed_per_filter_config"] = envoyResource{
+			"envoy.filters.http.local_ratelimit": envoyResource{
+				"@type":           typeHTTPRateLimit,
+				"stat_prefix":     name,
+				"token_bucket":    tokenBucket(config.RateLimit),
+				"filter_enabled":  enabled,
+				"filter_enforced": enabled,
+			},
+		}
+	}
+	return vhost
+}
+
+// httpListener all http rules share one listener, rules are distinguished by host
+func httpListener(port uint, virtualHosts []envoyResource) envoyResource {
+	manager := envoyResource{
+		"@type":       typeHTTPManager,
+		"stat_prefix": envoyHTTPListener,
+		"route_config": envoyResource{
+			"name":          envoyHTTPListener,
+			"virtual_hosts": virtualHosts,
+		},
+		"http_filters": []envoyResource{
+			{
+				"name": "envoy.filters.http.local_ratelimit",
+				"typed_config": envoyResource{
+					"@type":       typeHTTPRateLimit,
+					"stat_prefix": envoyHTTPListener,
+				},
+			},
+			{
+				"name":         "envoy.filters.http.router",
+				"typed_config": envoyResource{"@type": typeHTTPRouter},
+			},
+		},
+	}
+	return envoyResource{
+		"name":    envoyHTTPListener,
+		"address": socketAddress("0.0.0.0", port),
+		"filter_chains": []envoyResource{
+			{
+				"filters": []envoyResource{
+					{
+						"name":         "envoy.filters.network.http_connection_manager",
+						"typed_config": manager,
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,220 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package bcsegress
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func newTestEnvoy(t *testing.T, admin string) *Envoy {
+	proxy, err := NewEnvoy(&EgressOption{GenerateDir: t.TempDir(), EnvoyAdmin: admin, HTTPPort: 8080})
+	if err != nil {
+		t.Fatalf("create envoy failed, %s", err.Error())
+	}
+	return proxy.(*Envoy)
+}
+
+// readResources read resources of envoy DiscoveryResponse file
+func readResources(t *testing.T, e *Envoy, filename string) []map[string]interface{} {
+	content, err := ioutil.ReadFile(path.Join(e.option.GenerateDir, filename))
+	if err != nil {
+		t.Fatalf("read %s failed, %s", filename, err.Error())
+	}
+	response := &struct {
+		Resources []map[string]interface{} `json:"resources"`
+	}{}
+	if err := json.Unmarshal(content, response); err != nil {
+		t.Fatalf("decode %s failed, %s", filename, err.Error())
+	}
+	return response.Resources
+}
+
+// lookup get value from json object by keys, number keys are used as slice index
+func lookup(obj interface{}, keys ...interface{}) interface{} {
+	for _, key := range keys {
+		switch k := key.(type) {
+		case string:
+			m, ok := obj.(map[string]interface{})
+			if !ok {
+				return nil
+			}
+			obj = m[k]
+		case int:
+			s, ok := obj.([]interface{})
+			if !ok || k >= len(s) {
+				return nil
+			}
+			obj = s[k]
+		}
+	}
+	return obj
+}
+
+func TestEnvoyDataGeneration(t *testing.T) {
+	e := newTestEnvoy(t, "")
+	tcp := &TCPConfig{
+		Name:            "mysql",
+		ProxyPort:       3306,
+		HasBackend:      true,
+		IPs:             []string{"127.0.0.1", "127.0.0.2"},
+		Algorithm:       "hash",
+		DestinationPort: 3306,
+		RateLimit:       &RateLimitConfig{Rate: 10},
+	}
+	e.UpdateTCPRule(tcp)
+	httpConfig := SimpleHTTPConfig("api", "api.example.com", 443)
+	httpConfig.Methods = []string{"GET"}
+	httpConfig.Paths = []string{"/v1"}
+	httpConfig.TLS = &TLSConfig{
+		CertFile: "/certs/api/tls.crt",
+		KeyFile:  "/certs/api/tls.key",
+		CAFile:   defaultSystemCAFile,
+	}
+	e.UpdateHTTPRule(httpConfig)
+	if err := e.Reload("test/egress"); err != nil {
+		t.Fatalf("reload envoy failed, %s", err.Error())
+	}
+
+	clusters := readResources(t, e, envoyClusterFile)
+	if len(clusters) != 2 {
+		t.Fatalf("expect 2 clusters, but get %d", len(clusters))
+	}
+	tcpCluster, httpCluster := clusters[0], clusters[1]
+	if tcpCluster["name"] != "tcp_mysql_3306" || tcpCluster["type"] != "STATIC" ||
+		tcpCluster["lb_policy"] != "RING_HASH" {
+		t.Errorf("unexpected tcp cluster %+v", tcpCluster)
+	}
+	if endpoints := lookup(tcpCluster, "load_assignment", "endpoints", 0, "lb_endpoints").([]interface{}); len(endpoints) != 2 {
+		t.Errorf("expect 2 tcp endpoints, but get %d", len(endpoints))
+	}
+	if httpCluster["name"] != "http_api_example_com_443" || httpCluster["type"] != "LOGICAL_DNS" {
+		t.Errorf("unexpected http cluster %+v", httpCluster)
+	}
+	if sni := lookup(httpCluster, "transport_socket", "typed_config", "sni"); sni != "api.example.com" {
+		t.Errorf("expect http cluster sni api.example.com, but get %v", sni)
+	}
+	validation := lookup(httpCluster, "transport_socket", "typed_config", "common_tls_context", "validation_context")
+	if ca := lookup(validation, "trusted_ca", "filename"); ca != defaultSystemCAFile {
+		t.Errorf("expect http cluster trusted ca %s, but get %v", defaultSystemCAFile, ca)
+	}
+	if san := lookup(validation, "match_typed_subject_alt_names", 0); !reflect.DeepEqual(san, map[string]interface{}{
+		"san_type": "DNS",
+		"matcher":  map[string]interface{}{"exact": "api.example.com"},
+	}) {
+		t.Errorf("expect http cluster subject alt name matches sni, but get %v", san)
+	}
+
+	listeners := readResources(t, e, envoyListenerFile)
+	if len(listeners) != 2 {
+		t.Fatalf("expect 2 listeners, but get %d", len(listeners))
+	}
+	if port := lookup(listeners[0], "address", "socket_address", "port_value"); port != float64(3306) {
+		t.Errorf("expect tcp listener port 3306, but get %v", port)
+	}
+	tcpFilters := lookup(listeners[0], "filter_chains", 0, "filters").([]interface{})
+	if len(tcpFilters) != 2 || lookup(tcpFilters[0], "name") != "envoy.filters.network.local_ratelimit" {
+		t.Errorf("expect tcp rate limit filter before tcp proxy, but get %+v", tcpFilters)
+	}
+	if port := lookup(listeners[1], "address", "socket_address", "port_value"); port != float64(8080) {
+		t.Errorf("expect http listener port 8080, but get %v", port)
+	}
+	vhost := lookup(listeners[1], "filter_chains", 0, "filters", 0, "typed_config", "route_config", "virtual_hosts", 0)
+	if domains := lookup(vhost, "domains"); !reflect.DeepEqual(domains, []interface{}{"api.example.com:443"}) {
+		t.Errorf("unexpected virtual host domains %v", domains)
+	}
+	routes := lookup(vhost, "routes").([]interface{})
+	if len(routes) != 2 {
+		t.Fatalf("expect allowed route and denied route, but get %+v", routes)
+	}
+	if prefix := lookup(routes[0], "match", "prefix"); prefix != "/v1" {
+		t.Errorf("expect allowed prefix /v1, but get %v", prefix)
+	}
+	if regex := lookup(routes[0], "match", "headers", 0, "string_match", "safe_regex", "regex"); regex != "^(GET)$" {
+		t.Errorf("expect method regex ^(GET)$, but get %v", regex)
+	}
+	if status := lookup(routes[1], "direct_response", "status"); status != float64(http.StatusForbidden) {
+		t.Errorf("expect denied route with 403, but get %v", status)
+	}
+}
+
+func TestEnvoyListRuleStats(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Query().Get("filter"), `^cluster\.`) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		fmt.Fprint(w, `{"stats":[
+			{"name":"cluster.tcp_mysql_3306.upstream_cx_active","value":3},
+			{"name":"cluster.tcp_mysql_3306.upstream_cx_tx_bytes_total","value":100},
+			{"name":"cluster.tcp_mysql_3306.upstream_cx_rx_bytes_total","value":200},
+			{"name":"cluster.http_api_example_com.upstream_cx_active","value":1},
+			{"name":"cluster.unknown.upstream_cx_active","value":9},
+			{"name":"cluster.upstream_cx_active","value":9}
+		]}`)
+	}))
+	defer server.Close()
+
+	e := newTestEnvoy(t, strings.TrimPrefix(server.URL, "http://"))
+	e.UpdateTCPRule(&TCPConfig{Name: "mysql", ProxyPort: 3306, Domain: "mysql.example.com", DestinationPort: 3306})
+	e.UpdateHTTPRule(SimpleHTTPConfig("api", "api.example.com", 80))
+	if err := e.Reload("test/egress"); err != nil {
+		t.Fatalf("reload envoy failed, %s", err.Error())
+	}
+
+	stats, err := e.ListRuleStats()
+	if err != nil {
+		t.Fatalf("list rule stats failed, %s", err.Error())
+	}
+	expected := &ProxyStats{
+		HTTP: map[string]*RuleStats{"api.example.com": {ActiveConnections: 1}},
+		TCP:  map[string]*RuleStats{"mysql_3306": {ActiveConnections: 3, BytesSent: 100, BytesReceived: 200}},
+	}
+	if !reflect.DeepEqual(stats, expected) {
+		statsData, _ := json.Marshal(stats)
+		t.Errorf("unexpected rule stats %s", string(statsData))
+	}
+}
+
+func TestEnvoyCleanCertificates(t *testing.T) {
+	e := newTestEnvoy(t, "")
+	root := path.Join(e.option.GenerateDir, certDirectory)
+	current := path.Join(root, "ns_egress_api_00000002")
+	stale := path.Join(root, "ns_egress_api_00000001")
+	for _, dir := range []string{current, stale} {
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			t.Fatalf("create certificate directory failed, %s", err.Error())
+		}
+	}
+	config := SimpleHTTPConfig("api", "api.example.com", 443)
+	config.TLS = &TLSConfig{CertFile: path.Join(current, "tls.crt"), KeyFile: path.Join(current, "tls.key")}
+	e.UpdateHTTPRule(config)
+	if err := e.Reload("ns/egress"); err != nil {
+		t.Fatalf("reload envoy failed, %s", err.Error())
+	}
+	if _, err := os.Stat(current); err != nil {
+		t.Errorf("certificate directory in use is removed, %s", err.Error())
+	}
+	if _, err := os.Stat(stale); !os.IsNotExist(err) {
+		t.Errorf("expect stale certificate directory removed, but get %v", err)
+	}
+}
@@ -29,6 +29,15 @@ func init() {
 	viper.BindEnv("tamplate")
 	pflag.String("generate_dir", "./generate/", "directory for configuration generating")
 	viper.BindEnv("generate_dir")
+	pflag.String("proxy", ProxyTypeNginx, "proxy implementation for network flow control, nginx or envoy")
+	viper.BindEnv("proxy")
+	pflag.String("envoy_admin", defaultEnvoyAdmin, "envoy admin address for rule statistics, only work for envoy proxy")
+	viper.BindEnv("envoy_admin")
+	pflag.Uint("http_port", defaultEnvoyHTTPPort, "http proxy listen port, only work for envoy proxy")
+	viper.BindEnv("http_port")
+	pflag.String("system_ca_file", defaultSystemCAFile, "system ca bundle for destination validation of tls origination "+
+		"when tls secret contains no ca.crt")
+	viper.BindEnv("system_ca_file")
 	viper.BindPFlags(pflag.CommandLine)
 }
 
@@ -39,10 +48,23 @@ func NewOptionFromFlagAndEnv() *EgressOption {
 		Name:         viper.GetString("name"),
 		TemplateFile: viper.GetString("template"),
 		GenerateDir:  viper.GetString("generate_dir"),
+		ProxyType:    viper.GetString("proxy"),
+		EnvoyAdmin:   viper.GetString("envoy_admin"),
+		HTTPPort:     viper.GetUint("http_port"),
+		SystemCAFile: viper.GetString("system_ca_file"),
 	}
 	return egress
 }
 
+const (
+	//ProxyTypeNginx nginx proxy, rules take effect by reloading
+	ProxyTypeNginx = "nginx"
+	//ProxyTypeEnvoy envoy proxy, rules take effect by filesystem xDS without reloading
+	ProxyTypeEnvoy = "envoy"
+
+	defaultSystemCAFile = "/etc/ssl/certs/ca-certificates.crt"
+)
+
 //EgressOption all options that required for BCSEgressController
 type EgressOption struct {
 	Namespace       string
@@ -51,4 +73,12 @@ type EgressOption struct {
 	GenerateDir     string
 	ProxyExecutable string
 	ProxyConfig     string
+	//ProxyType nginx or envoy
+	ProxyType string
+	//EnvoyAdmin admin address of envoy
+	EnvoyAdmin string
+	//HTTPPort listen port for http rules
+	HTTPPort uint
+	//SystemCAFile ca bundle for destination validation when tls secret has no ca.crt
+	SystemCAFile string
 }
@@ -57,66 +57,40 @@ type Proxy interface {
 	Reload(egress string) error
 	// LastError get last reload error information according to egress rule
 	LastError(egress string) error
-}
 
-//generator data for template creation
-type generator struct {
-	TCPServer TCPList
+	// SupportPolicy return true if proxy supports L7 policy, rate limiting and TLS origination
+	SupportPolicy() bool
+	// ListRuleStats list network flow statistics of all rules, return nil if proxy doesn't support
+	ListRuleStats() (*ProxyStats, error)
 }
 
-//NewNginx create nginx instance as proxy implementation
-func NewNginx(option *EgressOption) (Proxy, error) {
-	if len(option.ProxyExecutable) == 0 {
-		option.ProxyExecutable = nginxExecutable
-	}
-	if len(option.ProxyConfig) == 0 {
-		option.ProxyConfig = nginxConfig
-	}
-	if len(option.GenerateDir) == 0 {
-		option.GenerateDir = defaultGenerateDirectory
-	}
-	if len(option.TemplateFile) == 0 {
-		option.TemplateFile = defaultTemplateFile
-	}
-	ngx := &Nginx{
-		option:         option,
-		tcpKeyConfigs:  make(map[string]*TCPConfig),
-		tcpPortConfigs: make(map[uint]*TCPConfig),
-		httpConfigs:    make(map[string]*HTTPConfig),
-		lastError:      make(map[string]error),
-	}
-	//ensure workspace directory existence
-	exist, err := fileExists(option.ProxyExecutable)
-	if err != nil || !exist {
-		klog.Errorf("Nginx proxy Executable %s is Lost", option.ProxyExecutable)
-		return nil, fmt.Errorf("proxy %s lost", option.ProxyExecutable)
-	}
-	exist, err = fileExists(option.ProxyConfig)
-	if err != nil || !exist {
-		klog.Errorf("Nginx proxy init config %s is Lost", option.ProxyExecutable)
-		return nil, fmt.Errorf("proxy config %s lost", option.ProxyConfig)
-	}
-	exist, err = fileExists(option.TemplateFile)
-	if err != nil || !exist {
-		klog.Errorf("Nginx proxy config template file %s is Lost", option.TemplateFile)
-		return nil, fmt.Errorf("proxy config template file %s lost", option.TemplateFile)
-	}
-	err = os.MkdirAll(option.GenerateDir, os.ModePerm)
-	if err != nil {
-		klog.Warningf("mkdir %s failed, err %s", option.GenerateDir, err.Error())
-		return nil, err
+//NewProxy create proxy implementation according to option
+func NewProxy(option *EgressOption) (Proxy, error) {
+	switch option.ProxyType {
+	case ProxyTypeEnvoy:
+		return NewEnvoy(option)
+	case ProxyTypeNginx, "":
+		return NewNginx(option)
+	default:
+		return nil, fmt.Errorf("unknown proxy type %s", option.ProxyType)
 	}
-	return ngx, nil
 }
 
-const (
-	nginxExecutable = "/usr/local/nginx/sbin/nginx"
-	nginxConfig     = "/usr/local/nginx/conf/nginx.conf"
-)
+//RuleStats network flow statistics of single rule
+type RuleStats struct {
+	ActiveConnections uint64
+	BytesSent         uint64
+	BytesReceived     uint64
+}
 
-//Nginx implementations for proxy interface
-type Nginx struct {
-	option *EgressOption
+//ProxyStats statistics of all rules, key is rule key
+type ProxyStats struct {
+	HTTP map[string]*RuleStats
+	TCP  map[string]*RuleStats
+}
+
+//ruleCache local cache for all proxy rules, shared by all proxy implementations
+type ruleCache struct {
 	//tcpLock for follow cachedata
 	tcpLock sync.RWMutex
 	//Key is indexer
@@ -132,11 +106,20 @@ type Nginx struct {
 	lastError map[string]error
 }
 
+func newRuleCache() ruleCache {
+	return ruleCache{
+		tcpKeyConfigs:  make(map[string]*TCPConfig),
+		tcpPortConfigs: make(map[uint]*TCPConfig),
+		httpConfigs:    make(map[string]*HTTPConfig),
+		lastError:      make(map[string]error),
+	}
+}
+
 //GetHTTPRule get specified http rule implementation
-func (ngx *Nginx) GetHTTPRule(key string) (*HTTPConfig, error) {
-	ngx.httpLock.RLock()
-	defer ngx.httpLock.Unlock()
-	config, ok := ngx.httpConfigs[key]
+func (c *ruleCache) GetHTTPRule(key string) (*HTTPConfig, error) {
+	c.httpLock.RLock()
+	defer c.httpLock.RUnlock()
+	config, ok := c.httpConfigs[key]
 	if ok {
 		return config, nil
 	}
@@ -144,26 +127,28 @@ func (ngx *Nginx) GetHTTPRule(key string) (*HTTPConfig, error) {
 }
 
 //ListHTTPRules list all http rules implementation
-func (ngx *Nginx) ListHTTPRules() ([]*HTTPConfig, error) {
-	if len(ngx.httpConfigs) == 0 {
+func (c *ruleCache) ListHTTPRules() ([]*HTTPConfig, error) {
+	c.httpLock.RLock()
+	defer c.httpLock.RUnlock()
+	if len(c.httpConfigs) == 0 {
 		return nil, nil
 	}
 	var l []*HTTPConfig
-	for _, config := range ngx.httpConfigs {
+	for _, config := range c.httpConfigs {
 		l = append(l, config)
 	}
 	return l, nil
 }
 
 //ListHTTPRulesByLabel http operation implementation
-func (ngx *Nginx) ListHTTPRulesByLabel(labels map[string]string) ([]*HTTPConfig, error) {
-	ngx.httpLock.RLock()
-	defer ngx.httpLock.Unlock()
-	if len(ngx.httpConfigs) == 0 {
+func (c *ruleCache) ListHTTPRulesByLabel(labels map[string]string) ([]*HTTPConfig, error) {
+	c.httpLock.RLock()
+	defer c.httpLock.RUnlock()
+	if len(c.httpConfigs) == 0 {
 		return nil, nil
 	}
 	var l []*HTTPConfig
-	for _, config := range ngx.httpConfigs {
+	for _, config := range c.httpConfigs {
 		if config.LabelFilter(labels) {
 			l = append(l, config)
 		}
@@ -172,26 +157,26 @@ func (ngx *Nginx) ListHTTPRulesByLabel(labels map[string]string) ([]*HTTPConfig,
 }
 
 //DeleteHTTPRule delete specified http rule implementation
-func (ngx *Nginx) DeleteHTTPRule(key string) error {
-	ngx.httpLock.Lock()
-	defer ngx.httpLock.Unlock()
-	delete(ngx.httpConfigs, key)
+func (c *ruleCache) DeleteHTTPRule(key string) error {
+	c.httpLock.Lock()
+	defer c.httpLock.Unlock()
+	delete(c.httpConfigs, key)
 	return nil
 }
 
 //UpdateHTTPRule update specified http rule implementation
-func (ngx *Nginx) UpdateHTTPRule(cfg *HTTPConfig) error {
-	ngx.httpLock.Lock()
-	defer ngx.httpLock.Unlock()
-	ngx.httpConfigs[cfg.Key()] = cfg
+func (c *ruleCache) UpdateHTTPRule(cfg *HTTPConfig) error {
+	c.httpLock.Lock()
+	defer c.httpLock.Unlock()
+	c.httpConfigs[cfg.Key()] = cfg
 	return nil
 }
 
 //GetTCPRule tcp operation implementation
-func (ngx *Nginx) GetTCPRule(key string) (*TCPConfig, error) {
-	ngx.tcpLock.RLock()
-	defer ngx.tcpLock.Unlock()
-	config, ok := ngx.tcpKeyConfigs[key]
+func (c *ruleCache) GetTCPRule(key string) (*TCPConfig, error) {
+	c.tcpLock.RLock()
+	defer c.tcpLock.RUnlock()
+	config, ok := c.tcpKeyConfigs[key]
 	if ok {
 		return config, nil
 	}
@@ -199,10 +184,10 @@ func (ngx *Nginx) GetTCPRule(key string) (*TCPConfig, error) {
 }
 
 //GetTCPRuleByPort tcp operation implementation
-func (ngx *Nginx) GetTCPRuleByPort(port uint) (*TCPConfig, error) {
-	ngx.tcpLock.RLock()
-	defer ngx.tcpLock.Unlock()
-	config, ok := ngx.tcpPortConfigs[port]
+func (c *ruleCache) GetTCPRuleByPort(port uint) (*TCPConfig, error) {
+	c.tcpLock.RLock()
+	defer c.tcpLock.RUnlock()
+	config, ok := c.tcpPortConfigs[port]
 	if ok {
 		return config, nil
 	}
@@ -210,28 +195,28 @@ func (ngx *Nginx) GetTCPRuleByPort(port uint) (*TCPConfig, error) {
 }
 
 //ListTCPRules tcp operation implementation
-func (ngx *Nginx) ListTCPRules() ([]*TCPConfig, error) {
-	ngx.tcpLock.RLock()
-	defer ngx.tcpLock.Unlock()
-	if len(ngx.tcpPortConfigs) != len(ngx.tcpKeyConfigs) {
-		return nil, fmt.Errorf("nginx proxy tcp configuration is inconsistent")
+func (c *ruleCache) ListTCPRules() ([]*TCPConfig, error) {
+	c.tcpLock.RLock()
+	defer c.tcpLock.RUnlock()
+	if len(c.tcpPortConfigs) != len(c.tcpKeyConfigs) {
+		return nil, fmt.Errorf("proxy tcp configuration is inconsistent")
 	}
 	var l []*TCPConfig
-	for _, config := range ngx.tcpPortConfigs {
+	for _, config := range c.tcpPortConfigs {
 		l = append(l, config)
 	}
 	return l, nil
 }
 
 //ListTCPRulesByLabel tcp operation implementation
-func (ngx *Nginx) ListTCPRulesByLabel(labels map[string]string) ([]*TCPConfig, error) {
-	ngx.tcpLock.RLock()
-	defer ngx.tcpLock.Unlock()
-	if len(ngx.tcpPortConfigs) != len(ngx.tcpKeyConfigs) {
-		return nil, fmt.Errorf("nginx proxy tcp configuration is inconsistent")
+func (c *ruleCache) ListTCPRulesByLabel(labels map[string]string) ([]*TCPConfig, error) {
+	c.tcpLock.RLock()
+	defer c.tcpLock.RUnlock()
+	if len(c.tcpPortConfigs) != len(c.tcpKeyConfigs) {
+		return nil, fmt.Errorf("proxy tcp configuration is inconsistent")
 	}
 	var l []*TCPConfig
-	for _, config := range ngx.tcpPortConfigs {
+	for _, config := range c.tcpPortConfigs {
 		if config.LabelFilter(labels) {
 			l = append(l, config)
 		}
@@ -240,39 +225,118 @@ func (ngx *Nginx) ListTCPRulesByLabel(labels map[string]string) ([]*TCPConfig, e
 }
 
 //DeleteTCPRule tcp operation implementation
-func (ngx *Nginx) DeleteTCPRule(key string) error {
-	ngx.tcpLock.Lock()
-	defer ngx.tcpLock.Unlock()
-	config, ok := ngx.tcpKeyConfigs[key]
+func (c *ruleCache) DeleteTCPRule(key string) error {
+	c.tcpLock.Lock()
+	defer c.tcpLock.Unlock()
+	config, ok := c.tcpKeyConfigs[key]
 	if !ok {
 		return nil
 	}
-	delete(ngx.tcpKeyConfigs, key)
-	_, pok := ngx.tcpPortConfigs[config.ProxyPort]
+	delete(c.tcpKeyConfigs, key)
+	_, pok := c.tcpPortConfigs[config.ProxyPort]
 	if !pok {
-		klog.Warningf("nginx proxy tcp port [%d] data is inconsistent with key data %s", config.ProxyPort, key)
+		klog.Warningf("proxy tcp port [%d] data is inconsistent with key data %s", config.ProxyPort, key)
 		return nil
 	}
-	delete(ngx.tcpPortConfigs, config.ProxyPort)
+	delete(c.tcpPortConfigs, config.ProxyPort)
 	return nil
 }
 
 //UpdateTCPRule tcp operation implementation
-func (ngx *Nginx) UpdateTCPRule(cfg *TCPConfig) error {
-	ngx.tcpLock.Lock()
-	defer ngx.tcpLock.Unlock()
+func (c *ruleCache) UpdateTCPRule(cfg *TCPConfig) error {
+	c.tcpLock.Lock()
+	defer c.tcpLock.Unlock()
 	//upate port reference
-	ngx.tcpKeyConfigs[cfg.Key()] = cfg
-	ngx.tcpPortConfigs[cfg.ProxyPort] = cfg
+	c.tcpKeyConfigs[cfg.Key()] = cfg
+	c.tcpPortConfigs[cfg.ProxyPort] = cfg
 	return nil
 }
 
+// LastError get last reload error information according to egress rule
+func (c *ruleCache) LastError(egress string) error {
+	c.errorLock.RLock()
+	defer c.errorLock.RUnlock()
+	err, ok := c.lastError[egress]
+	if ok {
+		return err
+	}
+	return nil
+}
+
+//generator data for template creation
+type generator struct {
+	TCPServer TCPList
+}
+
+//NewNginx create nginx instance as proxy implementation
+func NewNginx(option *EgressOption) (Proxy, error) {
+	if len(option.ProxyExecutable) == 0 {
+		option.ProxyExecutable = nginxExecutable
+	}
+	if len(option.ProxyConfig) == 0 {
+		option.ProxyConfig = nginxConfig
+	}
+	if len(option.GenerateDir) == 0 {
+		option.GenerateDir = defaultGenerateDirectory
+	}
+	if len(option.TemplateFile) == 0 {
+		option.TemplateFile = defaultTemplateFile
+	}
+	ngx := &Nginx{
+		ruleCache: newRuleCache(),
+		option:    option,
+	}
+	//ensure workspace directory existence
+	exist, err := fileExists(option.ProxyExecutable)
+	if err != nil || !exist {
+		klog.Errorf("Nginx proxy Executable %s is Lost", option.ProxyExecutable)
+		return nil, fmt.Errorf("proxy %s lost", option.ProxyExecutable)
+	}
+	exist, err = fileExists(option.ProxyConfig)
+	if err != nil || !exist {
+		klog.Errorf("Nginx proxy init config %s is Lost", option.ProxyExecutable)
+		return nil, fmt.Errorf("proxy config %s lost", option.ProxyConfig)
+	}
+	exist, err = fileExists(option.TemplateFile)
+	if err != nil || !exist {
+		klog.Errorf("Nginx proxy config template file %s is Lost", option.TemplateFile)
+		return nil, fmt.Errorf("proxy config template file %s lost", option.TemplateFile)
+	}
+	err = os.MkdirAll(option.GenerateDir, os.ModePerm)
+	if err != nil {
+		klog.Warningf("mkdir %s failed, err %s", option.GenerateDir, err.Error())
+		return nil, err
+	}
+	return ngx, nil
+}
+
+const (
+	nginxExecutable = "/usr/local/nginx/sbin/nginx"
+	nginxConfig     = "/usr/local/nginx/conf/nginx.conf"
+)
+
+//Nginx implementations for proxy interface
+type Nginx struct {
+	ruleCache
+	option *EgressOption
+}
+
+//SupportPolicy nginx proxy only supports basic forwarding
+func (ngx *Nginx) SupportPolicy() bool {
+	return false
+}
+
+//ListRuleStats nginx proxy doesn't support rule statistics
+func (ngx *Nginx) ListRuleStats() (*ProxyStats, error) {
+	return nil, nil
+}
+
 //Reload reload proxy for new configuration
 func (ngx *Nginx) Reload(egress string) error {
 	ngx.tcpLock.Lock()
 	defer ngx.tcpLock.Unlock()
 	ngx.httpLock.RLock()
-	defer ngx.httpLock.Unlock()
+	defer ngx.httpLock.RUnlock()
 	ngx.errorLock.Lock()
 	defer ngx.errorLock.Unlock()
 	//ready to generate nginx configuration from template
@@ -421,17 +485,6 @@ func (ngx *Nginx) reloadNginx(config string) error {
 	return nil
 }
 
-// LastError get last reload error information according to egress rule
-func (ngx *Nginx) LastError(egress string) error {
-	ngx.errorLock.RLock()
-	defer ngx.errorLock.Unlock()
-	err, ok := ngx.lastError[egress]
-	if ok {
-		return err
-	}
-	return nil
-}
-
 //FileExists check file exists
 func fileExists(filename string) (bool, error) {
 	if _, err := os.Stat(filename); os.IsNotExist(err) {
@@ -34,6 +34,15 @@ const (
 	GameDeploymentIndexOn = "tkex.bkbcs.tencent.com/gamedeployment-index-on"
 	// GameDeploymentIndexRange for pod inject index range
 	GameDeploymentIndexRange = "tkex.bkbcs.tencent.com/gamedeployment-index-range"
+	// GameDeploymentCanaryWeight marks that the clb weight of pod is managed by the setWeight canary step
+	GameDeploymentCanaryWeight = "tkex.bkbcs.tencent.com/gamedeployment-canary-weight"
+	// GameDeploymentOriginWeight records the clb weight of pod before it is managed by the setWeight canary step,
+	// the weight is restored to it when the canary weight is removed, empty means no clb weight is set before
+	GameDeploymentOriginWeight = "tkex.bkbcs.tencent.com/gamedeployment-origin-weight"
+	// LoadbalanceWeight is the pod clb weight annotation consumed by bcs-ingress-controller
+	LoadbalanceWeight = "networkextension.bkbcs.tencent.com/clb-weight"
+	// LoadbalanceWeightSynced is set by bcs-ingress-controller once the clb weight of pod is synced to loadbalancer
+	LoadbalanceWeightSynced = "networkextension.bkbcs.tencent.com/clb-weight-synced"
 
 	// DefaultGameDeploymentMaxUnavailable is the default value of maxUnavailable for GameDeployment update strategy.
 	DefaultGameDeploymentMaxUnavailable = "20%"
@@ -155,12 +164,20 @@ type GameDeploymentUpdateStrategy struct {
 type CanaryStrategy struct {
 	// +kubebuilder:validation:Required
 	Steps []CanaryStep `json:"steps,omitempty"`
+	// Analysis runs a HookRun in the background during the whole canary. If the HookRun fails,
+	// the canary is aborted and the updated pods are rolled back to the stable revision.
+	Analysis *hookv1alpha1.HookStep `json:"analysis,omitempty"`
 }
 
 type CanaryStep struct {
 	Partition *int32                 `json:"partition,omitempty"`
 	Pause     *CanaryPause           `json:"pause,omitempty"`
 	Hook      *hookv1alpha1.HookStep `json:"hook,omitempty"`
+	// SetWeight is the percentage of traffic sent to the updated pods through bcs-ingress-controller.
+	// The step is completed once the loadbalancer confirms the new pod weights.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	SetWeight *int32 `json:"setWeight,omitempty"`
 }
 
 type CanaryPause struct {
@@ -236,9 +253,13 @@ type GameDeploymentStatus struct {
 }
 
 type CanaryStatus struct {
-	Revision           string       `json:"revision,omitempty"`
-	PauseStartTime     *metav1.Time `json:"pauseStartTime,omitempty"`
-	CurrentStepHookRun string       `json:"currentStepHookRun,omitempty"`
+	Revision               string       `json:"revision,omitempty"`
+	PauseStartTime         *metav1.Time `json:"pauseStartTime,omitempty"`
+	CurrentStepHookRun     string       `json:"currentStepHookRun,omitempty"`
+	CurrentAnalysisHookRun string       `json:"currentAnalysisHookRun,omitempty"`
+	// Aborted indicates the canary of the update revision is aborted by the failed analysis,
+	// it is reset when the pod template is changed
+	Aborted bool `json:"aborted,omitempty"`
 }
 
 // GameDeploymentConditionType is type for GameDeployment conditions.
@@ -249,6 +270,8 @@ const (
 	GameDeploymentConditionFailedScale GameDeploymentConditionType = "FailedScale"
 	// GameDeploymentConditionFailedUpdate indicates GameDeployment controller failed to update pods.
 	GameDeploymentConditionFailedUpdate GameDeploymentConditionType = "FailedUpdate"
+	// GameDeploymentConditionAborted indicates the canary is aborted and the pods are rolled back to the stable revision.
+	GameDeploymentConditionAborted GameDeploymentConditionType = "Aborted"
 )
 
 // GameDeploymentCondition describes the state of a GameDeployment at a certain point.
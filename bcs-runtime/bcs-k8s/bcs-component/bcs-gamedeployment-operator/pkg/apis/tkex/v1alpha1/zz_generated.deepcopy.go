@@ -85,6 +85,11 @@ func (in *CanaryStep) DeepCopyInto(out *CanaryStep) {
 		*out = new(tkexv1alpha1.HookStep)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SetWeight != nil {
+		in, out := &in.SetWeight, &out.SetWeight
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -108,6 +113,11 @@ func (in *CanaryStrategy) DeepCopyInto(out *CanaryStrategy) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Analysis != nil {
+		in, out := &in.Analysis, &out.Analysis
+		*out = new(tkexv1alpha1.HookStep)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -15,6 +15,7 @@ package gamedeployment
 
 import (
 	gdv1alpha1 "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-gamedeployment-operator/pkg/apis/tkex/v1alpha1"
+	canaryutil "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-gamedeployment-operator/pkg/util/canary"
 	hooksutil "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-gamedeployment-operator/pkg/util/hook"
 	hookv1alpha1 "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/kubernetes/common/bcs-hook/apis/tkex/v1alpha1"
 	commonhookutil "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/kubernetes/common/util/hook"
@@ -30,6 +31,9 @@ type canaryContext struct {
 	otherHrs     []*hookv1alpha1.HookRun
 	pauseReasons []hookv1alpha1.PauseReason
 	pause        bool
+	abortMessage string
+	// weightSynced is true if the clb weights of pods set by setWeight step have been synced to loadbalancer
+	weightSynced bool
 }
 
 func newCanaryCtx(deploy *gdv1alpha1.GameDeployment, hrList []*hookv1alpha1.HookRun, updateRevision *apps.ControllerRevision,
@@ -39,7 +43,8 @@ func newCanaryCtx(deploy *gdv1alpha1.GameDeployment, hrList []*hookv1alpha1.Hook
 	canaryHrs := []*hookv1alpha1.HookRun{}
 	for _, hr := range otherHrs {
 		hookRunType, ok := hr.Labels[commonhookutil.HookRunTypeLabel]
-		if ok && hookRunType == commonhookutil.HookRunTypeCanaryStepLabel {
+		if ok && (hookRunType == commonhookutil.HookRunTypeCanaryStepLabel ||
+			hookRunType == commonhookutil.HookRunTypeCanaryAnalysisLabel) {
 			canaryHrs = append(canaryHrs, hr)
 		}
 	}
@@ -54,6 +59,16 @@ func newCanaryCtx(deploy *gdv1alpha1.GameDeployment, hrList []*hookv1alpha1.Hook
 	newStatus.PreDeleteHookConditions = copyStatus.PreDeleteHookConditions
 	newStatus.PreInplaceHookConditions = copyStatus.PreInplaceHookConditions
 	newStatus.PostInplaceHookConditions = copyStatus.PostInplaceHookConditions
+	// keep the canary aborted until the pod template is changed
+	if deploy.Status.Canary.Aborted && !canaryutil.CheckRevisionChange(deploy, updateRevision.Name) {
+		newStatus.Canary.Aborted = true
+		newStatus.Canary.CurrentAnalysisHookRun = deploy.Status.Canary.CurrentAnalysisHookRun
+		for _, cond := range copyStatus.Conditions {
+			if cond.Type == gdv1alpha1.GameDeploymentConditionAborted {
+				newStatus.Conditions = append(newStatus.Conditions, cond)
+			}
+		}
+	}
 
 	return &canaryContext{
 		deploy:     deploy,
@@ -77,6 +92,10 @@ func (cCtx *canaryContext) SetCurrentHookRuns(ars []*hookv1alpha1.HookRun) {
 	if currStepAr != nil {
 		cCtx.newStatus.Canary.CurrentStepHookRun = currStepAr.Name
 	}
+	currAnalysisAr := commonhookutil.GetCurrentAnalysisHookRun(ars)
+	if currAnalysisAr != nil {
+		cCtx.newStatus.Canary.CurrentAnalysisHookRun = currAnalysisAr.Name
+	}
 
 }
 
@@ -96,3 +115,13 @@ func (cCtx *canaryContext) AddPauseCondition(reason hookv1alpha1.PauseReason) {
 func (cCtx *canaryContext) HasAddPause() bool {
 	return len(cCtx.pauseReasons) > 0
 }
+
+// Abort aborts the canary with the message
+func (cCtx *canaryContext) Abort(message string) {
+	cCtx.abortMessage = message
+}
+
+// IsAborting returns whether the canary is aborted in this reconcile
+func (cCtx *canaryContext) IsAborting() bool {
+	return cCtx.abortMessage != ""
+}
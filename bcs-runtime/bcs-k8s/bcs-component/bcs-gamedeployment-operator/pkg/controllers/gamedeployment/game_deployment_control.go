@@ -141,12 +141,18 @@ func (gdc *defaultGameDeploymentControl) UpdateGameDeployment(deploy *gdv1alpha1
 		return 0, nil, err
 	}
 
+	// roll the pods back to the stable revision if the canary is aborted
+	targetRevision := updateRevision
+	if stableRevision := getAbortedStableRevision(deploy, revisions, updateRevision); stableRevision != nil {
+		targetRevision = stableRevision
+	}
+
 	// Refresh update expectations
 	for _, pod := range pods {
-		updateExpectations.ObserveUpdated(key, updateRevision.Name, pod)
+		updateExpectations.ObserveUpdated(key, targetRevision.Name, pod)
 	}
 	// If update expectations have not satisfied yet, just skip this reconcile.
-	if updateSatisfied, updateDirtyPods := updateExpectations.SatisfiedExpectations(key, updateRevision.Name); !updateSatisfied {
+	if updateSatisfied, updateDirtyPods := updateExpectations.SatisfiedExpectations(key, targetRevision.Name); !updateSatisfied {
 		klog.V(4).Infof("Not satisfied update for %v, updateDirtyPods=%v", key, updateDirtyPods)
 		return 0, nil, nil
 	}
@@ -178,7 +184,7 @@ func (gdc *defaultGameDeploymentControl) UpdateGameDeployment(deploy *gdv1alpha1
 		klog.Errorf("Failed to reconcile hookruns for %s: %v", key, err)
 		return 0, canaryCtx.newStatus, err
 	}
-	if canaryCtx.HasAddPause() {
+	if canaryCtx.HasAddPause() || canaryCtx.IsAborting() {
 		err = gdc.statusUpdater.UpdateGameDeploymentStatus(deploy, canaryCtx, pods)
 		return 0, canaryCtx.newStatus, err
 	}
@@ -192,12 +198,18 @@ func (gdc *defaultGameDeploymentControl) UpdateGameDeployment(deploy *gdv1alpha1
 	} else {
 		// scale and update pods
 		delayDuration, updateErr = gdc.updateGameDeployment(deploy, canaryCtx.newStatus,
-			currentRevision, updateRevision, revisions, pods, allPods, hrList)
+			currentRevision, targetRevision, revisions, pods, allPods, hrList)
 		if updateErr != nil {
 			return 0, canaryCtx.newStatus, updateErr
 		}
 	}
 
+	// set the clb weights of pods for setWeight canary steps
+	if err = gdc.reconcileCanaryWeight(canaryCtx, pods); err != nil {
+		klog.Errorf("Failed to reconcile canary weight for %s: %v", key, err)
+		return 0, canaryCtx.newStatus, err
+	}
+
 	unPauseDuration := gdc.reconcilePause(deploy)
 
 	// delete scale down dirty pods whose hooks are completed
@@ -241,6 +253,12 @@ func (gdc *defaultGameDeploymentControl) updateGameDeployment(
 	if err != nil {
 		return delayDuration, err
 	}
+	if updateRevision.Name != newStatus.UpdateRevision {
+		// rolling back the aborted canary, all the pods go back to the stable revision regardless of the partition
+		updateDeploy.Spec.UpdateStrategy.CanaryStrategy = nil
+		updateDeploy.Spec.UpdateStrategy.Partition = nil
+		updateDeploy.Spec.UpdateStrategy.Paused = false
+	}
 
 	// truncate unneeded PreDeleteHookRuns
 	err = gdc.truncatePreDeleteHookRuns(deploy, pods, hrList)
@@ -504,6 +522,27 @@ func (gdc *defaultGameDeploymentControl) getActiveRevisions(deploy *gdv1alpha1.G
 	return currentRevision, updateRevision, collisionCount, nil
 }
 
+// getAbortedStableRevision returns the stable revision to roll back to if the canary of the update revision is aborted
+func getAbortedStableRevision(deploy *gdv1alpha1.GameDeployment, revisions []*apps.ControllerRevision,
+	updateRevision *apps.ControllerRevision) *apps.ControllerRevision {
+	if !deploy.Status.Canary.Aborted || canaryutil.CheckRevisionChange(deploy, updateRevision.Name) {
+		return nil
+	}
+	// the revision is updated to the update revision only when the canary is completed
+	stableRevisionName := deploy.Status.Canary.Revision
+	if stableRevisionName == "" || stableRevisionName == updateRevision.Name {
+		return nil
+	}
+	for _, revision := range revisions {
+		if revision.Name == stableRevisionName {
+			return revision
+		}
+	}
+	klog.Warningf("Stable revision %s of aborted GameDeployment %s/%s is not found", stableRevisionName,
+		deploy.Namespace, deploy.Name)
+	return nil
+}
+
 func (gdc *defaultGameDeploymentControl) handleDirtyPods(deploy *gdv1alpha1.GameDeployment,
 	newStatus *gdv1alpha1.GameDeploymentStatus, dirtyPods []string) {
 	for _, podName := range dirtyPods {
@@ -573,6 +612,10 @@ func (gdc *defaultGameDeploymentControl) truncateHistory(
 	history := make([]*apps.ControllerRevision, 0, len(revisions))
 	// mark all live revisions
 	live := map[string]bool{current.Name: true, update.Name: true}
+	// keep the stable revision for rolling back the canary
+	if deploy.Status.Canary.Revision != "" {
+		live[deploy.Status.Canary.Revision] = true
+	}
 	for i := range pods {
 		live[util.GetPodRevision(pods[i])] = true
 	}
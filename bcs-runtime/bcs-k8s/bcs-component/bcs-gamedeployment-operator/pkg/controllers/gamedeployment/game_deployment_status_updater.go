@@ -102,6 +102,24 @@ func (r *realGameDeploymentStatusUpdater) UpdateGameDeploymentStatus(
 		return r.updateStatus(deploy, canaryCtx.newStatus, pointer.BoolPtr(false))
 	}
 
+	// hold the canary at the current step once it is aborted, the pods are rolled back to the stable revision
+	if canaryCtx.IsAborting() {
+		klog.Infof("Canary of GameDeployment %s/%s is aborted: %s", deploy.Namespace, deploy.Name, canaryCtx.abortMessage)
+		r.recorder.Event(deploy, corev1.EventTypeWarning, "CanaryAborted", canaryCtx.abortMessage)
+		canaryCtx.newStatus.Canary.Aborted = true
+		canaryCtx.newStatus.Conditions = append(canaryCtx.newStatus.Conditions, gdv1alpha1.GameDeploymentCondition{
+			Type:               gdv1alpha1.GameDeploymentConditionAborted,
+			Status:             v1.ConditionTrue,
+			LastTransitionTime: metav1.Now(),
+			Reason:             "AnalysisFailed",
+			Message:            canaryCtx.abortMessage,
+		})
+	}
+	if canaryCtx.newStatus.Canary.Aborted {
+		canaryCtx.newStatus.CurrentStepIndex = currentStepIndex
+		return r.updateStatus(deploy, canaryCtx.newStatus, pointer.BoolPtr(false))
+	}
+
 	if deploy.Status.Canary.Revision == "" {
 		if deploy.Spec.UpdateStrategy.CanaryStrategy == nil {
 			return r.updateStatus(deploy, canaryCtx.newStatus, pointer.BoolPtr(false))
@@ -330,6 +348,11 @@ func completeCurrentCanaryStep(deploy *gdv1alpha1.GameDeployment, canaryCtx *can
 		return true
 	}
 
+	if currentStep.SetWeight != nil && canaryCtx.weightSynced {
+		klog.Info("GameDeployment has synced the clb weights of the setWeight step")
+		return true
+	}
+
 	currentHrs := canaryCtx.CurrentHookRuns()
 	currentStepHr := commonhookutil.GetCurrentStepHookRun(currentHrs)
 	hrExistsAndCompleted := currentStepHr != nil && currentStepHr.Status.Phase.Completed()
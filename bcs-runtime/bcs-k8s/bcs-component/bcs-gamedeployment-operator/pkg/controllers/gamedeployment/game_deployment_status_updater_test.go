@@ -178,6 +178,40 @@ func TestCompleteCurrentCanaryStep(t *testing.T) {
 			ctx:      &canaryContext{},
 			expected: false,
 		},
+		{
+			name: "setWeight step complete",
+			deploy: func() *v1alpha1.GameDeployment {
+				deploy := test.NewGameDeployment(3)
+				deploy.Spec.UpdateStrategy.CanaryStrategy = &v1alpha1.CanaryStrategy{
+					Steps: []v1alpha1.CanaryStep{
+						{
+							SetWeight: func() *int32 { a := int32(5); return &a }(),
+						},
+					},
+				}
+				deploy.Status.CurrentStepIndex = func() *int32 { a := int32(0); return &a }()
+				return deploy
+			}(),
+			ctx:      &canaryContext{weightSynced: true},
+			expected: true,
+		},
+		{
+			name: "setWeight step isn't synced",
+			deploy: func() *v1alpha1.GameDeployment {
+				deploy := test.NewGameDeployment(3)
+				deploy.Spec.UpdateStrategy.CanaryStrategy = &v1alpha1.CanaryStrategy{
+					Steps: []v1alpha1.CanaryStep{
+						{
+							SetWeight: func() *int32 { a := int32(5); return &a }(),
+						},
+					},
+				}
+				deploy.Status.CurrentStepIndex = func() *int32 { a := int32(0); return &a }()
+				return deploy
+			}(),
+			ctx:      &canaryContext{},
+			expected: false,
+		},
 	}
 	for _, s := range tests {
 		t.Run(s.name, func(t *testing.T) {
@@ -15,6 +15,7 @@ package gamedeployment
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"strconv"
 	"strings"
@@ -63,6 +64,14 @@ func (gdc *defaultGameDeploymentControl) reconcileHookRuns(canaryCtx *canaryCont
 		if stepHookRun != nil {
 			newCurrentHookRuns = append(newCurrentHookRuns, stepHookRun)
 		}
+
+		analysisHookRun, err := gdc.reconcileAnalysisHookRun(canaryCtx)
+		if err != nil {
+			return err
+		}
+		if analysisHookRun != nil {
+			newCurrentHookRuns = append(newCurrentHookRuns, analysisHookRun)
+		}
 	}
 
 	canaryCtx.SetCurrentHookRuns(newCurrentHookRuns)
@@ -131,6 +140,12 @@ func (gdc *defaultGameDeploymentControl) reconcileStepHookRun(canaryCtx *canaryC
 	step, index := canaryutil.GetCurrentCanaryStep(deploy)
 	currentHr := commonhookutil.FilterHookRunsByName(currentHrs, deploy.Status.Canary.CurrentStepHookRun)
 
+	// the steps do not go on once the canary is aborted
+	if canaryCtx.newStatus.Canary.Aborted {
+		err := gdc.cancelHookRuns(canaryCtx, []*hookv1alpha1.HookRun{currentHr})
+		return nil, err
+	}
+
 	if len(deploy.Status.PauseConditions) > 0 {
 		return currentHr, nil
 	}
@@ -157,6 +172,46 @@ func (gdc *defaultGameDeploymentControl) reconcileStepHookRun(canaryCtx *canaryC
 	return currentHr, nil
 }
 
+// reconcileAnalysisHookRun reconcile the background analysis HookRun of canary, aborts the canary if it fails
+func (gdc *defaultGameDeploymentControl) reconcileAnalysisHookRun(canaryCtx *canaryContext) (*hookv1alpha1.HookRun, error) {
+	deploy := canaryCtx.deploy
+	currentHrs := canaryCtx.CurrentHookRuns()
+	currentHr := commonhookutil.FilterHookRunsByName(currentHrs, deploy.Status.Canary.CurrentAnalysisHookRun)
+
+	// keep the failed HookRun for the aborted canary
+	if canaryCtx.newStatus.Canary.Aborted {
+		return currentHr, nil
+	}
+
+	analysis := deploy.Spec.UpdateStrategy.CanaryStrategy.Analysis
+	step, _ := canaryutil.GetCurrentCanaryStep(deploy)
+	if analysis == nil || step == nil || deploy.Status.Canary.Revision == canaryCtx.newStatus.UpdateRevision {
+		err := gdc.cancelHookRuns(canaryCtx, []*hookv1alpha1.HookRun{currentHr})
+		return nil, err
+	}
+	if currentHr == nil {
+		revision := canaryCtx.newStatus.UpdateRevision
+		currentHr, err := gdc.createHookRun(canaryCtx, analysis, nil, commonhookutil.AnalysisLabels(revision))
+		if err == nil {
+			klog.Infof("Created analysis HookRun %s of GameDeployment %s/%s", currentHr.Name, deploy.Namespace, deploy.Name)
+		}
+		return currentHr, err
+	}
+
+	switch currentHr.Status.Phase {
+	case hookv1alpha1.HookPhaseInconclusive, hookv1alpha1.HookPhaseError, hookv1alpha1.HookPhaseFailed:
+		message := fmt.Sprintf("analysis HookRun %s is %s", currentHr.Name, currentHr.Status.Phase)
+		if metricResult := commonhookutil.GetFailedMetricResult(currentHr); metricResult != nil {
+			message = fmt.Sprintf("%s, metric %s is %s", message, metricResult.Name, metricResult.Phase)
+			if metricResult.Message != "" {
+				message = fmt.Sprintf("%s: %s", message, metricResult.Message)
+			}
+		}
+		canaryCtx.Abort(message)
+	}
+	return currentHr, nil
+}
+
 // createHookRun create HookRun
 func (gdc *defaultGameDeploymentControl) createHookRun(canaryCtx *canaryContext, hookStep *hookv1alpha1.HookStep, stepIndex *int32,
 	labels map[string]string) (*hookv1alpha1.HookRun, error) {
@@ -198,6 +253,8 @@ func (gdc *defaultGameDeploymentControl) newHookRunFromGameDeployment(canaryCtx
 	nameParts := []string{"canary", revision}
 	if stepIdx != nil {
 		nameParts = append(nameParts, strconv.Itoa(int(*stepIdx)))
+	} else {
+		nameParts = append(nameParts, "analysis")
 	}
 	nameParts = append(nameParts, hookStep.TemplateName)
 	name := strings.Join(nameParts, "-")
@@ -249,3 +249,108 @@ func TestNewHookRunFromGameDeployment(t *testing.T) {
 		t.Errorf("args error, got: %v", hr.Spec.Args)
 	}
 }
+
+func TestReconcileAnalysisHookRun(t *testing.T) {
+	newDeploy := func() *v1alpha1.GameDeployment {
+		deploy := test.NewGameDeployment(1)
+		deploy.Spec.UpdateStrategy.CanaryStrategy = &v1alpha1.CanaryStrategy{
+			Steps:    []v1alpha1.CanaryStep{{Partition: func() *int32 { a := int32(0); return &a }()}},
+			Analysis: &v1alpha12.HookStep{TemplateName: "foo"},
+		}
+		deploy.Status.Canary.Revision = "1"
+		deploy.Status.Canary.CurrentAnalysisHookRun = "hr1"
+		deploy.Status.CurrentStepIndex = func() *int32 { a := int32(0); return &a }()
+		return deploy
+	}
+	newAnalysisHR := func(phase v1alpha12.HookPhase) *v1alpha12.HookRun {
+		hr := newHR("hr1", phase, false, "canary-analysis")
+		hr.Status.MetricResults = []v1alpha12.MetricResult{
+			{Name: "m1", Phase: v1alpha12.HookPhaseSuccessful},
+			{Name: "m2", Phase: phase, Message: "bad"},
+		}
+		return hr
+	}
+
+	tests := []struct {
+		name           string
+		canaryCtx      *canaryContext
+		expectedAbort  string
+		expectedHr     string
+		expectedAction []testing2.Action
+	}{
+		{
+			name: "analysis is running",
+			canaryCtx: &canaryContext{
+				deploy:     newDeploy(),
+				newStatus:  &v1alpha1.GameDeploymentStatus{UpdateRevision: "2"},
+				currentHrs: []*v1alpha12.HookRun{newAnalysisHR(v1alpha12.HookPhaseRunning)},
+			},
+			expectedHr: "hr1",
+		},
+		{
+			name: "analysis failed",
+			canaryCtx: &canaryContext{
+				deploy:     newDeploy(),
+				newStatus:  &v1alpha1.GameDeploymentStatus{UpdateRevision: "2"},
+				currentHrs: []*v1alpha12.HookRun{newAnalysisHR(v1alpha12.HookPhaseFailed)},
+			},
+			expectedAbort: "analysis HookRun hr1 is Failed, metric m2 is Failed: bad",
+			expectedHr:    "hr1",
+		},
+		{
+			name: "already aborted",
+			canaryCtx: &canaryContext{
+				deploy: newDeploy(),
+				newStatus: &v1alpha1.GameDeploymentStatus{UpdateRevision: "2",
+					Canary: v1alpha1.CanaryStatus{Aborted: true}},
+				currentHrs: []*v1alpha12.HookRun{newAnalysisHR(v1alpha12.HookPhaseFailed)},
+			},
+			expectedHr: "hr1",
+		},
+		{
+			name: "canary completed",
+			canaryCtx: &canaryContext{
+				deploy: func() *v1alpha1.GameDeployment {
+					deploy := newDeploy()
+					deploy.Status.CurrentStepIndex = func() *int32 { a := int32(1); return &a }()
+					return deploy
+				}(),
+				newStatus:  &v1alpha1.GameDeploymentStatus{UpdateRevision: "2"},
+				currentHrs: []*v1alpha12.HookRun{newAnalysisHR(v1alpha12.HookPhaseRunning)},
+			},
+			expectedAction: []testing2.Action{
+				expectPatchHookRunAction("default", "hr1", nil),
+			},
+		},
+	}
+
+	for _, s := range tests {
+		t.Run(s.name, func(t *testing.T) {
+			hookClient := hookFake.NewSimpleClientset()
+			hookInformer := hookInformers.NewSharedInformerFactory(hookClient, controller.NoResyncPeriodFunc())
+			gdc := &defaultGameDeploymentControl{
+				hookRunLister:      hookInformer.Tkex().V1alpha1().HookRuns().Lister(),
+				hookTemplateLister: hookInformer.Tkex().V1alpha1().HookTemplates().Lister(),
+				hookClient:         hookClient,
+			}
+
+			hr, err := gdc.reconcileAnalysisHookRun(s.canaryCtx)
+			if err != nil {
+				t.Fatalf("got error: %v", err)
+			}
+			hrName := ""
+			if hr != nil {
+				hrName = hr.Name
+			}
+			if hrName != s.expectedHr {
+				t.Errorf("expected hookrun: %s, but got: %s", s.expectedHr, hrName)
+			}
+			if s.canaryCtx.abortMessage != s.expectedAbort {
+				t.Errorf("expected abort message: %s, but got: %s", s.expectedAbort, s.canaryCtx.abortMessage)
+			}
+			if !test.EqualActions(s.expectedAction, test.FilterActions(hookClient.Actions(), test.FilterPatchAction)) {
+				t.Errorf("expected actions: %v, but got: %v", s.expectedAction, hookClient.Actions())
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gamedeployment
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	gdv1alpha1 "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-gamedeployment-operator/pkg/apis/tkex/v1alpha1"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-gamedeployment-operator/pkg/util"
+	canaryutil "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-gamedeployment-operator/pkg/util/canary"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	patchtypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog"
+)
+
+// reconcileCanaryWeight sets the clb weights of pods according to the setWeight canary steps, the weights are
+// synced to the loadbalancer by bcs-ingress-controller. It records whether all the ready pods have confirmed weights.
+func (gdc *defaultGameDeploymentControl) reconcileCanaryWeight(canaryCtx *canaryContext, pods []*v1.Pod) error {
+	deploy := canaryCtx.deploy
+	weight := canaryutil.GetCurrentWeight(deploy)
+	// restore the clb weights of pods managed by GameDeployment when no weight is set
+	if weight == nil {
+		for _, pod := range pods {
+			if _, ok := pod.Annotations[gdv1alpha1.GameDeploymentCanaryWeight]; !ok {
+				continue
+			}
+			if err := gdc.patchPodWeight(pod, nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	var canaryCount, stableCount int32
+	for _, pod := range pods {
+		if pod.DeletionTimestamp != nil || !util.IsRunningAndReady(pod) {
+			continue
+		}
+		if util.GetPodRevision(pod) == canaryCtx.newStatus.UpdateRevision {
+			canaryCount++
+		} else {
+			stableCount++
+		}
+	}
+	canaryWeight, stableWeight := canaryutil.ComputePodWeights(*weight, canaryCount, stableCount)
+
+	synced := true
+	for _, pod := range pods {
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+		podWeight := strconv.Itoa(int(stableWeight))
+		if util.GetPodRevision(pod) == canaryCtx.newStatus.UpdateRevision {
+			podWeight = strconv.Itoa(int(canaryWeight))
+		}
+		if pod.Annotations[gdv1alpha1.LoadbalanceWeight] != podWeight ||
+			pod.Annotations[gdv1alpha1.GameDeploymentCanaryWeight] != podWeight {
+			if err := gdc.patchPodWeight(pod, &podWeight); err != nil {
+				return err
+			}
+			if util.IsRunningAndReady(pod) {
+				synced = false
+			}
+			continue
+		}
+		// unready pods receive no traffic, so there is no need to wait for them
+		if util.IsRunningAndReady(pod) && pod.Annotations[gdv1alpha1.LoadbalanceWeightSynced] != podWeight {
+			synced = false
+		}
+	}
+	if !synced {
+		klog.V(4).Infof("Waiting clb weights of GameDeployment %s/%s to be synced, canary weight %d, stable weight %d",
+			deploy.Namespace, deploy.Name, canaryWeight, stableWeight)
+	}
+	canaryCtx.weightSynced = synced
+	return nil
+}
+
+// patchPodWeight patches the clb weight of pod, the origin clb weight is recorded when the pod is managed for the
+// first time. If weight is nil, the clb weight is restored to the origin one and the weight annotations are removed.
+func (gdc *defaultGameDeploymentControl) patchPodWeight(pod *v1.Pod, weight *string) error {
+	annotations := map[string]interface{}{
+		gdv1alpha1.LoadbalanceWeight:          weight,
+		gdv1alpha1.GameDeploymentCanaryWeight: weight,
+	}
+	if weight != nil {
+		if _, ok := pod.Annotations[gdv1alpha1.GameDeploymentCanaryWeight]; !ok {
+			annotations[gdv1alpha1.GameDeploymentOriginWeight] = pod.Annotations[gdv1alpha1.LoadbalanceWeight]
+		}
+	} else {
+		if origin := pod.Annotations[gdv1alpha1.GameDeploymentOriginWeight]; len(origin) != 0 {
+			annotations[gdv1alpha1.LoadbalanceWeight] = origin
+		}
+		annotations[gdv1alpha1.GameDeploymentOriginWeight] = nil
+		annotations[gdv1alpha1.LoadbalanceWeightSynced] = nil
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": annotations,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = gdc.kubeClient.CoreV1().Pods(pod.Namespace).Patch(context.TODO(), pod.Name,
+		patchtypes.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		klog.Errorf("Failed to patch clb weight of pod %s/%s: %v", pod.Namespace, pod.Name, err)
+	}
+	return err
+}
@@ -0,0 +1,195 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gamedeployment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-gamedeployment-operator/pkg/apis/tkex/v1alpha1"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-gamedeployment-operator/pkg/test"
+	apps "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestReconcileCanaryWeight(t *testing.T) {
+	newDeploy := func(stepIndex int32) *v1alpha1.GameDeployment {
+		deploy := test.NewGameDeployment(5)
+		deploy.Spec.UpdateStrategy.CanaryStrategy = &v1alpha1.CanaryStrategy{
+			Steps: []v1alpha1.CanaryStep{
+				{Partition: func() *int32 { a := int32(4); return &a }()},
+				{SetWeight: func() *int32 { a := int32(50); return &a }()},
+			},
+		}
+		deploy.Status.UpdateRevision = "2"
+		deploy.Status.Canary.Revision = "1"
+		deploy.Status.CurrentStepIndex = func() *int32 { a := int32(stepIndex); return &a }()
+		return deploy
+	}
+	newWeightPod := func(suffix int, revision string, weight, synced string) *corev1.Pod {
+		pod := newPod(suffix, map[string]string{apps.ControllerRevisionHashLabelKey: revision}, true)
+		pod.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}
+		if weight != "" {
+			pod.Annotations[v1alpha1.LoadbalanceWeight] = weight
+			pod.Annotations[v1alpha1.GameDeploymentCanaryWeight] = weight
+		}
+		if synced != "" {
+			pod.Annotations[v1alpha1.LoadbalanceWeightSynced] = synced
+		}
+		return pod
+	}
+
+	tests := []struct {
+		name            string
+		deploy          *v1alpha1.GameDeployment
+		pods            []*corev1.Pod
+		expectedSynced  bool
+		expectedWeights map[string]string
+		// nil means the origin weight annotation is absent
+		expectedOrigins map[string]*string
+	}{
+		{
+			name:   "set weights of pods",
+			deploy: newDeploy(1),
+			pods: []*corev1.Pod{
+				newWeightPod(0, "2", "", ""),
+				newWeightPod(1, "1", "", ""),
+				newWeightPod(2, "1", "", ""),
+				newWeightPod(3, "1", "", ""),
+				newWeightPod(4, "1", "", ""),
+			},
+			expectedSynced: false,
+			expectedWeights: map[string]string{
+				"foo-0": "100", "foo-1": "25", "foo-2": "25", "foo-3": "25", "foo-4": "25",
+			},
+		},
+		{
+			name:   "record origin weights of pods",
+			deploy: newDeploy(1),
+			pods: []*corev1.Pod{
+				newWeightPod(0, "2", "", ""),
+				func() *corev1.Pod {
+					pod := newWeightPod(1, "1", "", "")
+					pod.Annotations[v1alpha1.LoadbalanceWeight] = "30"
+					return pod
+				}(),
+			},
+			expectedSynced: false,
+			expectedWeights: map[string]string{
+				"foo-0": "100", "foo-1": "100",
+			},
+			expectedOrigins: map[string]*string{
+				"foo-0": func() *string { a := ""; return &a }(),
+				"foo-1": func() *string { a := "30"; return &a }(),
+			},
+		},
+		{
+			name:   "weights are set but not synced",
+			deploy: newDeploy(1),
+			pods: []*corev1.Pod{
+				newWeightPod(0, "2", "100", "100"),
+				newWeightPod(1, "1", "25", "10"),
+				newWeightPod(2, "1", "25", "25"),
+				newWeightPod(3, "1", "25", "25"),
+				newWeightPod(4, "1", "25", "25"),
+			},
+			expectedSynced: false,
+			expectedWeights: map[string]string{
+				"foo-0": "100", "foo-1": "25", "foo-2": "25", "foo-3": "25", "foo-4": "25",
+			},
+		},
+		{
+			name:   "weights are synced",
+			deploy: newDeploy(1),
+			pods: []*corev1.Pod{
+				newWeightPod(0, "2", "100", "100"),
+				newWeightPod(1, "1", "25", "25"),
+				newWeightPod(2, "1", "25", "25"),
+				newWeightPod(3, "1", "25", "25"),
+				newWeightPod(4, "1", "25", "25"),
+			},
+			expectedSynced: true,
+			expectedWeights: map[string]string{
+				"foo-0": "100", "foo-1": "25", "foo-2": "25", "foo-3": "25", "foo-4": "25",
+			},
+		},
+		{
+			name:   "restore weights before setWeight step",
+			deploy: newDeploy(0),
+			pods: []*corev1.Pod{
+				newWeightPod(0, "2", "100", "100"),
+				func() *corev1.Pod {
+					pod := newWeightPod(1, "1", "25", "25")
+					pod.Annotations[v1alpha1.GameDeploymentOriginWeight] = "40"
+					return pod
+				}(),
+				func() *corev1.Pod {
+					pod := newWeightPod(2, "1", "", "")
+					pod.Annotations[v1alpha1.LoadbalanceWeight] = "30"
+					return pod
+				}(),
+			},
+			expectedSynced: false,
+			expectedWeights: map[string]string{
+				"foo-0": "", "foo-1": "40", "foo-2": "30",
+			},
+			expectedOrigins: map[string]*string{
+				"foo-0": nil, "foo-1": nil,
+			},
+		},
+	}
+
+	for _, s := range tests {
+		t.Run(s.name, func(t *testing.T) {
+			kubeClient := fake.NewSimpleClientset()
+			for _, pod := range s.pods {
+				_, _ = kubeClient.CoreV1().Pods(pod.Namespace).Create(context.TODO(), pod, metav1.CreateOptions{})
+			}
+			gdc := &defaultGameDeploymentControl{kubeClient: kubeClient}
+			canaryCtx := &canaryContext{
+				deploy:    s.deploy,
+				newStatus: &v1alpha1.GameDeploymentStatus{UpdateRevision: "2"},
+			}
+
+			if err := gdc.reconcileCanaryWeight(canaryCtx, s.pods); err != nil {
+				t.Fatalf("got error: %v", err)
+			}
+			if canaryCtx.weightSynced != s.expectedSynced {
+				t.Errorf("expected synced: %v, but got: %v", s.expectedSynced, canaryCtx.weightSynced)
+			}
+			for name, weight := range s.expectedWeights {
+				pod, err := kubeClient.CoreV1().Pods(corev1.NamespaceDefault).Get(context.TODO(), name, metav1.GetOptions{})
+				if err != nil {
+					t.Fatalf("got error: %v", err)
+				}
+				if pod.Annotations[v1alpha1.LoadbalanceWeight] != weight {
+					t.Errorf("expected weight of pod %s: %s, but got: %s",
+						name, weight, pod.Annotations[v1alpha1.LoadbalanceWeight])
+				}
+			}
+			for name, origin := range s.expectedOrigins {
+				pod, err := kubeClient.CoreV1().Pods(corev1.NamespaceDefault).Get(context.TODO(), name, metav1.GetOptions{})
+				if err != nil {
+					t.Fatalf("got error: %v", err)
+				}
+				got, ok := pod.Annotations[v1alpha1.GameDeploymentOriginWeight]
+				if (origin == nil) == ok || (origin != nil && *origin != got) {
+					t.Errorf("expected origin weight of pod %s: %v, but got: %s, exists: %v", name, origin, got, ok)
+				}
+			}
+		})
+	}
+}
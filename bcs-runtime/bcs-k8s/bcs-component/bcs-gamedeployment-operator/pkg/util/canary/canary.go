@@ -15,18 +15,27 @@ package canary
 
 import (
 	"fmt"
+	"hash"
 	"hash/fnv"
+	"strings"
 	"time"
 
 	gdv1alpha1 "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-gamedeployment-operator/pkg/apis/tkex/v1alpha1"
 	hookv1alpha1 "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/kubernetes/common/bcs-hook/apis/tkex/v1alpha1"
 
+	"github.com/davecgh/go-spew/spew"
 	"k8s.io/apimachinery/pkg/util/rand"
 	"k8s.io/klog"
-	hashutil "k8s.io/kubernetes/pkg/util/hash"
 	"k8s.io/utils/pointer"
 )
 
+const (
+	// maxPodWeight is the max clb weight of a pod
+	maxPodWeight int32 = 100
+	// unsetWeightField is the printed setWeight field of canary step which is not set
+	unsetWeightField = " SetWeight:(*int32)<nil>"
+)
+
 // GetCurrentCanaryStep get current canary step
 func GetCurrentCanaryStep(deploy *gdv1alpha1.GameDeployment) (*gdv1alpha1.CanaryStep, *int32) {
 	if deploy.Spec.UpdateStrategy.CanaryStrategy == nil || len(deploy.Spec.UpdateStrategy.CanaryStrategy.Steps) == 0 {
@@ -63,6 +72,57 @@ func GetCurrentPartition(deploy *gdv1alpha1.GameDeployment) int32 {
 	return *deploy.Spec.Replicas
 }
 
+// GetCurrentWeight get the traffic weight in percent of the updated pods, which is set by the latest
+// setWeight step before current step. Return nil if the canary is not in progress or no weight is set.
+func GetCurrentWeight(deploy *gdv1alpha1.GameDeployment) *int32 {
+	currentStep, currentStepIndex := GetCurrentCanaryStep(deploy)
+	if currentStep == nil || deploy.Status.Canary.Aborted {
+		return nil
+	}
+	if deploy.Status.Canary.Revision == "" || deploy.Status.Canary.Revision == deploy.Status.UpdateRevision {
+		return nil
+	}
+
+	for i := *currentStepIndex; i >= 0; i-- {
+		step := deploy.Spec.UpdateStrategy.CanaryStrategy.Steps[i]
+		if step.SetWeight != nil {
+			return step.SetWeight
+		}
+	}
+	return nil
+}
+
+// ComputePodWeights computes the clb weights of each updated pod and each stable pod, so that the updated pods
+// receive the given percentage of traffic. The weights are scaled to the max weight 100.
+func ComputePodWeights(weight, canaryCount, stableCount int32) (int32, int32) {
+	if weight <= 0 {
+		return 0, maxPodWeight
+	}
+	if weight >= 100 {
+		return maxPodWeight, 0
+	}
+	if canaryCount == 0 || stableCount == 0 {
+		return maxPodWeight, maxPodWeight
+	}
+
+	// canaryCount * canaryWeight / (stableCount * stableWeight) == weight / (100 - weight)
+	canaryShare := int64(weight) * int64(stableCount)
+	stableShare := int64(100-weight) * int64(canaryCount)
+	if canaryShare >= stableShare {
+		return maxPodWeight, scaleWeight(stableShare, canaryShare)
+	}
+	return scaleWeight(canaryShare, stableShare), maxPodWeight
+}
+
+// scaleWeight returns round(maxPodWeight * numerator / denominator), at least 1
+func scaleWeight(numerator, denominator int64) int32 {
+	w := int32((2*int64(maxPodWeight)*numerator + denominator) / (2 * denominator))
+	if w < 1 {
+		return 1
+	}
+	return w
+}
+
 // CheckStepHashChange detects if there is an change in the canary steps
 func CheckStepHashChange(deploy *gdv1alpha1.GameDeployment) bool {
 	if deploy.Status.CurrentStepHash == "" {
@@ -83,11 +143,24 @@ func CheckRevisionChange(deploy *gdv1alpha1.GameDeployment, revision string) boo
 func ComputeStepHash(deploy *gdv1alpha1.GameDeployment) string {
 	deployStepHasher := fnv.New32a()
 	if deploy.Spec.UpdateStrategy.CanaryStrategy != nil {
-		hashutil.DeepHashObject(deployStepHasher, deploy.Spec.UpdateStrategy.CanaryStrategy.Steps)
+		deepHashSteps(deployStepHasher, deploy.Spec.UpdateStrategy.CanaryStrategy.Steps)
 	}
 	return rand.SafeEncodeString(fmt.Sprint(deployStepHasher.Sum32()))
 }
 
+// deepHashSteps writes canary steps to hash in the same way as hashutil.DeepHashObject, except that the unset
+// setWeight fields are omitted, so the hash of steps without setWeight is kept unchanged after upgrade
+func deepHashSteps(hasher hash.Hash, steps []gdv1alpha1.CanaryStep) {
+	hasher.Reset()
+	printer := spew.ConfigState{
+		Indent:         " ",
+		SortKeys:       true,
+		DisableMethods: true,
+		SpewKeys:       true,
+	}
+	_, _ = hasher.Write([]byte(strings.ReplaceAll(printer.Sprintf("%#v", steps), unsetWeightField, "")))
+}
+
 // ResetCurrentStepIndex resets the canary step
 func ResetCurrentStepIndex(deploy *gdv1alpha1.GameDeployment) *int32 {
 	if deploy.Spec.UpdateStrategy.CanaryStrategy != nil && len(deploy.Spec.UpdateStrategy.CanaryStrategy.Steps) > 0 {
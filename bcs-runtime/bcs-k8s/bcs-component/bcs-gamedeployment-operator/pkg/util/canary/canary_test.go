@@ -171,6 +171,20 @@ func TestComputeStepHash(t *testing.T) {
 			},
 			expectedHash: "5d9755c8cc",
 		},
+		{
+			name: "canaryStrategy with setWeight",
+			deploy: &v1alpha1.GameDeployment{
+				Spec: v1alpha1.GameDeploymentSpec{UpdateStrategy: v1alpha1.GameDeploymentUpdateStrategy{
+					CanaryStrategy: &v1alpha1.CanaryStrategy{
+						Steps: []v1alpha1.CanaryStep{
+							{Pause: &v1alpha1.CanaryPause{}},
+							{SetWeight: func() *int32 { a := int32(20); return &a }()},
+						},
+					},
+				}},
+			},
+			expectedHash: "7794555866",
+		},
 	}
 
 	for _, s := range tests {
@@ -241,3 +255,160 @@ func TestGetMinDuration(t *testing.T) {
 		})
 	}
 }
+
+func TestGetCurrentWeight(t *testing.T) {
+	int32Ptr := func(i int32) *int32 { return &i }
+	newDeploy := func(stepIndex int32, canaryRevision string, aborted bool) *v1alpha1.GameDeployment {
+		return &v1alpha1.GameDeployment{
+			Spec: v1alpha1.GameDeploymentSpec{
+				UpdateStrategy: v1alpha1.GameDeploymentUpdateStrategy{
+					CanaryStrategy: &v1alpha1.CanaryStrategy{
+						Steps: []v1alpha1.CanaryStep{
+							{Partition: int32Ptr(8)},
+							{SetWeight: int32Ptr(5)},
+							{Pause: &v1alpha1.CanaryPause{}},
+							{SetWeight: int32Ptr(50)},
+						},
+					},
+				},
+			},
+			Status: v1alpha1.GameDeploymentStatus{
+				UpdateRevision:   "rev2",
+				CurrentStepIndex: int32Ptr(stepIndex),
+				Canary:           v1alpha1.CanaryStatus{Revision: canaryRevision, Aborted: aborted},
+			},
+		}
+	}
+	tests := []struct {
+		name           string
+		deploy         *v1alpha1.GameDeployment
+		expectedWeight *int32
+	}{
+		{
+			name:           "no canary",
+			deploy:         &v1alpha1.GameDeployment{},
+			expectedWeight: nil,
+		},
+		{
+			name:           "before setWeight step",
+			deploy:         newDeploy(0, "rev1", false),
+			expectedWeight: nil,
+		},
+		{
+			name:           "at setWeight step",
+			deploy:         newDeploy(1, "rev1", false),
+			expectedWeight: int32Ptr(5),
+		},
+		{
+			name:           "after setWeight step",
+			deploy:         newDeploy(2, "rev1", false),
+			expectedWeight: int32Ptr(5),
+		},
+		{
+			name:           "at the second setWeight step",
+			deploy:         newDeploy(3, "rev1", false),
+			expectedWeight: int32Ptr(50),
+		},
+		{
+			name:           "all steps completed",
+			deploy:         newDeploy(4, "rev1", false),
+			expectedWeight: nil,
+		},
+		{
+			name:           "already the update revision",
+			deploy:         newDeploy(2, "rev2", false),
+			expectedWeight: nil,
+		},
+		{
+			name:           "canary aborted",
+			deploy:         newDeploy(2, "rev1", true),
+			expectedWeight: nil,
+		},
+	}
+
+	for _, s := range tests {
+		t.Run(s.name, func(t *testing.T) {
+			if got := GetCurrentWeight(s.deploy); !reflect.DeepEqual(got, s.expectedWeight) {
+				t.Errorf("expected: %v, got: %v", s.expectedWeight, got)
+			}
+		})
+	}
+}
+
+func TestComputePodWeights(t *testing.T) {
+	tests := []struct {
+		name                 string
+		weight               int32
+		canaryCount          int32
+		stableCount          int32
+		expectedCanaryWeight int32
+		expectedStableWeight int32
+	}{
+		{
+			name:                 "zero weight",
+			weight:               0,
+			canaryCount:          2,
+			stableCount:          8,
+			expectedCanaryWeight: 0,
+			expectedStableWeight: 100,
+		},
+		{
+			name:                 "full weight",
+			weight:               100,
+			canaryCount:          2,
+			stableCount:          8,
+			expectedCanaryWeight: 100,
+			expectedStableWeight: 0,
+		},
+		{
+			name:                 "no stable pods",
+			weight:               20,
+			canaryCount:          2,
+			stableCount:          0,
+			expectedCanaryWeight: 100,
+			expectedStableWeight: 100,
+		},
+		{
+			name:                 "5% traffic for 20% pods",
+			weight:               5,
+			canaryCount:          2,
+			stableCount:          8,
+			expectedCanaryWeight: 21,
+			expectedStableWeight: 100,
+		},
+		{
+			name:                 "same ratio of traffic and pods",
+			weight:               20,
+			canaryCount:          2,
+			stableCount:          8,
+			expectedCanaryWeight: 100,
+			expectedStableWeight: 100,
+		},
+		{
+			name:                 "80% traffic for 20% pods",
+			weight:               80,
+			canaryCount:          2,
+			stableCount:          8,
+			expectedCanaryWeight: 100,
+			expectedStableWeight: 6,
+		},
+		{
+			name:                 "tiny weight",
+			weight:               1,
+			canaryCount:          100,
+			stableCount:          1,
+			expectedCanaryWeight: 1,
+			expectedStableWeight: 100,
+		},
+	}
+
+	for _, s := range tests {
+		t.Run(s.name, func(t *testing.T) {
+			canaryWeight, stableWeight := ComputePodWeights(s.weight, s.canaryCount, s.stableCount)
+			if canaryWeight != s.expectedCanaryWeight || stableWeight != s.expectedStableWeight {
+				t.Errorf("expected: %d/%d, got: %d/%d", s.expectedCanaryWeight, s.expectedStableWeight,
+					canaryWeight, stableWeight)
+			}
+		})
+	}
+}
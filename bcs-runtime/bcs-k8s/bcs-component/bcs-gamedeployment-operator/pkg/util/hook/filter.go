@@ -21,7 +21,7 @@ import (
 
 func FilterCurrentHookRuns(hookRuns []*hookv1alpha1.HookRun, deploy *gdv1alpha1.GameDeployment) ([]*hookv1alpha1.HookRun, []*hookv1alpha1.HookRun) {
 	return commonhookutil.FilterHookRuns(hookRuns, func(hr *hookv1alpha1.HookRun) bool {
-		if hr.Name == deploy.Status.Canary.CurrentStepHookRun {
+		if hr.Name == deploy.Status.Canary.CurrentStepHookRun || hr.Name == deploy.Status.Canary.CurrentAnalysisHookRun {
 			return true
 		}
 		return false
@@ -104,6 +104,9 @@ type GameStatefulSetUpdateStrategy struct {
 type CanaryStrategy struct {
 	// +kubebuilder:validation:Required
 	Steps []CanaryStep `json:"steps,omitempty"`
+	// Analysis runs a HookRun in the background during the whole canary. If the HookRun fails,
+	// the canary is aborted and the updated pods are rolled back to the stable revision.
+	Analysis *hookv1alpha1.HookStep `json:"analysis,omitempty"`
 }
 
 type CanaryStep struct {
@@ -328,14 +331,23 @@ type GameStatefulSetStatus struct {
 }
 
 type CanaryStatus struct {
-	Revision           string       `json:"revision,omitempty"`
-	PauseStartTime     *metav1.Time `json:"pauseStartTime,omitempty"`
-	CurrentStepHookRun string       `json:"currentStepHookRun,omitempty"`
+	Revision               string       `json:"revision,omitempty"`
+	PauseStartTime         *metav1.Time `json:"pauseStartTime,omitempty"`
+	CurrentStepHookRun     string       `json:"currentStepHookRun,omitempty"`
+	CurrentAnalysisHookRun string       `json:"currentAnalysisHookRun,omitempty"`
+	// Aborted indicates the canary of the update revision is aborted by the failed analysis,
+	// it is reset when the pod template is changed
+	Aborted bool `json:"aborted,omitempty"`
 }
 
 //GameStatefulSetConditionType condition type for statefulset
 type GameStatefulSetConditionType string
 
+const (
+	// GameStatefulSetConditionAborted indicates the canary is aborted and the pods are rolled back to the stable revision.
+	GameStatefulSetConditionAborted GameStatefulSetConditionType = "Aborted"
+)
+
 // GameStatefulSetCondition describes the state of a statefulset at a certain point.
 type GameStatefulSetCondition struct {
 	// Type of statefulset condition.
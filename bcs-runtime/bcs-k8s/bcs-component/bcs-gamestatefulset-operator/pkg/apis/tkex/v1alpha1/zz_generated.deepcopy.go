@@ -109,6 +109,11 @@ func (in *CanaryStrategy) DeepCopyInto(out *CanaryStrategy) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Analysis != nil {
+		in, out := &in.Analysis, &out.Analysis
+		*out = new(tkexv1alpha1.HookStep)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -16,6 +16,7 @@ package gamestatefulset
 import (
 	gstsv1alpha1 "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-gamestatefulset-operator/pkg/apis/tkex/v1alpha1"
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-gamestatefulset-operator/pkg/util"
+	canaryutil "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-gamestatefulset-operator/pkg/util/canary"
 	hooksutil "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-gamestatefulset-operator/pkg/util/hook"
 	hookv1alpha1 "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/kubernetes/common/bcs-hook/apis/tkex/v1alpha1"
 	commonhookutil "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/kubernetes/common/util/hook"
@@ -28,6 +29,7 @@ type canaryContext struct {
 	currentHrs   []*hookv1alpha1.HookRun
 	otherHrs     []*hookv1alpha1.HookRun
 	pauseReasons []hookv1alpha1.PauseReason
+	abortMessage string
 }
 
 func newCanaryCtx(set *gstsv1alpha1.GameStatefulSet, hrList []*hookv1alpha1.HookRun, currentRevision,
@@ -37,7 +39,8 @@ func newCanaryCtx(set *gstsv1alpha1.GameStatefulSet, hrList []*hookv1alpha1.Hook
 	canaryHrs := []*hookv1alpha1.HookRun{}
 	for _, hr := range otherHrs {
 		hookRunType, ok := hr.Labels[commonhookutil.HookRunTypeLabel]
-		if ok && hookRunType == commonhookutil.HookRunTypeCanaryStepLabel {
+		if ok && (hookRunType == commonhookutil.HookRunTypeCanaryStepLabel ||
+			hookRunType == commonhookutil.HookRunTypeCanaryAnalysisLabel) {
 			canaryHrs = append(canaryHrs, hr)
 		}
 	}
@@ -53,6 +56,16 @@ func newCanaryCtx(set *gstsv1alpha1.GameStatefulSet, hrList []*hookv1alpha1.Hook
 	newStatus.PreDeleteHookConditions = copyStatus.PreDeleteHookConditions
 	newStatus.PreInplaceHookConditions = copyStatus.PreInplaceHookConditions
 	newStatus.PostInplaceHookConditions = copyStatus.PostInplaceHookConditions
+	// keep the canary aborted until the pod template is changed
+	if set.Status.Canary.Aborted && !canaryutil.CheckRevisionChange(set, updateRevision.Name) {
+		newStatus.Canary.Aborted = true
+		newStatus.Canary.CurrentAnalysisHookRun = set.Status.Canary.CurrentAnalysisHookRun
+		for _, cond := range copyStatus.Conditions {
+			if cond.Type == gstsv1alpha1.GameStatefulSetConditionAborted {
+				newStatus.Conditions = append(newStatus.Conditions, cond)
+			}
+		}
+	}
 
 	// add Status.labelSelector
 	util.ToLabelString(set.Spec.Selector)
@@ -81,6 +94,10 @@ func (cCtx *canaryContext) SetCurrentHookRuns(ars []*hookv1alpha1.HookRun) {
 	if currStepAr != nil {
 		cCtx.newStatus.Canary.CurrentStepHookRun = currStepAr.Name
 	}
+	currAnalysisAr := commonhookutil.GetCurrentAnalysisHookRun(ars)
+	if currAnalysisAr != nil {
+		cCtx.newStatus.Canary.CurrentAnalysisHookRun = currAnalysisAr.Name
+	}
 
 }
 
@@ -91,3 +108,13 @@ func (cCtx *canaryContext) AddPauseCondition(reason hookv1alpha1.PauseReason) {
 func (cCtx *canaryContext) HasAddPause() bool {
 	return len(cCtx.pauseReasons) > 0
 }
+
+// Abort aborts the canary with the message
+func (cCtx *canaryContext) Abort(message string) {
+	cCtx.abortMessage = message
+}
+
+// IsAborting returns whether the canary is aborted in this reconcile
+func (cCtx *canaryContext) IsAborting() bool {
+	return cCtx.abortMessage != ""
+}
@@ -188,17 +188,23 @@ func (ssc *defaultGameStatefulSetControl) UpdateGameStatefulSet(
 	if err != nil {
 		return err
 	}
-	if canaryCtx.HasAddPause() {
+	if canaryCtx.HasAddPause() || canaryCtx.IsAborting() {
 		err = ssc.updateGameStatefulSetStatus(set, canaryCtx)
 		return err
 	}
 
+	// roll the pods back to the stable revision if the canary is aborted
+	targetRevision := updateRevision
+	if stableRevision := getAbortedStableRevision(set, revisions, updateRevision); stableRevision != nil {
+		targetRevision = stableRevision
+	}
+
 	// perform the main update function and get the status
 	_, updateErr := ssc.updateGameStatefulSet(
 		set,
 		canaryCtx.newStatus,
 		currentRevision,
-		updateRevision,
+		targetRevision,
 		pods,
 		revisions,
 		hrList)
@@ -297,6 +303,10 @@ func (ssc *defaultGameStatefulSetControl) truncateHistory(
 	history := make([]*apps.ControllerRevision, 0, len(revisions))
 	// mark all live revisions
 	live := map[string]bool{current.Name: true, update.Name: true}
+	// keep the stable revision for rolling back the canary
+	if set.Status.Canary.Revision != "" {
+		live[set.Status.Canary.Revision] = true
+	}
 	for i := range pods {
 		live[getPodRevision(pods[i])] = true
 	}
@@ -327,6 +337,27 @@ func (ssc *defaultGameStatefulSetControl) truncateHistory(
 	return nil
 }
 
+// getAbortedStableRevision returns the stable revision to roll back to if the canary of the update revision is aborted
+func getAbortedStableRevision(set *gstsv1alpha1.GameStatefulSet, revisions []*apps.ControllerRevision,
+	updateRevision *apps.ControllerRevision) *apps.ControllerRevision {
+	if !set.Status.Canary.Aborted || canaryutil.CheckRevisionChange(set, updateRevision.Name) {
+		return nil
+	}
+	// the revision is updated to the update revision only when the canary is completed
+	stableRevisionName := set.Status.Canary.Revision
+	if stableRevisionName == "" || stableRevisionName == updateRevision.Name {
+		return nil
+	}
+	for _, revision := range revisions {
+		if revision.Name == stableRevisionName {
+			return revision
+		}
+	}
+	klog.Warningf("Stable revision %s of aborted GameStatefulSet %s/%s is not found", stableRevisionName,
+		set.Namespace, set.Name)
+	return nil
+}
+
 // getStatefulSetRevisions returns the current and update ControllerRevisions for set. It also
 // returns a collision count that records the number of name collisions set saw when creating
 // new ControllerRevisions. This count is incremented on every name collision and is used in
@@ -432,6 +463,15 @@ func (ssc *defaultGameStatefulSetControl) updateGameStatefulSet(
 	if err != nil {
 		return nil, err
 	}
+	if updateRevision.Name != status.UpdateRevision {
+		// rolling back the aborted canary, all the pods go back to the stable revision regardless of the partition
+		set = set.DeepCopy()
+		set.Spec.UpdateStrategy.CanaryStrategy = nil
+		set.Spec.UpdateStrategy.Paused = false
+		if set.Spec.UpdateStrategy.RollingUpdate != nil {
+			set.Spec.UpdateStrategy.RollingUpdate.Partition = nil
+		}
+	}
 
 	// truncate unneeded PreDeleteHookRuns
 	err = ssc.truncatePreDeleteHookRuns(set, pods, hrList)
@@ -479,7 +519,9 @@ func (ssc *defaultGameStatefulSetControl) updateGameStatefulSet(
 			status.ReadyReplicas++
 		}
 
-		if isRunningAndReady(pods[i]) && getPodRevision(pods[i]) == updateRevision.Name {
+		// the updated replicas are counted against the update revision in status, which differs from
+		// updateRevision when rolling back an aborted canary
+		if isRunningAndReady(pods[i]) && getPodRevision(pods[i]) == status.UpdateRevision {
 			status.UpdatedReadyReplicas++
 		}
 
@@ -491,7 +533,7 @@ func (ssc *defaultGameStatefulSetControl) updateGameStatefulSet(
 
 		// count the number of current and update replicas
 		if isCreated(pods[i]) && !isTerminating(pods[i]) {
-			ssc.renewStatus(status, pods[i], currentRevision, updateRevision, 1)
+			ssc.renewStatus(status, pods[i], currentRevision, 1)
 		}
 
 		if ord := getOrdinal(pods[i]); 0 <= ord && ord < replicaCount {
@@ -586,7 +628,7 @@ func (ssc *defaultGameStatefulSetControl) updateGameStatefulSet(
 				return status, err
 			}
 			ssc.metrics.collectPodDeleteDurations(set.Namespace, set.Name, successStatus, recreatingPod, isGrace, time.Since(startTime))
-			ssc.renewStatus(status, replicas[i], currentRevision, updateRevision, -1)
+			ssc.renewStatus(status, replicas[i], currentRevision, -1)
 			status.Replicas--
 			replicas[i] = newVersionedGameStatefulSetPod(
 				set,
@@ -606,7 +648,7 @@ func (ssc *defaultGameStatefulSetControl) updateGameStatefulSet(
 				return status, err
 			}
 			if deleted {
-				ssc.renewStatus(status, replicas[i], currentRevision, updateRevision, -1)
+				ssc.renewStatus(status, replicas[i], currentRevision, -1)
 				status.Replicas--
 				replicas[i] = newVersionedGameStatefulSetPod(
 					set,
@@ -630,7 +672,7 @@ func (ssc *defaultGameStatefulSetControl) updateGameStatefulSet(
 			ssc.metrics.collectPodCreateDurations(set.Namespace, set.Name, successStatus, time.Since(startTime))
 			klog.Infof("GameStatefulSet %s/%s is creating Pod %s", set.Namespace, set.Name, replicas[i].Name)
 			status.Replicas++
-			ssc.renewStatus(status, replicas[i], currentRevision, updateRevision, 1)
+			ssc.renewStatus(status, replicas[i], currentRevision, 1)
 
 			// if the set does not allow bursting, return immediately
 			if monotonic {
@@ -760,7 +802,7 @@ func (ssc *defaultGameStatefulSetControl) updateGameStatefulSet(
 				return status, err
 			}
 			ssc.metrics.collectPodDeleteDurations(set.Namespace, set.Name, successStatus, deletePodAction, isGrace, time.Since(startTime))
-			ssc.renewStatus(status, condemned[target], currentRevision, updateRevision, -1)
+			ssc.renewStatus(status, condemned[target], currentRevision, -1)
 			if monotonic {
 				return status, nil
 			}
@@ -1370,11 +1412,11 @@ func (ssc *defaultGameStatefulSetControl) dealWithMaxSurge(set *gstsv1alpha1.Gam
 }
 
 func (ssc *defaultGameStatefulSetControl) renewStatus(status *gstsv1alpha1.GameStatefulSetStatus,
-	pod *v1.Pod, currentRevision, updateRevision *apps.ControllerRevision, num int) {
+	pod *v1.Pod, currentRevision *apps.ControllerRevision, num int) {
 	if getPodRevision(pod) == currentRevision.Name {
 		status.CurrentReplicas = status.CurrentReplicas + int32(num)
 	}
-	if getPodRevision(pod) == updateRevision.Name {
+	if getPodRevision(pod) == status.UpdateRevision {
 		status.UpdatedReplicas = status.UpdatedReplicas + int32(num)
 	}
 }
@@ -96,6 +96,24 @@ func (ssu *realGameStatefulSetStatusUpdater) UpdateGameStatefulSetStatus(
 		return ssu.updateStatus(set, canaryCtx.newStatus, pointer.BoolPtr(false))
 	}
 
+	// hold the canary at the current step once it is aborted, the pods are rolled back to the stable revision
+	if canaryCtx.IsAborting() {
+		klog.Infof("Canary of GameStatefulSet %s/%s is aborted: %s", set.Namespace, set.Name, canaryCtx.abortMessage)
+		ssu.recorder.Event(set, corev1.EventTypeWarning, "CanaryAborted", canaryCtx.abortMessage)
+		canaryCtx.newStatus.Canary.Aborted = true
+		canaryCtx.newStatus.Conditions = append(canaryCtx.newStatus.Conditions, gstsv1alpha1.GameStatefulSetCondition{
+			Type:               gstsv1alpha1.GameStatefulSetConditionAborted,
+			Status:             corev1.ConditionTrue,
+			LastTransitionTime: metav1.Now(),
+			Reason:             "AnalysisFailed",
+			Message:            canaryCtx.abortMessage,
+		})
+	}
+	if canaryCtx.newStatus.Canary.Aborted {
+		canaryCtx.newStatus.CurrentStepIndex = currentStepIndex
+		return ssu.updateStatus(set, canaryCtx.newStatus, pointer.BoolPtr(false))
+	}
+
 	if set.Status.Canary.Revision == "" {
 		if set.Spec.UpdateStrategy.CanaryStrategy == nil {
 			return ssu.updateStatus(set, canaryCtx.newStatus, pointer.BoolPtr(false))
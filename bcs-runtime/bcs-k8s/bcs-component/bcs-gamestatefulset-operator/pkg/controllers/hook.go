@@ -15,6 +15,7 @@ package gamestatefulset
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"strconv"
 	"strings"
@@ -63,6 +64,14 @@ func (ssc *defaultGameStatefulSetControl) reconcileHookRuns(canaryCtx *canaryCon
 		if stepHookRun != nil {
 			newCurrentHookRuns = append(newCurrentHookRuns, stepHookRun)
 		}
+
+		analysisHookRun, err := ssc.reconcileAnalysisHookRun(canaryCtx)
+		if err != nil {
+			return err
+		}
+		if analysisHookRun != nil {
+			newCurrentHookRuns = append(newCurrentHookRuns, analysisHookRun)
+		}
 	}
 
 	canaryCtx.SetCurrentHookRuns(newCurrentHookRuns)
@@ -131,6 +140,12 @@ func (ssc *defaultGameStatefulSetControl) reconcileStepHookRun(canaryCtx *canary
 	step, index := canaryutil.GetCurrentCanaryStep(set)
 	currentHr := commonhookutil.FilterHookRunsByName(currentHrs, set.Status.Canary.CurrentStepHookRun)
 
+	// the steps do not go on once the canary is aborted
+	if canaryCtx.newStatus.Canary.Aborted {
+		err := ssc.cancelHookRuns(canaryCtx, []*hookv1alpha1.HookRun{currentHr})
+		return nil, err
+	}
+
 	if len(set.Status.PauseConditions) > 0 {
 		return currentHr, nil
 	}
@@ -157,6 +172,46 @@ func (ssc *defaultGameStatefulSetControl) reconcileStepHookRun(canaryCtx *canary
 	return currentHr, nil
 }
 
+// reconcileAnalysisHookRun reconcile the background analysis HookRun of canary, aborts the canary if it fails
+func (ssc *defaultGameStatefulSetControl) reconcileAnalysisHookRun(canaryCtx *canaryContext) (*hookv1alpha1.HookRun, error) {
+	set := canaryCtx.set
+	currentHrs := canaryCtx.CurrentHookRuns()
+	currentHr := commonhookutil.FilterHookRunsByName(currentHrs, set.Status.Canary.CurrentAnalysisHookRun)
+
+	// keep the failed HookRun for the aborted canary
+	if canaryCtx.newStatus.Canary.Aborted {
+		return currentHr, nil
+	}
+
+	analysis := set.Spec.UpdateStrategy.CanaryStrategy.Analysis
+	step, _ := canaryutil.GetCurrentCanaryStep(set)
+	if analysis == nil || step == nil || set.Status.Canary.Revision == canaryCtx.newStatus.UpdateRevision {
+		err := ssc.cancelHookRuns(canaryCtx, []*hookv1alpha1.HookRun{currentHr})
+		return nil, err
+	}
+	if currentHr == nil {
+		revision := canaryCtx.newStatus.UpdateRevision
+		currentHr, err := ssc.createHookRun(canaryCtx, analysis, nil, commonhookutil.AnalysisLabels(revision))
+		if err == nil {
+			klog.Infof("Created analysis HookRun %s of GameStatefulSet %s/%s", currentHr.Name, set.Namespace, set.Name)
+		}
+		return currentHr, err
+	}
+
+	switch currentHr.Status.Phase {
+	case hookv1alpha1.HookPhaseInconclusive, hookv1alpha1.HookPhaseError, hookv1alpha1.HookPhaseFailed:
+		message := fmt.Sprintf("analysis HookRun %s is %s", currentHr.Name, currentHr.Status.Phase)
+		if metricResult := commonhookutil.GetFailedMetricResult(currentHr); metricResult != nil {
+			message = fmt.Sprintf("%s, metric %s is %s", message, metricResult.Name, metricResult.Phase)
+			if metricResult.Message != "" {
+				message = fmt.Sprintf("%s: %s", message, metricResult.Message)
+			}
+		}
+		canaryCtx.Abort(message)
+	}
+	return currentHr, nil
+}
+
 // createHookRun create HookRun
 func (ssc *defaultGameStatefulSetControl) createHookRun(canaryCtx *canaryContext, hookStep *hookv1alpha1.HookStep,
 	stepIndex *int32, labels map[string]string) (*hookv1alpha1.HookRun, error) {
@@ -198,6 +253,8 @@ func (ssc *defaultGameStatefulSetControl) newHookRunFromGameStatefulSet(canaryCt
 	nameParts := []string{"canary", revision}
 	if stepIdx != nil {
 		nameParts = append(nameParts, "step"+strconv.Itoa(int(*stepIdx)))
+	} else {
+		nameParts = append(nameParts, "analysis")
 	}
 	nameParts = append(nameParts, hookStep.TemplateName)
 	name := strings.Join(nameParts, "-")
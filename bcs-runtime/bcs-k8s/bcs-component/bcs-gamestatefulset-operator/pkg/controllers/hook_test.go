@@ -264,3 +264,108 @@ func TestNewHookRunFromGameStatefulSet(t *testing.T) {
 		t.Errorf("args error, got: %v", hr.Spec.Args)
 	}
 }
+
+func TestReconcileAnalysisHookRun(t *testing.T) {
+	newSet := func() *gstsv1alpha1.GameStatefulSet {
+		set := testutil.NewGameStatefulSet(1)
+		set.Spec.UpdateStrategy.CanaryStrategy = &gstsv1alpha1.CanaryStrategy{
+			Steps:    []gstsv1alpha1.CanaryStep{{Pause: &gstsv1alpha1.CanaryPause{}}},
+			Analysis: &hookv1alpha1.HookStep{TemplateName: "foo"},
+		}
+		set.Status.Canary.Revision = "1"
+		set.Status.Canary.CurrentAnalysisHookRun = "hr1"
+		set.Status.CurrentStepIndex = func() *int32 { a := int32(0); return &a }()
+		return set
+	}
+	newAnalysisHR := func(phase hookv1alpha1.HookPhase) *hookv1alpha1.HookRun {
+		hr := newHR("hr1", phase, false, commonhookutil.HookRunTypeCanaryAnalysisLabel)
+		hr.Status.MetricResults = []hookv1alpha1.MetricResult{
+			{Name: "m1", Phase: hookv1alpha1.HookPhaseSuccessful},
+			{Name: "m2", Phase: phase, Message: "bad"},
+		}
+		return hr
+	}
+
+	tests := []struct {
+		name           string
+		canaryCtx      *canaryContext
+		expectedAbort  string
+		expectedHr     string
+		expectedAction []testing2.Action
+	}{
+		{
+			name: "analysis is running",
+			canaryCtx: &canaryContext{
+				set:        newSet(),
+				newStatus:  &gstsv1alpha1.GameStatefulSetStatus{UpdateRevision: "2"},
+				currentHrs: []*hookv1alpha1.HookRun{newAnalysisHR(hookv1alpha1.HookPhaseRunning)},
+			},
+			expectedHr: "hr1",
+		},
+		{
+			name: "analysis failed",
+			canaryCtx: &canaryContext{
+				set:        newSet(),
+				newStatus:  &gstsv1alpha1.GameStatefulSetStatus{UpdateRevision: "2"},
+				currentHrs: []*hookv1alpha1.HookRun{newAnalysisHR(hookv1alpha1.HookPhaseFailed)},
+			},
+			expectedAbort: "analysis HookRun hr1 is Failed, metric m2 is Failed: bad",
+			expectedHr:    "hr1",
+		},
+		{
+			name: "already aborted",
+			canaryCtx: &canaryContext{
+				set: newSet(),
+				newStatus: &gstsv1alpha1.GameStatefulSetStatus{UpdateRevision: "2",
+					Canary: gstsv1alpha1.CanaryStatus{Aborted: true}},
+				currentHrs: []*hookv1alpha1.HookRun{newAnalysisHR(hookv1alpha1.HookPhaseFailed)},
+			},
+			expectedHr: "hr1",
+		},
+		{
+			name: "canary completed",
+			canaryCtx: &canaryContext{
+				set: func() *gstsv1alpha1.GameStatefulSet {
+					set := newSet()
+					set.Status.CurrentStepIndex = func() *int32 { a := int32(1); return &a }()
+					return set
+				}(),
+				newStatus:  &gstsv1alpha1.GameStatefulSetStatus{UpdateRevision: "2"},
+				currentHrs: []*hookv1alpha1.HookRun{newAnalysisHR(hookv1alpha1.HookPhaseRunning)},
+			},
+			expectedAction: []testing2.Action{
+				expectPatchHookRunAction("default", "hr1", nil),
+			},
+		},
+	}
+
+	for _, s := range tests {
+		t.Run(s.name, func(t *testing.T) {
+			hookClient := hookFake.NewSimpleClientset()
+			hookInformer := hookInformers.NewSharedInformerFactory(hookClient, controller.NoResyncPeriodFunc())
+			ssc := &defaultGameStatefulSetControl{
+				hookRunLister:      hookInformer.Tkex().V1alpha1().HookRuns().Lister(),
+				hookTemplateLister: hookInformer.Tkex().V1alpha1().HookTemplates().Lister(),
+				hookClient:         hookClient,
+			}
+
+			hr, err := ssc.reconcileAnalysisHookRun(s.canaryCtx)
+			if err != nil {
+				t.Fatalf("got error: %v", err)
+			}
+			hrName := ""
+			if hr != nil {
+				hrName = hr.Name
+			}
+			if hrName != s.expectedHr {
+				t.Errorf("expected hookrun: %s, but got: %s", s.expectedHr, hrName)
+			}
+			if s.canaryCtx.abortMessage != s.expectedAbort {
+				t.Errorf("expected abort message: %s, but got: %s", s.expectedAbort, s.canaryCtx.abortMessage)
+			}
+			if !testutil.EqualActions(s.expectedAction, testutil.FilterActions(hookClient.Actions(), testutil.FilterPatchAction)) {
+				t.Errorf("expected actions: %v, but got: %v", s.expectedAction, hookClient.Actions())
+			}
+		})
+	}
+}
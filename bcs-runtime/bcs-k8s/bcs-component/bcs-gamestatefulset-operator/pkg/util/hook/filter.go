@@ -21,7 +21,7 @@ import (
 
 func FilterCurrentHookRuns(hookRuns []*hookv1alpha1.HookRun, set *gstsv1alpha1.GameStatefulSet) ([]*hookv1alpha1.HookRun, []*hookv1alpha1.HookRun) {
 	return commonhookutil.FilterHookRuns(hookRuns, func(hr *hookv1alpha1.HookRun) bool {
-		if hr.Name == set.Status.Canary.CurrentStepHookRun {
+		if hr.Name == set.Status.Canary.CurrentStepHookRun || hr.Name == set.Status.Canary.CurrentAnalysisHookRun {
 			return true
 		}
 		return false
@@ -122,6 +122,8 @@ func main() {
 	)
 
 	controller := scaler.NewGeneralController(
+		client.CoreV1(),
+		client.CoreV1(),
 		client.CoreV1(),
 		scaleClient,
 		gpaClient.AutoscalingV1alpha1(),
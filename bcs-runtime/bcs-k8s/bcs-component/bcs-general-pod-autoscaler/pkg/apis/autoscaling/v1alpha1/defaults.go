@@ -23,6 +23,18 @@ func addDefaultingFuncs(scheme *runtime.Scheme) error {
 
 // SetDefaults_GeneralPodAutoscaler sets default values
 func SetDefaults_GeneralPodAutoscaler(obj *GeneralPodAutoscaler) {
+	if obj.Spec.PredictiveMode != nil {
+		if obj.Spec.PredictiveMode.ForecastWindowMinutes == 0 {
+			obj.Spec.PredictiveMode.ForecastWindowMinutes = 10
+		}
+		if obj.Spec.PredictiveMode.HistoryDays == 0 {
+			obj.Spec.PredictiveMode.HistoryDays = 7
+		}
+		if obj.Spec.PredictiveMode.MinConfidence == 0 {
+			obj.Spec.PredictiveMode.MinConfidence = 60
+		}
+	}
+
 	if obj.Spec.Behavior == nil {
 		obj.Spec.Behavior = new(GeneralPodAutoscalerBehavior)
 	}
@@ -102,6 +102,11 @@ type AutoScalingDrivenMode struct {
 	// EventMode is the event driven mode
 	// +optional
 	EventMode *EventMode `json:"event,omitempty" protobuf:"bytes,4,opt,name=event"`
+
+	// PredictiveMode is the predictive mode, it learns the daily load curve from metric mode
+	// and scales ahead of it, metric mode must be set when using it
+	// +optional
+	PredictiveMode *PredictiveMode `json:"predictive,omitempty" protobuf:"bytes,5,opt,name=predictive"`
 }
 
 // MetricMode metric mode 指标模式
@@ -133,6 +138,36 @@ type ScaleTriggers struct {
 	Name string `json:"name,omitempty"`
 	// Metadata contains the trigger config
 	Metadata map[string]string `json:"metadata"`
+	// SecretTargetRef sets the sensitive trigger parameters from secrets in the namespace of the gpa,
+	// they take precedence over the same parameters in metadata
+	// +optional
+	SecretTargetRef []SecretTargetRef `json:"secretTargetRef,omitempty"`
+}
+
+// SecretTargetRef references a key of a secret as a trigger parameter
+type SecretTargetRef struct {
+	// Parameter is the trigger parameter to set, e.g. password of redis trigger
+	Parameter string `json:"parameter"`
+	// Name is the name of the secret
+	Name string `json:"name"`
+	// Key is the key of the secret data
+	Key string `json:"key"`
+}
+
+// PredictiveMode is the history based predictive mode
+type PredictiveMode struct {
+	// ForecastWindowMinutes is how many minutes ahead the replicas are forecasted, defaults to 10
+	// +optional
+	ForecastWindowMinutes int32 `json:"forecastWindowMinutes,omitempty" protobuf:"varint,1,opt,name=forecastWindowMinutes"`
+	// HistoryDays is how many days of history are kept to forecast, defaults to 7
+	// +optional
+	HistoryDays int32 `json:"historyDays,omitempty" protobuf:"varint,2,opt,name=historyDays"`
+	// MinConfidence is the minimum confidence in percent required to use the forecast, defaults to 60
+	// +optional
+	MinConfidence int32 `json:"minConfidence,omitempty" protobuf:"varint,3,opt,name=minConfidence"`
+	// DryRun only records the forecast in status without affecting scaling
+	// +optional
+	DryRun bool `json:"dryRun,omitempty" protobuf:"varint,4,opt,name=dryRun"`
 }
 
 // WebhookMode allow users to provider a server
@@ -452,6 +487,48 @@ type GeneralPodAutoscalerStatus struct {
 	// LastCronScheduleTime is the schedule time of time mode
 	// +optional
 	LastCronScheduleTime *metav1.Time `json:"lastCronScheduleTime,omitempty" protobuf:"bytes,7,rep,name=lastCronScheduleTime"`
+
+	// TriggerStatuses is the last read state of the triggers of event mode
+	// +optional
+	TriggerStatuses []TriggerStatus `json:"triggerStatuses,omitempty" protobuf:"bytes,8,rep,name=triggerStatuses"`
+
+	// Forecast is the last forecast of predictive mode
+	// +optional
+	Forecast *ForecastStatus `json:"forecast,omitempty" protobuf:"bytes,9,opt,name=forecast"`
+}
+
+// ForecastStatus describes the last forecast of predictive mode.
+type ForecastStatus struct {
+	// ForecastTime is the time the replicas are forecasted for
+	ForecastTime metav1.Time `json:"forecastTime" protobuf:"bytes,1,name=forecastTime"`
+	// ForecastReplicas is the forecasted replicas, -1 means there is no history for the time
+	ForecastReplicas int32 `json:"forecastReplicas" protobuf:"varint,2,name=forecastReplicas"`
+	// Confidence is the confidence of the forecast in percent
+	Confidence int32 `json:"confidence" protobuf:"varint,3,name=confidence"`
+	// HistoryDays is the number of days having samples for the forecast time
+	HistoryDays int32 `json:"historyDays" protobuf:"varint,4,name=historyDays"`
+}
+
+// TriggerStatus describes the last-read state of a single event trigger.
+type TriggerStatus struct {
+	// Name is the trigger name, it is the trigger type if name is not set
+	Name string `json:"name" protobuf:"bytes,1,name=name"`
+	// Type is the trigger type
+	Type string `json:"type" protobuf:"bytes,2,name=type"`
+	// CurrentValue is the last value read from the trigger source
+	// +optional
+	CurrentValue int64 `json:"currentValue,omitempty" protobuf:"varint,3,opt,name=currentValue"`
+	// TargetValue is the value per replica configured in trigger metadata
+	// +optional
+	TargetValue int64 `json:"targetValue,omitempty" protobuf:"varint,4,opt,name=targetValue"`
+	// DesiredReplicas is the replicas recommended by the trigger, -1 means no recommendation
+	DesiredReplicas int32 `json:"desiredReplicas" protobuf:"varint,5,name=desiredReplicas"`
+	// LastProbeTime is the last time the trigger source was read
+	// +optional
+	LastProbeTime *metav1.Time `json:"lastProbeTime,omitempty" protobuf:"bytes,6,opt,name=lastProbeTime"`
+	// Message is the error message of the last read if it failed
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,7,opt,name=message"`
 }
 
 // GeneralPodAutoscalerConditionType are the valid conditions of
@@ -46,6 +46,11 @@ func (in *AutoScalingDrivenMode) DeepCopyInto(out *AutoScalingDrivenMode) {
 		*out = new(EventMode)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PredictiveMode != nil {
+		in, out := &in.PredictiveMode, &out.PredictiveMode
+		*out = new(PredictiveMode)
+		**out = **in
+	}
 	return
 }
 
@@ -168,6 +173,23 @@ func (in *ExternalMetricStatus) DeepCopy() *ExternalMetricStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ForecastStatus) DeepCopyInto(out *ForecastStatus) {
+	*out = *in
+	in.ForecastTime.DeepCopyInto(&out.ForecastTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ForecastStatus.
+func (in *ForecastStatus) DeepCopy() *ForecastStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ForecastStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GPAScalingPolicy) DeepCopyInto(out *GPAScalingPolicy) {
 	*out = *in
@@ -377,6 +399,18 @@ func (in *GeneralPodAutoscalerStatus) DeepCopyInto(out *GeneralPodAutoscalerStat
 		in, out := &in.LastCronScheduleTime, &out.LastCronScheduleTime
 		*out = (*in).DeepCopy()
 	}
+	if in.TriggerStatuses != nil {
+		in, out := &in.TriggerStatuses, &out.TriggerStatuses
+		*out = make([]TriggerStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Forecast != nil {
+		in, out := &in.Forecast, &out.Forecast
+		*out = new(ForecastStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -652,6 +686,22 @@ func (in *PodsMetricStatus) DeepCopy() *PodsMetricStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PredictiveMode) DeepCopyInto(out *PredictiveMode) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PredictiveMode.
+func (in *PredictiveMode) DeepCopy() *PredictiveMode {
+	if in == nil {
+		return nil
+	}
+	out := new(PredictiveMode)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceMetricSource) DeepCopyInto(out *ResourceMetricSource) {
 	*out = *in
@@ -696,6 +746,11 @@ func (in *ScaleTriggers) DeepCopyInto(out *ScaleTriggers) {
 			(*out)[key] = val
 		}
 	}
+	if in.SecretTargetRef != nil {
+		in, out := &in.SecretTargetRef, &out.SecretTargetRef
+		*out = make([]SecretTargetRef, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -709,6 +764,22 @@ func (in *ScaleTriggers) DeepCopy() *ScaleTriggers {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretTargetRef) DeepCopyInto(out *SecretTargetRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretTargetRef.
+func (in *SecretTargetRef) DeepCopy() *SecretTargetRef {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretTargetRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TimeMode) DeepCopyInto(out *TimeMode) {
 	*out = *in
@@ -746,6 +817,26 @@ func (in *TimeRange) DeepCopy() *TimeRange {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TriggerStatus) DeepCopyInto(out *TriggerStatus) {
+	*out = *in
+	if in.LastProbeTime != nil {
+		in, out := &in.LastProbeTime, &out.LastProbeTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TriggerStatus.
+func (in *TriggerStatus) DeepCopy() *TriggerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TriggerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WebhookMode) DeepCopyInto(out *WebhookMode) {
 	*out = *in
@@ -0,0 +1,108 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under,
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package scaler
+
+import (
+	"sync"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	v1core "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/klog"
+
+	autoscaling "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-general-pod-autoscaler/pkg/apis/autoscaling/v1alpha1"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-general-pod-autoscaler/pkg/scalercore"
+)
+
+// runningScaler is a long run scaler with the channel to stop it
+type runningScaler struct {
+	scaler     *scalercore.EventScaler
+	authParams []map[string]string
+	stopCh     chan struct{}
+}
+
+// eventScalerManager keeps one running event scaler for each gpa
+type eventScalerManager struct {
+	sync.Mutex
+	secretNamespacer v1core.SecretsGetter
+	scalers          map[string]*runningScaler
+}
+
+func newEventScalerManager(secretNamespacer v1core.SecretsGetter) *eventScalerManager {
+	return &eventScalerManager{
+		secretNamespacer: secretNamespacer,
+		scalers:          map[string]*runningScaler{},
+	}
+}
+
+// get returns the running event scaler of the gpa, the scaler would be restarted if triggers
+// or the secrets referenced by triggers are changed
+func (m *eventScalerManager) get(key string, gpa *autoscaling.GeneralPodAutoscaler) (*scalercore.EventScaler, error) {
+	authParams, err := m.resolveAuthParams(gpa)
+	if err != nil {
+		return nil, err
+	}
+	m.Lock()
+	defer m.Unlock()
+	if running, ok := m.scalers[key]; ok {
+		if apiequality.Semantic.DeepEqual(running.scaler.Triggers(), gpa.Spec.EventMode.Triggers) &&
+			apiequality.Semantic.DeepEqual(running.authParams, authParams) {
+			return running.scaler, nil
+		}
+		klog.Infof("Triggers of gpa %s changed, restart event scaler", key)
+		close(running.stopCh)
+		delete(m.scalers, key)
+	}
+	running := &runningScaler{
+		scaler:     scalercore.NewEventScaler(gpa, authParams),
+		authParams: authParams,
+		stopCh:     make(chan struct{}),
+	}
+	if err := running.scaler.Run(running.stopCh); err != nil {
+		return nil, err
+	}
+	m.scalers[key] = running
+	return running.scaler, nil
+}
+
+// resolveAuthParams reads the parameters referenced by secretTargetRef of every trigger
+func (m *eventScalerManager) resolveAuthParams(gpa *autoscaling.GeneralPodAutoscaler) ([]map[string]string, error) {
+	authParams := make([]map[string]string, 0, len(gpa.Spec.EventMode.Triggers))
+	for _, trigger := range gpa.Spec.EventMode.Triggers {
+		params, err := scalercore.ResolveAuthParams(m.secretNamespacer, gpa.Namespace, trigger)
+		if err != nil {
+			return nil, err
+		}
+		authParams = append(authParams, params)
+	}
+	return authParams, nil
+}
+
+// stop stops the running event scaler of the gpa if exists
+func (m *eventScalerManager) stop(key string) {
+	m.Lock()
+	defer m.Unlock()
+	if running, ok := m.scalers[key]; ok {
+		close(running.stopCh)
+		delete(m.scalers, key)
+	}
+}
+
+// stopAll stops all running event scalers
+func (m *eventScalerManager) stopAll() {
+	m.Lock()
+	defer m.Unlock()
+	for key, running := range m.scalers {
+		close(running.stopCh)
+		delete(m.scalers, key)
+	}
+}
@@ -105,6 +105,12 @@ type GeneralController struct {
 
 	doingCron sync.Map
 
+	// Long running scalers of event mode for each autoscaler
+	eventScalers *eventScalerManager
+
+	// Seasonal history of predictive mode for each autoscaler
+	histories *predictiveHistories
+
 	// Multi goroutines for autoscaler
 	workers int
 }
@@ -112,6 +118,8 @@ type GeneralController struct {
 // NewGeneralController creates a new GeneralController.
 func NewGeneralController(
 	evtNamespacer v1core.EventsGetter,
+	cmNamespacer v1core.ConfigMapsGetter,
+	secretNamespacer v1core.SecretsGetter,
 	scaleNamespacer scaleclient.ScalesGetter,
 	gpaNamespacer autoscalingclient.GeneralPodAutoscalersGetter,
 	mapper apimeta.RESTMapper,
@@ -143,6 +151,8 @@ func NewGeneralController(
 		recommendations: map[string][]timestampedRecommendation{},
 		scaleUpEvents:   map[string][]timestampedScaleEvent{},
 		scaleDownEvents: map[string][]timestampedScaleEvent{},
+		eventScalers:    newEventScalerManager(secretNamespacer),
+		histories:       newPredictiveHistories(cmNamespacer),
 		workers:         workers,
 	}
 
@@ -176,6 +186,7 @@ func NewGeneralController(
 func (a *GeneralController) Run(stopCh <-chan struct{}) {
 	defer utilruntime.HandleCrash()
 	defer a.queue.ShutDown()
+	defer a.eventScalers.stopAll()
 
 	klog.Infof("Starting GPA controller")
 	defer klog.Infof("Shutting down GPA controller")
@@ -373,7 +384,14 @@ func (a *GeneralController) computeReplicasForSimple(gpa *autoscaling.GeneralPod
 
 	currentReplicas := scale.Spec.Replicas
 
-	replicaCountProposal, modeNameProposal, err := computeDesiredSize(gpa, a.buildScalerChain(gpa), currentReplicas)
+	scalerChain, err := a.buildScalerChain(gpa)
+	if err != nil {
+		a.eventRecorder.Event(gpa, v1.EventTypeWarning, "FailedBuildScaler", err.Error())
+		setCondition(gpa, autoscaling.ScalingActive, v1.ConditionFalse, "FailedBuildScaler",
+			"the GPA controller was unable to build scalers: %v", err)
+		return -1, "", nil, time.Time{}, err
+	}
+	replicaCountProposal, modeNameProposal, err := computeDesiredSize(gpa, scalerChain, currentReplicas)
 	if err != nil {
 		setCondition(gpa, autoscaling.ScalingActive, v1.ConditionFalse, fmt.Sprintf("%v failed", modeNameProposal),
 			fmt.Sprintf("%v failed: %v",
@@ -394,7 +412,7 @@ func (a *GeneralController) computeReplicasForSimple(gpa *autoscaling.GeneralPod
 }
 
 // buildScalerChain build scaler chain for gpa scaler
-func (a *GeneralController) buildScalerChain(gpa *autoscaling.GeneralPodAutoscaler) []scalercore.Scaler {
+func (a *GeneralController) buildScalerChain(gpa *autoscaling.GeneralPodAutoscaler) ([]scalercore.Scaler, error) {
 	var scalerChain []scalercore.Scaler
 	if gpa.Spec.WebhookMode != nil {
 		scalerChain = append(scalerChain, scalercore.NewWebhookScaler(gpa.Spec.WebhookMode))
@@ -402,7 +420,32 @@ func (a *GeneralController) buildScalerChain(gpa *autoscaling.GeneralPodAutoscal
 	if gpa.Spec.TimeMode != nil {
 		scalerChain = append(scalerChain, scalercore.NewCronScaler(gpa.Spec.TimeMode.TimeRanges))
 	}
-	return scalerChain
+	key := fmt.Sprintf("%s/%s", gpa.Namespace, gpa.Name)
+	if gpa.Spec.PredictiveMode != nil {
+		predictiveScaler := scalercore.NewPredictiveScaler(gpa.Spec.PredictiveMode,
+			a.histories.get(key, gpa).SeasonalHistory, time.Now())
+		gpa.Status.Forecast = predictiveScaler.Forecast()
+		scalerChain = append(scalerChain, predictiveScaler)
+	} else {
+		a.histories.delete(key)
+		// the forecast in status means predictive mode was used before
+		if gpa.Status.Forecast != nil {
+			a.histories.deletePersisted(gpa)
+		}
+		gpa.Status.Forecast = nil
+	}
+	if gpa.Spec.EventMode == nil {
+		a.eventScalers.stop(key)
+		gpa.Status.TriggerStatuses = nil
+		return scalerChain, nil
+	}
+	eventScaler, err := a.eventScalers.get(key, gpa)
+	if err != nil {
+		return nil, err
+	}
+	gpa.Status.TriggerStatuses = eventScaler.TriggerStatuses()
+	scalerChain = append(scalerChain, eventScaler)
+	return scalerChain, nil
 }
 
 // computeStatusForResourceMG 原方法名 computeStatusForResourceMetricGeneric
@@ -575,6 +618,8 @@ func (a *GeneralController) reconcileKey(key string) (deleted bool, err error) {
 		delete(a.recommendations, key)
 		delete(a.scaleUpEvents, key)
 		delete(a.scaleDownEvents, key)
+		a.eventScalers.stop(key)
+		a.histories.delete(key)
 		return true, nil
 	}
 	if err != nil {
@@ -1040,6 +1085,7 @@ func (a *GeneralController) reconcileAutoscaler(gpa *autoscaling.GeneralPodAutos
 			}
 			klog.V(4).Infof("Metric-Mode: proposing %v desired replicas (based on %s from %s) for %s",
 				metricDesiredReplicas, metricName, metricTimestamp, reference)
+			a.histories.record(key, gpa, time.Now(), metricDesiredReplicas)
 		}
 
 		// get replicas from time/webhook mode
@@ -1650,6 +1696,8 @@ func (a *GeneralController) setStatus(gpa *autoscaling.GeneralPodAutoscaler, cur
 		LastScaleTime:   gpa.Status.LastScaleTime,
 		CurrentMetrics:  metricStatuses,
 		Conditions:      gpa.Status.Conditions,
+		TriggerStatuses: gpa.Status.TriggerStatuses,
+		Forecast:        gpa.Status.Forecast,
 	}
 	now := metav1.NewTime(time.Now())
 	if rescale {
@@ -1755,7 +1803,8 @@ func min(a, b int32) int32 {
 }
 
 func isEmpty(a autoscaling.AutoScalingDrivenMode) bool {
-	return a.MetricMode == nil && a.EventMode == nil && a.TimeMode == nil && a.WebhookMode == nil
+	return a.MetricMode == nil && a.EventMode == nil && a.TimeMode == nil && a.WebhookMode == nil &&
+		a.PredictiveMode == nil
 }
 
 func isComputeByLimits(gpa *autoscaling.GeneralPodAutoscaler) bool {
@@ -681,6 +681,8 @@ func (tc *testCase) setupController(t *testing.T) (*GeneralController, informers
 	defaultDownscalestabilizationWindow := 5 * time.Minute
 	gpaController := NewGeneralController(
 		eventClient.CoreV1(),
+		testClient.CoreV1(),
+		testClient.CoreV1(),
 		testScaleClient,
 		testGPAClient.AutoscalingV1alpha1(),
 		testrestmapper.TestOnlyStaticRESTMapper(testScheme()),
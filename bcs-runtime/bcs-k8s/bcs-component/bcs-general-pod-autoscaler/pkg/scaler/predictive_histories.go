@@ -0,0 +1,200 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under,
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package scaler
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1core "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/klog"
+
+	autoscaling "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-general-pod-autoscaler/pkg/apis/autoscaling/v1alpha1"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-general-pod-autoscaler/pkg/scalercore"
+)
+
+const (
+	// historyConfigMapSuffix is the suffix of the name of the configmap persisting the history of a gpa
+	historyConfigMapSuffix = "-predictive-history"
+	// historyConfigMapKey is the key of the samples in the configmap
+	historyConfigMapKey = "samples"
+	// historyGPALabel is the label of the configmap referring to the name of the gpa
+	historyGPALabel = "autoscaling.bkbcs.tencent.com/gpa"
+	// historySaveInterval is the min interval between two saves of the history of a gpa
+	historySaveInterval = 5 * time.Minute
+)
+
+// predictiveHistory is the seasonal history of a gpa with the time it was saved
+type predictiveHistory struct {
+	*scalercore.SeasonalHistory
+	lastSaved time.Time
+}
+
+// predictiveHistories keeps the seasonal history of each gpa using predictive mode,
+// the history is persisted in a configmap owned by the gpa so that it survives restarts and leader changes
+type predictiveHistories struct {
+	sync.Mutex
+	cmNamespacer v1core.ConfigMapsGetter
+	histories    map[string]*predictiveHistory
+}
+
+func newPredictiveHistories(cmNamespacer v1core.ConfigMapsGetter) *predictiveHistories {
+	return &predictiveHistories{
+		cmNamespacer: cmNamespacer,
+		histories:    map[string]*predictiveHistory{},
+	}
+}
+
+func historyConfigMapName(gpaName string) string {
+	return gpaName + historyConfigMapSuffix
+}
+
+// get returns the history of the gpa, loads it from the configmap if it is not in memory
+func (p *predictiveHistories) get(key string, gpa *autoscaling.GeneralPodAutoscaler) *predictiveHistory {
+	mode := gpa.Spec.PredictiveMode
+	p.Lock()
+	history, ok := p.histories[key]
+	p.Unlock()
+	if ok {
+		history.SetDays(mode.HistoryDays)
+		return history
+	}
+
+	// load out of lock, the api call should not block other gpas
+	loaded := &predictiveHistory{SeasonalHistory: scalercore.NewSeasonalHistory(mode.HistoryDays)}
+	samples, err := p.load(gpa)
+	if err != nil {
+		klog.Warningf("Load predictive history of gpa %s failed, start with empty history: %v", key, err)
+	} else if samples != nil {
+		loaded.Restore(samples)
+		klog.Infof("Loaded predictive history of gpa %s with %d days", key, len(samples))
+	}
+
+	p.Lock()
+	defer p.Unlock()
+	if history, ok = p.histories[key]; ok {
+		return history
+	}
+	p.histories[key] = loaded
+	return loaded
+}
+
+// record records the replicas proposed by metric mode as a sample of the gpa,
+// and saves the history if it has not been saved for historySaveInterval
+func (p *predictiveHistories) record(key string, gpa *autoscaling.GeneralPodAutoscaler, t time.Time,
+	replicas int32) {
+	if gpa.Spec.PredictiveMode == nil || replicas < 0 {
+		return
+	}
+	history := p.get(key, gpa)
+	history.Record(t, replicas)
+
+	p.Lock()
+	if t.Sub(history.lastSaved) < historySaveInterval {
+		p.Unlock()
+		return
+	}
+	history.lastSaved = t
+	p.Unlock()
+
+	if err := p.save(gpa, history.Samples()); err != nil {
+		klog.Errorf("Save predictive history of gpa %s failed: %v", key, err)
+		// retry on next record
+		p.Lock()
+		history.lastSaved = time.Time{}
+		p.Unlock()
+	}
+}
+
+// delete drops the history of the gpa in memory, the persisted one of a deleted gpa is
+// garbage collected with the gpa
+func (p *predictiveHistories) delete(key string) {
+	p.Lock()
+	defer p.Unlock()
+	delete(p.histories, key)
+}
+
+// deletePersisted drops the persisted history of the gpa which does not use predictive mode any more
+func (p *predictiveHistories) deletePersisted(gpa *autoscaling.GeneralPodAutoscaler) {
+	err := p.cmNamespacer.ConfigMaps(gpa.Namespace).Delete(historyConfigMapName(gpa.Name), &metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		klog.Errorf("Delete predictive history of gpa %s/%s failed: %v", gpa.Namespace, gpa.Name, err)
+	}
+}
+
+// load reads the samples from the configmap of the gpa, returns nil if it does not exist
+func (p *predictiveHistories) load(gpa *autoscaling.GeneralPodAutoscaler) (map[int64][]int32, error) {
+	cm, err := p.cmNamespacer.ConfigMaps(gpa.Namespace).Get(historyConfigMapName(gpa.Name), metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	// the configmap of a deleted gpa with the same name is not trusted
+	if !metav1.IsControlledBy(cm, gpa) {
+		return nil, fmt.Errorf("configmap %s/%s is not owned by the gpa", cm.Namespace, cm.Name)
+	}
+	data, ok := cm.Data[historyConfigMapKey]
+	if !ok {
+		return nil, nil
+	}
+	encoded := map[string][]int32{}
+	if err = json.Unmarshal([]byte(data), &encoded); err != nil {
+		return nil, err
+	}
+	samples := make(map[int64][]int32, len(encoded))
+	for day, buckets := range encoded {
+		d, err := strconv.ParseInt(day, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid day %q: %v", day, err)
+		}
+		samples[d] = buckets
+	}
+	return samples, nil
+}
+
+// save writes the samples to the configmap of the gpa, creates the configmap if it does not exist
+func (p *predictiveHistories) save(gpa *autoscaling.GeneralPodAutoscaler, samples map[int64][]int32) error {
+	encoded := make(map[string][]int32, len(samples))
+	for day, buckets := range samples {
+		encoded[strconv.FormatInt(day, 10)] = buckets
+	}
+	data, err := json.Marshal(encoded)
+	if err != nil {
+		return err
+	}
+	cm := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      historyConfigMapName(gpa.Name),
+			Namespace: gpa.Namespace,
+			Labels:    map[string]string{historyGPALabel: gpa.Name},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(gpa, autoscaling.SchemeGroupVersion.WithKind("GeneralPodAutoscaler")),
+			},
+		},
+		Data: map[string]string{historyConfigMapKey: string(data)},
+	}
+	// the controller is the only writer of the configmap, so it is updated unconditionally
+	_, err = p.cmNamespacer.ConfigMaps(gpa.Namespace).Update(cm)
+	if errors.IsNotFound(err) {
+		_, err = p.cmNamespacer.ConfigMaps(gpa.Namespace).Create(cm)
+	}
+	return err
+}
@@ -0,0 +1,61 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under,
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package scaler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	autoscaling "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-general-pod-autoscaler/pkg/apis/autoscaling/v1alpha1"
+)
+
+func TestPredictiveHistoriesPersist(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	gpa := &autoscaling.GeneralPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-gpa", Namespace: "test-namespace", UID: "test-uid"},
+		Spec: autoscaling.GeneralPodAutoscalerSpec{
+			AutoScalingDrivenMode: autoscaling.AutoScalingDrivenMode{
+				PredictiveMode: &autoscaling.PredictiveMode{ForecastWindowMinutes: 10, HistoryDays: 7},
+			},
+		},
+	}
+	key := "test-namespace/test-gpa"
+	now, _ := time.Parse("2006-01-02 15:04:05", "2020-12-18 09:00:00")
+
+	histories := newPredictiveHistories(client.CoreV1())
+	// samples are saved at most once every historySaveInterval
+	histories.record(key, gpa, now.Add(-24*time.Hour), 10)
+	_, err := client.CoreV1().ConfigMaps(gpa.Namespace).Get(historyConfigMapName(gpa.Name), metav1.GetOptions{})
+	assert.Nil(t, err)
+	histories.record(key, gpa, now.Add(-24*time.Hour+time.Minute), 12)
+
+	// the history of a restarted controller is loaded from the configmap
+	restarted := newPredictiveHistories(client.CoreV1())
+	replicas, _, days := restarted.get(key, gpa).Forecast(now)
+	assert.Equal(t, int32(10), replicas)
+	assert.Equal(t, int32(1), days)
+
+	// the configmap of another gpa with the same name is ignored
+	recreated := gpa.DeepCopy()
+	recreated.UID = "another-uid"
+	replicas, _, _ = newPredictiveHistories(client.CoreV1()).get(key, recreated).Forecast(now)
+	assert.Equal(t, int32(-1), replicas)
+
+	restarted.deletePersisted(gpa)
+	_, err = client.CoreV1().ConfigMaps(gpa.Namespace).Get(historyConfigMapName(gpa.Name), metav1.GetOptions{})
+	assert.NotNil(t, err)
+}
@@ -12,32 +12,187 @@
 
 package scalercore
 
-import autoscalingv1 "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-general-pod-autoscaler/pkg/apis/autoscaling/v1alpha1"
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
 
-var _ Scaler = &EventScaler{}
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog"
 
-// EventScaler event scaler
+	autoscalingv1 "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-general-pod-autoscaler/pkg/apis/autoscaling/v1alpha1"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-general-pod-autoscaler/pkg/metrics"
+)
+
+var _ LongRunScaler = &EventScaler{}
+
+// EventScaler event scaler, every trigger is polled in background and
+// GetReplicas returns the max replicas recommended by the triggers
 type EventScaler struct {
-	schedule string
-	name     string
+	name      string
+	namespace string
+	gpaName   string
+	key       string
+	triggers  []autoscalingv1.ScaleTriggers
+	// authParams are the parameters resolved from secrets of each trigger
+	authParams []map[string]string
+
+	mu       sync.RWMutex
+	statuses []autoscalingv1.TriggerStatus
 }
 
-// NewEventScaler new event scaler
-func NewEventScaler(schedule string) Scaler {
-	return &EventScaler{schedule: schedule, name: Event}
+// NewEventScaler new event scaler, authParams are the parameters resolved by ResolveAuthParams
+// for each trigger
+func NewEventScaler(gpa *autoscalingv1.GeneralPodAutoscaler, authParams []map[string]string) *EventScaler {
+	s := &EventScaler{
+		name:       Event,
+		namespace:  gpa.Namespace,
+		gpaName:    gpa.Name,
+		key:        gpa.Spec.ScaleTargetRef.Kind + "/" + gpa.Spec.ScaleTargetRef.Name,
+		authParams: authParams,
+	}
+	if gpa.Spec.EventMode != nil {
+		for _, t := range gpa.Spec.EventMode.Triggers {
+			s.triggers = append(s.triggers, *t.DeepCopy())
+		}
+	}
+	return s
 }
 
-// Run  run
+// Run builds all triggers and polls them until stopCh is closed
 func (e *EventScaler) Run(stopCh <-chan struct{}) error {
+	configs := make([]*triggerConfig, 0, len(e.triggers))
+	scalers := make([]triggerScaler, 0, len(e.triggers))
+	for i, t := range e.triggers {
+		var authParams map[string]string
+		if i < len(e.authParams) {
+			authParams = e.authParams[i]
+		}
+		config, err := parseTriggerConfig(t, authParams)
+		if err == nil {
+			var ts triggerScaler
+			if ts, err = newTriggerScaler(config); err == nil {
+				configs = append(configs, config)
+				scalers = append(scalers, ts)
+				continue
+			}
+		}
+		for _, ts := range scalers {
+			_ = ts.Close()
+		}
+		return err
+	}
+
+	e.mu.Lock()
+	e.statuses = make([]autoscalingv1.TriggerStatus, len(configs))
+	for i, config := range configs {
+		e.statuses[i] = autoscalingv1.TriggerStatus{
+			Name:            config.name,
+			Type:            config.triggerType,
+			TargetValue:     config.targetValue,
+			DesiredReplicas: -1,
+		}
+	}
+	e.mu.Unlock()
+
+	for i := range scalers {
+		go func(index int) {
+			defer func() {
+				if err := scalers[index].Close(); err != nil {
+					klog.Warningf("Close trigger %s of gpa %s/%s failed: %v",
+						configs[index].name, e.namespace, e.gpaName, err)
+				}
+			}()
+			wait.Until(func() {
+				e.probe(index, configs[index], scalers[index])
+			}, configs[index].pollingInterval, stopCh)
+		}(i)
+	}
 	return nil
 }
 
-//GetReplicas get replicas
-func (e *EventScaler) GetReplicas(*autoscalingv1.GeneralPodAutoscaler, int32) (int32, error) {
-	return 0, nil
+// probe reads the trigger once and records its status
+func (e *EventScaler) probe(index int, config *triggerConfig, ts triggerScaler) {
+	var metricsServer metrics.PrometheusMetricServer
+	startTime := time.Now()
+	ctx, cancel := context.WithTimeout(context.Background(), config.timeout)
+	defer cancel()
+	value, err := ts.GetMetricValue(ctx)
+	now := metav1.Now()
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	status := &e.statuses[index]
+	status.LastProbeTime = &now
+	if err != nil {
+		klog.Errorf("Get value of trigger %s for gpa %s/%s failed: %v", config.name, e.namespace, e.gpaName, err)
+		status.Message = err.Error()
+		status.DesiredReplicas = -1
+		metricsServer.RecordGPAScalerError(e.namespace, e.gpaName, e.key, "event", config.name, true)
+		metricsServer.RecordScalerExecDuration(e.namespace, e.gpaName, e.key, config.name, "event",
+			"failure", time.Since(startTime))
+		return
+	}
+	status.Message = ""
+	status.CurrentValue = value
+	status.DesiredReplicas = computeTriggerReplicas(value, config.targetValue)
+	klog.V(6).Infof("Trigger %s of gpa %s/%s: value %d, target %d, desired %d",
+		config.name, e.namespace, e.gpaName, value, config.targetValue, status.DesiredReplicas)
+	metricsServer.RecordGPAScalerError(e.namespace, e.gpaName, e.key, "event", config.name, false)
+	metricsServer.RecordGPAScalerMetric(e.namespace, e.gpaName, e.key, "event", config.name,
+		config.targetValue, value)
+	metricsServer.RecordScalerExecDuration(e.namespace, e.gpaName, e.key, config.name, "event",
+		"success", time.Since(startTime))
+}
+
+// GetReplicas get replicas, returns -1 if no trigger has been read yet
+func (e *EventScaler) GetReplicas(gpa *autoscalingv1.GeneralPodAutoscaler, currentReplicas int32) (int32, error) {
+	var metricsServer metrics.PrometheusMetricServer
+	var max int32 = -1
+	var failed []string
+	e.mu.RLock()
+	for _, status := range e.statuses {
+		if status.Message != "" {
+			failed = append(failed, fmt.Sprintf("%s: %s", status.Name, status.Message))
+			continue
+		}
+		if status.DesiredReplicas > max {
+			max = status.DesiredReplicas
+		}
+	}
+	total := len(e.statuses)
+	e.mu.RUnlock()
+	if total > 0 && len(failed) == total {
+		return -1, fmt.Errorf("all triggers failed: %v", failed)
+	}
+	klog.V(4).Infof("Event mode of gpa %s/%s recommend %d replicas, current: %d",
+		e.namespace, e.gpaName, max, currentReplicas)
+	metricsServer.RecordGPAScalerDesiredReplicas(gpa.Namespace, gpa.Name, e.key, "event", max)
+	return max, nil
+}
+
+// TriggerStatuses returns a copy of the last statuses of all triggers
+func (e *EventScaler) TriggerStatuses() []autoscalingv1.TriggerStatus {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	if len(e.statuses) == 0 {
+		return nil
+	}
+	statuses := make([]autoscalingv1.TriggerStatus, len(e.statuses))
+	for i := range e.statuses {
+		e.statuses[i].DeepCopyInto(&statuses[i])
+	}
+	return statuses
+}
+
+// Triggers returns the triggers the scaler was built with
+func (e *EventScaler) Triggers() []autoscalingv1.ScaleTriggers {
+	return e.triggers
 }
 
 // ScalerName scaler name
-func (s *EventScaler) ScalerName() string {
-	return s.name
+func (e *EventScaler) ScalerName() string {
+	return e.name
 }
@@ -0,0 +1,168 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under,
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package scalercore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// eventClient is used by http based triggers, timeout is controlled by request context
+var eventClient = http.Client{}
+
+var _ triggerScaler = &rabbitMQTrigger{}
+var _ triggerScaler = &metricsAPITrigger{}
+
+// rabbitMQTrigger reads the message count of a queue through the rabbitmq management api
+type rabbitMQTrigger struct {
+	queueURL string
+}
+
+// newRabbitMQTrigger metadata: host(management url with credentials), queueName, vhost(optional)
+func newRabbitMQTrigger(config *triggerConfig) (triggerScaler, error) {
+	host, err := getRequiredMetadata(config, "host")
+	if err != nil {
+		return nil, err
+	}
+	queueName, err := getRequiredMetadata(config, "queueName")
+	if err != nil {
+		return nil, err
+	}
+	vhost := config.metadata["vhost"]
+	if vhost == "" {
+		vhost = "/"
+	}
+	u, err := url.Parse(host)
+	if err != nil {
+		return nil, fmt.Errorf("trigger %s: invalid host %q: %v", config.name, host, err)
+	}
+	// vhost "/" must be escaped as %2F in the path
+	basePath, baseRawPath := strings.TrimSuffix(u.Path, "/"), strings.TrimSuffix(u.EscapedPath(), "/")
+	u.Path = basePath + "/api/queues/" + vhost + "/" + queueName
+	u.RawPath = baseRawPath + "/api/queues/" + url.PathEscape(vhost) + "/" + url.PathEscape(queueName)
+	return &rabbitMQTrigger{queueURL: u.String()}, nil
+}
+
+// GetMetricValue returns the total messages of the queue, including the unacknowledged ones
+func (r *rabbitMQTrigger) GetMetricValue(ctx context.Context) (int64, error) {
+	result := struct {
+		Messages int64 `json:"messages"`
+	}{}
+	if err := getJSON(ctx, r.queueURL, &result); err != nil {
+		return 0, err
+	}
+	return result.Messages, nil
+}
+
+// Close nothing to close
+func (r *rabbitMQTrigger) Close() error {
+	return nil
+}
+
+// metricsAPITrigger reads a value from any http endpoint returning json
+type metricsAPITrigger struct {
+	url           string
+	valueLocation []string
+}
+
+// newMetricsAPITrigger metadata: url, valueLocation(dot separated path of the value in response)
+func newMetricsAPITrigger(config *triggerConfig) (triggerScaler, error) {
+	u, err := getRequiredMetadata(config, "url")
+	if err != nil {
+		return nil, err
+	}
+	if _, err = url.ParseRequestURI(u); err != nil {
+		return nil, fmt.Errorf("trigger %s: invalid url %q: %v", config.name, u, err)
+	}
+	location, err := getRequiredMetadata(config, "valueLocation")
+	if err != nil {
+		return nil, err
+	}
+	return &metricsAPITrigger{url: u, valueLocation: strings.Split(location, ".")}, nil
+}
+
+// GetMetricValue returns the value found at valueLocation
+func (m *metricsAPITrigger) GetMetricValue(ctx context.Context) (int64, error) {
+	var result interface{}
+	if err := getJSON(ctx, m.url, &result); err != nil {
+		return 0, err
+	}
+	return getValueByLocation(result, m.valueLocation)
+}
+
+// Close nothing to close
+func (m *metricsAPITrigger) Close() error {
+	return nil
+}
+
+func getJSON(ctx context.Context, u string, v interface{}) error {
+	req, err := http.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	res, err := eventClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("bad status code %d from %s: %s", res.StatusCode, req.URL.Redacted(), string(body))
+	}
+	return json.Unmarshal(body, v)
+}
+
+// getValueByLocation walks through maps and arrays of a decoded json, then converts the leaf to int64
+func getValueByLocation(obj interface{}, location []string) (int64, error) {
+	current := obj
+	for _, key := range location {
+		switch v := current.(type) {
+		case map[string]interface{}:
+			next, ok := v[key]
+			if !ok {
+				return 0, fmt.Errorf("key %s not found in %s", key, strings.Join(location, "."))
+			}
+			current = next
+		case []interface{}:
+			index, err := strconv.Atoi(key)
+			if err != nil || index < 0 || index >= len(v) {
+				return 0, fmt.Errorf("invalid index %s in %s", key, strings.Join(location, "."))
+			}
+			current = v[index]
+		default:
+			return 0, fmt.Errorf("can not get %s from a non-object value in %s", key, strings.Join(location, "."))
+		}
+	}
+	switch v := current.(type) {
+	case float64:
+		return int64(math.Ceil(v)), nil
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0, fmt.Errorf("value %q of %s is not a number", v, strings.Join(location, "."))
+		}
+		return int64(math.Ceil(f)), nil
+	default:
+		return 0, fmt.Errorf("value of %s is not a number", strings.Join(location, "."))
+	}
+}
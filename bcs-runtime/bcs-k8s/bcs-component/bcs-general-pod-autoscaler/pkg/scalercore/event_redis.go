@@ -0,0 +1,130 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under,
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package scalercore
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+var _ triggerScaler = &redisTrigger{}
+
+// redisTrigger reads the length of a redis list, it speaks the plain RESP protocol
+// so that no redis client is needed for a single command
+type redisTrigger struct {
+	address   string
+	password  string
+	db        int
+	listName  string
+	enableTLS bool
+}
+
+// newRedisTrigger metadata: address, listName, password(optional, can be set by secretTargetRef),
+// db(optional), enableTLS(optional)
+func newRedisTrigger(config *triggerConfig) (triggerScaler, error) {
+	var err error
+	r := &redisTrigger{password: getAuthParameter(config, "password")}
+	if r.address, err = getRequiredMetadata(config, "address"); err != nil {
+		return nil, err
+	}
+	if r.listName, err = getRequiredMetadata(config, "listName"); err != nil {
+		return nil, err
+	}
+	if db := config.metadata["db"]; db != "" {
+		if r.db, err = strconv.Atoi(db); err != nil {
+			return nil, fmt.Errorf("trigger %s: invalid db %q: %v", config.name, db, err)
+		}
+	}
+	if enableTLS := config.metadata["enableTLS"]; enableTLS != "" {
+		if r.enableTLS, err = strconv.ParseBool(enableTLS); err != nil {
+			return nil, fmt.Errorf("trigger %s: invalid enableTLS %q: %v", config.name, enableTLS, err)
+		}
+	}
+	return r, nil
+}
+
+// GetMetricValue returns the length of the list
+func (r *redisTrigger) GetMetricValue(ctx context.Context) (int64, error) {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", r.address)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	if r.enableTLS {
+		host, _, _ := net.SplitHostPort(r.address)
+		tlsConn := tls.Client(conn, &tls.Config{ServerName: host})
+		if err = tlsConn.Handshake(); err != nil {
+			return 0, err
+		}
+		conn = tlsConn
+	}
+	reader := bufio.NewReader(conn)
+	if r.password != "" {
+		if _, err = redisDo(conn, reader, "AUTH", r.password); err != nil {
+			return 0, fmt.Errorf("redis auth failed: %v", err)
+		}
+	}
+	if r.db != 0 {
+		if _, err = redisDo(conn, reader, "SELECT", strconv.Itoa(r.db)); err != nil {
+			return 0, fmt.Errorf("redis select db %d failed: %v", r.db, err)
+		}
+	}
+	reply, err := redisDo(conn, reader, "LLEN", r.listName)
+	if err != nil {
+		return 0, fmt.Errorf("redis llen %s failed: %v", r.listName, err)
+	}
+	return strconv.ParseInt(reply, 10, 64)
+}
+
+// Close nothing to close, connections are created per read
+func (r *redisTrigger) Close() error {
+	return nil
+}
+
+// redisDo sends a command and returns the simple string or integer reply
+func redisDo(conn net.Conn, reader *bufio.Reader, args ...string) (string, error) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "*%d\r\n", len(args))
+	for _, arg := range args {
+		fmt.Fprintf(&b, "$%d\r\n%s\r\n", len(arg), arg)
+	}
+	if _, err := conn.Write([]byte(b.String())); err != nil {
+		return "", err
+	}
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	line = strings.TrimSuffix(line, "\r\n")
+	if len(line) == 0 {
+		return "", errors.New("empty reply")
+	}
+	switch line[0] {
+	case '+', ':':
+		return line[1:], nil
+	case '-':
+		return "", errors.New(line[1:])
+	default:
+		return "", fmt.Errorf("unexpected reply %q", line)
+	}
+}
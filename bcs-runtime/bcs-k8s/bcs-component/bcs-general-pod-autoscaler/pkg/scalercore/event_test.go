@@ -0,0 +1,296 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under,
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package scalercore
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-general-pod-autoscaler/pkg/apis/autoscaling/v1alpha1"
+)
+
+func Test_GetValueByLocation(t *testing.T) {
+	var obj interface{}
+	if err := json.Unmarshal([]byte(`{"a":{"b":[{"c":3.2},{"c":"7"}],"d":"x"}}`), &obj); err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []struct {
+		location string
+		value    int64
+		hasErr   bool
+	}{
+		{location: "a.b.0.c", value: 4},
+		{location: "a.b.1.c", value: 7},
+		{location: "a.b.2.c", hasErr: true},
+		{location: "a.d", hasErr: true},
+		{location: "a.e", hasErr: true},
+	} {
+		value, err := getValueByLocation(obj, strings.Split(c.location, "."))
+		if (err != nil) != c.hasErr {
+			t.Errorf("%s: expect error %v, got %v", c.location, c.hasErr, err)
+			continue
+		}
+		if value != c.value {
+			t.Errorf("%s: expect %d, got %d", c.location, c.value, value)
+		}
+	}
+}
+
+func Test_ParseTriggerConfig(t *testing.T) {
+	for _, c := range []struct {
+		name    string
+		trigger v1alpha1.ScaleTriggers
+		hasErr  bool
+	}{
+		{
+			name: "valid redis trigger",
+			trigger: v1alpha1.ScaleTriggers{Type: RedisTrigger, Metadata: map[string]string{
+				"address": "127.0.0.1:6379", "listName": "jobs", "targetValue": "10"}},
+		},
+		{
+			name: "missing target value",
+			trigger: v1alpha1.ScaleTriggers{Type: RedisTrigger, Metadata: map[string]string{
+				"address": "127.0.0.1:6379", "listName": "jobs"}},
+			hasErr: true,
+		},
+		{
+			name: "missing list name",
+			trigger: v1alpha1.ScaleTriggers{Type: RedisTrigger, Metadata: map[string]string{
+				"address": "127.0.0.1:6379", "targetValue": "10"}},
+			hasErr: true,
+		},
+		{
+			name: "invalid polling interval",
+			trigger: v1alpha1.ScaleTriggers{Type: MetricsAPITrigger, Metadata: map[string]string{
+				"url": "http://127.0.0.1/metrics", "valueLocation": "a", "targetValue": "10",
+				"pollingInterval": "-1"}},
+			hasErr: true,
+		},
+		{
+			name: "redis password from secret",
+			trigger: v1alpha1.ScaleTriggers{Type: RedisTrigger, Metadata: map[string]string{
+				"address": "127.0.0.1:6379", "listName": "jobs", "targetValue": "10"},
+				SecretTargetRef: []v1alpha1.SecretTargetRef{{Parameter: "password", Name: "redis", Key: "password"}}},
+		},
+		{
+			name: "secret key not set",
+			trigger: v1alpha1.ScaleTriggers{Type: RedisTrigger, Metadata: map[string]string{
+				"address": "127.0.0.1:6379", "listName": "jobs", "targetValue": "10"},
+				SecretTargetRef: []v1alpha1.SecretTargetRef{{Parameter: "password", Name: "redis"}}},
+			hasErr: true,
+		},
+		{
+			name: "parameter can not be set from secret",
+			trigger: v1alpha1.ScaleTriggers{Type: RedisTrigger, Metadata: map[string]string{
+				"address": "127.0.0.1:6379", "listName": "jobs", "targetValue": "10"},
+				SecretTargetRef: []v1alpha1.SecretTargetRef{{Parameter: "address", Name: "redis", Key: "address"}}},
+			hasErr: true,
+		},
+		{
+			name:    "unsupported type",
+			trigger: v1alpha1.ScaleTriggers{Type: "kafka", Metadata: map[string]string{"targetValue": "10"}},
+			hasErr:  true,
+		},
+	} {
+		err := ValidateTrigger(c.trigger)
+		if (err != nil) != c.hasErr {
+			t.Errorf("%s: expect error %v, got %v", c.name, c.hasErr, err)
+		}
+	}
+}
+
+func Test_ResolveAuthParams(t *testing.T) {
+	client := fake.NewSimpleClientset(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "redis"},
+		Data:       map[string][]byte{"password": []byte("secret")},
+	})
+	for _, c := range []struct {
+		name   string
+		refs   []v1alpha1.SecretTargetRef
+		params map[string]string
+		hasErr bool
+	}{
+		{
+			name: "no secret referenced",
+		},
+		{
+			name:   "password from secret",
+			refs:   []v1alpha1.SecretTargetRef{{Parameter: "password", Name: "redis", Key: "password"}},
+			params: map[string]string{"password": "secret"},
+		},
+		{
+			name:   "secret not found",
+			refs:   []v1alpha1.SecretTargetRef{{Parameter: "password", Name: "unknown", Key: "password"}},
+			hasErr: true,
+		},
+		{
+			name:   "key not found",
+			refs:   []v1alpha1.SecretTargetRef{{Parameter: "password", Name: "redis", Key: "unknown"}},
+			hasErr: true,
+		},
+	} {
+		params, err := ResolveAuthParams(client.CoreV1(), "default",
+			v1alpha1.ScaleTriggers{Type: RedisTrigger, SecretTargetRef: c.refs})
+		if (err != nil) != c.hasErr {
+			t.Errorf("%s: expect error %v, got %v", c.name, c.hasErr, err)
+			continue
+		}
+		if !reflect.DeepEqual(params, c.params) {
+			t.Errorf("%s: expect %v, got %v", c.name, c.params, params)
+		}
+	}
+}
+
+func Test_RedisTrigger(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		reader := bufio.NewReader(conn)
+		for {
+			// every command is sent as an array of bulk strings
+			header, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			var n int
+			fmt.Sscanf(header, "*%d", &n)
+			args := make([]string, 0, n)
+			for i := 0; i < n; i++ {
+				_, _ = reader.ReadString('\n')
+				arg, _ := reader.ReadString('\n')
+				args = append(args, strings.TrimSuffix(arg, "\r\n"))
+			}
+			switch args[0] {
+			case "AUTH":
+				if args[1] != "secret" {
+					_, _ = conn.Write([]byte("-WRONGPASS invalid password\r\n"))
+					continue
+				}
+				_, _ = conn.Write([]byte("+OK\r\n"))
+			case "LLEN":
+				_, _ = conn.Write([]byte(":25\r\n"))
+			default:
+				_, _ = conn.Write([]byte("-ERR unknown command\r\n"))
+			}
+		}
+	}()
+	// password from secret takes precedence over the one in metadata
+	ts, err := newRedisTrigger(&triggerConfig{name: "redis", metadata: map[string]string{
+		"address": ln.Addr().String(), "listName": "jobs", "password": "invalid"},
+		authParams: map[string]string{"password": "secret"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	value, err := ts.GetMetricValue(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value != 25 {
+		t.Errorf("expect 25, got %d", value)
+	}
+}
+
+func Test_RabbitMQTrigger(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() != "/api/queues/%2F/jobs" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"messages": 42, "messages_ready": 40}`))
+	}))
+	defer server.Close()
+	ts, err := newRabbitMQTrigger(&triggerConfig{name: "rabbitmq", metadata: map[string]string{
+		"host": server.URL, "queueName": "jobs"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	value, err := ts.GetMetricValue(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value != 42 {
+		t.Errorf("expect 42, got %d", value)
+	}
+}
+
+func Test_EventScalerGetReplicas(t *testing.T) {
+	gpa := &v1alpha1.GeneralPodAutoscaler{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "gpa"}}
+	for _, c := range []struct {
+		name     string
+		statuses []v1alpha1.TriggerStatus
+		desired  int32
+		hasErr   bool
+	}{
+		{
+			name:    "no trigger has been read",
+			desired: -1,
+		},
+		{
+			name: "max of triggers",
+			statuses: []v1alpha1.TriggerStatus{
+				{Name: "a", DesiredReplicas: 3},
+				{Name: "b", DesiredReplicas: 5},
+			},
+			desired: 5,
+		},
+		{
+			name: "failed trigger is ignored",
+			statuses: []v1alpha1.TriggerStatus{
+				{Name: "a", DesiredReplicas: 3},
+				{Name: "b", DesiredReplicas: -1, Message: "timeout"},
+			},
+			desired: 3,
+		},
+		{
+			name: "all triggers failed",
+			statuses: []v1alpha1.TriggerStatus{
+				{Name: "a", DesiredReplicas: -1, Message: "timeout"},
+			},
+			desired: -1,
+			hasErr:  true,
+		},
+	} {
+		s := NewEventScaler(gpa, nil)
+		s.statuses = c.statuses
+		desired, err := s.GetReplicas(gpa, 1)
+		if (err != nil) != c.hasErr {
+			t.Errorf("%s: expect error %v, got %v", c.name, c.hasErr, err)
+		}
+		if desired != c.desired {
+			t.Errorf("%s: expect %d, got %d", c.name, c.desired, desired)
+		}
+	}
+}
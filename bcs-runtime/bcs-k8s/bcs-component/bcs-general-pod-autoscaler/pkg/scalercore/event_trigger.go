@@ -0,0 +1,212 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under,
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package scalercore
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1core "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	autoscalingv1 "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-general-pod-autoscaler/pkg/apis/autoscaling/v1alpha1"
+)
+
+const (
+	// RedisTrigger scales on the length of a redis list
+	RedisTrigger = "redis"
+	// RabbitMQTrigger scales on the message count of a rabbitmq queue
+	RabbitMQTrigger = "rabbitmq"
+	// MetricsAPITrigger scales on a value read from a http json endpoint
+	MetricsAPITrigger = "metrics-api"
+
+	// metadata keys shared by all triggers
+	targetValueKey     = "targetValue"
+	pollingIntervalKey = "pollingInterval"
+	timeoutKey         = "timeout"
+
+	defaultPollingInterval = 30 * time.Second
+	defaultTriggerTimeout  = 10 * time.Second
+)
+
+// secretParameters are the parameters of each trigger type which can be set by secretTargetRef
+var secretParameters = map[string]map[string]bool{
+	RedisTrigger: {"password": true},
+}
+
+// triggerScaler reads the current value of a single event source
+type triggerScaler interface {
+	// GetMetricValue returns the current value of the event source
+	GetMetricValue(ctx context.Context) (int64, error)
+	// Close releases resources held by the trigger
+	Close() error
+}
+
+// triggerConfig is the common config parsed from trigger metadata
+type triggerConfig struct {
+	name            string
+	triggerType     string
+	targetValue     int64
+	pollingInterval time.Duration
+	timeout         time.Duration
+	metadata        map[string]string
+	// authParams are the parameters resolved from secretTargetRef
+	authParams map[string]string
+}
+
+// parseTriggerConfig parses the common config of a trigger
+func parseTriggerConfig(trigger autoscalingv1.ScaleTriggers, authParams map[string]string) (*triggerConfig, error) {
+	config := &triggerConfig{
+		name:            triggerName(trigger),
+		triggerType:     trigger.Type,
+		pollingInterval: defaultPollingInterval,
+		timeout:         defaultTriggerTimeout,
+		metadata:        trigger.Metadata,
+		authParams:      authParams,
+	}
+	target, ok := trigger.Metadata[targetValueKey]
+	if !ok {
+		return nil, fmt.Errorf("trigger %s: metadata %s must be set", config.name, targetValueKey)
+	}
+	targetValue, err := strconv.ParseInt(target, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("trigger %s: invalid %s %q: %v", config.name, targetValueKey, target, err)
+	}
+	if targetValue <= 0 {
+		return nil, fmt.Errorf("trigger %s: %s must be greater than 0", config.name, targetValueKey)
+	}
+	config.targetValue = targetValue
+	if config.pollingInterval, err = parseSeconds(trigger.Metadata, pollingIntervalKey,
+		defaultPollingInterval); err != nil {
+		return nil, fmt.Errorf("trigger %s: %v", config.name, err)
+	}
+	if config.timeout, err = parseSeconds(trigger.Metadata, timeoutKey, defaultTriggerTimeout); err != nil {
+		return nil, fmt.Errorf("trigger %s: %v", config.name, err)
+	}
+	return config, nil
+}
+
+// ValidateTrigger checks whether the trigger can be built, secrets referenced are not read
+func ValidateTrigger(trigger autoscalingv1.ScaleTriggers) error {
+	for _, ref := range trigger.SecretTargetRef {
+		if ref.Parameter == "" || ref.Name == "" || ref.Key == "" {
+			return fmt.Errorf("trigger %s: parameter, name and key of secretTargetRef must be set",
+				triggerName(trigger))
+		}
+		if !secretParameters[trigger.Type][ref.Parameter] {
+			return fmt.Errorf("trigger %s: parameter %s can not be set by secretTargetRef",
+				triggerName(trigger), ref.Parameter)
+		}
+	}
+	config, err := parseTriggerConfig(trigger, nil)
+	if err != nil {
+		return err
+	}
+	ts, err := newTriggerScaler(config)
+	if err != nil {
+		return err
+	}
+	return ts.Close()
+}
+
+// ResolveAuthParams reads the parameters referenced by secretTargetRef of the trigger
+// from secrets in the namespace
+func ResolveAuthParams(secretNamespacer v1core.SecretsGetter, namespace string,
+	trigger autoscalingv1.ScaleTriggers) (map[string]string, error) {
+	if len(trigger.SecretTargetRef) == 0 {
+		return nil, nil
+	}
+	authParams := make(map[string]string, len(trigger.SecretTargetRef))
+	for _, ref := range trigger.SecretTargetRef {
+		secret, err := secretNamespacer.Secrets(namespace).Get(ref.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("trigger %s: get secret %s/%s failed: %v",
+				triggerName(trigger), namespace, ref.Name, err)
+		}
+		value, ok := secret.Data[ref.Key]
+		if !ok {
+			return nil, fmt.Errorf("trigger %s: key %s not found in secret %s/%s",
+				triggerName(trigger), ref.Key, namespace, ref.Name)
+		}
+		authParams[ref.Parameter] = string(value)
+	}
+	return authParams, nil
+}
+
+// newTriggerScaler creates the trigger scaler by trigger type
+func newTriggerScaler(config *triggerConfig) (triggerScaler, error) {
+	switch config.triggerType {
+	case RedisTrigger:
+		return newRedisTrigger(config)
+	case RabbitMQTrigger:
+		return newRabbitMQTrigger(config)
+	case MetricsAPITrigger:
+		return newMetricsAPITrigger(config)
+	default:
+		return nil, fmt.Errorf("trigger %s: unsupported trigger type %q", config.name, config.triggerType)
+	}
+}
+
+// computeTriggerReplicas returns the replicas needed to keep value per replica under target
+func computeTriggerReplicas(value, target int64) int32 {
+	if value <= 0 {
+		return 0
+	}
+	replicas := math.Ceil(float64(value) / float64(target))
+	if replicas > math.MaxInt32 {
+		return math.MaxInt32
+	}
+	return int32(replicas)
+}
+
+func parseSeconds(metadata map[string]string, key string, defaultValue time.Duration) (time.Duration, error) {
+	value, ok := metadata[key]
+	if !ok || value == "" {
+		return defaultValue, nil
+	}
+	seconds, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: %v", key, value, err)
+	}
+	if seconds <= 0 {
+		return 0, fmt.Errorf("%s must be greater than 0", key)
+	}
+	return time.Duration(seconds) * time.Second, nil
+}
+
+// getAuthParameter returns the parameter resolved from secret, or the one in metadata if not referenced
+func getAuthParameter(config *triggerConfig, key string) string {
+	if value, ok := config.authParams[key]; ok {
+		return value
+	}
+	return config.metadata[key]
+}
+
+// triggerName returns the name of the trigger, it is the trigger type if name is not set
+func triggerName(trigger autoscalingv1.ScaleTriggers) string {
+	if trigger.Name == "" {
+		return trigger.Type
+	}
+	return trigger.Name
+}
+
+func getRequiredMetadata(config *triggerConfig, key string) (string, error) {
+	value := config.metadata[key]
+	if value == "" {
+		return "", fmt.Errorf("trigger %s: metadata %s must be set", config.name, key)
+	}
+	return value, nil
+}
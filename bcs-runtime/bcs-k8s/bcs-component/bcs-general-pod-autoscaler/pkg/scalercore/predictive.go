@@ -0,0 +1,225 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under,
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package scalercore
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog"
+
+	autoscalingv1 "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-general-pod-autoscaler/pkg/apis/autoscaling/v1alpha1"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-general-pod-autoscaler/pkg/metrics"
+)
+
+const (
+	// historyBucket is the resolution of the daily history
+	historyBucket          = 5 * time.Minute
+	bucketsPerDay          = int(24 * time.Hour / historyBucket)
+	noSampleInBucket int32 = -1
+)
+
+// SeasonalHistory keeps the max replicas sampled in every bucket of recent days
+type SeasonalHistory struct {
+	mu   sync.RWMutex
+	days int
+	// samples is keyed by the unix time of the local midnight of a day
+	samples map[int64][]int32
+}
+
+// NewSeasonalHistory creates history keeping samples of recent days
+func NewSeasonalHistory(days int32) *SeasonalHistory {
+	return &SeasonalHistory{days: int(days), samples: map[int64][]int32{}}
+}
+
+// SetDays changes how many days are kept, older days are dropped on next record
+func (h *SeasonalHistory) SetDays(days int32) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.days = int(days)
+}
+
+// Record records the replicas needed at time t
+func (h *SeasonalHistory) Record(t time.Time, replicas int32) {
+	day, bucket := dayAndBucket(t)
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	buckets, ok := h.samples[day]
+	if !ok {
+		buckets = make([]int32, bucketsPerDay)
+		for i := range buckets {
+			buckets[i] = noSampleInBucket
+		}
+		h.samples[day] = buckets
+	}
+	if replicas > buckets[bucket] {
+		buckets[bucket] = replicas
+	}
+	// drop the days out of history
+	oldest := t.AddDate(0, 0, -h.days)
+	oldestDay, _ := dayAndBucket(oldest)
+	for d := range h.samples {
+		if d < oldestDay {
+			delete(h.samples, d)
+		}
+	}
+}
+
+// Samples returns a copy of the samples, keyed by the unix time of the local midnight of a day
+func (h *SeasonalHistory) Samples() map[int64][]int32 {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	samples := make(map[int64][]int32, len(h.samples))
+	for d, buckets := range h.samples {
+		samples[d] = append([]int32(nil), buckets...)
+	}
+	return samples
+}
+
+// Restore replaces the samples with the ones returned by Samples, days with invalid buckets are ignored
+func (h *SeasonalHistory) Restore(samples map[int64][]int32) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.samples = make(map[int64][]int32, len(samples))
+	for d, buckets := range samples {
+		if len(buckets) != bucketsPerDay {
+			continue
+		}
+		h.samples[d] = append([]int32(nil), buckets...)
+	}
+}
+
+// Forecast forecasts the replicas at time t from the same time of the previous days,
+// it returns -1 replicas if there is no sample for the time
+func (h *SeasonalHistory) Forecast(t time.Time) (replicas int32, confidence int32, days int32) {
+	targetDay, bucket := dayAndBucket(t)
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	dayKeys := make([]int64, 0, len(h.samples))
+	for d := range h.samples {
+		if d < targetDay {
+			dayKeys = append(dayKeys, d)
+		}
+	}
+	sort.Slice(dayKeys, func(i, j int) bool { return dayKeys[i] > dayKeys[j] })
+
+	var values, weights []float64
+	for i, d := range dayKeys {
+		if i >= h.days {
+			break
+		}
+		// use the max of the neighbour buckets to tolerate gaps and small shifts of the curve
+		value := noSampleInBucket
+		for _, b := range []int{bucket - 1, bucket, bucket + 1} {
+			if b >= 0 && b < bucketsPerDay && h.samples[d][b] > value {
+				value = h.samples[d][b]
+			}
+		}
+		if value == noSampleInBucket {
+			continue
+		}
+		values = append(values, float64(value))
+		// recent days weigh more
+		weights = append(weights, 1/float64(i+1))
+	}
+	if len(values) == 0 || h.days <= 0 {
+		return -1, 0, 0
+	}
+
+	var sum, weightSum float64
+	for i := range values {
+		sum += values[i] * weights[i]
+		weightSum += weights[i]
+	}
+	mean := sum / weightSum
+	var variance float64
+	for i := range values {
+		variance += weights[i] * (values[i] - mean) * (values[i] - mean)
+	}
+	stddev := math.Sqrt(variance / weightSum)
+
+	// confidence grows with the days covered and drops with the variation between days
+	coverage := math.Min(1, float64(len(values))/float64(h.days))
+	stability := 1.0
+	if mean > 0 {
+		stability = math.Max(0, 1-stddev/mean)
+	}
+	return int32(math.Ceil(mean)), int32(math.Round(100 * coverage * stability)), int32(len(values))
+}
+
+func dayAndBucket(t time.Time) (int64, int) {
+	y, m, d := t.Date()
+	midnight := time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+	bucket := int(t.Sub(midnight) / historyBucket)
+	if bucket >= bucketsPerDay {
+		// the day is longer than 24h when daylight saving time ends
+		bucket = bucketsPerDay - 1
+	}
+	return midnight.Unix(), bucket
+}
+
+var _ Scaler = &PredictiveScaler{}
+
+// PredictiveScaler recommends the replicas forecasted from history
+type PredictiveScaler struct {
+	name     string
+	mode     *autoscalingv1.PredictiveMode
+	forecast autoscalingv1.ForecastStatus
+}
+
+// NewPredictiveScaler forecasts the replicas ForecastWindowMinutes after now
+func NewPredictiveScaler(mode *autoscalingv1.PredictiveMode, history *SeasonalHistory,
+	now time.Time) *PredictiveScaler {
+	forecastTime := now.Add(time.Duration(mode.ForecastWindowMinutes) * time.Minute)
+	replicas, confidence, days := history.Forecast(forecastTime)
+	return &PredictiveScaler{
+		name: Predictive,
+		mode: mode,
+		forecast: autoscalingv1.ForecastStatus{
+			ForecastTime:     metav1.NewTime(forecastTime),
+			ForecastReplicas: replicas,
+			Confidence:       confidence,
+			HistoryDays:      days,
+		},
+	}
+}
+
+// GetReplicas returns the forecasted replicas if the forecast is confident enough
+func (s *PredictiveScaler) GetReplicas(gpa *autoscalingv1.GeneralPodAutoscaler, currentReplicas int32) (int32, error) {
+	var metricsServer metrics.PrometheusMetricServer
+	key := gpa.Spec.ScaleTargetRef.Kind + "/" + gpa.Spec.ScaleTargetRef.Name
+	metricsServer.RecordGPAScalerMetric(gpa.Namespace, gpa.Name, key, "predictive", "confidence",
+		int64(s.mode.MinConfidence), int64(s.forecast.Confidence))
+	replicas := s.forecast.ForecastReplicas
+	if s.mode.DryRun || s.forecast.Confidence < s.mode.MinConfidence {
+		replicas = -1
+	}
+	klog.V(4).Infof("Predictive mode of gpa %s/%s forecast %d replicas at %v with confidence %d, recommend %d",
+		gpa.Namespace, gpa.Name, s.forecast.ForecastReplicas, s.forecast.ForecastTime, s.forecast.Confidence,
+		replicas)
+	metricsServer.RecordGPAScalerDesiredReplicas(gpa.Namespace, gpa.Name, key, "predictive", replicas)
+	return replicas, nil
+}
+
+// Forecast returns the forecast of the scaler
+func (s *PredictiveScaler) Forecast() *autoscalingv1.ForecastStatus {
+	return s.forecast.DeepCopy()
+}
+
+// ScalerName scaler name
+func (s *PredictiveScaler) ScalerName() string {
+	return s.name
+}
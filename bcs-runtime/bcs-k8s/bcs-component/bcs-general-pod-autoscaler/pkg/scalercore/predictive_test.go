@@ -0,0 +1,117 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under,
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package scalercore
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-general-pod-autoscaler/pkg/apis/autoscaling/v1alpha1"
+)
+
+func Test_SeasonalHistoryForecast(t *testing.T) {
+	now, _ := time.Parse("2006-01-02 15:04:05", "2020-12-18 09:00:00")
+	for _, c := range []struct {
+		name       string
+		samples    map[int]int32
+		replicas   int32
+		confidence int32
+		days       int32
+	}{
+		{
+			name:     "no history",
+			replicas: -1,
+		},
+		{
+			name:       "stable history of all days",
+			samples:    map[int]int32{1: 10, 2: 10, 3: 10, 4: 10},
+			replicas:   10,
+			confidence: 100,
+			days:       4,
+		},
+		{
+			name:       "stable history of half days",
+			samples:    map[int]int32{1: 10, 2: 10},
+			replicas:   10,
+			confidence: 50,
+			days:       2,
+		},
+		{
+			name:       "recent days weigh more",
+			samples:    map[int]int32{1: 20, 2: 10, 3: 10, 4: 10},
+			replicas:   15,
+			confidence: 66,
+			days:       4,
+		},
+		{
+			name:     "days out of history are dropped",
+			samples:  map[int]int32{5: 10, 6: 10},
+			replicas: -1,
+		},
+	} {
+		history := NewSeasonalHistory(4)
+		for daysAgo, replicas := range c.samples {
+			history.Record(now.AddDate(0, 0, -daysAgo), replicas)
+		}
+		// recording now drops the days out of history
+		history.Record(now.Add(-time.Hour), 1)
+		replicas, confidence, days := history.Forecast(now)
+		if replicas != c.replicas || confidence != c.confidence || days != c.days {
+			t.Errorf("%s: expect (%d, %d, %d), got (%d, %d, %d)", c.name, c.replicas, c.confidence, c.days,
+				replicas, confidence, days)
+		}
+	}
+}
+
+func Test_PredictiveScalerGetReplicas(t *testing.T) {
+	now, _ := time.Parse("2006-01-02 15:04:05", "2020-12-18 09:00:00")
+	history := NewSeasonalHistory(2)
+	history.Record(now.AddDate(0, 0, -1).Add(10*time.Minute), 8)
+	gpa := &v1alpha1.GeneralPodAutoscaler{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "gpa"}}
+	for _, c := range []struct {
+		name    string
+		mode    v1alpha1.PredictiveMode
+		desired int32
+	}{
+		{
+			name:    "confident enough",
+			mode:    v1alpha1.PredictiveMode{ForecastWindowMinutes: 10, MinConfidence: 50},
+			desired: 8,
+		},
+		{
+			name:    "not confident enough",
+			mode:    v1alpha1.PredictiveMode{ForecastWindowMinutes: 10, MinConfidence: 60},
+			desired: -1,
+		},
+		{
+			name:    "dry run",
+			mode:    v1alpha1.PredictiveMode{ForecastWindowMinutes: 10, MinConfidence: 50, DryRun: true},
+			desired: -1,
+		},
+	} {
+		mode := c.mode
+		s := NewPredictiveScaler(&mode, history, now)
+		desired, err := s.GetReplicas(gpa, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if desired != c.desired {
+			t.Errorf("%s: expect %d, got %d", c.name, c.desired, desired)
+		}
+		if s.Forecast().ForecastReplicas != 8 {
+			t.Errorf("%s: expect forecast 8, got %d", c.name, s.Forecast().ForecastReplicas)
+		}
+	}
+}
@@ -20,6 +20,8 @@ const (
 	Webhook = "Webhook"
 	Event   = "Event"
 	Cron    = "Cron"
+	// Predictive is the name of predictive scaler
+	Predictive = "Predictive"
 )
 
 // Scaler scaler
@@ -24,6 +24,7 @@ import (
 	"k8s.io/apiserver/pkg/util/webhook"
 
 	autoscaling "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-general-pod-autoscaler/pkg/apis/autoscaling/v1alpha1"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-general-pod-autoscaler/pkg/scalercore"
 )
 
 const (
@@ -74,6 +75,11 @@ func validateHorizontalPodAutoscalerSpec(autoscaler autoscaling.GeneralPodAutosc
 			allErrs = append(allErrs, refErrs...)
 		}
 	}
+	if autoscaler.AutoScalingDrivenMode.PredictiveMode != nil {
+		if refErrs := validatePredictive(autoscaler.AutoScalingDrivenMode, fldPath.Child("predictive")); len(refErrs) > 0 {
+			allErrs = append(allErrs, refErrs...)
+		}
+	}
 	if refErrs := validateBehavior(autoscaler.Behavior, fldPath.Child("behavior")); len(refErrs) > 0 {
 		allErrs = append(allErrs, refErrs...)
 	}
@@ -225,7 +231,32 @@ func validateEvent(triggers []autoscaling.ScaleTriggers, fldPath *field.Path) fi
 		}
 		if len(trigger.Metadata) == 0 {
 			allErrs = append(allErrs, field.Forbidden(fldPath.Child("medadata"), "trigger medadata must set"))
+			continue
 		}
+		if err := scalercore.ValidateTrigger(trigger); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("triggers"), trigger.Type, err.Error()))
+		}
+	}
+	return allErrs
+}
+
+func validatePredictive(mode autoscaling.AutoScalingDrivenMode, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if mode.MetricMode == nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "predictive mode learns from metric mode, metric mode must set"))
+	}
+	predictive := mode.PredictiveMode
+	if predictive.ForecastWindowMinutes < 0 || predictive.ForecastWindowMinutes > 24*60 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("forecastWindowMinutes"),
+			predictive.ForecastWindowMinutes, "must be between 0 and 1440"))
+	}
+	if predictive.HistoryDays < 0 || predictive.HistoryDays > 30 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("historyDays"),
+			predictive.HistoryDays, "must be between 0 and 30"))
+	}
+	if predictive.MinConfidence < 0 || predictive.MinConfidence > 100 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minConfidence"),
+			predictive.MinConfidence, "must be between 0 and 100"))
 	}
 	return allErrs
 }
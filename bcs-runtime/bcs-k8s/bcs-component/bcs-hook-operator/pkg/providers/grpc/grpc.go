@@ -0,0 +1,261 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-hook-operator/pkg/util/evaluate"
+	metricutil "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-hook-operator/pkg/util/metric"
+	templateutil "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-hook-operator/pkg/util/template"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/kubernetes/common/bcs-hook/apis/tkex/v1alpha1"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/jsonpath"
+	"k8s.io/klog"
+)
+
+// Provider calls a grpc method and evaluates the response
+type Provider struct {
+	resolver MethodResolver
+}
+
+// NewGRPCProvider creates a grpc provider resolving methods through server reflection
+func NewGRPCProvider() *Provider {
+	return &Provider{resolver: &reflectionResolver{}}
+}
+
+// Run calls the grpc method, all the work is done in Run like the web provider
+func (p *Provider) Run(run *v1alpha1.HookRun, metric v1alpha1.Metric) v1alpha1.Measurement {
+	startTime := metav1.Now()
+
+	// Measurement to pass back
+	measurement := v1alpha1.Measurement{
+		StartedAt: &startTime,
+	}
+
+	value, status, err := p.call(run, metric)
+	if err != nil {
+		return metricutil.MarkMeasurementError(measurement, err)
+	}
+
+	measurement.Value = value
+	measurement.Phase = status
+	finishedTime := metav1.Now()
+	measurement.FinishedAt = &finishedTime
+
+	return measurement
+}
+
+// Resume should not be used the GRPC provider since all the work should occur in the Run method
+func (p *Provider) Resume(run *v1alpha1.HookRun, metric v1alpha1.Metric, measurement v1alpha1.Measurement) v1alpha1.Measurement {
+	klog.Warningf("HookRun: %s/%s, metric: %s. GRPC provider should not execute the Resume method", run.Namespace, run.Name, metric.Name)
+	return measurement
+}
+
+// Terminate should not be used the GRPC provider since all the work should occur in the Run method
+func (p *Provider) Terminate(run *v1alpha1.HookRun, metric v1alpha1.Metric, measurement v1alpha1.Measurement) v1alpha1.Measurement {
+	klog.Warningf("HookRun: %s/%s, metric: %s. GRPC provider should not execute the Terminate method", run.Namespace, run.Name, metric.Name)
+	return measurement
+}
+
+// GarbageCollect is a no-op for the GRPC provider
+func (p *Provider) GarbageCollect(run *v1alpha1.HookRun, metric v1alpha1.Metric, limit int) error {
+	return nil
+}
+
+func (p *Provider) call(run *v1alpha1.HookRun, metric v1alpha1.Metric) (string, v1alpha1.HookPhase, error) {
+	grpcMetric := metric.Provider.GRPC
+	address, err := templateutil.ResolveArgs(grpcMetric.Address, run.Spec.Args)
+	if err != nil {
+		return "", v1alpha1.HookPhaseError, err
+	}
+	request, err := templateutil.ResolveArgs(grpcMetric.Request, run.Spec.Args)
+	if err != nil {
+		return "", v1alpha1.HookPhaseError, err
+	}
+	service, method, err := splitMethod(grpcMetric.Method)
+	if err != nil {
+		return "", v1alpha1.HookPhaseError, err
+	}
+
+	// Using a default timeout of 10 seconds
+	timeout := time.Duration(10) * time.Second
+	if grpcMetric.TimeoutSeconds > 0 {
+		timeout = time.Duration(grpcMetric.TimeoutSeconds) * time.Second
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	dialOpt, err := transportOption(grpcMetric.TLS, address)
+	if err != nil {
+		return "", v1alpha1.HookPhaseError, err
+	}
+	conn, err := grpc.DialContext(ctx, address, dialOpt, grpc.WithBlock())
+	if err != nil {
+		return "", v1alpha1.HookPhaseError, fmt.Errorf("dial %s failed: %v", address, err)
+	}
+	defer conn.Close()
+
+	if len(grpcMetric.Metadata) > 0 {
+		md := metadata.MD{}
+		for _, header := range grpcMetric.Metadata {
+			value, err := templateutil.ResolveArgs(header.Value, run.Spec.Args)
+			if err != nil {
+				return "", v1alpha1.HookPhaseError, err
+			}
+			md.Append(header.Key, value)
+		}
+		ctx = metadata.NewOutgoingContext(ctx, md)
+	}
+
+	methodDesc, err := p.resolver.ResolveMethod(ctx, conn, service, method)
+	if err != nil {
+		return "", v1alpha1.HookPhaseError, err
+	}
+	response, err := invoke(ctx, conn, methodDesc, request)
+	if err != nil {
+		return "", v1alpha1.HookPhaseError, err
+	}
+
+	out, err := parseResponse(grpcMetric.JsonPath, response)
+	if err != nil {
+		return "", v1alpha1.HookPhaseError, err
+	}
+	status := evaluate.EvaluateResult(out, metric)
+	return out, status, nil
+}
+
+// invoke calls the unary method with the json request and returns the response in json
+func invoke(ctx context.Context, conn *grpc.ClientConn, methodDesc protoreflect.MethodDescriptor,
+	request string) ([]byte, error) {
+	if methodDesc.IsStreamingClient() || methodDesc.IsStreamingServer() {
+		return nil, fmt.Errorf("method %s is not unary", methodDesc.FullName())
+	}
+	req := dynamicpb.NewMessage(methodDesc.Input())
+	if strings.TrimSpace(request) != "" {
+		if err := protojson.Unmarshal([]byte(request), req); err != nil {
+			return nil, fmt.Errorf("could not parse request to %s: %v", methodDesc.Input().FullName(), err)
+		}
+	}
+	reqBytes, err := proto.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	var respBytes []byte
+	fullMethod := fmt.Sprintf("/%s/%s", methodDesc.Parent().FullName(), methodDesc.Name())
+	if err = conn.Invoke(ctx, fullMethod, reqBytes, &respBytes, grpc.ForceCodec(rawCodec{})); err != nil {
+		return nil, fmt.Errorf("call %s failed: %v", fullMethod, err)
+	}
+	resp := dynamicpb.NewMessage(methodDesc.Output())
+	if err = proto.Unmarshal(respBytes, resp); err != nil {
+		return nil, fmt.Errorf("could not decode response of %s: %v", fullMethod, err)
+	}
+	return protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(resp)
+}
+
+// parseResponse returns the value of jsonPath in the response, or the whole response if jsonPath is empty
+func parseResponse(path string, response []byte) (string, error) {
+	if path == "" {
+		return string(response), nil
+	}
+	var data interface{}
+	if err := json.Unmarshal(response, &data); err != nil {
+		return "", fmt.Errorf("Could not parse JSON response: %v", err)
+	}
+	jsonParser := jsonpath.New("metrics")
+	if err := jsonParser.Parse(path); err != nil {
+		return "", err
+	}
+	buf := new(bytes.Buffer)
+	if err := jsonParser.Execute(buf, data); err != nil {
+		return "", fmt.Errorf("Could not find JsonPath in response: %s", err)
+	}
+	return buf.String(), nil
+}
+
+// splitMethod splits "pkg.Service/Method" or "/pkg.Service/Method" to service and method name
+func splitMethod(fullMethod string) (string, string, error) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	pos := strings.LastIndex(fullMethod, "/")
+	if pos <= 0 || pos == len(fullMethod)-1 {
+		return "", "", fmt.Errorf("invalid grpc method %q, should be like package.Service/Method", fullMethod)
+	}
+	return fullMethod[:pos], fullMethod[pos+1:], nil
+}
+
+func transportOption(config *v1alpha1.GRPCTLSConfig, address string) (grpc.DialOption, error) {
+	if config == nil {
+		return grpc.WithInsecure(), nil
+	}
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: config.InsecureSkipVerify,
+		ServerName:         config.ServerName,
+	}
+	if tlsConfig.ServerName == "" {
+		if host, _, err := net.SplitHostPort(address); err == nil {
+			tlsConfig.ServerName = host
+		}
+	}
+	if config.CA != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(config.CA)) {
+			return nil, fmt.Errorf("no certs were appended from ca")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	return grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)), nil
+}
+
+// rawCodec passes the already encoded messages through, so that any message can be sent
+type rawCodec struct{}
+
+// Marshal returns the bytes as is
+func (rawCodec) Marshal(v interface{}) ([]byte, error) {
+	b, ok := v.([]byte)
+	if !ok {
+		return nil, fmt.Errorf("rawCodec can not marshal %T", v)
+	}
+	return b, nil
+}
+
+// Unmarshal copies the bytes to v
+func (rawCodec) Unmarshal(data []byte, v interface{}) error {
+	b, ok := v.(*[]byte)
+	if !ok {
+		return fmt.Errorf("rawCodec can not unmarshal to %T", v)
+	}
+	*b = append((*b)[:0], data...)
+	return nil
+}
+
+// Name returns the proto codec name, the content is still protobuf on the wire
+func (rawCodec) Name() string {
+	return "proto"
+}
@@ -0,0 +1,62 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitMethod(t *testing.T) {
+	service, method, err := splitMethod("/grpc.health.v1.Health/Check")
+	assert.Nil(t, err)
+	assert.Equal(t, "grpc.health.v1.Health", service)
+	assert.Equal(t, "Check", method)
+
+	_, _, err = splitMethod("grpc.health.v1.Health")
+	assert.NotNil(t, err)
+	_, _, err = splitMethod("grpc.health.v1.Health/")
+	assert.NotNil(t, err)
+}
+
+func TestParseResponse(t *testing.T) {
+	response := []byte(`{"status": "SERVING", "pods": [{"name": "p0", "sessions": 3}]}`)
+	out, err := parseResponse("{$.status}", response)
+	assert.Nil(t, err)
+	assert.Equal(t, "SERVING", out)
+
+	out, err = parseResponse("{$.pods[0].sessions}", response)
+	assert.Nil(t, err)
+	assert.Equal(t, "3", out)
+
+	out, err = parseResponse("", response)
+	assert.Nil(t, err)
+	assert.Equal(t, string(response), out)
+
+	_, err = parseResponse("{$.missing}", response)
+	assert.NotNil(t, err)
+}
+
+func TestResolveHealthMethod(t *testing.T) {
+	r := &reflectionResolver{}
+	methodDesc, err := r.ResolveMethod(context.Background(), nil, healthService, "Check")
+	assert.Nil(t, err)
+	assert.Equal(t, "grpc.health.v1.HealthCheckRequest", string(methodDesc.Input().FullName()))
+	assert.Equal(t, "grpc.health.v1.HealthCheckResponse", string(methodDesc.Output().FullName()))
+
+	_, err = r.ResolveMethod(context.Background(), nil, healthService, "Watch")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,158 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+const healthService = "grpc.health.v1.Health"
+
+// MethodResolver resolves the descriptor of a grpc method
+type MethodResolver interface {
+	ResolveMethod(ctx context.Context, conn *grpc.ClientConn, service, method string) (
+		protoreflect.MethodDescriptor, error)
+}
+
+// reflectionResolver resolves methods through the grpc server reflection service,
+// the standard health check is resolved locally so servers without reflection can be checked
+type reflectionResolver struct{}
+
+// ResolveMethod resolves the method of the service
+func (r *reflectionResolver) ResolveMethod(ctx context.Context, conn *grpc.ClientConn, service,
+	method string) (protoreflect.MethodDescriptor, error) {
+	var files *protoregistry.Files
+	var err error
+	if service == healthService {
+		files, err = healthFiles()
+	} else {
+		files, err = r.fetchFiles(ctx, conn, service)
+	}
+	if err != nil {
+		return nil, err
+	}
+	desc, err := files.FindDescriptorByName(protoreflect.FullName(service))
+	if err != nil {
+		return nil, fmt.Errorf("service %s not found: %v", service, err)
+	}
+	serviceDesc, ok := desc.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a service", service)
+	}
+	methodDesc := serviceDesc.Methods().ByName(protoreflect.Name(method))
+	if methodDesc == nil {
+		return nil, fmt.Errorf("method %s not found in service %s", method, service)
+	}
+	return methodDesc, nil
+}
+
+// fetchFiles fetches the file defining the service and all its dependencies
+func (r *reflectionResolver) fetchFiles(ctx context.Context, conn *grpc.ClientConn,
+	service string) (*protoregistry.Files, error) {
+	stream, err := rpb.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("server reflection is not available: %v", err)
+	}
+	defer stream.CloseSend() // nolint
+	if err = stream.Send(&rpb.ServerReflectionRequest{
+		MessageRequest: &rpb.ServerReflectionRequest_FileContainingSymbol{FileContainingSymbol: service},
+	}); err != nil {
+		return nil, fmt.Errorf("server reflection is not available: %v", err)
+	}
+	resp, err := stream.Recv()
+	if err != nil {
+		return nil, fmt.Errorf("server reflection is not available: %v", err)
+	}
+	if errResp := resp.GetErrorResponse(); errResp != nil {
+		return nil, fmt.Errorf("resolve service %s failed: %s", service, errResp.GetErrorMessage())
+	}
+	fdResp := resp.GetFileDescriptorResponse()
+	if fdResp == nil {
+		return nil, fmt.Errorf("resolve service %s failed: unexpected reflection response", service)
+	}
+	// the server sends the file and all the dependencies not sent yet on the stream
+	fileSet := &descriptorpb.FileDescriptorSet{}
+	for _, b := range fdResp.GetFileDescriptorProto() {
+		fd := &descriptorpb.FileDescriptorProto{}
+		if err = proto.Unmarshal(b, fd); err != nil {
+			return nil, fmt.Errorf("decode file descriptor of %s failed: %v", service, err)
+		}
+		fileSet.File = append(fileSet.File, fd)
+	}
+	files, err := protodesc.NewFiles(fileSet)
+	if err != nil {
+		return nil, fmt.Errorf("build file descriptors of %s failed: %v", service, err)
+	}
+	return files, nil
+}
+
+// healthFiles builds the descriptor of grpc/health/v1/health.proto
+func healthFiles() (*protoregistry.Files, error) {
+	optional := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum()
+	file := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("grpc/health/v1/health.proto"),
+		Package: proto.String("grpc.health.v1"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: proto.String("HealthCheckRequest"),
+				Field: []*descriptorpb.FieldDescriptorProto{{
+					Name:     proto.String("service"),
+					JsonName: proto.String("service"),
+					Number:   proto.Int32(1),
+					Label:    optional,
+					Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+				}},
+			},
+			{
+				Name: proto.String("HealthCheckResponse"),
+				Field: []*descriptorpb.FieldDescriptorProto{{
+					Name:     proto.String("status"),
+					JsonName: proto.String("status"),
+					Number:   proto.Int32(1),
+					Label:    optional,
+					Type:     descriptorpb.FieldDescriptorProto_TYPE_ENUM.Enum(),
+					TypeName: proto.String(".grpc.health.v1.HealthCheckResponse.ServingStatus"),
+				}},
+				EnumType: []*descriptorpb.EnumDescriptorProto{{
+					Name: proto.String("ServingStatus"),
+					Value: []*descriptorpb.EnumValueDescriptorProto{
+						{Name: proto.String("UNKNOWN"), Number: proto.Int32(0)},
+						{Name: proto.String("SERVING"), Number: proto.Int32(1)},
+						{Name: proto.String("NOT_SERVING"), Number: proto.Int32(2)},
+						{Name: proto.String("SERVICE_UNKNOWN"), Number: proto.Int32(3)},
+					},
+				}},
+			},
+		},
+		Service: []*descriptorpb.ServiceDescriptorProto{{
+			Name: proto.String("Health"),
+			Method: []*descriptorpb.MethodDescriptorProto{{
+				Name:       proto.String("Check"),
+				InputType:  proto.String(".grpc.health.v1.HealthCheckRequest"),
+				OutputType: proto.String(".grpc.health.v1.HealthCheckResponse"),
+			}},
+		}},
+	}
+	return protodesc.NewFiles(&descriptorpb.FileDescriptorSet{File: []*descriptorpb.FileDescriptorProto{file}})
+}
@@ -0,0 +1,283 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package job
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	hooksutil "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-hook-operator/pkg/util/hook"
+	metricutil "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-hook-operator/pkg/util/metric"
+	templateutil "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-hook-operator/pkg/util/template"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/kubernetes/common/bcs-hook/apis/tkex/v1alpha1"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog"
+)
+
+const (
+	// ProviderType indicates the provider is job.
+	ProviderType = "Job"
+	// HookRunUIDLabelKey is the label of the jobs created for a hookrun
+	HookRunUIDLabelKey = "hookrun.tkex.tencent.com/uid"
+	// MetricNameAnnotationKey is the annotation of the metric name which the job is created for
+	MetricNameAnnotationKey = "hookrun.tkex.tencent.com/metric-name"
+	// MetricHashLabelKey is the label of the hash of the metric name, the metric name may be not a valid label value
+	MetricHashLabelKey = "hookrun.tkex.tencent.com/metric-hash"
+
+	// resumeInterval is the interval to check the status of a running job
+	resumeInterval = 10 * time.Second
+	// maxJobNameLength keeps the job-name label of the pods valid
+	maxJobNameLength = 63
+)
+
+// Provider runs a kubernetes job as the measurement
+type Provider struct {
+	kubeClient kubernetes.Interface
+}
+
+// NewJobProvider creates a job provider
+func NewJobProvider(kubeClient kubernetes.Interface) *Provider {
+	return &Provider{kubeClient: kubeClient}
+}
+
+// Type incidates provider is a job provider
+func (p *Provider) Type() string {
+	return ProviderType
+}
+
+// Run creates the job of the measurement, the job is checked in Resume until it finishes
+func (p *Provider) Run(run *v1alpha1.HookRun, metric v1alpha1.Metric) v1alpha1.Measurement {
+	startTime := metav1.Now()
+	measurement := v1alpha1.Measurement{
+		StartedAt: &startTime,
+		Phase:     v1alpha1.HookPhaseRunning,
+	}
+
+	job, err := newJob(run, metric)
+	if err != nil {
+		return metricutil.MarkMeasurementError(measurement, err)
+	}
+	created, err := p.kubeClient.BatchV1().Jobs(job.Namespace).Create(context.TODO(), job, metav1.CreateOptions{})
+	if err != nil {
+		if !k8serrors.IsAlreadyExists(err) {
+			return metricutil.MarkMeasurementError(measurement, err)
+		}
+		// the job was created by a previous Run whose status was not saved
+		created, err = p.kubeClient.BatchV1().Jobs(job.Namespace).Get(context.TODO(), job.Name, metav1.GetOptions{})
+		if err != nil {
+			return metricutil.MarkMeasurementError(measurement, err)
+		}
+	}
+	klog.Infof("HookRun: %s/%s, metric: %s. created job %s", run.Namespace, run.Name, metric.Name, created.Name)
+	return updateMeasurement(measurement, created)
+}
+
+// Resume checks the status of the job
+func (p *Provider) Resume(run *v1alpha1.HookRun, metric v1alpha1.Metric,
+	measurement v1alpha1.Measurement) v1alpha1.Measurement {
+	name := jobName(run, metric)
+	job, err := p.kubeClient.BatchV1().Jobs(run.Namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			err = fmt.Errorf("job %s is not found", name)
+		}
+		return metricutil.MarkMeasurementError(measurement, err)
+	}
+	return updateMeasurement(measurement, job)
+}
+
+// Terminate deletes the running job
+func (p *Provider) Terminate(run *v1alpha1.HookRun, metric v1alpha1.Metric,
+	measurement v1alpha1.Measurement) v1alpha1.Measurement {
+	name := jobName(run, metric)
+	if err := p.deleteJob(run.Namespace, name); err != nil {
+		return metricutil.MarkMeasurementError(measurement, err)
+	}
+	klog.Infof("HookRun: %s/%s, metric: %s. terminated job %s", run.Namespace, run.Name, metric.Name, name)
+	measurement.Phase = v1alpha1.HookPhaseSuccessful
+	measurement.ResumeAt = nil
+	finishedTime := metav1.Now()
+	measurement.FinishedAt = &finishedTime
+	return measurement
+}
+
+// GarbageCollect deletes the oldest finished jobs of the metric, keeps the latest limit jobs
+func (p *Provider) GarbageCollect(run *v1alpha1.HookRun, metric v1alpha1.Metric, limit int) error {
+	selector := labels.SelectorFromSet(labels.Set{
+		HookRunUIDLabelKey: string(run.UID),
+		MetricHashLabelKey: metricHash(metric.Name),
+	})
+	jobList, err := p.kubeClient.BatchV1().Jobs(run.Namespace).List(context.TODO(),
+		metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return err
+	}
+	jobs := jobList.Items
+	if len(jobs) <= limit {
+		return nil
+	}
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].CreationTimestamp.Before(&jobs[j].CreationTimestamp)
+	})
+	for i := 0; i < len(jobs)-limit; i++ {
+		if _, finished := jobFinished(&jobs[i]); !finished {
+			continue
+		}
+		if err := p.deleteJob(jobs[i].Namespace, jobs[i].Name); err != nil {
+			return err
+		}
+		klog.Infof("HookRun: %s/%s, metric: %s. garbage collected job %s", run.Namespace, run.Name,
+			metric.Name, jobs[i].Name)
+	}
+	return nil
+}
+
+func (p *Provider) deleteJob(namespace, name string) error {
+	// delete the pods of the job as well
+	propagation := metav1.DeletePropagationBackground
+	err := p.kubeClient.BatchV1().Jobs(namespace).Delete(context.TODO(), name,
+		metav1.DeleteOptions{PropagationPolicy: &propagation})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// newJob builds the job of the next measurement from the template of the metric
+func newJob(run *v1alpha1.HookRun, metric v1alpha1.Metric) (*batchv1.Job, error) {
+	template, err := resolveTemplate(metric.Provider.Job, run.Spec.Args)
+	if err != nil {
+		return nil, err
+	}
+	job := &batchv1.Job{
+		ObjectMeta: template.Metadata,
+		Spec:       template.Spec,
+	}
+	job.Name = jobName(run, metric)
+	job.GenerateName = ""
+	job.Namespace = run.Namespace
+	if job.Labels == nil {
+		job.Labels = make(map[string]string)
+	}
+	job.Labels[HookRunUIDLabelKey] = string(run.UID)
+	job.Labels[MetricHashLabelKey] = metricHash(metric.Name)
+	if job.Annotations == nil {
+		job.Annotations = make(map[string]string)
+	}
+	job.Annotations[MetricNameAnnotationKey] = metric.Name
+	job.OwnerReferences = []metav1.OwnerReference{
+		*metav1.NewControllerRef(run, v1alpha1.SchemeGroupVersion.WithKind("HookRun")),
+	}
+	// the job is the measurement, retry by the metric instead of restarting the pod
+	if job.Spec.Template.Spec.RestartPolicy == "" {
+		job.Spec.Template.Spec.RestartPolicy = corev1.RestartPolicyNever
+	}
+	return job, nil
+}
+
+// resolveTemplate substitutes the args in the job template
+func resolveTemplate(template *v1alpha1.JobMetric, args []v1alpha1.Argument) (*v1alpha1.JobMetric, error) {
+	data, err := json.Marshal(template)
+	if err != nil {
+		return nil, err
+	}
+	// the args are substituted in json, escape them to keep the json valid
+	escapedArgs := make([]v1alpha1.Argument, 0, len(args))
+	for _, arg := range args {
+		if arg.Value != nil {
+			escaped, err := json.Marshal(*arg.Value)
+			if err != nil {
+				return nil, err
+			}
+			value := string(escaped[1 : len(escaped)-1])
+			arg.Value = &value
+		}
+		escapedArgs = append(escapedArgs, arg)
+	}
+	resolved, err := templateutil.ResolveArgs(string(data), escapedArgs)
+	if err != nil {
+		return nil, err
+	}
+	result := &v1alpha1.JobMetric{}
+	if err = json.Unmarshal([]byte(resolved), result); err != nil {
+		return nil, fmt.Errorf("invalid job template after args are resolved: %v", err)
+	}
+	return result, nil
+}
+
+// jobName generates the name of the job of the current measurement, the measurement is counted
+// when it is completed, so the running measurement always gets the same name
+func jobName(run *v1alpha1.HookRun, metric v1alpha1.Metric) string {
+	var count int32
+	if result := hooksutil.GetResult(run, metric.Name); result != nil {
+		count = result.Count
+	}
+	suffix := fmt.Sprintf("-%s-%d", metricHash(string(run.UID)+"/"+metric.Name), count+1)
+	prefix := strings.ToLower(run.Name)
+	if len(prefix)+len(suffix) > maxJobNameLength {
+		prefix = strings.TrimRight(prefix[:maxJobNameLength-len(suffix)], "-.")
+	}
+	return prefix + suffix
+}
+
+func metricHash(name string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(name)))[:10]
+}
+
+// jobFinished returns the finished condition type of the job
+func jobFinished(job *batchv1.Job) (*batchv1.JobCondition, bool) {
+	for i := range job.Status.Conditions {
+		condition := &job.Status.Conditions[i]
+		if (condition.Type == batchv1.JobComplete || condition.Type == batchv1.JobFailed) &&
+			condition.Status == corev1.ConditionTrue {
+			return condition, true
+		}
+	}
+	return nil, false
+}
+
+// updateMeasurement updates the measurement by the status of the job
+func updateMeasurement(measurement v1alpha1.Measurement, job *batchv1.Job) v1alpha1.Measurement {
+	condition, finished := jobFinished(job)
+	if !finished {
+		measurement.Phase = v1alpha1.HookPhaseRunning
+		measurement.Value = fmt.Sprintf("active: %d, succeeded: %d, failed: %d", job.Status.Active,
+			job.Status.Succeeded, job.Status.Failed)
+		resumeAt := metav1.NewTime(time.Now().Add(resumeInterval))
+		measurement.ResumeAt = &resumeAt
+		return measurement
+	}
+	measurement.Value = string(condition.Type)
+	measurement.Message = condition.Message
+	if condition.Type == batchv1.JobComplete {
+		measurement.Phase = v1alpha1.HookPhaseSuccessful
+	} else {
+		measurement.Phase = v1alpha1.HookPhaseFailed
+	}
+	measurement.ResumeAt = nil
+	finishedTime := metav1.Now()
+	measurement.FinishedAt = &finishedTime
+	return measurement
+}
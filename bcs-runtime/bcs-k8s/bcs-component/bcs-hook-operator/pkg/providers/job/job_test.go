@@ -0,0 +1,137 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package job
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-hook-operator/pkg/util/testutil"
+	hookv1alpha1 "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/kubernetes/common/bcs-hook/apis/tkex/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newJobMetric() hookv1alpha1.Metric {
+	return hookv1alpha1.Metric{
+		Name: "warm-cache",
+		Provider: hookv1alpha1.MetricProvider{Job: &hookv1alpha1.JobMetric{
+			Metadata: metav1.ObjectMeta{Labels: map[string]string{"app": "warm"}},
+			Spec: batchv1.JobSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{
+							Name:  "warm",
+							Image: "busybox",
+							Args:  []string{"warm", "--pod={{ args.PodName }}"},
+						}},
+					},
+				},
+			},
+		}},
+	}
+}
+
+func newJobHookRun() *hookv1alpha1.HookRun {
+	hr := testutil.NewHookRun("m0")
+	hr.UID = "d1b6ff1a-5f3b-4b11-8d5d-2f7d5b0d6a0e"
+	podName := `pod-"0"`
+	hr.Spec.Args = []hookv1alpha1.Argument{{Name: "PodName", Value: &podName}}
+	return hr
+}
+
+func TestRunAndResume(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset()
+	p := NewJobProvider(kubeClient)
+	hr := newJobHookRun()
+	metric := newJobMetric()
+
+	measurement := p.Run(hr, metric)
+	assert.Equal(t, hookv1alpha1.HookPhaseRunning, measurement.Phase)
+	assert.NotNil(t, measurement.ResumeAt)
+
+	job, err := kubeClient.BatchV1().Jobs(hr.Namespace).Get(context.TODO(), jobName(hr, metric), metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"warm", `--pod=pod-"0"`}, job.Spec.Template.Spec.Containers[0].Args)
+	assert.Equal(t, corev1.RestartPolicyNever, job.Spec.Template.Spec.RestartPolicy)
+	assert.Equal(t, "warm", job.Labels["app"])
+	assert.Equal(t, string(hr.UID), job.Labels[HookRunUIDLabelKey])
+	assert.Equal(t, metric.Name, job.Annotations[MetricNameAnnotationKey])
+
+	// run again is idempotent
+	measurement = p.Run(hr, metric)
+	assert.Equal(t, hookv1alpha1.HookPhaseRunning, measurement.Phase)
+
+	job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue,
+		Message: "BackoffLimitExceeded"}}
+	_, err = kubeClient.BatchV1().Jobs(hr.Namespace).UpdateStatus(context.TODO(), job, metav1.UpdateOptions{})
+	assert.Nil(t, err)
+	measurement = p.Resume(hr, metric, measurement)
+	assert.Equal(t, hookv1alpha1.HookPhaseFailed, measurement.Phase)
+	assert.Equal(t, "BackoffLimitExceeded", measurement.Message)
+	assert.NotNil(t, measurement.FinishedAt)
+}
+
+func TestTerminate(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset()
+	p := NewJobProvider(kubeClient)
+	hr := newJobHookRun()
+	metric := newJobMetric()
+
+	measurement := p.Run(hr, metric)
+	measurement = p.Terminate(hr, metric, measurement)
+	assert.Equal(t, hookv1alpha1.HookPhaseSuccessful, measurement.Phase)
+	jobs, err := kubeClient.BatchV1().Jobs(hr.Namespace).List(context.TODO(), metav1.ListOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(jobs.Items))
+}
+
+func TestGarbageCollect(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset()
+	p := NewJobProvider(kubeClient)
+	hr := newJobHookRun()
+	metric := newJobMetric()
+
+	for i := 0; i < 3; i++ {
+		hr.Status.MetricResults = []hookv1alpha1.MetricResult{{Name: metric.Name, Count: int32(i)}}
+		p.Run(hr, metric)
+		job, err := kubeClient.BatchV1().Jobs(hr.Namespace).Get(context.TODO(), jobName(hr, metric),
+			metav1.GetOptions{})
+		assert.Nil(t, err)
+		job.CreationTimestamp = metav1.Unix(int64(i), 0)
+		// the last job is still running
+		if i < 2 {
+			job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+		}
+		_, err = kubeClient.BatchV1().Jobs(hr.Namespace).Update(context.TODO(), job, metav1.UpdateOptions{})
+		assert.Nil(t, err)
+	}
+
+	assert.Nil(t, p.GarbageCollect(hr, metric, 1))
+	jobs, err := kubeClient.BatchV1().Jobs(hr.Namespace).List(context.TODO(), metav1.ListOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(jobs.Items))
+	assert.Equal(t, jobName(hr, metric), jobs.Items[0].Name)
+}
+
+func TestJobName(t *testing.T) {
+	hr := newJobHookRun()
+	hr.Name = "gamedeployment-sample-predelete-0123456789-abcdefghijklmnopqrstuvwxyz"
+	name := jobName(hr, newJobMetric())
+	assert.True(t, len(name) <= maxJobNameLength)
+	assert.NotEqual(t, name, jobName(hr, hookv1alpha1.Metric{Name: "other"}))
+}
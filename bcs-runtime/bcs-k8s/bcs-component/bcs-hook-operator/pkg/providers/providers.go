@@ -16,6 +16,8 @@ package providers
 import (
 	"fmt"
 
+	grpcprovider "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-hook-operator/pkg/providers/grpc"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-hook-operator/pkg/providers/job"
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-hook-operator/pkg/providers/kube"
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-hook-operator/pkg/providers/prometheus"
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-hook-operator/pkg/providers/web"
@@ -62,6 +64,10 @@ func (f *ProviderFactory) NewProvider(metric v1alpha1.Metric) (Provider, error)
 			return nil, err
 		}
 		return kube.NewKubeProvider(dynamicClient, discoveryClient), nil
+	} else if metric.Provider.GRPC != nil {
+		return grpcprovider.NewGRPCProvider(), nil
+	} else if metric.Provider.Job != nil {
+		return job.NewJobProvider(f.KubeClient), nil
 	}
 	return nil, fmt.Errorf("no valid provider in metric '%s'", metric.Name)
 }
@@ -0,0 +1,249 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.,
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under,
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package audit
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+)
+
+const (
+	// DecisionAllowed plugin allows the request without patch
+	DecisionAllowed = "allowed"
+	// DecisionPatched plugin allows the request with patch
+	DecisionPatched = "patched"
+	// DecisionDenied plugin denies the request
+	DecisionDenied = "denied"
+	// DecisionError plugin returns invalid response
+	DecisionError = "error"
+
+	// ModeEnforce the decision of plugin takes effect
+	ModeEnforce = "enforce"
+	// ModeAudit the decision of plugin is only recorded
+	ModeAudit = "audit"
+
+	// DefaultDecisionLogSize default capacity of decision log
+	DefaultDecisionLogSize = 1000
+)
+
+// Decision admission decision made by a plugin
+type Decision struct {
+	Time      time.Time        `json:"time"`
+	UID       string           `json:"uid"`
+	Plugin    string           `json:"plugin"`
+	Mode      string           `json:"mode"`
+	Decision  string           `json:"decision"`
+	Operation string           `json:"operation"`
+	Kind      string           `json:"kind"`
+	Namespace string           `json:"namespace"`
+	Name      string           `json:"name"`
+	Patch     []PatchOperation `json:"patch,omitempty"`
+	Latency   time.Duration    `json:"latency"`
+	Error     string           `json:"error,omitempty"`
+}
+
+// PatchOperation op and path of a patch operation made by plugin, values are not recorded
+// because patches may carry secrets, such as the sdk-appSecret env injected by dbprivilege
+type PatchOperation struct {
+	Op   string `json:"op"`
+	Path string `json:"path"`
+}
+
+// SummarizeJSONPatch returns op and path of each operation in json patch, nil is returned
+// when patch cannot be decoded
+func SummarizeJSONPatch(patch []byte) []PatchOperation {
+	var operations []PatchOperation
+	if err := json.Unmarshal(patch, &operations); err != nil {
+		return nil
+	}
+	return operations
+}
+
+// SummarizeMergePatch returns paths of fields changed by json merge patch, field set to null
+// is removed and other fields are replaced, nil is returned when patch cannot be decoded
+func SummarizeMergePatch(patch []byte) []PatchOperation {
+	var obj map[string]interface{}
+	if err := json.Unmarshal(patch, &obj); err != nil {
+		return nil
+	}
+	var operations []PatchOperation
+	summarizeMergePatch("", obj, &operations)
+	return operations
+}
+
+func summarizeMergePatch(prefix string, obj map[string]interface{}, operations *[]PatchOperation) {
+	keys := make([]string, 0, len(obj))
+	for key := range obj {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		path := prefix + "/" + strings.NewReplacer("~", "~0", "/", "~1").Replace(key)
+		switch value := obj[key].(type) {
+		case nil:
+			*operations = append(*operations, PatchOperation{Op: "remove", Path: path})
+		case map[string]interface{}:
+			if len(value) == 0 {
+				*operations = append(*operations, PatchOperation{Op: "replace", Path: path})
+				continue
+			}
+			summarizeMergePatch(path, value, operations)
+		default:
+			*operations = append(*operations, PatchOperation{Op: "replace", Path: path})
+		}
+	}
+}
+
+// FormatPatch returns operations in format "op path", split by comma
+func FormatPatch(operations []PatchOperation) string {
+	items := make([]string, 0, len(operations))
+	for _, operation := range operations {
+		items = append(items, operation.Op+" "+operation.Path)
+	}
+	return strings.Join(items, ", ")
+}
+
+// Filter filter for listing decisions, empty field matches all
+type Filter struct {
+	Plugin    string
+	Mode      string
+	Decision  string
+	Namespace string
+	Kind      string
+	Limit     int
+}
+
+func (f *Filter) match(d *Decision) bool {
+	if len(f.Plugin) != 0 && f.Plugin != d.Plugin {
+		return false
+	}
+	if len(f.Mode) != 0 && f.Mode != d.Mode {
+		return false
+	}
+	if len(f.Decision) != 0 && f.Decision != d.Decision {
+		return false
+	}
+	if len(f.Namespace) != 0 && f.Namespace != d.Namespace {
+		return false
+	}
+	if len(f.Kind) != 0 && f.Kind != d.Kind {
+		return false
+	}
+	return true
+}
+
+// DecisionLog ring buffer for recent admission decisions, the oldest decision is
+// overwritten when the buffer is full
+type DecisionLog struct {
+	lock      sync.RWMutex
+	decisions []*Decision
+	next      int
+	full      bool
+}
+
+// NewDecisionLog create decision log with capacity size
+func NewDecisionLog(size int) *DecisionLog {
+	if size <= 0 {
+		size = DefaultDecisionLogSize
+	}
+	return &DecisionLog{
+		decisions: make([]*Decision, size),
+	}
+}
+
+// Record records decision
+func (dl *DecisionLog) Record(d *Decision) {
+	dl.lock.Lock()
+	defer dl.lock.Unlock()
+	dl.decisions[dl.next] = d
+	dl.next = (dl.next + 1) % len(dl.decisions)
+	if dl.next == 0 {
+		dl.full = true
+	}
+}
+
+// List list decisions matching filter, the latest decision comes first
+func (dl *DecisionLog) List(filter *Filter) []*Decision {
+	dl.lock.RLock()
+	defer dl.lock.RUnlock()
+	count := dl.next
+	if dl.full {
+		count = len(dl.decisions)
+	}
+	result := make([]*Decision, 0)
+	for i := 1; i <= count; i++ {
+		d := dl.decisions[(dl.next-i+len(dl.decisions))%len(dl.decisions)]
+		if !filter.match(d) {
+			continue
+		}
+		result = append(result, d)
+		if filter.Limit > 0 && len(result) >= filter.Limit {
+			break
+		}
+	}
+	return result
+}
+
+// ServeHTTP lists decisions, query parameters plugin, mode, decision, namespace, kind and limit
+// are supported for filtering
+func (dl *DecisionLog) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	filter := &Filter{
+		Plugin:    query.Get("plugin"),
+		Mode:      query.Get("mode"),
+		Decision:  query.Get("decision"),
+		Namespace: query.Get("namespace"),
+		Kind:      query.Get("kind"),
+	}
+	if limit := query.Get("limit"); len(limit) != 0 {
+		var err error
+		if filter.Limit, err = strconv.Atoi(limit); err != nil {
+			http.Error(w, "invalid limit "+limit, http.StatusBadRequest)
+			return
+		}
+	}
+	data, err := json.Marshal(dl.List(filter))
+	if err != nil {
+		blog.Errorf("encode decisions failed, err %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(data); err != nil {
+		blog.Errorf("write decisions failed, err %s", err.Error())
+	}
+}
+
+// TokenAuth requires bearer token in Authorization header before calling handler,
+// no authentication is done when token is empty
+func TokenAuth(token string, handler http.Handler) http.Handler {
+	if len(token) == 0 {
+		return handler
+	}
+	expected := []byte("Bearer " + token)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), expected) != 1 {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,115 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.,
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under,
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package audit
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestDecisionLog(t *testing.T) {
+	dl := NewDecisionLog(3)
+	if decisions := dl.List(&Filter{}); len(decisions) != 0 {
+		t.Fatalf("expect empty decisions, but get %d", len(decisions))
+	}
+	for _, name := range []string{"a", "b", "c", "d"} {
+		dl.Record(&Decision{Plugin: "p", Name: name, Decision: DecisionAllowed})
+	}
+	decisions := dl.List(&Filter{})
+	if len(decisions) != 3 {
+		t.Fatalf("expect 3 decisions, but get %d", len(decisions))
+	}
+	for index, name := range []string{"d", "c", "b"} {
+		if decisions[index].Name != name {
+			t.Errorf("expect decision %d with name %s, but get %s", index, name, decisions[index].Name)
+		}
+	}
+	dl.Record(&Decision{Plugin: "q", Name: "e", Decision: DecisionDenied})
+	if decisions := dl.List(&Filter{Plugin: "q"}); len(decisions) != 1 || decisions[0].Name != "e" {
+		t.Errorf("expect decision e of plugin q, but get %v", decisions)
+	}
+	if decisions := dl.List(&Filter{Limit: 1}); len(decisions) != 1 || decisions[0].Name != "e" {
+		t.Errorf("expect latest decision e, but get %v", decisions)
+	}
+}
+
+func TestDecisionLogServeHTTP(t *testing.T) {
+	dl := NewDecisionLog(10)
+	dl.Record(&Decision{Plugin: "p", Name: "a", Mode: ModeAudit, Decision: DecisionPatched})
+	dl.Record(&Decision{Plugin: "p", Name: "b", Mode: ModeEnforce, Decision: DecisionAllowed})
+
+	recorder := httptest.NewRecorder()
+	dl.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/?mode=audit", nil))
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expect status 200, but get %d", recorder.Code)
+	}
+	var decisions []*Decision
+	if err := json.Unmarshal(recorder.Body.Bytes(), &decisions); err != nil {
+		t.Fatal(err)
+	}
+	if len(decisions) != 1 || decisions[0].Name != "a" {
+		t.Errorf("expect decision a, but get %v", decisions)
+	}
+
+	recorder = httptest.NewRecorder()
+	dl.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/?limit=x", nil))
+	if recorder.Code != http.StatusBadRequest {
+		t.Errorf("expect status 400, but get %d", recorder.Code)
+	}
+}
+
+func TestSummarizePatch(t *testing.T) {
+	jsonPatch := []byte(`[{"op":"add","path":"/spec/containers/0/env/-","value":{"name":"sdk-appSecret","value":"secret"}}]`)
+	if patch := FormatPatch(SummarizeJSONPatch(jsonPatch)); patch != "add /spec/containers/0/env/-" {
+		t.Errorf("unexpected json patch summary %s", patch)
+	}
+	if operations := SummarizeJSONPatch([]byte("invalid")); operations != nil {
+		t.Errorf("expect nil operations for invalid patch, but get %v", operations)
+	}
+
+	mergePatch := []byte(`{"metadata":{"annotations":{"a/b":"secret","c":null}},"spec":{"replicas":2}}`)
+	if patch := FormatPatch(SummarizeMergePatch(mergePatch)); patch !=
+		"replace /metadata/annotations/a~1b, remove /metadata/annotations/c, replace /spec/replicas" {
+		t.Errorf("unexpected merge patch summary %s", patch)
+	}
+
+	data, err := json.Marshal(&Decision{Patch: SummarizeJSONPatch(jsonPatch)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "secret") {
+		t.Errorf("patch value is recorded in decision %s", string(data))
+	}
+}
+
+func TestTokenAuth(t *testing.T) {
+	dl := NewDecisionLog(10)
+	handler := TokenAuth("token", dl)
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+	if recorder.Code != http.StatusUnauthorized {
+		t.Errorf("expect status 401 without token, but get %d", recorder.Code)
+	}
+
+	recorder = httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	request.Header.Set("Authorization", "Bearer token")
+	handler.ServeHTTP(recorder, request)
+	if recorder.Code != http.StatusOK {
+		t.Errorf("expect status 200 with token, but get %d", recorder.Code)
+	}
+}
@@ -51,6 +51,18 @@ var (
 		Help:      "plugin request latency statistic for bcs-webhook-server",
 		Buckets:   []float64{0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1.0, 2.0, 3.0},
 	}, []string{"pluginName", "status"})
+
+	pluginDecision = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: BkBcsWebhookServer,
+		Name:      "plugin_decision_total_num",
+		Help:      "The total num of admission decisions made by bcs-webhook-server plugins",
+	}, []string{"pluginName", "decision", "mode"})
+	pluginDecisionLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: BkBcsWebhookServer,
+		Name:      "plugin_decision_latency_time",
+		Help:      "admission decision latency statistic for bcs-webhook-server plugins",
+		Buckets:   []float64{0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1.0, 2.0, 3.0},
+	}, []string{"pluginName", "mode"})
 )
 
 func init() {
@@ -59,6 +71,9 @@ func init() {
 	prometheus.MustRegister(requestLatencyAPI)
 
 	prometheus.MustRegister(pluginLatency)
+
+	prometheus.MustRegister(pluginDecision)
+	prometheus.MustRegister(pluginDecisionLatency)
 }
 
 //ReportBcsWebhookServerAPIMetrics report all api action metrics
@@ -71,3 +86,9 @@ func ReportBcsWebhookServerAPIMetrics(handler, method, status string, started ti
 func ReportBcsWebhookServerPluginLantency(pluginName, status string, started time.Time) {
 	pluginLatency.WithLabelValues(pluginName, status).Observe(time.Since(started).Seconds())
 }
+
+// ReportBcsWebhookServerPluginDecision report admission decision of plugin, mode is enforce or audit
+func ReportBcsWebhookServerPluginDecision(pluginName, decision, mode string, latency time.Duration) {
+	pluginDecision.WithLabelValues(pluginName, decision, mode).Inc()
+	pluginDecisionLatency.WithLabelValues(pluginName, mode).Observe(latency.Seconds())
+}
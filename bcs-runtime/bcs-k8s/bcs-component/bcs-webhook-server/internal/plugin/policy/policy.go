@@ -0,0 +1,360 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.,
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under,
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package policy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"k8s.io/api/admission/v1beta1"
+	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	apiextensionsclient "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	restclient "k8s.io/client-go/rest"
+	clientGoCache "k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/internal/metrics"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/internal/pluginutil"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/internal/types"
+)
+
+// Hooker webhook for generic admission policies, the policies are defined by BcsAdmissionPolicy
+// and take effect without restarting webhook server
+type Hooker struct {
+	stopCh chan struct{}
+	opt    *Options
+
+	policyLock sync.RWMutex
+	policies   map[string]*compiledPolicy
+}
+
+// NewHooker create policy hooker
+func NewHooker() *Hooker {
+	return &Hooker{
+		policies: make(map[string]*compiledPolicy),
+	}
+}
+
+// AnnotationKey implements plugin interface, policy works on all objects
+func (h *Hooker) AnnotationKey() string {
+	return ""
+}
+
+// Init implements plugin interface
+func (h *Hooker) Init(configFilePath string) error {
+	fileBytes, err := ioutil.ReadFile(configFilePath)
+	if err != nil {
+		blog.Errorf("read policy config file %s failed, err %s", configFilePath, err.Error())
+		return fmt.Errorf("read policy config file %s failed, err %s", configFilePath, err.Error())
+	}
+	h.opt = &Options{}
+	if err = json.Unmarshal(fileBytes, h.opt); err != nil {
+		blog.Errorf("decode policy config failed, err %s", err.Error())
+		return fmt.Errorf("decode policy config failed, err %s", err.Error())
+	}
+	h.stopCh = make(chan struct{})
+	return h.initKubeClient()
+}
+
+func (h *Hooker) initKubeClient() error {
+	var cfg *restclient.Config
+	var err error
+	if len(h.opt.KubeMaster) == 0 && len(h.opt.Kubeconfig) == 0 {
+		cfg, err = restclient.InClusterConfig()
+		if err != nil {
+			return fmt.Errorf("build config from in cluster failed, err %s", err.Error())
+		}
+	} else {
+		cfg, err = clientcmd.BuildConfigFromFlags(h.opt.KubeMaster, h.opt.Kubeconfig)
+		if err != nil {
+			return fmt.Errorf("building kubeconfig failed, err %s", err.Error())
+		}
+	}
+	extensionClient, err := apiextensionsclient.NewForConfig(cfg)
+	if err != nil {
+		return fmt.Errorf("buildling extension clientset failed, err %s", err.Error())
+	}
+	created, err := h.createPolicyCrd(extensionClient)
+	if err != nil {
+		return fmt.Errorf("create admission policy crd failed, err %s", err.Error())
+	}
+	blog.Infof("created BcsAdmissionPolicy crd: %t", created)
+
+	dynamicClient, err := dynamic.NewForConfig(cfg)
+	if err != nil {
+		return fmt.Errorf("build dynamic client failed, err %s", err.Error())
+	}
+	factory := dynamicinformer.NewDynamicSharedInformerFactory(dynamicClient, 0)
+	informer := factory.ForResource(policyGVR).Informer()
+	informer.AddEventHandler(clientGoCache.ResourceEventHandlerFuncs{
+		AddFunc: h.onPolicyChanged,
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			h.onPolicyChanged(newObj)
+		},
+		DeleteFunc: h.onPolicyDeleted,
+	})
+	go factory.Start(h.stopCh)
+	blog.Infof("Waiting for BcsAdmissionPolicy informer caches to sync")
+	if ok := clientGoCache.WaitForCacheSync(h.stopCh, informer.HasSynced); !ok {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+	return nil
+}
+
+// create crd of BcsAdmissionPolicy
+func (h *Hooker) createPolicyCrd(clientset apiextensionsclient.Interface) (bool, error) {
+	crd := &apiextensionsv1beta1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: policyGVR.GroupResource().String(),
+		},
+		Spec: apiextensionsv1beta1.CustomResourceDefinitionSpec{
+			Group:   policyGVR.Group,
+			Version: policyGVR.Version,
+			Scope:   apiextensionsv1beta1.ClusterScoped,
+			Names: apiextensionsv1beta1.CustomResourceDefinitionNames{
+				Plural:   policyPlural,
+				Kind:     policyKind,
+				ListKind: policyListKind,
+			},
+		},
+	}
+	_, err := clientset.ApiextensionsV1beta1().CustomResourceDefinitions().Create(
+		context.Background(), crd, metav1.CreateOptions{})
+	if err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			blog.Infof("crd is already exists: %s", err)
+			return false, nil
+		}
+		blog.Errorf("create crd failed: %s", err)
+		return false, err
+	}
+	return true, nil
+}
+
+func (h *Hooker) onPolicyChanged(obj interface{}) {
+	unstruct, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		blog.Warnf("unexpected BcsAdmissionPolicy object type %T", obj)
+		return
+	}
+	policy := &BcsAdmissionPolicy{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstruct.UnstructuredContent(), policy); err != nil {
+		blog.Errorf("convert BcsAdmissionPolicy %s failed, err %s", unstruct.GetName(), err.Error())
+		return
+	}
+	if err := h.updatePolicy(policy); err != nil {
+		blog.Errorf("load BcsAdmissionPolicy %s failed, err %s", policy.GetName(), err.Error())
+	}
+}
+
+func (h *Hooker) onPolicyDeleted(obj interface{}) {
+	if tombstone, ok := obj.(clientGoCache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	unstruct, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		blog.Warnf("unexpected BcsAdmissionPolicy object type %T", obj)
+		return
+	}
+	h.deletePolicy(unstruct.GetName())
+}
+
+// updatePolicy compiles policy and replaces the old one, the invalid policy is removed so
+// that the stale rules no longer take effect
+func (h *Hooker) updatePolicy(policy *BcsAdmissionPolicy) error {
+	cp, err := compilePolicy(policy)
+	if err != nil {
+		h.deletePolicy(policy.GetName())
+		return err
+	}
+	h.policyLock.Lock()
+	defer h.policyLock.Unlock()
+	h.policies[cp.name] = cp
+	blog.Infof("BcsAdmissionPolicy %s with %d rules loaded", cp.name, len(cp.rules))
+	return nil
+}
+
+func (h *Hooker) deletePolicy(name string) {
+	h.policyLock.Lock()
+	defer h.policyLock.Unlock()
+	if _, ok := h.policies[name]; ok {
+		delete(h.policies, name)
+		blog.Infof("BcsAdmissionPolicy %s unloaded", name)
+	}
+}
+
+// listPolicies list policies concerned with request, sorted by name
+func (h *Hooker) listPolicies(req *v1beta1.AdmissionRequest, namespace string) []*compiledPolicy {
+	h.policyLock.RLock()
+	defer h.policyLock.RUnlock()
+	var policies []*compiledPolicy
+	for _, cp := range h.policies {
+		if cp.isConcerned(req, namespace) {
+			policies = append(policies, cp)
+		}
+	}
+	sort.Slice(policies, func(i, j int) bool {
+		return policies[i].name < policies[j].name
+	})
+	return policies
+}
+
+// Handle implements plugin interface
+func (h *Hooker) Handle(ar v1beta1.AdmissionReview) *v1beta1.AdmissionResponse {
+	req := ar.Request
+	started := time.Now()
+	raw := req.Object.Raw
+	if req.Operation == v1beta1.Delete {
+		raw = req.OldObject.Raw
+	}
+	object := make(map[string]interface{})
+	if err := json.Unmarshal(raw, &object); err != nil {
+		blog.Errorf("cannot decode raw object %s, err %s", string(raw), err.Error())
+		metrics.ReportBcsWebhookServerPluginLantency(pluginName, metrics.StatusFailure, started)
+		return pluginutil.ToAdmissionResponse(err)
+	}
+	// Deal with potential empty fields, e.g., when the pod is created by a deployment
+	namespace := req.Namespace
+	if len(namespace) == 0 {
+		namespace = (&unstructured.Unstructured{Object: object}).GetNamespace()
+	}
+	policies := h.listPolicies(req, namespace)
+	if len(policies) == 0 {
+		return &v1beta1.AdmissionResponse{Allowed: true}
+	}
+	env, err := newEvalEnv(req, namespace, object)
+	if err != nil {
+		blog.Errorf("create evaluation env failed, err %s", err.Error())
+		metrics.ReportBcsWebhookServerPluginLantency(pluginName, metrics.StatusFailure, started)
+		return pluginutil.ToAdmissionResponse(err)
+	}
+
+	var patches []types.PatchOperation
+	for _, cp := range policies {
+		for _, cr := range cp.rules {
+			newPatches, modified, response := h.applyRule(cp, cr, env, raw)
+			if response != nil {
+				metrics.ReportBcsWebhookServerPluginLantency(pluginName, metrics.StatusFailure, started)
+				return response
+			}
+			if len(newPatches) == 0 {
+				continue
+			}
+			// later rules are evaluated on the patched object
+			modifiedObject := make(map[string]interface{})
+			if err := json.Unmarshal(modified, &modifiedObject); err != nil {
+				metrics.ReportBcsWebhookServerPluginLantency(pluginName, metrics.StatusFailure, started)
+				return pluginutil.ToAdmissionResponse(err)
+			}
+			raw = modified
+			env["object"] = modifiedObject
+			patches = append(patches, newPatches...)
+		}
+	}
+
+	patchesBytes, err := json.Marshal(patches)
+	if err != nil {
+		blog.Errorf("encoding patches failed, err %s", err.Error())
+		metrics.ReportBcsWebhookServerPluginLantency(pluginName, metrics.StatusFailure, started)
+		return pluginutil.ToAdmissionResponse(err)
+	}
+	metrics.ReportBcsWebhookServerPluginLantency(pluginName, metrics.StatusSuccess, started)
+	return &v1beta1.AdmissionResponse{
+		Allowed: true,
+		Patch:   patchesBytes,
+		PatchType: func() *v1beta1.PatchType {
+			pt := v1beta1.PatchTypeJSONPatch
+			return &pt
+		}(),
+	}
+}
+
+// applyRule evaluates rule on object, returns patches and patched object when rule matches,
+// returns response when the request should be rejected
+func (h *Hooker) applyRule(cp *compiledPolicy, cr *compiledRule, env map[string]interface{}, raw []byte) (
+	[]types.PatchOperation, []byte, *v1beta1.AdmissionResponse) {
+	matched, err := cr.match(env)
+	if err != nil {
+		return nil, nil, h.handleError(cp, cr, fmt.Errorf("evaluate match expression failed, err %s", err.Error()))
+	}
+	if !matched {
+		return nil, nil, nil
+	}
+	if cr.message != nil {
+		message, err := cr.renderMessage(env)
+		if err != nil {
+			return nil, nil, h.handleError(cp, cr, err)
+		}
+		blog.Infof("request %s is rejected by policy %s rule %s", env["request"], cp.name, cr.name)
+		return nil, nil, &v1beta1.AdmissionResponse{
+			Allowed: false,
+			Result: &metav1.Status{
+				Code:    http.StatusForbidden,
+				Reason:  metav1.StatusReasonForbidden,
+				Message: fmt.Sprintf("policy %s rule %s: %s", cp.name, cr.name, message),
+			},
+		}
+	}
+	// object cannot be mutated when it is deleted
+	if env["request"].(map[string]interface{})["operation"] == string(v1beta1.Delete) {
+		return nil, nil, nil
+	}
+	patches, err := cr.renderPatches(env)
+	if err != nil {
+		return nil, nil, h.handleError(cp, cr, err)
+	}
+	patchesBytes, err := json.Marshal(patches)
+	if err != nil {
+		return nil, nil, h.handleError(cp, cr, err)
+	}
+	patchObj, err := jsonpatch.DecodePatch(patchesBytes)
+	if err != nil {
+		return nil, nil, h.handleError(cp, cr, fmt.Errorf("decode patch failed, err %s", err.Error()))
+	}
+	modified, err := patchObj.Apply(raw)
+	if err != nil {
+		return nil, nil, h.handleError(cp, cr, fmt.Errorf("apply patch failed, err %s", err.Error()))
+	}
+	return patches, modified, nil
+}
+
+// handleError returns reject response when failure policy is Fail, otherwise the rule is ignored
+func (h *Hooker) handleError(cp *compiledPolicy, cr *compiledRule, err error) *v1beta1.AdmissionResponse {
+	blog.Warnf("policy %s rule %s failed, failurePolicy %s, err %s", cp.name, cr.name, cp.failurePolicy, err.Error())
+	if cp.failurePolicy == FailurePolicyFail {
+		return pluginutil.ToAdmissionResponse(fmt.Errorf("policy %s rule %s failed, err %s", cp.name, cr.name, err.Error()))
+	}
+	return nil
+}
+
+// Close implements plugin interface
+func (h *Hooker) Close() error {
+	if h.stopCh != nil {
+		close(h.stopCh)
+	}
+	return nil
+}
@@ -0,0 +1,281 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.,
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under,
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package policy
+
+import (
+	"encoding/json"
+	"testing"
+
+	"k8s.io/api/admission/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/internal/types"
+)
+
+func newPolicy(name string, spec BcsAdmissionPolicySpec) *BcsAdmissionPolicy {
+	return &BcsAdmissionPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       spec,
+	}
+}
+
+func newReview(op v1beta1.Operation, namespace, object string) v1beta1.AdmissionReview {
+	req := &v1beta1.AdmissionRequest{
+		Kind:      metav1.GroupVersionKind{Version: "v1", Kind: "Pod"},
+		Namespace: namespace,
+		Operation: op,
+	}
+	if op == v1beta1.Delete {
+		req.OldObject = runtime.RawExtension{Raw: []byte(object)}
+	} else {
+		req.Object = runtime.RawExtension{Raw: []byte(object)}
+	}
+	return v1beta1.AdmissionReview{Request: req}
+}
+
+func TestCompilePolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    BcsAdmissionPolicySpec
+		isError bool
+	}{
+		{
+			name: "valid policy",
+			spec: BcsAdmissionPolicySpec{Rules: []PolicyRule{
+				{Name: "r1", Match: `request.kind == "Pod"`, Message: "forbidden"},
+			}},
+		},
+		{
+			name:    "empty rules",
+			spec:    BcsAdmissionPolicySpec{},
+			isError: true,
+		},
+		{
+			name: "invalid expression",
+			spec: BcsAdmissionPolicySpec{Rules: []PolicyRule{
+				{Name: "r1", Match: `request.kind ==`, Message: "forbidden"},
+			}},
+			isError: true,
+		},
+		{
+			name: "unknown variable",
+			spec: BcsAdmissionPolicySpec{Rules: []PolicyRule{
+				{Name: "r1", Match: `pod.kind == "Pod"`, Message: "forbidden"},
+			}},
+			isError: true,
+		},
+		{
+			name: "both patches and message",
+			spec: BcsAdmissionPolicySpec{Rules: []PolicyRule{
+				{Name: "r1", Match: "true", Message: "forbidden",
+					Patches: []PatchTemplate{{Op: PatchOperationAdd, Path: "/a", Value: "b"}}},
+			}},
+			isError: true,
+		},
+		{
+			name: "invalid patch op",
+			spec: BcsAdmissionPolicySpec{Rules: []PolicyRule{
+				{Name: "r1", Match: "true", Patches: []PatchTemplate{{Op: "move", Path: "/a"}}},
+			}},
+			isError: true,
+		},
+		{
+			name: "invalid failure policy",
+			spec: BcsAdmissionPolicySpec{FailurePolicy: "Unknown", Rules: []PolicyRule{
+				{Name: "r1", Match: "true", Message: "forbidden"},
+			}},
+			isError: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := compilePolicy(newPolicy("p", test.spec))
+			if err != nil && !test.isError {
+				t.Errorf("expect no error, but get err %s", err.Error())
+			}
+			if err == nil && test.isError {
+				t.Errorf("expect error, but no error")
+			}
+		})
+	}
+}
+
+func TestHandle(t *testing.T) {
+	pod := `{"metadata":{"name":"foo","namespace":"ns1","labels":{"app":"web"}},"spec":{}}`
+	tests := []struct {
+		name            string
+		policies        []*BcsAdmissionPolicy
+		review          v1beta1.AdmissionReview
+		expectedAllowed bool
+		expectedPatches []types.PatchOperation
+	}{
+		{
+			name: "add labels by templates",
+			policies: []*BcsAdmissionPolicy{
+				newPolicy("p1", BcsAdmissionPolicySpec{
+					Kinds: []string{"Pod"},
+					Rules: []PolicyRule{{
+						Name:  "label",
+						Match: `get(object, "metadata.labels.app") == "web"`,
+						Patches: []PatchTemplate{{
+							Op:    PatchOperationAdd,
+							Path:  "/metadata/labels/team",
+							Value: `{{ .object.metadata.labels.app }}-team`,
+						}},
+					}},
+				}),
+			},
+			review:          newReview(v1beta1.Create, "ns1", pod),
+			expectedAllowed: true,
+			expectedPatches: []types.PatchOperation{
+				{Op: PatchOperationAdd, Path: "/metadata/labels/team", Value: "web-team"},
+			},
+		},
+		{
+			name: "later rules see patched object",
+			policies: []*BcsAdmissionPolicy{
+				newPolicy("p1", BcsAdmissionPolicySpec{
+					Rules: []PolicyRule{
+						{
+							Name:  "annotations",
+							Match: `get(object, "metadata.annotations") == nil`,
+							Patches: []PatchTemplate{{
+								Op:    PatchOperationAdd,
+								Path:  "/metadata/annotations",
+								Value: `{"owner": "{{ .request.namespace }}"}`,
+							}},
+						},
+						{
+							Name:    "reject",
+							Match:   `get(object, "metadata.annotations.owner") != "ns1"`,
+							Message: "owner must be ns1",
+						},
+					},
+				}),
+			},
+			review:          newReview(v1beta1.Create, "ns1", pod),
+			expectedAllowed: true,
+			expectedPatches: []types.PatchOperation{
+				{Op: PatchOperationAdd, Path: "/metadata/annotations", Value: map[string]interface{}{"owner": "ns1"}},
+			},
+		},
+		{
+			name: "reject by message",
+			policies: []*BcsAdmissionPolicy{
+				newPolicy("p1", BcsAdmissionPolicySpec{
+					Namespaces: []string{"ns1"},
+					Rules: []PolicyRule{{
+						Name:    "no-web",
+						Match:   `object.metadata.labels.app == "web"`,
+						Message: "app {{ .object.metadata.labels.app }} is not allowed",
+					}},
+				}),
+			},
+			review:          newReview(v1beta1.Create, "ns1", pod),
+			expectedAllowed: false,
+		},
+		{
+			name: "namespace not concerned",
+			policies: []*BcsAdmissionPolicy{
+				newPolicy("p1", BcsAdmissionPolicySpec{
+					Namespaces: []string{"ns2"},
+					Rules:      []PolicyRule{{Name: "all", Match: "true", Message: "forbidden"}},
+				}),
+			},
+			review:          newReview(v1beta1.Create, "ns1", pod),
+			expectedAllowed: true,
+		},
+		{
+			name: "evaluation error is ignored",
+			policies: []*BcsAdmissionPolicy{
+				newPolicy("p1", BcsAdmissionPolicySpec{
+					Rules: []PolicyRule{{Name: "err", Match: `object.spec.containers[0].name == "a"`, Message: "forbidden"}},
+				}),
+			},
+			review:          newReview(v1beta1.Create, "ns1", pod),
+			expectedAllowed: true,
+		},
+		{
+			name: "evaluation error fails request",
+			policies: []*BcsAdmissionPolicy{
+				newPolicy("p1", BcsAdmissionPolicySpec{
+					FailurePolicy: FailurePolicyFail,
+					Rules:         []PolicyRule{{Name: "err", Match: `object.spec.containers[0].name == "a"`, Message: "forbidden"}},
+				}),
+			},
+			review:          newReview(v1beta1.Create, "ns1", pod),
+			expectedAllowed: false,
+		},
+		{
+			name: "no patches on delete",
+			policies: []*BcsAdmissionPolicy{
+				newPolicy("p1", BcsAdmissionPolicySpec{
+					Rules: []PolicyRule{{
+						Name:    "label",
+						Match:   "true",
+						Patches: []PatchTemplate{{Op: PatchOperationRemove, Path: "/metadata/labels"}},
+					}},
+				}),
+			},
+			review:          newReview(v1beta1.Delete, "ns1", pod),
+			expectedAllowed: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := NewHooker()
+			for _, p := range test.policies {
+				if err := h.updatePolicy(p); err != nil {
+					t.Fatalf("load policy failed, err %s", err.Error())
+				}
+			}
+			resp := h.Handle(test.review)
+			if resp.Allowed != test.expectedAllowed {
+				t.Fatalf("expect allowed %v, but get %v, result %v", test.expectedAllowed, resp.Allowed, resp.Result)
+			}
+			if !resp.Allowed {
+				return
+			}
+			expected, _ := json.Marshal(test.expectedPatches)
+			if len(resp.Patch) == 0 {
+				resp.Patch = []byte("null")
+			}
+			if string(expected) != string(resp.Patch) {
+				t.Errorf("expect patches %s, but get %s", string(expected), string(resp.Patch))
+			}
+		})
+	}
+}
+
+func TestDeletePolicy(t *testing.T) {
+	h := NewHooker()
+	policy := newPolicy("p1", BcsAdmissionPolicySpec{
+		Rules: []PolicyRule{{Name: "all", Match: "true", Message: "forbidden"}},
+	})
+	if err := h.updatePolicy(policy); err != nil {
+		t.Fatalf("load policy failed, err %s", err.Error())
+	}
+	review := newReview(v1beta1.Create, "ns1", `{"metadata":{"name":"foo"}}`)
+	if resp := h.Handle(review); resp.Allowed {
+		t.Errorf("expect request rejected by policy")
+	}
+	// invalid update removes the old policy
+	policy.Spec.Rules[0].Match = "invalid =="
+	if err := h.updatePolicy(policy); err == nil {
+		t.Errorf("expect error for invalid policy")
+	}
+	if resp := h.Handle(review); !resp.Allowed {
+		t.Errorf("expect request allowed after policy removed")
+	}
+}
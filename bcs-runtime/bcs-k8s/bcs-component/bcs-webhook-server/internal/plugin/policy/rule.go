@@ -0,0 +1,264 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.,
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under,
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package policy
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/antonmedv/expr"
+	"github.com/antonmedv/expr/vm"
+	"k8s.io/api/admission/v1beta1"
+
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/internal/types"
+)
+
+// exprEnv declares variables and functions available in match expressions
+var exprEnv = map[string]interface{}{
+	"request":   map[string]interface{}{},
+	"object":    map[string]interface{}{},
+	"oldObject": map[string]interface{}{},
+	"get":       getField,
+}
+
+// compiledPolicy policy with compiled expressions and templates
+type compiledPolicy struct {
+	name          string
+	kinds         map[string]struct{}
+	operations    map[string]struct{}
+	namespaces    map[string]struct{}
+	failurePolicy string
+	rules         []*compiledRule
+}
+
+// compiledRule rule with compiled expression and templates
+type compiledRule struct {
+	name    string
+	program *vm.Program
+	patches []*compiledPatch
+	message *template.Template
+}
+
+// compiledPatch json patch with compiled templates
+type compiledPatch struct {
+	op    string
+	path  *template.Template
+	value *template.Template
+}
+
+// compilePolicy validates policy and compiles all expressions and templates in it
+func compilePolicy(policy *BcsAdmissionPolicy) (*compiledPolicy, error) {
+	cp := &compiledPolicy{
+		name:          policy.GetName(),
+		kinds:         toSet(policy.Spec.Kinds),
+		operations:    toSet(policy.Spec.Operations),
+		namespaces:    toSet(policy.Spec.Namespaces),
+		failurePolicy: policy.Spec.FailurePolicy,
+	}
+	switch cp.failurePolicy {
+	case "":
+		cp.failurePolicy = FailurePolicyIgnore
+	case FailurePolicyIgnore, FailurePolicyFail:
+	default:
+		return nil, fmt.Errorf("invalid failurePolicy %s", cp.failurePolicy)
+	}
+	if len(policy.Spec.Rules) == 0 {
+		return nil, fmt.Errorf("rules cannot be empty")
+	}
+	for _, rule := range policy.Spec.Rules {
+		cr, err := compileRule(rule)
+		if err != nil {
+			return nil, fmt.Errorf("compile rule %s failed, err %s", rule.Name, err.Error())
+		}
+		cp.rules = append(cp.rules, cr)
+	}
+	return cp, nil
+}
+
+func compileRule(rule PolicyRule) (*compiledRule, error) {
+	if len(rule.Match) == 0 {
+		return nil, fmt.Errorf("match cannot be empty")
+	}
+	if len(rule.Patches) == 0 && len(rule.Message) == 0 {
+		return nil, fmt.Errorf("one of patches and message must be set")
+	}
+	if len(rule.Patches) != 0 && len(rule.Message) != 0 {
+		return nil, fmt.Errorf("patches and message cannot be set at the same time")
+	}
+	program, err := expr.Compile(rule.Match, expr.Env(exprEnv), expr.AsBool())
+	if err != nil {
+		return nil, fmt.Errorf("compile match expression failed, err %s", err.Error())
+	}
+	cr := &compiledRule{
+		name:    rule.Name,
+		program: program,
+	}
+	if len(rule.Message) != 0 {
+		if cr.message, err = template.New(rule.Name).Parse(rule.Message); err != nil {
+			return nil, fmt.Errorf("parse message template failed, err %s", err.Error())
+		}
+		return cr, nil
+	}
+	for index, patch := range rule.Patches {
+		switch patch.Op {
+		case PatchOperationAdd, PatchOperationReplace, PatchOperationRemove:
+		default:
+			return nil, fmt.Errorf("unsupported patch op %s", patch.Op)
+		}
+		cpt := &compiledPatch{op: patch.Op}
+		if cpt.path, err = template.New(fmt.Sprintf("path-%d", index)).Parse(patch.Path); err != nil {
+			return nil, fmt.Errorf("parse patch path template failed, err %s", err.Error())
+		}
+		if patch.Op != PatchOperationRemove {
+			if cpt.value, err = template.New(fmt.Sprintf("value-%d", index)).Parse(patch.Value); err != nil {
+				return nil, fmt.Errorf("parse patch value template failed, err %s", err.Error())
+			}
+		}
+		cr.patches = append(cr.patches, cpt)
+	}
+	return cr, nil
+}
+
+// isConcerned checks if policy works on the admission request
+func (cp *compiledPolicy) isConcerned(req *v1beta1.AdmissionRequest, namespace string) bool {
+	if !inSet(cp.kinds, req.Kind.Kind) || !inSet(cp.operations, string(req.Operation)) {
+		return false
+	}
+	return inSet(cp.namespaces, namespace)
+}
+
+// match evaluates match expression of rule
+func (cr *compiledRule) match(env map[string]interface{}) (matched bool, err error) {
+	// expression may panic when accessing field of nil value
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("evaluation panicked: %v", r)
+		}
+	}()
+	output, err := expr.Run(cr.program, env)
+	if err != nil {
+		return false, err
+	}
+	matched, ok := output.(bool)
+	if !ok {
+		return false, fmt.Errorf("match expression returns %T instead of bool", output)
+	}
+	return matched, nil
+}
+
+// renderMessage renders reject message of rule
+func (cr *compiledRule) renderMessage(env map[string]interface{}) (string, error) {
+	return render(cr.message, env)
+}
+
+// renderPatches renders json patches of rule
+func (cr *compiledRule) renderPatches(env map[string]interface{}) ([]types.PatchOperation, error) {
+	var patches []types.PatchOperation
+	for _, cpt := range cr.patches {
+		path, err := render(cpt.path, env)
+		if err != nil {
+			return nil, err
+		}
+		patch := types.PatchOperation{
+			Op:   cpt.op,
+			Path: path,
+		}
+		if cpt.value != nil {
+			value, err := render(cpt.value, env)
+			if err != nil {
+				return nil, err
+			}
+			var jsonValue interface{}
+			if err := json.Unmarshal([]byte(value), &jsonValue); err != nil {
+				// not a json value, use it as string
+				jsonValue = value
+			}
+			patch.Value = jsonValue
+		}
+		patches = append(patches, patch)
+	}
+	return patches, nil
+}
+
+// newEvalEnv creates environment for expressions and templates.
+// request: information of admission request
+// object: the object in request, it's the old object for DELETE operation
+// oldObject: the old object in request, nil for CREATE operation
+func newEvalEnv(req *v1beta1.AdmissionRequest, namespace string, object map[string]interface{}) (
+	map[string]interface{}, error) {
+	var oldObject map[string]interface{}
+	if len(req.OldObject.Raw) != 0 {
+		if err := json.Unmarshal(req.OldObject.Raw, &oldObject); err != nil {
+			return nil, fmt.Errorf("decode old object failed, err %s", err.Error())
+		}
+	}
+	return map[string]interface{}{
+		"request": map[string]interface{}{
+			"kind":      req.Kind.Kind,
+			"group":     req.Kind.Group,
+			"version":   req.Kind.Version,
+			"namespace": namespace,
+			"name":      req.Name,
+			"operation": string(req.Operation),
+			"username":  req.UserInfo.Username,
+			"groups":    req.UserInfo.Groups,
+		},
+		"object":    object,
+		"oldObject": oldObject,
+		"get":       getField,
+	}, nil
+}
+
+// getField get field value by path split by dot, returns nil when any field in path is not found,
+// e.g. get(object, "metadata.labels.app")
+func getField(obj interface{}, path string) interface{} {
+	current := obj
+	for _, field := range strings.Split(path, ".") {
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		if current, ok = m[field]; !ok {
+			return nil
+		}
+	}
+	return current
+}
+
+func render(t *template.Template, env map[string]interface{}) (string, error) {
+	buf := &bytes.Buffer{}
+	if err := t.Execute(buf, env); err != nil {
+		return "", fmt.Errorf("render template %s failed, err %s", t.Name(), err.Error())
+	}
+	return buf.String(), nil
+}
+
+func toSet(items []string) map[string]struct{} {
+	set := make(map[string]struct{})
+	for _, item := range items {
+		set[item] = struct{}{}
+	}
+	return set
+}
+
+// inSet returns true if set is empty or item is in set
+func inSet(set map[string]struct{}, item string) bool {
+	if len(set) == 0 {
+		return true
+	}
+	_, ok := set[item]
+	return ok
+}
@@ -0,0 +1,87 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.,
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under,
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package policy
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	pluginName = "policy"
+
+	// FailurePolicyIgnore ignore the rule when evaluation failed
+	FailurePolicyIgnore = "Ignore"
+	// FailurePolicyFail reject the request when evaluation failed
+	FailurePolicyFail = "Fail"
+
+	// PatchOperationAdd patch add operation
+	PatchOperationAdd = "add"
+	// PatchOperationReplace patch replace operation
+	PatchOperationReplace = "replace"
+	// PatchOperationRemove patch remove operation
+	PatchOperationRemove = "remove"
+
+	policyKind     = "BcsAdmissionPolicy"
+	policyListKind = "BcsAdmissionPolicyList"
+	policyPlural   = "bcsadmissionpolicies"
+)
+
+// policyGVR group version resource of BcsAdmissionPolicy
+var policyGVR = schema.GroupVersionResource{
+	Group:    "bkbcs.tencent.com",
+	Version:  "v1",
+	Resource: policyPlural,
+}
+
+// BcsAdmissionPolicy admission policy defined by platform teams, the policy is cluster scoped
+type BcsAdmissionPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec BcsAdmissionPolicySpec `json:"spec"`
+}
+
+// BcsAdmissionPolicySpec spec of BcsAdmissionPolicy
+type BcsAdmissionPolicySpec struct {
+	// Kinds kinds of object that the policy works on, empty means all kinds
+	Kinds []string `json:"kinds,omitempty"`
+	// Operations admission operations that the policy works on, empty means all operations
+	Operations []string `json:"operations,omitempty"`
+	// Namespaces namespaces that the policy works on, empty means all namespaces
+	Namespaces []string `json:"namespaces,omitempty"`
+	// FailurePolicy how to handle the evaluation error, Ignore or Fail, default is Ignore
+	FailurePolicy string `json:"failurePolicy,omitempty"`
+	// Rules are evaluated in order
+	Rules []PolicyRule `json:"rules"`
+}
+
+// PolicyRule rule of admission policy
+type PolicyRule struct {
+	// Name name of the rule
+	Name string `json:"name"`
+	// Match bool expression evaluated on the admission request, rule takes effect when it returns true
+	Match string `json:"match"`
+	// Patches json patch templates applied to object when the rule matches
+	Patches []PatchTemplate `json:"patches,omitempty"`
+	// Message template of message to reject the request when the rule matches
+	Message string `json:"message,omitempty"`
+}
+
+// PatchTemplate template of json patch, path and value are go templates
+type PatchTemplate struct {
+	Op   string `json:"op"`
+	Path string `json:"path"`
+	// Value rendered value is decoded as json, it is used as string when decoding failed
+	Value string `json:"value,omitempty"`
+}
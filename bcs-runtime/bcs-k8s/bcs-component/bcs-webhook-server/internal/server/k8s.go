@@ -28,6 +28,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 
 	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/internal/audit"
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/internal/metrics"
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/internal/pluginutil"
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/internal/types"
@@ -180,36 +181,64 @@ func (ws *WebhookServer) doK8sHook(ar v1beta1.AdmissionReview) *v1beta1.Admissio
 		}
 		blog.Infof("%s %s/%s hooked by plugin %s",
 			tmpUnstructKind, tmpUnstructName, tmpUnstructNs, pluginNames[index])
+		decision := &audit.Decision{
+			Time:      time.Now(),
+			UID:       string(req.UID),
+			Plugin:    pluginNames[index],
+			Mode:      audit.ModeEnforce,
+			Decision:  audit.DecisionAllowed,
+			Operation: string(req.Operation),
+			Kind:      tmpUnstructKind,
+			Namespace: tmpUnstructNs,
+			Name:      tmpUnstructName,
+		}
+		if ws.isAuditPlugin(pluginNames[index]) {
+			decision.Mode = audit.ModeAudit
+		}
 		// do webhook
 		tmpResponse := p.Handle(ar)
-		// when one plugin is not allowed, just return response
+		decision.Latency = time.Since(decision.Time)
 		if !tmpResponse.Allowed {
-			return tmpResponse
-		}
-		if len(tmpResponse.Patch) != 0 {
-			newPatches := make([]types.PatchOperation, 0)
-			err := json.Unmarshal(tmpResponse.Patch, &newPatches)
-			if err != nil {
-				blog.Errorf("decode plugin patches failed, err %s", err.Error())
-				return pluginutil.ToAdmissionResponse(
-					fmt.Errorf("decode plugin patches failed, err %s", err.Error()))
+			decision.Decision = audit.DecisionDenied
+			if tmpResponse.Result != nil {
+				decision.Error = tmpResponse.Result.Message
 			}
-			patches = append(patches, newPatches...)
-			// change the input for next plugin
-			patchObj, err := jsonpatch.DecodePatch(tmpResponse.Patch)
-			if err != nil {
-				blog.Errorf("decode patch failed, err %s", err.Error())
-				return pluginutil.ToAdmissionResponse(
-					fmt.Errorf("decode patch failed, err %s", err.Error()))
+			ws.recordDecision(decision)
+			// in audit mode, the denial is only recorded
+			if decision.Mode == audit.ModeAudit {
+				blog.Infof("%s %s/%s denied by plugin %s in audit mode, msg %s",
+					tmpUnstructKind, tmpUnstructName, tmpUnstructNs, pluginNames[index], decision.Error)
+				continue
 			}
-			modified, err := patchObj.Apply(req.Object.Raw)
-			if err != nil {
-				blog.Errorf("apply patch failed, err %s", err.Error())
-				return pluginutil.ToAdmissionResponse(
-					fmt.Errorf("apply patch failed, err %s", err.Error()))
+			// when one plugin is not allowed, just return response
+			return tmpResponse
+		}
+		if len(tmpResponse.Patch) == 0 {
+			ws.recordDecision(decision)
+			continue
+		}
+		decision.Decision = audit.DecisionPatched
+		decision.Patch = audit.SummarizeJSONPatch(tmpResponse.Patch)
+		newPatches, modified, err := applyPluginPatch(req.Object.Raw, tmpResponse.Patch)
+		if err != nil {
+			blog.Errorf("%s", err.Error())
+			decision.Decision = audit.DecisionError
+			decision.Error = err.Error()
+			ws.recordDecision(decision)
+			if decision.Mode == audit.ModeAudit {
+				continue
 			}
-			req.Object.Raw = modified
+			return pluginutil.ToAdmissionResponse(err)
 		}
+		ws.recordDecision(decision)
+		// in audit mode, the patch is only recorded
+		if decision.Mode == audit.ModeAudit {
+			blog.Infof("%s %s/%s patched by plugin %s in audit mode, patch %s",
+				tmpUnstructKind, tmpUnstructName, tmpUnstructNs, pluginNames[index], audit.FormatPatch(decision.Patch))
+			continue
+		}
+		patches = append(patches, newPatches...)
+		req.Object.Raw = modified
 	}
 	patchesBytes, err := json.Marshal(patches)
 	if err != nil {
@@ -226,3 +255,20 @@ func (ws *WebhookServer) doK8sHook(ar v1beta1.AdmissionReview) *v1beta1.Admissio
 	}
 	return &reviewResponse
 }
+
+// applyPluginPatch decodes patch returned by plugin and applies it to raw object
+func applyPluginPatch(raw, patch []byte) ([]types.PatchOperation, []byte, error) {
+	newPatches := make([]types.PatchOperation, 0)
+	if err := json.Unmarshal(patch, &newPatches); err != nil {
+		return nil, nil, fmt.Errorf("decode plugin patches failed, err %s", err.Error())
+	}
+	patchObj, err := jsonpatch.DecodePatch(patch)
+	if err != nil {
+		return nil, nil, fmt.Errorf("decode patch failed, err %s", err.Error())
+	}
+	modified, err := patchObj.Apply(raw)
+	if err != nil {
+		return nil, nil, fmt.Errorf("apply patch failed, err %s", err.Error())
+	}
+	return newPatches, modified, nil
+}
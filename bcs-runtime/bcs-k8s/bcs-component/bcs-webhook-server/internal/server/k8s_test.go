@@ -24,8 +24,10 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/internal/audit"
 	_ "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/internal/plugin/fake"
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/internal/pluginmanager"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/internal/types"
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/options"
 )
 
@@ -118,3 +120,67 @@ func TestK8SHook(t *testing.T) {
 		}
 	}
 }
+
+func TestK8SHookAuditMode(t *testing.T) {
+	opt := &options.ServerOption{
+		EngineType: options.EngineTypeKubernetes,
+		Plugins:    "fake",
+	}
+	pm := pluginmanager.NewManager(opt.EngineType, opt.PluginDir)
+	if err := pm.InitPlugins(strings.Split(opt.Plugins, ",")); err != nil {
+		t.Fatal(err)
+	}
+	server := &WebhookServer{
+		Opt:          opt,
+		EngineType:   opt.EngineType,
+		PluginMgr:    pm,
+		DecisionLog:  audit.NewDecisionLog(10),
+		AuditPlugins: map[string]struct{}{"fake": {}},
+	}
+
+	podBytes, err := json.Marshal(&corev1.Pod{
+		TypeMeta: metav1.TypeMeta{
+			Kind: "Pod",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "test",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp := server.doK8sHook(v1beta1.AdmissionReview{
+		Request: &v1beta1.AdmissionRequest{
+			Kind: metav1.GroupVersionKind{
+				Kind: "Pod",
+			},
+			Operation: v1beta1.Create,
+			Object: runtime.RawExtension{
+				Raw: podBytes,
+			},
+		},
+	})
+	if !resp.Allowed {
+		t.Fatalf("expect allowed but get denied")
+	}
+	var patches []types.PatchOperation
+	if err := json.Unmarshal(resp.Patch, &patches); err != nil {
+		t.Fatal(err)
+	}
+	if len(patches) != 0 {
+		t.Errorf("expect no patch applied in audit mode, but get %+v", patches)
+	}
+
+	decisions := server.DecisionLog.List(&audit.Filter{Plugin: "fake"})
+	if len(decisions) != 1 {
+		t.Fatalf("expect 1 decision, but get %d", len(decisions))
+	}
+	if decisions[0].Mode != audit.ModeAudit || decisions[0].Decision != audit.DecisionPatched {
+		t.Errorf("expect patched decision in audit mode, but get %s decision in %s mode",
+			decisions[0].Decision, decisions[0].Mode)
+	}
+	if decisions[0].Namespace != "test" || decisions[0].Name != "test" || len(decisions[0].Patch) == 0 {
+		t.Errorf("unexpected decision %+v", decisions[0])
+	}
+}
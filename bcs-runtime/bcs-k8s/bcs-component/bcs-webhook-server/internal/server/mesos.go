@@ -14,14 +14,18 @@ package server
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"strconv"
 	"time"
 
+	jsonpatch "github.com/evanphx/json-patch"
+
 	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
 	commtypes "github.com/Tencent/bk-bcs/bcs-common/common/types"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/internal/audit"
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/internal/metrics"
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/internal/types"
 )
@@ -152,7 +156,6 @@ func (ws *WebhookServer) MesosHook(w http.ResponseWriter, r *http.Request) {
 func (ws *WebhookServer) doAppHook(application *commtypes.ReplicaController) (*commtypes.ReplicaController, error) {
 	plugins := ws.PluginMgr.GetMesosPlugins()
 	pluginNames := ws.PluginMgr.GetMesosPluginNames()
-	var err error
 	patchedApplication := application
 
 	// check if object in ignore namespaces should be hooked
@@ -174,10 +177,24 @@ func (ws *WebhookServer) doAppHook(application *commtypes.ReplicaController) (*c
 	}
 
 	for index, p := range plugins {
-		patchedApplication, err = p.InjectApplicationContent(patchedApplication)
+		origin, err := json.Marshal(patchedApplication)
+		if err != nil {
+			return nil, fmt.Errorf("encode application failed, err %s", err.Error())
+		}
+		// plugin may modify the input, so inject a copy which is dropped in audit mode
+		input := &commtypes.ReplicaController{}
+		if err = json.Unmarshal(origin, input); err != nil {
+			return nil, fmt.Errorf("copy application failed, err %s", err.Error())
+		}
+		decision := ws.newMesosDecision(pluginNames[index], application.Kind, application.ObjectMeta)
+		injected, injectErr := p.InjectApplicationContent(input)
+		apply, err := ws.recordMesosDecision(decision, origin, injected, injectErr)
 		if err != nil {
 			return nil, fmt.Errorf("plugin %s inject appliction failed, err %s", pluginNames[index], err)
 		}
+		if apply {
+			patchedApplication = injected
+		}
 	}
 	return patchedApplication, nil
 }
@@ -185,7 +202,6 @@ func (ws *WebhookServer) doAppHook(application *commtypes.ReplicaController) (*c
 func (ws *WebhookServer) doDepHook(deployment *commtypes.BcsDeployment) (*commtypes.BcsDeployment, error) {
 	plugins := ws.PluginMgr.GetMesosPlugins()
 	pluginNames := ws.PluginMgr.GetMesosPluginNames()
-	var err error
 	patchedDeployment := deployment
 
 	// check if object in ignore namespaces should be hooked
@@ -207,10 +223,90 @@ func (ws *WebhookServer) doDepHook(deployment *commtypes.BcsDeployment) (*commty
 	}
 
 	for index, p := range plugins {
-		patchedDeployment, err = p.InjectDeployContent(patchedDeployment)
+		origin, err := json.Marshal(patchedDeployment)
+		if err != nil {
+			return nil, fmt.Errorf("encode deployment failed, err %s", err.Error())
+		}
+		// plugin may modify the input, so inject a copy which is dropped in audit mode
+		input := &commtypes.BcsDeployment{}
+		if err = json.Unmarshal(origin, input); err != nil {
+			return nil, fmt.Errorf("copy deployment failed, err %s", err.Error())
+		}
+		decision := ws.newMesosDecision(pluginNames[index], deployment.Kind, deployment.ObjectMeta)
+		injected, injectErr := p.InjectDeployContent(input)
+		apply, err := ws.recordMesosDecision(decision, origin, injected, injectErr)
 		if err != nil {
 			return nil, fmt.Errorf("plugin %s inject deployment failed, err %s", pluginNames[index], err)
 		}
+		if apply {
+			patchedDeployment = injected
+		}
 	}
 	return patchedDeployment, nil
 }
+
+// newMesosDecision creates decision of plugin for mesos object, mesos hook has no operation and uid
+func (ws *WebhookServer) newMesosDecision(plugin string, kind commtypes.BcsDataType,
+	meta commtypes.ObjectMeta) *audit.Decision {
+	decision := &audit.Decision{
+		Time:      time.Now(),
+		Plugin:    plugin,
+		Mode:      audit.ModeEnforce,
+		Decision:  audit.DecisionAllowed,
+		Kind:      string(kind),
+		Namespace: meta.NameSpace,
+		Name:      meta.Name,
+	}
+	if ws.isAuditPlugin(plugin) {
+		decision.Mode = audit.ModeAudit
+	}
+	return decision
+}
+
+// recordMesosDecision records the decision of mesos plugin, the patch is the json merge patch from origin
+// object to the injected one, and a plugin error denies the request.
+// It returns whether the injected object should be used, and the error which should fail the request.
+func (ws *WebhookServer) recordMesosDecision(d *audit.Decision, origin []byte, injected interface{},
+	injectErr error) (bool, error) {
+	d.Latency = time.Since(d.Time)
+	if injectErr != nil {
+		d.Decision = audit.DecisionDenied
+		d.Error = injectErr.Error()
+		ws.recordDecision(d)
+		// in audit mode, the denial is only recorded
+		if d.Mode == audit.ModeAudit {
+			blog.Infof("%s %s/%s denied by plugin %s in audit mode, msg %s",
+				d.Kind, d.Name, d.Namespace, d.Plugin, d.Error)
+			return false, nil
+		}
+		return false, injectErr
+	}
+	var patch []byte
+	modified, err := json.Marshal(injected)
+	if err == nil {
+		patch, err = jsonpatch.CreateMergePatch(origin, modified)
+	}
+	if err != nil {
+		d.Decision = audit.DecisionError
+		d.Error = fmt.Sprintf("create patch of injected object failed, err %s", err.Error())
+		ws.recordDecision(d)
+		if d.Mode == audit.ModeAudit {
+			return false, nil
+		}
+		return false, errors.New(d.Error)
+	}
+	if string(patch) == "{}" {
+		ws.recordDecision(d)
+		return true, nil
+	}
+	d.Decision = audit.DecisionPatched
+	d.Patch = audit.SummarizeMergePatch(patch)
+	ws.recordDecision(d)
+	// in audit mode, the patch is only recorded
+	if d.Mode == audit.ModeAudit {
+		blog.Infof("%s %s/%s patched by plugin %s in audit mode, patch %s",
+			d.Kind, d.Name, d.Namespace, d.Plugin, audit.FormatPatch(d.Patch))
+		return false, nil
+	}
+	return true, nil
+}
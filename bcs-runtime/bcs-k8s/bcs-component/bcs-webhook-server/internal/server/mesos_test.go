@@ -0,0 +1,105 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.,
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under,
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	commtypes "github.com/Tencent/bk-bcs/bcs-common/common/types"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/internal/audit"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/options"
+)
+
+func TestRecordMesosDecision(t *testing.T) {
+	application := &commtypes.ReplicaController{
+		TypeMeta:   commtypes.TypeMeta{Kind: commtypes.BcsDataType_APP},
+		ObjectMeta: commtypes.ObjectMeta{Name: "test", NameSpace: "test"},
+	}
+	origin, err := json.Marshal(application)
+	if err != nil {
+		t.Fatal(err)
+	}
+	injected := &commtypes.ReplicaController{}
+	if err = json.Unmarshal(origin, injected); err != nil {
+		t.Fatal(err)
+	}
+	injected.Labels = map[string]string{"injected": "true"}
+
+	testCases := []struct {
+		title     string
+		audit     bool
+		injected  *commtypes.ReplicaController
+		injectErr error
+		apply     bool
+		hasErr    bool
+		decision  string
+	}{
+		{
+			title:    "allowed",
+			injected: application,
+			apply:    true,
+			decision: audit.DecisionAllowed,
+		},
+		{
+			title:    "patched",
+			injected: injected,
+			apply:    true,
+			decision: audit.DecisionPatched,
+		},
+		{
+			title:    "patched in audit mode",
+			audit:    true,
+			injected: injected,
+			decision: audit.DecisionPatched,
+		},
+		{
+			title:     "denied",
+			injectErr: fmt.Errorf("invalid application"),
+			hasErr:    true,
+			decision:  audit.DecisionDenied,
+		},
+		{
+			title:     "denied in audit mode",
+			audit:     true,
+			injectErr: fmt.Errorf("invalid application"),
+			decision:  audit.DecisionDenied,
+		},
+	}
+
+	for index, testCase := range testCases {
+		t.Logf("test %d, %s", index, testCase.title)
+		server := &WebhookServer{
+			Opt:         &options.ServerOption{AuditMode: testCase.audit},
+			DecisionLog: audit.NewDecisionLog(10),
+		}
+		d := server.newMesosDecision("fake", application.Kind, application.ObjectMeta)
+		apply, err := server.recordMesosDecision(d, origin, testCase.injected, testCase.injectErr)
+		if apply != testCase.apply || (err != nil) != testCase.hasErr {
+			t.Errorf("expect apply %t and error %t, but get %t and %v", testCase.apply, testCase.hasErr, apply, err)
+		}
+		decisions := server.DecisionLog.List(&audit.Filter{})
+		if len(decisions) != 1 {
+			t.Fatalf("expect 1 decision, but get %d", len(decisions))
+		}
+		if decisions[0].Decision != testCase.decision || decisions[0].Namespace != "test" ||
+			decisions[0].Kind != string(commtypes.BcsDataType_APP) {
+			t.Errorf("unexpected decision %+v", decisions[0])
+		}
+		if testCase.decision == audit.DecisionPatched &&
+			audit.FormatPatch(decisions[0].Patch) != "replace /metadata/labels/injected" {
+			t.Errorf("unexpected patch %+v", decisions[0].Patch)
+		}
+	}
+}
@@ -25,7 +25,8 @@ import (
 
 	"github.com/Tencent/bk-bcs/bcs-common/common"
 	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
-	_ "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/internal/metrics"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/internal/audit"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/internal/metrics"
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/internal/pluginmanager"
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-component/bcs-webhook-server/options"
 
@@ -39,6 +40,10 @@ type WebhookServer struct {
 	PluginMgr  *pluginmanager.Manager
 	EngineType string //kubernetes or mesos
 	PluginDir  string
+	// DecisionLog records recent admission decisions of plugins
+	DecisionLog *audit.DecisionLog
+	// AuditPlugins names of plugins running in audit mode
+	AuditPlugins map[string]struct{}
 }
 
 // NewWebhookServer new webhook server from options
@@ -55,11 +60,26 @@ func NewWebhookServer(opt *options.ServerOption) (*WebhookServer, error) {
 		return nil, err
 	}
 
+	auditPlugins := make(map[string]struct{})
+	for _, name := range strings.Split(opt.AuditPlugins, ",") {
+		if name = strings.TrimSpace(name); len(name) != 0 {
+			auditPlugins[name] = struct{}{}
+		}
+	}
+
+	// decision log is only kept when it can be queried
+	var decisionLog *audit.DecisionLog
+	if opt.DecisionLogEnabled {
+		decisionLog = audit.NewDecisionLog(int(opt.DecisionLogLen))
+	}
+
 	whsvr := &WebhookServer{
-		Opt:        opt,
-		EngineType: opt.EngineType,
-		PluginDir:  opt.PluginDir,
-		PluginMgr:  pm,
+		Opt:          opt,
+		EngineType:   opt.EngineType,
+		PluginDir:    opt.PluginDir,
+		PluginMgr:    pm,
+		DecisionLog:  decisionLog,
+		AuditPlugins: auditPlugins,
 		Server: &http.Server{
 			Addr:      fmt.Sprintf("%s:%v", opt.Address, opt.Port),
 			TLSConfig: &tls.Config{Certificates: []tls.Certificate{pair}},
@@ -92,7 +112,7 @@ func (ws *WebhookServer) Run() {
 	}
 
 	// run prometheus server
-	runPrometheusMetricsServer(ws.Opt)
+	runPrometheusMetricsServer(ws.Opt, ws.DecisionLog)
 
 	// listening OS shutdown singal
 	signalChan := make(chan os.Signal, 1)
@@ -107,11 +127,32 @@ func (ws *WebhookServer) Run() {
 	return
 }
 
-func runPrometheusMetricsServer(opt *options.ServerOption) {
+// isAuditPlugin returns true if the decision of plugin should only be recorded
+func (ws *WebhookServer) isAuditPlugin(name string) bool {
+	if ws.Opt != nil && ws.Opt.AuditMode {
+		return true
+	}
+	_, ok := ws.AuditPlugins[name]
+	return ok
+}
+
+// recordDecision records admission decision to decision log and metrics
+func (ws *WebhookServer) recordDecision(d *audit.Decision) {
+	metrics.ReportBcsWebhookServerPluginDecision(d.Plugin, d.Decision, d.Mode, d.Latency)
+	if ws.DecisionLog != nil {
+		ws.DecisionLog.Record(d)
+	}
+}
+
+func runPrometheusMetricsServer(opt *options.ServerOption, decisionLog *audit.DecisionLog) {
 	blog.Infof("begin register prometheus metrics server: port(%d)", opt.MetricPort)
 
 	// register prometheus server
 	http.Handle("/metrics", promhttp.Handler())
+	// register debug handler for recent admission decisions
+	if decisionLog != nil {
+		http.Handle("/debug/webhook/decisions", audit.TokenAuth(opt.DecisionLogToken, decisionLog))
+	}
 	addr := opt.Address + ":" + strconv.Itoa(int(opt.MetricPort))
 	go http.ListenAndServe(addr, nil)
 
@@ -34,6 +34,12 @@ type ServerOption struct {
 	EngineType     string `json:"engine_type" value:"kubernetes" usage:"the platform that bcs-webhook-server runs in, kubernetes or mesos"`
 	PluginDir      string `json:"plugin_dir" value:"./plugins" usage:"directory for bcs webhook plugins"`
 	Plugins        string `json:"plugins" value:"" usage:"plugin names, call plugin Handle in this order"`
+	AuditMode      bool   `json:"audit_mode" value:"false" usage:"if true, decisions of all plugins are only recorded and not take effect"`
+	AuditPlugins   string `json:"audit_plugins" value:"" usage:"plugin names running in audit mode, split by comma"`
+	DecisionLogLen uint   `json:"decision_log_len" value:"1000" usage:"max number of recent admission decisions kept in decision log"`
+	// decision log is served on metric port, it is disabled by default
+	DecisionLogEnabled bool   `json:"decision_log_enabled" value:"false" usage:"if true, recent admission decisions are served at /debug/webhook/decisions on metric port"`
+	DecisionLogToken   string `json:"decision_log_token" value:"" usage:"bearer token required by decision log endpoint, no authentication if empty"`
 }
 
 const (
@@ -56,5 +62,6 @@ func Parse(ops *ServerOption) error {
 		return fmt.Errorf("unsupported engine type %s", ops.EngineType)
 	}
 	strings.Replace(ops.Plugins, ";", ",", -1)
+	ops.AuditPlugins = strings.Replace(ops.AuditPlugins, ";", ",", -1)
 	return nil
 }
@@ -2327,6 +2327,917 @@ func (m *ListIPQuotaResp) GetQuotas() []*common.IPQuota {
 	return nil
 }
 
+type GetIPPoolUtilizationReq struct {
+	Seq                  uint64   `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	VpcID                string   `protobuf:"bytes,2,opt,name=vpcID,proto3" json:"vpcID,omitempty"`
+	Region               string   `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
+	SubnetID             string   `protobuf:"bytes,4,opt,name=subnetID,proto3" json:"subnetID,omitempty"`
+	Cluster              string   `protobuf:"bytes,5,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetIPPoolUtilizationReq) Reset()         { *m = GetIPPoolUtilizationReq{} }
+func (m *GetIPPoolUtilizationReq) String() string { return proto.CompactTextString(m) }
+func (*GetIPPoolUtilizationReq) ProtoMessage()    {}
+func (*GetIPPoolUtilizationReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_967af07f722e7296, []int{36}
+}
+
+func (m *GetIPPoolUtilizationReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetIPPoolUtilizationReq.Unmarshal(m, b)
+}
+func (m *GetIPPoolUtilizationReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetIPPoolUtilizationReq.Marshal(b, m, deterministic)
+}
+func (m *GetIPPoolUtilizationReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetIPPoolUtilizationReq.Merge(m, src)
+}
+func (m *GetIPPoolUtilizationReq) XXX_Size() int {
+	return xxx_messageInfo_GetIPPoolUtilizationReq.Size(m)
+}
+func (m *GetIPPoolUtilizationReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetIPPoolUtilizationReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetIPPoolUtilizationReq proto.InternalMessageInfo
+
+func (m *GetIPPoolUtilizationReq) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *GetIPPoolUtilizationReq) GetVpcID() string {
+	if m != nil {
+		return m.VpcID
+	}
+	return ""
+}
+
+func (m *GetIPPoolUtilizationReq) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *GetIPPoolUtilizationReq) GetSubnetID() string {
+	if m != nil {
+		return m.SubnetID
+	}
+	return ""
+}
+
+func (m *GetIPPoolUtilizationReq) GetCluster() string {
+	if m != nil {
+		return m.Cluster
+	}
+	return ""
+}
+
+type GetIPPoolUtilizationResp struct {
+	Seq                  uint64                `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	ErrCode              common.ErrCode        `protobuf:"varint,2,opt,name=errCode,proto3,enum=common.ErrCode" json:"errCode,omitempty"`
+	ErrMsg               string                `protobuf:"bytes,3,opt,name=errMsg,proto3" json:"errMsg,omitempty"`
+	Subnets              []*SubnetUtilization  `protobuf:"bytes,4,rep,name=subnets,proto3" json:"subnets,omitempty"`
+	Clusters             []*ClusterUtilization `protobuf:"bytes,5,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	FixedIPs             []*FixedIPReservation `protobuf:"bytes,6,rep,name=fixedIPs,proto3" json:"fixedIPs,omitempty"`
+	LeakedIPs            []*LeakedIP           `protobuf:"bytes,7,rep,name=leakedIPs,proto3" json:"leakedIPs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetIPPoolUtilizationResp) Reset()         { *m = GetIPPoolUtilizationResp{} }
+func (m *GetIPPoolUtilizationResp) String() string { return proto.CompactTextString(m) }
+func (*GetIPPoolUtilizationResp) ProtoMessage()    {}
+func (*GetIPPoolUtilizationResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_967af07f722e7296, []int{37}
+}
+
+func (m *GetIPPoolUtilizationResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetIPPoolUtilizationResp.Unmarshal(m, b)
+}
+func (m *GetIPPoolUtilizationResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetIPPoolUtilizationResp.Marshal(b, m, deterministic)
+}
+func (m *GetIPPoolUtilizationResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetIPPoolUtilizationResp.Merge(m, src)
+}
+func (m *GetIPPoolUtilizationResp) XXX_Size() int {
+	return xxx_messageInfo_GetIPPoolUtilizationResp.Size(m)
+}
+func (m *GetIPPoolUtilizationResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetIPPoolUtilizationResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetIPPoolUtilizationResp proto.InternalMessageInfo
+
+func (m *GetIPPoolUtilizationResp) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *GetIPPoolUtilizationResp) GetErrCode() common.ErrCode {
+	if m != nil {
+		return m.ErrCode
+	}
+	return common.ErrCode_ERROR_OK
+}
+
+func (m *GetIPPoolUtilizationResp) GetErrMsg() string {
+	if m != nil {
+		return m.ErrMsg
+	}
+	return ""
+}
+
+func (m *GetIPPoolUtilizationResp) GetSubnets() []*SubnetUtilization {
+	if m != nil {
+		return m.Subnets
+	}
+	return nil
+}
+
+func (m *GetIPPoolUtilizationResp) GetClusters() []*ClusterUtilization {
+	if m != nil {
+		return m.Clusters
+	}
+	return nil
+}
+
+func (m *GetIPPoolUtilizationResp) GetFixedIPs() []*FixedIPReservation {
+	if m != nil {
+		return m.FixedIPs
+	}
+	return nil
+}
+
+func (m *GetIPPoolUtilizationResp) GetLeakedIPs() []*LeakedIP {
+	if m != nil {
+		return m.LeakedIPs
+	}
+	return nil
+}
+
+type SubnetUtilization struct {
+	VpcID                string   `protobuf:"bytes,1,opt,name=vpcID,proto3" json:"vpcID,omitempty"`
+	Region               string   `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	Zone                 string   `protobuf:"bytes,3,opt,name=zone,proto3" json:"zone,omitempty"`
+	SubnetID             string   `protobuf:"bytes,4,opt,name=subnetID,proto3" json:"subnetID,omitempty"`
+	SubnetCidr           string   `protobuf:"bytes,5,opt,name=subnetCidr,proto3" json:"subnetCidr,omitempty"`
+	State                int32    `protobuf:"varint,6,opt,name=state,proto3" json:"state,omitempty"`
+	TotalIPNum           uint64   `protobuf:"varint,7,opt,name=totalIPNum,proto3" json:"totalIPNum,omitempty"`
+	FreeIPNum            uint64   `protobuf:"varint,8,opt,name=freeIPNum,proto3" json:"freeIPNum,omitempty"`
+	ActiveIPNum          uint64   `protobuf:"varint,9,opt,name=activeIPNum,proto3" json:"activeIPNum,omitempty"`
+	IdleIPNum            uint64   `protobuf:"varint,10,opt,name=idleIPNum,proto3" json:"idleIPNum,omitempty"`
+	FixedIPNum           uint64   `protobuf:"varint,11,opt,name=fixedIPNum,proto3" json:"fixedIPNum,omitempty"`
+	EniPrimaryIPNum      uint64   `protobuf:"varint,12,opt,name=eniPrimaryIPNum,proto3" json:"eniPrimaryIPNum,omitempty"`
+	ReservedIPNum        uint64   `protobuf:"varint,13,opt,name=reservedIPNum,proto3" json:"reservedIPNum,omitempty"`
+	TransitIPNum         uint64   `protobuf:"varint,14,opt,name=transitIPNum,proto3" json:"transitIPNum,omitempty"`
+	LeakedIPNum          uint64   `protobuf:"varint,15,opt,name=leakedIPNum,proto3" json:"leakedIPNum,omitempty"`
+	EniNum               uint64   `protobuf:"varint,16,opt,name=eniNum,proto3" json:"eniNum,omitempty"`
+	Usage                float64  `protobuf:"fixed64,17,opt,name=usage,proto3" json:"usage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubnetUtilization) Reset()         { *m = SubnetUtilization{} }
+func (m *SubnetUtilization) String() string { return proto.CompactTextString(m) }
+func (*SubnetUtilization) ProtoMessage()    {}
+func (*SubnetUtilization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_967af07f722e7296, []int{38}
+}
+
+func (m *SubnetUtilization) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubnetUtilization.Unmarshal(m, b)
+}
+func (m *SubnetUtilization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubnetUtilization.Marshal(b, m, deterministic)
+}
+func (m *SubnetUtilization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubnetUtilization.Merge(m, src)
+}
+func (m *SubnetUtilization) XXX_Size() int {
+	return xxx_messageInfo_SubnetUtilization.Size(m)
+}
+func (m *SubnetUtilization) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubnetUtilization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubnetUtilization proto.InternalMessageInfo
+
+func (m *SubnetUtilization) GetVpcID() string {
+	if m != nil {
+		return m.VpcID
+	}
+	return ""
+}
+
+func (m *SubnetUtilization) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *SubnetUtilization) GetZone() string {
+	if m != nil {
+		return m.Zone
+	}
+	return ""
+}
+
+func (m *SubnetUtilization) GetSubnetID() string {
+	if m != nil {
+		return m.SubnetID
+	}
+	return ""
+}
+
+func (m *SubnetUtilization) GetSubnetCidr() string {
+	if m != nil {
+		return m.SubnetCidr
+	}
+	return ""
+}
+
+func (m *SubnetUtilization) GetState() int32 {
+	if m != nil {
+		return m.State
+	}
+	return 0
+}
+
+func (m *SubnetUtilization) GetTotalIPNum() uint64 {
+	if m != nil {
+		return m.TotalIPNum
+	}
+	return 0
+}
+
+func (m *SubnetUtilization) GetFreeIPNum() uint64 {
+	if m != nil {
+		return m.FreeIPNum
+	}
+	return 0
+}
+
+func (m *SubnetUtilization) GetActiveIPNum() uint64 {
+	if m != nil {
+		return m.ActiveIPNum
+	}
+	return 0
+}
+
+func (m *SubnetUtilization) GetIdleIPNum() uint64 {
+	if m != nil {
+		return m.IdleIPNum
+	}
+	return 0
+}
+
+func (m *SubnetUtilization) GetFixedIPNum() uint64 {
+	if m != nil {
+		return m.FixedIPNum
+	}
+	return 0
+}
+
+func (m *SubnetUtilization) GetEniPrimaryIPNum() uint64 {
+	if m != nil {
+		return m.EniPrimaryIPNum
+	}
+	return 0
+}
+
+func (m *SubnetUtilization) GetReservedIPNum() uint64 {
+	if m != nil {
+		return m.ReservedIPNum
+	}
+	return 0
+}
+
+func (m *SubnetUtilization) GetTransitIPNum() uint64 {
+	if m != nil {
+		return m.TransitIPNum
+	}
+	return 0
+}
+
+func (m *SubnetUtilization) GetLeakedIPNum() uint64 {
+	if m != nil {
+		return m.LeakedIPNum
+	}
+	return 0
+}
+
+func (m *SubnetUtilization) GetEniNum() uint64 {
+	if m != nil {
+		return m.EniNum
+	}
+	return 0
+}
+
+func (m *SubnetUtilization) GetUsage() float64 {
+	if m != nil {
+		return m.Usage
+	}
+	return 0
+}
+
+type ClusterUtilization struct {
+	Cluster              string   `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	QuotaLimit           uint64   `protobuf:"varint,2,opt,name=quotaLimit,proto3" json:"quotaLimit,omitempty"`
+	QuotaUsed            uint64   `protobuf:"varint,3,opt,name=quotaUsed,proto3" json:"quotaUsed,omitempty"`
+	ActiveIPNum          uint64   `protobuf:"varint,4,opt,name=activeIPNum,proto3" json:"activeIPNum,omitempty"`
+	IdleIPNum            uint64   `protobuf:"varint,5,opt,name=idleIPNum,proto3" json:"idleIPNum,omitempty"`
+	FixedIPNum           uint64   `protobuf:"varint,6,opt,name=fixedIPNum,proto3" json:"fixedIPNum,omitempty"`
+	EniPrimaryIPNum      uint64   `protobuf:"varint,7,opt,name=eniPrimaryIPNum,proto3" json:"eniPrimaryIPNum,omitempty"`
+	LeakedIPNum          uint64   `protobuf:"varint,8,opt,name=leakedIPNum,proto3" json:"leakedIPNum,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClusterUtilization) Reset()         { *m = ClusterUtilization{} }
+func (m *ClusterUtilization) String() string { return proto.CompactTextString(m) }
+func (*ClusterUtilization) ProtoMessage()    {}
+func (*ClusterUtilization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_967af07f722e7296, []int{39}
+}
+
+func (m *ClusterUtilization) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClusterUtilization.Unmarshal(m, b)
+}
+func (m *ClusterUtilization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClusterUtilization.Marshal(b, m, deterministic)
+}
+func (m *ClusterUtilization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterUtilization.Merge(m, src)
+}
+func (m *ClusterUtilization) XXX_Size() int {
+	return xxx_messageInfo_ClusterUtilization.Size(m)
+}
+func (m *ClusterUtilization) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterUtilization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterUtilization proto.InternalMessageInfo
+
+func (m *ClusterUtilization) GetCluster() string {
+	if m != nil {
+		return m.Cluster
+	}
+	return ""
+}
+
+func (m *ClusterUtilization) GetQuotaLimit() uint64 {
+	if m != nil {
+		return m.QuotaLimit
+	}
+	return 0
+}
+
+func (m *ClusterUtilization) GetQuotaUsed() uint64 {
+	if m != nil {
+		return m.QuotaUsed
+	}
+	return 0
+}
+
+func (m *ClusterUtilization) GetActiveIPNum() uint64 {
+	if m != nil {
+		return m.ActiveIPNum
+	}
+	return 0
+}
+
+func (m *ClusterUtilization) GetIdleIPNum() uint64 {
+	if m != nil {
+		return m.IdleIPNum
+	}
+	return 0
+}
+
+func (m *ClusterUtilization) GetFixedIPNum() uint64 {
+	if m != nil {
+		return m.FixedIPNum
+	}
+	return 0
+}
+
+func (m *ClusterUtilization) GetEniPrimaryIPNum() uint64 {
+	if m != nil {
+		return m.EniPrimaryIPNum
+	}
+	return 0
+}
+
+func (m *ClusterUtilization) GetLeakedIPNum() uint64 {
+	if m != nil {
+		return m.LeakedIPNum
+	}
+	return 0
+}
+
+type FixedIPReservation struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	SubnetID             string   `protobuf:"bytes,2,opt,name=subnetID,proto3" json:"subnetID,omitempty"`
+	Cluster              string   `protobuf:"bytes,3,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	Namespace            string   `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	PodName              string   `protobuf:"bytes,5,opt,name=podName,proto3" json:"podName,omitempty"`
+	WorkloadName         string   `protobuf:"bytes,6,opt,name=workloadName,proto3" json:"workloadName,omitempty"`
+	WorkloadKind         string   `protobuf:"bytes,7,opt,name=workloadKind,proto3" json:"workloadKind,omitempty"`
+	EniID                string   `protobuf:"bytes,8,opt,name=eniID,proto3" json:"eniID,omitempty"`
+	Status               string   `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	KeepDuration         string   `protobuf:"bytes,10,opt,name=keepDuration,proto3" json:"keepDuration,omitempty"`
+	ExpireTime           string   `protobuf:"bytes,11,opt,name=expireTime,proto3" json:"expireTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FixedIPReservation) Reset()         { *m = FixedIPReservation{} }
+func (m *FixedIPReservation) String() string { return proto.CompactTextString(m) }
+func (*FixedIPReservation) ProtoMessage()    {}
+func (*FixedIPReservation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_967af07f722e7296, []int{40}
+}
+
+func (m *FixedIPReservation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FixedIPReservation.Unmarshal(m, b)
+}
+func (m *FixedIPReservation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FixedIPReservation.Marshal(b, m, deterministic)
+}
+func (m *FixedIPReservation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FixedIPReservation.Merge(m, src)
+}
+func (m *FixedIPReservation) XXX_Size() int {
+	return xxx_messageInfo_FixedIPReservation.Size(m)
+}
+func (m *FixedIPReservation) XXX_DiscardUnknown() {
+	xxx_messageInfo_FixedIPReservation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FixedIPReservation proto.InternalMessageInfo
+
+func (m *FixedIPReservation) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *FixedIPReservation) GetSubnetID() string {
+	if m != nil {
+		return m.SubnetID
+	}
+	return ""
+}
+
+func (m *FixedIPReservation) GetCluster() string {
+	if m != nil {
+		return m.Cluster
+	}
+	return ""
+}
+
+func (m *FixedIPReservation) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *FixedIPReservation) GetPodName() string {
+	if m != nil {
+		return m.PodName
+	}
+	return ""
+}
+
+func (m *FixedIPReservation) GetWorkloadName() string {
+	if m != nil {
+		return m.WorkloadName
+	}
+	return ""
+}
+
+func (m *FixedIPReservation) GetWorkloadKind() string {
+	if m != nil {
+		return m.WorkloadKind
+	}
+	return ""
+}
+
+func (m *FixedIPReservation) GetEniID() string {
+	if m != nil {
+		return m.EniID
+	}
+	return ""
+}
+
+func (m *FixedIPReservation) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *FixedIPReservation) GetKeepDuration() string {
+	if m != nil {
+		return m.KeepDuration
+	}
+	return ""
+}
+
+func (m *FixedIPReservation) GetExpireTime() string {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return ""
+}
+
+type LeakedIP struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	SubnetID             string   `protobuf:"bytes,2,opt,name=subnetID,proto3" json:"subnetID,omitempty"`
+	Cluster              string   `protobuf:"bytes,3,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	Namespace            string   `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	PodName              string   `protobuf:"bytes,5,opt,name=podName,proto3" json:"podName,omitempty"`
+	ContainerID          string   `protobuf:"bytes,6,opt,name=containerID,proto3" json:"containerID,omitempty"`
+	Host                 string   `protobuf:"bytes,7,opt,name=host,proto3" json:"host,omitempty"`
+	EniID                string   `protobuf:"bytes,8,opt,name=eniID,proto3" json:"eniID,omitempty"`
+	Status               string   `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	IsFixed              bool     `protobuf:"varint,10,opt,name=isFixed,proto3" json:"isFixed,omitempty"`
+	Reason               string   `protobuf:"bytes,11,opt,name=reason,proto3" json:"reason,omitempty"`
+	UpdateTime           string   `protobuf:"bytes,12,opt,name=updateTime,proto3" json:"updateTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LeakedIP) Reset()         { *m = LeakedIP{} }
+func (m *LeakedIP) String() string { return proto.CompactTextString(m) }
+func (*LeakedIP) ProtoMessage()    {}
+func (*LeakedIP) Descriptor() ([]byte, []int) {
+	return fileDescriptor_967af07f722e7296, []int{41}
+}
+
+func (m *LeakedIP) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LeakedIP.Unmarshal(m, b)
+}
+func (m *LeakedIP) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LeakedIP.Marshal(b, m, deterministic)
+}
+func (m *LeakedIP) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeakedIP.Merge(m, src)
+}
+func (m *LeakedIP) XXX_Size() int {
+	return xxx_messageInfo_LeakedIP.Size(m)
+}
+func (m *LeakedIP) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeakedIP.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeakedIP proto.InternalMessageInfo
+
+func (m *LeakedIP) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *LeakedIP) GetSubnetID() string {
+	if m != nil {
+		return m.SubnetID
+	}
+	return ""
+}
+
+func (m *LeakedIP) GetCluster() string {
+	if m != nil {
+		return m.Cluster
+	}
+	return ""
+}
+
+func (m *LeakedIP) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *LeakedIP) GetPodName() string {
+	if m != nil {
+		return m.PodName
+	}
+	return ""
+}
+
+func (m *LeakedIP) GetContainerID() string {
+	if m != nil {
+		return m.ContainerID
+	}
+	return ""
+}
+
+func (m *LeakedIP) GetHost() string {
+	if m != nil {
+		return m.Host
+	}
+	return ""
+}
+
+func (m *LeakedIP) GetEniID() string {
+	if m != nil {
+		return m.EniID
+	}
+	return ""
+}
+
+func (m *LeakedIP) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *LeakedIP) GetIsFixed() bool {
+	if m != nil {
+		return m.IsFixed
+	}
+	return false
+}
+
+func (m *LeakedIP) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *LeakedIP) GetUpdateTime() string {
+	if m != nil {
+		return m.UpdateTime
+	}
+	return ""
+}
+
+type CompactIPPoolReq struct {
+	Seq                  uint64   `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	VpcID                string   `protobuf:"bytes,2,opt,name=vpcID,proto3" json:"vpcID,omitempty"`
+	Region               string   `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
+	SubnetID             string   `protobuf:"bytes,4,opt,name=subnetID,proto3" json:"subnetID,omitempty"`
+	DryRun               bool     `protobuf:"varint,5,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CompactIPPoolReq) Reset()         { *m = CompactIPPoolReq{} }
+func (m *CompactIPPoolReq) String() string { return proto.CompactTextString(m) }
+func (*CompactIPPoolReq) ProtoMessage()    {}
+func (*CompactIPPoolReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_967af07f722e7296, []int{42}
+}
+
+func (m *CompactIPPoolReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompactIPPoolReq.Unmarshal(m, b)
+}
+func (m *CompactIPPoolReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompactIPPoolReq.Marshal(b, m, deterministic)
+}
+func (m *CompactIPPoolReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactIPPoolReq.Merge(m, src)
+}
+func (m *CompactIPPoolReq) XXX_Size() int {
+	return xxx_messageInfo_CompactIPPoolReq.Size(m)
+}
+func (m *CompactIPPoolReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactIPPoolReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactIPPoolReq proto.InternalMessageInfo
+
+func (m *CompactIPPoolReq) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *CompactIPPoolReq) GetVpcID() string {
+	if m != nil {
+		return m.VpcID
+	}
+	return ""
+}
+
+func (m *CompactIPPoolReq) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *CompactIPPoolReq) GetSubnetID() string {
+	if m != nil {
+		return m.SubnetID
+	}
+	return ""
+}
+
+func (m *CompactIPPoolReq) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type CompactIPPoolResp struct {
+	Seq                  uint64                    `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	ErrCode              common.ErrCode            `protobuf:"varint,2,opt,name=errCode,proto3,enum=common.ErrCode" json:"errCode,omitempty"`
+	ErrMsg               string                    `protobuf:"bytes,3,opt,name=errMsg,proto3" json:"errMsg,omitempty"`
+	DryRun               bool                      `protobuf:"varint,4,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	Operations           []*IPPoolCompactOperation `protobuf:"bytes,5,rep,name=operations,proto3" json:"operations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *CompactIPPoolResp) Reset()         { *m = CompactIPPoolResp{} }
+func (m *CompactIPPoolResp) String() string { return proto.CompactTextString(m) }
+func (*CompactIPPoolResp) ProtoMessage()    {}
+func (*CompactIPPoolResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_967af07f722e7296, []int{43}
+}
+
+func (m *CompactIPPoolResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompactIPPoolResp.Unmarshal(m, b)
+}
+func (m *CompactIPPoolResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompactIPPoolResp.Marshal(b, m, deterministic)
+}
+func (m *CompactIPPoolResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactIPPoolResp.Merge(m, src)
+}
+func (m *CompactIPPoolResp) XXX_Size() int {
+	return xxx_messageInfo_CompactIPPoolResp.Size(m)
+}
+func (m *CompactIPPoolResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactIPPoolResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactIPPoolResp proto.InternalMessageInfo
+
+func (m *CompactIPPoolResp) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *CompactIPPoolResp) GetErrCode() common.ErrCode {
+	if m != nil {
+		return m.ErrCode
+	}
+	return common.ErrCode_ERROR_OK
+}
+
+func (m *CompactIPPoolResp) GetErrMsg() string {
+	if m != nil {
+		return m.ErrMsg
+	}
+	return ""
+}
+
+func (m *CompactIPPoolResp) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *CompactIPPoolResp) GetOperations() []*IPPoolCompactOperation {
+	if m != nil {
+		return m.Operations
+	}
+	return nil
+}
+
+type IPPoolCompactOperation struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	SubnetID             string   `protobuf:"bytes,2,opt,name=subnetID,proto3" json:"subnetID,omitempty"`
+	Cluster              string   `protobuf:"bytes,3,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	Type                 string   `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	SrcEniID             string   `protobuf:"bytes,5,opt,name=srcEniID,proto3" json:"srcEniID,omitempty"`
+	DestEniID            string   `protobuf:"bytes,6,opt,name=destEniID,proto3" json:"destEniID,omitempty"`
+	Reason               string   `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	Executed             bool     `protobuf:"varint,8,opt,name=executed,proto3" json:"executed,omitempty"`
+	ErrMsg               string   `protobuf:"bytes,9,opt,name=errMsg,proto3" json:"errMsg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IPPoolCompactOperation) Reset()         { *m = IPPoolCompactOperation{} }
+func (m *IPPoolCompactOperation) String() string { return proto.CompactTextString(m) }
+func (*IPPoolCompactOperation) ProtoMessage()    {}
+func (*IPPoolCompactOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_967af07f722e7296, []int{44}
+}
+
+func (m *IPPoolCompactOperation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IPPoolCompactOperation.Unmarshal(m, b)
+}
+func (m *IPPoolCompactOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IPPoolCompactOperation.Marshal(b, m, deterministic)
+}
+func (m *IPPoolCompactOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IPPoolCompactOperation.Merge(m, src)
+}
+func (m *IPPoolCompactOperation) XXX_Size() int {
+	return xxx_messageInfo_IPPoolCompactOperation.Size(m)
+}
+func (m *IPPoolCompactOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_IPPoolCompactOperation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IPPoolCompactOperation proto.InternalMessageInfo
+
+func (m *IPPoolCompactOperation) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *IPPoolCompactOperation) GetSubnetID() string {
+	if m != nil {
+		return m.SubnetID
+	}
+	return ""
+}
+
+func (m *IPPoolCompactOperation) GetCluster() string {
+	if m != nil {
+		return m.Cluster
+	}
+	return ""
+}
+
+func (m *IPPoolCompactOperation) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *IPPoolCompactOperation) GetSrcEniID() string {
+	if m != nil {
+		return m.SrcEniID
+	}
+	return ""
+}
+
+func (m *IPPoolCompactOperation) GetDestEniID() string {
+	if m != nil {
+		return m.DestEniID
+	}
+	return ""
+}
+
+func (m *IPPoolCompactOperation) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *IPPoolCompactOperation) GetExecuted() bool {
+	if m != nil {
+		return m.Executed
+	}
+	return false
+}
+
+func (m *IPPoolCompactOperation) GetErrMsg() string {
+	if m != nil {
+		return m.ErrMsg
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*AddSubnetReq)(nil), "cloudnetservice.AddSubnetReq")
 	proto.RegisterType((*AddSubnetResp)(nil), "cloudnetservice.AddSubnetResp")
@@ -2364,207 +3275,257 @@ func init() {
 	proto.RegisterType((*DeleteIPQuotaResp)(nil), "cloudnetservice.DeleteIPQuotaResp")
 	proto.RegisterType((*ListIPQuotaReq)(nil), "cloudnetservice.ListIPQuotaReq")
 	proto.RegisterType((*ListIPQuotaResp)(nil), "cloudnetservice.ListIPQuotaResp")
+	proto.RegisterType((*GetIPPoolUtilizationReq)(nil), "cloudnetservice.GetIPPoolUtilizationReq")
+	proto.RegisterType((*GetIPPoolUtilizationResp)(nil), "cloudnetservice.GetIPPoolUtilizationResp")
+	proto.RegisterType((*SubnetUtilization)(nil), "cloudnetservice.SubnetUtilization")
+	proto.RegisterType((*ClusterUtilization)(nil), "cloudnetservice.ClusterUtilization")
+	proto.RegisterType((*FixedIPReservation)(nil), "cloudnetservice.FixedIPReservation")
+	proto.RegisterType((*LeakedIP)(nil), "cloudnetservice.LeakedIP")
+	proto.RegisterType((*CompactIPPoolReq)(nil), "cloudnetservice.CompactIPPoolReq")
+	proto.RegisterType((*CompactIPPoolResp)(nil), "cloudnetservice.CompactIPPoolResp")
+	proto.RegisterType((*IPPoolCompactOperation)(nil), "cloudnetservice.IPPoolCompactOperation")
 }
 
 func init() { proto.RegisterFile("cloudnetservice.proto", fileDescriptor_967af07f722e7296) }
 
 var fileDescriptor_967af07f722e7296 = []byte{
-	// 3115 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0x5b, 0x70, 0x14, 0xc7,
-	0xd5, 0xae, 0x5e, 0x5d, 0x40, 0xad, 0x2b, 0x8d, 0xc0, 0xfb, 0xaf, 0xb9, 0x8c, 0xc7, 0xff, 0x6f,
-	0xf8, 0x27, 0x88, 0xb5, 0xc7, 0xc4, 0x76, 0xc9, 0x76, 0xcc, 0x48, 0xb2, 0x9c, 0x05, 0x4c, 0x94,
-	0x01, 0x57, 0x92, 0xb7, 0x8c, 0x76, 0x1b, 0x69, 0xcc, 0x6a, 0x66, 0xd8, 0x19, 0x61, 0x12, 0x8a,
-	0x2a, 0x85, 0x70, 0x91, 0x09, 0x18, 0x18, 0x1b, 0x63, 0x30, 0x36, 0x81, 0xb2, 0x1d, 0xe2, 0xaa,
-	0x58, 0xd8, 0x71, 0x30, 0x60, 0xd9, 0x79, 0xcb, 0x43, 0x52, 0x95, 0x07, 0xa5, 0x2a, 0x8f, 0xa9,
-	0x54, 0xac, 0x5d, 0x49, 0xa9, 0x54, 0x39, 0x0f, 0x79, 0xca, 0x53, 0xaa, 0x2f, 0x73, 0xdd, 0xd1,
-	0x05, 0x6c, 0x39, 0x4a, 0xa0, 0x6a, 0xbb, 0xfb, 0x9c, 0x9e, 0xee, 0xaf, 0x4f, 0x9f, 0x73, 0xfa,
-	0x9c, 0x6e, 0xc1, 0x55, 0xf9, 0xa2, 0x39, 0x5c, 0x30, 0xb0, 0x63, 0xe3, 0xd2, 0x7e, 0x3d, 0x8f,
-	0x37, 0x5b, 0x25, 0xd3, 0x31, 0x51, 0x6b, 0x8c, 0x9c, 0xe9, 0x1d, 0xd0, 0x9d, 0xc1, 0xe1, 0xfe,
-	0xcd, 0x79, 0x73, 0x28, 0xbb, 0x1b, 0x1b, 0x79, 0x6c, 0x38, 0xd9, 0xfe, 0xbd, 0x1d, 0xfd, 0x79,
-	0x3b, 0xdb, 0x9f, 0xb7, 0x3b, 0x0c, 0xec, 0xbc, 0x60, 0x96, 0xf6, 0x66, 0x35, 0x4b, 0xcf, 0xd2,
-	0x11, 0xf2, 0x66, 0x31, 0x9b, 0x37, 0x87, 0x86, 0x4c, 0x83, 0x17, 0x6c, 0xe0, 0xcc, 0x9a, 0x01,
-	0xd3, 0x1c, 0x28, 0x62, 0xda, 0x51, 0x33, 0x0c, 0xd3, 0xd1, 0x1c, 0xdd, 0x34, 0x6c, 0xce, 0xdd,
-	0xc4, 0xbe, 0xed, 0x18, 0xc0, 0x46, 0x87, 0xfd, 0x82, 0x36, 0x30, 0x80, 0x4b, 0x59, 0xd3, 0xa2,
-	0x3d, 0xaa, 0x7b, 0x8b, 0x9f, 0xd5, 0xc2, 0x26, 0xa5, 0x50, 0xd8, 0x35, 0xdc, 0x6f, 0x60, 0x47,
-	0xc5, 0xfb, 0xd0, 0x7a, 0x58, 0x63, 0xe3, 0x7d, 0x69, 0x20, 0x80, 0x8d, 0xb5, 0x5d, 0xcd, 0xae,
-	0x02, 0x25, 0xd2, 0x96, 0xc9, 0x8f, 0x4a, 0x7e, 0xd0, 0x06, 0x58, 0xb7, 0xdf, 0xca, 0xe7, 0x7a,
-	0xd2, 0x29, 0x01, 0x6c, 0x6c, 0xe8, 0x5a, 0xe1, 0x2a, 0x2d, 0x12, 0xa3, 0xc8, 0xac, 0x50, 0x59,
-	0x81, 0xbe, 0x06, 0xeb, 0x4b, 0x78, 0x40, 0x37, 0x8d, 0x74, 0x0d, 0xed, 0xb9, 0xd2, 0x55, 0xda,
-	0x24, 0x4e, 0x92, 0x79, 0xa9, 0xf2, 0x12, 0xdd, 0x0f, 0x6b, 0x7f, 0x68, 0x1a, 0x38, 0x5d, 0x4b,
-	0xbb, 0xb6, 0xba, 0x4a, 0x93, 0x44, 0x09, 0x32, 0xfd, 0x55, 0xe9, 0x2f, 0x7a, 0x18, 0x2e, 0xb7,
-	0x29, 0xd0, 0x5c, 0x4f, 0xba, 0x8e, 0x76, 0xbc, 0xc7, 0x55, 0xda, 0x25, 0x9f, 0x28, 0xfb, 0x35,
-	0xd5, 0xaf, 0xa1, 0xc7, 0x21, 0x64, 0xf5, 0x6e, 0xbd, 0x50, 0x4a, 0xd7, 0xd3, 0xcf, 0xee, 0x75,
-	0x95, 0xb4, 0x14, 0x22, 0xcb, 0xa1, 0xba, 0x1a, 0xaa, 0xa3, 0xef, 0xc3, 0x96, 0x21, 0xdd, 0xc8,
-	0xf5, 0xed, 0x1c, 0x1e, 0xea, 0xc3, 0xa5, 0xa7, 0x0d, 0x3d, 0xbd, 0x4c, 0x00, 0x1b, 0xeb, 0xba,
-	0x1e, 0x73, 0x95, 0xaf, 0x4b, 0x31, 0x96, 0x7c, 0xff, 0x90, 0x6e, 0x08, 0x25, 0x4c, 0xb6, 0x1b,
-	0x17, 0x04, 0xdd, 0x12, 0x8c, 0xe1, 0xa1, 0x7e, 0x5c, 0x12, 0xf6, 0x98, 0x25, 0x01, 0x6b, 0xf9,
-	0x41, 0x01, 0x1b, 0xba, 0x1a, 0xfb, 0xa8, 0xf3, 0x2f, 0xc0, 0x55, 0xfe, 0x04, 0xe0, 0x6e, 0x29,
-	0xb2, 0x0d, 0xf2, 0xaa, 0xf2, 0xe8, 0xeb, 0xe5, 0x8f, 0x6f, 0x4f, 0xde, 0x3e, 0x5b, 0xfe, 0xc5,
-	0x99, 0xa9, 0x4f, 0xce, 0x4e, 0x8f, 0x7d, 0x54, 0x79, 0xff, 0xf0, 0x04, 0x20, 0x5b, 0x30, 0x01,
-	0x98, 0x84, 0x27, 0x00, 0x97, 0xde, 0x04, 0xf0, 0x97, 0x3b, 0x01, 0xc2, 0xcb, 0x3a, 0x0a, 0xd0,
-	0x61, 0x70, 0x50, 0x10, 0x6d, 0xbc, 0x4f, 0xec, 0x14, 0xc4, 0x87, 0xc4, 0x4d, 0x82, 0x48, 0x3f,
-	0x25, 0xad, 0xfd, 0x56, 0xbe, 0xe3, 0x00, 0xf9, 0x47, 0xa8, 0x6c, 0x20, 0x42, 0xd6, 0xac, 0x0e,
-	0x7b, 0x50, 0x33, 0x06, 0x06, 0x35, 0x9d, 0x30, 0x88, 0xe0, 0x09, 0x99, 0xd4, 0xbd, 0x49, 0x48,
-	0x9b, 0xd5, 0x83, 0x01, 0x82, 0x59, 0xe9, 0x4c, 0xf2, 0xa3, 0x9b, 0x1f, 0x24, 0xff, 0xb3, 0xf2,
-	0x16, 0xf1, 0x90, 0x78, 0x19, 0xc0, 0xe6, 0xd0, 0xf2, 0x6c, 0x6b, 0x7e, 0x35, 0xdb, 0x0a, 0x97,
-	0xe1, 0x52, 0xa9, 0xdb, 0x2c, 0x60, 0xaa, 0x68, 0x2d, 0x72, 0xeb, 0x66, 0x6e, 0x04, 0x4f, 0x33,
-	0x72, 0xd7, 0x2a, 0x57, 0x41, 0x92, 0xd7, 0x49, 0xf6, 0x2a, 0xaa, 0x57, 0x21, 0xfa, 0x87, 0x4b,
-	0xa5, 0x67, 0xed, 0x81, 0x88, 0xfe, 0x31, 0x92, 0xcc, 0x4b, 0x95, 0x97, 0xe2, 0x9b, 0x35, 0xb0,
-	0xb5, 0x07, 0x17, 0xb1, 0x83, 0xff, 0x7b, 0x4d, 0xa1, 0xf3, 0x16, 0x70, 0x95, 0x5f, 0x01, 0xb8,
-	0x4d, 0x8a, 0x2f, 0x95, 0xa8, 0xdb, 0x4f, 0x67, 0x2e, 0xbc, 0x75, 0xc7, 0xea, 0x26, 0x0f, 0x20,
-	0xfc, 0x15, 0xe8, 0x97, 0x70, 0x48, 0xbc, 0x0a, 0x60, 0x5b, 0x14, 0xf5, 0x12, 0xd4, 0xa2, 0xb1,
-	0x14, 0x6c, 0xde, 0xa1, 0xdb, 0xce, 0xbf, 0x5d, 0x87, 0xc2, 0xea, 0x51, 0xbb, 0x50, 0xf5, 0x28,
-	0xb9, 0x8a, 0x09, 0x37, 0x49, 0xd1, 0x15, 0xc8, 0xab, 0x2a, 0x97, 0xdf, 0x9e, 0xba, 0xf4, 0x13,
-	0x5f, 0x35, 0xca, 0xa3, 0xe7, 0xa7, 0xaf, 0x5c, 0x63, 0xaa, 0x21, 0x3f, 0x85, 0x9e, 0xfc, 0x02,
-	0xdb, 0x2f, 0x1c, 0x12, 0x2b, 0x00, 0xb6, 0x84, 0xe7, 0x5b, 0x7a, 0x9b, 0x8a, 0x7a, 0xe0, 0x32,
-	0x26, 0x22, 0x3b, 0x5d, 0x2b, 0xd4, 0x6c, 0x6c, 0x94, 0x57, 0x7a, 0xd3, 0x75, 0x93, 0x03, 0x9e,
-	0x21, 0xe7, 0x53, 0xf2, 0x8e, 0xb2, 0x57, 0x51, 0xbd, 0x8a, 0xf8, 0x51, 0x0d, 0x6c, 0xed, 0x26,
-	0x0b, 0x5f, 0x14, 0x07, 0x13, 0xde, 0xef, 0x9a, 0x85, 0x9e, 0x8c, 0x1b, 0x60, 0x9d, 0xed, 0x68,
-	0x0e, 0xf3, 0x34, 0xcd, 0x7c, 0x74, 0x4a, 0x91, 0x59, 0xa1, 0xb2, 0x22, 0xe1, 0x14, 0xac, 0xfb,
-	0x92, 0x4f, 0xc1, 0x0b, 0xc0, 0x55, 0xce, 0x01, 0xd8, 0x2b, 0xc5, 0x65, 0x24, 0xa3, 0xca, 0xeb,
-	0x1f, 0x56, 0x5e, 0xb9, 0xc5, 0x74, 0x6f, 0xea, 0xc4, 0x8d, 0xca, 0xc8, 0x8f, 0xe2, 0x6e, 0x29,
-	0x74, 0xf8, 0x71, 0xf8, 0xdb, 0x51, 0xee, 0x8b, 0x79, 0x25, 0x3a, 0x8c, 0xd8, 0x29, 0x3c, 0xc8,
-	0x3d, 0x4f, 0x14, 0xd5, 0x12, 0xf4, 0x3c, 0x7f, 0x4e, 0xc1, 0x55, 0xcf, 0x60, 0x47, 0xd9, 0xaf,
-	0xe9, 0x45, 0xad, 0xbf, 0xf8, 0x9f, 0x71, 0x8a, 0x75, 0xbe, 0x0b, 0x5c, 0xe5, 0x1d, 0x00, 0x73,
-	0x52, 0x32, 0xf6, 0x85, 0x6c, 0xbe, 0x7f, 0x26, 0xb1, 0xd1, 0xbf, 0x87, 0xbe, 0xf3, 0xe5, 0x9c,
-	0x47, 0x9a, 0xa5, 0xfb, 0xf4, 0x8e, 0x2d, 0xc4, 0x55, 0xfd, 0x15, 0xc0, 0xd5, 0x49, 0x30, 0x97,
-	0xa0, 0xcb, 0xda, 0x0a, 0xeb, 0x99, 0x4d, 0x50, 0xf1, 0xcf, 0xe2, 0xb1, 0xd8, 0x08, 0xac, 0x9f,
-	0xcc, 0x4b, 0x95, 0x97, 0xe2, 0x54, 0x3d, 0x6c, 0x56, 0x8a, 0x45, 0x33, 0xaf, 0x39, 0x38, 0xd7,
-	0xb7, 0x20, 0x3d, 0x0a, 0xfb, 0xa0, 0xd4, 0x42, 0x7d, 0x50, 0x16, 0x2e, 0xcb, 0x17, 0x87, 0x6d,
-	0x07, 0x97, 0xf8, 0xba, 0x98, 0x1c, 0x38, 0x4d, 0xf6, 0x2a, 0xaa, 0x57, 0x41, 0x8f, 0xc2, 0x06,
-	0x43, 0x1b, 0xc2, 0xb6, 0xa5, 0xe5, 0x3d, 0xe5, 0xfa, 0x1f, 0x57, 0x59, 0x2d, 0x05, 0x54, 0x39,
-	0xa8, 0xaa, 0x41, 0x95, 0xcc, 0x64, 0x99, 0x85, 0x9d, 0xda, 0x10, 0xe6, 0x01, 0x13, 0x9b, 0x89,
-	0xd3, 0x64, 0xaf, 0xa2, 0x7a, 0x15, 0xf4, 0x14, 0x6c, 0xcc, 0x9b, 0x86, 0xa3, 0xe9, 0x06, 0x2e,
-	0xe5, 0x7a, 0x78, 0xe6, 0xb0, 0xd6, 0x55, 0x32, 0x52, 0x98, 0x2e, 0x87, 0x1b, 0x6a, 0xb8, 0x41,
-	0x4c, 0x60, 0xd0, 0xb4, 0x1d, 0x9a, 0x32, 0x78, 0x26, 0x40, 0x08, 0x32, 0xfd, 0x55, 0xe9, 0x2f,
-	0xb1, 0x3e, 0x6c, 0xe8, 0xb9, 0x9e, 0xf4, 0xf2, 0x90, 0xf5, 0x51, 0x8a, 0xcc, 0x0a, 0x95, 0x15,
-	0xe8, 0x09, 0xb8, 0x4c, 0xb7, 0x7b, 0xf5, 0x03, 0xb8, 0x90, 0x6e, 0x10, 0xc0, 0xc6, 0xe5, 0x5d,
-	0xa2, 0xab, 0xac, 0x97, 0x3c, 0x9a, 0xdc, 0x5e, 0x79, 0x6d, 0xac, 0x7c, 0xe6, 0x9d, 0xf2, 0xe8,
-	0xb1, 0x99, 0xa3, 0xa7, 0xca, 0xaf, 0xdf, 0x2e, 0xbf, 0x77, 0x31, 0xd7, 0xa7, 0x7a, 0x6c, 0xb4,
-	0x1d, 0x36, 0xed, 0xc5, 0xd8, 0xea, 0x19, 0x2e, 0xd1, 0xf4, 0x2f, 0x0d, 0xe9, 0x6c, 0x1b, 0x5c,
-	0xe5, 0x7f, 0xa5, 0x08, 0x43, 0x6e, 0xf7, 0xbe, 0x9d, 0xfc, 0xf5, 0x1b, 0x53, 0xe7, 0x2e, 0x54,
-	0xce, 0xdf, 0x98, 0x39, 0xff, 0xa1, 0x1a, 0xe9, 0xd3, 0xf9, 0xdb, 0x94, 0xab, 0xfc, 0x26, 0x05,
-	0x6d, 0x29, 0xaa, 0x22, 0x72, 0x3b, 0x9b, 0x3d, 0xd7, 0x57, 0xbe, 0x74, 0xbd, 0xfc, 0xc6, 0x48,
-	0x34, 0x88, 0x0c, 0x79, 0x69, 0x6f, 0x33, 0x27, 0x40, 0xb0, 0x3f, 0x13, 0xc0, 0x13, 0xfc, 0x04,
-	0xa0, 0x92, 0x99, 0x00, 0x6c, 0xe1, 0x13, 0x20, 0x22, 0xee, 0xdf, 0x03, 0xf4, 0xbb, 0x78, 0x4a,
-	0x33, 0x4b, 0xdc, 0x48, 0x58, 0x7c, 0x26, 0xc2, 0x21, 0x39, 0xb6, 0x6f, 0xff, 0xfe, 0xbc, 0x84,
-	0x63, 0xd8, 0x84, 0xc4, 0xa7, 0x27, 0x04, 0x07, 0xdb, 0x74, 0x88, 0x0e, 0xde, 0x9b, 0xe0, 0x09,
-	0xa7, 0x35, 0x74, 0x56, 0x8a, 0x8e, 0x50, 0xb1, 0xa1, 0x07, 0xf3, 0x05, 0x50, 0x09, 0x6b, 0x97,
-	0xd2, 0xd3, 0xbb, 0x7d, 0xc7, 0xae, 0x1e, 0x65, 0x5b, 0xef, 0x8e, 0xed, 0xbb, 0x94, 0x6d, 0x3d,
-	0xbd, 0xca, 0xae, 0x1d, 0xdb, 0x7b, 0xb6, 0xf5, 0xee, 0x52, 0x7a, 0x7b, 0x76, 0x6c, 0x23, 0x6e,
-	0xe5, 0x8f, 0x00, 0xb6, 0x84, 0xc5, 0xb8, 0x04, 0xdd, 0xc9, 0x16, 0x98, 0xd2, 0x2d, 0xee, 0x4a,
-	0xda, 0xbc, 0x99, 0x72, 0x7d, 0xdf, 0xea, 0x7f, 0x1e, 0xe7, 0x9d, 0x2e, 0xe4, 0x2a, 0xad, 0x52,
-	0x4a, 0xb7, 0xe4, 0x06, 0xdd, 0x12, 0x4c, 0x4a, 0x53, 0x53, 0xba, 0x25, 0x9e, 0xa8, 0x85, 0x4d,
-	0x2a, 0x2e, 0x62, 0xcd, 0x5e, 0xa8, 0x07, 0x09, 0x39, 0x83, 0xd4, 0x82, 0x9c, 0x41, 0xc8, 0xa6,
-	0x6b, 0x16, 0x64, 0xd3, 0xdd, 0xb0, 0x89, 0x57, 0xc3, 0x0e, 0x64, 0xbd, 0xab, 0xac, 0x91, 0x22,
-	0x0c, 0x39, 0xd2, 0x52, 0x23, 0xad, 0xb8, 0x63, 0xa8, 0xbb, 0x53, 0xc7, 0xd0, 0xf9, 0x77, 0xe0,
-	0x2a, 0x7f, 0x03, 0xf0, 0xbb, 0x52, 0x44, 0x3e, 0xf2, 0x8a, 0x99, 0xe3, 0x27, 0x2a, 0xaf, 0x7c,
-	0xca, 0x8c, 0x68, 0xea, 0xe2, 0x91, 0x5c, 0x9f, 0x67, 0x3b, 0x81, 0xc1, 0x04, 0x46, 0x12, 0xc1,
-	0x14, 0x33, 0x92, 0xd3, 0x00, 0x9d, 0x8c, 0x1b, 0xc9, 0x6c, 0x96, 0x10, 0x57, 0x7b, 0xcb, 0x2c,
-	0x74, 0x3c, 0x18, 0x62, 0xf8, 0x56, 0x42, 0xb9, 0xcc, 0x54, 0xee, 0x5c, 0xcb, 0x2f, 0x03, 0xd8,
-	0x1c, 0x5a, 0xec, 0x12, 0x8c, 0xa0, 0x5e, 0xaa, 0x83, 0xad, 0xdd, 0x45, 0xac, 0x19, 0xd4, 0x61,
-	0x2e, 0x50, 0x63, 0x83, 0x90, 0x28, 0x35, 0x7f, 0x48, 0xf4, 0xd5, 0x9d, 0x75, 0xdd, 0xb0, 0xe9,
-	0x05, 0xb3, 0xb4, 0xb7, 0x68, 0x6a, 0xe1, 0x03, 0x8f, 0xa9, 0x79, 0x98, 0x21, 0x47, 0x5a, 0x6a,
-	0xa4, 0x15, 0x1e, 0x64, 0xbb, 0x6e, 0x14, 0xf8, 0x01, 0x18, 0x1d, 0x84, 0x30, 0xe4, 0x48, 0x4b,
-	0x8d, 0xb4, 0xc8, 0x9a, 0xb5, 0x42, 0xa1, 0x84, 0x6d, 0x9b, 0x1f, 0x83, 0x6c, 0xcd, 0x9c, 0x26,
-	0x7b, 0x15, 0xd5, 0xab, 0x74, 0x1e, 0x4f, 0xb9, 0xca, 0x8f, 0x53, 0xd0, 0x90, 0xe2, 0x9b, 0x21,
-	0xaf, 0xad, 0xdc, 0x3c, 0x3a, 0x75, 0xe6, 0x98, 0x6f, 0x1e, 0xde, 0x41, 0x55, 0x1e, 0xbb, 0x35,
-	0xfd, 0xfe, 0x15, 0xcf, 0x54, 0xfc, 0x78, 0x30, 0xf9, 0x90, 0x89, 0x2c, 0x71, 0x02, 0xf8, 0x40,
-	0xce, 0x00, 0x74, 0x2a, 0x6e, 0x31, 0xb3, 0xc6, 0x88, 0x77, 0x70, 0xa8, 0x84, 0xa7, 0x23, 0x54,
-	0x62, 0x5d, 0x34, 0xb9, 0xd8, 0x33, 0x5c, 0xb4, 0xb1, 0x43, 0xba, 0x70, 0x08, 0xd1, 0xf3, 0xc5,
-	0xcb, 0x3b, 0x22, 0x32, 0x58, 0x82, 0x56, 0xf3, 0xcf, 0x14, 0x6c, 0xa4, 0x20, 0x9f, 0x36, 0xf4,
-	0x85, 0x66, 0x1b, 0x2c, 0xde, 0x49, 0xcd, 0x13, 0xef, 0x9c, 0x03, 0x34, 0xe0, 0x31, 0x4b, 0x79,
-	0xe6, 0xdc, 0x97, 0x77, 0x1d, 0x74, 0x95, 0x03, 0x92, 0x47, 0x93, 0x87, 0x78, 0xc0, 0x33, 0x7e,
-	0xbb, 0x3c, 0x7a, 0x83, 0x5d, 0x5f, 0x55, 0x5e, 0x1c, 0xa9, 0x5c, 0x7a, 0x51, 0xb7, 0x3e, 0x1f,
-	0x3f, 0xf9, 0xd9, 0xc8, 0x99, 0xca, 0x2f, 0xaf, 0x55, 0x8e, 0x9c, 0xfe, 0x6c, 0xe4, 0x6c, 0xb8,
-	0xcb, 0xe4, 0xf8, 0xc5, 0xf2, 0xf5, 0x63, 0x5a, 0xde, 0xd1, 0xf7, 0x63, 0x96, 0x50, 0x50, 0x47,
-	0xcb, 0x98, 0x9f, 0x8f, 0x9f, 0x2c, 0x8f, 0x8d, 0x4f, 0x1f, 0xff, 0xb0, 0xfc, 0xde, 0xad, 0xf2,
-	0x85, 0x6b, 0x24, 0xe7, 0xf8, 0xf8, 0xd2, 0xe4, 0xcd, 0x53, 0x33, 0x23, 0x17, 0x55, 0x6f, 0xde,
-	0xce, 0x51, 0xe0, 0x2a, 0x47, 0x00, 0xfc, 0xa6, 0xd4, 0x44, 0xd7, 0xbd, 0x93, 0xc8, 0x0e, 0xef,
-	0x93, 0x25, 0xa6, 0x9d, 0x93, 0x37, 0x47, 0x26, 0x6f, 0xbe, 0x6b, 0x98, 0x05, 0x3c, 0x79, 0xf3,
-	0x04, 0xc3, 0x33, 0x75, 0xf1, 0xc8, 0xd4, 0xcf, 0x6f, 0xcf, 0x9c, 0xff, 0xc0, 0x8b, 0x8b, 0xfc,
-	0x14, 0x86, 0x2d, 0xfb, 0x31, 0xf4, 0x48, 0x4c, 0xf1, 0xaa, 0x22, 0x0b, 0xaa, 0x5d, 0x1c, 0x80,
-	0xd8, 0x29, 0xec, 0xd1, 0x8a, 0x36, 0x16, 0x0e, 0x89, 0x6f, 0x02, 0xd8, 0x14, 0x08, 0x7f, 0x09,
-	0x6a, 0xc7, 0xbb, 0x29, 0x08, 0x77, 0xe8, 0xb6, 0x93, 0xeb, 0xb3, 0x89, 0x72, 0xb4, 0x85, 0xe0,
-	0x31, 0x3c, 0xab, 0x61, 0xbd, 0xb9, 0x67, 0x8f, 0x8d, 0x1d, 0x0a, 0xa7, 0x46, 0xe5, 0x2d, 0xd4,
-	0x0e, 0xeb, 0x8a, 0xfa, 0x90, 0xee, 0xd0, 0x49, 0x6a, 0x54, 0xd6, 0x20, 0x54, 0x96, 0xa9, 0x52,
-	0x5f, 0xe8, 0xa5, 0xa5, 0xab, 0x7d, 0x1f, 0x4c, 0xdd, 0x9c, 0xef, 0x6e, 0x33, 0xa1, 0x7c, 0x84,
-	0xfa, 0xae, 0x50, 0xda, 0x91, 0x0e, 0x5c, 0x31, 0x75, 0x4b, 0x81, 0xcf, 0x4d, 0x07, 0x21, 0xc5,
-	0x72, 0xc6, 0xf1, 0x62, 0x87, 0x35, 0x61, 0x6f, 0xdc, 0x40, 0x79, 0x21, 0x97, 0xdb, 0xee, 0xe9,
-	0x35, 0x64, 0xd8, 0x98, 0x12, 0x23, 0x9e, 0x02, 0x34, 0x52, 0x22, 0x8b, 0xf8, 0x57, 0xc3, 0x7a,
-	0xe2, 0x01, 0x86, 0xed, 0x74, 0x13, 0xc3, 0xcb, 0x5a, 0xe2, 0x31, 0x00, 0x1b, 0x7d, 0x61, 0xd9,
-	0x56, 0x82, 0xb4, 0xfe, 0x7f, 0xbe, 0xdd, 0x0b, 0xb6, 0x69, 0x75, 0x74, 0x9b, 0xfc, 0x50, 0x4e,
-	0x84, 0x35, 0xba, 0xe5, 0x5d, 0x64, 0x55, 0xc5, 0x72, 0x2a, 0x61, 0x12, 0x20, 0x7e, 0x44, 0xca,
-	0xcd, 0xba, 0x1a, 0xcb, 0x3a, 0x08, 0x75, 0xc3, 0x76, 0x34, 0x23, 0x8f, 0x3d, 0x63, 0x56, 0x43,
-	0x14, 0xb2, 0x72, 0x9a, 0xff, 0xb3, 0xe9, 0xd9, 0xa5, 0x75, 0x48, 0xea, 0xb5, 0x51, 0xa9, 0xb7,
-	0xc3, 0x3a, 0xdd, 0x28, 0xe0, 0x03, 0x74, 0x0b, 0x6b, 0x55, 0xd6, 0x10, 0x2f, 0x01, 0xd8, 0x1a,
-	0x01, 0xb2, 0x58, 0x52, 0xd9, 0x02, 0x9b, 0xb0, 0xa1, 0xf7, 0x95, 0xf4, 0x21, 0xad, 0xf4, 0x83,
-	0x5c, 0xdf, 0x6c, 0xa1, 0xae, 0x1a, 0xe9, 0x25, 0x1e, 0xf4, 0x43, 0x9a, 0xbb, 0x96, 0x92, 0x18,
-	0x9b, 0x98, 0xc1, 0x8a, 0xd0, 0x02, 0xd9, 0xd4, 0x86, 0x65, 0x83, 0x61, 0x4b, 0x78, 0xf2, 0x45,
-	0x92, 0x8c, 0xf8, 0x1a, 0x80, 0x6d, 0xbb, 0x4b, 0x9a, 0x61, 0xe7, 0xfa, 0x76, 0x51, 0x35, 0x4d,
-	0x5e, 0x67, 0x3a, 0x38, 0xe6, 0xd9, 0x22, 0xbd, 0x66, 0xc4, 0x0a, 0x6b, 0x62, 0x56, 0xb8, 0x06,
-	0x36, 0xd8, 0xa5, 0x3c, 0x1b, 0x97, 0x6b, 0x44, 0x40, 0x20, 0xb2, 0x2b, 0x60, 0xdb, 0xe1, 0x6c,
-	0x66, 0xdb, 0x21, 0x8a, 0x38, 0x08, 0x57, 0xc4, 0x90, 0x2d, 0x96, 0x10, 0x1e, 0x87, 0xcd, 0xcf,
-	0x60, 0x27, 0xd7, 0xf7, 0xed, 0x61, 0xd3, 0xd1, 0x66, 0x15, 0x40, 0x24, 0x75, 0xf1, 0x55, 0x5b,
-	0x3c, 0x09, 0x60, 0x4b, 0xf8, 0xeb, 0xc5, 0xd2, 0xe1, 0xff, 0x83, 0x75, 0xfb, 0xc8, 0x0c, 0x5c,
-	0x79, 0x5b, 0x03, 0xe5, 0x65, 0x13, 0x33, 0xae, 0xb8, 0x1b, 0xb6, 0x75, 0x97, 0x30, 0xcd, 0x35,
-	0xef, 0x66, 0x39, 0x51, 0xcf, 0xdc, 0xcc, 0x3d, 0x33, 0xd9, 0x8b, 0xd8, 0xa8, 0x8b, 0xb5, 0x17,
-	0xbb, 0x61, 0xdb, 0x73, 0x56, 0x61, 0x11, 0xf0, 0xc7, 0x46, 0x5d, 0x2c, 0xfc, 0xdf, 0xf0, 0x9e,
-	0xb1, 0xee, 0x52, 0x9d, 0x06, 0xe1, 0x8a, 0xd8, 0xf7, 0x8b, 0x85, 0x54, 0x64, 0x2f, 0x33, 0x73,
-	0xe1, 0x14, 0x4f, 0x03, 0xd8, 0x1a, 0xe9, 0xb4, 0x58, 0xda, 0xbd, 0x01, 0xd6, 0x53, 0xfd, 0xf5,
-	0x8e, 0xae, 0x2a, 0xf5, 0xe6, 0x6c, 0xf9, 0xea, 0x6a, 0x92, 0xc6, 0x99, 0xc3, 0x85, 0x9d, 0xfe,
-	0x1f, 0x5e, 0xa0, 0x63, 0x00, 0x36, 0xf8, 0xcf, 0xcf, 0x68, 0xed, 0xe6, 0xf8, 0xdf, 0x6b, 0x84,
-	0x5f, 0xde, 0x33, 0xeb, 0xe6, 0x62, 0xdb, 0x96, 0xd8, 0xe9, 0x2a, 0x22, 0x6a, 0x8d, 0x3d, 0xcf,
-	0x67, 0xe2, 0x84, 0xc3, 0x7f, 0x98, 0x7c, 0x39, 0xd5, 0x2a, 0xc2, 0xec, 0xfe, 0x87, 0xb2, 0xcc,
-	0xdf, 0x75, 0x02, 0x09, 0x9d, 0x04, 0xb0, 0x29, 0xfc, 0x88, 0x89, 0x84, 0xaa, 0xc9, 0x62, 0x2f,
-	0xb3, 0x99, 0xfb, 0xe6, 0xe9, 0x61, 0x5b, 0xe2, 0xa3, 0x1c, 0x51, 0xe4, 0x05, 0x37, 0x13, 0x27,
-	0x50, 0x44, 0x4d, 0x52, 0x08, 0x11, 0x3a, 0x0e, 0x58, 0x78, 0xc6, 0xc1, 0x54, 0xaf, 0x3c, 0xf2,
-	0x12, 0x98, 0x59, 0x3f, 0x27, 0xdf, 0xb6, 0xc4, 0xc7, 0x5c, 0x65, 0x1d, 0x6a, 0x2e, 0xea, 0xb6,
-	0x13, 0xc0, 0x88, 0x36, 0x29, 0x88, 0x15, 0xa8, 0x35, 0x00, 0x91, 0x25, 0x7c, 0x2a, 0x98, 0xf0,
-	0x1b, 0x4b, 0x82, 0x60, 0x62, 0x0f, 0x43, 0x09, 0x82, 0x89, 0x3f, 0xd2, 0x10, 0xc1, 0xac, 0x41,
-	0x4d, 0xec, 0x0d, 0x81, 0x91, 0x33, 0x91, 0x16, 0x45, 0x83, 0xc4, 0xe6, 0x10, 0x1a, 0xd3, 0x22,
-	0xfb, 0xf4, 0x01, 0x80, 0xa8, 0xfa, 0xaa, 0x1f, 0x3d, 0x50, 0x35, 0x65, 0xe2, 0xb3, 0x45, 0x66,
-	0xc3, 0x82, 0xfa, 0xd9, 0x96, 0xb8, 0xdd, 0x55, 0x36, 0x22, 0x34, 0xfd, 0xd2, 0x47, 0xe5, 0xd3,
-	0xaf, 0x96, 0x4f, 0x8f, 0x4d, 0xbd, 0x72, 0x8d, 0x4b, 0x2d, 0x81, 0x46, 0xc1, 0x66, 0x50, 0x3a,
-	0x04, 0x76, 0x8f, 0x6e, 0x14, 0x34, 0x6f, 0x58, 0x22, 0x43, 0x18, 0x5c, 0x24, 0x26, 0xec, 0x66,
-	0xe4, 0xb2, 0x36, 0x61, 0x37, 0xa3, 0xb7, 0x90, 0xe2, 0x93, 0xae, 0x22, 0xa0, 0x16, 0x96, 0x6e,
-	0xeb, 0x16, 0x4b, 0x5d, 0x32, 0xb1, 0x36, 0x05, 0xd5, 0x2e, 0xd2, 0xfd, 0xd4, 0xad, 0xac, 0xc6,
-	0x47, 0x20, 0x32, 0x1c, 0x05, 0xb0, 0xc1, 0xbf, 0xf1, 0x49, 0x30, 0xba, 0xf0, 0xd5, 0x57, 0x82,
-	0xd1, 0x45, 0x2e, 0x8b, 0xc4, 0x27, 0x28, 0x16, 0x76, 0x3f, 0x16, 0x60, 0x89, 0xb6, 0x29, 0x96,
-	0x95, 0x62, 0x0b, 0xc7, 0x52, 0x62, 0x03, 0x10, 0x28, 0x6f, 0x01, 0xd8, 0x1c, 0x09, 0x15, 0x50,
-	0xb5, 0xf2, 0xc4, 0x83, 0x9c, 0x8c, 0x38, 0x5f, 0x17, 0xdb, 0x12, 0x77, 0xb8, 0xca, 0x26, 0xd4,
-	0x5e, 0x3e, 0xfd, 0x5a, 0xe5, 0xd4, 0x55, 0x0f, 0x06, 0xcb, 0x2a, 0x33, 0x89, 0x54, 0x0a, 0xf1,
-	0x1e, 0x11, 0x71, 0x88, 0x0e, 0x19, 0x90, 0x45, 0xff, 0x04, 0xe6, 0x15, 0x2f, 0x9d, 0xe3, 0x09,
-	0x7f, 0x92, 0x11, 0x44, 0xef, 0x44, 0x92, 0x8c, 0x20, 0x76, 0x63, 0xc0, 0x31, 0xf2, 0xbb, 0x13,
-	0x7a, 0x65, 0xe2, 0x0b, 0x30, 0x91, 0x1a, 0xc3, 0xb8, 0x87, 0x0c, 0x95, 0xcd, 0x93, 0x51, 0x09,
-	0xc6, 0xf7, 0x01, 0x5c, 0xee, 0xa5, 0x9c, 0x68, 0x4d, 0xf2, 0xec, 0x2c, 0x1a, 0xce, 0xac, 0x9d,
-	0x83, 0x6b, 0x5b, 0xe2, 0xa0, 0xab, 0x3c, 0x8b, 0xee, 0xe7, 0x08, 0xc6, 0x6f, 0x55, 0x46, 0x7e,
-	0x36, 0xf5, 0xc9, 0xd9, 0xf2, 0xa9, 0x2b, 0x7e, 0xde, 0xec, 0xc3, 0x7c, 0x20, 0xb1, 0xd3, 0xd4,
-	0xc5, 0x23, 0xd1, 0x7e, 0x61, 0x6b, 0xc6, 0x86, 0x1e, 0x60, 0x3e, 0x08, 0xeb, 0xd9, 0x19, 0x85,
-	0xee, 0x4d, 0xf4, 0x60, 0x2c, 0x3b, 0xcd, 0xac, 0x99, 0x9d, 0x69, 0x5b, 0xe2, 0x23, 0x54, 0x03,
-	0x2b, 0x97, 0xdf, 0x9e, 0x1e, 0x23, 0x9b, 0x4a, 0xff, 0x08, 0x22, 0x13, 0x6b, 0x53, 0x04, 0xcd,
-	0xa8, 0x91, 0x8b, 0x8e, 0x7a, 0xb6, 0x7f, 0x00, 0xd8, 0x18, 0xca, 0x61, 0xd0, 0xec, 0x66, 0xc7,
-	0xc5, 0x26, 0xcc, 0xdd, 0xc1, 0xb6, 0xc4, 0x2b, 0xc0, 0x55, 0x46, 0x01, 0xea, 0xe2, 0x47, 0x0e,
-	0xbd, 0x71, 0x08, 0x0b, 0x87, 0x99, 0xe8, 0xf4, 0x7b, 0xd7, 0xcb, 0x9f, 0x9c, 0xfb, 0x7c, 0xfc,
-	0x64, 0xf9, 0xcc, 0xc9, 0xca, 0xf9, 0x1b, 0x95, 0x17, 0x3f, 0x2d, 0x8f, 0x5e, 0x2f, 0x9f, 0x19,
-	0x9d, 0x19, 0x39, 0x3c, 0x75, 0xf1, 0x08, 0xf7, 0x33, 0x5f, 0xc2, 0x18, 0xe1, 0x93, 0x4e, 0xb7,
-	0x88, 0xe4, 0xb9, 0xc9, 0xc1, 0x20, 0x3f, 0x41, 0xb3, 0xda, 0x37, 0x5f, 0xf4, 0xfa, 0x39, 0xf9,
-	0xb6, 0x25, 0x3e, 0xe7, 0x2a, 0x5b, 0xd1, 0x3a, 0x76, 0xa4, 0xcd, 0x86, 0x33, 0x33, 0x0f, 0x3f,
-	0x7c, 0x02, 0x32, 0xa4, 0xe8, 0x14, 0x80, 0xcb, 0x9f, 0xc1, 0x0e, 0x0d, 0x21, 0x12, 0x40, 0x46,
-	0xa2, 0xfe, 0x04, 0x90, 0xd1, 0xb8, 0x5e, 0xdc, 0x4a, 0x4d, 0x8d, 0xb9, 0x6e, 0xef, 0xb2, 0x67,
-	0xe6, 0xe8, 0xa9, 0x99, 0xab, 0x6f, 0x64, 0x12, 0xa9, 0x14, 0x50, 0x23, 0x6a, 0x20, 0x80, 0x68,
-	0xf8, 0x82, 0x2e, 0x00, 0xd8, 0xc8, 0x02, 0x69, 0x06, 0x29, 0xc1, 0xbe, 0x63, 0xc1, 0x7b, 0x82,
-	0x9f, 0xaa, 0x8a, 0xc4, 0xc5, 0x6e, 0xe6, 0xa7, 0xe8, 0x5e, 0xc7, 0x81, 0x25, 0x51, 0x29, 0xb0,
-	0x16, 0x31, 0x00, 0x46, 0xb6, 0x94, 0x60, 0x63, 0x41, 0xf2, 0x6c, 0xd8, 0xe2, 0x81, 0x79, 0x02,
-	0xb6, 0xaa, 0x28, 0xfb, 0x2e, 0xb1, 0x65, 0xa2, 0xd8, 0x5e, 0x05, 0xb0, 0x91, 0xc5, 0x45, 0xb3,
-	0x61, 0x8b, 0x07, 0xdd, 0x09, 0xd8, 0xaa, 0xe2, 0x6a, 0xbe, 0xa1, 0x4c, 0xab, 0xaa, 0xb1, 0x55,
-	0x53, 0xd9, 0x86, 0x4a, 0xa1, 0x0d, 0x7d, 0x19, 0xc0, 0x06, 0xe2, 0x46, 0x18, 0xac, 0xf5, 0xb3,
-	0xb8, 0x18, 0x1f, 0x94, 0x30, 0x77, 0x07, 0x26, 0x2e, 0x09, 0xad, 0x24, 0xae, 0x45, 0x88, 0x21,
-	0x4a, 0x22, 0x52, 0x40, 0x6d, 0x52, 0x8b, 0x0f, 0x88, 0x3a, 0xa5, 0xae, 0x67, 0x5d, 0x65, 0x1b,
-	0xba, 0x0f, 0xa6, 0x69, 0xa0, 0x2c, 0x04, 0x91, 0xb2, 0xa0, 0xf4, 0xe5, 0x84, 0x1e, 0x33, 0x2f,
-	0xd7, 0xd1, 0x5b, 0x68, 0x09, 0x00, 0xb9, 0x4d, 0xb3, 0xac, 0xa2, 0x9e, 0xa7, 0x0f, 0xbf, 0xd9,
-	0xe7, 0x6d, 0xd3, 0xe8, 0xac, 0xa2, 0xf4, 0xd7, 0xd3, 0xbf, 0x25, 0x7e, 0xf8, 0x5f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x47, 0xf0, 0xbb, 0x9b, 0x09, 0x2d, 0x00, 0x00,
+	// 3768 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5b, 0x6f, 0x70, 0xdc, 0xc6,
+	0x75, 0xef, 0x1e, 0xef, 0xc8, 0xe3, 0xf2, 0xaf, 0xd6, 0x94, 0x7c, 0x41, 0x64, 0xe9, 0xbc, 0x4e,
+	0x23, 0xe6, 0x6a, 0x8a, 0x0e, 0xe2, 0xc6, 0x1e, 0xc6, 0xa9, 0x0d, 0x92, 0x96, 0x7b, 0x92, 0xec,
+	0xb0, 0x90, 0x3c, 0x4d, 0xbf, 0x15, 0xbc, 0x5b, 0x92, 0x88, 0x8f, 0x00, 0x74, 0x00, 0x15, 0x39,
+	0x1e, 0xcf, 0xb0, 0xaa, 0x2c, 0xd3, 0xae, 0x14, 0xc9, 0x88, 0x1d, 0x59, 0xb2, 0x1c, 0x57, 0x9e,
+	0x24, 0x75, 0x3d, 0x53, 0xcb, 0x4e, 0x5d, 0x5b, 0x52, 0x94, 0xf4, 0x5b, 0xa7, 0x7f, 0x66, 0xfa,
+	0x81, 0x9d, 0xc9, 0xc7, 0x4e, 0xa7, 0xe6, 0x1d, 0xc9, 0xb6, 0x33, 0xe9, 0x87, 0x7e, 0xea, 0xa7,
+	0xce, 0xfe, 0x01, 0xb0, 0xc0, 0xe1, 0x48, 0xca, 0x31, 0x53, 0xb6, 0xd2, 0xcc, 0x61, 0xf7, 0xbd,
+	0x87, 0xdd, 0xdf, 0xbe, 0x7d, 0xef, 0xed, 0xbe, 0x5d, 0x10, 0xee, 0xae, 0xd4, 0xec, 0x85, 0xaa,
+	0x45, 0x3c, 0x97, 0xd4, 0x4f, 0x9a, 0x15, 0x72, 0xd0, 0xa9, 0xdb, 0x9e, 0x8d, 0x06, 0x12, 0x64,
+	0xe5, 0xd0, 0xac, 0xe9, 0xcd, 0x2d, 0x4c, 0x1f, 0xac, 0xd8, 0xf3, 0xa3, 0xc7, 0x89, 0x55, 0x21,
+	0x96, 0x37, 0x3a, 0xfd, 0xcc, 0xc8, 0x74, 0xc5, 0x1d, 0x9d, 0xae, 0xb8, 0x23, 0x16, 0xf1, 0xbe,
+	0x6d, 0xd7, 0x9f, 0x19, 0x35, 0x1c, 0x73, 0x94, 0xb5, 0x50, 0xb1, 0x6b, 0xa3, 0x15, 0x7b, 0x7e,
+	0xde, 0xb6, 0xc4, 0x83, 0x37, 0xac, 0xec, 0x9d, 0xb5, 0xed, 0xd9, 0x1a, 0x61, 0x82, 0x86, 0x65,
+	0xd9, 0x9e, 0xe1, 0x99, 0xb6, 0xe5, 0x0a, 0xee, 0xfd, 0xfc, 0xdd, 0x91, 0x59, 0x62, 0x8d, 0xb8,
+	0xdf, 0x36, 0x66, 0x67, 0x49, 0x7d, 0xd4, 0x76, 0x98, 0x44, 0xab, 0x34, 0xfe, 0x24, 0x0b, 0x7b,
+	0xb5, 0x6a, 0xf5, 0xd8, 0xc2, 0xb4, 0x45, 0x3c, 0x9d, 0x9c, 0x40, 0xfb, 0x61, 0x87, 0x4b, 0x4e,
+	0x14, 0x40, 0x11, 0x0c, 0x67, 0xc7, 0xfb, 0x7c, 0x0d, 0x96, 0x68, 0x5d, 0xa5, 0x3f, 0x3a, 0xfd,
+	0x41, 0x07, 0x60, 0xee, 0xa4, 0x53, 0x29, 0x4f, 0x16, 0x32, 0x45, 0x30, 0xdc, 0x3d, 0xbe, 0xcb,
+	0xd7, 0xfa, 0x4b, 0x9c, 0xa2, 0xf2, 0x87, 0xce, 0x1f, 0xe8, 0xb7, 0x60, 0x67, 0x9d, 0xcc, 0x9a,
+	0xb6, 0x55, 0xe8, 0x60, 0x92, 0x77, 0xf9, 0xda, 0x60, 0x49, 0x90, 0x54, 0xf1, 0xd4, 0xc5, 0x13,
+	0xdd, 0x07, 0xb3, 0xdf, 0xb1, 0x2d, 0x52, 0xc8, 0x32, 0xd1, 0x01, 0x5f, 0xeb, 0x2d, 0x31, 0x82,
+	0xca, 0x7e, 0x75, 0xf6, 0x8b, 0xbe, 0x02, 0xf3, 0x2e, 0x03, 0x5a, 0x9e, 0x2c, 0xe4, 0x98, 0xe0,
+	0xdd, 0xbe, 0x36, 0x54, 0x0a, 0x89, 0x6a, 0x58, 0xd2, 0xc3, 0x12, 0xfa, 0x1a, 0x84, 0xbc, 0x3c,
+	0x61, 0x56, 0xeb, 0x85, 0x4e, 0xf6, 0xda, 0xe7, 0x7d, 0xad, 0x50, 0x92, 0xc8, 0xaa, 0x54, 0xd6,
+	0xa5, 0x32, 0xfa, 0x43, 0xd8, 0x3f, 0x6f, 0x5a, 0xe5, 0xa9, 0xa7, 0x16, 0xe6, 0xa7, 0x48, 0xfd,
+	0x71, 0xcb, 0x2c, 0x74, 0x15, 0xc1, 0x70, 0x6e, 0xfc, 0x61, 0x5f, 0xfb, 0xed, 0x52, 0x82, 0xa5,
+	0xde, 0x37, 0x6f, 0x5a, 0xc5, 0x3a, 0xa1, 0xd3, 0x4d, 0xaa, 0x45, 0xd3, 0x29, 0x5a, 0x0b, 0xf3,
+	0xd3, 0xa4, 0x5e, 0x9c, 0xb1, 0xeb, 0x45, 0x62, 0x54, 0xe6, 0x8a, 0xc4, 0x32, 0xf5, 0xc4, 0x4b,
+	0x63, 0xff, 0x0a, 0x7c, 0xed, 0x9f, 0x01, 0x3c, 0x5e, 0x8a, 0x4d, 0x83, 0xba, 0xbb, 0xb1, 0xf4,
+	0x46, 0xe3, 0xa7, 0xb7, 0x56, 0x6e, 0xbd, 0xd2, 0xf8, 0x8b, 0x8b, 0xab, 0x3f, 0x7b, 0x65, 0xed,
+	0xfa, 0xc7, 0xcd, 0x0f, 0x4e, 0x2f, 0x03, 0x3a, 0x05, 0xcb, 0x80, 0x6b, 0x78, 0x19, 0x08, 0xed,
+	0x2d, 0x83, 0x70, 0xb8, 0xcb, 0x40, 0x1e, 0xd6, 0x0b, 0x00, 0x9d, 0x06, 0xcf, 0x15, 0xb1, 0x4b,
+	0x4e, 0xe0, 0xb1, 0x22, 0xfe, 0x32, 0xbe, 0xbf, 0x88, 0xd9, 0xab, 0xb4, 0x76, 0xd2, 0xa9, 0x8c,
+	0x9c, 0xa2, 0xff, 0x28, 0x95, 0x37, 0x44, 0xc9, 0x86, 0x33, 0xe2, 0xce, 0x19, 0xd6, 0xec, 0x9c,
+	0x61, 0x52, 0x06, 0x55, 0x3c, 0x25, 0xd3, 0x72, 0xd0, 0x09, 0x1e, 0x0b, 0xca, 0x51, 0x03, 0x51,
+	0xaf, 0xac, 0x27, 0xf5, 0xa1, 0x83, 0x0f, 0xd0, 0xff, 0xa3, 0xea, 0x83, 0xf8, 0x79, 0xfc, 0x16,
+	0x80, 0x7d, 0xd2, 0xf0, 0x5c, 0x67, 0x73, 0x33, 0x7b, 0x0c, 0x76, 0x91, 0x7a, 0x7d, 0xc2, 0xae,
+	0x12, 0x66, 0x68, 0xfd, 0xea, 0xc0, 0x41, 0xe1, 0x04, 0x8f, 0x73, 0xf2, 0xf8, 0x6e, 0x5f, 0x43,
+	0xa5, 0x40, 0x48, 0x0d, 0x0a, 0x7a, 0x50, 0xa0, 0xf6, 0x47, 0xea, 0xf5, 0x27, 0xdd, 0xd9, 0x98,
+	0xfd, 0x71, 0x92, 0x2a, 0x9e, 0xba, 0x78, 0xe2, 0x1f, 0x74, 0xc0, 0x81, 0x49, 0x52, 0x23, 0x1e,
+	0xf9, 0xff, 0xeb, 0x0a, 0x63, 0x37, 0x81, 0xaf, 0xfd, 0x15, 0x80, 0x87, 0x4b, 0xc9, 0xa1, 0x52,
+	0x73, 0xfb, 0xd3, 0xf5, 0xcb, 0x3f, 0xbc, 0x63, 0x73, 0x53, 0x67, 0x11, 0xf9, 0x35, 0xd8, 0x57,
+	0xf1, 0x79, 0xfc, 0x36, 0x80, 0x83, 0x71, 0xd4, 0x3b, 0xd0, 0x8a, 0xae, 0x67, 0x60, 0xdf, 0x51,
+	0xd3, 0xf5, 0xfe, 0xd7, 0x6d, 0x48, 0x36, 0x8f, 0xec, 0x56, 0xcd, 0xa3, 0xee, 0x6b, 0x36, 0xbc,
+	0xbf, 0x14, 0x1f, 0x81, 0xba, 0xbb, 0xf9, 0xd6, 0x8f, 0x56, 0xaf, 0xfe, 0x49, 0x68, 0x1a, 0x8d,
+	0xa5, 0x4b, 0x6b, 0xd7, 0xde, 0xe1, 0xa6, 0xa1, 0x3e, 0x8a, 0xbe, 0xfe, 0x2b, 0x4c, 0x7f, 0xf1,
+	0x79, 0xdc, 0x04, 0xb0, 0x5f, 0xee, 0x6f, 0xe7, 0x4d, 0x2a, 0x9a, 0x84, 0x5d, 0x5c, 0x45, 0x6e,
+	0x21, 0x5b, 0xec, 0x18, 0xee, 0x51, 0xef, 0x0a, 0xba, 0x9b, 0xa0, 0x0b, 0x3c, 0x47, 0x2e, 0xba,
+	0x14, 0x82, 0x6a, 0x50, 0xd0, 0x83, 0x02, 0xfe, 0xb8, 0x03, 0x0e, 0x4c, 0xd0, 0x81, 0x6f, 0x4b,
+	0x80, 0x91, 0xe7, 0xbb, 0x63, 0xab, 0x2b, 0xe3, 0x01, 0x98, 0x73, 0x3d, 0xc3, 0xe3, 0x91, 0xa6,
+	0x4f, 0xb4, 0xce, 0x28, 0x2a, 0x7f, 0xe8, 0xfc, 0x91, 0xb2, 0x0a, 0xe6, 0x3e, 0xe3, 0x55, 0xf0,
+	0x32, 0xf0, 0xb5, 0xd7, 0x00, 0x3c, 0x54, 0x4a, 0xea, 0x48, 0x45, 0xcd, 0x37, 0x3e, 0x6c, 0xbe,
+	0x7a, 0x93, 0xdb, 0xde, 0xea, 0xcb, 0x1f, 0x35, 0x17, 0xff, 0x28, 0x19, 0x96, 0xa4, 0xc5, 0x4f,
+	0xc0, 0x3f, 0x82, 0xca, 0xbf, 0x5a, 0x54, 0x62, 0xcd, 0xe0, 0xb1, 0xe2, 0x03, 0x22, 0xf2, 0xc4,
+	0x51, 0xed, 0xc0, 0xc8, 0xf3, 0x2f, 0x19, 0xb8, 0xfb, 0x09, 0xe2, 0x69, 0x27, 0x0d, 0xb3, 0x66,
+	0x4c, 0xd7, 0xfe, 0x6f, 0xac, 0x62, 0x63, 0xef, 0x02, 0x5f, 0xfb, 0x31, 0x80, 0xe5, 0x52, 0x3a,
+	0xf6, 0xad, 0x4c, 0x7e, 0xb8, 0x26, 0xf1, 0xd6, 0xff, 0x00, 0xfd, 0xfe, 0x67, 0xb3, 0x1e, 0x19,
+	0x8e, 0x19, 0xd2, 0x47, 0x1e, 0xa4, 0xa1, 0xea, 0xdf, 0x00, 0xdc, 0x93, 0x06, 0x73, 0x07, 0x86,
+	0xac, 0xc7, 0x60, 0x27, 0xf7, 0x09, 0xa6, 0xfe, 0x36, 0x11, 0x8b, 0xb7, 0xc0, 0xe5, 0x54, 0xf1,
+	0xd4, 0xc5, 0x13, 0xaf, 0x76, 0xc2, 0x3e, 0xad, 0x56, 0xb3, 0x2b, 0x86, 0x47, 0xca, 0x53, 0x5b,
+	0xb2, 0x23, 0x39, 0x06, 0x65, 0xb6, 0x1a, 0x83, 0x46, 0x61, 0x57, 0xa5, 0xb6, 0xe0, 0x7a, 0xa4,
+	0x2e, 0xc6, 0xc5, 0xf5, 0x20, 0x68, 0x6a, 0x50, 0xd0, 0x83, 0x02, 0x7a, 0x08, 0x76, 0x5b, 0xc6,
+	0x3c, 0x71, 0x1d, 0xa3, 0x12, 0x18, 0xd7, 0xe7, 0x7c, 0x6d, 0x4f, 0x29, 0xa2, 0xaa, 0x51, 0x51,
+	0x8f, 0x8a, 0xb4, 0x27, 0xc7, 0xae, 0x3e, 0x65, 0xcc, 0x93, 0x42, 0x4e, 0xea, 0x49, 0xd0, 0xd4,
+	0xa0, 0xa0, 0x07, 0x05, 0xf4, 0x28, 0xec, 0xa9, 0xd8, 0x96, 0x67, 0x98, 0x16, 0xa9, 0x97, 0x27,
+	0x45, 0xe6, 0x70, 0x8f, 0xaf, 0x29, 0x25, 0x99, 0xae, 0xca, 0x15, 0x5d, 0xae, 0x50, 0x17, 0x98,
+	0xb3, 0x5d, 0xaf, 0xd0, 0x25, 0xb9, 0x00, 0x25, 0xa8, 0xec, 0x57, 0x67, 0xbf, 0xd4, 0xfb, 0x88,
+	0x65, 0x96, 0x27, 0x0b, 0x79, 0xc9, 0xfb, 0x18, 0x45, 0xe5, 0x0f, 0x9d, 0x3f, 0xd0, 0x23, 0xb0,
+	0xcb, 0x74, 0x0f, 0x99, 0xa7, 0x48, 0xb5, 0xd0, 0x5d, 0x04, 0xc3, 0xf9, 0x71, 0xec, 0x6b, 0xfb,
+	0x4b, 0x01, 0x4d, 0x1d, 0x6a, 0xbe, 0x7e, 0xbd, 0x71, 0xf1, 0xc7, 0x8d, 0xa5, 0xb3, 0xeb, 0x2f,
+	0x9c, 0x6f, 0xbc, 0x71, 0xab, 0xf1, 0xde, 0x95, 0xf2, 0x94, 0x1e, 0xb0, 0xd1, 0x11, 0xd8, 0xfb,
+	0x0c, 0x21, 0xce, 0xe4, 0x42, 0x9d, 0xa5, 0x7f, 0x05, 0xc8, 0x7a, 0x3b, 0xe0, 0x6b, 0x5f, 0x28,
+	0xc5, 0x18, 0xea, 0x50, 0xf0, 0xee, 0xca, 0x5f, 0xbf, 0xb9, 0xfa, 0xda, 0xe5, 0xe6, 0xa5, 0x8f,
+	0xd6, 0x2f, 0x7d, 0xa8, 0xc7, 0x64, 0xc6, 0xfe, 0x3e, 0xe3, 0x6b, 0x7f, 0x93, 0x81, 0x6e, 0x29,
+	0x6e, 0x22, 0xea, 0x10, 0xef, 0xbd, 0x3c, 0xd5, 0xb8, 0xfa, 0x7e, 0xe3, 0xcd, 0xc5, 0xf8, 0x26,
+	0x52, 0x8a, 0xd2, 0xc1, 0x64, 0x2e, 0x83, 0x68, 0x7e, 0x96, 0x41, 0xa0, 0xf8, 0x65, 0xc0, 0x34,
+	0xb3, 0x0c, 0xf8, 0xc0, 0x97, 0x41, 0x4c, 0xdd, 0xff, 0x08, 0xd0, 0x3f, 0x24, 0x53, 0x9a, 0x36,
+	0xfb, 0x46, 0xca, 0x12, 0x3d, 0x51, 0x0e, 0xcd, 0xb1, 0x43, 0xff, 0x0f, 0xfb, 0xa5, 0x1c, 0xcb,
+	0xa5, 0x24, 0xd1, 0x3d, 0x25, 0x78, 0xc4, 0x65, 0x4d, 0x8c, 0x08, 0x69, 0x8a, 0x47, 0x4e, 0x6b,
+	0x58, 0xaf, 0x0c, 0x1d, 0xa5, 0x12, 0xcb, 0x8c, 0xfa, 0x8b, 0xa0, 0x52, 0xd6, 0x31, 0x6d, 0xf2,
+	0xd0, 0x91, 0xa3, 0xc7, 0x26, 0xb5, 0xc3, 0x87, 0x8e, 0x1e, 0x39, 0xa6, 0x1d, 0x9e, 0x3c, 0xa4,
+	0x1d, 0x3b, 0x7a, 0x64, 0xf2, 0xf0, 0xa1, 0x63, 0xda, 0xa1, 0xc9, 0xa3, 0x87, 0x69, 0x58, 0xf9,
+	0x05, 0x80, 0xfd, 0xb2, 0x1a, 0x77, 0x60, 0x38, 0x79, 0x10, 0x66, 0x4c, 0x47, 0x84, 0x92, 0xc1,
+	0xa0, 0xa7, 0xf2, 0xd4, 0x37, 0xa6, 0xbf, 0x45, 0x2a, 0xde, 0x38, 0xf2, 0xb5, 0x81, 0x52, 0xc6,
+	0x74, 0xd4, 0x6e, 0xd3, 0x29, 0xda, 0x8c, 0xa6, 0x67, 0x4c, 0x07, 0xbf, 0x9c, 0x85, 0xbd, 0x3a,
+	0xa9, 0x11, 0xc3, 0xdd, 0x6a, 0x04, 0x91, 0x82, 0x41, 0x66, 0x4b, 0xc1, 0x40, 0xf2, 0xe9, 0x8e,
+	0x2d, 0xf9, 0xf4, 0x04, 0xec, 0x15, 0x45, 0x39, 0x80, 0xec, 0xf7, 0xb5, 0xbd, 0xa5, 0x18, 0x43,
+	0x8d, 0xd5, 0xf4, 0x58, 0x2d, 0x19, 0x18, 0x72, 0x77, 0x1a, 0x18, 0xc6, 0xfe, 0x13, 0xf8, 0xda,
+	0x7f, 0x00, 0xf8, 0xcd, 0x52, 0x4c, 0x3f, 0xea, 0xae, 0xf5, 0x17, 0x5f, 0x6e, 0xbe, 0xfa, 0x73,
+	0xee, 0x44, 0xab, 0x57, 0xce, 0x94, 0xa7, 0x02, 0xdf, 0x89, 0x1c, 0x26, 0x72, 0x92, 0x18, 0xa6,
+	0x84, 0x93, 0x5c, 0x00, 0xe8, 0x5c, 0xd2, 0x49, 0xda, 0x79, 0x42, 0xd2, 0xec, 0x1d, 0xbb, 0x3a,
+	0xf2, 0x80, 0xc4, 0x08, 0xbd, 0x84, 0x71, 0xb9, 0xab, 0xdc, 0xb9, 0x95, 0xd3, 0x13, 0x00, 0x69,
+	0xb0, 0x3b, 0x70, 0x07, 0xf5, 0xdd, 0x1c, 0x1c, 0x98, 0xa8, 0x11, 0xc3, 0x62, 0x01, 0x73, 0x8b,
+	0x16, 0x1b, 0x6d, 0x89, 0x32, 0x9b, 0x6f, 0x89, 0x7e, 0x7d, 0x6b, 0xdd, 0x04, 0xec, 0xa5, 0x47,
+	0x89, 0x35, 0xdb, 0x90, 0x17, 0x3c, 0x6e, 0xe6, 0x32, 0x43, 0x8d, 0xd5, 0xf4, 0x58, 0x4d, 0x6e,
+	0xe4, 0x88, 0x69, 0x55, 0x0b, 0x9d, 0x29, 0x8d, 0x50, 0x86, 0x1a, 0xab, 0xe9, 0xb1, 0x1a, 0x1d,
+	0xb3, 0x51, 0xad, 0xd6, 0x89, 0xeb, 0x16, 0xba, 0xa4, 0x31, 0x0b, 0x9a, 0x1a, 0x14, 0xf4, 0xa0,
+	0x30, 0xf6, 0x62, 0xc6, 0xd7, 0xfe, 0x38, 0x03, 0xad, 0x52, 0x72, 0x32, 0xd4, 0x7b, 0x9a, 0x37,
+	0x5e, 0x58, 0xbd, 0x78, 0x36, 0x74, 0x8f, 0x60, 0xa1, 0x6a, 0x5c, 0xbf, 0xb9, 0xf6, 0xc1, 0xb5,
+	0xc0, 0x55, 0xc2, 0xfd, 0x60, 0xfa, 0x22, 0x13, 0x1b, 0xe2, 0x32, 0x08, 0x81, 0x5c, 0x04, 0xe8,
+	0x7c, 0xd2, 0x63, 0xda, 0xee, 0x11, 0xef, 0x60, 0x51, 0x91, 0xbb, 0xa3, 0x54, 0xea, 0x5d, 0x2c,
+	0xb9, 0x98, 0x59, 0xa8, 0xb9, 0xc4, 0xa3, 0x22, 0x02, 0x42, 0x7c, 0x7d, 0x09, 0xf2, 0x8e, 0x98,
+	0x0e, 0x76, 0xa0, 0xd7, 0xfc, 0x77, 0x06, 0xf6, 0x30, 0x90, 0x8f, 0x5b, 0xe6, 0x56, 0xb3, 0x0d,
+	0xbe, 0xdf, 0xc9, 0x6c, 0xb2, 0xdf, 0x79, 0x0d, 0xb0, 0x0d, 0x8f, 0x5d, 0xaf, 0xf0, 0xe0, 0x9e,
+	0x1f, 0x7f, 0xce, 0xd7, 0x4e, 0x95, 0x02, 0x9a, 0x3a, 0x2f, 0x36, 0x3c, 0xb7, 0x6f, 0x35, 0x96,
+	0x3e, 0xe2, 0xc7, 0x57, 0xcd, 0x97, 0x16, 0x9b, 0x57, 0x5f, 0x32, 0x9d, 0x5f, 0xde, 0x3e, 0xf7,
+	0xc9, 0xe2, 0xc5, 0xe6, 0x5f, 0xbe, 0xd3, 0x3c, 0x73, 0xe1, 0x93, 0xc5, 0x57, 0x64, 0x91, 0x95,
+	0xdb, 0x57, 0x1a, 0xef, 0x9f, 0x35, 0x2a, 0x9e, 0x79, 0x92, 0xf0, 0x84, 0x82, 0x05, 0x5a, 0xce,
+	0xfc, 0xe5, 0xed, 0x73, 0x8d, 0xeb, 0xb7, 0xd7, 0x5e, 0xfc, 0xb0, 0xf1, 0xde, 0xcd, 0xc6, 0xe5,
+	0x77, 0x68, 0xce, 0xf1, 0xd3, 0xab, 0x2b, 0x37, 0xce, 0xaf, 0x2f, 0x5e, 0xd1, 0x83, 0x7e, 0xc7,
+	0x96, 0x80, 0xaf, 0x9d, 0x01, 0xf0, 0x77, 0x4b, 0xbd, 0x6c, 0xdc, 0x4f, 0x51, 0xdd, 0x91, 0x13,
+	0x6a, 0x89, 0x5b, 0xe7, 0xca, 0x8d, 0xc5, 0x95, 0x1b, 0xef, 0x5a, 0x76, 0x95, 0xac, 0xdc, 0x78,
+	0x99, 0xe3, 0x59, 0xbd, 0x72, 0x66, 0xf5, 0xcf, 0x6f, 0xad, 0x5f, 0xfa, 0x49, 0xb0, 0x2f, 0x0a,
+	0x53, 0x18, 0x3e, 0xec, 0x87, 0xd1, 0x57, 0x13, 0x86, 0xd7, 0xb2, 0xb3, 0x60, 0xd6, 0x25, 0x00,
+	0xe0, 0xb1, 0xe2, 0x8c, 0x51, 0x73, 0x49, 0xf1, 0x79, 0xfc, 0x03, 0x00, 0x7b, 0x23, 0xe5, 0xef,
+	0x40, 0xeb, 0x78, 0x37, 0x03, 0x21, 0x3d, 0xdd, 0x29, 0x4f, 0xb9, 0xd4, 0x38, 0x06, 0x25, 0x78,
+	0x1c, 0xcf, 0x1e, 0xd8, 0x69, 0xcf, 0xcc, 0xb8, 0xc4, 0x63, 0x70, 0x3a, 0x74, 0x51, 0x43, 0x43,
+	0x30, 0x57, 0x33, 0xe7, 0x4d, 0x8f, 0x75, 0xd2, 0xa1, 0xf3, 0x0a, 0xa5, 0xf2, 0x4c, 0x95, 0xc5,
+	0xc2, 0x20, 0x2d, 0xdd, 0x13, 0xc6, 0x60, 0x16, 0xe6, 0xc2, 0x70, 0xab, 0x48, 0xf9, 0x08, 0x8b,
+	0x5d, 0x52, 0xda, 0x51, 0x88, 0x42, 0x31, 0x0b, 0x4b, 0x51, 0xcc, 0x2d, 0x44, 0x5b, 0x8a, 0x3c,
+	0xe7, 0x88, 0x2a, 0xda, 0x2b, 0x47, 0xe3, 0x6e, 0xc6, 0x8b, 0x08, 0x14, 0x1b, 0xb7, 0x6b, 0xc8,
+	0xb1, 0xb1, 0x0a, 0x42, 0x22, 0x05, 0xe8, 0x61, 0x44, 0x56, 0xa6, 0x78, 0x69, 0x04, 0x58, 0x70,
+	0x0b, 0xbd, 0x1c, 0x2f, 0xaf, 0xe1, 0xb3, 0x00, 0xf6, 0x84, 0xca, 0x72, 0x9d, 0x14, 0x6d, 0x7d,
+	0x69, 0xb3, 0xd9, 0x8b, 0xa6, 0x69, 0x4f, 0x7c, 0x9a, 0xc2, 0xad, 0x1c, 0x86, 0x1d, 0xa6, 0x13,
+	0x1c, 0x64, 0xb5, 0xec, 0xe5, 0x74, 0xca, 0xc4, 0x67, 0xa5, 0x1d, 0xa9, 0x70, 0xeb, 0x56, 0x2c,
+	0xfb, 0x20, 0x34, 0x2d, 0xd7, 0x33, 0xac, 0x0a, 0x09, 0x9c, 0x59, 0x97, 0x28, 0x74, 0xe4, 0x2c,
+	0xff, 0xe7, 0xdd, 0xb3, 0xb2, 0xac, 0xf5, 0x6c, 0x5c, 0xeb, 0x43, 0x30, 0x67, 0x5a, 0x55, 0x72,
+	0x8a, 0x4d, 0x61, 0x56, 0xe7, 0x15, 0x7c, 0x15, 0xc0, 0x81, 0x18, 0x90, 0xed, 0xd2, 0xca, 0x83,
+	0xb0, 0x97, 0x58, 0xe6, 0x54, 0xdd, 0x9c, 0x37, 0xea, 0xcf, 0x96, 0xa7, 0xda, 0x6d, 0x75, 0xf5,
+	0x98, 0x14, 0x7e, 0x2e, 0xdc, 0xd2, 0x7c, 0x6a, 0x2d, 0xe1, 0x44, 0xc7, 0x1c, 0x56, 0x8c, 0x16,
+	0xe9, 0x26, 0x2b, 0xeb, 0x86, 0xc0, 0x7e, 0xb9, 0xf3, 0x6d, 0xd2, 0x0c, 0x7e, 0x1d, 0xc0, 0xc1,
+	0xe3, 0x75, 0xc3, 0x72, 0xcb, 0x53, 0xc7, 0x98, 0x99, 0xa6, 0x8f, 0xb3, 0x10, 0x2d, 0xf3, 0x7c,
+	0x90, 0x41, 0x35, 0xe6, 0x85, 0x1d, 0x09, 0x2f, 0xdc, 0x0b, 0xbb, 0xdd, 0x7a, 0x85, 0xb7, 0x2b,
+	0x2c, 0x22, 0x22, 0x50, 0xdd, 0x55, 0x89, 0xeb, 0x09, 0x36, 0xf7, 0x6d, 0x89, 0x82, 0xe7, 0xe0,
+	0xae, 0x04, 0xb2, 0xed, 0x52, 0xc2, 0xd7, 0x60, 0xdf, 0x13, 0xc4, 0x2b, 0x4f, 0xfd, 0xde, 0x82,
+	0xed, 0x19, 0x6d, 0x15, 0x10, 0x4b, 0x5d, 0x42, 0xd3, 0xc6, 0xe7, 0x00, 0xec, 0x97, 0xdf, 0xde,
+	0x2e, 0x1b, 0xfe, 0x4d, 0x98, 0x3b, 0x41, 0x7b, 0x10, 0xc6, 0x3b, 0x10, 0x19, 0x2f, 0xef, 0x98,
+	0x73, 0xf1, 0x71, 0x38, 0x38, 0x51, 0x27, 0x2c, 0xd7, 0xfc, 0x34, 0xc3, 0x89, 0x47, 0xe6, 0x3e,
+	0x11, 0x99, 0xe9, 0x5c, 0x24, 0x5a, 0xdd, 0xae, 0xb9, 0x38, 0x0e, 0x07, 0x9f, 0x76, 0xaa, 0xdb,
+	0x80, 0x3f, 0xd1, 0xea, 0x76, 0xe1, 0xff, 0x9d, 0xe0, 0x1a, 0xeb, 0x53, 0x9a, 0xd3, 0x1c, 0xdc,
+	0x95, 0x78, 0x7f, 0xbb, 0x90, 0x62, 0x7e, 0x33, 0xb3, 0x11, 0x4e, 0x7c, 0x01, 0xc0, 0x81, 0x98,
+	0xd0, 0x76, 0x59, 0xf7, 0x01, 0xd8, 0xc9, 0xec, 0x37, 0x58, 0xba, 0x5a, 0xcc, 0x5b, 0xb0, 0x29,
+	0xa2, 0xbb, 0x99, 0xbb, 0x4d, 0xd9, 0x76, 0xed, 0x69, 0xcf, 0xac, 0x99, 0xdf, 0x61, 0x87, 0x56,
+	0xe9, 0x7a, 0x1e, 0x8a, 0x9d, 0x7d, 0xb7, 0xee, 0x28, 0x3a, 0xda, 0xee, 0x28, 0xb2, 0xed, 0x77,
+	0x14, 0xb9, 0xf8, 0x8c, 0xad, 0x65, 0x60, 0x21, 0x1d, 0xd1, 0x76, 0x29, 0xeb, 0x91, 0xe4, 0x8d,
+	0x15, 0x3e, 0x98, 0xfc, 0x44, 0x85, 0x9f, 0x01, 0xcb, 0x68, 0x82, 0x57, 0xd0, 0xa3, 0x30, 0x2f,
+	0xa0, 0xd3, 0xa8, 0x4b, 0x5f, 0xbf, 0xaf, 0xe5, 0xf5, 0x09, 0x2e, 0x20, 0xbf, 0x1f, 0xbe, 0x44,
+	0x1b, 0x98, 0xe1, 0x29, 0x8b, 0x5b, 0xe8, 0x6c, 0xd3, 0x40, 0x94, 0xd3, 0x90, 0xfa, 0x49, 0xd1,
+	0x40, 0xf0, 0x12, 0xcd, 0x7b, 0x6b, 0xc4, 0x78, 0x86, 0xb7, 0xd0, 0xc5, 0x5a, 0xf8, 0x5c, 0x4b,
+	0x0b, 0x47, 0x85, 0x84, 0x1e, 0xc9, 0xe2, 0x97, 0xb2, 0x70, 0x57, 0xcb, 0xc8, 0xa2, 0x49, 0x06,
+	0xe9, 0x93, 0x9c, 0x89, 0x4d, 0x72, 0xda, 0xc6, 0x65, 0xa3, 0x89, 0xdf, 0x17, 0xfb, 0xbe, 0x44,
+	0x2c, 0x53, 0x11, 0x85, 0xf6, 0xce, 0x6f, 0xd9, 0xe8, 0x1e, 0x34, 0x17, 0x5c, 0xa9, 0xed, 0x83,
+	0xd0, 0xb3, 0x3d, 0xa3, 0xc6, 0x2e, 0xc1, 0xd8, 0x1e, 0x34, 0xab, 0x4b, 0x14, 0xba, 0x34, 0xce,
+	0xd4, 0x09, 0xe1, 0xec, 0x3c, 0x63, 0x47, 0x04, 0x54, 0x84, 0x3d, 0x3c, 0x85, 0xe1, 0xfc, 0x6e,
+	0xc6, 0x97, 0x49, 0xf4, 0x7d, 0xb3, 0x5a, 0x13, 0x7c, 0xc8, 0xdf, 0x0f, 0x09, 0xb4, 0x77, 0xa1,
+	0x6c, 0xca, 0xee, 0xe1, 0xbd, 0x47, 0x14, 0x34, 0x0c, 0x07, 0xe4, 0x2d, 0x08, 0x15, 0xea, 0x65,
+	0x42, 0x49, 0x32, 0xfa, 0x02, 0xec, 0x0b, 0xee, 0xfa, 0xb8, 0x5c, 0x1f, 0x93, 0x8b, 0x13, 0xe9,
+	0x36, 0xc7, 0xa3, 0x4b, 0xb5, 0xe9, 0x71, 0xa1, 0x7e, 0x26, 0x14, 0xa3, 0xd1, 0x31, 0x05, 0x13,
+	0x49, 0x45, 0x06, 0xf8, 0x98, 0x24, 0x12, 0x33, 0x77, 0xcb, 0xa4, 0xcc, 0x41, 0xc6, 0x14, 0x35,
+	0xaa, 0xe1, 0x05, 0xd7, 0x98, 0x25, 0x85, 0x5d, 0x45, 0x30, 0x0c, 0x74, 0x5e, 0xc1, 0xaf, 0x67,
+	0x20, 0x6a, 0x35, 0x53, 0xd9, 0x4f, 0x41, 0x7c, 0x65, 0xd8, 0x07, 0x21, 0x8b, 0x21, 0x47, 0xd9,
+	0xf2, 0x90, 0xe1, 0x4a, 0x89, 0x28, 0x54, 0xa5, 0xac, 0xf6, 0xb4, 0x4b, 0xaa, 0xcc, 0x3a, 0xb2,
+	0x7a, 0x44, 0x48, 0x4e, 0x49, 0x76, 0x93, 0x29, 0xc9, 0x6d, 0x3c, 0x25, 0x9d, 0x5b, 0x99, 0x92,
+	0xae, 0xf4, 0x29, 0x49, 0x28, 0x32, 0xdf, 0xa2, 0x48, 0xfc, 0x8b, 0x0c, 0x44, 0xad, 0x0e, 0x28,
+	0x6f, 0xe2, 0x40, 0xfb, 0x4d, 0x5c, 0xa6, 0x7d, 0xe0, 0xeb, 0x88, 0x2b, 0x74, 0x6f, 0xcb, 0xf1,
+	0x95, 0x9c, 0x30, 0x15, 0x12, 0xf7, 0x31, 0x51, 0xa2, 0x85, 0x13, 0xa7, 0x57, 0x3c, 0x79, 0x8b,
+	0xd1, 0x64, 0x19, 0x76, 0x38, 0xd5, 0x15, 0x97, 0xa1, 0xb4, 0x28, 0x25, 0xcb, 0xcb, 0x29, 0x59,
+	0x94, 0x7e, 0x75, 0xcb, 0xe9, 0x17, 0x6d, 0xb1, 0xf5, 0x86, 0x24, 0x7e, 0xf1, 0x41, 0x27, 0x89,
+	0x9c, 0x72, 0xcc, 0x3a, 0x39, 0x6e, 0xce, 0x13, 0x91, 0xd4, 0x49, 0x14, 0xfc, 0xb7, 0x19, 0x98,
+	0x0f, 0xe2, 0xd2, 0x8e, 0x51, 0x67, 0x31, 0xe5, 0x1e, 0x2b, 0x7e, 0x51, 0x85, 0xe4, 0x8b, 0x2a,
+	0x91, 0xa5, 0xde, 0x99, 0xf2, 0x0a, 0xd1, 0xe5, 0x14, 0xd5, 0x5b, 0x3e, 0xba, 0x78, 0x62, 0x61,
+	0xd6, 0x70, 0x6d, 0x4b, 0xa8, 0x4b, 0xd4, 0xa8, 0x2a, 0x17, 0xd8, 0x8e, 0x8b, 0xa9, 0x92, 0x67,
+	0xc2, 0x12, 0x85, 0x26, 0xa1, 0x83, 0x13, 0xf6, 0xbc, 0x63, 0x54, 0xc4, 0xca, 0xb9, 0xdd, 0x0b,
+	0xf8, 0x1e, 0xd8, 0x59, 0xad, 0x3f, 0xab, 0x2f, 0xf0, 0x63, 0x84, 0xbc, 0x2e, 0x6a, 0xf8, 0xef,
+	0x00, 0xdc, 0x95, 0x00, 0xb2, 0x5d, 0xeb, 0x76, 0x04, 0x21, 0x2b, 0x43, 0x40, 0x4f, 0x40, 0x68,
+	0x3b, 0x84, 0xdb, 0x60, 0xb0, 0x26, 0x1f, 0x68, 0x59, 0x10, 0x39, 0x3a, 0x01, 0xf5, 0x1b, 0x81,
+	0xbc, 0x2e, 0xbd, 0x8a, 0x4f, 0x67, 0xe0, 0x9e, 0x74, 0xb1, 0xcf, 0xdc, 0x5a, 0x11, 0xcc, 0x7a,
+	0xcf, 0x3a, 0x81, 0xa1, 0xb2, 0x32, 0x6b, 0xa9, 0x5e, 0x79, 0x9c, 0x99, 0x55, 0x4e, 0xb4, 0x24,
+	0xea, 0xd4, 0xba, 0x69, 0x6e, 0xc7, 0x99, 0xdc, 0x46, 0x23, 0x82, 0x64, 0x45, 0x5d, 0x31, 0x2b,
+	0x52, 0x60, 0x9e, 0x9c, 0x22, 0x95, 0x05, 0x8f, 0x54, 0x99, 0xa1, 0xe6, 0xf5, 0xb0, 0x2e, 0x69,
+	0xb9, 0x5b, 0xd6, 0xb2, 0xfa, 0xef, 0x77, 0xd3, 0x83, 0x7e, 0x7b, 0xa1, 0xfa, 0x54, 0xa8, 0x3b,
+	0x74, 0x16, 0xc0, 0xee, 0xf0, 0x03, 0x45, 0x74, 0x4f, 0x8b, 0x6e, 0xe5, 0x6f, 0x33, 0x95, 0x7d,
+	0x1b, 0xb1, 0x5d, 0x07, 0x8f, 0xf9, 0x1a, 0x46, 0x03, 0x89, 0x0f, 0x38, 0x95, 0x24, 0xe1, 0xf4,
+	0x3f, 0xad, 0x7c, 0x2f, 0x33, 0x80, 0xe1, 0xe8, 0xc9, 0x2f, 0x8f, 0x72, 0x7d, 0x8e, 0x81, 0x12,
+	0x3a, 0x07, 0x60, 0xaf, 0xfc, 0x99, 0x1b, 0x2a, 0xb6, 0x74, 0x96, 0xf8, 0x76, 0x4f, 0xb9, 0x77,
+	0x13, 0x09, 0xd7, 0xc1, 0x0f, 0x09, 0x44, 0xb1, 0x6f, 0xfc, 0x94, 0x24, 0x81, 0x21, 0xea, 0x2d,
+	0x49, 0x88, 0xd0, 0x8b, 0x80, 0x1f, 0xe0, 0x09, 0x30, 0xad, 0x23, 0x8f, 0x7d, 0x2b, 0xa6, 0xec,
+	0xdf, 0x90, 0xef, 0x3a, 0xf8, 0x61, 0x5f, 0xdb, 0x87, 0xfa, 0x6a, 0xa6, 0xeb, 0x45, 0x30, 0xe2,
+	0x55, 0x06, 0x62, 0x17, 0x1a, 0x88, 0x40, 0x8c, 0x52, 0x3e, 0x53, 0x8c, 0xfc, 0x15, 0x4e, 0x8a,
+	0x62, 0x12, 0x9f, 0x0e, 0x29, 0xf7, 0x6e, 0x22, 0xc1, 0x15, 0xb3, 0x17, 0xf5, 0xf2, 0xaf, 0x4c,
+	0x38, 0x59, 0x89, 0xd5, 0x18, 0x1a, 0x84, 0xfb, 0x24, 0x34, 0xb6, 0x43, 0xe7, 0xe9, 0x27, 0x00,
+	0xa2, 0xd6, 0x8f, 0x41, 0xd0, 0x17, 0x5b, 0xba, 0x4c, 0xfd, 0xb0, 0x45, 0x39, 0xb0, 0x25, 0x39,
+	0xd7, 0xc1, 0x47, 0x7c, 0x6d, 0x18, 0xa1, 0xb5, 0xef, 0x7e, 0xdc, 0xb8, 0xf0, 0xfd, 0xc6, 0x85,
+	0xeb, 0xab, 0xaf, 0xbe, 0x23, 0xb4, 0x96, 0x42, 0x63, 0x60, 0x15, 0x54, 0x90, 0xc0, 0xce, 0x98,
+	0x56, 0xd5, 0x08, 0x9a, 0xa5, 0x3a, 0x84, 0xd1, 0x55, 0x73, 0xca, 0x6c, 0xc6, 0xae, 0xf3, 0x95,
+	0xfd, 0x1b, 0xf2, 0x5d, 0x07, 0x7f, 0xdd, 0xd7, 0x8a, 0xa8, 0x9f, 0x5f, 0xc8, 0x98, 0x0e, 0x3f,
+	0xdc, 0x56, 0x12, 0x75, 0x06, 0x6a, 0x08, 0xb3, 0xf9, 0x34, 0x9d, 0x51, 0x43, 0xb4, 0x40, 0x75,
+	0xb8, 0x04, 0x60, 0x77, 0x78, 0x27, 0x98, 0xe2, 0x74, 0xf2, 0xe5, 0xa8, 0xb2, 0x6f, 0x23, 0xb6,
+	0xeb, 0xe0, 0x47, 0x18, 0x16, 0x7e, 0x83, 0x1a, 0x61, 0x89, 0xd7, 0x19, 0x96, 0xbb, 0x70, 0xbf,
+	0xc0, 0x52, 0xe7, 0x0d, 0x50, 0x28, 0x3f, 0x04, 0xb0, 0x2f, 0x76, 0x98, 0x84, 0x5a, 0x8d, 0x27,
+	0x79, 0x0c, 0xa6, 0xe0, 0xcd, 0x44, 0x5c, 0x07, 0x1f, 0xf5, 0xb5, 0xfb, 0xd1, 0x50, 0xe3, 0xc2,
+	0xeb, 0xcd, 0xf3, 0x6f, 0x07, 0x30, 0xf8, 0xbd, 0x83, 0x92, 0x4a, 0x65, 0x10, 0xef, 0xc6, 0x48,
+	0x40, 0x64, 0x3b, 0x64, 0xbe, 0xc6, 0x52, 0x98, 0xd7, 0x82, 0x03, 0x7f, 0xb1, 0x7b, 0x4b, 0x73,
+	0x82, 0xf8, 0xad, 0x99, 0x72, 0xef, 0x26, 0x12, 0x21, 0x46, 0x71, 0xbb, 0xc6, 0x2e, 0xd5, 0x42,
+	0x05, 0xa6, 0x52, 0x13, 0x18, 0xd9, 0x26, 0x75, 0xb4, 0x42, 0x5b, 0xa5, 0x18, 0x3f, 0x00, 0x30,
+	0x1f, 0x5c, 0x4a, 0xa0, 0xbd, 0xe9, 0xbd, 0xf3, 0xf3, 0x52, 0xe5, 0x9e, 0x0d, 0xb8, 0xae, 0x83,
+	0xe7, 0x7c, 0xed, 0x49, 0x74, 0x9f, 0x40, 0x70, 0xfb, 0x66, 0x73, 0xf1, 0xcf, 0xe8, 0xe7, 0xa7,
+	0xe7, 0xaf, 0x85, 0x37, 0x2b, 0x21, 0xcc, 0x2f, 0xa6, 0x0a, 0xad, 0x5e, 0x39, 0x13, 0x97, 0x93,
+	0xbd, 0x99, 0x58, 0x66, 0x84, 0xf9, 0x39, 0xd8, 0xc9, 0x4f, 0x31, 0xd0, 0xe7, 0x53, 0x23, 0x18,
+	0xbf, 0xbf, 0x50, 0xf6, 0xb6, 0x67, 0xba, 0x0e, 0xfe, 0x2a, 0xb3, 0xc0, 0xe6, 0x5b, 0x3f, 0x5a,
+	0xbb, 0x4e, 0x27, 0x95, 0x7d, 0x26, 0xab, 0x24, 0xea, 0x0c, 0x41, 0x1f, 0xea, 0x11, 0xaa, 0x63,
+	0x91, 0xed, 0xbf, 0x00, 0xec, 0x91, 0x4e, 0xb9, 0x51, 0x7b, 0xb7, 0x13, 0x6a, 0x2b, 0x6e, 0x2c,
+	0xe0, 0x3a, 0xf8, 0x1a, 0xf0, 0xb5, 0x25, 0x80, 0xc6, 0xc5, 0x92, 0xc3, 0xee, 0xa4, 0x64, 0xe5,
+	0x70, 0x17, 0x5d, 0x7b, 0xef, 0xfd, 0xc6, 0xcf, 0x5e, 0xa3, 0x97, 0x5e, 0x17, 0xcf, 0x35, 0x2f,
+	0x7d, 0xd4, 0x7c, 0xe9, 0xe7, 0x8d, 0xa5, 0xf7, 0x1b, 0x17, 0x97, 0xd6, 0x17, 0x4f, 0xd3, 0xeb,
+	0x55, 0x1e, 0x67, 0x3e, 0x83, 0x36, 0xe4, 0x95, 0xce, 0x74, 0xa8, 0xe6, 0x85, 0xcb, 0xc1, 0xe8,
+	0x04, 0x1b, 0xb5, 0xf5, 0x6f, 0x31, 0xe8, 0xfd, 0x1b, 0xf2, 0x5d, 0x07, 0x3f, 0xed, 0x6b, 0x8f,
+	0xa1, 0x7d, 0x7c, 0x49, 0x6b, 0x87, 0x53, 0xd9, 0x84, 0x2f, 0xaf, 0x80, 0x1c, 0x29, 0x3a, 0x0f,
+	0x60, 0xfe, 0x09, 0xe2, 0xb1, 0x43, 0xa6, 0x14, 0x90, 0xb1, 0x73, 0x61, 0x65, 0xff, 0x86, 0x7c,
+	0xd7, 0xc1, 0x8f, 0x31, 0x57, 0xe3, 0xa1, 0x3b, 0xb8, 0x0e, 0x5c, 0x7f, 0xe1, 0xfc, 0xfa, 0xdb,
+	0x6f, 0x2a, 0xa9, 0x54, 0x06, 0xa8, 0x07, 0x75, 0x53, 0x40, 0x2c, 0xd7, 0x44, 0x97, 0x01, 0xec,
+	0xe1, 0x47, 0xad, 0x1c, 0x52, 0x8a, 0x7f, 0x27, 0x8e, 0x77, 0x15, 0xbc, 0x99, 0x88, 0xeb, 0xe0,
+	0x09, 0x1e, 0xa7, 0xd8, 0x5c, 0x27, 0x81, 0xa5, 0x51, 0x19, 0xb0, 0x7e, 0x1c, 0x01, 0xa3, 0x53,
+	0x4a, 0xb1, 0xf1, 0x63, 0xd4, 0x76, 0xd8, 0x92, 0x47, 0xb7, 0x0a, 0xde, 0x4c, 0xe4, 0x53, 0x63,
+	0x53, 0xe2, 0xd8, 0xbe, 0x0f, 0x60, 0x0f, 0xdf, 0x17, 0xb5, 0xc3, 0x96, 0x3c, 0x96, 0x55, 0xf0,
+	0x66, 0x22, 0xe1, 0x84, 0x72, 0xab, 0x6a, 0xc5, 0xd6, 0x4a, 0xe5, 0x13, 0x5a, 0x92, 0x26, 0xf4,
+	0x7b, 0x00, 0x76, 0xd3, 0x30, 0xc2, 0x61, 0xed, 0x6f, 0x13, 0x62, 0x42, 0x50, 0xc5, 0x8d, 0x05,
+	0xb8, 0xba, 0x4a, 0xe8, 0x2e, 0x1a, 0x5a, 0x8a, 0x09, 0x44, 0x69, 0x44, 0x06, 0x68, 0xb0, 0xd4,
+	0x1f, 0x02, 0xe2, 0x41, 0x69, 0x1e, 0x0e, 0xa5, 0x9d, 0x59, 0xa2, 0xe1, 0x74, 0x0b, 0x6f, 0x3d,
+	0x6c, 0x55, 0xbe, 0xb4, 0x45, 0x49, 0xd7, 0xc1, 0xbf, 0x81, 0xbe, 0x09, 0xfb, 0x62, 0x39, 0x56,
+	0x9a, 0x59, 0x27, 0x92, 0x41, 0x05, 0x6f, 0x26, 0x42, 0x5b, 0x1e, 0x7f, 0xd2, 0xd7, 0x0e, 0xa3,
+	0x7b, 0x61, 0x81, 0xed, 0xf8, 0x8b, 0xd1, 0x96, 0xbf, 0xa8, 0x4d, 0x95, 0x8b, 0x93, 0x76, 0x45,
+	0xcd, 0xb1, 0x0f, 0x2e, 0x4a, 0x00, 0xa8, 0x83, 0x86, 0xe3, 0xd4, 0xcc, 0x0a, 0xc3, 0x35, 0xfa,
+	0x2d, 0xd7, 0xb6, 0xc6, 0x5a, 0x28, 0xd3, 0x9d, 0xec, 0xcf, 0xe6, 0xbe, 0xf2, 0x3f, 0x03, 0x00,
+	0xcd, 0x44, 0x56, 0x2a, 0xf4, 0x37, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2597,6 +3558,8 @@ type CloudNetserviceClient interface {
 	UpdateQuota(ctx context.Context, in *UpdateIPQuotaReq, opts ...grpc.CallOption) (*UpdateIPQuotaResp, error)
 	DeleteQuota(ctx context.Context, in *DeleteIPQuotaReq, opts ...grpc.CallOption) (*DeleteIPQuotaResp, error)
 	ListQuota(ctx context.Context, in *ListIPQuotaReq, opts ...grpc.CallOption) (*ListIPQuotaResp, error)
+	GetIPPoolUtilization(ctx context.Context, in *GetIPPoolUtilizationReq, opts ...grpc.CallOption) (*GetIPPoolUtilizationResp, error)
+	CompactIPPool(ctx context.Context, in *CompactIPPoolReq, opts ...grpc.CallOption) (*CompactIPPoolResp, error)
 }
 
 type cloudNetserviceClient struct {
@@ -2769,6 +3732,24 @@ func (c *cloudNetserviceClient) ListQuota(ctx context.Context, in *ListIPQuotaRe
 	return out, nil
 }
 
+func (c *cloudNetserviceClient) GetIPPoolUtilization(ctx context.Context, in *GetIPPoolUtilizationReq, opts ...grpc.CallOption) (*GetIPPoolUtilizationResp, error) {
+	out := new(GetIPPoolUtilizationResp)
+	err := c.cc.Invoke(ctx, "/cloudnetservice.CloudNetservice/GetIPPoolUtilization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudNetserviceClient) CompactIPPool(ctx context.Context, in *CompactIPPoolReq, opts ...grpc.CallOption) (*CompactIPPoolResp, error) {
+	out := new(CompactIPPoolResp)
+	err := c.cc.Invoke(ctx, "/cloudnetservice.CloudNetservice/CompactIPPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CloudNetserviceServer is the server API for CloudNetservice service.
 type CloudNetserviceServer interface {
 	AddSubnet(context.Context, *AddSubnetReq) (*AddSubnetResp, error)
@@ -2789,6 +3770,8 @@ type CloudNetserviceServer interface {
 	UpdateQuota(context.Context, *UpdateIPQuotaReq) (*UpdateIPQuotaResp, error)
 	DeleteQuota(context.Context, *DeleteIPQuotaReq) (*DeleteIPQuotaResp, error)
 	ListQuota(context.Context, *ListIPQuotaReq) (*ListIPQuotaResp, error)
+	GetIPPoolUtilization(context.Context, *GetIPPoolUtilizationReq) (*GetIPPoolUtilizationResp, error)
+	CompactIPPool(context.Context, *CompactIPPoolReq) (*CompactIPPoolResp, error)
 }
 
 // UnimplementedCloudNetserviceServer can be embedded to have forward compatible implementations.
@@ -2849,6 +3832,12 @@ func (*UnimplementedCloudNetserviceServer) DeleteQuota(ctx context.Context, req
 func (*UnimplementedCloudNetserviceServer) ListQuota(ctx context.Context, req *ListIPQuotaReq) (*ListIPQuotaResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListQuota not implemented")
 }
+func (*UnimplementedCloudNetserviceServer) GetIPPoolUtilization(ctx context.Context, req *GetIPPoolUtilizationReq) (*GetIPPoolUtilizationResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIPPoolUtilization not implemented")
+}
+func (*UnimplementedCloudNetserviceServer) CompactIPPool(ctx context.Context, req *CompactIPPoolReq) (*CompactIPPoolResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompactIPPool not implemented")
+}
 
 func RegisterCloudNetserviceServer(s *grpc.Server, srv CloudNetserviceServer) {
 	s.RegisterService(&_CloudNetservice_serviceDesc, srv)
@@ -3178,6 +4167,42 @@ func _CloudNetservice_ListQuota_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CloudNetservice_GetIPPoolUtilization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIPPoolUtilizationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudNetserviceServer).GetIPPoolUtilization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cloudnetservice.CloudNetservice/GetIPPoolUtilization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudNetserviceServer).GetIPPoolUtilization(ctx, req.(*GetIPPoolUtilizationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudNetservice_CompactIPPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompactIPPoolReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudNetserviceServer).CompactIPPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cloudnetservice.CloudNetservice/CompactIPPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudNetserviceServer).CompactIPPool(ctx, req.(*CompactIPPoolReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _CloudNetservice_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cloudnetservice.CloudNetservice",
 	HandlerType: (*CloudNetserviceServer)(nil),
@@ -3254,6 +4279,14 @@ var _CloudNetservice_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListQuota",
 			Handler:    _CloudNetservice_ListQuota_Handler,
 		},
+		{
+			MethodName: "GetIPPoolUtilization",
+			Handler:    _CloudNetservice_GetIPPoolUtilization_Handler,
+		},
+		{
+			MethodName: "CompactIPPool",
+			Handler:    _CloudNetservice_CompactIPPool_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cloudnetservice.proto",
@@ -0,0 +1,104 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.,
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under,
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pool
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/api/protocol/cloudnetservice"
+	pbcommon "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/api/protocol/common"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-cloud-netservice/internal/compactor"
+)
+
+// CompactAction action for compact ip pool
+type CompactAction struct {
+	req  *pb.CompactIPPoolReq
+	resp *pb.CompactIPPoolResp
+
+	ctx context.Context
+
+	ipCompactor *compactor.IPCompactor
+
+	operations []*compactor.Operation
+}
+
+// NewCompactAction create compact action
+func NewCompactAction(ctx context.Context,
+	req *pb.CompactIPPoolReq, resp *pb.CompactIPPoolResp,
+	ipCompactor *compactor.IPCompactor) *CompactAction {
+
+	action := &CompactAction{
+		req:         req,
+		resp:        resp,
+		ctx:         ctx,
+		ipCompactor: ipCompactor,
+	}
+	action.resp.Seq = req.Seq
+	action.resp.DryRun = req.DryRun
+	return action
+}
+
+// Err set err info
+func (a *CompactAction) Err(errCode pbcommon.ErrCode, errMsg string) error {
+	a.resp.ErrCode = errCode
+	a.resp.ErrMsg = errMsg
+	return errors.New(errMsg)
+}
+
+// Input do something before Do function
+func (a *CompactAction) Input() error {
+	return nil
+}
+
+// Output do something after Do function
+func (a *CompactAction) Output() error {
+	for _, op := range a.operations {
+		pbOp := &pb.IPPoolCompactOperation{
+			Address:   op.IPObj.Address,
+			SubnetID:  op.IPObj.SubnetID,
+			Cluster:   op.IPObj.Cluster,
+			Type:      op.Type,
+			SrcEniID:  op.SrcEniID,
+			DestEniID: op.DestEniID,
+			Reason:    op.Reason,
+			Executed:  op.Executed,
+		}
+		if op.Err != nil {
+			pbOp.ErrMsg = op.Err.Error()
+		}
+		a.resp.Operations = append(a.resp.Operations, pbOp)
+	}
+	return nil
+}
+
+func (a *CompactAction) compact() (pbcommon.ErrCode, string) {
+	ops, err := a.ipCompactor.Compact(a.ctx, &compactor.Filter{
+		VpcID:    a.req.VpcID,
+		Region:   a.req.Region,
+		SubnetID: a.req.SubnetID,
+	}, a.req.DryRun)
+	if err != nil {
+		return pbcommon.ErrCode_ERROR_CLOUD_NETSERVICE_STOREOPS_FAILED, err.Error()
+	}
+	a.operations = ops
+	return pbcommon.ErrCode_ERROR_OK, ""
+}
+
+// Do do compact action
+func (a *CompactAction) Do() error {
+	if errCode, errMsg := a.compact(); errCode != pbcommon.ErrCode_ERROR_OK {
+		return a.Err(errCode, errMsg)
+	}
+	return nil
+}
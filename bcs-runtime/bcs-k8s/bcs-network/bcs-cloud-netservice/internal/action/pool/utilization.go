@@ -0,0 +1,161 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.,
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under,
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pool
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	pb "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/api/protocol/cloudnetservice"
+	pbcommon "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/api/protocol/common"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-cloud-netservice/internal/compactor"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-cloud-netservice/internal/types"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-cloud-netservice/internal/utils"
+)
+
+// UtilizationAction action for get ip pool utilization
+type UtilizationAction struct {
+	req  *pb.GetIPPoolUtilizationReq
+	resp *pb.GetIPPoolUtilizationResp
+
+	ctx context.Context
+
+	ipCompactor *compactor.IPCompactor
+
+	report *compactor.PoolReport
+}
+
+// NewUtilizationAction create utilization action
+func NewUtilizationAction(ctx context.Context,
+	req *pb.GetIPPoolUtilizationReq, resp *pb.GetIPPoolUtilizationResp,
+	ipCompactor *compactor.IPCompactor) *UtilizationAction {
+
+	action := &UtilizationAction{
+		req:         req,
+		resp:        resp,
+		ctx:         ctx,
+		ipCompactor: ipCompactor,
+	}
+	action.resp.Seq = req.Seq
+	return action
+}
+
+// Err set err info
+func (a *UtilizationAction) Err(errCode pbcommon.ErrCode, errMsg string) error {
+	a.resp.ErrCode = errCode
+	a.resp.ErrMsg = errMsg
+	return errors.New(errMsg)
+}
+
+// Input do something before Do function
+func (a *UtilizationAction) Input() error {
+	return nil
+}
+
+// Output do something after Do function
+func (a *UtilizationAction) Output() error {
+	for _, subnetReport := range a.report.Subnets {
+		a.resp.Subnets = append(a.resp.Subnets, &pb.SubnetUtilization{
+			VpcID:           subnetReport.Subnet.VpcID,
+			Region:          subnetReport.Subnet.Region,
+			Zone:            subnetReport.Subnet.Zone,
+			SubnetID:        subnetReport.Subnet.SubnetID,
+			SubnetCidr:      subnetReport.Subnet.SubnetCidr,
+			State:           subnetReport.Subnet.State,
+			TotalIPNum:      uint64(subnetReport.TotalIPNum),
+			FreeIPNum:       uint64(subnetReport.FreeIPNum),
+			ActiveIPNum:     uint64(subnetReport.ActiveIPNum),
+			IdleIPNum:       uint64(subnetReport.IdleIPNum),
+			FixedIPNum:      uint64(subnetReport.FixedIPNum),
+			EniPrimaryIPNum: uint64(subnetReport.EniPrimaryIPNum),
+			ReservedIPNum:   uint64(subnetReport.ReservedIPNum),
+			TransitIPNum:    uint64(subnetReport.TransitIPNum),
+			LeakedIPNum:     uint64(subnetReport.LeakedIPNum),
+			EniNum:          uint64(subnetReport.EniNum),
+			Usage:           subnetReport.Usage(),
+		})
+	}
+	for _, clusterReport := range a.report.Clusters {
+		a.resp.Clusters = append(a.resp.Clusters, &pb.ClusterUtilization{
+			Cluster:         clusterReport.Cluster,
+			QuotaLimit:      uint64(clusterReport.QuotaLimit),
+			QuotaUsed:       uint64(clusterReport.QuotaUsed()),
+			ActiveIPNum:     uint64(clusterReport.ActiveIPNum),
+			IdleIPNum:       uint64(clusterReport.IdleIPNum),
+			FixedIPNum:      uint64(clusterReport.FixedIPNum),
+			EniPrimaryIPNum: uint64(clusterReport.EniPrimaryIPNum),
+			LeakedIPNum:     uint64(clusterReport.LeakedIPNum),
+		})
+	}
+	for _, ipObj := range a.report.FixedIPs {
+		reservation := &pb.FixedIPReservation{
+			Address:      ipObj.Address,
+			SubnetID:     ipObj.SubnetID,
+			Cluster:      ipObj.Cluster,
+			Namespace:    ipObj.Namespace,
+			PodName:      ipObj.PodName,
+			WorkloadName: ipObj.WorkloadName,
+			WorkloadKind: ipObj.WorkloadKind,
+			EniID:        ipObj.EniID,
+			Status:       ipObj.Status,
+			KeepDuration: ipObj.KeepDuration,
+		}
+		// fixed ip is kept for keep duration after it is released by pod
+		if ipObj.Status == types.IPStatusAvailable {
+			if duration, err := time.ParseDuration(ipObj.KeepDuration); err == nil {
+				reservation.ExpireTime = utils.FormatTime(ipObj.UpdateTime.Add(duration))
+			}
+		}
+		a.resp.FixedIPs = append(a.resp.FixedIPs, reservation)
+	}
+	for _, leakedIP := range a.report.LeakedIPs {
+		a.resp.LeakedIPs = append(a.resp.LeakedIPs, &pb.LeakedIP{
+			Address:     leakedIP.IPObj.Address,
+			SubnetID:    leakedIP.IPObj.SubnetID,
+			Cluster:     leakedIP.IPObj.Cluster,
+			Namespace:   leakedIP.IPObj.Namespace,
+			PodName:     leakedIP.IPObj.PodName,
+			ContainerID: leakedIP.IPObj.ContainerID,
+			Host:        leakedIP.IPObj.Host,
+			EniID:       leakedIP.IPObj.EniID,
+			Status:      leakedIP.IPObj.Status,
+			IsFixed:     leakedIP.IPObj.IsFixed,
+			Reason:      leakedIP.Reason,
+			UpdateTime:  utils.FormatTime(leakedIP.IPObj.UpdateTime),
+		})
+	}
+	return nil
+}
+
+func (a *UtilizationAction) analyze() (pbcommon.ErrCode, string) {
+	report, err := a.ipCompactor.Analyze(a.ctx, &compactor.Filter{
+		VpcID:    a.req.VpcID,
+		Region:   a.req.Region,
+		SubnetID: a.req.SubnetID,
+		Cluster:  a.req.Cluster,
+	})
+	if err != nil {
+		return pbcommon.ErrCode_ERROR_CLOUD_NETSERVICE_STOREOPS_FAILED, err.Error()
+	}
+	a.report = report
+	return pbcommon.ErrCode_ERROR_OK, ""
+}
+
+// Do do utilization action
+func (a *UtilizationAction) Do() error {
+	if errCode, errMsg := a.analyze(); errCode != pbcommon.ErrCode_ERROR_OK {
+		return a.Err(errCode, errMsg)
+	}
+	return nil
+}
@@ -27,11 +27,13 @@ import (
 
 	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
 	"github.com/Tencent/bk-bcs/bcs-common/common/ssl"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/bcsapi"
 	pbcloudnetservice "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/api/protocol/cloudnetservice"
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-cloud-netservice/internal/cleaner"
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-cloud-netservice/internal/cloud"
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-cloud-netservice/internal/cloud/aws"
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-cloud-netservice/internal/cloud/tencentcloud"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-cloud-netservice/internal/compactor"
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-cloud-netservice/internal/metric"
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-cloud-netservice/internal/option"
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-cloud-netservice/internal/store"
@@ -78,6 +80,12 @@ type CloudNetservice struct {
 	// ip cleaner
 	ipCleaner *cleaner.IPCleaner
 
+	// ip pool compactor
+	ipCompactor *compactor.IPCompactor
+
+	// pod lister for checking pods of leaked ips
+	podLister compactor.PodLister
+
 	// elector for leader election
 	elector *leaderelection.Client
 
@@ -225,6 +233,42 @@ func (cn *CloudNetservice) initIPCleaner() {
 	go cn.ipCleaner.Run(context.TODO())
 }
 
+func (cn *CloudNetservice) initPodLister() error {
+	if len(cn.cfg.StorageHosts) == 0 {
+		blog.Warnf("bcs-storage is not configured, ips of pods are never treated as leaked")
+		return nil
+	}
+	storageConf := &bcsapi.Config{
+		Hosts:     strings.Split(cn.cfg.StorageHosts, ","),
+		AuthToken: cn.cfg.StorageToken,
+		Gateway:   cn.cfg.StorageGateway,
+	}
+	if len(cn.cfg.StorageCAFile) != 0 {
+		tlsConf, err := ssl.ClientTslConfVerityServer(cn.cfg.StorageCAFile)
+		if err != nil {
+			return fmt.Errorf("load ca file %s for bcs-storage failed, err %s", cn.cfg.StorageCAFile, err.Error())
+		}
+		storageConf.TLSConfig = tlsConf
+	}
+	cn.podLister = compactor.NewStoragePodLister(bcsapi.NewStorage(storageConf))
+	return nil
+}
+
+func (cn *CloudNetservice) initIPCompactor() {
+	blog.Infof("init ip compactor")
+	cn.ipCompactor = compactor.NewIPCompactor(
+		time.Duration(cn.cfg.IPCompactIntervalMinute)*time.Minute,
+		time.Duration(cn.cfg.IPLeakGraceMinute)*time.Minute,
+		cn.cfg.IPCompactDryRun,
+		cn.storeIf, cn.cloudIf, cn.podLister, cn.locker, cn.elector)
+	// periodic compaction is disabled when interval is 0, compaction can still be triggered by api
+	if cn.cfg.IPCompactIntervalMinute <= 0 {
+		blog.Infof("periodic ip compaction is disabled")
+		return
+	}
+	go cn.ipCompactor.Run(context.TODO())
+}
+
 func (cn *CloudNetservice) initModules() {
 
 	if err := cn.initStore(); err != nil {
@@ -240,7 +284,12 @@ func (cn *CloudNetservice) initModules() {
 		blog.Fatalf("initLeaderElection failed, err %s", err.Error())
 	}
 
+	if err := cn.initPodLister(); err != nil {
+		blog.Fatalf("initPodLister failed, err %s", err.Error())
+	}
+
 	cn.initIPCleaner()
+	cn.initIPCompactor()
 
 	cn.mux = http.NewServeMux()
 
@@ -22,6 +22,7 @@ import (
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-cloud-netservice/internal/action"
 	eniAction "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-cloud-netservice/internal/action/eni"
 	ipAction "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-cloud-netservice/internal/action/ip"
+	poolAction "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-cloud-netservice/internal/action/pool"
 	quotaAction "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-cloud-netservice/internal/action/quota"
 	subnetAction "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-cloud-netservice/internal/action/subnet"
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-cloud-netservice/internal/metric"
@@ -334,3 +335,39 @@ func (cn *CloudNetservice) ListQuota(ctx context.Context, req *pb.ListIPQuotaReq
 
 	return response, nil
 }
+
+// GetIPPoolUtilization get ip pool utilization
+func (cn *CloudNetservice) GetIPPoolUtilization(ctx context.Context, req *pb.GetIPPoolUtilizationReq) (
+	*pb.GetIPPoolUtilizationResp, error) {
+	rtime := time.Now()
+	blog.V(3).Infof("GetIPPoolUtilization seq[%d] input[%+v]", req.Seq, req)
+	response := &pb.GetIPPoolUtilizationResp{Seq: req.Seq, ErrCode: pbcommon.ErrCode_ERROR_OK, ErrMsg: "OK"}
+
+	defer func() {
+		cost := metric.DefaultCollector.StatRequest("GetIPPoolUtilization", response.ErrCode, rtime, time.Now())
+		blog.V(3).Infof("GetIPPoolUtilization seq[%d]| output[%dms][%+v]", req.Seq, cost, response)
+	}()
+
+	utilizationAction := poolAction.NewUtilizationAction(ctx, req, response, cn.ipCompactor)
+	action.NewExecutor().Execute(utilizationAction)
+
+	return response, nil
+}
+
+// CompactIPPool free leaked ips and rebalance idle ips of ip pool
+func (cn *CloudNetservice) CompactIPPool(ctx context.Context, req *pb.CompactIPPoolReq) (
+	*pb.CompactIPPoolResp, error) {
+	rtime := time.Now()
+	blog.V(3).Infof("CompactIPPool seq[%d] input[%+v]", req.Seq, req)
+	response := &pb.CompactIPPoolResp{Seq: req.Seq, ErrCode: pbcommon.ErrCode_ERROR_OK, ErrMsg: "OK"}
+
+	defer func() {
+		cost := metric.DefaultCollector.StatRequest("CompactIPPool", response.ErrCode, rtime, time.Now())
+		blog.V(3).Infof("CompactIPPool seq[%d]| output[%dms][%+v]", req.Seq, cost, response)
+	}()
+
+	compactAction := poolAction.NewCompactAction(ctx, req, response, cn.ipCompactor)
+	action.NewExecutor().Execute(compactAction)
+
+	return response, nil
+}
@@ -0,0 +1,418 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.,
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under,
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package compactor
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-cloud-netservice/internal/types"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/internal/constant"
+)
+
+const (
+	// OperationTypeFree free leaked ip
+	OperationTypeFree = "free"
+	// OperationTypeRelease release redundant idle ip from eni back to subnet
+	OperationTypeRelease = "release"
+	// OperationTypeMigrate migrate idle ip to another eni of the same cluster
+	OperationTypeMigrate = "migrate"
+)
+
+// Filter filter for ip pool analysis and compaction
+type Filter struct {
+	VpcID    string
+	Region   string
+	SubnetID string
+	// Cluster only filters cluster, fixed ip and leaked ip results
+	Cluster string
+}
+
+// SubnetReport ip utilization of subnet
+type SubnetReport struct {
+	Subnet          *types.CloudSubnet
+	TotalIPNum      int
+	FreeIPNum       int
+	ActiveIPNum     int
+	IdleIPNum       int
+	FixedIPNum      int
+	EniPrimaryIPNum int
+	ReservedIPNum   int
+	TransitIPNum    int
+	LeakedIPNum     int
+	EniNum          int
+	// Verified is false when enis of subnet cannot be queried from cloud, leaked ips are not detected then
+	Verified bool
+}
+
+// Usage ratio of ips which are not free
+func (r *SubnetReport) Usage() float64 {
+	if r.TotalIPNum == 0 {
+		return 0
+	}
+	return float64(r.TotalIPNum-r.FreeIPNum) / float64(r.TotalIPNum)
+}
+
+// ClusterReport ip utilization of cluster
+type ClusterReport struct {
+	Cluster         string
+	QuotaLimit      int64
+	ActiveIPNum     int
+	IdleIPNum       int
+	FixedIPNum      int
+	EniPrimaryIPNum int
+	LeakedIPNum     int
+}
+
+// QuotaUsed ip number counted by quota, same as CheckIPQuota
+func (r *ClusterReport) QuotaUsed() int {
+	return r.ActiveIPNum + r.FixedIPNum + r.EniPrimaryIPNum
+}
+
+// LeakedIP ip which is still occupied in store while its pod no longer exists
+type LeakedIP struct {
+	IPObj  *types.IPObject
+	Reason string
+}
+
+// PoolReport report of ip pool
+type PoolReport struct {
+	Subnets   []*SubnetReport
+	Clusters  []*ClusterReport
+	FixedIPs  []*types.IPObject
+	LeakedIPs []*LeakedIP
+}
+
+// Operation compaction operation for one ip
+type Operation struct {
+	Type      string
+	IPObj     *types.IPObject
+	SrcEniID  string
+	DestEniID string
+	Reason    string
+	Executed  bool
+	Err       error
+
+	// destHost host of dest eni for migrate operation
+	destHost string
+	// unassignEniID cloud eni id which the leaked ip is still assigned to
+	unassignEniID string
+}
+
+// eniIndex index cloud enis of subnet by both eni id and eni name, eni primary ip objects record eni name
+// while other ip objects record eni id
+type eniIndex struct {
+	enis map[string]*types.EniObject
+	ips  map[string]map[string]struct{}
+}
+
+func newEniIndex(enis []*types.EniObject) *eniIndex {
+	index := &eniIndex{
+		enis: make(map[string]*types.EniObject),
+		ips:  make(map[string]map[string]struct{}),
+	}
+	for _, eni := range enis {
+		ipSet := make(map[string]struct{})
+		for _, ip := range eni.IPs {
+			ipSet[ip.IP] = struct{}{}
+		}
+		index.ips[eni.EniID] = ipSet
+		index.enis[eni.EniID] = eni
+		if len(eni.EniName) != 0 {
+			index.enis[eni.EniName] = eni
+		}
+	}
+	return index
+}
+
+// get eni by eni id or eni name
+func (e *eniIndex) get(key string) *types.EniObject {
+	return e.enis[key]
+}
+
+// hasIP check whether ip is assigned to eni in cloud
+func (e *eniIndex) hasIP(eni *types.EniObject, ip string) bool {
+	_, ok := e.ips[eni.EniID][ip]
+	return ok
+}
+
+// subnetState snapshot of ip objects and cloud enis of one subnet
+type subnetState struct {
+	subnet *types.CloudSubnet
+	ipObjs []*types.IPObject
+	// enis is nil when enis cannot be queried from cloud
+	enis   *eniIndex
+	leaked map[string]*LeakedIP
+	report *SubnetReport
+}
+
+// analyzeSubnet count ips of subnet by status and detect leaked ips. An ip which has not been changed for
+// leakGrace is leaked when its eni no longer exists or no longer holds it in cloud, which means the pod and
+// its node are gone without releasing the ip, or when it is stuck in applying or deleting status. An ip
+// allocated for pod is only leaked when the pod is confirmed to be gone from its cluster.
+func analyzeSubnet(subnet *types.CloudSubnet, ipObjs []*types.IPObject, enis []*types.EniObject,
+	verified bool, pods *podIndex, now time.Time, leakGrace time.Duration) *subnetState {
+	state := &subnetState{
+		subnet: subnet,
+		ipObjs: ipObjs,
+		leaked: make(map[string]*LeakedIP),
+		report: &SubnetReport{
+			Subnet:     subnet,
+			TotalIPNum: len(ipObjs),
+			EniNum:     len(enis),
+			Verified:   verified,
+		},
+	}
+	// no eni is found for a subnet, it is much more likely that the cloud api returns unexpected result than
+	// all the nodes are gone, do not detect leaked ips in this case
+	if verified && len(enis) != 0 {
+		state.enis = newEniIndex(enis)
+	} else {
+		state.report.Verified = false
+	}
+	for _, ipObj := range ipObjs {
+		switch ipObj.Status {
+		case types.IPStatusFree:
+			state.report.FreeIPNum++
+		case types.IPStatusActive:
+			state.report.ActiveIPNum++
+		case types.IPStatusAvailable:
+			if !ipObj.IsFixed {
+				state.report.IdleIPNum++
+			}
+		case types.IPStatusENIPrimary:
+			state.report.EniPrimaryIPNum++
+		case types.IPStatusReserved:
+			state.report.ReservedIPNum++
+		case types.IPStatusApplying, types.IPStatusDeleting:
+			state.report.TransitIPNum++
+		}
+		if ipObj.IsFixed {
+			state.report.FixedIPNum++
+		}
+		if state.enis == nil || now.Sub(ipObj.UpdateTime) <= leakGrace {
+			continue
+		}
+		if reason := state.leakReason(ipObj, pods); len(reason) != 0 {
+			state.leaked[ipObj.Address] = &LeakedIP{IPObj: ipObj, Reason: reason}
+		}
+	}
+	state.report.LeakedIPNum = len(state.leaked)
+	return state
+}
+
+// leakReason returns reason when ip object is leaked, otherwise returns empty string
+func (s *subnetState) leakReason(ipObj *types.IPObject, pods *podIndex) string {
+	reason := s.eniLeakReason(ipObj)
+	if len(reason) == 0 || len(ipObj.PodName) == 0 {
+		return reason
+	}
+	exists, known := pods.podExists(ipObj)
+	if !known || exists {
+		return ""
+	}
+	return fmt.Sprintf("%s, pod %s/%s is not found in cluster %s", reason, ipObj.Namespace, ipObj.PodName,
+		ipObj.Cluster)
+}
+
+// eniLeakReason returns reason when ip object is not held by eni in cloud or stuck in transit status
+func (s *subnetState) eniLeakReason(ipObj *types.IPObject) string {
+	switch ipObj.Status {
+	case types.IPStatusActive, types.IPStatusAvailable:
+		if len(ipObj.EniID) == 0 {
+			return ""
+		}
+		eni := s.enis.get(ipObj.EniID)
+		if eni == nil {
+			return fmt.Sprintf("eni %s of %s ip is not found in cloud", ipObj.EniID, ipObj.Status)
+		}
+		if !s.enis.hasIP(eni, ipObj.Address) {
+			return fmt.Sprintf("%s ip is not assigned to eni %s in cloud", ipObj.Status, ipObj.EniID)
+		}
+	case types.IPStatusENIPrimary:
+		if s.enis.get(ipObj.EniID) == nil {
+			return fmt.Sprintf("eni %s of eni primary ip is not found in cloud", ipObj.EniID)
+		}
+	case types.IPStatusApplying, types.IPStatusDeleting:
+		return fmt.Sprintf("ip is stuck in %s status since %s", ipObj.Status, ipObj.UpdateTime.Format(time.RFC3339))
+	}
+	return ""
+}
+
+// planFree generate operations to free leaked ips
+func (s *subnetState) planFree() []*Operation {
+	var ops []*Operation
+	for _, leakedIP := range s.sortedLeakedIPs() {
+		op := &Operation{
+			Type:     OperationTypeFree,
+			IPObj:    leakedIP.IPObj,
+			SrcEniID: leakedIP.IPObj.EniID,
+			Reason:   leakedIP.Reason,
+		}
+		// ip stuck in transit status may have been assigned to eni already
+		if leakedIP.IPObj.Status == types.IPStatusApplying || leakedIP.IPObj.Status == types.IPStatusDeleting {
+			if eni := s.enis.get(leakedIP.IPObj.EniID); eni != nil && s.enis.hasIP(eni, leakedIP.IPObj.Address) {
+				op.unassignEniID = eni.EniID
+			}
+		}
+		ops = append(ops, op)
+	}
+	return ops
+}
+
+// planRebalance generate operations to rebalance idle ips between enis. Each eni keeps at most minIPNumPerEni
+// idle ips of subnet, redundant idle ips are migrated to enis of the same cluster which have fewer idle ips,
+// and the rest are released back to subnet.
+func (s *subnetState) planRebalance() []*Operation {
+	target := int(s.subnet.MinIPNumPerEni)
+	if target <= 0 {
+		target = constant.DefaultMinIPNumPerEni
+	}
+	idleIPs := make(map[string][]*types.IPObject)
+	eniCluster := make(map[string]string)
+	eniHost := make(map[string]string)
+	for _, ipObj := range s.ipObjs {
+		if _, ok := s.leaked[ipObj.Address]; ok {
+			continue
+		}
+		if ipObj.Status != types.IPStatusActive && ipObj.Status != types.IPStatusAvailable {
+			continue
+		}
+		eni := s.enis.get(ipObj.EniID)
+		if eni == nil || !s.enis.hasIP(eni, ipObj.Address) {
+			continue
+		}
+		if len(ipObj.Cluster) != 0 && len(ipObj.Host) != 0 {
+			eniCluster[eni.EniID] = ipObj.Cluster
+			eniHost[eni.EniID] = ipObj.Host
+		}
+		if ipObj.Status == types.IPStatusAvailable && !ipObj.IsFixed {
+			idleIPs[eni.EniID] = append(idleIPs[eni.EniID], ipObj)
+		}
+	}
+
+	var eniIDs []string
+	for eniID := range eniCluster {
+		eniIDs = append(eniIDs, eniID)
+	}
+	sort.Strings(eniIDs)
+	deficits := make(map[string]int)
+	for _, eniID := range eniIDs {
+		if len(idleIPs[eniID]) < target {
+			deficits[eniID] = target - len(idleIPs[eniID])
+		}
+	}
+
+	var donors []string
+	for eniID, ips := range idleIPs {
+		if len(ips) > target {
+			donors = append(donors, eniID)
+		}
+	}
+	sort.Strings(donors)
+	var ops []*Operation
+	for _, donor := range donors {
+		ips := idleIPs[donor]
+		sort.Slice(ips, func(i, j int) bool {
+			return ips[i].Address < ips[j].Address
+		})
+		for _, ipObj := range ips[target:] {
+			op := &Operation{
+				Type:     OperationTypeRelease,
+				IPObj:    ipObj,
+				SrcEniID: donor,
+				Reason:   fmt.Sprintf("eni %s holds %d idle ips, more than %d", donor, len(ips), target),
+			}
+			for _, eniID := range eniIDs {
+				if eniID == donor || deficits[eniID] == 0 || eniCluster[eniID] != ipObj.Cluster {
+					continue
+				}
+				op.Type = OperationTypeMigrate
+				op.DestEniID = eniID
+				op.destHost = eniHost[eniID]
+				op.Reason = fmt.Sprintf("%s, eni %s holds %d idle ips, less than %d", op.Reason, eniID,
+					target-deficits[eniID], target)
+				deficits[eniID]--
+				break
+			}
+			ops = append(ops, op)
+		}
+	}
+	return ops
+}
+
+func (s *subnetState) sortedLeakedIPs() []*LeakedIP {
+	var leakedIPs []*LeakedIP
+	for _, leakedIP := range s.leaked {
+		leakedIPs = append(leakedIPs, leakedIP)
+	}
+	sort.Slice(leakedIPs, func(i, j int) bool {
+		return leakedIPs[i].IPObj.Address < leakedIPs[j].IPObj.Address
+	})
+	return leakedIPs
+}
+
+// buildPoolReport aggregate subnet states into pool report
+func buildPoolReport(states []*subnetState, quotas []*types.IPQuota, cluster string) *PoolReport {
+	report := &PoolReport{}
+	clusterReports := make(map[string]*ClusterReport)
+	getClusterReport := func(name string) *ClusterReport {
+		if _, ok := clusterReports[name]; !ok {
+			clusterReports[name] = &ClusterReport{Cluster: name}
+		}
+		return clusterReports[name]
+	}
+	for _, quota := range quotas {
+		if len(cluster) != 0 && quota.Cluster != cluster {
+			continue
+		}
+		getClusterReport(quota.Cluster).QuotaLimit = quota.Limit
+	}
+	for _, state := range states {
+		report.Subnets = append(report.Subnets, state.report)
+		for _, ipObj := range state.ipObjs {
+			if len(ipObj.Cluster) == 0 || (len(cluster) != 0 && ipObj.Cluster != cluster) {
+				continue
+			}
+			clusterReport := getClusterReport(ipObj.Cluster)
+			switch {
+			case ipObj.IsFixed:
+				clusterReport.FixedIPNum++
+				report.FixedIPs = append(report.FixedIPs, ipObj)
+			case ipObj.Status == types.IPStatusActive:
+				clusterReport.ActiveIPNum++
+			case ipObj.Status == types.IPStatusAvailable:
+				clusterReport.IdleIPNum++
+			case ipObj.Status == types.IPStatusENIPrimary:
+				clusterReport.EniPrimaryIPNum++
+			}
+			if _, ok := state.leaked[ipObj.Address]; ok {
+				clusterReport.LeakedIPNum++
+			}
+		}
+		for _, leakedIP := range state.sortedLeakedIPs() {
+			if len(cluster) != 0 && leakedIP.IPObj.Cluster != cluster {
+				continue
+			}
+			report.LeakedIPs = append(report.LeakedIPs, leakedIP)
+		}
+	}
+	for _, clusterReport := range clusterReports {
+		report.Clusters = append(report.Clusters, clusterReport)
+	}
+	sort.Slice(report.Clusters, func(i, j int) bool {
+		return report.Clusters[i].Cluster < report.Clusters[j].Cluster
+	})
+	return report
+}
@@ -0,0 +1,271 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.,
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under,
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package compactor
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-cloud-netservice/internal/types"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newEni(eniID, eniName string, ips ...string) *types.EniObject {
+	eni := &types.EniObject{EniID: eniID, EniName: eniName}
+	for index, ip := range ips {
+		eni.IPs = append(eni.IPs, &types.EniIPAddr{IP: ip, IsPrimary: index == 0})
+	}
+	return eni
+}
+
+func newIPObj(address, status, eniID, cluster, host string, updateTime time.Time) *types.IPObject {
+	return &types.IPObject{
+		Address:    address,
+		SubnetID:   "subnet-1",
+		Status:     status,
+		EniID:      eniID,
+		Cluster:    cluster,
+		Host:       host,
+		UpdateTime: updateTime,
+	}
+}
+
+// TestAnalyzeSubnet test ip counting and leaked ip detection
+func TestAnalyzeSubnet(t *testing.T) {
+	now := time.Now()
+	old := now.Add(-time.Hour)
+	subnet := &types.CloudSubnet{SubnetID: "subnet-1"}
+	enis := []*types.EniObject{
+		newEni("eni-1", "ins-1-0", "10.0.0.1", "10.0.0.2", "10.0.0.3", "10.0.0.6"),
+	}
+	fixedIP := newIPObj("10.0.0.9", types.IPStatusActive, "eni-2", "cluster-1", "ins-2", old)
+	fixedIP.IsFixed = true
+	ipObjs := []*types.IPObject{
+		newIPObj("10.0.0.1", types.IPStatusENIPrimary, "ins-1-0", "cluster-1", "ins-1", old),
+		newIPObj("10.0.0.2", types.IPStatusActive, "eni-1", "cluster-1", "ins-1", old),
+		newIPObj("10.0.0.3", types.IPStatusAvailable, "eni-1", "cluster-1", "ins-1", old),
+		// ip is not assigned to eni-1 in cloud
+		newIPObj("10.0.0.4", types.IPStatusActive, "eni-1", "cluster-1", "ins-1", old),
+		// changed recently, not leaked
+		newIPObj("10.0.0.5", types.IPStatusActive, "eni-1", "cluster-1", "ins-1", now),
+		newIPObj("10.0.0.6", types.IPStatusApplying, "eni-1", "cluster-1", "ins-1", old),
+		newIPObj("10.0.0.7", types.IPStatusFree, "", "", "", old),
+		newIPObj("10.0.0.8", types.IPStatusReserved, "", "", "", old),
+		// eni of fixed ip is gone
+		fixedIP,
+		// eni primary ip of eni which is gone
+		newIPObj("10.0.0.10", types.IPStatusENIPrimary, "ins-2-0", "cluster-1", "ins-2", old),
+	}
+	state := analyzeSubnet(subnet, ipObjs, enis, true, nil, now, 30*time.Minute)
+	report := state.report
+	if report.TotalIPNum != 10 || report.FreeIPNum != 1 || report.ActiveIPNum != 4 || report.IdleIPNum != 1 ||
+		report.FixedIPNum != 1 || report.EniPrimaryIPNum != 2 || report.ReservedIPNum != 1 ||
+		report.TransitIPNum != 1 || report.EniNum != 1 || !report.Verified {
+		t.Errorf("unexpected subnet report %+v", report)
+	}
+	if usage := report.Usage(); usage != 0.9 {
+		t.Errorf("expect usage 0.9, got %f", usage)
+	}
+	expectLeaked := []string{"10.0.0.10", "10.0.0.4", "10.0.0.6", "10.0.0.9"}
+	leakedIPs := state.sortedLeakedIPs()
+	if len(leakedIPs) != len(expectLeaked) {
+		t.Fatalf("expect leaked ips %v, got %d leaked ips", expectLeaked, len(leakedIPs))
+	}
+	for index, leakedIP := range leakedIPs {
+		if leakedIP.IPObj.Address != expectLeaked[index] {
+			t.Errorf("expect leaked ip %s, got %s", expectLeaked[index], leakedIP.IPObj.Address)
+		}
+	}
+
+	// applying ip is still assigned to eni, it should be unassigned when it is freed
+	ops := state.planFree()
+	for _, op := range ops {
+		if op.Type != OperationTypeFree {
+			t.Errorf("expect free operation, got %s", op.Type)
+		}
+		if op.IPObj.Address == "10.0.0.6" && op.unassignEniID != "eni-1" {
+			t.Errorf("expect applying ip to be unassigned from eni-1, got %s", op.unassignEniID)
+		}
+	}
+}
+
+// TestAnalyzeSubnetUnverified test leaked ips are not detected when enis are unknown
+func TestAnalyzeSubnetUnverified(t *testing.T) {
+	now := time.Now()
+	ipObjs := []*types.IPObject{
+		newIPObj("10.0.0.2", types.IPStatusActive, "eni-1", "cluster-1", "ins-1", now.Add(-time.Hour)),
+	}
+	for _, enis := range [][]*types.EniObject{nil, {newEni("eni-1", "ins-1-0", "10.0.0.1")}} {
+		state := analyzeSubnet(&types.CloudSubnet{SubnetID: "subnet-1"}, ipObjs, enis, len(enis) == 0,
+			nil, now, time.Minute)
+		if state.report.Verified || len(state.leaked) != 0 || state.enis != nil {
+			t.Errorf("expect unverified subnet without leaked ips, got %+v", state.report)
+		}
+	}
+}
+
+// fakePodLister pods by cluster, listing pods of cluster not in map fails
+type fakePodLister map[string][]*corev1.Pod
+
+func (f fakePodLister) ListPods(cluster string) ([]*corev1.Pod, error) {
+	pods, ok := f[cluster]
+	if !ok {
+		return nil, fmt.Errorf("cluster %s not found", cluster)
+	}
+	return pods, nil
+}
+
+func newPod(namespace, name, podIP string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Status:     corev1.PodStatus{PodIP: podIP},
+	}
+}
+
+// TestAnalyzeSubnetPods test ips of pods are leaked only when pods are confirmed to be gone
+func TestAnalyzeSubnetPods(t *testing.T) {
+	now := time.Now()
+	old := now.Add(-time.Hour)
+	enis := []*types.EniObject{newEni("eni-1", "ins-1-0", "10.0.0.1")}
+	newPodIPObj := func(address, cluster, podName string) *types.IPObject {
+		ipObj := newIPObj(address, types.IPStatusActive, "eni-1", cluster, "ins-1", old)
+		ipObj.Namespace = "default"
+		ipObj.PodName = podName
+		return ipObj
+	}
+	ipObjs := []*types.IPObject{
+		// pod still exists, eni of cloud may be inconsistent
+		newPodIPObj("10.0.0.2", "cluster-1", "pod-2"),
+		// pod is gone
+		newPodIPObj("10.0.0.3", "cluster-1", "pod-3"),
+		// pod with the same name is recreated with another ip
+		newPodIPObj("10.0.0.4", "cluster-1", "pod-4"),
+		// pod is recreated and not scheduled yet
+		newPodIPObj("10.0.0.5", "cluster-1", "pod-5"),
+		// pods of cluster cannot be listed
+		newPodIPObj("10.0.0.6", "cluster-2", "pod-6"),
+		// no pod of cluster is found
+		newPodIPObj("10.0.0.7", "cluster-3", "pod-7"),
+		// ip without pod is checked by eni only
+		newIPObj("10.0.0.8", types.IPStatusENIPrimary, "ins-2-0", "cluster-2", "ins-2", old),
+	}
+	lister := fakePodLister{
+		"cluster-1": {
+			newPod("default", "pod-2", "10.0.0.2"),
+			newPod("default", "pod-4", "10.0.0.40"),
+			newPod("default", "pod-5", ""),
+		},
+		"cluster-3": {},
+	}
+	state := analyzeSubnet(&types.CloudSubnet{SubnetID: "subnet-1"}, ipObjs, enis, true, newPodIndex(lister),
+		now, 30*time.Minute)
+	expectLeaked := []string{"10.0.0.3", "10.0.0.4", "10.0.0.8"}
+	leakedIPs := state.sortedLeakedIPs()
+	if len(leakedIPs) != len(expectLeaked) {
+		t.Fatalf("expect leaked ips %v, got %d leaked ips", expectLeaked, len(leakedIPs))
+	}
+	for index, leakedIP := range leakedIPs {
+		if leakedIP.IPObj.Address != expectLeaked[index] {
+			t.Errorf("expect leaked ip %s, got %s", expectLeaked[index], leakedIP.IPObj.Address)
+		}
+	}
+
+	// pods are unknown without pod lister
+	state = analyzeSubnet(&types.CloudSubnet{SubnetID: "subnet-1"}, ipObjs, enis, true, newPodIndex(nil),
+		now, 30*time.Minute)
+	if len(state.leaked) != 1 || state.leaked["10.0.0.8"] == nil {
+		t.Errorf("expect only eni primary ip leaked without pod lister, got %d leaked ips", len(state.leaked))
+	}
+}
+
+// TestPlanRebalance test redundant idle ips are migrated to enis of the same cluster or released
+func TestPlanRebalance(t *testing.T) {
+	now := time.Now()
+	subnet := &types.CloudSubnet{SubnetID: "subnet-1", MinIPNumPerEni: 2}
+	enis := []*types.EniObject{
+		newEni("eni-1", "ins-1-0", "10.0.0.1", "10.0.1.1", "10.0.1.2", "10.0.1.3", "10.0.1.4", "10.0.1.5"),
+		newEni("eni-2", "ins-2-0", "10.0.0.2", "10.0.2.1"),
+		newEni("eni-3", "ins-3-0", "10.0.0.3", "10.0.3.1"),
+	}
+	var ipObjs []*types.IPObject
+	for _, ip := range []string{"10.0.1.1", "10.0.1.2", "10.0.1.3", "10.0.1.4", "10.0.1.5"} {
+		ipObjs = append(ipObjs, newIPObj(ip, types.IPStatusAvailable, "eni-1", "cluster-1", "ins-1", now))
+	}
+	ipObjs = append(ipObjs,
+		newIPObj("10.0.2.1", types.IPStatusActive, "eni-2", "cluster-1", "ins-2", now),
+		// eni of another cluster never receives ips of cluster-1
+		newIPObj("10.0.3.1", types.IPStatusActive, "eni-3", "cluster-2", "ins-3", now),
+	)
+	state := analyzeSubnet(subnet, ipObjs, enis, true, nil, now, time.Minute)
+	ops := state.planRebalance()
+	expectOps := []struct {
+		address   string
+		opType    string
+		destEniID string
+	}{
+		{"10.0.1.3", OperationTypeMigrate, "eni-2"},
+		{"10.0.1.4", OperationTypeMigrate, "eni-2"},
+		{"10.0.1.5", OperationTypeRelease, ""},
+	}
+	if len(ops) != len(expectOps) {
+		t.Fatalf("expect %d operations, got %d", len(expectOps), len(ops))
+	}
+	for index, op := range ops {
+		expect := expectOps[index]
+		if op.IPObj.Address != expect.address || op.Type != expect.opType || op.DestEniID != expect.destEniID ||
+			op.SrcEniID != "eni-1" {
+			t.Errorf("expect operation %+v, got %s %s %s->%s", expect, op.Type, op.IPObj.Address,
+				op.SrcEniID, op.DestEniID)
+		}
+		if op.Type == OperationTypeMigrate && op.destHost != "ins-2" {
+			t.Errorf("expect dest host ins-2, got %s", op.destHost)
+		}
+	}
+}
+
+// TestBuildPoolReport test cluster utilization and cluster filter
+func TestBuildPoolReport(t *testing.T) {
+	now := time.Now()
+	fixedIP := newIPObj("10.0.0.4", types.IPStatusAvailable, "", "cluster-1", "", now)
+	fixedIP.IsFixed = true
+	ipObjs := []*types.IPObject{
+		newIPObj("10.0.0.1", types.IPStatusENIPrimary, "ins-1-0", "cluster-1", "ins-1", now),
+		newIPObj("10.0.0.2", types.IPStatusActive, "eni-1", "cluster-1", "ins-1", now),
+		newIPObj("10.0.0.3", types.IPStatusAvailable, "eni-1", "cluster-1", "ins-1", now),
+		fixedIP,
+		newIPObj("10.0.0.5", types.IPStatusActive, "eni-2", "cluster-2", "ins-2", now),
+	}
+	state := analyzeSubnet(&types.CloudSubnet{SubnetID: "subnet-1"}, ipObjs, nil, false, nil, now, time.Minute)
+	quotas := []*types.IPQuota{{Cluster: "cluster-1", Limit: 10}, {Cluster: "cluster-3", Limit: 5}}
+
+	report := buildPoolReport([]*subnetState{state}, quotas, "")
+	if len(report.Clusters) != 3 || len(report.FixedIPs) != 1 || len(report.Subnets) != 1 {
+		t.Fatalf("unexpected pool report %+v", report)
+	}
+	cluster1 := report.Clusters[0]
+	if cluster1.Cluster != "cluster-1" || cluster1.QuotaLimit != 10 || cluster1.QuotaUsed() != 3 ||
+		cluster1.IdleIPNum != 1 || cluster1.FixedIPNum != 1 {
+		t.Errorf("unexpected cluster report %+v", cluster1)
+	}
+	if report.Clusters[2].Cluster != "cluster-3" || report.Clusters[2].QuotaUsed() != 0 {
+		t.Errorf("unexpected cluster report %+v", report.Clusters[2])
+	}
+
+	report = buildPoolReport([]*subnetState{state}, quotas, "cluster-2")
+	if len(report.Clusters) != 1 || report.Clusters[0].ActiveIPNum != 1 || len(report.FixedIPs) != 0 {
+		t.Errorf("unexpected filtered pool report %+v", report)
+	}
+}
@@ -0,0 +1,355 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.,
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under,
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package compactor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-cloud-netservice/internal/cloud"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-cloud-netservice/internal/metric"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-cloud-netservice/internal/store"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-cloud-netservice/internal/store/kube"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-cloud-netservice/internal/types"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/pkg/leaderelection"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/pkg/lock"
+)
+
+const (
+	// lockTTL ttl for subnet lock during compaction
+	lockTTL = 60 * time.Second
+
+	// operation results for metric
+	operationResultSuccess = "success"
+	operationResultFailed  = "failed"
+	operationResultDryRun  = "dryrun"
+)
+
+// IPCompactor ip pool compactor, it reports ip utilization of subnets and clusters, frees leaked ips
+// and rebalances idle ips between enis
+type IPCompactor struct {
+	// client for store ip object and subnet
+	storeIf store.Interface
+
+	// cloud interface for operate eni ip
+	cloudIf cloud.Interface
+
+	// podLister list pods of cluster, ips of pods are never treated as leaked when it is nil
+	podLister PodLister
+
+	// locker for subnet, avoid conflict with eni ip allocation
+	locker lock.DistributedLock
+
+	// elector elector for leader election
+	elector *leaderelection.Client
+
+	// interval interval for periodic compaction
+	interval time.Duration
+
+	// leakGrace ip object which has not been changed for leakGrace can be treated as leaked
+	leakGrace time.Duration
+
+	// dryRun periodic compaction only reports operations without executing them
+	dryRun bool
+}
+
+// NewIPCompactor create ip compactor
+func NewIPCompactor(interval time.Duration,
+	leakGrace time.Duration,
+	dryRun bool,
+	storeIf store.Interface,
+	cloudIf cloud.Interface,
+	podLister PodLister,
+	locker lock.DistributedLock,
+	elector *leaderelection.Client) *IPCompactor {
+	return &IPCompactor{
+		storeIf:   storeIf,
+		cloudIf:   cloudIf,
+		podLister: podLister,
+		locker:    locker,
+		elector:   elector,
+		interval:  interval,
+		leakGrace: leakGrace,
+		dryRun:    dryRun,
+	}
+}
+
+// Run run compactor
+func (c *IPCompactor) Run(ctx context.Context) error {
+	blog.Infof("run ip compactor")
+	timer := time.NewTicker(c.interval)
+	defer timer.Stop()
+	for {
+		select {
+		case <-timer.C:
+			if c.elector.IsMaster() {
+				blog.Infof("do compact ip pool, dry run %v", c.dryRun)
+				c.compactAndReport(ctx)
+			}
+		case <-ctx.Done():
+			blog.Infof("ip compactor context done")
+			return nil
+		}
+	}
+}
+
+func (c *IPCompactor) compactAndReport(ctx context.Context) {
+	ops, err := c.Compact(ctx, &Filter{}, c.dryRun)
+	if err != nil {
+		blog.Warnf("compact ip pool failed, err %s", err.Error())
+	}
+	for _, op := range ops {
+		result := operationResultDryRun
+		if !c.dryRun {
+			result = operationResultSuccess
+			if !op.Executed {
+				result = operationResultFailed
+			}
+		}
+		metric.DefaultCollector.StatIPPoolCompaction(op.Type, result)
+	}
+	report, err := c.Analyze(ctx, &Filter{})
+	if err != nil {
+		blog.Warnf("analyze ip pool failed, err %s", err.Error())
+		return
+	}
+	for _, subnetReport := range report.Subnets {
+		subnetID := subnetReport.Subnet.SubnetID
+		metric.DefaultCollector.StatIPPool(subnetID, "total", float64(subnetReport.TotalIPNum))
+		metric.DefaultCollector.StatIPPool(subnetID, types.IPStatusFree, float64(subnetReport.FreeIPNum))
+		metric.DefaultCollector.StatIPPool(subnetID, types.IPStatusActive, float64(subnetReport.ActiveIPNum))
+		metric.DefaultCollector.StatIPPool(subnetID, "idle", float64(subnetReport.IdleIPNum))
+		metric.DefaultCollector.StatIPPool(subnetID, "fixed", float64(subnetReport.FixedIPNum))
+		metric.DefaultCollector.StatIPPool(subnetID, types.IPStatusENIPrimary,
+			float64(subnetReport.EniPrimaryIPNum))
+		metric.DefaultCollector.StatIPPool(subnetID, "leaked", float64(subnetReport.LeakedIPNum))
+	}
+}
+
+// Analyze analyze ip pool utilization
+func (c *IPCompactor) Analyze(ctx context.Context, filter *Filter) (*PoolReport, error) {
+	states, err := c.loadSubnetStates(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	quotas, err := c.storeIf.ListIPQuota(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list ip quota failed, err %s", err.Error())
+	}
+	return buildPoolReport(states, quotas, filter.Cluster), nil
+}
+
+// Compact free leaked ips and rebalance idle ips of subnets, only returns planned operations when dryRun is true
+func (c *IPCompactor) Compact(ctx context.Context, filter *Filter, dryRun bool) ([]*Operation, error) {
+	subnets, err := c.listSubnets(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	var allOps []*Operation
+	pods := newPodIndex(c.podLister)
+	for _, subnet := range subnets {
+		ops, err := c.compactSubnet(ctx, subnet, pods, dryRun)
+		if err != nil {
+			blog.Warnf("compact subnet %s failed, err %s", subnet.SubnetID, err.Error())
+			continue
+		}
+		allOps = append(allOps, ops...)
+	}
+	return allOps, nil
+}
+
+func (c *IPCompactor) compactSubnet(ctx context.Context, subnet *types.CloudSubnet, pods *podIndex,
+	dryRun bool) ([]*Operation, error) {
+	if !dryRun {
+		if err := c.locker.Lock(subnet.SubnetID, []lock.LockOption{lock.LockTTL(lockTTL)}...); err != nil {
+			return nil, fmt.Errorf("lock subnet %s failed, err %s", subnet.SubnetID, err.Error())
+		}
+		defer c.locker.Unlock(subnet.SubnetID)
+	}
+	state, err := c.loadSubnetState(ctx, subnet, pods)
+	if err != nil {
+		return nil, err
+	}
+	if state.enis == nil {
+		blog.Warnf("enis of subnet %s are unknown, skip compaction", subnet.SubnetID)
+		return nil, nil
+	}
+	ops := append(state.planFree(), state.planRebalance()...)
+	if dryRun {
+		return ops, nil
+	}
+	for _, op := range ops {
+		switch op.Type {
+		case OperationTypeFree:
+			op.Err = c.doFree(ctx, op)
+		case OperationTypeRelease:
+			op.Err = c.doRelease(ctx, op)
+		case OperationTypeMigrate:
+			op.Err = c.doMigrate(ctx, op)
+		}
+		if op.Err != nil {
+			blog.Warnf("do %s operation for ip %s failed, err %s", op.Type, op.IPObj.Address, op.Err.Error())
+			continue
+		}
+		op.Executed = true
+		blog.Infof("done %s operation for ip %s, reason: %s", op.Type, op.IPObj.Address, op.Reason)
+	}
+	return ops, nil
+}
+
+func (c *IPCompactor) listSubnets(ctx context.Context, filter *Filter) ([]*types.CloudSubnet, error) {
+	labelsMap := make(map[string]string)
+	if len(filter.VpcID) != 0 {
+		labelsMap[kube.CrdNameLabelsVpcID] = filter.VpcID
+	}
+	if len(filter.Region) != 0 {
+		labelsMap[kube.CrdNameLabelsRegion] = filter.Region
+	}
+	subnets, err := c.storeIf.ListSubnet(ctx, labelsMap)
+	if err != nil {
+		return nil, fmt.Errorf("list subnet failed, err %s", err.Error())
+	}
+	if len(filter.SubnetID) == 0 {
+		return subnets, nil
+	}
+	var retSubnets []*types.CloudSubnet
+	for _, subnet := range subnets {
+		if subnet.SubnetID == filter.SubnetID {
+			retSubnets = append(retSubnets, subnet)
+		}
+	}
+	return retSubnets, nil
+}
+
+func (c *IPCompactor) loadSubnetStates(ctx context.Context, filter *Filter) ([]*subnetState, error) {
+	subnets, err := c.listSubnets(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	var states []*subnetState
+	pods := newPodIndex(c.podLister)
+	for _, subnet := range subnets {
+		state, err := c.loadSubnetState(ctx, subnet, pods)
+		if err != nil {
+			return nil, err
+		}
+		states = append(states, state)
+	}
+	return states, nil
+}
+
+func (c *IPCompactor) loadSubnetState(ctx context.Context, subnet *types.CloudSubnet, pods *podIndex) (
+	*subnetState, error) {
+	ipObjs, err := c.storeIf.ListIPObject(ctx, map[string]string{
+		kube.CrdNameLabelsSubnetID: subnet.SubnetID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list ip objects of subnet %s failed, err %s", subnet.SubnetID, err.Error())
+	}
+	verified := true
+	enis, err := c.cloudIf.QueryEniList(subnet.SubnetID)
+	if err != nil {
+		blog.Warnf("query enis of subnet %s failed, leaked ips are not detected, err %s",
+			subnet.SubnetID, err.Error())
+		verified = false
+	}
+	return analyzeSubnet(subnet, ipObjs, enis, verified, pods, time.Now(), c.leakGrace), nil
+}
+
+// doFree free leaked ip, leaked fixed ip is kept for its workload as available ip
+func (c *IPCompactor) doFree(ctx context.Context, op *Operation) error {
+	ipObj := op.IPObj
+	if len(op.unassignEniID) != 0 {
+		if err := c.cloudIf.UnassignIPFromEni([]string{ipObj.Address}, op.unassignEniID); err != nil {
+			return fmt.Errorf("unassign ip %s from eni %s failed, err %s",
+				ipObj.Address, op.unassignEniID, err.Error())
+		}
+	}
+	switch {
+	case ipObj.Status == types.IPStatusENIPrimary:
+		ipObj.Status = types.IPStatusFree
+		ipObj.EniID = ""
+		ipObj.Host = ""
+		ipObj.Cluster = ""
+	case ipObj.IsFixed:
+		ipObj.Status = types.IPStatusAvailable
+		ipObj.EniID = ""
+		ipObj.Host = ""
+		ipObj.ContainerID = ""
+	default:
+		ipObj.Status = types.IPStatusFree
+		ipObj.EniID = ""
+		ipObj.Host = ""
+		ipObj.ContainerID = ""
+		ipObj.Cluster = ""
+	}
+	if _, err := c.storeIf.UpdateIPObject(ctx, ipObj); err != nil {
+		return fmt.Errorf("update leaked ip %s to store failed, err %s", ipObj.Address, err.Error())
+	}
+	return nil
+}
+
+// doRelease unassign redundant idle ip from eni and set it free
+func (c *IPCompactor) doRelease(ctx context.Context, op *Operation) error {
+	ipObj := op.IPObj
+	ipObj.Status = types.IPStatusDeleting
+	deletingIPObj, err := c.storeIf.UpdateIPObject(ctx, ipObj)
+	if err != nil {
+		return fmt.Errorf("change ip %s to deleting status failed, err %s", ipObj.Address, err.Error())
+	}
+	if err := c.cloudIf.UnassignIPFromEni([]string{deletingIPObj.Address}, op.SrcEniID); err != nil {
+		c.revertToAvailable(ctx, deletingIPObj)
+		return fmt.Errorf("unassign ip %s from eni %s failed, err %s", deletingIPObj.Address, op.SrcEniID,
+			err.Error())
+	}
+	deletingIPObj.Status = types.IPStatusFree
+	deletingIPObj.EniID = ""
+	deletingIPObj.Host = ""
+	deletingIPObj.ContainerID = ""
+	deletingIPObj.Cluster = ""
+	if _, err := c.storeIf.UpdateIPObject(ctx, deletingIPObj); err != nil {
+		return fmt.Errorf("set ip %s free to store failed, err %s", deletingIPObj.Address, err.Error())
+	}
+	return nil
+}
+
+// doMigrate migrate idle ip to dest eni
+func (c *IPCompactor) doMigrate(ctx context.Context, op *Operation) error {
+	ipObj := op.IPObj
+	ipObj.Status = types.IPStatusApplying
+	applyingIPObj, err := c.storeIf.UpdateIPObject(ctx, ipObj)
+	if err != nil {
+		return fmt.Errorf("change ip %s to applying status failed, err %s", ipObj.Address, err.Error())
+	}
+	if err := c.cloudIf.MigrateIP(applyingIPObj.Address, op.SrcEniID, op.DestEniID); err != nil {
+		c.revertToAvailable(ctx, applyingIPObj)
+		return fmt.Errorf("migrate ip %s from eni %s to eni %s failed, err %s",
+			applyingIPObj.Address, op.SrcEniID, op.DestEniID, err.Error())
+	}
+	applyingIPObj.Status = types.IPStatusAvailable
+	applyingIPObj.EniID = op.DestEniID
+	applyingIPObj.Host = op.destHost
+	if _, err := c.storeIf.UpdateIPObject(ctx, applyingIPObj); err != nil {
+		return fmt.Errorf("update migrated ip %s to store failed, err %s", applyingIPObj.Address, err.Error())
+	}
+	return nil
+}
+
+func (c *IPCompactor) revertToAvailable(ctx context.Context, ipObj *types.IPObject) {
+	ipObj.Status = types.IPStatusAvailable
+	if _, err := c.storeIf.UpdateIPObject(ctx, ipObj); err != nil {
+		blog.Errorf("revert ip %s to available status failed, err %s", ipObj.Address, err.Error())
+	}
+}
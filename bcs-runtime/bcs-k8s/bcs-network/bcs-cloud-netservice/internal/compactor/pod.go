@@ -0,0 +1,111 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.,
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under,
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package compactor
+
+import (
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/bcsapi"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-cloud-netservice/internal/types"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// PodLister list pods of cluster
+type PodLister interface {
+	ListPods(cluster string) ([]*corev1.Pod, error)
+}
+
+// storagePodLister list pods of cluster from bcs-storage
+type storagePodLister struct {
+	storageCli bcsapi.Storage
+}
+
+// NewStoragePodLister create pod lister by bcs-storage client
+func NewStoragePodLister(storageCli bcsapi.Storage) PodLister {
+	return &storagePodLister{storageCli: storageCli}
+}
+
+// ListPods implements PodLister
+func (s *storagePodLister) ListPods(cluster string) ([]*corev1.Pod, error) {
+	storagePods, err := s.storageCli.QueryK8SPod(cluster)
+	if err != nil {
+		return nil, err
+	}
+	var pods []*corev1.Pod
+	for _, storagePod := range storagePods {
+		if storagePod.Data != nil {
+			pods = append(pods, storagePod.Data)
+		}
+	}
+	return pods, nil
+}
+
+// podIndex index pods of clusters by namespace and name, pods of each cluster are listed once
+// during one analysis
+type podIndex struct {
+	lister PodLister
+	// clusters cluster -> namespace/name -> pod ip, pods of cluster are unknown when cluster is nil
+	clusters map[string]map[string]string
+}
+
+func newPodIndex(lister PodLister) *podIndex {
+	return &podIndex{
+		lister:   lister,
+		clusters: make(map[string]map[string]string),
+	}
+}
+
+// podExists check whether pod of ip object still exists and uses the ip, known is false when pods
+// of cluster cannot be listed
+func (p *podIndex) podExists(ipObj *types.IPObject) (exists bool, known bool) {
+	if p == nil || p.lister == nil {
+		return false, false
+	}
+	pods, ok := p.clusters[ipObj.Cluster]
+	if !ok {
+		pods = p.loadPods(ipObj.Cluster)
+		p.clusters[ipObj.Cluster] = pods
+	}
+	if pods == nil {
+		return false, false
+	}
+	podIP, ok := pods[ipObj.Namespace+"/"+ipObj.PodName]
+	if !ok {
+		return false, true
+	}
+	// pod with the same name is recreated and has got another ip
+	if len(podIP) != 0 && podIP != ipObj.Address {
+		return false, true
+	}
+	return true, true
+}
+
+func (p *podIndex) loadPods(cluster string) map[string]string {
+	pods, err := p.lister.ListPods(cluster)
+	if err != nil {
+		blog.Warnf("list pods of cluster %s failed, leaked ips of pods are not detected, err %s",
+			cluster, err.Error())
+		return nil
+	}
+	// no pod is found for a cluster, it is much more likely that bcs-storage returns unexpected result than
+	// all the pods are gone, do not detect leaked ips of pods in this case
+	if len(pods) == 0 {
+		blog.Warnf("no pod of cluster %s is found, leaked ips of pods are not detected", cluster)
+		return nil
+	}
+	podIPs := make(map[string]string, len(pods))
+	for _, pod := range pods {
+		podIPs[pod.Namespace+"/"+pod.Name] = pod.Status.PodIP
+	}
+	return podIPs
+}
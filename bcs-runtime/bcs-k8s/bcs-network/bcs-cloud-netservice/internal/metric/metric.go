@@ -55,6 +55,12 @@ type Collector struct {
 
 	// record multiple gauge value
 	gaugeSet *prometheus.GaugeVec
+
+	// ip number of subnet by status
+	ipPoolGauge *prometheus.GaugeVec
+
+	// ip pool compaction operation counter
+	ipPoolCompactCounter *prometheus.CounterVec
 }
 
 // NewCollector returns a new Collector
@@ -112,8 +118,27 @@ func (c *Collector) Init() {
 		},
 		[]string{"name"},
 	)
+	c.ipPoolGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "bcs_network",
+			Subsystem: "cloudnetservice",
+			Name:      "ip_pool_ips",
+			Help:      "ip number of subnet by status.",
+		},
+		[]string{"subnet", "status"},
+	)
+	c.ipPoolCompactCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "bcs_network",
+			Subsystem: "cloudnetservice",
+			Name:      "ip_pool_compact_total",
+			Help:      "ip pool compaction operation total counter",
+		},
+		[]string{"type", "result"},
+	)
 	prometheus.MustRegister(c.reqCounter, c.respTimeSummary,
-		c.cloudOperationCounter, c.cloudOperationSummary, c.gaugeSet)
+		c.cloudOperationCounter, c.cloudOperationSummary, c.gaugeSet,
+		c.ipPoolGauge, c.ipPoolCompactCounter)
 }
 
 // RegisterMux register handler to mux
@@ -153,6 +178,22 @@ func (c *Collector) StatGauge(name string, value float64) {
 	}).Set(value)
 }
 
+// StatIPPool report ip number of subnet by status
+func (c *Collector) StatIPPool(subnet, status string, value float64) {
+	c.ipPoolGauge.With(prometheus.Labels{
+		"subnet": subnet,
+		"status": status,
+	}).Set(value)
+}
+
+// StatIPPoolCompaction report ip pool compaction operation
+func (c *Collector) StatIPPoolCompaction(opType, result string) {
+	c.ipPoolCompactCounter.With(prometheus.Labels{
+		"type":   opType,
+		"result": result,
+	}).Inc()
+}
+
 // toMSTimestamp converts time.Time to millisecond timestamp.
 func toMSTimestamp(t time.Time) int64 {
 	return t.UnixNano() / 1e6
@@ -42,6 +42,21 @@ type Config struct {
 	IPCleanIntervalMinute int `json:"ip_clean_interval_minute" value:"10" usage:"minute for ip cleaner check interval"`
 	// FixedIPCleanIntervalMinute fixed clean interval
 	FixedIPCleanIntervalMinute int `json:"fixed_ip_clean_interval_minute" value:"20" usage:"interval minute for ip cleaner check fixed ip"` // nolint
+	// IPCompactIntervalMinute ip pool compaction interval
+	IPCompactIntervalMinute int `json:"ip_compact_interval_minute" value:"30" usage:"interval minute for ip pool compaction, 0 for disabled"` // nolint
+	// IPCompactDryRun periodic ip pool compaction only reports operations
+	IPCompactDryRun bool `json:"ip_compact_dry_run" value:"true" usage:"periodic ip pool compaction only reports operations without executing them"` // nolint
+	// IPLeakGraceMinute grace time before unchanged ip is treated as leaked
+	IPLeakGraceMinute int `json:"ip_leak_grace_minute" value:"30" usage:"minute before unchanged ip can be treated as leaked"`
+
+	// StorageHosts addresses of bcs-storage, pods are checked by bcs-storage before their ips are treated as leaked
+	StorageHosts string `json:"storage_hosts" value:"" usage:"bcs-storage or bcs-api-gateway addresses separated by comma, ips of pods are never treated as leaked when empty"` // nolint
+	// StorageToken auth token for bcs-storage
+	StorageToken string `json:"storage_token" value:"" usage:"auth token for bcs-storage"`
+	// StorageGateway whether storage hosts are addresses of bcs-api-gateway
+	StorageGateway bool `json:"storage_gateway" value:"false" usage:"whether storage_hosts are addresses of bcs-api-gateway"`
+	// StorageCAFile ca file for bcs-storage
+	StorageCAFile string `json:"storage_ca_file" value:"" usage:"ca file to verify bcs-storage over https, empty for http"`
 
 	// EtcdEndpoints endpoints of etcd
 	EtcdEndpoints string `json:"etcd_endpoints" value:"" usage:"endpoints of etcd"`
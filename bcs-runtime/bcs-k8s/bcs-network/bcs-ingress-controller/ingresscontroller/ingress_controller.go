@@ -125,7 +125,8 @@ func (ir *IngressReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 		return ctrl.Result{}, nil
 	}
 
-	if err := ir.IngressConverter.ProcessUpdateIngress(ingress); err != nil {
+	retry, err := ir.IngressConverter.ProcessUpdateIngress(ingress)
+	if err != nil {
 		// create event for ingress
 		ir.IngressEventer.Eventf(ingress, k8scorev1.EventTypeWarning,
 			"process ingress failed", "error: %s", err.Error())
@@ -135,6 +136,13 @@ func (ir *IngressReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 			RequeueAfter: time.Duration(5 * time.Second),
 		}, nil
 	}
+	// wait for pod clb weights being synced to cloud
+	if retry {
+		return ctrl.Result{
+			Requeue:      true,
+			RequeueAfter: time.Duration(3 * time.Second),
+		}, nil
+	}
 
 	return ctrl.Result{}, nil
 }
@@ -126,6 +126,11 @@ func (e *Elb) IsNamespaced() bool {
 	return false
 }
 
+// CreateLoadBalancer create loadbalancer, not supported for aws elb
+func (e *Elb) CreateLoadBalancer(region string, opt *cloud.LoadBalanceCreateOption) (*cloud.LoadBalanceObject, error) {
+	return nil, fmt.Errorf("create loadbalancer is not supported for aws elb")
+}
+
 // EnsureListener ensure listener to cloud
 func (e *Elb) EnsureListener(region string, listener *networkextensionv1.Listener) (string, error) {
 	if listener.Spec.LoadbalancerID == "" {
@@ -98,6 +98,11 @@ func (e *GCLB) IsNamespaced() bool {
 	return false
 }
 
+// CreateLoadBalancer create loadbalancer, not supported for gcp
+func (e *GCLB) CreateLoadBalancer(region string, opt *cloud.LoadBalanceCreateOption) (*cloud.LoadBalanceObject, error) {
+	return nil, fmt.Errorf("create loadbalancer is not supported for gcp")
+}
+
 // EnsureListener ensure listener to cloud
 func (e *GCLB) EnsureListener(region string, listener *networkextensionv1.Listener) (string, error) {
 	if listener.Spec.LoadbalancerID == "" {
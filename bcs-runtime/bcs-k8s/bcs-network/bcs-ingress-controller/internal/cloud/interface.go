@@ -107,6 +107,20 @@ type LoadBalanceObject struct {
 	AWSLBType string `json:"awsLBType,omitempty"`
 }
 
+// LoadBalanceCreateOption option for creating loadbalancer
+type LoadBalanceCreateOption struct {
+	// Namespace namespace of the resource which requires the loadbalancer, used by namespaced client
+	Namespace string `json:"namespace,omitempty"`
+	// Name name of loadbalancer
+	Name string `json:"name"`
+	// Type OPEN or INTERNAL
+	Type string `json:"type,omitempty"`
+	// VpcID vpc of loadbalancer
+	VpcID string `json:"vpcID,omitempty"`
+	// SubnetID subnet of loadbalancer, only for INTERNAL loadbalancer
+	SubnetID string `json:"subnetID,omitempty"`
+}
+
 // BackendHealthStatus health status of cloud loadbalancer backend
 type BackendHealthStatus struct {
 	ListenerID   string
@@ -131,6 +145,9 @@ type LoadBalance interface {
 	// IsNamespaced if client is namespaced
 	IsNamespaced() bool
 
+	// CreateLoadBalancer create loadbalancer in region
+	CreateLoadBalancer(region string, opt *LoadBalanceCreateOption) (*LoadBalanceObject, error)
+
 	// EnsureListener ensure listener to cloud, and get listener info
 	EnsureListener(region string, listener *networkextensionv1.Listener) (string, error)
 
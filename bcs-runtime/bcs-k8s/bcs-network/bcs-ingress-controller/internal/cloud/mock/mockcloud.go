@@ -34,6 +34,21 @@ func (m *MockLoadBalance) EXPECT() *MockLoadBalanceMockRecorder {
 	return m.recorder
 }
 
+// CreateLoadBalancer mocks base method
+func (m *MockLoadBalance) CreateLoadBalancer(arg0 string, arg1 *cloud.LoadBalanceCreateOption) (*cloud.LoadBalanceObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLoadBalancer", arg0, arg1)
+	ret0, _ := ret[0].(*cloud.LoadBalanceObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLoadBalancer indicates an expected call of CreateLoadBalancer
+func (mr *MockLoadBalanceMockRecorder) CreateLoadBalancer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLoadBalancer", reflect.TypeOf((*MockLoadBalance)(nil).CreateLoadBalancer), arg0, arg1)
+}
+
 // DeleteListener mocks base method
 func (m *MockLoadBalance) DeleteListener(arg0 string, arg1 *v1.Listener) error {
 	m.ctrl.T.Helper()
@@ -101,6 +101,19 @@ func (nc *NamespacedLB) IsNamespaced() bool {
 	return true
 }
 
+// CreateLoadBalancer create loadbalancer with client of the namespace in option
+func (nc *NamespacedLB) CreateLoadBalancer(region string, opt *cloud.LoadBalanceCreateOption) (
+	*cloud.LoadBalanceObject, error) {
+	if opt == nil {
+		return nil, fmt.Errorf("create option cannot be empty")
+	}
+	tmpClient, err := nc.getNsClient(opt.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	return tmpClient.CreateLoadBalancer(region, opt)
+}
+
 // EnsureListener implements LoadBalance interface
 func (nc *NamespacedLB) EnsureListener(region string, listener *networkextensionv1.Listener) (string, error) {
 	tmpClient, err := nc.getNsClient(listener.GetNamespace())
@@ -0,0 +1,249 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package onprem
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-ingress-controller/internal/metrics"
+	networkextensionv1 "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/kubernetes/apis/networkextension/v1"
+)
+
+// The agent protocol is a small json over http api served by the agent on every lb node:
+//
+//	PUT  /v1/loadbalancers/{lbID}/listeners         create or update listeners, body AgentListenerList
+//	POST /v1/loadbalancers/{lbID}/listeners/delete  delete listeners, body AgentDeleteRequest
+//	GET  /v1/loadbalancers/{lbID}/health            health of backends, response AgentHealthResponse
+//
+// All requests are idempotent, the agent returns 2xx on success, or an AgentErrorResponse otherwise.
+// Agents should be served with https, requests carry the token as "Authorization: Bearer {token}" if configured.
+
+// AgentListener listener to be programmed by lb agent
+type AgentListener struct {
+	// ListenerID id of listener, unique in loadbalancer
+	ListenerID string `json:"listenerID"`
+	Name       string `json:"name"`
+	Namespace  string `json:"namespace"`
+	Protocol   string `json:"protocol"`
+	Port       int    `json:"port"`
+	// EndPort is set for segment listener, ports in [port, endPort] are forwarded to
+	// the same offset of backend port
+	EndPort     int                                            `json:"endPort,omitempty"`
+	Attribute   *networkextensionv1.IngressListenerAttribute   `json:"listenerAttribute,omitempty"`
+	Certificate *networkextensionv1.IngressListenerCertificate `json:"certificate,omitempty"`
+	// Backends backends of 4 layer listener
+	Backends []networkextensionv1.ListenerBackend `json:"backends,omitempty"`
+	// Rules rules of 7 layer listener
+	Rules []AgentListenerRule `json:"rules,omitempty"`
+}
+
+// AgentListenerRule 7 layer route rule of listener
+type AgentListenerRule struct {
+	Domain    string                                       `json:"domain"`
+	Path      string                                       `json:"path,omitempty"`
+	Attribute *networkextensionv1.IngressListenerAttribute `json:"listenerAttribute,omitempty"`
+	Backends  []networkextensionv1.ListenerBackend         `json:"backends,omitempty"`
+}
+
+// AgentListenerList request body for ensuring listeners
+type AgentListenerList struct {
+	Listeners []*AgentListener `json:"listeners"`
+}
+
+// AgentDeleteRequest request body for deleting listeners
+type AgentDeleteRequest struct {
+	ListenerIDs []string `json:"listenerIDs"`
+}
+
+// AgentBackendHealth health status of a backend reported by lb agent
+type AgentBackendHealth struct {
+	ListenerID   string `json:"listenerID"`
+	ListenerPort int    `json:"listenerPort"`
+	Protocol     string `json:"protocol"`
+	Domain       string `json:"domain,omitempty"`
+	Path         string `json:"path,omitempty"`
+	IP           string `json:"ip"`
+	Port         int    `json:"port"`
+	// Status Healthy, Unhealthy or Unknown
+	Status string `json:"status"`
+}
+
+// AgentHealthResponse response of backend health
+type AgentHealthResponse struct {
+	Backends []*AgentBackendHealth `json:"backends"`
+}
+
+// AgentErrorResponse error response of lb agent
+type AgentErrorResponse struct {
+	Message string `json:"message"`
+}
+
+// AgentClient client for lb agents
+type AgentClient interface {
+	// EnsureListeners create or update listeners on agent
+	EnsureListeners(agent, lbID string, listeners []*AgentListener) error
+	// DeleteListeners delete listeners on agent
+	DeleteListeners(agent, lbID string, listenerIDs []string) error
+	// DescribeHealth get health of backends on agent
+	DescribeHealth(agent, lbID string) ([]*AgentBackendHealth, error)
+}
+
+// AgentClientOption option of agent client, the credentials are loaded from secret
+type AgentClientOption struct {
+	// Timeout timeout for calling lb agents
+	Timeout time.Duration
+	// Token bearer token for authentication of agents
+	Token string
+	// CA pem encoded ca certificate to verify agents, system roots are used if empty
+	CA []byte
+	// Cert pem encoded client certificate for mutual tls
+	Cert []byte
+	// Key pem encoded private key of client certificate
+	Key []byte
+}
+
+// HTTPAgentClient client for lb agents with http protocol
+type HTTPAgentClient struct {
+	client *http.Client
+	token  string
+}
+
+// NewHTTPAgentClient create http agent client
+func NewHTTPAgentClient(option *AgentClientOption) (*HTTPAgentClient, error) {
+	tlsConfig, err := option.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	return &HTTPAgentClient{
+		client: &http.Client{Timeout: option.Timeout, Transport: transport},
+		token:  option.Token,
+	}, nil
+}
+
+// tlsConfig build tls config for connecting agents with https
+func (o *AgentClientOption) tlsConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if len(o.CA) != 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(o.CA) {
+			return nil, fmt.Errorf("invalid agent ca certificate")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if len(o.Cert) != 0 || len(o.Key) != 0 {
+		cert, err := tls.X509KeyPair(o.Cert, o.Key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid agent client certificate, err %s", err.Error())
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+var _ AgentClient = &HTTPAgentClient{}
+
+// EnsureListeners create or update listeners on agent
+func (c *HTTPAgentClient) EnsureListeners(agent, lbID string, listeners []*AgentListener) error {
+	return c.do("EnsureListeners", http.MethodPut, agent, lbID, "listeners",
+		&AgentListenerList{Listeners: listeners}, nil)
+}
+
+// DeleteListeners delete listeners on agent
+func (c *HTTPAgentClient) DeleteListeners(agent, lbID string, listenerIDs []string) error {
+	return c.do("DeleteListeners", http.MethodPost, agent, lbID, "listeners/delete",
+		&AgentDeleteRequest{ListenerIDs: listenerIDs}, nil)
+}
+
+// DescribeHealth get health of backends on agent
+func (c *HTTPAgentClient) DescribeHealth(agent, lbID string) ([]*AgentBackendHealth, error) {
+	resp := &AgentHealthResponse{}
+	if err := c.do("DescribeHealth", http.MethodGet, agent, lbID, "health", nil, resp); err != nil {
+		return nil, err
+	}
+	return resp.Backends, nil
+}
+
+// do send request to agent and decode response into out if not nil
+func (c *HTTPAgentClient) do(method, httpMethod, agent, lbID, subPath string, in, out interface{}) error {
+	startTime := time.Now()
+	mf := func(ret string) {
+		metrics.ReportLibRequestMetric(SystemNameInMetric, HandlerNameInMetricAgent, method, ret, startTime)
+	}
+
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("encode %s request failed, err %s", method, err.Error())
+		}
+		body = bytes.NewReader(data)
+	}
+	reqURL := fmt.Sprintf("%s/v1/loadbalancers/%s/%s", agent, url.PathEscape(lbID), subPath)
+	req, err := http.NewRequest(httpMethod, reqURL, body)
+	if err != nil {
+		return fmt.Errorf("create %s request to agent %s failed, err %s", method, agent, err.Error())
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if len(c.token) != 0 {
+		// token is never sent in plain text
+		if !strings.HasPrefix(agent, "https://") {
+			return fmt.Errorf("%s of lb %s on agent %s failed, token requires https agent", method, lbID, agent)
+		}
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		mf(metrics.LibCallStatusTimeout)
+		blog.Errorf("%s of lb %s on agent %s failed, err %s", method, lbID, agent, err.Error())
+		return fmt.Errorf("%s of lb %s on agent %s failed, err %s", method, lbID, agent, err.Error())
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxAgentResponseSize))
+	if err != nil {
+		mf(metrics.LibCallStatusErr)
+		return fmt.Errorf("read %s response from agent %s failed, err %s", method, agent, err.Error())
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		mf(metrics.LibCallStatusErr)
+		errResp := &AgentErrorResponse{}
+		if jsonErr := json.Unmarshal(data, errResp); jsonErr != nil || len(errResp.Message) == 0 {
+			errResp.Message = string(data)
+		}
+		blog.Errorf("%s of lb %s on agent %s failed, code %d, msg %s",
+			method, lbID, agent, resp.StatusCode, errResp.Message)
+		return fmt.Errorf("%s of lb %s on agent %s failed, code %d, msg %s",
+			method, lbID, agent, resp.StatusCode, errResp.Message)
+	}
+	if out != nil {
+		if err := json.Unmarshal(data, out); err != nil {
+			mf(metrics.LibCallStatusErr)
+			return fmt.Errorf("decode %s response from agent %s failed, err %s", method, agent, err.Error())
+		}
+	}
+	mf(metrics.LibCallStatusOK)
+	return nil
+}
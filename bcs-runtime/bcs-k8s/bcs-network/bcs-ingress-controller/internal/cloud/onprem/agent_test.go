@@ -0,0 +1,126 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package onprem
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// newTestClientCert generate self-signed client certificate and key in pem
+func newTestClientCert(t *testing.T) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key failed, err %s", err.Error())
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "bcs-ingress-controller"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		IsCA:         true,
+
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create certificate failed, err %s", err.Error())
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("marshal key failed, err %s", err.Error())
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+}
+
+func TestHTTPAgentClientTLS(t *testing.T) {
+	cert, key := newTestClientCert(t)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AppendCertsFromPEM(cert)
+
+	agent := newFakeAgent()
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("Authorization") != "Bearer token" {
+			rw.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		agent.ServeHTTP(rw, req)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs}
+	server.StartTLS()
+	defer server.Close()
+	serverCA := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	testCases := []struct {
+		name    string
+		option  *AgentClientOption
+		agent   string
+		success bool
+	}{
+		{
+			name:    "mutual tls with token",
+			option:  &AgentClientOption{Timeout: time.Second, Token: "token", CA: serverCA, Cert: cert, Key: key},
+			agent:   server.URL,
+			success: true,
+		},
+		{
+			name:   "invalid token",
+			option: &AgentClientOption{Timeout: time.Second, Token: "invalid", CA: serverCA, Cert: cert, Key: key},
+			agent:  server.URL,
+		},
+		{
+			name:   "no client certificate",
+			option: &AgentClientOption{Timeout: time.Second, Token: "token", CA: serverCA},
+			agent:  server.URL,
+		},
+		{
+			name:   "untrusted agent",
+			option: &AgentClientOption{Timeout: time.Second, Token: "token", Cert: cert, Key: key},
+			agent:  server.URL,
+		},
+		{
+			name:   "token over plain http",
+			option: &AgentClientOption{Timeout: time.Second, Token: "token", CA: serverCA, Cert: cert, Key: key},
+			agent:  "http://" + server.Listener.Addr().String(),
+		},
+	}
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			agentCli, err := NewHTTPAgentClient(test.option)
+			if err != nil {
+				t.Fatalf("create agent client failed, err %s", err.Error())
+			}
+			_, err = agentCli.DescribeHealth(test.agent, "lb-1")
+			if test.success != (err == nil) {
+				t.Errorf("expect success %v, but get err %v", test.success, err)
+			}
+		})
+	}
+
+	if _, err := NewHTTPAgentClient(&AgentClientOption{Cert: cert}); err == nil {
+		t.Errorf("expect error for client certificate without key")
+	}
+}
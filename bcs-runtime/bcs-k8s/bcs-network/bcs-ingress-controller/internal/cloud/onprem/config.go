@@ -0,0 +1,99 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package onprem
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+)
+
+// LoadBalancerConfig config of a self-hosted loadbalancer, which is a fleet of lb nodes sharing the same vips.
+// Every lb node runs an agent (IPVS, HAProxy or Envoy) which programs the listeners on the node.
+type LoadBalancerConfig struct {
+	// ID id of loadbalancer, used as loadbalancer id in ingress and port pool
+	ID string `json:"id"`
+	// Name name of loadbalancer
+	Name string `json:"name,omitempty"`
+	// Region region of loadbalancer, e.g. the name of idc
+	Region string `json:"region"`
+	// Type OPEN or INTERNAL
+	Type string `json:"type,omitempty"`
+	// VIPs virtual ips of loadbalancer
+	VIPs []string `json:"vips"`
+	// Agents addresses of the agents on lb nodes, e.g. https://10.0.0.1:9090
+	Agents []string `json:"agents"`
+}
+
+// Config config of self-hosted loadbalancers
+type Config struct {
+	LoadBalancers []*LoadBalancerConfig `json:"loadBalancers"`
+}
+
+// LoadConfigFile load loadbalancer config from file
+func LoadConfigFile(path string) (*Config, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read loadbalancer config file %s failed, err %s", path, err.Error())
+	}
+	return LoadConfig(data)
+}
+
+// LoadConfig load loadbalancer config from json data
+func LoadConfig(data []byte) (*Config, error) {
+	config := &Config{}
+	if err := json.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("decode loadbalancer config failed, err %s", err.Error())
+	}
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// validate check loadbalancer config
+func (c *Config) validate() error {
+	if len(c.LoadBalancers) == 0 {
+		return fmt.Errorf("no loadbalancer in config")
+	}
+	idSet := make(map[string]struct{})
+	for _, lb := range c.LoadBalancers {
+		if lb == nil || len(lb.ID) == 0 {
+			return fmt.Errorf("loadbalancer id cannot be empty")
+		}
+		if _, ok := idSet[lb.ID]; ok {
+			return fmt.Errorf("duplicated loadbalancer id %s", lb.ID)
+		}
+		idSet[lb.ID] = struct{}{}
+		if len(lb.Region) == 0 {
+			return fmt.Errorf("region of loadbalancer %s cannot be empty", lb.ID)
+		}
+		if len(lb.VIPs) == 0 {
+			return fmt.Errorf("vips of loadbalancer %s cannot be empty", lb.ID)
+		}
+		if len(lb.Agents) == 0 {
+			return fmt.Errorf("agents of loadbalancer %s cannot be empty", lb.ID)
+		}
+		for index, agent := range lb.Agents {
+			if !strings.HasPrefix(agent, "http://") && !strings.HasPrefix(agent, "https://") {
+				agent = "http://" + agent
+			}
+			lb.Agents[index] = strings.TrimSuffix(agent, "/")
+		}
+		if len(lb.Name) == 0 {
+			lb.Name = lb.ID
+		}
+	}
+	return nil
+}
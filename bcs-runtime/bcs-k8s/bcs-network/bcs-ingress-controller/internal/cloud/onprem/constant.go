@@ -0,0 +1,59 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package onprem
+
+import "time"
+
+const (
+	// SystemNameInMetric system name in metric
+	SystemNameInMetric = "onprem"
+	// HandlerNameInMetricAgent handler name in metric
+	HandlerNameInMetricAgent = "agent"
+
+	// ProtocolHTTP http protocol
+	ProtocolHTTP = "HTTP"
+	// ProtocolHTTPS https protocol
+	ProtocolHTTPS = "HTTPS"
+	// ProtocolTCP tcp protocol
+	ProtocolTCP = "TCP"
+	// ProtocolUDP udp protocol
+	ProtocolUDP = "UDP"
+
+	// EnvNameOnPremLBConfig env name of the path of loadbalancer config file
+	EnvNameOnPremLBConfig = "ONPREM_LB_CONFIG"
+	// EnvNameOnPremAgentTimeout env name of timeout for calling lb agents, golang time format
+	EnvNameOnPremAgentTimeout = "ONPREM_AGENT_TIMEOUT"
+	// EnvNameOnPremAgentToken env name of bearer token for authentication of lb agents
+	EnvNameOnPremAgentToken = "ONPREM_AGENT_TOKEN"
+	// EnvNameOnPremAgentCAFile env name of the path of ca certificate to verify lb agents
+	EnvNameOnPremAgentCAFile = "ONPREM_AGENT_CA_FILE"
+	// EnvNameOnPremAgentCertFile env name of the path of client certificate for lb agents
+	EnvNameOnPremAgentCertFile = "ONPREM_AGENT_CERT_FILE"
+	// EnvNameOnPremAgentKeyFile env name of the path of client private key for lb agents
+	EnvNameOnPremAgentKeyFile = "ONPREM_AGENT_KEY_FILE"
+	// SecretKeyOnPremLBConfig key of loadbalancer config in secret, used in namespaced mode
+	SecretKeyOnPremLBConfig = "lbConfig"
+	// SecretKeyOnPremAgentToken key of agent token in secret, used in namespaced mode
+	SecretKeyOnPremAgentToken = "agentToken"
+	// SecretKeyOnPremAgentCA key of agent ca certificate in secret, used in namespaced mode
+	SecretKeyOnPremAgentCA = "agentCA"
+	// SecretKeyOnPremAgentCert key of agent client certificate in secret, used in namespaced mode
+	SecretKeyOnPremAgentCert = "agentCert"
+	// SecretKeyOnPremAgentKey key of agent client private key in secret, used in namespaced mode
+	SecretKeyOnPremAgentKey = "agentKey"
+
+	// defaultAgentTimeout default timeout for calling lb agents
+	defaultAgentTimeout = 10 * time.Second
+	// maxAgentResponseSize max size of agent response body
+	maxAgentResponseSize = 16 << 20
+)
@@ -0,0 +1,492 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package onprem
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-ingress-controller/internal/cloud"
+	networkextensionv1 "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/kubernetes/apis/networkextension/v1"
+	k8scorev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// OnPremLB client to operate self-hosted loadbalancers through the agents on lb nodes
+type OnPremLB struct {
+	// map of loadbalancer id and config
+	lbMap    map[string]*LoadBalancerConfig
+	agentCli AgentClient
+}
+
+// NewOnPremLB create self-hosted loadbalancer client, the loadbalancer config is loaded from file
+func NewOnPremLB() (*OnPremLB, error) {
+	configPath := os.Getenv(EnvNameOnPremLBConfig)
+	if len(configPath) == 0 {
+		return nil, fmt.Errorf("env %s cannot be empty", EnvNameOnPremLBConfig)
+	}
+	config, err := LoadConfigFile(configPath)
+	if err != nil {
+		return nil, err
+	}
+	option, err := loadAgentOptionFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	agentCli, err := NewHTTPAgentClient(option)
+	if err != nil {
+		return nil, err
+	}
+	return newOnPremLB(config, agentCli), nil
+}
+
+// NewOnPremLBWithSecret create self-hosted loadbalancer client with loadbalancer config in k8s secret
+func NewOnPremLBWithSecret(secret *k8scorev1.Secret, k8sClient client.Client) (cloud.LoadBalance, error) {
+	data, ok := secret.Data[SecretKeyOnPremLBConfig]
+	if !ok {
+		return nil, fmt.Errorf("lost %s in secret %s/%s", SecretKeyOnPremLBConfig,
+			secret.Namespace, secret.Name)
+	}
+	config, err := LoadConfig(data)
+	if err != nil {
+		return nil, err
+	}
+	timeout, err := loadAgentTimeout()
+	if err != nil {
+		return nil, err
+	}
+	agentCli, err := NewHTTPAgentClient(&AgentClientOption{
+		Timeout: timeout,
+		Token:   strings.TrimSpace(string(secret.Data[SecretKeyOnPremAgentToken])),
+		CA:      secret.Data[SecretKeyOnPremAgentCA],
+		Cert:    secret.Data[SecretKeyOnPremAgentCert],
+		Key:     secret.Data[SecretKeyOnPremAgentKey],
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create agent client with secret %s/%s failed, err %s",
+			secret.Namespace, secret.Name, err.Error())
+	}
+	return newOnPremLB(config, agentCli), nil
+}
+
+func newOnPremLB(config *Config, agentCli AgentClient) *OnPremLB {
+	lbMap := make(map[string]*LoadBalancerConfig)
+	for _, lb := range config.LoadBalancers {
+		lbMap[lb.ID] = lb
+	}
+	return &OnPremLB{
+		lbMap:    lbMap,
+		agentCli: agentCli,
+	}
+}
+
+// loadAgentOptionFromEnv load agent client option from env, token is injected from secret and
+// certificates are files mounted from secret
+func loadAgentOptionFromEnv() (*AgentClientOption, error) {
+	timeout, err := loadAgentTimeout()
+	if err != nil {
+		return nil, err
+	}
+	option := &AgentClientOption{
+		Timeout: timeout,
+		Token:   strings.TrimSpace(os.Getenv(EnvNameOnPremAgentToken)),
+	}
+	files := map[string]*[]byte{
+		EnvNameOnPremAgentCAFile:   &option.CA,
+		EnvNameOnPremAgentCertFile: &option.Cert,
+		EnvNameOnPremAgentKeyFile:  &option.Key,
+	}
+	for env, content := range files {
+		path := os.Getenv(env)
+		if len(path) == 0 {
+			continue
+		}
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read %s %s failed, err %s", env, path, err.Error())
+		}
+		*content = data
+	}
+	return option, nil
+}
+
+func loadAgentTimeout() (time.Duration, error) {
+	timeoutStr := os.Getenv(EnvNameOnPremAgentTimeout)
+	if len(timeoutStr) == 0 {
+		return defaultAgentTimeout, nil
+	}
+	timeout, err := time.ParseDuration(timeoutStr)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %s, err %s", EnvNameOnPremAgentTimeout, timeoutStr, err.Error())
+	}
+	return timeout, nil
+}
+
+var _ cloud.LoadBalance = &OnPremLB{}
+
+// DescribeLoadBalancer get loadbalancer object by id or name
+func (o *OnPremLB) DescribeLoadBalancer(region, lbID, name string) (*cloud.LoadBalanceObject, error) {
+	var lb *LoadBalancerConfig
+	if len(lbID) != 0 {
+		lb = o.lbMap[lbID]
+	} else {
+		for _, tmpLb := range o.lbMap {
+			if tmpLb.Name == name {
+				lb = tmpLb
+				break
+			}
+		}
+	}
+	if lb == nil || (len(region) != 0 && lb.Region != region) {
+		blog.Errorf("lb with id %s name %s not found in region %s", lbID, name, region)
+		return nil, cloud.ErrLoadbalancerNotFound
+	}
+	return &cloud.LoadBalanceObject{
+		LbID:   lb.ID,
+		Region: lb.Region,
+		Name:   lb.Name,
+		Type:   lb.Type,
+		IPs:    lb.VIPs,
+		VIPs:   lb.VIPs,
+	}, nil
+}
+
+// DescribeLoadBalancerWithNs get loadbalancer object by id or name with namespace specified
+func (o *OnPremLB) DescribeLoadBalancerWithNs(ns, region, lbID, name string) (*cloud.LoadBalanceObject, error) {
+	return o.DescribeLoadBalancer(region, lbID, name)
+}
+
+// IsNamespaced if client is namespaced
+func (o *OnPremLB) IsNamespaced() bool {
+	return false
+}
+
+// CreateLoadBalancer create loadbalancer, on-premises loadbalancers are declared in config file
+func (o *OnPremLB) CreateLoadBalancer(region string, opt *cloud.LoadBalanceCreateOption) (
+	*cloud.LoadBalanceObject, error) {
+	return nil, fmt.Errorf("create loadbalancer is not supported for on-premises loadbalancer, " +
+		"please declare it in config file")
+}
+
+// EnsureListener ensure listener to all the agents of loadbalancer
+func (o *OnPremLB) EnsureListener(region string, listener *networkextensionv1.Listener) (string, error) {
+	lb, err := o.getLoadBalancer(region, listener.Spec.LoadbalancerID)
+	if err != nil {
+		return "", err
+	}
+	agentListener, err := convertListener(listener)
+	if err != nil {
+		return "", err
+	}
+	if err := o.doOnAgents(lb, func(agent string) error {
+		return o.agentCli.EnsureListeners(agent, lb.ID, []*AgentListener{agentListener})
+	}); err != nil {
+		return "", err
+	}
+	return agentListener.ListenerID, nil
+}
+
+// DeleteListener delete listener from all the agents of loadbalancer
+func (o *OnPremLB) DeleteListener(region string, listener *networkextensionv1.Listener) error {
+	lb, err := o.getLoadBalancer(region, listener.Spec.LoadbalancerID)
+	if err != nil {
+		return err
+	}
+	listenerID := getListenerID(listener)
+	return o.doOnAgents(lb, func(agent string) error {
+		return o.agentCli.DeleteListeners(agent, lb.ID, []string{listenerID})
+	})
+}
+
+// EnsureMultiListeners ensure multiple listeners with one request to each agent
+func (o *OnPremLB) EnsureMultiListeners(region, lbID string, listeners []*networkextensionv1.Listener) (
+	map[string]string, error) {
+	lb, err := o.getLoadBalancer(region, lbID)
+	if err != nil {
+		return nil, err
+	}
+	retMap := make(map[string]string)
+	agentListeners := make([]*AgentListener, 0, len(listeners))
+	for _, listener := range listeners {
+		agentListener, err := convertListener(listener)
+		if err != nil {
+			// listener not in the returned map will be requeued
+			blog.Warnf("convert listener %s/%s failed, err %s", listener.GetNamespace(), listener.GetName(),
+				err.Error())
+			continue
+		}
+		agentListeners = append(agentListeners, agentListener)
+	}
+	if len(agentListeners) == 0 {
+		return retMap, nil
+	}
+	if err := o.doOnAgents(lb, func(agent string) error {
+		return o.agentCli.EnsureListeners(agent, lb.ID, agentListeners)
+	}); err != nil {
+		return nil, err
+	}
+	for _, agentListener := range agentListeners {
+		retMap[agentListener.Name] = agentListener.ListenerID
+	}
+	return retMap, nil
+}
+
+// DeleteMultiListeners delete multiple listeners with one request to each agent
+func (o *OnPremLB) DeleteMultiListeners(region, lbID string, listeners []*networkextensionv1.Listener) error {
+	lb, err := o.getLoadBalancer(region, lbID)
+	if err != nil {
+		return err
+	}
+	listenerIDs := make([]string, 0, len(listeners))
+	for _, listener := range listeners {
+		listenerIDs = append(listenerIDs, getListenerID(listener))
+	}
+	return o.doOnAgents(lb, func(agent string) error {
+		return o.agentCli.DeleteListeners(agent, lb.ID, listenerIDs)
+	})
+}
+
+// EnsureSegmentListener ensure listener with port segment
+func (o *OnPremLB) EnsureSegmentListener(region string, listener *networkextensionv1.Listener) (string, error) {
+	return o.EnsureListener(region, listener)
+}
+
+// EnsureMultiSegmentListeners ensure multi segment listeners
+func (o *OnPremLB) EnsureMultiSegmentListeners(region, lbID string, listeners []*networkextensionv1.Listener) (
+	map[string]string, error) {
+	return o.EnsureMultiListeners(region, lbID, listeners)
+}
+
+// DeleteSegmentListener delete segment listener
+func (o *OnPremLB) DeleteSegmentListener(region string, listener *networkextensionv1.Listener) error {
+	return o.DeleteListener(region, listener)
+}
+
+// DescribeBackendStatus describe backend status reported by the agents of loadbalancers. A backend is healthy
+// only when it is healthy on all the agents, and unhealthy when it is unhealthy on any agent.
+func (o *OnPremLB) DescribeBackendStatus(region, ns string, lbIDs []string) (
+	map[string][]*cloud.BackendHealthStatus, error) {
+	retMap := make(map[string][]*cloud.BackendHealthStatus)
+	for _, lbID := range lbIDs {
+		lb, err := o.getLoadBalancer(region, lbID)
+		if err != nil {
+			blog.Warnf("describe backend status of lb %s failed, err %s", lbID, err.Error())
+			continue
+		}
+		statuses, err := o.describeLoadBalancerHealth(lb, ns)
+		if err != nil {
+			blog.Warnf("describe backend status of lb %s failed, err %s", lbID, err.Error())
+			continue
+		}
+		retMap[lbID] = statuses
+	}
+	return retMap, nil
+}
+
+// describeLoadBalancerHealth get health of backends from all the agents of loadbalancer and merge them
+func (o *OnPremLB) describeLoadBalancerHealth(lb *LoadBalancerConfig, ns string) (
+	[]*cloud.BackendHealthStatus, error) {
+	type backendKey struct {
+		listenerID string
+		domain     string
+		path       string
+		ip         string
+		port       int
+	}
+	type backendHealth struct {
+		status       *cloud.BackendHealthStatus
+		healthyNum   int
+		unhealthyNum int
+	}
+
+	var mutex sync.Mutex
+	healthMap := make(map[backendKey]*backendHealth)
+	respondedNum := 0
+	err := o.doOnAgents(lb, func(agent string) error {
+		backends, err := o.agentCli.DescribeHealth(agent, lb.ID)
+		if err != nil {
+			return err
+		}
+		mutex.Lock()
+		defer mutex.Unlock()
+		respondedNum++
+		for _, backend := range backends {
+			key := backendKey{
+				listenerID: backend.ListenerID,
+				domain:     backend.Domain,
+				path:       backend.Path,
+				ip:         backend.IP,
+				port:       backend.Port,
+			}
+			health, ok := healthMap[key]
+			if !ok {
+				health = &backendHealth{
+					status: &cloud.BackendHealthStatus{
+						ListenerID:   backend.ListenerID,
+						ListenerPort: backend.ListenerPort,
+						Namespace:    ns,
+						IP:           backend.IP,
+						Port:         backend.Port,
+						Protocol:     backend.Protocol,
+						Host:         backend.Domain,
+						Path:         backend.Path,
+					},
+				}
+				healthMap[key] = health
+			}
+			switch backend.Status {
+			case cloud.BackendHealthStatusHealthy:
+				health.healthyNum++
+			case cloud.BackendHealthStatusUnhealthy:
+				health.unhealthyNum++
+			}
+		}
+		return nil
+	})
+	if respondedNum == 0 {
+		return nil, err
+	}
+	if err != nil {
+		blog.Warnf("some agents of lb %s failed, err %s", lb.ID, err.Error())
+	}
+
+	statuses := make([]*cloud.BackendHealthStatus, 0, len(healthMap))
+	for _, health := range healthMap {
+		switch {
+		case health.unhealthyNum > 0:
+			health.status.Status = cloud.BackendHealthStatusUnhealthy
+		case health.healthyNum == len(lb.Agents):
+			health.status.Status = cloud.BackendHealthStatusHealthy
+		default:
+			health.status.Status = cloud.BackendHealthStatusUnknown
+		}
+		statuses = append(statuses, health.status)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		if statuses[i].ListenerID != statuses[j].ListenerID {
+			return statuses[i].ListenerID < statuses[j].ListenerID
+		}
+		if statuses[i].Host != statuses[j].Host {
+			return statuses[i].Host < statuses[j].Host
+		}
+		if statuses[i].Path != statuses[j].Path {
+			return statuses[i].Path < statuses[j].Path
+		}
+		if statuses[i].IP != statuses[j].IP {
+			return statuses[i].IP < statuses[j].IP
+		}
+		return statuses[i].Port < statuses[j].Port
+	})
+	return statuses, nil
+}
+
+// getLoadBalancer get loadbalancer config by id
+func (o *OnPremLB) getLoadBalancer(region, lbID string) (*LoadBalancerConfig, error) {
+	if len(lbID) == 0 {
+		return nil, fmt.Errorf("loadbalancer id is empty")
+	}
+	lb, ok := o.lbMap[lbID]
+	if !ok {
+		return nil, fmt.Errorf("loadbalancer %s not found", lbID)
+	}
+	if len(region) != 0 && lb.Region != region {
+		return nil, fmt.Errorf("loadbalancer %s is in region %s, not in %s", lbID, lb.Region, region)
+	}
+	return lb, nil
+}
+
+// doOnAgents call fn on all the agents of loadbalancer concurrently, returns error if any agent failed
+func (o *OnPremLB) doOnAgents(lb *LoadBalancerConfig, fn func(agent string) error) error {
+	errCh := make(chan error, len(lb.Agents))
+	wg := sync.WaitGroup{}
+	wg.Add(len(lb.Agents))
+	for _, agent := range lb.Agents {
+		go func(agent string) {
+			defer wg.Done()
+			if err := fn(agent); err != nil {
+				errCh <- err
+			}
+		}(agent)
+	}
+	wg.Wait()
+	close(errCh)
+
+	var errMsgs []string
+	for err := range errCh {
+		errMsgs = append(errMsgs, err.Error())
+	}
+	if len(errMsgs) != 0 {
+		return fmt.Errorf("%d of %d agents of lb %s failed, errs [%s]", len(errMsgs), len(lb.Agents), lb.ID,
+			strings.Join(errMsgs, "; "))
+	}
+	return nil
+}
+
+// getListenerID listener id is generated from protocol and ports, so that it is stable on all the agents
+func getListenerID(listener *networkextensionv1.Listener) string {
+	protocol := strings.ToLower(listener.Spec.Protocol)
+	if listener.Spec.EndPort > 0 {
+		return fmt.Sprintf("%s-%d-%d", protocol, listener.Spec.Port, listener.Spec.EndPort)
+	}
+	return fmt.Sprintf("%s-%d", protocol, listener.Spec.Port)
+}
+
+// convertListener convert listener to the listener of agent protocol
+func convertListener(listener *networkextensionv1.Listener) (*AgentListener, error) {
+	agentListener := &AgentListener{
+		ListenerID:  getListenerID(listener),
+		Name:        listener.GetName(),
+		Namespace:   listener.GetNamespace(),
+		Protocol:    strings.ToUpper(listener.Spec.Protocol),
+		Port:        listener.Spec.Port,
+		EndPort:     listener.Spec.EndPort,
+		Attribute:   listener.Spec.ListenerAttribute,
+		Certificate: listener.Spec.Certificate,
+	}
+	switch agentListener.Protocol {
+	case ProtocolHTTP, ProtocolHTTPS:
+		for _, rule := range listener.Spec.Rules {
+			agentRule := AgentListenerRule{
+				Domain:    rule.Domain,
+				Path:      rule.Path,
+				Attribute: rule.ListenerAttribute,
+			}
+			if rule.TargetGroup != nil {
+				agentRule.Backends = sortBackends(rule.TargetGroup.Backends)
+			}
+			agentListener.Rules = append(agentListener.Rules, agentRule)
+		}
+	case ProtocolTCP, ProtocolUDP:
+		if listener.Spec.TargetGroup != nil {
+			agentListener.Backends = sortBackends(listener.Spec.TargetGroup.Backends)
+		}
+	default:
+		blog.Errorf("invalid protocol %s", listener.Spec.Protocol)
+		return nil, fmt.Errorf("invalid protocol %s", listener.Spec.Protocol)
+	}
+	return agentListener, nil
+}
+
+// sortBackends returns sorted copy of backends, so that agents can compare them with the programmed ones
+func sortBackends(backends []networkextensionv1.ListenerBackend) []networkextensionv1.ListenerBackend {
+	sorted := make(networkextensionv1.ListenerBackendList, len(backends))
+	copy(sorted, backends)
+	sort.Sort(sorted)
+	return sorted
+}
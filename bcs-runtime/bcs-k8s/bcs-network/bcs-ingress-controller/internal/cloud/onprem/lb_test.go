@@ -0,0 +1,283 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package onprem
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-ingress-controller/internal/cloud"
+	networkextensionv1 "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/kubernetes/apis/networkextension/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// fakeAgent lb agent which records the programmed listeners
+type fakeAgent struct {
+	mutex     sync.Mutex
+	failed    bool
+	listeners map[string]*AgentListener
+	health    []*AgentBackendHealth
+}
+
+func newFakeAgent() *fakeAgent {
+	return &fakeAgent{listeners: make(map[string]*AgentListener)}
+}
+
+func (fa *fakeAgent) setFailed(failed bool) {
+	fa.mutex.Lock()
+	defer fa.mutex.Unlock()
+	fa.failed = failed
+}
+
+func (fa *fakeAgent) setHealth(health []*AgentBackendHealth) {
+	fa.mutex.Lock()
+	defer fa.mutex.Unlock()
+	fa.health = health
+}
+
+func (fa *fakeAgent) getListeners() map[string]*AgentListener {
+	fa.mutex.Lock()
+	defer fa.mutex.Unlock()
+	listeners := make(map[string]*AgentListener, len(fa.listeners))
+	for id, li := range fa.listeners {
+		listeners[id] = li
+	}
+	return listeners
+}
+
+func (fa *fakeAgent) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	fa.mutex.Lock()
+	defer fa.mutex.Unlock()
+	if fa.failed {
+		rw.WriteHeader(http.StatusInternalServerError)
+		_ = json.NewEncoder(rw).Encode(&AgentErrorResponse{Message: "agent failed"})
+		return
+	}
+	switch {
+	case req.Method == http.MethodPut && strings.HasSuffix(req.URL.Path, "/listeners"):
+		list := &AgentListenerList{}
+		if err := json.NewDecoder(req.Body).Decode(list); err != nil {
+			rw.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		for _, li := range list.Listeners {
+			fa.listeners[li.ListenerID] = li
+		}
+	case req.Method == http.MethodPost && strings.HasSuffix(req.URL.Path, "/listeners/delete"):
+		delReq := &AgentDeleteRequest{}
+		if err := json.NewDecoder(req.Body).Decode(delReq); err != nil {
+			rw.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		for _, id := range delReq.ListenerIDs {
+			delete(fa.listeners, id)
+		}
+	case req.Method == http.MethodGet && strings.HasSuffix(req.URL.Path, "/health"):
+		_ = json.NewEncoder(rw).Encode(&AgentHealthResponse{Backends: fa.health})
+	default:
+		rw.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newTestOnPremLB(t *testing.T, agentNum int) (*OnPremLB, []*fakeAgent) {
+	lbConfig := &LoadBalancerConfig{
+		ID:     "lb-1",
+		Region: "idc-1",
+		VIPs:   []string{"10.0.0.100"},
+	}
+	var agents []*fakeAgent
+	for i := 0; i < agentNum; i++ {
+		agent := newFakeAgent()
+		server := httptest.NewServer(agent)
+		t.Cleanup(server.Close)
+		agents = append(agents, agent)
+		lbConfig.Agents = append(lbConfig.Agents, server.URL)
+	}
+	config := &Config{LoadBalancers: []*LoadBalancerConfig{lbConfig}}
+	if err := config.validate(); err != nil {
+		t.Fatalf("validate config failed, err %s", err.Error())
+	}
+	agentCli, err := NewHTTPAgentClient(&AgentClientOption{Timeout: time.Second})
+	if err != nil {
+		t.Fatalf("create agent client failed, err %s", err.Error())
+	}
+	return newOnPremLB(config, agentCli), agents
+}
+
+func newTestListener(name, protocol string, port, endPort int) *networkextensionv1.Listener {
+	return &networkextensionv1.Listener{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		},
+		Spec: networkextensionv1.ListenerSpec{
+			LoadbalancerID: "lb-1",
+			Port:           port,
+			EndPort:        endPort,
+			Protocol:       protocol,
+			TargetGroup: &networkextensionv1.ListenerTargetGroup{
+				Backends: []networkextensionv1.ListenerBackend{
+					{IP: "127.0.0.2", Port: 8080, Weight: 10},
+					{IP: "127.0.0.1", Port: 8080, Weight: 10},
+				},
+			},
+		},
+	}
+}
+
+// TestLoadConfig test function LoadConfig
+func TestLoadConfig(t *testing.T) {
+	config, err := LoadConfig([]byte(`{"loadBalancers": [{"id": "lb-1", "region": "idc-1",
+		"vips": ["10.0.0.100"], "agents": ["10.0.0.1:9090", "http://10.0.0.2:9090/"]}]}`))
+	if err != nil {
+		t.Fatalf("load config failed, err %s", err.Error())
+	}
+	lb := config.LoadBalancers[0]
+	if !reflect.DeepEqual(lb.Agents, []string{"http://10.0.0.1:9090", "http://10.0.0.2:9090"}) {
+		t.Errorf("unexpected agents %v", lb.Agents)
+	}
+	if lb.Name != "lb-1" {
+		t.Errorf("expect default name lb-1, but get %s", lb.Name)
+	}
+
+	invalidConfigs := []string{
+		`{"loadBalancers": []}`,
+		`{"loadBalancers": [{"id": "lb-1", "region": "idc-1", "vips": ["10.0.0.100"]}]}`,
+		`{"loadBalancers": [{"id": "lb-1", "region": "idc-1", "vips": ["10.0.0.100"], "agents": ["a"]},
+			{"id": "lb-1", "region": "idc-1", "vips": ["10.0.0.101"], "agents": ["b"]}]}`,
+	}
+	for _, data := range invalidConfigs {
+		if _, err := LoadConfig([]byte(data)); err == nil {
+			t.Errorf("expect error for config %s", data)
+		}
+	}
+}
+
+// TestEnsureAndDeleteListeners test listeners are programmed to all the agents
+func TestEnsureAndDeleteListeners(t *testing.T) {
+	lb, agents := newTestOnPremLB(t, 2)
+
+	listenerID, err := lb.EnsureListener("idc-1", newTestListener("li-1", "TCP", 8000, 0))
+	if err != nil {
+		t.Fatalf("ensure listener failed, err %s", err.Error())
+	}
+	if listenerID != "tcp-8000" {
+		t.Errorf("expect listener id tcp-8000, but get %s", listenerID)
+	}
+	idMap, err := lb.EnsureMultiSegmentListeners("idc-1", "lb-1", []*networkextensionv1.Listener{
+		newTestListener("li-2", "UDP", 9000, 9009),
+		newTestListener("li-3", "QUIC", 9010, 9019),
+	})
+	if err != nil {
+		t.Fatalf("ensure multi segment listeners failed, err %s", err.Error())
+	}
+	if !reflect.DeepEqual(idMap, map[string]string{"li-2": "udp-9000-9009"}) {
+		t.Errorf("unexpected listener id map %v", idMap)
+	}
+	for _, agent := range agents {
+		listeners := agent.getListeners()
+		if len(listeners) != 2 {
+			t.Fatalf("expect 2 listeners on agent, but get %d", len(listeners))
+		}
+		backends := listeners["tcp-8000"].Backends
+		if backends[0].IP != "127.0.0.1" || backends[1].IP != "127.0.0.2" {
+			t.Errorf("backends are not sorted, %v", backends)
+		}
+	}
+
+	if err := lb.DeleteMultiListeners("idc-1", "lb-1", []*networkextensionv1.Listener{
+		newTestListener("li-1", "TCP", 8000, 0),
+		newTestListener("li-2", "UDP", 9000, 9009),
+	}); err != nil {
+		t.Fatalf("delete multi listeners failed, err %s", err.Error())
+	}
+	for _, agent := range agents {
+		if listeners := agent.getListeners(); len(listeners) != 0 {
+			t.Errorf("expect no listener on agent, but get %d", len(listeners))
+		}
+	}
+
+	// failure of any agent fails the whole request
+	agents[1].setFailed(true)
+	if _, err := lb.EnsureListener("idc-1", newTestListener("li-1", "TCP", 8000, 0)); err == nil {
+		t.Errorf("expect error when agent failed")
+	}
+	if _, err := lb.EnsureListener("idc-2", newTestListener("li-1", "TCP", 8000, 0)); err == nil {
+		t.Errorf("expect error when region mismatched")
+	}
+}
+
+// TestDescribeBackendStatus test backend health of agents are merged
+func TestDescribeBackendStatus(t *testing.T) {
+	lb, agents := newTestOnPremLB(t, 2)
+	newHealth := func(ip, status string) *AgentBackendHealth {
+		return &AgentBackendHealth{
+			ListenerID:   "tcp-8000",
+			ListenerPort: 8000,
+			Protocol:     "TCP",
+			IP:           ip,
+			Port:         8080,
+			Status:       status,
+		}
+	}
+	agents[0].setHealth([]*AgentBackendHealth{
+		newHealth("127.0.0.1", cloud.BackendHealthStatusHealthy),
+		newHealth("127.0.0.2", cloud.BackendHealthStatusHealthy),
+		newHealth("127.0.0.3", cloud.BackendHealthStatusHealthy),
+	})
+	agents[1].setHealth([]*AgentBackendHealth{
+		newHealth("127.0.0.1", cloud.BackendHealthStatusHealthy),
+		newHealth("127.0.0.2", cloud.BackendHealthStatusUnhealthy),
+	})
+
+	statusMap, err := lb.DescribeBackendStatus("idc-1", "default", []string{"lb-1", "lb-2"})
+	if err != nil {
+		t.Fatalf("describe backend status failed, err %s", err.Error())
+	}
+	if _, ok := statusMap["lb-2"]; ok {
+		t.Errorf("unknown lb should be skipped")
+	}
+	statuses := statusMap["lb-1"]
+	expectedStatuses := []string{
+		cloud.BackendHealthStatusHealthy, cloud.BackendHealthStatusUnhealthy, cloud.BackendHealthStatusUnknown,
+	}
+	if len(statuses) != len(expectedStatuses) {
+		t.Fatalf("expect %d statuses, but get %d", len(expectedStatuses), len(statuses))
+	}
+	for index, status := range statuses {
+		if status.Status != expectedStatuses[index] {
+			t.Errorf("expect status %s of %s, but get %s", expectedStatuses[index], status.IP, status.Status)
+		}
+		if status.Namespace != "default" || status.ListenerPort != 8000 {
+			t.Errorf("unexpected status %+v", status)
+		}
+	}
+
+	// backends are unknown when one of agents failed
+	agents[1].setFailed(true)
+	statusMap, err = lb.DescribeBackendStatus("idc-1", "default", []string{"lb-1"})
+	if err != nil {
+		t.Fatalf("describe backend status failed, err %s", err.Error())
+	}
+	for _, status := range statusMap["lb-1"] {
+		if status.Status != cloud.BackendHealthStatusUnknown {
+			t.Errorf("expect unknown status of %s, but get %s", status.IP, status.Status)
+		}
+	}
+}
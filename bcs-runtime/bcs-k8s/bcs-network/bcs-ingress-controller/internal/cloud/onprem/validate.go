@@ -0,0 +1,234 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package onprem
+
+import (
+	"fmt"
+
+	networkextensionv1 "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/kubernetes/apis/networkextension/v1"
+)
+
+const (
+	// LbPolicyWRR weighted round robin
+	LbPolicyWRR = "WRR"
+	// LbPolicyLeastConn weighted least connection
+	LbPolicyLeastConn = "LEAST_CONN"
+	// LbPolicySourceHash source ip hash
+	LbPolicySourceHash = "SOURCE_HASH"
+
+	// CertModeUnidirectional unidirectional tls
+	CertModeUnidirectional = "UNIDIRECTIONAL"
+	// CertModeMutual mutual tls
+	CertModeMutual = "MUTUAL"
+)
+
+// OnPremValidater validates the parameters for self-hosted loadbalancer
+type OnPremValidater struct{}
+
+// NewOnPremValidater creates a new validater for self-hosted loadbalancer
+func NewOnPremValidater() *OnPremValidater {
+	return &OnPremValidater{}
+}
+
+// IsIngressValid check bcs ingress parameter
+func (ov *OnPremValidater) IsIngressValid(ingress *networkextensionv1.Ingress) (bool, string) {
+	if ingress == nil {
+		return false, "ingress cannot be empty"
+	}
+	for i := range ingress.Spec.Rules {
+		if ok, msg := ov.validateIngressRule(&ingress.Spec.Rules[i]); !ok {
+			return ok, msg
+		}
+	}
+
+	for i := range ingress.Spec.PortMappings {
+		if ok, msg := ov.validateListenerMapping(&ingress.Spec.PortMappings[i]); !ok {
+			return ok, msg
+		}
+	}
+	return true, ""
+}
+
+// CheckNoConflictsInIngress return true, if there is no conflicts in ingress itself
+func (ov *OnPremValidater) CheckNoConflictsInIngress(ingress *networkextensionv1.Ingress) (bool, string) {
+	ruleMap := make(map[int]networkextensionv1.IngressRule)
+	portReuseMap := make(map[int]struct{})
+	for index, rule := range ingress.Spec.Rules {
+		existedRule, ok := ruleMap[rule.Port]
+		if !ok {
+			ruleMap[rule.Port] = ingress.Spec.Rules[index]
+			continue
+		}
+		// udp and tcp listener can use the same port, as listener id contains protocol
+		if (rule.Protocol == ProtocolTCP && existedRule.Protocol == ProtocolUDP) ||
+			(existedRule.Protocol == ProtocolTCP && rule.Protocol == ProtocolUDP) {
+			if _, ok := portReuseMap[rule.Port]; !ok {
+				portReuseMap[rule.Port] = struct{}{}
+				continue
+			}
+		}
+		return false, fmt.Sprintf("%+v conflicts with %+v", rule, existedRule)
+	}
+
+	for i := 0; i < len(ingress.Spec.PortMappings)-1; i++ {
+		mapping := ingress.Spec.PortMappings[i]
+		for port, rule := range ruleMap {
+			if port >= mapping.StartPort+mapping.StartIndex && port < mapping.StartPort+mapping.EndIndex {
+				return false, fmt.Sprintf("%+v port conflicts with %+v", mapping, rule)
+			}
+		}
+		for j := i + 1; j < len(ingress.Spec.PortMappings); j++ {
+			tmpMapping := ingress.Spec.PortMappings[j]
+			if mapping.StartPort+mapping.StartIndex > tmpMapping.StartPort+tmpMapping.EndIndex ||
+				mapping.StartPort+mapping.EndIndex < tmpMapping.StartPort+tmpMapping.StartIndex {
+				continue
+			}
+			return false, fmt.Sprintf("%+v ports conflicts with %+v", mapping, tmpMapping)
+		}
+	}
+	return true, ""
+}
+
+// validateIngressRule check ingress rule
+func (ov *OnPremValidater) validateIngressRule(rule *networkextensionv1.IngressRule) (bool, string) {
+	if rule.Port <= 0 || rule.Port >= 65536 {
+		return false, fmt.Sprintf("invalid port %d, available [1-65535]", rule.Port)
+	}
+	if rule.Protocol != ProtocolHTTP &&
+		rule.Protocol != ProtocolHTTPS &&
+		rule.Protocol != ProtocolTCP &&
+		rule.Protocol != ProtocolUDP {
+		return false, fmt.Sprintf("invalid protocol %s, available [http, https, tcp, udp]", rule.Protocol)
+	}
+	if rule.Protocol == ProtocolHTTPS {
+		if rule.Certificate == nil {
+			return false, "certificate cannot be empty for protocol https"
+		}
+		if ok, msg := ov.validateCertificate(rule.Certificate); !ok {
+			return ok, msg
+		}
+	}
+	if rule.ListenerAttribute != nil {
+		if ok, msg := ov.validateListenerAttribute(rule.ListenerAttribute); !ok {
+			return ok, msg
+		}
+	}
+	if rule.Protocol == ProtocolHTTP || rule.Protocol == ProtocolHTTPS {
+		for i := range rule.Routes {
+			if ok, msg := ov.validateListenerRoute(&rule.Routes[i]); !ok {
+				return ok, msg
+			}
+		}
+	}
+	return true, ""
+}
+
+func (ov *OnPremValidater) validateListenerRoute(r *networkextensionv1.Layer7Route) (bool, string) {
+	if len(r.Domain) == 0 {
+		return false, "domain cannot be empty for 7 layer listener"
+	}
+	if r.ListenerAttribute != nil {
+		if ok, msg := ov.validateListenerAttribute(r.ListenerAttribute); !ok {
+			return ok, msg
+		}
+	}
+	return true, ""
+}
+
+func (ov *OnPremValidater) validatePortMappingRoute(r *networkextensionv1.IngressPortMappingLayer7Route) (
+	bool, string) {
+	if len(r.Domain) == 0 {
+		return false, "domain cannot be empty for 7 layer listener"
+	}
+	if r.ListenerAttribute != nil {
+		if ok, msg := ov.validateListenerAttribute(r.ListenerAttribute); !ok {
+			return ok, msg
+		}
+	}
+	return true, ""
+}
+
+// validateListenerAttribute check listener attribute
+func (ov *OnPremValidater) validateListenerAttribute(attr *networkextensionv1.IngressListenerAttribute) (
+	bool, string) {
+	if attr.SessionTime < 0 {
+		return false, fmt.Sprintf("invalid session time %d, session time cannot be negative", attr.SessionTime)
+	}
+	if len(attr.LbPolicy) != 0 &&
+		attr.LbPolicy != LbPolicyWRR &&
+		attr.LbPolicy != LbPolicyLeastConn &&
+		attr.LbPolicy != LbPolicySourceHash {
+		return false, fmt.Sprintf("invalid lb policy %s, available [%s, %s, %s]", attr.LbPolicy,
+			LbPolicyWRR, LbPolicyLeastConn, LbPolicySourceHash)
+	}
+	if attr.HealthCheck != nil && attr.HealthCheck.Enabled {
+		if attr.HealthCheck.HealthNum < 0 || attr.HealthCheck.UnHealthNum < 0 {
+			return false, "healthNum and unHealthNum cannot be negative"
+		}
+		if attr.HealthCheck.IntervalTime < 0 || attr.HealthCheck.Timeout < 0 {
+			return false, "intervalTime and timeout cannot be negative"
+		}
+		if attr.HealthCheck.IntervalTime != 0 && attr.HealthCheck.Timeout > attr.HealthCheck.IntervalTime {
+			return false, fmt.Sprintf("invalid timeout %d, timeout must be lower than or equal to the interval",
+				attr.HealthCheck.Timeout)
+		}
+	}
+	return true, ""
+}
+
+// validateCertificate check listener certificate
+func (ov *OnPremValidater) validateCertificate(certs *networkextensionv1.IngressListenerCertificate) (bool, string) {
+	if len(certs.Mode) != 0 && certs.Mode != CertModeUnidirectional && certs.Mode != CertModeMutual {
+		return false, fmt.Sprintf("invalid tls mode %s, available [%s, %s]", certs.Mode,
+			CertModeUnidirectional, CertModeMutual)
+	}
+	if len(certs.CertID) == 0 {
+		return false, "certID cannot be empty"
+	}
+	if certs.Mode == CertModeMutual && len(certs.CertCaID) == 0 {
+		return false, "certCaID cannot be empty"
+	}
+	return true, ""
+}
+
+// validateListenerMapping check listener mapping
+func (ov *OnPremValidater) validateListenerMapping(mapping *networkextensionv1.IngressPortMapping) (bool, string) {
+	switch mapping.Protocol {
+	case ProtocolHTTP, ProtocolHTTPS:
+		if len(mapping.Routes) == 0 {
+			return false, fmt.Sprintf("no routes in 7 layer mapping, startPort %d", mapping.StartPort)
+		}
+		for index := range mapping.Routes {
+			if ok, msg := ov.validatePortMappingRoute(&mapping.Routes[index]); !ok {
+				return ok, msg
+			}
+		}
+		if mapping.Protocol == ProtocolHTTPS {
+			if mapping.Certificate == nil {
+				return false, "no certificate for https listener"
+			}
+			if ok, msg := ov.validateCertificate(mapping.Certificate); !ok {
+				return ok, msg
+			}
+		}
+	case ProtocolTCP, ProtocolUDP:
+		if mapping.ListenerAttribute != nil {
+			if ok, msg := ov.validateListenerAttribute(mapping.ListenerAttribute); !ok {
+				return ok, msg
+			}
+		}
+	default:
+		return false, fmt.Sprintf("invalid mapping protocol %s", mapping.Protocol)
+	}
+	return true, ""
+}
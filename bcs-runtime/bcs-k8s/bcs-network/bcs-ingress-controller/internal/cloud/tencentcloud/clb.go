@@ -157,6 +157,44 @@ func (c *Clb) IsNamespaced() bool {
 	return false
 }
 
+// CreateLoadBalancer create clb loadbalancer
+func (c *Clb) CreateLoadBalancer(region string, opt *cloud.LoadBalanceCreateOption) (*cloud.LoadBalanceObject, error) {
+	if opt == nil {
+		return nil, fmt.Errorf("create option cannot be empty")
+	}
+	req := tclb.NewCreateLoadBalancerRequest()
+	lbType := opt.Type
+	if len(lbType) == 0 {
+		lbType = ClbLoadBalancerTypeOpen
+	}
+	req.LoadBalancerType = tcommon.StringPtr(lbType)
+	req.Forward = tcommon.Int64Ptr(1)
+	req.LoadBalancerName = tcommon.StringPtr(opt.Name)
+	if len(opt.VpcID) != 0 {
+		req.VpcId = tcommon.StringPtr(opt.VpcID)
+	}
+	if len(opt.SubnetID) != 0 {
+		req.SubnetId = tcommon.StringPtr(opt.SubnetID)
+	}
+
+	ctime := time.Now()
+	lbIDs, err := c.sdkWrapper.CreateLoadBalancer(region, req)
+	if err != nil {
+		cloud.StatRequest("CreateLoadBalancer", cloud.MetricAPIFailed, ctime, time.Now())
+		return nil, err
+	}
+	cloud.StatRequest("CreateLoadBalancer", cloud.MetricAPISuccess, ctime, time.Now())
+	if len(lbIDs) == 0 {
+		return nil, fmt.Errorf("no loadbalancer id returned for request %s", req.ToJsonString())
+	}
+	return &cloud.LoadBalanceObject{
+		LbID:   lbIDs[0],
+		Region: region,
+		Name:   opt.Name,
+		Type:   lbType,
+	}, nil
+}
+
 // EnsureListener ensure listener to cloud, and get listener info
 func (c *Clb) EnsureListener(region string, listener *networkextensionv1.Listener) (string, error) {
 	cloudListener, err := c.getListenerInfoByPort(region, listener.Spec.LoadbalancerID,
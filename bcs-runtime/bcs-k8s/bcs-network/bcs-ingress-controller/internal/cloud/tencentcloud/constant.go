@@ -22,6 +22,11 @@ const (
 	// ClbProtocolUDP clb udp protocol
 	ClbProtocolUDP = "UDP"
 
+	// ClbLoadBalancerTypeOpen clb loadbalancer type for public network
+	ClbLoadBalancerTypeOpen = "OPEN"
+	// ClbLoadBalancerTypeInternal clb loadbalancer type for private network
+	ClbLoadBalancerTypeInternal = "INTERNAL"
+
 	// just for v2 api
 
 	// ClbListenerProtocolHTTP clb listener http protocol
@@ -313,6 +313,53 @@ func (sw *SdkWrapper) DescribeLoadBalancers(region string, req *tclb.DescribeLoa
 	return resp, nil
 }
 
+// CreateLoadBalancer wrap CreateLoadBalancer, returns ids of created loadbalancers
+func (sw *SdkWrapper) CreateLoadBalancer(region string, req *tclb.CreateLoadBalancerRequest) ([]string, error) {
+	blog.V(3).Infof("CreateLoadBalancer request: %s", req.ToJsonString())
+	var err error
+	var resp *tclb.CreateLoadBalancerResponse
+
+	startTime := time.Now()
+	mf := func(ret string) {
+		metrics.ReportLibRequestMetric(
+			SystemNameInMetricTencentCloud,
+			HandlerNameInMetricTencentCloudSDK,
+			"CreateLoadBalancer", ret, startTime)
+	}
+
+	counter := 1
+	for ; counter <= maxRetry; counter++ {
+		blog.V(3).Infof("CreateLoadBalancer try %d/%d", counter, maxRetry)
+		sw.tryThrottle()
+		clbCli, inErr := sw.getRegionClient(region)
+		if inErr != nil {
+			mf(metrics.LibCallStatusErr)
+			return nil, inErr
+		}
+		resp, err = clbCli.CreateLoadBalancer(req)
+		if err != nil {
+			if terr, ok := err.(*terrors.TencentCloudSDKError); ok {
+				sw.checkErrCode(terr)
+				if terr.Code == RequestLimitExceededCode {
+					continue
+				}
+			}
+			mf(metrics.LibCallStatusErr)
+			blog.Errorf("CreateLoadBalancer failed, err %s", err.Error())
+			return nil, fmt.Errorf("CreateLoadBalancer failed, err %s", err.Error())
+		}
+		blog.V(3).Infof("CreateLoadBalancer response: %s", resp.ToJsonString())
+		break
+	}
+	if counter > maxRetry {
+		mf(metrics.LibCallStatusTimeout)
+		blog.Errorf("CreateLoadBalancer out of maxRetry %d", maxRetry)
+		return nil, fmt.Errorf("CreateLoadBalancer out of maxRetry %d", maxRetry)
+	}
+	mf(metrics.LibCallStatusOK)
+	return tcommon.StringValues(resp.Response.LoadBalancerIds), nil
+}
+
 // CreateListener wrap CreateListener, length of Ports should be less than 50
 func (sw *SdkWrapper) CreateListener(region string, req *tclb.CreateListenerRequest) ([]string, error) {
 	rounds := len(req.ListenerNames) / MaxListenersForCreateEachTime
@@ -146,4 +146,6 @@ func (cc *CloudCollector) update() {
 	}
 	//update status to cache
 	cc.cache.UpdateCache(totalStatusMap)
+	// report backend health to listener status
+	cc.updateListenerHealth(totalStatusMap)
 }
@@ -0,0 +1,124 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cloudcollector
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sort"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-ingress-controller/internal/cloud"
+	networkextensionv1 "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/kubernetes/apis/networkextension/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// updateListenerHealth updates backend health status of listeners, only the listeners of loadbalancers
+// which report backend status are updated
+func (cc *CloudCollector) updateListenerHealth(totalStatusMap map[string][]*cloud.BackendHealthStatus) {
+	listenerList := &networkextensionv1.ListenerList{}
+	if err := cc.k8sClient.List(context.Background(), listenerList); err != nil {
+		blog.Errorf("list listeners failed when update health status, err %s", err.Error())
+		return
+	}
+	for i := range listenerList.Items {
+		listener := &listenerList.Items[i]
+		if len(listener.Status.ListenerID) == 0 {
+			continue
+		}
+		statusList, ok := totalStatusMap[listener.Spec.LoadbalancerID]
+		if !ok {
+			continue
+		}
+		healthStatus := buildListenerHealthStatus(listener.Status.ListenerID, statusList)
+		if reflect.DeepEqual(healthStatus, listener.Status.HealthStatus) {
+			continue
+		}
+		if err := cc.patchListenerHealth(listener, healthStatus); err != nil {
+			blog.Warnf("patch health status of listener %s/%s failed, err %s",
+				listener.GetNamespace(), listener.GetName(), err.Error())
+		}
+	}
+}
+
+func (cc *CloudCollector) patchListenerHealth(listener *networkextensionv1.Listener,
+	healthStatus *networkextensionv1.ListenerHealthStatus) error {
+	patchData, err := json.Marshal(map[string]interface{}{
+		"status": map[string]interface{}{
+			"healthStatus": healthStatus,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	updateListener := &networkextensionv1.Listener{}
+	updateListener.SetName(listener.GetName())
+	updateListener.SetNamespace(listener.GetNamespace())
+	return cc.k8sClient.Patch(context.Background(), updateListener,
+		client.RawPatch(k8stypes.MergePatchType, patchData), &client.PatchOptions{})
+}
+
+// buildListenerHealthStatus build health status of listener from backend status of loadbalancer
+func buildListenerHealthStatus(listenerID string,
+	statusList []*cloud.BackendHealthStatus) *networkextensionv1.ListenerHealthStatus {
+	type ruleKey struct {
+		domain string
+		path   string
+	}
+	ruleMap := make(map[ruleKey]*networkextensionv1.ListenerRuleHealthStatus)
+	for _, status := range statusList {
+		if status.ListenerID != listenerID {
+			continue
+		}
+		key := ruleKey{domain: status.Host, path: status.Path}
+		ruleHealth, ok := ruleMap[key]
+		if !ok {
+			ruleHealth = &networkextensionv1.ListenerRuleHealthStatus{
+				Domain: status.Host,
+				URL:    status.Path,
+			}
+			ruleMap[key] = ruleHealth
+		}
+		ruleHealth.Backends = append(ruleHealth.Backends, networkextensionv1.ListenerBackendHealthStatus{
+			IP:                 status.IP,
+			Port:               status.Port,
+			HealthStatus:       status.Status == cloud.BackendHealthStatusHealthy,
+			HealthStatusDetail: status.Status,
+		})
+	}
+	if len(ruleMap) == 0 {
+		return nil
+	}
+
+	healthStatus := &networkextensionv1.ListenerHealthStatus{}
+	for _, ruleHealth := range ruleMap {
+		backends := ruleHealth.Backends
+		sort.Slice(backends, func(i, j int) bool {
+			if backends[i].IP != backends[j].IP {
+				return backends[i].IP < backends[j].IP
+			}
+			return backends[i].Port < backends[j].Port
+		})
+		healthStatus.RulesHealth = append(healthStatus.RulesHealth, *ruleHealth)
+	}
+	rules := healthStatus.RulesHealth
+	sort.Slice(rules, func(i, j int) bool {
+		if rules[i].Domain != rules[j].Domain {
+			return rules[i].Domain < rules[j].Domain
+		}
+		return rules[i].URL < rules[j].URL
+	})
+	return healthStatus
+}
@@ -21,6 +21,8 @@ const (
 	CloudAWS = "aws"
 	// CloudGCP gcp cloud
 	CloudGCP = "gcp"
+	// CloudOnPrem self-hosted loadbalancers on premises
+	CloudOnPrem = "onprem"
 
 	// EnvNameIsTCPUDPPortReuse env name for option if the loadbalancer provider support tcp udp port reuse
 	// if enabled, we will find protocol info in 4 layer listener name
@@ -80,6 +82,9 @@ const (
 	AnnotationForPortPoolBindingStatus = "status.portpools.networkextension.bkbcs.tencent.com"
 	// AnnotationForPortPoolReadinessGate port pool readiness gate
 	AnnotationForPortPoolReadinessGate = "readinessgate.portpools.networkextension.bkbcs.tencent.com"
+	// AnnotationForPortPoolPendingItem name of the item being appended to port pool by auto expansion,
+	// it is recorded before the loadbalancer of the item is created
+	AnnotationForPortPoolPendingItem = "pendingitem.portpools.networkextension.bkbcs.tencent.com"
 
 	// ConditionTypeBcsIngressPortBinding readiness gate condition type for port binding of bcs-ingress-controller
 	ConditionTypeBcsIngressPortBinding = "networkextension.bkbcs.tencent.com/portbinding-ready"
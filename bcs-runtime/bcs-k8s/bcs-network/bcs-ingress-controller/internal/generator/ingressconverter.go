@@ -22,6 +22,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	gocache "github.com/patrickmn/go-cache"
+	k8scorev1 "k8s.io/api/core/v1"
 	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8slabels "k8s.io/apimachinery/pkg/labels"
 	k8stypes "k8s.io/apimachinery/pkg/types"
@@ -237,38 +238,40 @@ func (g *IngressConverter) getIngressLoadbalances(ingress *networkextensionv1.In
 	return lbs, nil
 }
 
-// ProcessUpdateIngress process newly added or updated ingress
-func (g *IngressConverter) ProcessUpdateIngress(ingress *networkextensionv1.Ingress) error {
+// ProcessUpdateIngress process newly added or updated ingress,
+// the returned bool value indicates whether ingress should be retried to wait for pod clb weights being synced
+func (g *IngressConverter) ProcessUpdateIngress(ingress *networkextensionv1.Ingress) (bool, error) {
 	isValid, errMsg := g.ingressValidater.IsIngressValid(ingress)
 	if !isValid {
 		blog.Errorf("ingress %+v ingress is invalid, err %s", ingress, errMsg)
-		return fmt.Errorf("ingress %+v ingress is invalid, err %s", ingress, errMsg)
+		return false, fmt.Errorf("ingress %+v ingress is invalid, err %s", ingress, errMsg)
 	}
 
 	isValid, errMsg = g.ingressValidater.CheckNoConflictsInIngress(ingress)
 	if !isValid {
 		blog.Errorf("ingress %+v ingress has conflicts, err %s", ingress, errMsg)
-		return fmt.Errorf("ingress %+v ingress has conflicts, err %s", ingress, errMsg)
+		return false, fmt.Errorf("ingress %+v ingress has conflicts, err %s", ingress, errMsg)
 	}
 
 	lbObjs, err := g.getIngressLoadbalances(ingress)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	for _, lbObj := range lbObjs {
 		isConflict, inErr := g.checkConflicts(lbObj.LbID, ingress)
 		if inErr != nil {
-			return inErr
+			return false, inErr
 		}
 		if isConflict {
 			blog.Errorf("ingress %+v is conflict with existed listeners", ingress)
-			return fmt.Errorf("ingress %+v is conflict with existed listeners", ingress)
+			return false, fmt.Errorf("ingress %+v is conflict with existed listeners", ingress)
 		}
 	}
 
 	var generatedListeners []networkextensionv1.Listener
 	var generatedSegListeners []networkextensionv1.Listener
+	var weightedPods []*k8scorev1.Pod
 	for _, rule := range ingress.Spec.Rules {
 		ruleConverter := NewRuleConverter(g.cli, lbObjs, ingress.GetName(), ingress.GetNamespace(), &rule)
 		ruleConverter.SetNamespaced(g.lbClient.IsNamespaced())
@@ -276,9 +279,10 @@ func (g *IngressConverter) ProcessUpdateIngress(ingress *networkextensionv1.Ingr
 		listeners, inErr := ruleConverter.DoConvert()
 		if inErr != nil {
 			blog.Errorf("convert rule %+v failed, err %s", rule, inErr.Error())
-			return fmt.Errorf("convert rule %+v failed, err %s", rule, inErr.Error())
+			return false, fmt.Errorf("convert rule %+v failed, err %s", rule, inErr.Error())
 		}
 		generatedListeners = append(generatedListeners, listeners...)
+		weightedPods = append(weightedPods, ruleConverter.GetWeightedPods()...)
 	}
 	for _, mapping := range ingress.Spec.PortMappings {
 		mappingConverter := NewMappingConverter(g.cli, lbObjs, ingress.GetName(), ingress.GetNamespace(), &mapping)
@@ -286,7 +290,7 @@ func (g *IngressConverter) ProcessUpdateIngress(ingress *networkextensionv1.Ingr
 		listeners, inErr := mappingConverter.DoConvert()
 		if inErr != nil {
 			blog.Errorf("convert mapping %+v failed, err %s", mapping, inErr.Error())
-			return fmt.Errorf("convert mapping %+v failed, err %s", mapping, inErr.Error())
+			return false, fmt.Errorf("convert mapping %+v failed, err %s", mapping, inErr.Error())
 		}
 		// if ignore segment, disable segment feature;
 		// if segment length is not set or equals to 1, disable segment feature;
@@ -301,25 +305,65 @@ func (g *IngressConverter) ProcessUpdateIngress(ingress *networkextensionv1.Ingr
 
 	existedListeners, err := g.getListeners(ingress.GetName(), ingress.GetNamespace())
 	if err != nil {
-		return err
+		return false, err
 	}
 	existedSegListeners, err := g.getSegmentListeners(ingress.GetName(), ingress.GetNamespace())
 	if err != nil {
-		return err
+		return false, err
 	}
-	err = g.syncListeners(ingress.GetName(), ingress.GetNamespace(),
+	changed, err := g.syncListeners(ingress.GetName(), ingress.GetNamespace(),
 		existedListeners, generatedListeners, existedSegListeners, generatedSegListeners)
 	if err != nil {
 		blog.Errorf("syncListeners listener of ingress %s/%s failed, err %s",
 			ingress.GetName(), ingress.GetNamespace(), err.Error())
-		return fmt.Errorf("syncListeners listener ingress %s/%s failed, err %s",
+		return false, fmt.Errorf("syncListeners listener ingress %s/%s failed, err %s",
 			ingress.GetName(), ingress.GetNamespace(), err.Error())
 	}
 	if err = g.patchIngressStatus(ingress, lbObjs); err != nil {
 		blog.Errorf("update ingress vips failed, err %s", err.Error())
-		return fmt.Errorf("update ingress vips failed, err %s", err.Error())
+		return false, fmt.Errorf("update ingress vips failed, err %s", err.Error())
 	}
-	return nil
+	return g.syncPodWeights(weightedPods, changed, append(existedListeners, existedSegListeners...))
+}
+
+// syncPodWeights notify pods that their clb weights have been synced to cloud,
+// the returned bool value indicates whether ingress should be retried to wait for listeners being synced
+func (g *IngressConverter) syncPodWeights(pods []*k8scorev1.Pod, listenerChanged bool,
+	existedListeners []networkextensionv1.Listener) (bool, error) {
+
+	var unsyncedPods []*k8scorev1.Pod
+	podSet := make(map[string]struct{})
+	for _, pod := range pods {
+		key := pod.GetNamespace() + "/" + pod.GetName()
+		if _, ok := podSet[key]; ok {
+			continue
+		}
+		podSet[key] = struct{}{}
+		if !IsPodLBWeightSynced(pod) {
+			unsyncedPods = append(unsyncedPods, pod)
+		}
+	}
+	if len(unsyncedPods) == 0 {
+		return false, nil
+	}
+	// new weights were just written to listeners, wait for them to be synced to cloud
+	if listenerChanged {
+		return true, nil
+	}
+	for _, listener := range existedListeners {
+		if listener.Status.Status != networkextensionv1.ListenerStatusSynced {
+			blog.V(3).Infof("listener %s/%s not synced, wait to sync pod clb weights",
+				listener.GetNamespace(), listener.GetName())
+			return true, nil
+		}
+	}
+	for _, pod := range unsyncedPods {
+		if err := PatchPodLBWeightSynced(g.cli, pod); err != nil {
+			blog.Warnf("sync clb weight of pod %s/%s failed, err %s", pod.GetNamespace(), pod.GetName(), err.Error())
+			return true, err
+		}
+	}
+	return false, nil
 }
 
 // update ingress loadbalancers fields
@@ -453,7 +497,7 @@ func (g *IngressConverter) getSegmentListeners(ingressName, ingressNamespace str
 
 func (g *IngressConverter) syncListeners(ingressName, ingressNamespace string,
 	existedListeners, listeners []networkextensionv1.Listener,
-	existedSegListeners, segListeners []networkextensionv1.Listener) error {
+	existedSegListeners, segListeners []networkextensionv1.Listener) (bool, error) {
 
 	adds, dels, olds, news := GetDiffListeners(existedListeners, listeners)
 	sadds, sdels, solds, snews := GetDiffListeners(existedSegListeners, segListeners)
@@ -466,7 +510,7 @@ func (g *IngressConverter) syncListeners(ingressName, ingressNamespace string,
 		err := g.cli.Delete(context.TODO(), &del, &client.DeleteOptions{})
 		if err != nil {
 			blog.Errorf("delete listener %+v failed, err %s", del, err.Error())
-			return fmt.Errorf("delete listener %+v failed, err %s", del, err.Error())
+			return false, fmt.Errorf("delete listener %+v failed, err %s", del, err.Error())
 		}
 	}
 	for _, add := range adds {
@@ -474,17 +518,21 @@ func (g *IngressConverter) syncListeners(ingressName, ingressNamespace string,
 		err := g.cli.Create(context.TODO(), &add, &client.CreateOptions{})
 		if err != nil {
 			blog.Errorf("create listener %+v failed, err %s", add, err.Error())
-			return fmt.Errorf("create listener %+v failed, err %s", add, err.Error())
+			return false, fmt.Errorf("create listener %+v failed, err %s", add, err.Error())
 		}
 	}
 	for index, new := range news {
 		blog.V(3).Infof("[generator] update listener %s/%s", new.GetNamespace(), new.GetName())
 		new.ResourceVersion = olds[index].ResourceVersion
+		// listener has no status subresource, keep the listener id and mark the new spec not synced,
+		// so that pod clb weights are not acknowledged until the worker syncs the new spec to cloud
+		new.Status = olds[index].Status
+		new.Status.Status = networkextensionv1.ListenerStatusNotSynced
 		err := g.cli.Update(context.TODO(), &new, &client.UpdateOptions{})
 		if err != nil {
 			blog.Errorf("update listener %+v failed, err %s", new, err.Error())
-			return fmt.Errorf("update listener %+v failed, err %s", new, err.Error())
+			return false, fmt.Errorf("update listener %+v failed, err %s", new, err.Error())
 		}
 	}
-	return nil
+	return len(adds) != 0 || len(dels) != 0 || len(news) != 0, nil
 }
@@ -1228,7 +1228,7 @@ func TestIngressConvert(t *testing.T) {
 
 			for _, ingress := range test.ingresses {
 				cli.Create(context.TODO(), &ingress)
-				err := ic.ProcessUpdateIngress(&ingress)
+				_, err := ic.ProcessUpdateIngress(&ingress)
 				if (err != nil && !test.hasErr) || (err == nil && test.hasErr) {
 					t.Errorf("expect %v, but err is %v", test.hasErr, err)
 				}
@@ -1250,3 +1250,45 @@ func TestIngressConvert(t *testing.T) {
 		})
 	}
 }
+
+// TestSyncListenersResetStatus test listener with changed spec is marked not synced
+func TestSyncListenersResetStatus(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	newScheme.AddKnownTypes(
+		networkextensionv1.GroupVersion,
+		&networkextensionv1.Listener{},
+		&networkextensionv1.ListenerList{})
+	cli := k8sfake.NewFakeClientWithScheme(newScheme)
+
+	existed := getExistedListeners()[0]
+	existed.Status = networkextensionv1.ListenerStatus{
+		ListenerID: "lbl-1",
+		Status:     networkextensionv1.ListenerStatusSynced,
+	}
+	if err := cli.Create(context.TODO(), &existed); err != nil {
+		t.Fatalf("create listener failed, err %s", err.Error())
+	}
+	created := &networkextensionv1.Listener{}
+	if err := cli.Get(context.TODO(), k8sclient.ObjectKey{Namespace: existed.GetNamespace(),
+		Name: existed.GetName()}, created); err != nil {
+		t.Fatalf("get listener failed, err %s", err.Error())
+	}
+
+	generated := getExistedListeners()[0]
+	generated.Spec.Protocol = "udp"
+	ic := &IngressConverter{cli: cli}
+	changed, err := ic.syncListeners("ingress1", "ns1", []networkextensionv1.Listener{*created},
+		[]networkextensionv1.Listener{generated}, nil, nil)
+	if err != nil || !changed {
+		t.Fatalf("expect listener changed, but changed %v, err %v", changed, err)
+	}
+
+	updated := &networkextensionv1.Listener{}
+	if err := cli.Get(context.TODO(), k8sclient.ObjectKey{Namespace: existed.GetNamespace(),
+		Name: existed.GetName()}, updated); err != nil {
+		t.Fatalf("get listener failed, err %s", err.Error())
+	}
+	if updated.Status.Status != networkextensionv1.ListenerStatusNotSynced || updated.Status.ListenerID != "lbl-1" {
+		t.Errorf("expect listener lbl-1 not synced, but get status %+v", updated.Status)
+	}
+}
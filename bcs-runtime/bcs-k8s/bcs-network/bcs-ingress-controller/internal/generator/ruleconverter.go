@@ -48,6 +48,8 @@ type RuleConverter struct {
 	isNamespaced bool
 	// if true, allow tcp listener and udp listener use same port
 	isTCPUDPPortReuse bool
+	// pods whose clb-weight annotation has been applied to listener backends
+	weightedPods []*k8scorev1.Pod
 }
 
 // NewRuleConverter create rule converter
@@ -79,6 +81,11 @@ func (rc *RuleConverter) SetTCPUDPPortReuse(isTCPUDPPortReuse bool) {
 	rc.isTCPUDPPortReuse = isTCPUDPPortReuse
 }
 
+// GetWeightedPods get pods whose clb-weight annotation has been applied to generated listeners
+func (rc *RuleConverter) GetWeightedPods() []*k8scorev1.Pod {
+	return rc.weightedPods
+}
+
 // DoConvert do convert action
 func (rc *RuleConverter) DoConvert() ([]networkextensionv1.Listener, error) {
 	var retListeners []networkextensionv1.Listener
@@ -331,9 +338,10 @@ func (rc *RuleConverter) getServiceBackendsFromPods(
 		if len(pod.Status.PodIP) == 0 {
 			continue
 		}
-		backendWeight := rc.getPodWeight(pod, weight)
+		backendWeight, weightApplied := rc.getPodWeight(pod, weight)
 		if pod.DeletionTimestamp != nil {
 			backendWeight = 0
+			weightApplied = false
 		}
 		// if container is unready, client should not visit this pod
 		if pod.Status.Phase == k8scorev1.PodRunning {
@@ -346,6 +354,7 @@ func (rc *RuleConverter) getServiceBackendsFromPods(
 			}
 			if !ready {
 				backendWeight = 0
+				weightApplied = false
 			}
 			blog.Infof("pod name %s namespace %s is running, backendWeight: %d", pod.Name, pod.Namespace, backendWeight)
 		}
@@ -374,6 +383,9 @@ func (rc *RuleConverter) getServiceBackendsFromPods(
 				break
 			}
 		}
+		if found && weightApplied {
+			rc.weightedPods = append(rc.weightedPods, pod)
+		}
 	}
 	return retBackends, nil
 }
@@ -408,7 +420,11 @@ func (rc *RuleConverter) getNodePortBackends(
 			Port:   int(svcPort.NodePort),
 			Weight: weight,
 		}
-		newBackend.Weight = rc.getPodWeight(pod, weight)
+		var weightApplied bool
+		newBackend.Weight, weightApplied = rc.getPodWeight(pod, weight)
+		if weightApplied {
+			rc.weightedPods = append(rc.weightedPods, pod)
+		}
 		backendMap[pod.Status.HostIP+strconv.Itoa(int(svcPort.NodePort))] = newBackend
 		retBackends = append(retBackends, newBackend)
 	}
@@ -436,22 +452,17 @@ func (rc *RuleConverter) getPodsByLabels(ns string, labels map[string]string) ([
 	return retPods, nil
 }
 
-// get pod clb-weight from annotations
-func (rc *RuleConverter) getPodWeight(pod *k8scorev1.Pod, weight int) int {
-	if clbWeightValue, ok := pod.Annotations[networkextensionv1.AnnotationKeyForLoadbalanceWeight]; ok {
-		clbWeight, err := strconv.Atoi(clbWeightValue)
-		if err != nil {
-			blog.Warnf("get pod %s/%s's clb-weight error: %s", pod.Namespace, pod.Name, err.Error())
-			return weight
-		}
-		err = rc.patchPodLBWeightReady(pod)
-		if err != nil {
-			blog.Warnf("patch pod %s/%s's clb-weight error: %s", pod.Namespace, pod.Name, err.Error())
-			return weight
-		}
-		return clbWeight
+// get pod clb-weight from annotations, the returned bool value indicates whether clb-weight is applied
+func (rc *RuleConverter) getPodWeight(pod *k8scorev1.Pod, weight int) (int, bool) {
+	clbWeight, ok := GetPodLBWeight(pod, weight)
+	if !ok {
+		return weight, false
+	}
+	if err := rc.patchPodLBWeightReady(pod); err != nil {
+		blog.Warnf("patch pod %s/%s's clb-weight error: %s", pod.Namespace, pod.Name, err.Error())
+		return weight, false
 	}
-	return weight
+	return clbWeight, true
 }
 
 // patch pod annotations for clb weight, if pod lb weight be set, then switch annotation ready to true
@@ -13,6 +13,7 @@
 package generator
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"reflect"
@@ -22,6 +23,8 @@ import (
 
 	k8scorev1 "k8s.io/api/core/v1"
 	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-ingress-controller/internal/constant"
@@ -249,3 +252,57 @@ func GetLabelLBId(lbID string) string {
 	}
 	return lbID
 }
+
+// GetPodLBWeight get pod clb weight from annotations,
+// return default weight and false if the annotation is not set or invalid
+func GetPodLBWeight(pod *k8scorev1.Pod, defaultWeight int) (int, bool) {
+	clbWeightValue, ok := pod.Annotations[networkextensionv1.AnnotationKeyForLoadbalanceWeight]
+	if !ok {
+		return defaultWeight, false
+	}
+	clbWeight, err := strconv.Atoi(clbWeightValue)
+	if err != nil {
+		blog.Warnf("get pod %s/%s's clb-weight error: %s", pod.Namespace, pod.Name, err.Error())
+		return defaultWeight, false
+	}
+	return clbWeight, true
+}
+
+// PatchPodLBWeightSynced patch pod annotation to notify that the clb weight of pod has been synced to cloud
+func PatchPodLBWeightSynced(cli client.Client, pod *k8scorev1.Pod) error {
+	clbWeightValue, ok := pod.Annotations[networkextensionv1.AnnotationKeyForLoadbalanceWeight]
+	if !ok || pod.Annotations[networkextensionv1.AnnotationKeyForLoadbalanceWeightSynced] == clbWeightValue {
+		return nil
+	}
+	patchStruct := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{
+				networkextensionv1.AnnotationKeyForLoadbalanceWeightSynced: clbWeightValue,
+			},
+		},
+	}
+	patchData, err := json.Marshal(patchStruct)
+	if err != nil {
+		return err
+	}
+	updatePod := &k8scorev1.Pod{
+		ObjectMeta: k8smetav1.ObjectMeta{
+			Name:      pod.GetName(),
+			Namespace: pod.GetNamespace(),
+		},
+	}
+	if err := cli.Patch(context.TODO(), updatePod, client.RawPatch(k8stypes.MergePatchType, patchData)); err != nil {
+		return fmt.Errorf("patch pod %s/%s clb weight synced failed, err %s",
+			pod.GetNamespace(), pod.GetName(), err.Error())
+	}
+	return nil
+}
+
+// IsPodLBWeightSynced return true if the clb weight of pod has been acknowledged
+func IsPodLBWeightSynced(pod *k8scorev1.Pod) bool {
+	clbWeightValue, ok := pod.Annotations[networkextensionv1.AnnotationKeyForLoadbalanceWeight]
+	if !ok {
+		return true
+	}
+	return pod.Annotations[networkextensionv1.AnnotationKeyForLoadbalanceWeightSynced] == clbWeightValue
+}
@@ -34,9 +34,30 @@ var (
 		Name:      "poolitem_portitem_used",
 		Help:      "port pool capacty",
 	}, []string{"poolkey", "itemname", "protocol"})
+	portPoolAllocationRateMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "bkbcs_ingressctrl",
+		Subsystem: "cache",
+		Name:      "poolitem_portitem_allocation_rate",
+		Help:      "net number of port items allocated per hour",
+	}, []string{"poolkey", "itemname", "protocol"})
+	portPoolExhaustionSecondsMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "bkbcs_ingressctrl",
+		Subsystem: "cache",
+		Name:      "poolitem_exhaustion_seconds",
+		Help:      "estimated seconds before port items are exhausted",
+	}, []string{"poolkey", "itemname", "protocol"})
+	portPoolAllocateFailedMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "bkbcs_ingressctrl",
+		Subsystem: "cache",
+		Name:      "pool_allocate_failed_total",
+		Help:      "counter of failed port allocations from port pool",
+	}, []string{"poolkey", "protocol"})
 )
 
 func init() {
 	metrics.Registry.MustRegister(portPoolCapacityMetric)
 	metrics.Registry.MustRegister(portPoolAllocatedMetric)
+	metrics.Registry.MustRegister(portPoolAllocationRateMetric)
+	metrics.Registry.MustRegister(portPoolExhaustionSecondsMetric)
+	metrics.Registry.MustRegister(portPoolAllocateFailedMetric)
 }
@@ -17,6 +17,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-ingress-controller/internal/constant"
 	networkextensionv1 "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/kubernetes/apis/networkextension/v1"
 )
 
@@ -24,12 +25,15 @@ import (
 type Cache struct {
 	sync.Mutex
 	portPoolMap map[string]*CachePool
+	// key: pool key, value: last time when allocation from pool failed
+	allocateFailedTimeMap map[string]time.Time
 }
 
 // NewCache create new cache
 func NewCache() *Cache {
 	return &Cache{
-		portPoolMap: make(map[string]*CachePool),
+		portPoolMap:           make(map[string]*CachePool),
+		allocateFailedTimeMap: make(map[string]time.Time),
 	}
 }
 
@@ -39,18 +43,9 @@ func (c *Cache) Start() {
 	for {
 		select {
 		case <-ticker.C:
-			portPoolCapacityMetric.Reset()
-			portPoolAllocatedMetric.Reset()
-			for poolKey, pool := range c.portPoolMap {
-				for _, item := range pool.ItemList {
-					for protocol, list := range item.PortListMap {
-						portPoolCapacityMetric.WithLabelValues(poolKey, item.ItemStatus.ItemName, protocol).
-							Set(float64(list.GetAvailabePortNum()))
-						portPoolAllocatedMetric.WithLabelValues(poolKey, item.ItemStatus.ItemName, protocol).
-							Set(float64(list.GetAllocatedPortNum()))
-					}
-				}
-			}
+			c.Lock()
+			c.recordUsage(time.Now())
+			c.Unlock()
 		}
 	}
 }
@@ -91,6 +86,7 @@ func (c *Cache) DeletePortPoolItem(poolKey, poolItemKey string) {
 	pool.DeletePoolItem(poolItemKey)
 	if len(pool.ItemList) == 0 {
 		delete(c.portPoolMap, poolKey)
+		delete(c.allocateFailedTimeMap, poolKey)
 	}
 }
 
@@ -110,7 +106,11 @@ func (c *Cache) AllocatePortBinding(poolKey, protocol string) (
 	if !ok {
 		return nil, AllocatedPortItem{}, fmt.Errorf("pool %s not found in cache", poolKey)
 	}
-	return pool.AllocatePortBinding(protocol)
+	itemStatus, portItem, err := pool.AllocatePortBinding(protocol)
+	if err != nil {
+		c.recordAllocateFailure(poolKey, protocol)
+	}
+	return itemStatus, portItem, err
 }
 
 // AllocateAllProtocolPortBinding allocate ports with all protocols
@@ -120,7 +120,11 @@ func (c *Cache) AllocateAllProtocolPortBinding(poolKey string) (
 	if !ok {
 		return nil, nil, fmt.Errorf("pool %s not found in cache", poolKey)
 	}
-	return pool.AllocateAllProtocolPortBinding()
+	itemStatus, portItemMap, err := pool.AllocateAllProtocolPortBinding()
+	if err != nil {
+		c.recordAllocateFailure(poolKey, constant.PortPoolPortProtocolTCPUDP)
+	}
+	return itemStatus, portItemMap, err
 }
 
 // ReleasePortBinding release port binding
@@ -15,6 +15,7 @@ package portpoolcache
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-ingress-controller/internal/constant"
 	networkextensionv1 "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/kubernetes/apis/networkextension/v1"
@@ -183,3 +184,63 @@ func TestAllocateAllProtocolPortBinding(t *testing.T) {
 		t.Fatalf("expect %v, but get %v", mapItem, expectMap)
 	}
 }
+
+// TestGetPoolUsage test usage statistics of pool items
+func TestGetPoolUsage(t *testing.T) {
+	cache, err := getNewCache()
+	if err != nil {
+		t.Fatalf("failed to get new cache")
+	}
+	now := time.Now()
+	cache.recordUsage(now.Add(-30 * time.Minute))
+	for i := 0; i < 10; i++ {
+		if _, _, err := cache.AllocatePortBinding("test1.ns1", "TCP"); err != nil {
+			t.Fatalf("allocate port binding failed, err %s", err.Error())
+		}
+	}
+	cache.recordUsage(now)
+
+	usageList := cache.GetPoolUsage("test1.ns1")
+	if len(usageList) != 2 {
+		t.Fatalf("expect 2 item usages, but get %d", len(usageList))
+	}
+	usage := usageList[0]
+	if usage.Total != 1000 || usage.Allocated != 10 || usage.GetUtilization() != 1 {
+		t.Fatalf("unexpected usage %+v", usage)
+	}
+	if usage.AllocationRate != 20 {
+		t.Fatalf("expect allocation rate 20, but get %f", usage.AllocationRate)
+	}
+	if usage.ExhaustionETA != time.Duration(990.0/20*float64(time.Hour)) {
+		t.Fatalf("unexpected exhaustion eta %s", usage.ExhaustionETA)
+	}
+	if usageList[1].AllocationRate != 0 || usageList[1].ExhaustionETA >= 0 {
+		t.Fatalf("unexpected usage of idle item %+v", usageList[1])
+	}
+}
+
+// TestAllocateFailure test allocation failure is recorded
+func TestAllocateFailure(t *testing.T) {
+	cache := NewCache()
+	if err := cache.AddPortPoolItem("test1.ns1", &networkextensionv1.PortPoolItemStatus{
+		ItemName:        "item1",
+		LoadBalancerIDs: []string{"lb1"},
+		StartPort:       30000,
+		EndPort:         30001,
+		Status:          constant.PortBindingStatusReady,
+	}); err != nil {
+		t.Fatalf("add port pool item failed, err %s", err.Error())
+	}
+	if _, _, err := cache.AllocatePortBinding("test1.ns1", "TCP"); err != nil {
+		t.Fatalf("allocate port binding failed, err %s", err.Error())
+	}
+	if !cache.GetLastAllocateFailedTime("test1.ns1").IsZero() {
+		t.Fatalf("allocation failure should not be recorded")
+	}
+	if _, _, err := cache.AllocatePortBinding("test1.ns1", "TCP"); err == nil {
+		t.Fatalf("allocate port binding from exhausted pool should fail")
+	}
+	if cache.GetLastAllocateFailedTime("test1.ns1").IsZero() {
+		t.Fatalf("allocation failure should be recorded")
+	}
+}
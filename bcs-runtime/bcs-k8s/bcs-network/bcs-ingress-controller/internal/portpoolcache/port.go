@@ -67,6 +67,9 @@ type CachePortList struct {
 	AvailablePortNum int
 	AllocatedPortNum int
 	Ports            []*CachePort
+
+	// samples of allocated port number for calculating allocation rate
+	usageSamples []usageSample
 }
 
 // NewCachePortList create cache port list
@@ -0,0 +1,172 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package portpoolcache
+
+import (
+	"time"
+)
+
+// usageSampleWindow time window of samples used to calculate allocation rate
+const usageSampleWindow = time.Hour
+
+// usageSample allocated port number at certain time
+type usageSample struct {
+	timestamp time.Time
+	allocated int
+}
+
+// ItemUsage usage statistics of port pool item
+type ItemUsage struct {
+	PoolKey  string
+	ItemKey  string
+	ItemName string
+	// Total number of port segments
+	Total int
+	// Allocated number of allocated port segments, the max value among protocols
+	Allocated int
+	// AllocationRate net number of port segments allocated per hour, the max value among protocols
+	AllocationRate float64
+	// ExhaustionETA estimated duration before ports are exhausted, negative value means never
+	ExhaustionETA time.Duration
+}
+
+// GetUtilization get utilization in percent
+func (iu *ItemUsage) GetUtilization() int {
+	if iu.Total <= 0 {
+		return 0
+	}
+	return iu.Allocated * 100 / iu.Total
+}
+
+// recordUsage record sample of allocated port number, samples out of window are dropped
+func (cpl *CachePortList) recordUsage(now time.Time) {
+	cpl.usageSamples = append(cpl.usageSamples, usageSample{
+		timestamp: now,
+		allocated: cpl.AllocatedPortNum,
+	})
+	index := 0
+	for index < len(cpl.usageSamples)-1 && now.Sub(cpl.usageSamples[index].timestamp) > usageSampleWindow {
+		index++
+	}
+	cpl.usageSamples = cpl.usageSamples[index:]
+}
+
+// getAllocationRate get net number of port segments allocated per hour in sample window
+func (cpl *CachePortList) getAllocationRate() float64 {
+	if len(cpl.usageSamples) < 2 {
+		return 0
+	}
+	first := cpl.usageSamples[0]
+	last := cpl.usageSamples[len(cpl.usageSamples)-1]
+	duration := last.timestamp.Sub(first.timestamp)
+	if duration <= 0 {
+		return 0
+	}
+	return float64(last.allocated-first.allocated) / duration.Hours()
+}
+
+// getExhaustionETA get estimated duration before ports of list are exhausted, negative value means never
+func (cpl *CachePortList) getExhaustionETA() time.Duration {
+	remain := cpl.AvailablePortNum - cpl.AllocatedPortNum
+	if remain <= 0 {
+		return 0
+	}
+	rate := cpl.getAllocationRate()
+	if rate <= 0 {
+		return -1
+	}
+	return time.Duration(float64(remain) / rate * float64(time.Hour))
+}
+
+// recordUsage record usage samples for all protocols
+func (cpi *CachePoolItem) recordUsage(now time.Time) {
+	for _, list := range cpi.PortListMap {
+		list.recordUsage(now)
+	}
+}
+
+// GetUsage get usage statistics of item
+func (cpi *CachePoolItem) GetUsage() *ItemUsage {
+	usage := &ItemUsage{
+		PoolKey:       cpi.PoolKey,
+		ItemKey:       cpi.GetKey(),
+		ItemName:      cpi.ItemStatus.ItemName,
+		ExhaustionETA: -1,
+	}
+	for _, list := range cpi.PortListMap {
+		if usage.Total == 0 || list.GetAvailabePortNum() < usage.Total {
+			usage.Total = list.GetAvailabePortNum()
+		}
+		if list.GetAllocatedPortNum() > usage.Allocated {
+			usage.Allocated = list.GetAllocatedPortNum()
+		}
+		if rate := list.getAllocationRate(); rate > usage.AllocationRate {
+			usage.AllocationRate = rate
+		}
+		eta := list.getExhaustionETA()
+		if eta >= 0 && (usage.ExhaustionETA < 0 || eta < usage.ExhaustionETA) {
+			usage.ExhaustionETA = eta
+		}
+	}
+	return usage
+}
+
+// GetPoolUsage get usage statistics of all items in pool
+func (c *Cache) GetPoolUsage(poolKey string) []*ItemUsage {
+	pool, ok := c.portPoolMap[poolKey]
+	if !ok {
+		return nil
+	}
+	var retList []*ItemUsage
+	for _, item := range pool.ItemList {
+		retList = append(retList, item.GetUsage())
+	}
+	return retList
+}
+
+// GetLastAllocateFailedTime get last time when allocation from pool failed, zero if never
+func (c *Cache) GetLastAllocateFailedTime(poolKey string) time.Time {
+	return c.allocateFailedTimeMap[poolKey]
+}
+
+// recordAllocateFailure record allocation failure of pool
+func (c *Cache) recordAllocateFailure(poolKey, protocol string) {
+	c.allocateFailedTimeMap[poolKey] = time.Now()
+	portPoolAllocateFailedMetric.WithLabelValues(poolKey, protocol).Inc()
+}
+
+// recordUsage record usage samples of all pools and report metrics
+func (c *Cache) recordUsage(now time.Time) {
+	portPoolCapacityMetric.Reset()
+	portPoolAllocatedMetric.Reset()
+	portPoolAllocationRateMetric.Reset()
+	portPoolExhaustionSecondsMetric.Reset()
+	for poolKey, pool := range c.portPoolMap {
+		for _, item := range pool.ItemList {
+			item.recordUsage(now)
+			for protocol, list := range item.PortListMap {
+				portPoolCapacityMetric.WithLabelValues(poolKey, item.ItemStatus.ItemName, protocol).
+					Set(float64(list.GetAvailabePortNum()))
+				portPoolAllocatedMetric.WithLabelValues(poolKey, item.ItemStatus.ItemName, protocol).
+					Set(float64(list.GetAllocatedPortNum()))
+				portPoolAllocationRateMetric.WithLabelValues(poolKey, item.ItemStatus.ItemName, protocol).
+					Set(list.getAllocationRate())
+				// ports which are not being consumed are not reported
+				if eta := list.getExhaustionETA(); eta >= 0 {
+					portPoolExhaustionSecondsMetric.WithLabelValues(poolKey, item.ItemStatus.ItemName, protocol).
+						Set(eta.Seconds())
+				}
+			}
+		}
+	}
+}
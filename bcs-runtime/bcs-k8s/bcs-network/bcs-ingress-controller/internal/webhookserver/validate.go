@@ -65,6 +65,9 @@ func (s *Server) checkPortPool(newPool *networkextensionv1.PortPool) error {
 	// key: lbID-port, value: itemName
 	lbPortMap := make(map[string]string)
 	itemNameMap := make(map[string]struct{})
+	if err := newPool.Spec.AutoExpand.Validate(); err != nil {
+		return fmt.Errorf("invalid autoExpand, err %s", err.Error())
+	}
 	for _, item := range newPool.Spec.PoolItems {
 		if err := item.Validate(); err != nil {
 			return err
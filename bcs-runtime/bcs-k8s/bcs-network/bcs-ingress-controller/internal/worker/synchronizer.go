@@ -25,7 +25,6 @@ import (
 	networkextensionv1 "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/kubernetes/apis/networkextension/v1"
 
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
-	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8stypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
@@ -354,19 +353,8 @@ func (h *EventHandler) ensureListener(li *networkextensionv1.Listener) error {
 	}
 	h.recordListenerSuccessEvent(li, listenerID)
 
-	rawPatch := client.RawPatch(k8stypes.MergePatchType, []byte("{\"status\":{\"listenerID\":\""+listenerID+"\"}}"))
-	updateListener := &networkextensionv1.Listener{
-		ObjectMeta: k8smetav1.ObjectMeta{
-			Name:      li.GetName(),
-			Namespace: li.GetNamespace(),
-		},
-	}
-	err = h.k8sCli.Patch(context.TODO(), updateListener, rawPatch, &client.PatchOptions{})
-	if err != nil {
-		blog.Errorf("patch listener id %s to k8s apiserver failed, err %s", listenerID, err.Error())
-		return fmt.Errorf("update listener id %s to k8s apiserver failed, err %s", listenerID, err.Error())
-	}
-	return nil
+	// mark listener synced, so that pod clb weights can be acknowledged
+	return h.patchListenerID(li, listenerID)
 }
 
 func (h *EventHandler) deleteListener(li *networkextensionv1.Listener) error {
@@ -14,6 +14,7 @@ package worker
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
@@ -62,16 +63,32 @@ func (h *EventHandler) recordBackendUnhealthyEvent(lis *networkextensionv1.Liste
 		fmt.Sprintf(MsgBackendUnhealthy, lis.GetName(), port, backends))
 }
 
+// patchListenerID marks the listener synced with its cloud listener id. The resourceVersion of the synced
+// listener is used as precondition, so that a listener whose spec was changed during the sync is not marked synced
 func (h *EventHandler) patchListenerID(lis *networkextensionv1.Listener, lid string) error {
-	rawPatch := client.RawPatch(k8stypes.MergePatchType, []byte(
-		"{\"status\":{\"listenerID\":\""+lid+"\", \"status\": \"Synced\"}}"))
+	patchStruct := map[string]interface{}{
+		"status": map[string]interface{}{
+			"listenerID": lid,
+			"status":     networkextensionv1.ListenerStatusSynced,
+		},
+	}
+	if lis.GetResourceVersion() != "" {
+		patchStruct["metadata"] = map[string]interface{}{
+			"resourceVersion": lis.GetResourceVersion(),
+		}
+	}
+	patchBytes, err := json.Marshal(patchStruct)
+	if err != nil {
+		return fmt.Errorf("encoding listener status to json bytes failed, err %s", err.Error())
+	}
+	rawPatch := client.RawPatch(k8stypes.MergePatchType, patchBytes)
 	updateListener := &networkextensionv1.Listener{
 		ObjectMeta: k8smetav1.ObjectMeta{
 			Name:      lis.GetName(),
 			Namespace: lis.GetNamespace(),
 		},
 	}
-	err := h.k8sCli.Patch(context.Background(), updateListener, rawPatch, &client.PatchOptions{})
+	err = h.k8sCli.Patch(context.Background(), updateListener, rawPatch, &client.PatchOptions{})
 	if err != nil {
 		blog.Errorf("patch listener id %s to k8s apiserver failed, err %s", lid, err.Error())
 		return fmt.Errorf("update listener id %s to k8s apiserver failed, err %s", lid, err.Error())
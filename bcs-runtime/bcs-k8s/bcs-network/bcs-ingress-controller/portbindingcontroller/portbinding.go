@@ -19,6 +19,7 @@ import (
 
 	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-ingress-controller/internal/constant"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-ingress-controller/internal/generator"
 	bcsnetcommon "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/pkg/common"
 	networkextensionv1 "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/kubernetes/apis/networkextension/v1"
 
@@ -75,6 +76,11 @@ func (pbh *portBindingHandler) ensurePortBinding(
 	}
 	if unreadyNum == 0 {
 		portBinding.Status.Status = constant.PortBindingStatusReady
+		// all listeners have been synced with the current pod clb weight
+		if err := generator.PatchPodLBWeightSynced(pbh.k8sClient, pod); err != nil {
+			blog.Warnf("sync clb weight of pod %s/%s failed, err %s", pod.GetNamespace(), pod.GetName(), err.Error())
+			return true, err
+		}
 	}
 	if err := pbh.k8sClient.Status().Update(context.Background(), portBinding, &client.UpdateOptions{}); err != nil {
 		return true, fmt.Errorf("ensure port binding %s/%s failed, err %s",
@@ -71,10 +71,11 @@ func (pbih *portBindingItemHandler) ensureItem(
 		}
 
 		// tmpTargetGroup is use to build listener.spec.status or check listener whether changed when listener has targetGroup
+		weight, _ := generator.GetPodLBWeight(pod, networkextensionv1.DefaultWeight)
 		backend := networkextensionv1.ListenerBackend{
 			IP:     pod.Status.PodIP,
 			Port:   item.RsStartPort,
-			Weight: networkextensionv1.DefaultWeight,
+			Weight: weight,
 		}
 		if hostPort := generator.GetPodHostPortByPort(pod, int32(item.RsStartPort)); item.HostPort &&
 			hostPort != 0 {
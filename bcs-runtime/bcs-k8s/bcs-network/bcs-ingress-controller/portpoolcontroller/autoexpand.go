@@ -0,0 +1,260 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package portpoolcontroller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-ingress-controller/internal/cloud"
+	ingresscommon "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-ingress-controller/internal/common"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-ingress-controller/internal/constant"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-ingress-controller/internal/portpoolcache"
+	networkextensionv1 "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/kubernetes/apis/networkextension/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// autoExpandCheckInterval interval to check usage of port pool with auto expand policy
+const autoExpandCheckInterval = 1 * time.Minute
+
+// setPoolUsageStatus set usage statistics from pool cache into status of port pool and its items,
+// caller should hold the lock of pool cache
+func (pph *PortPoolHandler) setPoolUsageStatus(pool *networkextensionv1.PortPool) {
+	poolKey := ingresscommon.GetNamespacedNameKey(pool.GetName(), pool.GetNamespace())
+	usageMap := make(map[string]*portpoolcache.ItemUsage)
+	for _, usage := range pph.poolCache.GetPoolUsage(poolKey) {
+		usageMap[usage.ItemKey] = usage
+	}
+
+	now := time.Now()
+	var total, allocated int
+	var rate float64
+	for _, itemStatus := range pool.Status.PoolItemStatuses {
+		usage, ok := usageMap[itemStatus.GetKey()]
+		if !ok {
+			continue
+		}
+		itemStatus.TotalPorts = usage.Total
+		itemStatus.AllocatedPorts = usage.Allocated
+		itemStatus.Utilization = usage.GetUtilization()
+		itemStatus.AllocationRate = strconv.FormatFloat(usage.AllocationRate, 'f', 2, 64)
+		itemStatus.ExhaustionTime = getExhaustionTime(now, usage.ExhaustionETA)
+		// ports of deleting items cannot be allocated any more
+		if itemStatus.Status == constant.PortPoolItemStatusDeleting {
+			continue
+		}
+		total += usage.Total
+		allocated += usage.Allocated
+		rate += usage.AllocationRate
+	}
+
+	pool.Status.Utilization = 0
+	pool.Status.ExhaustionTime = nil
+	if total == 0 {
+		return
+	}
+	pool.Status.Utilization = allocated * 100 / total
+	if allocated >= total {
+		pool.Status.ExhaustionTime = getExhaustionTime(now, 0)
+	} else if rate > 0 {
+		pool.Status.ExhaustionTime = getExhaustionTime(now,
+			time.Duration(float64(total-allocated)/rate*float64(time.Hour)))
+	}
+}
+
+// getExhaustionTime convert exhaustion eta to time, negative eta means ports will not be exhausted
+func getExhaustionTime(now time.Time, eta time.Duration) *metav1.Time {
+	if eta < 0 {
+		return nil
+	}
+	exhaustionTime := metav1.NewTime(now.Add(eta).Truncate(time.Second))
+	return &exhaustionTime
+}
+
+// getExpandReason get the reason why port pool should be expanded, empty string means no need to expand
+func (pph *PortPoolHandler) getExpandReason(pool *networkextensionv1.PortPool, now time.Time) string {
+	policy := pool.Spec.AutoExpand
+	// wait for the last expansion to take effect
+	if len(pool.Status.PoolItemStatuses) != len(pool.Spec.PoolItems) {
+		return ""
+	}
+	for _, itemStatus := range pool.Status.PoolItemStatuses {
+		if itemStatus.Status != constant.PortPoolItemStatusReady {
+			return ""
+		}
+	}
+	if pool.Status.LastExpandTime != nil &&
+		now.Sub(pool.Status.LastExpandTime.Time) < time.Duration(policy.GetCooldownSeconds())*time.Second {
+		return ""
+	}
+
+	pph.poolCache.Lock()
+	lastFailedTime := pph.poolCache.GetLastAllocateFailedTime(
+		ingresscommon.GetNamespacedNameKey(pool.GetName(), pool.GetNamespace()))
+	pph.poolCache.Unlock()
+	if !lastFailedTime.IsZero() &&
+		(pool.Status.LastExpandTime == nil || lastFailedTime.After(pool.Status.LastExpandTime.Time)) {
+		return fmt.Sprintf("port allocation failed at %s", lastFailedTime.Format(time.RFC3339))
+	}
+	if pool.Status.Utilization >= policy.GetUtilizationThreshold() {
+		return fmt.Sprintf("utilization %d%% reaches threshold %d%%",
+			pool.Status.Utilization, policy.GetUtilizationThreshold())
+	}
+	if policy.ExhaustionWindowSeconds > 0 && pool.Status.ExhaustionTime != nil &&
+		pool.Status.ExhaustionTime.Sub(now) < time.Duration(policy.ExhaustionWindowSeconds)*time.Second {
+		return fmt.Sprintf("ports are estimated to be exhausted at %s",
+			pool.Status.ExhaustionTime.Format(time.RFC3339))
+	}
+	return ""
+}
+
+// autoExpandPortPool expand port pool according to auto expand policy
+// the returned string describes the expansion, empty string means pool is not expanded
+func (pph *PortPoolHandler) autoExpandPortPool(pool *networkextensionv1.PortPool) (string, error) {
+	if pool.Spec.AutoExpand == nil || len(pool.Spec.PoolItems) == 0 {
+		return "", nil
+	}
+	now := time.Now()
+	reason := pph.getExpandReason(pool, now)
+	// the loadbalancer of the pending item may have been created by the last failed expansion
+	if pendingItem, ok := pool.GetAnnotations()[constant.AnnotationForPortPoolPendingItem]; ok && len(reason) == 0 &&
+		pool.Spec.AutoExpand.Strategy == networkextensionv1.PortPoolAutoExpandStrategyNewLoadBalancer {
+		reason = fmt.Sprintf("resume pending item %s", pendingItem)
+	}
+	if len(reason) == 0 {
+		return "", nil
+	}
+	blog.Infof("port pool %s/%s should be expanded, reason: %s", pool.GetName(), pool.GetNamespace(), reason)
+
+	var msg string
+	var err error
+	switch pool.Spec.AutoExpand.Strategy {
+	case networkextensionv1.PortPoolAutoExpandStrategyExtendPort:
+		msg, err = pph.extendPortPoolItem(pool)
+	case networkextensionv1.PortPoolAutoExpandStrategyNewLoadBalancer:
+		msg, err = pph.appendPortPoolItem(pool, now)
+	default:
+		err = fmt.Errorf("invalid auto expand strategy %s", pool.Spec.AutoExpand.Strategy)
+	}
+	if err != nil {
+		return "", fmt.Errorf("expand port pool %s/%s failed, reason: %s, err %s",
+			pool.GetName(), pool.GetNamespace(), reason, err.Error())
+	}
+
+	if err := pph.k8sClient.Update(context.Background(), pool, &client.UpdateOptions{}); err != nil {
+		return "", fmt.Errorf("update port pool %s/%s for expansion failed, err %s",
+			pool.GetName(), pool.GetNamespace(), err.Error())
+	}
+	expandTime := metav1.NewTime(now)
+	pool.Status.LastExpandTime = &expandTime
+	if err := pph.k8sClient.Status().Update(context.Background(), pool, &client.UpdateOptions{}); err != nil {
+		return "", fmt.Errorf("update %s/%s status failed, err %s", pool.GetNamespace(), pool.GetName(), err.Error())
+	}
+	return fmt.Sprintf("%s, reason: %s", msg, reason), nil
+}
+
+// extendPortPoolItem increase end port of the last item in pool
+func (pph *PortPoolHandler) extendPortPoolItem(pool *networkextensionv1.PortPool) (string, error) {
+	policy := pool.Spec.AutoExpand
+	item := pool.Spec.PoolItems[len(pool.Spec.PoolItems)-1]
+	segmentLen := item.SegmentLength
+	if segmentLen == 0 {
+		segmentLen = 1
+	}
+	newEndPort := item.EndPort + policy.PortStep
+	if newEndPort > policy.GetMaxEndPort() {
+		newEndPort = policy.GetMaxEndPort()
+	}
+	if maxEndPort := item.StartPort + constant.MaxPortQuantityForEachLoadbalancer*segmentLen; newEndPort > maxEndPort {
+		newEndPort = maxEndPort
+	}
+	if newEndPort <= item.EndPort {
+		return "", fmt.Errorf("end port %d of item %s reaches the limit", item.EndPort, item.ItemName)
+	}
+	msg := fmt.Sprintf("extend end port of item %s from %d to %d", item.ItemName, item.EndPort, newEndPort)
+	item.EndPort = newEndPort
+	return msg, nil
+}
+
+// appendPortPoolItem create new loadbalancer and append item with it to pool,
+// the port range of new item is the same as the last item.
+// The name of the new item is recorded in pool annotation before the loadbalancer is created, so that
+// the loadbalancer created by a failed expansion is found by name and reused by the next expansion.
+func (pph *PortPoolHandler) appendPortPoolItem(pool *networkextensionv1.PortPool, now time.Time) (string, error) {
+	policy := pool.Spec.AutoExpand
+	if len(pool.Spec.PoolItems) >= policy.MaxItems {
+		return "", fmt.Errorf("item number %d reaches max items %d", len(pool.Spec.PoolItems), policy.MaxItems)
+	}
+	lastItem := pool.Spec.PoolItems[len(pool.Spec.PoolItems)-1]
+	itemName, ok := pool.GetAnnotations()[constant.AnnotationForPortPoolPendingItem]
+	if !ok {
+		itemName = fmt.Sprintf("%s-%d", pool.GetName(), now.Unix())
+		annotations := pool.GetAnnotations()
+		if annotations == nil {
+			annotations = make(map[string]string)
+		}
+		annotations[constant.AnnotationForPortPoolPendingItem] = itemName
+		pool.SetAnnotations(annotations)
+		if err := pph.k8sClient.Update(context.Background(), pool, &client.UpdateOptions{}); err != nil {
+			return "", fmt.Errorf("record pending item %s failed, err %s", itemName, err.Error())
+		}
+	}
+
+	region := pph.region
+	opt := &cloud.LoadBalanceCreateOption{
+		Namespace: pool.GetNamespace(),
+		Name:      itemName,
+	}
+	if policy.LoadBalancer != nil {
+		if len(policy.LoadBalancer.Region) != 0 {
+			region = policy.LoadBalancer.Region
+		}
+		opt.Type = policy.LoadBalancer.Type
+		opt.VpcID = policy.LoadBalancer.VpcID
+		opt.SubnetID = policy.LoadBalancer.SubnetID
+	}
+	lbObj, err := pph.lbClient.DescribeLoadBalancerWithNs(pool.GetNamespace(), region, "", itemName)
+	if err == cloud.ErrLoadbalancerNotFound {
+		lbObj, err = pph.lbClient.CreateLoadBalancer(region, opt)
+		if err != nil {
+			return "", fmt.Errorf("create loadbalancer in region %s failed, err %s", region, err.Error())
+		}
+		blog.Infof("loadbalancer %s created for port pool %s/%s", lbObj.LbID, pool.GetName(), pool.GetNamespace())
+	} else if err != nil {
+		return "", fmt.Errorf("describe loadbalancer %s in region %s failed, err %s", itemName, region, err.Error())
+	} else {
+		blog.Infof("reuse loadbalancer %s of pending item %s for port pool %s/%s",
+			lbObj.LbID, itemName, pool.GetName(), pool.GetNamespace())
+	}
+
+	lbID := lbObj.LbID
+	if region != pph.region {
+		lbID = region + constant.DelimiterForLbID + lbObj.LbID
+	}
+	pool.Spec.PoolItems = append(pool.Spec.PoolItems, &networkextensionv1.PortPoolItem{
+		ItemName:        itemName,
+		LoadBalancerIDs: []string{lbID},
+		Protocol:        lastItem.Protocol,
+		StartPort:       lastItem.StartPort,
+		EndPort:         lastItem.EndPort,
+		SegmentLength:   lastItem.SegmentLength,
+	})
+	// the pending item is done when the pool with new item is updated
+	delete(pool.Annotations, constant.AnnotationForPortPoolPendingItem)
+	return fmt.Sprintf("append item %s with new loadbalancer %s", itemName, lbID), nil
+}
@@ -0,0 +1,159 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package portpoolcontroller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	k8sfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-ingress-controller/internal/cloud"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-ingress-controller/internal/cloud/mock"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-ingress-controller/internal/constant"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-ingress-controller/internal/portpoolcache"
+	networkextensionv1 "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/kubernetes/apis/networkextension/v1"
+)
+
+func newTestPortPool(strategy string) *networkextensionv1.PortPool {
+	return &networkextensionv1.PortPool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pool1",
+			Namespace: "ns1",
+		},
+		Spec: networkextensionv1.PortPoolSpec{
+			PoolItems: []*networkextensionv1.PortPoolItem{
+				{
+					ItemName:        "item1",
+					LoadBalancerIDs: []string{"lb-1"},
+					StartPort:       30000,
+					EndPort:         30100,
+				},
+			},
+			AutoExpand: &networkextensionv1.PortPoolAutoExpand{
+				Strategy:   strategy,
+				PortStep:   100,
+				MaxEndPort: 30150,
+				MaxItems:   3,
+			},
+		},
+		Status: networkextensionv1.PortPoolStatus{
+			PoolItemStatuses: []*networkextensionv1.PortPoolItemStatus{
+				{
+					ItemName: "item1",
+					Status:   constant.PortPoolItemStatusReady,
+				},
+			},
+		},
+	}
+}
+
+func newTestPortPoolClient(t *testing.T, pool *networkextensionv1.PortPool) client.Client {
+	newScheme := runtime.NewScheme()
+	newScheme.AddKnownTypes(
+		networkextensionv1.GroupVersion,
+		&networkextensionv1.PortPool{},
+		&networkextensionv1.PortPoolList{})
+	cli := k8sfake.NewFakeClientWithScheme(newScheme)
+	if err := cli.Create(context.Background(), pool); err != nil {
+		t.Fatalf("create port pool failed, err %s", err.Error())
+	}
+	return cli
+}
+
+func getTestPortPool(t *testing.T, cli client.Client) *networkextensionv1.PortPool {
+	pool := &networkextensionv1.PortPool{}
+	if err := cli.Get(context.Background(), k8stypes.NamespacedName{Namespace: "ns1", Name: "pool1"},
+		pool); err != nil {
+		t.Fatalf("get port pool failed, err %s", err.Error())
+	}
+	return pool
+}
+
+// TestExtendPortPoolItem test end port of the last item is extended within limits
+func TestExtendPortPoolItem(t *testing.T) {
+	pph := &PortPoolHandler{}
+	pool := newTestPortPool(networkextensionv1.PortPoolAutoExpandStrategyExtendPort)
+	if _, err := pph.extendPortPoolItem(pool); err != nil {
+		t.Fatalf("extend port pool item failed, err %s", err.Error())
+	}
+	// end port is limited by max end port
+	if pool.Spec.PoolItems[0].EndPort != 30150 {
+		t.Errorf("expect end port 30150, but get %d", pool.Spec.PoolItems[0].EndPort)
+	}
+	if _, err := pph.extendPortPoolItem(pool); err == nil {
+		t.Errorf("expect error when end port reaches max end port")
+	}
+}
+
+// TestAppendPortPoolItemReuseLoadBalancer test loadbalancer created by a failed expansion is reused
+func TestAppendPortPoolItemReuseLoadBalancer(t *testing.T) {
+	pool := newTestPortPool(networkextensionv1.PortPoolAutoExpandStrategyNewLoadBalancer)
+	cli := newTestPortPoolClient(t, pool)
+	pool = getTestPortPool(t, cli)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockCloud := mock.NewMockLoadBalance(ctrl)
+	pph := newPortPoolHandler("", "testregion", mockCloud, cli, portpoolcache.NewCache())
+
+	now := time.Unix(1600000000, 0)
+	itemName := "pool1-1600000000"
+	gomock.InOrder(
+		mockCloud.EXPECT().DescribeLoadBalancerWithNs("ns1", "testregion", "", itemName).
+			Return(nil, cloud.ErrLoadbalancerNotFound),
+		mockCloud.EXPECT().CreateLoadBalancer("testregion", gomock.Any()).
+			Return(&cloud.LoadBalanceObject{LbID: "lb-new", Name: itemName, Region: "testregion"}, nil).
+			Times(1),
+		mockCloud.EXPECT().DescribeLoadBalancerWithNs("ns1", "testregion", "", itemName).
+			Return(&cloud.LoadBalanceObject{LbID: "lb-new", Name: itemName, Region: "testregion"}, nil),
+	)
+
+	// the first expansion creates loadbalancer but fails to update the pool with new item
+	if _, err := pph.appendPortPoolItem(pool, now); err != nil {
+		t.Fatalf("append port pool item failed, err %s", err.Error())
+	}
+	pool = getTestPortPool(t, cli)
+	if pool.Annotations[constant.AnnotationForPortPoolPendingItem] != itemName {
+		t.Fatalf("expect pending item %s recorded, but get annotations %v", itemName, pool.Annotations)
+	}
+	if len(pool.Spec.PoolItems) != 1 {
+		t.Fatalf("expect pool items not updated, but get %d items", len(pool.Spec.PoolItems))
+	}
+
+	// the next expansion resumes the pending item with the created loadbalancer
+	msg, err := pph.autoExpandPortPool(pool)
+	if err != nil {
+		t.Fatalf("auto expand port pool failed, err %s", err.Error())
+	}
+	if len(msg) == 0 {
+		t.Fatalf("expect pending item resumed")
+	}
+	pool = getTestPortPool(t, cli)
+	if len(pool.Spec.PoolItems) != 2 {
+		t.Fatalf("expect 2 items, but get %d", len(pool.Spec.PoolItems))
+	}
+	newItem := pool.Spec.PoolItems[1]
+	if newItem.ItemName != itemName || len(newItem.LoadBalancerIDs) != 1 || newItem.LoadBalancerIDs[0] != "lb-new" {
+		t.Errorf("unexpected new item %+v", newItem)
+	}
+	if _, ok := pool.Annotations[constant.AnnotationForPortPoolPendingItem]; ok {
+		t.Errorf("expect pending item annotation removed, but get %v", pool.Annotations)
+	}
+}
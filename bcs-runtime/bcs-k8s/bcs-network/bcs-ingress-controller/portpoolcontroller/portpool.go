@@ -130,6 +130,7 @@ func (pph *PortPoolHandler) ensurePortPool(pool *networkextensionv1.PortPool) (b
 	for _, ts := range newItemStatusList {
 		pool.Status.PoolItemStatuses = append(pool.Status.PoolItemStatuses, ts)
 	}
+	pph.setPoolUsageStatus(pool)
 
 	err := pph.k8sClient.Status().Update(context.Background(), pool, &client.UpdateOptions{})
 	if err != nil {
@@ -130,6 +130,20 @@ func (ppr *PortPoolReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error)
 		}, nil
 	}
 
+	if portPool.Spec.AutoExpand != nil {
+		msg, err := handler.autoExpandPortPool(portPool)
+		if err != nil {
+			blog.Warnf("%s", err.Error())
+			ppr.recordListenerEvent(portPool, k8scorev1.EventTypeWarning, "expand port pool failed", err.Error())
+		} else if len(msg) != 0 {
+			ppr.recordListenerEvent(portPool, k8scorev1.EventTypeNormal, "port pool expanded", msg)
+		}
+		return ctrl.Result{
+			Requeue:      true,
+			RequeueAfter: autoExpandCheckInterval,
+		}, nil
+	}
+
 	return ctrl.Result{Requeue: true,
 		RequeueAfter: 20 * time.Minute}, nil
 }
@@ -25,10 +25,13 @@ import (
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-networkpolicy/datainformer"
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-networkpolicy/iptables"
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-networkpolicy/metrics"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-networkpolicy/multicluster"
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-networkpolicy/options"
+	netextv1 "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/kubernetes/apis/networkextension/v1"
 	docker "github.com/fsouza/go-dockerclient"
 	"github.com/prometheus/client_golang/prometheus"
 	api "k8s.io/api/core/v1"
+	discovery "k8s.io/api/discovery/v1beta1"
 	networking "k8s.io/api/networking/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
@@ -60,6 +63,9 @@ type podPolicyController struct {
 	dataInformer       datainformer.Interface
 	dataInformerSynced bool
 
+	// resolver resolves peers of MultiClusterNetworkPolicy, nil if multi-cluster is disabled
+	resolver *multicluster.Resolver
+
 	// dockerClient for events
 	dockerClient *docker.Client
 }
@@ -159,6 +165,14 @@ func (pc *podPolicyController) Sync() (err error) {
 		blog.Errorf("Synced failed, build networkPolicies occurred an error.")
 		return err
 	}
+	if pc.resolver != nil {
+		multiClusterPolicyInfos, err := np.NewMultiClusterPolicyHandler(pc.dataInformer, pc.resolver).Build()
+		if err != nil {
+			blog.Errorf("Synced failed, build multiClusterNetworkPolicies occurred an error.")
+			return err
+		}
+		networkPolicyInfos = append(networkPolicyInfos, multiClusterPolicyInfos...)
+	}
 	blog.Infof("Build network policies successfully, version: %s", syncVersion)
 
 	iptHandler := ipt.NewHandler(pc.dockerClient, pc.ipSetHandler, networkPolicyInfos, syncVersion)
@@ -252,6 +266,38 @@ func (pc *podPolicyController) newNetworkPolicyEventHandler() cache.ResourceEven
 	}
 }
 
+func (pc *podPolicyController) newMultiClusterPolicyEventHandler() cache.ResourceEventHandler {
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			mcnp := obj.(*netextv1.MultiClusterNetworkPolicy)
+			pc.sendEvent(resourceEvent{Type: MultiClusterNetworkPolicyUpdate, Namespace: mcnp.Namespace, Name: mcnp.Name})
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			mcnp := newObj.(*netextv1.MultiClusterNetworkPolicy)
+			pc.sendEvent(resourceEvent{Type: MultiClusterNetworkPolicyUpdate, Namespace: mcnp.Namespace, Name: mcnp.Name})
+		},
+		DeleteFunc: func(obj interface{}) {
+			pc.sendEvent(resourceEvent{Type: MultiClusterNetworkPolicyUpdate})
+		},
+	}
+}
+
+func (pc *podPolicyController) newImportedEndpointsEventHandler() cache.ResourceEventHandler {
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			slice := obj.(*discovery.EndpointSlice)
+			pc.sendEvent(resourceEvent{Type: ImportedEndpointsUpdate, Namespace: slice.Namespace, Name: slice.Name})
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			slice := newObj.(*discovery.EndpointSlice)
+			pc.sendEvent(resourceEvent{Type: ImportedEndpointsUpdate, Namespace: slice.Namespace, Name: slice.Name})
+		},
+		DeleteFunc: func(obj interface{}) {
+			pc.sendEvent(resourceEvent{Type: ImportedEndpointsUpdate})
+		},
+	}
+}
+
 func compareLabels(m1, m2 map[string]string) bool {
 	if len(m1) != len(m2) {
 		return false
@@ -294,9 +340,11 @@ func (pc *podPolicyController) OnNamespaceUpdate(obj interface{}) {}
 // NewPodPolicyController returns new NetworkPolicyController object
 // add data informer for pod, namespace and network policy discovery
 // add iptables sync error metric
+// resolver is optional, MultiClusterNetworkPolicies are handled when it is not nil
 func NewPodPolicyController(
 	clientset kubernetes.Interface,
 	informer datainformer.Interface,
+	resolver *multicluster.Resolver,
 	config *options.NetworkPolicyOption) (controller.Controller, error) {
 
 	//Register the metrics for this controller
@@ -327,5 +375,13 @@ func NewPodPolicyController(
 	ppc.podEventHandler = ppc.newPodEventHandler()
 	ppc.namespaceEventHandler = ppc.newNamespaceEventHandler()
 	ppc.networkPolicyEventHandler = ppc.newNetworkPolicyEventHandler()
+	if resolver != nil {
+		ppc.resolver = resolver
+		resolver.AddPolicyEventHandler(ppc.newMultiClusterPolicyEventHandler())
+		resolver.AddEndpointSliceEventHandler(ppc.newImportedEndpointsEventHandler())
+		resolver.SetRemotePodsHandler(func(clusterID string) {
+			ppc.sendEvent(resourceEvent{Type: RemotePodsUpdate, Name: clusterID})
+		})
+	}
 	return &ppc, nil
 }
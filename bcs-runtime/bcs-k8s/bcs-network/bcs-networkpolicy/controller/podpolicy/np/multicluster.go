@@ -0,0 +1,204 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package np
+
+import (
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-networkpolicy/controller"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-networkpolicy/datainformer"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-networkpolicy/multicluster"
+	netextv1 "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/kubernetes/apis/networkextension/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	// multiClusterPolicyNamePrefix prefix of the name of networkPolicyInfo built from MultiClusterNetworkPolicy,
+	// ':' is not allowed in name of kubernetes object, so the ipSets and chains will not conflict with
+	// the NetworkPolicy with the same name.
+	multiClusterPolicyNamePrefix = "mcnp:"
+)
+
+// MultiClusterPolicyHandler list all MultiClusterNetworkPolicies and re-build them to net struct,
+// peers in remote clusters are resolved by multi-cluster resolver.
+type MultiClusterPolicyHandler struct {
+	informer datainformer.Interface
+	resolver *multicluster.Resolver
+}
+
+// NewMultiClusterPolicyHandler return MultiClusterPolicyHandler object.
+func NewMultiClusterPolicyHandler(informer datainformer.Interface,
+	resolver *multicluster.Resolver) MultiClusterPolicyHandler {
+	return MultiClusterPolicyHandler{
+		informer: informer,
+		resolver: resolver,
+	}
+}
+
+// Build used to rebuild all the MultiClusterNetworkPolicies
+func (h MultiClusterPolicyHandler) Build() ([]controller.NetworkPolicyInfo, error) {
+	policies, err := h.resolver.ListPolicies()
+	if err != nil {
+		blog.Errorf("Failed to list multiClusterNetworkPolicies, err: %s", err.Error())
+		return nil, err
+	}
+
+	// reuse the port evaluation of networkPolicy
+	nph := NewNetworkPolicyHandler(h.informer)
+	newPolicies := make([]controller.NetworkPolicyInfo, 0, len(policies))
+	for _, policy := range policies {
+		newPolicy := controller.NetworkPolicyInfo{
+			Name:       multiClusterPolicyNamePrefix + policy.Name,
+			Namespace:  policy.Namespace,
+			Labels:     policy.Spec.PodSelector.MatchLabels,
+			PolicyType: evalMultiClusterPolicyType(policy),
+		}
+
+		targetPods, err := h.informer.ListPodsByNamespace(policy.Namespace, policy.Spec.PodSelector.MatchLabels)
+		if err != nil {
+			blog.Errorf("Failed to list pods with multiClusterNetworkPolicy: %s/%s, err: %s",
+				policy.Namespace, policy.Name, err.Error())
+			return nil, err
+		}
+		newPolicy.TargetPods = make(map[string]controller.PodInfo)
+		for _, pod := range targetPods {
+			if pod.Status.PodIP == "" {
+				continue
+			}
+			newPolicy.TargetPods[pod.Status.PodIP] = controller.PodInfo{
+				IP:        pod.Status.PodIP,
+				Name:      pod.Name,
+				Namespace: pod.Namespace,
+				Labels:    pod.Labels,
+			}
+		}
+		podsNamedPorts := nph.buildPodsPorts(targetPods)
+
+		newPolicy.IngressRules = make([]controller.IngressRule, 0, len(policy.Spec.Ingress))
+		for _, rule := range policy.Spec.Ingress {
+			ingressRule := controller.IngressRule{}
+			if len(rule.From) == 0 {
+				ingressRule.MatchAllSource = true
+			}
+			for _, peer := range rule.From {
+				peerPods, err := h.evalPeer(policy, peer)
+				if err != nil {
+					return nil, err
+				}
+				ingressRule.SrcPods = append(ingressRule.SrcPods, peerPods...)
+			}
+			if len(rule.Ports) == 0 {
+				ingressRule.MatchAllPorts = true
+			} else {
+				ingressRule.Ports = nph.evalPorts(defaultPortsProtocol(rule.Ports), podsNamedPorts)
+			}
+			newPolicy.IngressRules = append(newPolicy.IngressRules, ingressRule)
+		}
+
+		newPolicy.EgressRules = make([]controller.EgressRule, 0, len(policy.Spec.Egress))
+		for _, rule := range policy.Spec.Egress {
+			egressRule := controller.EgressRule{}
+			if len(rule.To) == 0 {
+				egressRule.MatchAllDestinations = true
+			}
+			for _, peer := range rule.To {
+				peerPods, err := h.evalPeer(policy, peer)
+				if err != nil {
+					return nil, err
+				}
+				egressRule.DstPods = append(egressRule.DstPods, peerPods...)
+			}
+			if len(rule.Ports) == 0 {
+				egressRule.MatchAllPorts = true
+			} else {
+				egressRule.Ports = nph.evalPorts(defaultPortsProtocol(rule.Ports), podsNamedPorts)
+			}
+			newPolicy.EgressRules = append(newPolicy.EgressRules, egressRule)
+		}
+
+		newPolicies = append(newPolicies, newPolicy)
+	}
+	return newPolicies, nil
+}
+
+// evalPeer returns the pods selected by peer, which may run in local or remote cluster.
+func (h MultiClusterPolicyHandler) evalPeer(policy *netextv1.MultiClusterNetworkPolicy,
+	peer netextv1.MultiClusterNetworkPolicyPeer) ([]controller.PodInfo, error) {
+	if len(peer.ServiceImport) != 0 {
+		pods, err := h.resolver.ListImportedEndpoints(policy.Namespace, peer.ServiceImport, peer.ClusterID)
+		if err != nil {
+			blog.Errorf("Failed to list endpoints of serviceImport %s with multiClusterNetworkPolicy: %s/%s, err: %s",
+				peer.ServiceImport, policy.Namespace, policy.Name, err.Error())
+			return nil, err
+		}
+		return pods, nil
+	}
+
+	namespaces := peer.Namespaces
+	if len(namespaces) == 0 {
+		namespaces = []string{policy.Namespace}
+	}
+	selector := labels.Everything()
+	if peer.PodSelector != nil {
+		var err error
+		if selector, err = metav1.LabelSelectorAsSelector(peer.PodSelector); err != nil {
+			blog.Errorf("Invalid podSelector of multiClusterNetworkPolicy: %s/%s, err: %s",
+				policy.Namespace, policy.Name, err.Error())
+			return nil, err
+		}
+	}
+
+	if !h.resolver.IsLocalCluster(peer.ClusterID) {
+		return h.resolver.ListRemotePods(peer.ClusterID, namespaces, selector), nil
+	}
+	var localPods []*corev1.Pod
+	for _, ns := range namespaces {
+		pods, err := h.informer.ListPodsByNamespace(ns, nil)
+		if err != nil {
+			blog.Errorf("Failed to list pods with multiClusterNetworkPolicy: %s/%s, err: %s",
+				policy.Namespace, policy.Name, err.Error())
+			return nil, err
+		}
+		localPods = append(localPods, pods...)
+	}
+	return multicluster.FilterPods(localPods, namespaces, selector), nil
+}
+
+// defaultPortsProtocol set protocol of ports to TCP if it is not specified
+func defaultPortsProtocol(ports []networking.NetworkPolicyPort) []networking.NetworkPolicyPort {
+	result := make([]networking.NetworkPolicyPort, 0, len(ports))
+	for _, port := range ports {
+		if port.Protocol == nil {
+			protocol := corev1.ProtocolTCP
+			port.Protocol = &protocol
+		}
+		result = append(result, port)
+	}
+	return result
+}
+
+// evalMultiClusterPolicyType evaluates policy type in the same way as networkPolicy
+func evalMultiClusterPolicyType(policy *netextv1.MultiClusterNetworkPolicy) controller.NetworkPolicyType {
+	spec := networking.NetworkPolicySpec{PolicyTypes: policy.Spec.PolicyTypes}
+	if policy.Spec.Ingress != nil {
+		spec.Ingress = make([]networking.NetworkPolicyIngressRule, len(policy.Spec.Ingress))
+	}
+	if policy.Spec.Egress != nil {
+		spec.Egress = make([]networking.NetworkPolicyEgressRule, len(policy.Spec.Egress))
+	}
+	return evalPolicyType(&networking.NetworkPolicy{Spec: spec})
+}
@@ -23,6 +23,12 @@ const (
 	NamespaceUpdate EventType = "NamespaceUpdate"
 	// NetworkPolicyUpdate event change of networkPolicy
 	NetworkPolicyUpdate EventType = "NetworkPolicyUpdate"
+	// MultiClusterNetworkPolicyUpdate event change of multiClusterNetworkPolicy
+	MultiClusterNetworkPolicyUpdate EventType = "MultiClusterNetworkPolicyUpdate"
+	// ImportedEndpointsUpdate event change of endpointSlices imported by mcs
+	ImportedEndpointsUpdate EventType = "ImportedEndpointsUpdate"
+	// RemotePodsUpdate event change of pods in remote cluster
+	RemotePodsUpdate EventType = "RemotePodsUpdate"
 )
 
 // resourceEvent defines the change of informer received
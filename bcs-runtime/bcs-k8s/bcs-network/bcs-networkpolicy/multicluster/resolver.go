@@ -0,0 +1,340 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+// Package multicluster resolves peers of MultiClusterNetworkPolicy which run in other clusters
+package multicluster
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/Tencent/bk-bcs/bcs-common/common/ssl"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/bcsapi"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-networkpolicy/controller"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-networkpolicy/options"
+	netextv1 "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/kubernetes/apis/networkextension/v1"
+	netextclientset "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/kubernetes/generated/clientset/versioned"
+	netextinformers "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/kubernetes/generated/informers/externalversions"
+	netextlisters "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/kubernetes/generated/listers/networkextension/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	discovery "k8s.io/api/discovery/v1beta1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	listerdiscovery "k8s.io/client-go/listers/discovery/v1beta1"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Resolver watches MultiClusterNetworkPolicies and the endpoints imported by mcs in local cluster,
+// and polls pods of the remote clusters referenced by policies from bcs-storage.
+type Resolver struct {
+	opt        *options.NetworkPolicyOption
+	storageCli bcsapi.Storage
+
+	kubeInformerFactory   informers.SharedInformerFactory
+	netextInformerFactory netextinformers.SharedInformerFactory
+	policyInformer        cache.SharedIndexInformer
+	policyLister          netextlisters.MultiClusterNetworkPolicyLister
+	sliceInformer         cache.SharedIndexInformer
+	sliceLister           listerdiscovery.EndpointSliceLister
+
+	mu sync.RWMutex
+	// remotePods pods of remote clusters, clusterID -> pods
+	remotePods map[string][]*corev1.Pod
+	// remoteFingerprints fingerprint of pods of remote clusters, used to detect changes
+	remoteFingerprints map[string]string
+	// remotePodsHandler called when pods of remote cluster changed
+	remotePodsHandler func(clusterID string)
+
+	stopCh chan struct{}
+}
+
+// New create Resolver
+func New(opt *options.NetworkPolicyOption) (*Resolver, error) {
+	storageConf := &bcsapi.Config{
+		Hosts:     strings.Split(opt.StorageHosts, ","),
+		AuthToken: opt.StorageToken,
+		Gateway:   opt.StorageGateway,
+	}
+	if len(opt.StorageCAFile) != 0 {
+		tlsConf, err := ssl.ClientTslConfVerityServer(opt.StorageCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("load ca file %s for bcs-storage failed, err %s", opt.StorageCAFile, err.Error())
+		}
+		storageConf.TLSConfig = tlsConf
+	}
+	return &Resolver{
+		opt:                opt,
+		storageCli:         bcsapi.NewStorage(storageConf),
+		remotePods:         make(map[string][]*corev1.Pod),
+		remoteFingerprints: make(map[string]string),
+		stopCh:             make(chan struct{}),
+	}, nil
+}
+
+// Init init informers for MultiClusterNetworkPolicy and EndpointSlice
+func (r *Resolver) Init(client kubernetes.Interface, netextClient netextclientset.Interface) {
+	resync := time.Duration(r.opt.KubeReSyncPeriod) * time.Second
+	r.kubeInformerFactory = informers.NewSharedInformerFactory(client, resync)
+	r.netextInformerFactory = netextinformers.NewSharedInformerFactory(netextClient, resync)
+
+	policyInformer := r.netextInformerFactory.Networkextension().V1().MultiClusterNetworkPolicies()
+	sliceInformer := r.kubeInformerFactory.Discovery().V1beta1().EndpointSlices()
+	r.policyInformer = policyInformer.Informer()
+	r.policyLister = policyInformer.Lister()
+	r.sliceInformer = sliceInformer.Informer()
+	r.sliceLister = sliceInformer.Lister()
+}
+
+// AddPolicyEventHandler add MultiClusterNetworkPolicy event handler
+func (r *Resolver) AddPolicyEventHandler(handler cache.ResourceEventHandler) {
+	r.policyInformer.AddEventHandler(handler)
+}
+
+// AddEndpointSliceEventHandler add event handler for EndpointSlices imported by mcs
+func (r *Resolver) AddEndpointSliceEventHandler(handler cache.ResourceEventHandler) {
+	r.sliceInformer.AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: func(obj interface{}) bool {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			slice, ok := obj.(*discovery.EndpointSlice)
+			if !ok {
+				return false
+			}
+			_, ok = slice.Labels[netextv1.MultiClusterLabelServiceName]
+			return ok
+		},
+		Handler: handler,
+	})
+}
+
+// SetRemotePodsHandler set the handler called when pods of remote cluster changed
+func (r *Resolver) SetRemotePodsHandler(handler func(clusterID string)) {
+	r.remotePodsHandler = handler
+}
+
+// Run start informers, wait for cache sync and start polling remote pods
+func (r *Resolver) Run() error {
+	syncFlag := make(chan struct{})
+	r.kubeInformerFactory.Start(r.stopCh)
+	r.netextInformerFactory.Start(r.stopCh)
+	go func() {
+		blog.Infof("wait for multi-cluster informer factory cache sync")
+		r.kubeInformerFactory.WaitForCacheSync(r.stopCh)
+		r.netextInformerFactory.WaitForCacheSync(r.stopCh)
+		close(syncFlag)
+	}()
+	select {
+	case <-time.After(time.Duration(r.opt.KubeCacheSyncTimeout) * time.Second):
+		return fmt.Errorf("wait for multi-cluster cache sync timeout after %d seconds", r.opt.KubeCacheSyncTimeout)
+	case <-syncFlag:
+	}
+
+	r.syncRemotePods()
+	go r.loop()
+	return nil
+}
+
+// Stop stop informers and polling
+func (r *Resolver) Stop() {
+	blog.Infof("stop multi-cluster resolver")
+	close(r.stopCh)
+}
+
+func (r *Resolver) loop() {
+	ticker := time.NewTicker(time.Duration(r.opt.MultiClusterSyncPeriod) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.stopCh:
+			return
+		case <-ticker.C:
+			r.syncRemotePods()
+		}
+	}
+}
+
+// syncRemotePods query pods of every remote cluster referenced by policies, and call
+// remotePodsHandler when pods of cluster changed. Pods of cluster are kept when query
+// failed, so that the rules are not flushed by the failure of bcs-storage.
+func (r *Resolver) syncRemotePods() {
+	policies, err := r.ListPolicies()
+	if err != nil {
+		blog.Errorf("list multi-cluster network policies failed, err %s", err.Error())
+		return
+	}
+	clusters := r.remoteClusters(policies)
+
+	var changed []string
+	for clusterID := range clusters {
+		storagePods, err := r.storageCli.QueryK8SPod(clusterID)
+		if err != nil {
+			blog.Errorf("query pods of cluster %s from bcs-storage failed, err %s", clusterID, err.Error())
+			continue
+		}
+		pods := make([]*corev1.Pod, 0, len(storagePods))
+		for _, storagePod := range storagePods {
+			if storagePod.IsBcsObjectDeleted || storagePod.Data == nil {
+				continue
+			}
+			pods = append(pods, storagePod.Data)
+		}
+		fingerprint := podsFingerprint(pods)
+
+		r.mu.Lock()
+		if r.remoteFingerprints[clusterID] != fingerprint {
+			r.remotePods[clusterID] = pods
+			r.remoteFingerprints[clusterID] = fingerprint
+			changed = append(changed, clusterID)
+		}
+		r.mu.Unlock()
+	}
+
+	// clean the clusters no longer referenced
+	r.mu.Lock()
+	for clusterID := range r.remotePods {
+		if _, ok := clusters[clusterID]; !ok {
+			delete(r.remotePods, clusterID)
+			delete(r.remoteFingerprints, clusterID)
+		}
+	}
+	r.mu.Unlock()
+
+	if r.remotePodsHandler == nil {
+		return
+	}
+	for _, clusterID := range changed {
+		r.remotePodsHandler(clusterID)
+	}
+}
+
+// remoteClusters returns the clusters whose pods need to be queried from bcs-storage
+func (r *Resolver) remoteClusters(policies []*netextv1.MultiClusterNetworkPolicy) map[string]struct{} {
+	clusters := make(map[string]struct{})
+	addPeers := func(peers []netextv1.MultiClusterNetworkPolicyPeer) {
+		for _, peer := range peers {
+			if len(peer.ServiceImport) != 0 || r.IsLocalCluster(peer.ClusterID) {
+				continue
+			}
+			clusters[peer.ClusterID] = struct{}{}
+		}
+	}
+	for _, policy := range policies {
+		for _, rule := range policy.Spec.Ingress {
+			addPeers(rule.From)
+		}
+		for _, rule := range policy.Spec.Egress {
+			addPeers(rule.To)
+		}
+	}
+	return clusters
+}
+
+// IsLocalCluster returns whether the peer of clusterID runs in local cluster
+func (r *Resolver) IsLocalCluster(clusterID string) bool {
+	return len(clusterID) == 0 || clusterID == r.opt.ClusterID
+}
+
+// ListPolicies list all MultiClusterNetworkPolicies
+func (r *Resolver) ListPolicies() ([]*netextv1.MultiClusterNetworkPolicy, error) {
+	return r.policyLister.List(labels.Everything())
+}
+
+// ListRemotePods list pods of remote cluster in namespaces which match the selector
+func (r *Resolver) ListRemotePods(clusterID string, namespaces []string, selector labels.Selector) []controller.PodInfo {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return FilterPods(r.remotePods[clusterID], namespaces, selector)
+}
+
+// ListImportedEndpoints list the endpoints imported by ServiceImport in namespace,
+// only endpoints from clusterID are returned when clusterID is not empty
+func (r *Resolver) ListImportedEndpoints(namespace, serviceImport, clusterID string) ([]controller.PodInfo, error) {
+	set := labels.Set{netextv1.MultiClusterLabelServiceName: serviceImport}
+	if len(clusterID) != 0 {
+		set[netextv1.MultiClusterLabelSourceCluster] = clusterID
+	}
+	slices, err := r.sliceLister.EndpointSlices(namespace).List(labels.SelectorFromSet(set))
+	if err != nil {
+		return nil, err
+	}
+	return EndpointSlicesToPods(slices), nil
+}
+
+// FilterPods returns the pods with ip in namespaces which match the selector
+func FilterPods(pods []*corev1.Pod, namespaces []string, selector labels.Selector) []controller.PodInfo {
+	nsSet := make(map[string]struct{}, len(namespaces))
+	for _, ns := range namespaces {
+		nsSet[ns] = struct{}{}
+	}
+
+	var podInfos []controller.PodInfo
+	for _, pod := range pods {
+		if _, ok := nsSet[pod.Namespace]; !ok {
+			continue
+		}
+		if len(pod.Status.PodIP) == 0 || !selector.Matches(labels.Set(pod.Labels)) {
+			continue
+		}
+		podInfos = append(podInfos, controller.PodInfo{
+			IP:        pod.Status.PodIP,
+			Name:      pod.Name,
+			Namespace: pod.Namespace,
+			Labels:    pod.Labels,
+		})
+	}
+	return podInfos
+}
+
+// EndpointSlicesToPods converts ready endpoints of EndpointSlices to pods
+func EndpointSlicesToPods(slices []*discovery.EndpointSlice) []controller.PodInfo {
+	var podInfos []controller.PodInfo
+	for _, slice := range slices {
+		for _, ep := range slice.Endpoints {
+			if ep.Conditions.Ready != nil && !*ep.Conditions.Ready {
+				continue
+			}
+			podInfo := controller.PodInfo{Namespace: slice.Namespace}
+			if ep.TargetRef != nil {
+				podInfo.Name = ep.TargetRef.Name
+				podInfo.Namespace = ep.TargetRef.Namespace
+			}
+			for _, address := range ep.Addresses {
+				podInfo.IP = address
+				podInfos = append(podInfos, podInfo)
+			}
+		}
+	}
+	return podInfos
+}
+
+// podsFingerprint returns the fingerprint of fields concerned by network policy
+func podsFingerprint(pods []*corev1.Pod) string {
+	items := make([]string, 0, len(pods))
+	for _, pod := range pods {
+		podLabels := make([]string, 0, len(pod.Labels))
+		for k, v := range pod.Labels {
+			podLabels = append(podLabels, k+"="+v)
+		}
+		sort.Strings(podLabels)
+		items = append(items, pod.Namespace+"/"+pod.Name+"/"+pod.Status.PodIP+"/"+strings.Join(podLabels, ","))
+	}
+	sort.Strings(items)
+	return strings.Join(items, ";")
+}
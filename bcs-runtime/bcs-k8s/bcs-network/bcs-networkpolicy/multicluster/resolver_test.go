@@ -0,0 +1,155 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package multicluster
+
+import (
+	"testing"
+
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-networkpolicy/options"
+	netextv1 "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/kubernetes/apis/networkextension/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	discovery "k8s.io/api/discovery/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func newPod(ns, name, ip string, podLabels map[string]string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: name, Labels: podLabels},
+		Status:     corev1.PodStatus{PodIP: ip},
+	}
+}
+
+func TestFilterPods(t *testing.T) {
+	pods := []*corev1.Pod{
+		newPod("ns1", "web-1", "10.0.0.1", map[string]string{"app": "web"}),
+		newPod("ns1", "web-2", "", map[string]string{"app": "web"}),
+		newPod("ns1", "db-1", "10.0.0.3", map[string]string{"app": "db"}),
+		newPod("ns2", "web-3", "10.0.0.4", map[string]string{"app": "web"}),
+	}
+	selector, err := metav1.LabelSelectorAsSelector(&metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{
+			{Key: "app", Operator: metav1.LabelSelectorOpIn, Values: []string{"web"}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		namespaces []string
+		selector   labels.Selector
+		expectIPs  []string
+	}{
+		{"selector in one namespace", []string{"ns1"}, selector, []string{"10.0.0.1"}},
+		{"selector in namespaces", []string{"ns1", "ns2"}, selector, []string{"10.0.0.1", "10.0.0.4"}},
+		{"everything", []string{"ns1"}, labels.Everything(), []string{"10.0.0.1", "10.0.0.3"}},
+		{"no namespace", nil, labels.Everything(), nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			podInfos := FilterPods(pods, test.namespaces, test.selector)
+			if len(podInfos) != len(test.expectIPs) {
+				t.Fatalf("expect %d pods, got %v", len(test.expectIPs), podInfos)
+			}
+			for i, podInfo := range podInfos {
+				if podInfo.IP != test.expectIPs[i] {
+					t.Errorf("expect ip %s, got %s", test.expectIPs[i], podInfo.IP)
+				}
+			}
+		})
+	}
+}
+
+func TestEndpointSlicesToPods(t *testing.T) {
+	notReady := false
+	slices := []*discovery.EndpointSlice{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "web-import-cluster2"},
+			Endpoints: []discovery.Endpoint{
+				{
+					Addresses: []string{"10.1.0.1"},
+					TargetRef: &corev1.ObjectReference{Namespace: "remote", Name: "web-1"},
+				},
+				{
+					Addresses:  []string{"10.1.0.2"},
+					Conditions: discovery.EndpointConditions{Ready: &notReady},
+				},
+				{Addresses: []string{"10.1.0.3"}},
+			},
+		},
+	}
+	podInfos := EndpointSlicesToPods(slices)
+	if len(podInfos) != 2 {
+		t.Fatalf("expect 2 pods, got %v", podInfos)
+	}
+	if podInfos[0].IP != "10.1.0.1" || podInfos[0].Namespace != "remote" || podInfos[0].Name != "web-1" {
+		t.Errorf("unexpected pod %+v", podInfos[0])
+	}
+	if podInfos[1].IP != "10.1.0.3" || podInfos[1].Namespace != "ns1" {
+		t.Errorf("unexpected pod %+v", podInfos[1])
+	}
+}
+
+func TestRemoteClusters(t *testing.T) {
+	r := &Resolver{opt: &options.NetworkPolicyOption{ClusterID: "BCS-K8S-00001"}}
+	policies := []*netextv1.MultiClusterNetworkPolicy{
+		{
+			Spec: netextv1.MultiClusterNetworkPolicySpec{
+				Ingress: []netextv1.MultiClusterNetworkPolicyIngressRule{
+					{From: []netextv1.MultiClusterNetworkPolicyPeer{
+						{ClusterID: "BCS-K8S-00002"},
+						{ClusterID: "BCS-K8S-00001"},
+						{},
+					}},
+				},
+				Egress: []netextv1.MultiClusterNetworkPolicyEgressRule{
+					{To: []netextv1.MultiClusterNetworkPolicyPeer{
+						{ClusterID: "BCS-K8S-00003"},
+						{ClusterID: "BCS-K8S-00004", ServiceImport: "web"},
+					}},
+				},
+			},
+		},
+	}
+	clusters := r.remoteClusters(policies)
+	if len(clusters) != 2 {
+		t.Fatalf("expect 2 remote clusters, got %v", clusters)
+	}
+	for _, clusterID := range []string{"BCS-K8S-00002", "BCS-K8S-00003"} {
+		if _, ok := clusters[clusterID]; !ok {
+			t.Errorf("expect remote cluster %s", clusterID)
+		}
+	}
+}
+
+func TestPodsFingerprint(t *testing.T) {
+	pods1 := []*corev1.Pod{
+		newPod("ns1", "web-1", "10.0.0.1", map[string]string{"app": "web", "version": "v1"}),
+		newPod("ns1", "web-2", "10.0.0.2", nil),
+	}
+	pods2 := []*corev1.Pod{
+		newPod("ns1", "web-2", "10.0.0.2", nil),
+		newPod("ns1", "web-1", "10.0.0.1", map[string]string{"version": "v1", "app": "web"}),
+	}
+	if podsFingerprint(pods1) != podsFingerprint(pods2) {
+		t.Errorf("fingerprint should not depend on order")
+	}
+	pods2[1].Labels["version"] = "v2"
+	if podsFingerprint(pods1) == podsFingerprint(pods2) {
+		t.Errorf("fingerprint should change with labels")
+	}
+}
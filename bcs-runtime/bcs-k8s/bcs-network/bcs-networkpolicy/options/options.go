@@ -48,6 +48,19 @@ type NetworkPolicyOption struct {
 	WorkMode             string `json:"workMode" value:"global" usage:"workmode for controller, available [global]/[pod]"`
 	DockerSock           string `json:"dockerSock" value:"unix:///var/run/docker.sock" usage:"docker socket file"`
 	Debug                bool   `json:"debug" value:"false" usage:"open pprof"`
+
+	// options for MultiClusterNetworkPolicy, only available in pod work mode
+	ClusterID              string `json:"clusterID" value:"" usage:"id of the cluster which controller runs in"`
+	StorageHosts           string `json:"storageHosts" value:"" usage:"bcs-storage or bcs-api-gateway addresses separated by comma, empty to disable multi-cluster network policy"`
+	StorageToken           string `json:"storageToken" value:"" usage:"auth token for bcs-storage"`
+	StorageGateway         bool   `json:"storageGateway" value:"false" usage:"whether storageHosts are addresses of bcs-api-gateway"`
+	StorageCAFile          string `json:"storageCAFile" value:"" usage:"ca file to verify bcs-storage over https, empty for http"`
+	MultiClusterSyncPeriod uint   `json:"multiClusterSyncPeriod" value:"60" usage:"interval for sync remote pods from bcs-storage in seconds; (default 60)"`
+}
+
+// MultiClusterEnabled returns whether MultiClusterNetworkPolicy is enabled
+func (opt *NetworkPolicyOption) MultiClusterEnabled() bool {
+	return opt.WorkMode == WorkModePod && len(opt.StorageHosts) != 0
 }
 
 // New new NetworkPolicyOption object
@@ -66,4 +79,7 @@ func Parse(opt *NetworkPolicyOption) {
 	if len(opt.Kubeconfig) == 0 {
 		blog.Fatal("kubeconfig cannot be empty")
 	}
+	if opt.MultiClusterEnabled() && len(opt.ClusterID) == 0 {
+		blog.Fatal("clusterID cannot be empty when storageHosts is set")
+	}
 }
@@ -29,7 +29,9 @@ import (
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-networkpolicy/datainformer"
 	infrk8s "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-networkpolicy/datainformer/kubernetes"
 	infrmesos "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-networkpolicy/datainformer/mesos"
+	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-networkpolicy/multicluster"
 	"github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/bcs-network/bcs-networkpolicy/options"
+	netextclientset "github.com/Tencent/bk-bcs/bcs-runtime/bcs-k8s/kubernetes/generated/clientset/versioned"
 	"github.com/emicklei/go-restful"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"k8s.io/client-go/kubernetes"
@@ -42,6 +44,7 @@ type Server struct {
 	opt              *options.NetworkPolicyOption
 	httpServer       *httpserver.HttpServer
 	infr             datainformer.Interface
+	resolver         *multicluster.Resolver
 	policyController controller.Controller
 }
 
@@ -54,7 +57,7 @@ func New(opt *options.NetworkPolicyOption) *Server {
 
 // Init create dataInformer and networkPolicy controller
 func (s *Server) Init() error {
-	clientSet, bcsClientSet, err := s.buildClientSet()
+	clientSet, bcsClientSet, netextClientSet, err := s.buildClientSet()
 	if err != nil {
 		return err
 	}
@@ -79,7 +82,15 @@ func (s *Server) Init() error {
 	case options.WorkModeGlobal:
 		npc, err = networkpolicy.NewNetworkPolicyController(clientSet, dataInformer, s.opt)
 	case options.WorkModePod:
-		npc, err = podpolicy.NewPodPolicyController(clientSet, dataInformer, s.opt)
+		if s.opt.MultiClusterEnabled() {
+			s.resolver, err = multicluster.New(s.opt)
+			if err != nil {
+				return fmt.Errorf("create multi-cluster resolver failed, err: %s", err.Error())
+			}
+			s.resolver.Init(clientSet, netextClientSet)
+			blog.Infof("MultiClusterNetworkPolicy is enabled in cluster %s", s.opt.ClusterID)
+		}
+		npc, err = podpolicy.NewPodPolicyController(clientSet, dataInformer, s.resolver, s.opt)
 	default:
 		return fmt.Errorf("unknown workMode '%s'", s.opt.WorkMode)
 	}
@@ -102,32 +113,39 @@ func (s *Server) Init() error {
 	return nil
 }
 
-// buildClientSet return kubernetes and bcs clientSet
-func (s *Server) buildClientSet() (client kubernetes.Interface, bcsClient bcsclientset.Interface, err error) {
+// buildClientSet return kubernetes, bcs and networkextension clientSet
+func (s *Server) buildClientSet() (client kubernetes.Interface, bcsClient bcsclientset.Interface,
+	netextClient netextclientset.Interface, err error) {
 	var clientConfig *rest.Config
 	if len(s.opt.Kubeconfig) != 0 {
 		clientConfig, err = clientcmd.BuildConfigFromFlags(s.opt.KubeMaster, s.opt.Kubeconfig)
 		if err != nil {
-			return client, bcsClient, fmt.Errorf("build configuration from %s, %s failed, err %s",
+			return client, bcsClient, netextClient, fmt.Errorf("build configuration from %s, %s failed, err %s",
 				s.opt.KubeMaster, s.opt.Kubeconfig, err.Error())
 		}
 	} else {
 		clientConfig, err = rest.InClusterConfig()
 		if err != nil {
-			return client, bcsClient, fmt.Errorf("init inCluster config failed, err %s", err.Error())
+			return client, bcsClient, netextClient, fmt.Errorf("init inCluster config failed, err %s", err.Error())
 		}
 	}
 
 	client, err = kubernetes.NewForConfig(clientConfig)
 	if err != nil {
-		return client, bcsClient, fmt.Errorf("create client set failed, err %s", err.Error())
+		return client, bcsClient, netextClient, fmt.Errorf("create client set failed, err %s", err.Error())
 	}
 
 	bcsClient, err = bcsclientset.NewForConfig(clientConfig)
 	if err != nil {
-		return client, bcsClient, fmt.Errorf("create bcs client set failed, err %s", err.Error())
+		return client, bcsClient, netextClient, fmt.Errorf("create bcs client set failed, err %s", err.Error())
+	}
+
+	netextClient, err = netextclientset.NewForConfig(clientConfig)
+	if err != nil {
+		return client, bcsClient, netextClient, fmt.Errorf("create networkextension client set failed, err %s",
+			err.Error())
 	}
-	return client, bcsClient, nil
+	return client, bcsClient, netextClient, nil
 }
 
 // buildHttpServer return httpServer object
@@ -161,6 +179,14 @@ func (s *Server) Run(stopCh chan struct{}) error {
 	}
 	blog.Infof("DataInformer is started.")
 
+	// Start multi-cluster resolver before controller, so that the first sync contains remote peers
+	if s.resolver != nil {
+		if err := s.resolver.Run(); err != nil {
+			return fmt.Errorf("start multi-cluster resolver failed, err %s", err.Error())
+		}
+		blog.Infof("Multi-cluster resolver is started.")
+	}
+
 	// Update dataInformer sync status of networkPolicy controller
 	s.policyController.SetDataInformerSynced()
 
@@ -226,6 +252,9 @@ func (s *Server) Run(stopCh chan struct{}) error {
 func (s *Server) Stop() {
 	blog.Infof("Stop data informer.")
 	s.infr.Stop()
+	if s.resolver != nil {
+		s.resolver.Stop()
+	}
 }
 
 func getRouteFunc(f http.HandlerFunc) restful.RouteFunction {
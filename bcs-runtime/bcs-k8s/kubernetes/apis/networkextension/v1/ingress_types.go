@@ -44,6 +44,8 @@ const (
 	// Pod CLB weight annotation key
 	AnnotationKeyForLoadbalanceWeight      = "networkextension.bkbcs.tencent.com/clb-weight"
 	AnnotationKeyForLoadbalanceWeightReady = "networkextension.bkbcs.tencent.com/clb-weight-ready"
+	// AnnotationKeyForLoadbalanceWeightSynced the clb weight of pod which has been synced to the cloud loadbalancer
+	AnnotationKeyForLoadbalanceWeightSynced = "networkextension.bkbcs.tencent.com/clb-weight-synced"
 )
 
 // EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
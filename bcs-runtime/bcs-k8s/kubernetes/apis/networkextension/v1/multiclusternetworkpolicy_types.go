@@ -0,0 +1,116 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package v1
+
+import (
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+const (
+	// MultiClusterLabelServiceName label key of endpoint slices imported by mcs ServiceImport
+	MultiClusterLabelServiceName = "multicluster.kubernetes.io/service-name"
+	// MultiClusterLabelSourceCluster label key of source cluster of endpoint slices imported by bcs-mcs
+	MultiClusterLabelSourceCluster = "mcs.bkbcs.tencent.com/config.cluster"
+)
+
+// MultiClusterNetworkPolicyPeer describes a peer to allow traffic to/from, peer pods can run in other clusters
+type MultiClusterNetworkPolicyPeer struct {
+	// ClusterID id of the cluster which peer pods run in, empty for local cluster
+	// +optional
+	ClusterID string `json:"clusterID,omitempty"`
+
+	// Namespaces namespaces of peer pods, empty for the namespace of policy
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// PodSelector selects peer pods by labels, nil selects all pods in the namespaces
+	// +optional
+	PodSelector *metav1.LabelSelector `json:"podSelector,omitempty"`
+
+	// ServiceImport name of mcs ServiceImport in the namespace of policy. When it is set, peer ips are resolved
+	// from the endpoints imported from ClusterID, Namespaces and PodSelector are ignored
+	// +optional
+	ServiceImport string `json:"serviceImport,omitempty"`
+}
+
+// MultiClusterNetworkPolicyIngressRule ingress rule of multi-cluster network policy
+type MultiClusterNetworkPolicyIngressRule struct {
+	// Ports ports which traffic is allowed to, empty matches all ports
+	// +optional
+	Ports []networkingv1.NetworkPolicyPort `json:"ports,omitempty"`
+
+	// From peers which traffic is allowed from, empty matches all sources
+	// +optional
+	From []MultiClusterNetworkPolicyPeer `json:"from,omitempty"`
+}
+
+// MultiClusterNetworkPolicyEgressRule egress rule of multi-cluster network policy
+type MultiClusterNetworkPolicyEgressRule struct {
+	// Ports ports which traffic is allowed to, empty matches all ports
+	// +optional
+	Ports []networkingv1.NetworkPolicyPort `json:"ports,omitempty"`
+
+	// To peers which traffic is allowed to, empty matches all destinations
+	// +optional
+	To []MultiClusterNetworkPolicyPeer `json:"to,omitempty"`
+}
+
+// MultiClusterNetworkPolicySpec defines the desired state of MultiClusterNetworkPolicy
+type MultiClusterNetworkPolicySpec struct {
+	// PodSelector selects pods in the namespace of policy which the policy applies to,
+	// only matchLabels is supported
+	PodSelector metav1.LabelSelector `json:"podSelector"`
+
+	// +optional
+	Ingress []MultiClusterNetworkPolicyIngressRule `json:"ingress,omitempty"`
+
+	// +optional
+	Egress []MultiClusterNetworkPolicyEgressRule `json:"egress,omitempty"`
+
+	// PolicyTypes same as policyTypes of NetworkPolicy
+	// +optional
+	PolicyTypes []networkingv1.PolicyType `json:"policyTypes,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:shortName=mcnp
+
+// MultiClusterNetworkPolicy is the Schema for the multiclusternetworkpolicies API
+type MultiClusterNetworkPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec MultiClusterNetworkPolicySpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MultiClusterNetworkPolicyList contains a list of MultiClusterNetworkPolicy
+type MultiClusterNetworkPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MultiClusterNetworkPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&MultiClusterNetworkPolicy{}, &MultiClusterNetworkPolicyList{})
+}
@@ -66,6 +66,107 @@ type PortPoolSpec struct {
 
 	PoolItems         []*PortPoolItem           `json:"poolItems"`
 	ListenerAttribute *IngressListenerAttribute `json:"listenerAttribute,omitempty"`
+	AutoExpand        *PortPoolAutoExpand       `json:"autoExpand,omitempty"`
+}
+
+const (
+	// PortPoolAutoExpandStrategyExtendPort expand port pool by increasing end port of the last item
+	PortPoolAutoExpandStrategyExtendPort = "ExtendPort"
+	// PortPoolAutoExpandStrategyNewLoadBalancer expand port pool by appending item with new created loadbalancer
+	PortPoolAutoExpandStrategyNewLoadBalancer = "NewLoadBalancer"
+
+	// DefaultPortPoolAutoExpandUtilizationThreshold default utilization threshold in percent for expansion
+	DefaultPortPoolAutoExpandUtilizationThreshold = 80
+	// DefaultPortPoolAutoExpandCooldownSeconds default cooldown seconds between two expansions
+	DefaultPortPoolAutoExpandCooldownSeconds = 300
+	// DefaultPortPoolAutoExpandMaxEndPort default max end port for ExtendPort strategy
+	DefaultPortPoolAutoExpandMaxEndPort = 65535
+)
+
+// PortPoolAutoExpand policy for expanding port pool automatically before ports are exhausted
+type PortPoolAutoExpand struct {
+	// +kubebuilder:validation:Enum=ExtendPort;NewLoadBalancer
+	Strategy string `json:"strategy"`
+	// UtilizationThreshold expand pool when utilization percent of pool reaches the threshold, default 80
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:validation:Minimum=1
+	UtilizationThreshold int `json:"utilizationThreshold,omitempty"`
+	// ExhaustionWindowSeconds expand pool when ports are estimated to be exhausted within the window, 0 to disable
+	ExhaustionWindowSeconds int64 `json:"exhaustionWindowSeconds,omitempty"`
+	// CooldownSeconds min interval between two expansions, default 300
+	CooldownSeconds int64 `json:"cooldownSeconds,omitempty"`
+	// PortStep number of ports added to the last item for each expansion, used by ExtendPort strategy
+	PortStep uint32 `json:"portStep,omitempty"`
+	// MaxEndPort upper limit of end port, used by ExtendPort strategy, default 65535
+	// +kubebuilder:validation:Maximum=65535
+	MaxEndPort uint32 `json:"maxEndPort,omitempty"`
+	// MaxItems max number of pool items, used by NewLoadBalancer strategy
+	MaxItems int `json:"maxItems,omitempty"`
+	// LoadBalancer template for new created loadbalancers, used by NewLoadBalancer strategy
+	LoadBalancer *PortPoolLoadBalancerTemplate `json:"loadBalancer,omitempty"`
+}
+
+// PortPoolLoadBalancerTemplate template for loadbalancers created by port pool expansion
+type PortPoolLoadBalancerTemplate struct {
+	// Region region of loadbalancer, use region of controller if empty
+	Region string `json:"region,omitempty"`
+	// Type OPEN or INTERNAL
+	Type     string `json:"type,omitempty"`
+	VpcID    string `json:"vpcID,omitempty"`
+	SubnetID string `json:"subnetID,omitempty"`
+}
+
+// Validate do validation
+func (ppae *PortPoolAutoExpand) Validate() error {
+	if ppae == nil {
+		return nil
+	}
+	switch ppae.Strategy {
+	case PortPoolAutoExpandStrategyExtendPort:
+		if ppae.PortStep == 0 {
+			return fmt.Errorf("portStep cannot be zero for strategy %s", ppae.Strategy)
+		}
+		if ppae.MaxEndPort > DefaultPortPoolAutoExpandMaxEndPort {
+			return fmt.Errorf("maxEndPort should be no more than %d", DefaultPortPoolAutoExpandMaxEndPort)
+		}
+	case PortPoolAutoExpandStrategyNewLoadBalancer:
+		if ppae.MaxItems <= 0 {
+			return fmt.Errorf("maxItems should be positive for strategy %s", ppae.Strategy)
+		}
+	default:
+		return fmt.Errorf("invalid auto expand strategy %s", ppae.Strategy)
+	}
+	if ppae.UtilizationThreshold < 0 || ppae.UtilizationThreshold > 100 {
+		return fmt.Errorf("utilizationThreshold should be in [0, 100]")
+	}
+	if ppae.ExhaustionWindowSeconds < 0 || ppae.CooldownSeconds < 0 {
+		return fmt.Errorf("exhaustionWindowSeconds and cooldownSeconds cannot be negative")
+	}
+	return nil
+}
+
+// GetUtilizationThreshold get utilization threshold in percent
+func (ppae *PortPoolAutoExpand) GetUtilizationThreshold() int {
+	if ppae.UtilizationThreshold == 0 {
+		return DefaultPortPoolAutoExpandUtilizationThreshold
+	}
+	return ppae.UtilizationThreshold
+}
+
+// GetCooldownSeconds get cooldown seconds between two expansions
+func (ppae *PortPoolAutoExpand) GetCooldownSeconds() int64 {
+	if ppae.CooldownSeconds == 0 {
+		return DefaultPortPoolAutoExpandCooldownSeconds
+	}
+	return ppae.CooldownSeconds
+}
+
+// GetMaxEndPort get max end port for ExtendPort strategy
+func (ppae *PortPoolAutoExpand) GetMaxEndPort() uint32 {
+	if ppae.MaxEndPort == 0 {
+		return DefaultPortPoolAutoExpandMaxEndPort
+	}
+	return ppae.MaxEndPort
 }
 
 // PortPoolItemStatus status of a port pool item
@@ -78,6 +179,16 @@ type PortPoolItemStatus struct {
 	PoolItemLoadBalancers []*IngressLoadBalancer `json:"poolItemLoadBalancers,omitempty"`
 	Status                string                 `json:"status"`
 	Message               string                 `json:"message"`
+	// TotalPorts number of port segments of the item
+	TotalPorts int `json:"totalPorts,omitempty"`
+	// AllocatedPorts number of allocated port segments of the item
+	AllocatedPorts int `json:"allocatedPorts,omitempty"`
+	// Utilization percent of allocated port segments
+	Utilization int `json:"utilization,omitempty"`
+	// AllocationRate net number of port segments allocated per hour
+	AllocationRate string `json:"allocationRate,omitempty"`
+	// ExhaustionTime estimated time when ports of the item are exhausted
+	ExhaustionTime *metav1.Time `json:"exhaustionTime,omitempty"`
 }
 
 // GetKey get port pool item key
@@ -99,6 +210,12 @@ type PortPoolStatus struct {
 	// Important: Run "make" to regenerate code after modifying this file
 
 	PoolItemStatuses []*PortPoolItemStatus `json:"poolItems,omitempty"`
+	// Utilization percent of allocated port segments of the whole pool
+	Utilization int `json:"utilization,omitempty"`
+	// ExhaustionTime estimated time when ports of the whole pool are exhausted
+	ExhaustionTime *metav1.Time `json:"exhaustionTime,omitempty"`
+	// LastExpandTime last time when pool was expanded automatically
+	LastExpandTime *metav1.Time `json:"lastExpandTime,omitempty"`
 }
 
 // +kubebuilder:object:root=true
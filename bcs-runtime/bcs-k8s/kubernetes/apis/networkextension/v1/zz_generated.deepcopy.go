@@ -18,6 +18,8 @@
 package v1
 
 import (
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -655,6 +657,182 @@ func (in *ListenerTargetGroup) DeepCopy() *ListenerTargetGroup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiClusterNetworkPolicy) DeepCopyInto(out *MultiClusterNetworkPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiClusterNetworkPolicy.
+func (in *MultiClusterNetworkPolicy) DeepCopy() *MultiClusterNetworkPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiClusterNetworkPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MultiClusterNetworkPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiClusterNetworkPolicyEgressRule) DeepCopyInto(out *MultiClusterNetworkPolicyEgressRule) {
+	*out = *in
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]networkingv1.NetworkPolicyPort, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = make([]MultiClusterNetworkPolicyPeer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiClusterNetworkPolicyEgressRule.
+func (in *MultiClusterNetworkPolicyEgressRule) DeepCopy() *MultiClusterNetworkPolicyEgressRule {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiClusterNetworkPolicyEgressRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiClusterNetworkPolicyIngressRule) DeepCopyInto(out *MultiClusterNetworkPolicyIngressRule) {
+	*out = *in
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]networkingv1.NetworkPolicyPort, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.From != nil {
+		in, out := &in.From, &out.From
+		*out = make([]MultiClusterNetworkPolicyPeer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiClusterNetworkPolicyIngressRule.
+func (in *MultiClusterNetworkPolicyIngressRule) DeepCopy() *MultiClusterNetworkPolicyIngressRule {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiClusterNetworkPolicyIngressRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiClusterNetworkPolicyList) DeepCopyInto(out *MultiClusterNetworkPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MultiClusterNetworkPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiClusterNetworkPolicyList.
+func (in *MultiClusterNetworkPolicyList) DeepCopy() *MultiClusterNetworkPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiClusterNetworkPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MultiClusterNetworkPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiClusterNetworkPolicyPeer) DeepCopyInto(out *MultiClusterNetworkPolicyPeer) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PodSelector != nil {
+		in, out := &in.PodSelector, &out.PodSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiClusterNetworkPolicyPeer.
+func (in *MultiClusterNetworkPolicyPeer) DeepCopy() *MultiClusterNetworkPolicyPeer {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiClusterNetworkPolicyPeer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiClusterNetworkPolicySpec) DeepCopyInto(out *MultiClusterNetworkPolicySpec) {
+	*out = *in
+	in.PodSelector.DeepCopyInto(&out.PodSelector)
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = make([]MultiClusterNetworkPolicyIngressRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = make([]MultiClusterNetworkPolicyEgressRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PolicyTypes != nil {
+		in, out := &in.PolicyTypes, &out.PolicyTypes
+		*out = make([]networkingv1.PolicyType, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiClusterNetworkPolicySpec.
+func (in *MultiClusterNetworkPolicySpec) DeepCopy() *MultiClusterNetworkPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiClusterNetworkPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PortBinding) DeepCopyInto(out *PortBinding) {
 	*out = *in
@@ -844,6 +1022,26 @@ func (in *PortPool) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortPoolAutoExpand) DeepCopyInto(out *PortPoolAutoExpand) {
+	*out = *in
+	if in.LoadBalancer != nil {
+		in, out := &in.LoadBalancer, &out.LoadBalancer
+		*out = new(PortPoolLoadBalancerTemplate)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortPoolAutoExpand.
+func (in *PortPoolAutoExpand) DeepCopy() *PortPoolAutoExpand {
+	if in == nil {
+		return nil
+	}
+	out := new(PortPoolAutoExpand)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PortPoolItem) DeepCopyInto(out *PortPoolItem) {
 	*out = *in
@@ -883,6 +1081,10 @@ func (in *PortPoolItemStatus) DeepCopyInto(out *PortPoolItemStatus) {
 			}
 		}
 	}
+	if in.ExhaustionTime != nil {
+		in, out := &in.ExhaustionTime, &out.ExhaustionTime
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortPoolItemStatus.
@@ -927,6 +1129,21 @@ func (in *PortPoolList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortPoolLoadBalancerTemplate) DeepCopyInto(out *PortPoolLoadBalancerTemplate) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortPoolLoadBalancerTemplate.
+func (in *PortPoolLoadBalancerTemplate) DeepCopy() *PortPoolLoadBalancerTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(PortPoolLoadBalancerTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PortPoolSpec) DeepCopyInto(out *PortPoolSpec) {
 	*out = *in
@@ -946,6 +1163,11 @@ func (in *PortPoolSpec) DeepCopyInto(out *PortPoolSpec) {
 		*out = new(IngressListenerAttribute)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AutoExpand != nil {
+		in, out := &in.AutoExpand, &out.AutoExpand
+		*out = new(PortPoolAutoExpand)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortPoolSpec.
@@ -972,6 +1194,14 @@ func (in *PortPoolStatus) DeepCopyInto(out *PortPoolStatus) {
 			}
 		}
 	}
+	if in.ExhaustionTime != nil {
+		in, out := &in.ExhaustionTime, &out.ExhaustionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastExpandTime != nil {
+		in, out := &in.LastExpandTime, &out.LastExpandTime
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortPoolStatus.
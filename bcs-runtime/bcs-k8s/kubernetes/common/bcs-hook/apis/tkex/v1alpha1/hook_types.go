@@ -18,6 +18,7 @@ package v1alpha1
 import (
 	"time"
 
+	batchv1 "k8s.io/api/batch/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -171,6 +172,10 @@ type MetricProvider struct {
 	Prometheus *PrometheusMetric `json:"prometheus,omitempty"`
 	// Kubernetes specifies the kubernetes metric to operate
 	Kubernetes *KubernetesMetric `json:"kubernetes,omitempty"`
+	// GRPC specifies the grpc method to call
+	GRPC *GRPCMetric `json:"grpc,omitempty"`
+	// Job specifies the job to run
+	Job *JobMetric `json:"job,omitempty"`
 }
 
 // Field defines the path and vaule of Kubernetes metric type
@@ -211,6 +216,46 @@ type WebMetric struct {
 	JsonPath string `json:"jsonPath"`
 }
 
+// GRPCMetric is the metric type of grpc, the response message is decoded to json
+// and the value of JsonPath is the result
+type GRPCMetric struct {
+	// Address is the host and port of the grpc server, e.g. "{{ args.PodIP }}:9090"
+	// +kubebuilder:validation:Required
+	Address string `json:"address"`
+	// Method is the full method name, e.g. "grpc.health.v1.Health/Check". Methods other than
+	// the health check are resolved through the grpc server reflection service
+	// +kubebuilder:validation:Required
+	Method string `json:"method"`
+	// Request is the request message in json
+	Request string `json:"request,omitempty"`
+	// Metadata is sent as the grpc metadata of the call
+	Metadata       []WebMetricHeader `json:"metadata,omitempty"`
+	TimeoutSeconds int               `json:"timeoutSeconds,omitempty"`
+	// JsonPath is the path of the result in the response json, the whole response is used if not set
+	JsonPath string `json:"jsonPath,omitempty"`
+	// TLS enables tls for the connection
+	TLS *GRPCTLSConfig `json:"tls,omitempty"`
+}
+
+// GRPCTLSConfig defines the tls config of grpc metric
+type GRPCTLSConfig struct {
+	// InsecureSkipVerify skips the verification of the server certificate
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+	// ServerName is used to verify the server certificate, defaults to the host of Address
+	ServerName string `json:"serverName,omitempty"`
+	// CA is the PEM encoded ca certificate to verify the server certificate
+	CA string `json:"ca,omitempty"`
+}
+
+// JobMetric is the metric type of job, the measurement is successful if the job completes
+// and failed if the job fails. The args of the HookRun can be used in the template, e.g. "{{ args.PodIP }}"
+type JobMetric struct {
+	// Metadata is the labels and annotations of the job, the name is generated by the hookrun controller
+	Metadata metav1.ObjectMeta `json:"metadata,omitempty"`
+	// +kubebuilder:validation:Required
+	Spec batchv1.JobSpec `json:"spec"`
+}
+
 // WebMetricHeader defines values of the header in web
 type WebMetricHeader struct {
 	// +kubebuilder:validation:Required
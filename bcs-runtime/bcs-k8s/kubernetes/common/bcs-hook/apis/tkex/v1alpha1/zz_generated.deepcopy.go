@@ -42,6 +42,64 @@ func (in *Argument) DeepCopy() *Argument {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Field) DeepCopyInto(out *Field) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Field.
+func (in *Field) DeepCopy() *Field {
+	if in == nil {
+		return nil
+	}
+	out := new(Field)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCMetric) DeepCopyInto(out *GRPCMetric) {
+	*out = *in
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make([]WebMetricHeader, len(*in))
+		copy(*out, *in)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(GRPCTLSConfig)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCMetric.
+func (in *GRPCMetric) DeepCopy() *GRPCMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCTLSConfig) DeepCopyInto(out *GRPCTLSConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCTLSConfig.
+func (in *GRPCTLSConfig) DeepCopy() *GRPCTLSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCTLSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HookRun) DeepCopyInto(out *HookRun) {
 	*out = *in
@@ -287,6 +345,45 @@ func (in *HookTemplateSpec) DeepCopy() *HookTemplateSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobMetric) DeepCopyInto(out *JobMetric) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobMetric.
+func (in *JobMetric) DeepCopy() *JobMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(JobMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesMetric) DeepCopyInto(out *KubernetesMetric) {
+	*out = *in
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]Field, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesMetric.
+func (in *KubernetesMetric) DeepCopy() *KubernetesMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Measurement) DeepCopyInto(out *Measurement) {
 	*out = *in
@@ -355,6 +452,21 @@ func (in *MetricProvider) DeepCopyInto(out *MetricProvider) {
 		*out = new(PrometheusMetric)
 		**out = **in
 	}
+	if in.Kubernetes != nil {
+		in, out := &in.Kubernetes, &out.Kubernetes
+		*out = new(KubernetesMetric)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GRPC != nil {
+		in, out := &in.GRPC, &out.GRPC
+		*out = new(GRPCMetric)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Job != nil {
+		in, out := &in.Job, &out.Job
+		*out = new(JobMetric)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
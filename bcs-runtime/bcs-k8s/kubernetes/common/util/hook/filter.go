@@ -53,6 +53,17 @@ func GetCurrentStepHookRun(currentHrs []*hookv1alpha1.HookRun) *hookv1alpha1.Hoo
 	return nil
 }
 
+// GetCurrentAnalysisHookRun returns the background analysis hookrun of canary
+func GetCurrentAnalysisHookRun(currentHrs []*hookv1alpha1.HookRun) *hookv1alpha1.HookRun {
+	for _, hr := range currentHrs {
+		hookRunType, ok := hr.Labels[HookRunTypeLabel]
+		if ok && hookRunType == HookRunTypeCanaryAnalysisLabel {
+			return hr
+		}
+	}
+	return nil
+}
+
 func FilterHookRunsToDelete(hrs []*hookv1alpha1.HookRun, revision string) []*hookv1alpha1.HookRun {
 	hrsToDelete := []*hookv1alpha1.HookRun{}
 	for _, hr := range hrs {
@@ -36,6 +36,8 @@ const (
 	HookRunTypeLabel = "hookrun-type"
 	// HookRunTypeCanaryStepLabel is the value of label, indicating the type is canary-step
 	HookRunTypeCanaryStepLabel = "canary-step"
+	// HookRunTypeCanaryAnalysisLabel is the value of label, indicating the type is the background analysis of canary
+	HookRunTypeCanaryAnalysisLabel = "canary-analysis"
 	// HookRunTypePreDeleteLabel is the value of label, indicating the type is predelete
 	HookRunTypePreDeleteLabel = "pre-delete-step"
 	// HookRunTypePreInplaceLabel is the value of label, indicating the type is preinplace
@@ -69,6 +71,25 @@ func StepLabels(index int32, revision string) map[string]string {
 	}
 }
 
+// AnalysisLabels returns the labels of the background analysis of canary
+func AnalysisLabels(revision string) map[string]string {
+	return map[string]string{
+		WorkloadRevisionUniqueLabel: revision,
+		HookRunTypeLabel:            HookRunTypeCanaryAnalysisLabel,
+	}
+}
+
+// GetFailedMetricResult returns the first metric result which makes the hookrun failed, errored or inconclusive
+func GetFailedMetricResult(hr *hookv1alpha1.HookRun) *hookv1alpha1.MetricResult {
+	for i := range hr.Status.MetricResults {
+		switch hr.Status.MetricResults[i].Phase {
+		case hookv1alpha1.HookPhaseFailed, hookv1alpha1.HookPhaseError, hookv1alpha1.HookPhaseInconclusive:
+			return &hr.Status.MetricResults[i]
+		}
+	}
+	return nil
+}
+
 // NewHookRunFromTemplate returns the hookrun based on hooktemplate
 func NewHookRunFromTemplate(template *hookv1alpha1.HookTemplate, args []hookv1alpha1.Argument, name, generateName,
 	namespace string) (*hookv1alpha1.HookRun, error) {
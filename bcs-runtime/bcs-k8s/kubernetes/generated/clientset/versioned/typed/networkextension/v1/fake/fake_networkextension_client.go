@@ -32,6 +32,10 @@ func (c *FakeNetworkextensionV1) Listeners(namespace string) v1.ListenerInterfac
 	return &FakeListeners{c, namespace}
 }
 
+func (c *FakeNetworkextensionV1) MultiClusterNetworkPolicies(namespace string) v1.MultiClusterNetworkPolicyInterface {
+	return &FakeMultiClusterNetworkPolicies{c, namespace}
+}
+
 func (c *FakeNetworkextensionV1) PortBindings(namespace string) v1.PortBindingInterface {
 	return &FakePortBindings{c, namespace}
 }
@@ -18,6 +18,8 @@ type IngressExpansion interface{}
 
 type ListenerExpansion interface{}
 
+type MultiClusterNetworkPolicyExpansion interface{}
+
 type PortBindingExpansion interface{}
 
 type PortPoolExpansion interface{}
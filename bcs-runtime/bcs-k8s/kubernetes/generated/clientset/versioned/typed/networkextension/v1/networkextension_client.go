@@ -24,6 +24,7 @@ type NetworkextensionV1Interface interface {
 	RESTClient() rest.Interface
 	IngressesGetter
 	ListenersGetter
+	MultiClusterNetworkPoliciesGetter
 	PortBindingsGetter
 	PortPoolsGetter
 }
@@ -41,6 +42,10 @@ func (c *NetworkextensionV1Client) Listeners(namespace string) ListenerInterface
 	return newListeners(c, namespace)
 }
 
+func (c *NetworkextensionV1Client) MultiClusterNetworkPolicies(namespace string) MultiClusterNetworkPolicyInterface {
+	return newMultiClusterNetworkPolicies(c, namespace)
+}
+
 func (c *NetworkextensionV1Client) PortBindings(namespace string) PortBindingInterface {
 	return newPortBindings(c, namespace)
 }
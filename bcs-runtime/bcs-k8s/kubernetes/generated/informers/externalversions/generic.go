@@ -69,6 +69,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Networkextension().V1().Ingresses().Informer()}, nil
 	case networkextensionv1.SchemeGroupVersion.WithResource("listeners"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Networkextension().V1().Listeners().Informer()}, nil
+	case networkextensionv1.SchemeGroupVersion.WithResource("multiclusternetworkpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Networkextension().V1().MultiClusterNetworkPolicies().Informer()}, nil
 	case networkextensionv1.SchemeGroupVersion.WithResource("portbindings"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Networkextension().V1().PortBindings().Informer()}, nil
 	case networkextensionv1.SchemeGroupVersion.WithResource("portpools"):
@@ -24,6 +24,8 @@ type Interface interface {
 	Ingresses() IngressInformer
 	// Listeners returns a ListenerInformer.
 	Listeners() ListenerInformer
+	// MultiClusterNetworkPolicies returns a MultiClusterNetworkPolicyInformer.
+	MultiClusterNetworkPolicies() MultiClusterNetworkPolicyInformer
 	// PortBindings returns a PortBindingInformer.
 	PortBindings() PortBindingInformer
 	// PortPools returns a PortPoolInformer.
@@ -51,6 +53,11 @@ func (v *version) Listeners() ListenerInformer {
 	return &listenerInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// MultiClusterNetworkPolicies returns a MultiClusterNetworkPolicyInformer.
+func (v *version) MultiClusterNetworkPolicies() MultiClusterNetworkPolicyInformer {
+	return &multiClusterNetworkPolicyInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // PortBindings returns a PortBindingInformer.
 func (v *version) PortBindings() PortBindingInformer {
 	return &portBindingInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
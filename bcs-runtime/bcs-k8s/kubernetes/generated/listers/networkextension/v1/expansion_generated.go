@@ -30,6 +30,14 @@ type ListenerListerExpansion interface{}
 // ListenerNamespaceLister.
 type ListenerNamespaceListerExpansion interface{}
 
+// MultiClusterNetworkPolicyListerExpansion allows custom methods to be added to
+// MultiClusterNetworkPolicyLister.
+type MultiClusterNetworkPolicyListerExpansion interface{}
+
+// MultiClusterNetworkPolicyNamespaceListerExpansion allows custom methods to be added to
+// MultiClusterNetworkPolicyNamespaceLister.
+type MultiClusterNetworkPolicyNamespaceListerExpansion interface{}
+
 // PortBindingListerExpansion allows custom methods to be added to
 // PortBindingLister.
 type PortBindingListerExpansion interface{}
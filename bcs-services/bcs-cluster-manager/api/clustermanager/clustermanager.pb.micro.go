@@ -77,6 +77,13 @@ func NewClusterManagerEndpoints() []*api.Endpoint {
 			Body:    "*",
 			Handler: "rpc",
 		},
+		&api.Endpoint{
+			Name:    "ClusterManager.UpgradeCluster",
+			Path:    []string{"/clustermanager/v1/cluster/{clusterID}/upgrade"},
+			Method:  []string{"PUT"},
+			Body:    "*",
+			Handler: "rpc",
+		},
 		&api.Endpoint{
 			Name:    "ClusterManager.AddNodesToCluster",
 			Path:    []string{"/clustermanager/v1/cluster/{clusterID}/node"},
@@ -122,6 +129,13 @@ func NewClusterManagerEndpoints() []*api.Endpoint {
 			Method:  []string{"GET"},
 			Handler: "rpc",
 		},
+		&api.Endpoint{
+			Name:    "ClusterManager.ListClusterDrift",
+			Path:    []string{"/clustermanager/v1/clusterdrift"},
+			Method:  []string{"GET"},
+			Body:    "",
+			Handler: "rpc",
+		},
 		&api.Endpoint{
 			Name:    "ClusterManager.GetNode",
 			Path:    []string{"/clustermanager/v1/node/{innerIP}"},
@@ -156,6 +170,13 @@ func NewClusterManagerEndpoints() []*api.Endpoint {
 			Body:    "*",
 			Handler: "rpc",
 		},
+		&api.Endpoint{
+			Name:    "ClusterManager.DrainNode",
+			Path:    []string{"/clustermanager/v1/node/drain"},
+			Method:  []string{"PUT"},
+			Body:    "*",
+			Handler: "rpc",
+		},
 		&api.Endpoint{
 			Name:    "ClusterManager.GetClusterCredential",
 			Path:    []string{"/clustermanager/v1/clustercredential/{serverKey}"},
@@ -388,6 +409,13 @@ func NewClusterManagerEndpoints() []*api.Endpoint {
 			Body:    "*",
 			Handler: "rpc",
 		},
+		&api.Endpoint{
+			Name:    "ClusterManager.UpgradeNodeGroup",
+			Path:    []string{"/clustermanager/v1/nodegroup/{nodeGroupID}/upgrade"},
+			Method:  []string{"PUT"},
+			Body:    "*",
+			Handler: "rpc",
+		},
 		&api.Endpoint{
 			Name:    "ClusterManager.DeleteNodeGroup",
 			Path:    []string{"/clustermanager/v1/nodegroup/{nodeGroupID}"},
@@ -476,6 +504,13 @@ func NewClusterManagerEndpoints() []*api.Endpoint {
 			Body:    "*",
 			Handler: "rpc",
 		},
+		&api.Endpoint{
+			Name:    "ClusterManager.SkipTaskStep",
+			Path:    []string{"/clustermanager/v1/task/{taskID}/step/{stepName}/skip"},
+			Method:  []string{"PUT"},
+			Body:    "*",
+			Handler: "rpc",
+		},
 		&api.Endpoint{
 			Name:    "ClusterManager.UpdateTask",
 			Path:    []string{"/clustermanager/v1/task/{taskID}"},
@@ -604,6 +639,76 @@ func NewClusterManagerEndpoints() []*api.Endpoint {
 			Method:  []string{"GET"},
 			Handler: "rpc",
 		},
+		&api.Endpoint{
+			Name:    "ClusterManager.CreateClusterTemplate",
+			Path:    []string{"/clustermanager/v1/clustertemplate"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "ClusterManager.UpdateClusterTemplate",
+			Path:    []string{"/clustermanager/v1/clustertemplate/{templateID}"},
+			Method:  []string{"PUT"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "ClusterManager.DeleteClusterTemplate",
+			Path:    []string{"/clustermanager/v1/clustertemplate/{templateID}"},
+			Method:  []string{"DELETE"},
+			Body:    "",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "ClusterManager.GetClusterTemplate",
+			Path:    []string{"/clustermanager/v1/clustertemplate/{templateID}"},
+			Method:  []string{"GET"},
+			Body:    "",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "ClusterManager.ListClusterTemplate",
+			Path:    []string{"/clustermanager/v1/clustertemplate"},
+			Method:  []string{"GET"},
+			Body:    "",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "ClusterManager.CreateNodeGroupTemplate",
+			Path:    []string{"/clustermanager/v1/nodegrouptemplate"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "ClusterManager.UpdateNodeGroupTemplate",
+			Path:    []string{"/clustermanager/v1/nodegrouptemplate/{templateID}"},
+			Method:  []string{"PUT"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "ClusterManager.DeleteNodeGroupTemplate",
+			Path:    []string{"/clustermanager/v1/nodegrouptemplate/{templateID}"},
+			Method:  []string{"DELETE"},
+			Body:    "",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "ClusterManager.GetNodeGroupTemplate",
+			Path:    []string{"/clustermanager/v1/nodegrouptemplate/{templateID}"},
+			Method:  []string{"GET"},
+			Body:    "",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "ClusterManager.ListNodeGroupTemplate",
+			Path:    []string{"/clustermanager/v1/nodegrouptemplate"},
+			Method:  []string{"GET"},
+			Body:    "",
+			Handler: "rpc",
+		},
 	}
 }
 
@@ -616,6 +721,7 @@ type ClusterManagerService interface {
 	CheckCloudKubeConfig(ctx context.Context, in *KubeConfigReq, opts ...client.CallOption) (*KubeConfigResp, error)
 	ImportCluster(ctx context.Context, in *ImportClusterReq, opts ...client.CallOption) (*ImportClusterResp, error)
 	UpdateCluster(ctx context.Context, in *UpdateClusterReq, opts ...client.CallOption) (*UpdateClusterResp, error)
+	UpgradeCluster(ctx context.Context, in *UpgradeClusterReq, opts ...client.CallOption) (*UpgradeClusterResp, error)
 	AddNodesToCluster(ctx context.Context, in *AddNodesRequest, opts ...client.CallOption) (*AddNodesResponse, error)
 	DeleteNodesFromCluster(ctx context.Context, in *DeleteNodesRequest, opts ...client.CallOption) (*DeleteNodesResponse, error)
 	ListNodesInCluster(ctx context.Context, in *ListNodesInClusterRequest, opts ...client.CallOption) (*ListNodesInClusterResponse, error)
@@ -623,12 +729,14 @@ type ClusterManagerService interface {
 	GetCluster(ctx context.Context, in *GetClusterReq, opts ...client.CallOption) (*GetClusterResp, error)
 	ListCluster(ctx context.Context, in *ListClusterReq, opts ...client.CallOption) (*ListClusterResp, error)
 	ListCommonCluster(ctx context.Context, in *ListCommonClusterReq, opts ...client.CallOption) (*ListCommonClusterResp, error)
+	ListClusterDrift(ctx context.Context, in *ListClusterDriftRequest, opts ...client.CallOption) (*ListClusterDriftResponse, error)
 	//* node management
 	GetNode(ctx context.Context, in *GetNodeRequest, opts ...client.CallOption) (*GetNodeResponse, error)
 	UpdateNode(ctx context.Context, in *UpdateNodeRequest, opts ...client.CallOption) (*UpdateNodeResponse, error)
 	CheckNodeInCluster(ctx context.Context, in *CheckNodesRequest, opts ...client.CallOption) (*CheckNodesResponse, error)
 	CordonNode(ctx context.Context, in *CordonNodeRequest, opts ...client.CallOption) (*CordonNodeResponse, error)
 	UnCordonNode(ctx context.Context, in *UnCordonNodeRequest, opts ...client.CallOption) (*UnCordonNodeResponse, error)
+	DrainNode(ctx context.Context, in *DrainNodeRequest, opts ...client.CallOption) (*DrainNodeResponse, error)
 	//* cluster credential management
 	GetClusterCredential(ctx context.Context, in *GetClusterCredentialReq, opts ...client.CallOption) (*GetClusterCredentialResp, error)
 	UpdateClusterCredential(ctx context.Context, in *UpdateClusterCredentialReq, opts ...client.CallOption) (*UpdateClusterCredentialResp, error)
@@ -672,6 +780,7 @@ type ClusterManagerService interface {
 	//* NodeGroup information management *
 	CreateNodeGroup(ctx context.Context, in *CreateNodeGroupRequest, opts ...client.CallOption) (*CreateNodeGroupResponse, error)
 	UpdateNodeGroup(ctx context.Context, in *UpdateNodeGroupRequest, opts ...client.CallOption) (*UpdateNodeGroupResponse, error)
+	UpgradeNodeGroup(ctx context.Context, in *UpgradeNodeGroupRequest, opts ...client.CallOption) (*UpgradeNodeGroupResponse, error)
 	DeleteNodeGroup(ctx context.Context, in *DeleteNodeGroupRequest, opts ...client.CallOption) (*DeleteNodeGroupResponse, error)
 	GetNodeGroup(ctx context.Context, in *GetNodeGroupRequest, opts ...client.CallOption) (*GetNodeGroupResponse, error)
 	ListNodeGroup(ctx context.Context, in *ListNodeGroupRequest, opts ...client.CallOption) (*ListNodeGroupResponse, error)
@@ -686,6 +795,7 @@ type ClusterManagerService interface {
 	//* Task information management *
 	CreateTask(ctx context.Context, in *CreateTaskRequest, opts ...client.CallOption) (*CreateTaskResponse, error)
 	RetryTask(ctx context.Context, in *RetryTaskRequest, opts ...client.CallOption) (*RetryTaskResponse, error)
+	SkipTaskStep(ctx context.Context, in *SkipTaskStepRequest, opts ...client.CallOption) (*SkipTaskStepResponse, error)
 	UpdateTask(ctx context.Context, in *UpdateTaskRequest, opts ...client.CallOption) (*UpdateTaskResponse, error)
 	DeleteTask(ctx context.Context, in *DeleteTaskRequest, opts ...client.CallOption) (*DeleteTaskResponse, error)
 	GetTask(ctx context.Context, in *GetTaskRequest, opts ...client.CallOption) (*GetTaskResponse, error)
@@ -709,6 +819,16 @@ type ClusterManagerService interface {
 	ListCloudSecurityGroups(ctx context.Context, in *ListCloudSecurityGroupsRequest, opts ...client.CallOption) (*ListCloudSecurityGroupsResponse, error)
 	ListCloudInstanceTypes(ctx context.Context, in *ListCloudInstanceTypeRequest, opts ...client.CallOption) (*ListCloudInstanceTypeResponse, error)
 	ListCloudOsImage(ctx context.Context, in *ListCloudOsImageRequest, opts ...client.CallOption) (*ListCloudOsImageResponse, error)
+	CreateClusterTemplate(ctx context.Context, in *CreateClusterTemplateRequest, opts ...client.CallOption) (*CreateClusterTemplateResponse, error)
+	UpdateClusterTemplate(ctx context.Context, in *UpdateClusterTemplateRequest, opts ...client.CallOption) (*UpdateClusterTemplateResponse, error)
+	DeleteClusterTemplate(ctx context.Context, in *DeleteClusterTemplateRequest, opts ...client.CallOption) (*DeleteClusterTemplateResponse, error)
+	GetClusterTemplate(ctx context.Context, in *GetClusterTemplateRequest, opts ...client.CallOption) (*GetClusterTemplateResponse, error)
+	ListClusterTemplate(ctx context.Context, in *ListClusterTemplateRequest, opts ...client.CallOption) (*ListClusterTemplateResponse, error)
+	CreateNodeGroupTemplate(ctx context.Context, in *CreateNodeGroupTemplateRequest, opts ...client.CallOption) (*CreateNodeGroupTemplateResponse, error)
+	UpdateNodeGroupTemplate(ctx context.Context, in *UpdateNodeGroupTemplateRequest, opts ...client.CallOption) (*UpdateNodeGroupTemplateResponse, error)
+	DeleteNodeGroupTemplate(ctx context.Context, in *DeleteNodeGroupTemplateRequest, opts ...client.CallOption) (*DeleteNodeGroupTemplateResponse, error)
+	GetNodeGroupTemplate(ctx context.Context, in *GetNodeGroupTemplateRequest, opts ...client.CallOption) (*GetNodeGroupTemplateResponse, error)
+	ListNodeGroupTemplate(ctx context.Context, in *ListNodeGroupTemplateRequest, opts ...client.CallOption) (*ListNodeGroupTemplateResponse, error)
 }
 
 type clusterManagerService struct {
@@ -773,6 +893,16 @@ func (c *clusterManagerService) UpdateCluster(ctx context.Context, in *UpdateClu
 	return out, nil
 }
 
+func (c *clusterManagerService) UpgradeCluster(ctx context.Context, in *UpgradeClusterReq, opts ...client.CallOption) (*UpgradeClusterResp, error) {
+	req := c.c.NewRequest(c.name, "ClusterManager.UpgradeCluster", in)
+	out := new(UpgradeClusterResp)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clusterManagerService) AddNodesToCluster(ctx context.Context, in *AddNodesRequest, opts ...client.CallOption) (*AddNodesResponse, error) {
 	req := c.c.NewRequest(c.name, "ClusterManager.AddNodesToCluster", in)
 	out := new(AddNodesResponse)
@@ -843,6 +973,16 @@ func (c *clusterManagerService) ListCommonCluster(ctx context.Context, in *ListC
 	return out, nil
 }
 
+func (c *clusterManagerService) ListClusterDrift(ctx context.Context, in *ListClusterDriftRequest, opts ...client.CallOption) (*ListClusterDriftResponse, error) {
+	req := c.c.NewRequest(c.name, "ClusterManager.ListClusterDrift", in)
+	out := new(ListClusterDriftResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clusterManagerService) GetNode(ctx context.Context, in *GetNodeRequest, opts ...client.CallOption) (*GetNodeResponse, error) {
 	req := c.c.NewRequest(c.name, "ClusterManager.GetNode", in)
 	out := new(GetNodeResponse)
@@ -893,6 +1033,16 @@ func (c *clusterManagerService) UnCordonNode(ctx context.Context, in *UnCordonNo
 	return out, nil
 }
 
+func (c *clusterManagerService) DrainNode(ctx context.Context, in *DrainNodeRequest, opts ...client.CallOption) (*DrainNodeResponse, error) {
+	req := c.c.NewRequest(c.name, "ClusterManager.DrainNode", in)
+	out := new(DrainNodeResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clusterManagerService) GetClusterCredential(ctx context.Context, in *GetClusterCredentialReq, opts ...client.CallOption) (*GetClusterCredentialResp, error) {
 	req := c.c.NewRequest(c.name, "ClusterManager.GetClusterCredential", in)
 	out := new(GetClusterCredentialResp)
@@ -1243,6 +1393,16 @@ func (c *clusterManagerService) UpdateNodeGroup(ctx context.Context, in *UpdateN
 	return out, nil
 }
 
+func (c *clusterManagerService) UpgradeNodeGroup(ctx context.Context, in *UpgradeNodeGroupRequest, opts ...client.CallOption) (*UpgradeNodeGroupResponse, error) {
+	req := c.c.NewRequest(c.name, "ClusterManager.UpgradeNodeGroup", in)
+	out := new(UpgradeNodeGroupResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clusterManagerService) DeleteNodeGroup(ctx context.Context, in *DeleteNodeGroupRequest, opts ...client.CallOption) (*DeleteNodeGroupResponse, error) {
 	req := c.c.NewRequest(c.name, "ClusterManager.DeleteNodeGroup", in)
 	out := new(DeleteNodeGroupResponse)
@@ -1373,6 +1533,16 @@ func (c *clusterManagerService) RetryTask(ctx context.Context, in *RetryTaskRequ
 	return out, nil
 }
 
+func (c *clusterManagerService) SkipTaskStep(ctx context.Context, in *SkipTaskStepRequest, opts ...client.CallOption) (*SkipTaskStepResponse, error) {
+	req := c.c.NewRequest(c.name, "ClusterManager.SkipTaskStep", in)
+	out := new(SkipTaskStepResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clusterManagerService) UpdateTask(ctx context.Context, in *UpdateTaskRequest, opts ...client.CallOption) (*UpdateTaskResponse, error) {
 	req := c.c.NewRequest(c.name, "ClusterManager.UpdateTask", in)
 	out := new(UpdateTaskResponse)
@@ -1573,6 +1743,106 @@ func (c *clusterManagerService) ListCloudOsImage(ctx context.Context, in *ListCl
 	return out, nil
 }
 
+func (c *clusterManagerService) CreateClusterTemplate(ctx context.Context, in *CreateClusterTemplateRequest, opts ...client.CallOption) (*CreateClusterTemplateResponse, error) {
+	req := c.c.NewRequest(c.name, "ClusterManager.CreateClusterTemplate", in)
+	out := new(CreateClusterTemplateResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterManagerService) UpdateClusterTemplate(ctx context.Context, in *UpdateClusterTemplateRequest, opts ...client.CallOption) (*UpdateClusterTemplateResponse, error) {
+	req := c.c.NewRequest(c.name, "ClusterManager.UpdateClusterTemplate", in)
+	out := new(UpdateClusterTemplateResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterManagerService) DeleteClusterTemplate(ctx context.Context, in *DeleteClusterTemplateRequest, opts ...client.CallOption) (*DeleteClusterTemplateResponse, error) {
+	req := c.c.NewRequest(c.name, "ClusterManager.DeleteClusterTemplate", in)
+	out := new(DeleteClusterTemplateResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterManagerService) GetClusterTemplate(ctx context.Context, in *GetClusterTemplateRequest, opts ...client.CallOption) (*GetClusterTemplateResponse, error) {
+	req := c.c.NewRequest(c.name, "ClusterManager.GetClusterTemplate", in)
+	out := new(GetClusterTemplateResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterManagerService) ListClusterTemplate(ctx context.Context, in *ListClusterTemplateRequest, opts ...client.CallOption) (*ListClusterTemplateResponse, error) {
+	req := c.c.NewRequest(c.name, "ClusterManager.ListClusterTemplate", in)
+	out := new(ListClusterTemplateResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterManagerService) CreateNodeGroupTemplate(ctx context.Context, in *CreateNodeGroupTemplateRequest, opts ...client.CallOption) (*CreateNodeGroupTemplateResponse, error) {
+	req := c.c.NewRequest(c.name, "ClusterManager.CreateNodeGroupTemplate", in)
+	out := new(CreateNodeGroupTemplateResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterManagerService) UpdateNodeGroupTemplate(ctx context.Context, in *UpdateNodeGroupTemplateRequest, opts ...client.CallOption) (*UpdateNodeGroupTemplateResponse, error) {
+	req := c.c.NewRequest(c.name, "ClusterManager.UpdateNodeGroupTemplate", in)
+	out := new(UpdateNodeGroupTemplateResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterManagerService) DeleteNodeGroupTemplate(ctx context.Context, in *DeleteNodeGroupTemplateRequest, opts ...client.CallOption) (*DeleteNodeGroupTemplateResponse, error) {
+	req := c.c.NewRequest(c.name, "ClusterManager.DeleteNodeGroupTemplate", in)
+	out := new(DeleteNodeGroupTemplateResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterManagerService) GetNodeGroupTemplate(ctx context.Context, in *GetNodeGroupTemplateRequest, opts ...client.CallOption) (*GetNodeGroupTemplateResponse, error) {
+	req := c.c.NewRequest(c.name, "ClusterManager.GetNodeGroupTemplate", in)
+	out := new(GetNodeGroupTemplateResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterManagerService) ListNodeGroupTemplate(ctx context.Context, in *ListNodeGroupTemplateRequest, opts ...client.CallOption) (*ListNodeGroupTemplateResponse, error) {
+	req := c.c.NewRequest(c.name, "ClusterManager.ListNodeGroupTemplate", in)
+	out := new(ListNodeGroupTemplateResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for ClusterManager service
 
 type ClusterManagerHandler interface {
@@ -1582,6 +1852,7 @@ type ClusterManagerHandler interface {
 	CheckCloudKubeConfig(context.Context, *KubeConfigReq, *KubeConfigResp) error
 	ImportCluster(context.Context, *ImportClusterReq, *ImportClusterResp) error
 	UpdateCluster(context.Context, *UpdateClusterReq, *UpdateClusterResp) error
+	UpgradeCluster(context.Context, *UpgradeClusterReq, *UpgradeClusterResp) error
 	AddNodesToCluster(context.Context, *AddNodesRequest, *AddNodesResponse) error
 	DeleteNodesFromCluster(context.Context, *DeleteNodesRequest, *DeleteNodesResponse) error
 	ListNodesInCluster(context.Context, *ListNodesInClusterRequest, *ListNodesInClusterResponse) error
@@ -1589,12 +1860,14 @@ type ClusterManagerHandler interface {
 	GetCluster(context.Context, *GetClusterReq, *GetClusterResp) error
 	ListCluster(context.Context, *ListClusterReq, *ListClusterResp) error
 	ListCommonCluster(context.Context, *ListCommonClusterReq, *ListCommonClusterResp) error
+	ListClusterDrift(context.Context, *ListClusterDriftRequest, *ListClusterDriftResponse) error
 	//* node management
 	GetNode(context.Context, *GetNodeRequest, *GetNodeResponse) error
 	UpdateNode(context.Context, *UpdateNodeRequest, *UpdateNodeResponse) error
 	CheckNodeInCluster(context.Context, *CheckNodesRequest, *CheckNodesResponse) error
 	CordonNode(context.Context, *CordonNodeRequest, *CordonNodeResponse) error
 	UnCordonNode(context.Context, *UnCordonNodeRequest, *UnCordonNodeResponse) error
+	DrainNode(context.Context, *DrainNodeRequest, *DrainNodeResponse) error
 	//* cluster credential management
 	GetClusterCredential(context.Context, *GetClusterCredentialReq, *GetClusterCredentialResp) error
 	UpdateClusterCredential(context.Context, *UpdateClusterCredentialReq, *UpdateClusterCredentialResp) error
@@ -1638,6 +1911,7 @@ type ClusterManagerHandler interface {
 	//* NodeGroup information management *
 	CreateNodeGroup(context.Context, *CreateNodeGroupRequest, *CreateNodeGroupResponse) error
 	UpdateNodeGroup(context.Context, *UpdateNodeGroupRequest, *UpdateNodeGroupResponse) error
+	UpgradeNodeGroup(context.Context, *UpgradeNodeGroupRequest, *UpgradeNodeGroupResponse) error
 	DeleteNodeGroup(context.Context, *DeleteNodeGroupRequest, *DeleteNodeGroupResponse) error
 	GetNodeGroup(context.Context, *GetNodeGroupRequest, *GetNodeGroupResponse) error
 	ListNodeGroup(context.Context, *ListNodeGroupRequest, *ListNodeGroupResponse) error
@@ -1652,6 +1926,7 @@ type ClusterManagerHandler interface {
 	//* Task information management *
 	CreateTask(context.Context, *CreateTaskRequest, *CreateTaskResponse) error
 	RetryTask(context.Context, *RetryTaskRequest, *RetryTaskResponse) error
+	SkipTaskStep(context.Context, *SkipTaskStepRequest, *SkipTaskStepResponse) error
 	UpdateTask(context.Context, *UpdateTaskRequest, *UpdateTaskResponse) error
 	DeleteTask(context.Context, *DeleteTaskRequest, *DeleteTaskResponse) error
 	GetTask(context.Context, *GetTaskRequest, *GetTaskResponse) error
@@ -1675,6 +1950,16 @@ type ClusterManagerHandler interface {
 	ListCloudSecurityGroups(context.Context, *ListCloudSecurityGroupsRequest, *ListCloudSecurityGroupsResponse) error
 	ListCloudInstanceTypes(context.Context, *ListCloudInstanceTypeRequest, *ListCloudInstanceTypeResponse) error
 	ListCloudOsImage(context.Context, *ListCloudOsImageRequest, *ListCloudOsImageResponse) error
+	CreateClusterTemplate(context.Context, *CreateClusterTemplateRequest, *CreateClusterTemplateResponse) error
+	UpdateClusterTemplate(context.Context, *UpdateClusterTemplateRequest, *UpdateClusterTemplateResponse) error
+	DeleteClusterTemplate(context.Context, *DeleteClusterTemplateRequest, *DeleteClusterTemplateResponse) error
+	GetClusterTemplate(context.Context, *GetClusterTemplateRequest, *GetClusterTemplateResponse) error
+	ListClusterTemplate(context.Context, *ListClusterTemplateRequest, *ListClusterTemplateResponse) error
+	CreateNodeGroupTemplate(context.Context, *CreateNodeGroupTemplateRequest, *CreateNodeGroupTemplateResponse) error
+	UpdateNodeGroupTemplate(context.Context, *UpdateNodeGroupTemplateRequest, *UpdateNodeGroupTemplateResponse) error
+	DeleteNodeGroupTemplate(context.Context, *DeleteNodeGroupTemplateRequest, *DeleteNodeGroupTemplateResponse) error
+	GetNodeGroupTemplate(context.Context, *GetNodeGroupTemplateRequest, *GetNodeGroupTemplateResponse) error
+	ListNodeGroupTemplate(context.Context, *ListNodeGroupTemplateRequest, *ListNodeGroupTemplateResponse) error
 }
 
 func RegisterClusterManagerHandler(s server.Server, hdlr ClusterManagerHandler, opts ...server.HandlerOption) error {
@@ -1684,6 +1969,7 @@ func RegisterClusterManagerHandler(s server.Server, hdlr ClusterManagerHandler,
 		CheckCloudKubeConfig(ctx context.Context, in *KubeConfigReq, out *KubeConfigResp) error
 		ImportCluster(ctx context.Context, in *ImportClusterReq, out *ImportClusterResp) error
 		UpdateCluster(ctx context.Context, in *UpdateClusterReq, out *UpdateClusterResp) error
+		UpgradeCluster(ctx context.Context, in *UpgradeClusterReq, out *UpgradeClusterResp) error
 		AddNodesToCluster(ctx context.Context, in *AddNodesRequest, out *AddNodesResponse) error
 		DeleteNodesFromCluster(ctx context.Context, in *DeleteNodesRequest, out *DeleteNodesResponse) error
 		ListNodesInCluster(ctx context.Context, in *ListNodesInClusterRequest, out *ListNodesInClusterResponse) error
@@ -1691,11 +1977,13 @@ func RegisterClusterManagerHandler(s server.Server, hdlr ClusterManagerHandler,
 		GetCluster(ctx context.Context, in *GetClusterReq, out *GetClusterResp) error
 		ListCluster(ctx context.Context, in *ListClusterReq, out *ListClusterResp) error
 		ListCommonCluster(ctx context.Context, in *ListCommonClusterReq, out *ListCommonClusterResp) error
+		ListClusterDrift(ctx context.Context, in *ListClusterDriftRequest, out *ListClusterDriftResponse) error
 		GetNode(ctx context.Context, in *GetNodeRequest, out *GetNodeResponse) error
 		UpdateNode(ctx context.Context, in *UpdateNodeRequest, out *UpdateNodeResponse) error
 		CheckNodeInCluster(ctx context.Context, in *CheckNodesRequest, out *CheckNodesResponse) error
 		CordonNode(ctx context.Context, in *CordonNodeRequest, out *CordonNodeResponse) error
 		UnCordonNode(ctx context.Context, in *UnCordonNodeRequest, out *UnCordonNodeResponse) error
+		DrainNode(ctx context.Context, in *DrainNodeRequest, out *DrainNodeResponse) error
 		GetClusterCredential(ctx context.Context, in *GetClusterCredentialReq, out *GetClusterCredentialResp) error
 		UpdateClusterCredential(ctx context.Context, in *UpdateClusterCredentialReq, out *UpdateClusterCredentialResp) error
 		DeleteClusterCredential(ctx context.Context, in *DeleteClusterCredentialReq, out *DeleteClusterCredentialResp) error
@@ -1731,6 +2019,7 @@ func RegisterClusterManagerHandler(s server.Server, hdlr ClusterManagerHandler,
 		GetVPCCidr(ctx context.Context, in *GetVPCCidrRequest, out *GetVPCCidrResponse) error
 		CreateNodeGroup(ctx context.Context, in *CreateNodeGroupRequest, out *CreateNodeGroupResponse) error
 		UpdateNodeGroup(ctx context.Context, in *UpdateNodeGroupRequest, out *UpdateNodeGroupResponse) error
+		UpgradeNodeGroup(ctx context.Context, in *UpgradeNodeGroupRequest, out *UpgradeNodeGroupResponse) error
 		DeleteNodeGroup(ctx context.Context, in *DeleteNodeGroupRequest, out *DeleteNodeGroupResponse) error
 		GetNodeGroup(ctx context.Context, in *GetNodeGroupRequest, out *GetNodeGroupResponse) error
 		ListNodeGroup(ctx context.Context, in *ListNodeGroupRequest, out *ListNodeGroupResponse) error
@@ -1744,6 +2033,7 @@ func RegisterClusterManagerHandler(s server.Server, hdlr ClusterManagerHandler,
 		DisableNodeGroupAutoScale(ctx context.Context, in *DisableNodeGroupAutoScaleRequest, out *DisableNodeGroupAutoScaleResponse) error
 		CreateTask(ctx context.Context, in *CreateTaskRequest, out *CreateTaskResponse) error
 		RetryTask(ctx context.Context, in *RetryTaskRequest, out *RetryTaskResponse) error
+		SkipTaskStep(ctx context.Context, in *SkipTaskStepRequest, out *SkipTaskStepResponse) error
 		UpdateTask(ctx context.Context, in *UpdateTaskRequest, out *UpdateTaskResponse) error
 		DeleteTask(ctx context.Context, in *DeleteTaskRequest, out *DeleteTaskResponse) error
 		GetTask(ctx context.Context, in *GetTaskRequest, out *GetTaskResponse) error
@@ -1764,6 +2054,16 @@ func RegisterClusterManagerHandler(s server.Server, hdlr ClusterManagerHandler,
 		ListCloudSecurityGroups(ctx context.Context, in *ListCloudSecurityGroupsRequest, out *ListCloudSecurityGroupsResponse) error
 		ListCloudInstanceTypes(ctx context.Context, in *ListCloudInstanceTypeRequest, out *ListCloudInstanceTypeResponse) error
 		ListCloudOsImage(ctx context.Context, in *ListCloudOsImageRequest, out *ListCloudOsImageResponse) error
+		CreateClusterTemplate(ctx context.Context, in *CreateClusterTemplateRequest, out *CreateClusterTemplateResponse) error
+		UpdateClusterTemplate(ctx context.Context, in *UpdateClusterTemplateRequest, out *UpdateClusterTemplateResponse) error
+		DeleteClusterTemplate(ctx context.Context, in *DeleteClusterTemplateRequest, out *DeleteClusterTemplateResponse) error
+		GetClusterTemplate(ctx context.Context, in *GetClusterTemplateRequest, out *GetClusterTemplateResponse) error
+		ListClusterTemplate(ctx context.Context, in *ListClusterTemplateRequest, out *ListClusterTemplateResponse) error
+		CreateNodeGroupTemplate(ctx context.Context, in *CreateNodeGroupTemplateRequest, out *CreateNodeGroupTemplateResponse) error
+		UpdateNodeGroupTemplate(ctx context.Context, in *UpdateNodeGroupTemplateRequest, out *UpdateNodeGroupTemplateResponse) error
+		DeleteNodeGroupTemplate(ctx context.Context, in *DeleteNodeGroupTemplateRequest, out *DeleteNodeGroupTemplateResponse) error
+		GetNodeGroupTemplate(ctx context.Context, in *GetNodeGroupTemplateRequest, out *GetNodeGroupTemplateResponse) error
+		ListNodeGroupTemplate(ctx context.Context, in *ListNodeGroupTemplateRequest, out *ListNodeGroupTemplateResponse) error
 	}
 	type ClusterManager struct {
 		clusterManager
@@ -1804,6 +2104,13 @@ func RegisterClusterManagerHandler(s server.Server, hdlr ClusterManagerHandler,
 		Body:    "*",
 		Handler: "rpc",
 	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "ClusterManager.UpgradeCluster",
+		Path:    []string{"/clustermanager/v1/cluster/{clusterID}/upgrade"},
+		Method:  []string{"PUT"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
 	opts = append(opts, api.WithEndpoint(&api.Endpoint{
 		Name:    "ClusterManager.AddNodesToCluster",
 		Path:    []string{"/clustermanager/v1/cluster/{clusterID}/node"},
@@ -1849,6 +2156,13 @@ func RegisterClusterManagerHandler(s server.Server, hdlr ClusterManagerHandler,
 		Method:  []string{"GET"},
 		Handler: "rpc",
 	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "ClusterManager.ListClusterDrift",
+		Path:    []string{"/clustermanager/v1/clusterdrift"},
+		Method:  []string{"GET"},
+		Body:    "",
+		Handler: "rpc",
+	}))
 	opts = append(opts, api.WithEndpoint(&api.Endpoint{
 		Name:    "ClusterManager.GetNode",
 		Path:    []string{"/clustermanager/v1/node/{innerIP}"},
@@ -1883,6 +2197,13 @@ func RegisterClusterManagerHandler(s server.Server, hdlr ClusterManagerHandler,
 		Body:    "*",
 		Handler: "rpc",
 	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "ClusterManager.DrainNode",
+		Path:    []string{"/clustermanager/v1/node/drain"},
+		Method:  []string{"PUT"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
 	opts = append(opts, api.WithEndpoint(&api.Endpoint{
 		Name:    "ClusterManager.GetClusterCredential",
 		Path:    []string{"/clustermanager/v1/clustercredential/{serverKey}"},
@@ -2115,6 +2436,13 @@ func RegisterClusterManagerHandler(s server.Server, hdlr ClusterManagerHandler,
 		Body:    "*",
 		Handler: "rpc",
 	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "ClusterManager.UpgradeNodeGroup",
+		Path:    []string{"/clustermanager/v1/nodegroup/{nodeGroupID}/upgrade"},
+		Method:  []string{"PUT"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
 	opts = append(opts, api.WithEndpoint(&api.Endpoint{
 		Name:    "ClusterManager.DeleteNodeGroup",
 		Path:    []string{"/clustermanager/v1/nodegroup/{nodeGroupID}"},
@@ -2203,6 +2531,13 @@ func RegisterClusterManagerHandler(s server.Server, hdlr ClusterManagerHandler,
 		Body:    "*",
 		Handler: "rpc",
 	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "ClusterManager.SkipTaskStep",
+		Path:    []string{"/clustermanager/v1/task/{taskID}/step/{stepName}/skip"},
+		Method:  []string{"PUT"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
 	opts = append(opts, api.WithEndpoint(&api.Endpoint{
 		Name:    "ClusterManager.UpdateTask",
 		Path:    []string{"/clustermanager/v1/task/{taskID}"},
@@ -2331,6 +2666,76 @@ func RegisterClusterManagerHandler(s server.Server, hdlr ClusterManagerHandler,
 		Method:  []string{"GET"},
 		Handler: "rpc",
 	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "ClusterManager.CreateClusterTemplate",
+		Path:    []string{"/clustermanager/v1/clustertemplate"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "ClusterManager.UpdateClusterTemplate",
+		Path:    []string{"/clustermanager/v1/clustertemplate/{templateID}"},
+		Method:  []string{"PUT"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "ClusterManager.DeleteClusterTemplate",
+		Path:    []string{"/clustermanager/v1/clustertemplate/{templateID}"},
+		Method:  []string{"DELETE"},
+		Body:    "",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "ClusterManager.GetClusterTemplate",
+		Path:    []string{"/clustermanager/v1/clustertemplate/{templateID}"},
+		Method:  []string{"GET"},
+		Body:    "",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "ClusterManager.ListClusterTemplate",
+		Path:    []string{"/clustermanager/v1/clustertemplate"},
+		Method:  []string{"GET"},
+		Body:    "",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "ClusterManager.CreateNodeGroupTemplate",
+		Path:    []string{"/clustermanager/v1/nodegrouptemplate"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "ClusterManager.UpdateNodeGroupTemplate",
+		Path:    []string{"/clustermanager/v1/nodegrouptemplate/{templateID}"},
+		Method:  []string{"PUT"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "ClusterManager.DeleteNodeGroupTemplate",
+		Path:    []string{"/clustermanager/v1/nodegrouptemplate/{templateID}"},
+		Method:  []string{"DELETE"},
+		Body:    "",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "ClusterManager.GetNodeGroupTemplate",
+		Path:    []string{"/clustermanager/v1/nodegrouptemplate/{templateID}"},
+		Method:  []string{"GET"},
+		Body:    "",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "ClusterManager.ListNodeGroupTemplate",
+		Path:    []string{"/clustermanager/v1/nodegrouptemplate"},
+		Method:  []string{"GET"},
+		Body:    "",
+		Handler: "rpc",
+	}))
 	return s.Handle(s.NewHandler(&ClusterManager{h}, opts...))
 }
 
@@ -2358,6 +2763,10 @@ func (h *clusterManagerHandler) UpdateCluster(ctx context.Context, in *UpdateClu
 	return h.ClusterManagerHandler.UpdateCluster(ctx, in, out)
 }
 
+func (h *clusterManagerHandler) UpgradeCluster(ctx context.Context, in *UpgradeClusterReq, out *UpgradeClusterResp) error {
+	return h.ClusterManagerHandler.UpgradeCluster(ctx, in, out)
+}
+
 func (h *clusterManagerHandler) AddNodesToCluster(ctx context.Context, in *AddNodesRequest, out *AddNodesResponse) error {
 	return h.ClusterManagerHandler.AddNodesToCluster(ctx, in, out)
 }
@@ -2386,6 +2795,10 @@ func (h *clusterManagerHandler) ListCommonCluster(ctx context.Context, in *ListC
 	return h.ClusterManagerHandler.ListCommonCluster(ctx, in, out)
 }
 
+func (h *clusterManagerHandler) ListClusterDrift(ctx context.Context, in *ListClusterDriftRequest, out *ListClusterDriftResponse) error {
+	return h.ClusterManagerHandler.ListClusterDrift(ctx, in, out)
+}
+
 func (h *clusterManagerHandler) GetNode(ctx context.Context, in *GetNodeRequest, out *GetNodeResponse) error {
 	return h.ClusterManagerHandler.GetNode(ctx, in, out)
 }
@@ -2406,6 +2819,10 @@ func (h *clusterManagerHandler) UnCordonNode(ctx context.Context, in *UnCordonNo
 	return h.ClusterManagerHandler.UnCordonNode(ctx, in, out)
 }
 
+func (h *clusterManagerHandler) DrainNode(ctx context.Context, in *DrainNodeRequest, out *DrainNodeResponse) error {
+	return h.ClusterManagerHandler.DrainNode(ctx, in, out)
+}
+
 func (h *clusterManagerHandler) GetClusterCredential(ctx context.Context, in *GetClusterCredentialReq, out *GetClusterCredentialResp) error {
 	return h.ClusterManagerHandler.GetClusterCredential(ctx, in, out)
 }
@@ -2546,6 +2963,10 @@ func (h *clusterManagerHandler) UpdateNodeGroup(ctx context.Context, in *UpdateN
 	return h.ClusterManagerHandler.UpdateNodeGroup(ctx, in, out)
 }
 
+func (h *clusterManagerHandler) UpgradeNodeGroup(ctx context.Context, in *UpgradeNodeGroupRequest, out *UpgradeNodeGroupResponse) error {
+	return h.ClusterManagerHandler.UpgradeNodeGroup(ctx, in, out)
+}
+
 func (h *clusterManagerHandler) DeleteNodeGroup(ctx context.Context, in *DeleteNodeGroupRequest, out *DeleteNodeGroupResponse) error {
 	return h.ClusterManagerHandler.DeleteNodeGroup(ctx, in, out)
 }
@@ -2598,6 +3019,10 @@ func (h *clusterManagerHandler) RetryTask(ctx context.Context, in *RetryTaskRequ
 	return h.ClusterManagerHandler.RetryTask(ctx, in, out)
 }
 
+func (h *clusterManagerHandler) SkipTaskStep(ctx context.Context, in *SkipTaskStepRequest, out *SkipTaskStepResponse) error {
+	return h.ClusterManagerHandler.SkipTaskStep(ctx, in, out)
+}
+
 func (h *clusterManagerHandler) UpdateTask(ctx context.Context, in *UpdateTaskRequest, out *UpdateTaskResponse) error {
 	return h.ClusterManagerHandler.UpdateTask(ctx, in, out)
 }
@@ -2677,3 +3102,43 @@ func (h *clusterManagerHandler) ListCloudInstanceTypes(ctx context.Context, in *
 func (h *clusterManagerHandler) ListCloudOsImage(ctx context.Context, in *ListCloudOsImageRequest, out *ListCloudOsImageResponse) error {
 	return h.ClusterManagerHandler.ListCloudOsImage(ctx, in, out)
 }
+
+func (h *clusterManagerHandler) CreateClusterTemplate(ctx context.Context, in *CreateClusterTemplateRequest, out *CreateClusterTemplateResponse) error {
+	return h.ClusterManagerHandler.CreateClusterTemplate(ctx, in, out)
+}
+
+func (h *clusterManagerHandler) UpdateClusterTemplate(ctx context.Context, in *UpdateClusterTemplateRequest, out *UpdateClusterTemplateResponse) error {
+	return h.ClusterManagerHandler.UpdateClusterTemplate(ctx, in, out)
+}
+
+func (h *clusterManagerHandler) DeleteClusterTemplate(ctx context.Context, in *DeleteClusterTemplateRequest, out *DeleteClusterTemplateResponse) error {
+	return h.ClusterManagerHandler.DeleteClusterTemplate(ctx, in, out)
+}
+
+func (h *clusterManagerHandler) GetClusterTemplate(ctx context.Context, in *GetClusterTemplateRequest, out *GetClusterTemplateResponse) error {
+	return h.ClusterManagerHandler.GetClusterTemplate(ctx, in, out)
+}
+
+func (h *clusterManagerHandler) ListClusterTemplate(ctx context.Context, in *ListClusterTemplateRequest, out *ListClusterTemplateResponse) error {
+	return h.ClusterManagerHandler.ListClusterTemplate(ctx, in, out)
+}
+
+func (h *clusterManagerHandler) CreateNodeGroupTemplate(ctx context.Context, in *CreateNodeGroupTemplateRequest, out *CreateNodeGroupTemplateResponse) error {
+	return h.ClusterManagerHandler.CreateNodeGroupTemplate(ctx, in, out)
+}
+
+func (h *clusterManagerHandler) UpdateNodeGroupTemplate(ctx context.Context, in *UpdateNodeGroupTemplateRequest, out *UpdateNodeGroupTemplateResponse) error {
+	return h.ClusterManagerHandler.UpdateNodeGroupTemplate(ctx, in, out)
+}
+
+func (h *clusterManagerHandler) DeleteNodeGroupTemplate(ctx context.Context, in *DeleteNodeGroupTemplateRequest, out *DeleteNodeGroupTemplateResponse) error {
+	return h.ClusterManagerHandler.DeleteNodeGroupTemplate(ctx, in, out)
+}
+
+func (h *clusterManagerHandler) GetNodeGroupTemplate(ctx context.Context, in *GetNodeGroupTemplateRequest, out *GetNodeGroupTemplateResponse) error {
+	return h.ClusterManagerHandler.GetNodeGroupTemplate(ctx, in, out)
+}
+
+func (h *clusterManagerHandler) ListNodeGroupTemplate(ctx context.Context, in *ListNodeGroupTemplateRequest, out *ListNodeGroupTemplateResponse) error {
+	return h.ClusterManagerHandler.ListNodeGroupTemplate(ctx, in, out)
+}
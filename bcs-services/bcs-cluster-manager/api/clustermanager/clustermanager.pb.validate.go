@@ -2362,6 +2362,64 @@ func (m *ClusterMgr) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetUpgradeCluster()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ClusterMgrValidationError{
+					field:  "UpgradeCluster",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ClusterMgrValidationError{
+					field:  "UpgradeCluster",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpgradeCluster()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ClusterMgrValidationError{
+				field:  "UpgradeCluster",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpgradeNodes()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ClusterMgrValidationError{
+					field:  "UpgradeNodes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ClusterMgrValidationError{
+					field:  "UpgradeNodes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpgradeNodes()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ClusterMgrValidationError{
+				field:  "UpgradeNodes",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return ClusterMgrMultiError(errors)
 	}
@@ -9437,6 +9495,18 @@ func (m *Step) validate(all bool) error {
 
 	// no validation rules for TaskName
 
+	// no validation rules for MaxRetry
+
+	// no validation rules for RetryInterval
+
+	// no validation rules for Timeout
+
+	// no validation rules for IdempotencyKey
+
+	// no validation rules for SkipReason
+
+	// no validation rules for SkipOperator
+
 	if len(errors) > 0 {
 		return StepMultiError(errors)
 	}
@@ -10179,6 +10249,12 @@ func (m *CreateClusterReq) validate(all bool) error {
 
 	// no validation rules for CloudAccountID
 
+	// no validation rules for TemplateID
+
+	// no validation rules for TemplateVersion
+
+	// no validation rules for TemplateParams
+
 	if len(errors) > 0 {
 		return CreateClusterReqMultiError(errors)
 	}
@@ -23739,6 +23815,12 @@ func (m *CreateNodeGroupRequest) validate(all bool) error {
 
 	// no validation rules for RuntimeVersion
 
+	// no validation rules for TemplateID
+
+	// no validation rules for TemplateVersion
+
+	// no validation rules for TemplateParams
+
 	if len(errors) > 0 {
 		return CreateNodeGroupRequestMultiError(errors)
 	}
@@ -25957,6 +26039,35 @@ func (m *DeleteNodesRequest) validate(all bool) error {
 
 	// no validation rules for OnlyDeleteInfo
 
+	if all {
+		switch v := interface{}(m.GetDrainOption()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeleteNodesRequestValidationError{
+					field:  "DrainOption",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeleteNodesRequestValidationError{
+					field:  "DrainOption",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDrainOption()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeleteNodesRequestValidationError{
+				field:  "DrainOption",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return DeleteNodesRequestMultiError(errors)
 	}
@@ -26971,6 +27082,35 @@ func (m *CleanNodesInGroupRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if all {
+		switch v := interface{}(m.GetDrainOption()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CleanNodesInGroupRequestValidationError{
+					field:  "DrainOption",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CleanNodesInGroupRequestValidationError{
+					field:  "DrainOption",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDrainOption()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CleanNodesInGroupRequestValidationError{
+				field:  "DrainOption",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return CleanNodesInGroupRequestMultiError(errors)
 	}
@@ -28806,6 +28946,17 @@ func (m *RetryTaskRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if utf8.RuneCountInString(m.GetStepName()) > 1024 {
+		err := RetryTaskRequestValidationError{
+			field:  "StepName",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return RetryTaskRequestMultiError(errors)
 	}
@@ -34741,3 +34892,5230 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = CreateNamespaceWithQuotaResp_CreateNamespaceWithQuotaRespDataValidationError{}
+
+// Validate checks the field values on UpgradeClusterReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpgradeClusterReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpgradeClusterReq with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// UpgradeClusterReqMultiError, or nil if none found.
+func (m *UpgradeClusterReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpgradeClusterReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetClusterID()); l < 2 || l > 100 {
+		err := UpgradeClusterReqValidationError{
+			field:  "ClusterID",
+			reason: "value length must be between 2 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetVersion()); l < 1 || l > 32 {
+		err := UpgradeClusterReqValidationError{
+			field:  "Version",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxUnavailable() > 100 {
+		err := UpgradeClusterReqValidationError{
+			field:  "MaxUnavailable",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetOperator()) > 100 {
+		err := UpgradeClusterReqValidationError{
+			field:  "Operator",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpgradeClusterReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpgradeClusterReqMultiError is an error wrapping multiple validation errors
+// returned by UpgradeClusterReq.ValidateAll() if the designated constraints
+// aren't met.
+type UpgradeClusterReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpgradeClusterReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpgradeClusterReqMultiError) AllErrors() []error { return m }
+
+// UpgradeClusterReqValidationError is the validation error returned by
+// UpgradeClusterReq.Validate if the designated constraints aren't met.
+type UpgradeClusterReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpgradeClusterReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpgradeClusterReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpgradeClusterReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpgradeClusterReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpgradeClusterReqValidationError) ErrorName() string {
+	return "UpgradeClusterReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpgradeClusterReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpgradeClusterReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpgradeClusterReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpgradeClusterReqValidationError{}
+
+// Validate checks the field values on UpgradeClusterResp with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *UpgradeClusterResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpgradeClusterResp with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// UpgradeClusterRespMultiError, or nil if none found.
+func (m *UpgradeClusterResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpgradeClusterResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Result
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpgradeClusterRespValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpgradeClusterRespValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpgradeClusterRespValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpgradeClusterRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpgradeClusterRespMultiError is an error wrapping multiple validation errors
+// returned by UpgradeClusterResp.ValidateAll() if the designated constraints
+// aren't met.
+type UpgradeClusterRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpgradeClusterRespMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpgradeClusterRespMultiError) AllErrors() []error { return m }
+
+// UpgradeClusterRespValidationError is the validation error returned by
+// UpgradeClusterResp.Validate if the designated constraints aren't met.
+type UpgradeClusterRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpgradeClusterRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpgradeClusterRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpgradeClusterRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpgradeClusterRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpgradeClusterRespValidationError) ErrorName() string {
+	return "UpgradeClusterRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpgradeClusterRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpgradeClusterResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpgradeClusterRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpgradeClusterRespValidationError{}
+
+// Validate checks the field values on UpgradeNodeGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *UpgradeNodeGroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpgradeNodeGroupRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpgradeNodeGroupRequestMultiError, or nil if none found.
+func (m *UpgradeNodeGroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpgradeNodeGroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetNodeGroupID()); l < 5 || l > 100 {
+		err := UpgradeNodeGroupRequestValidationError{
+			field:  "NodeGroupID",
+			reason: "value length must be between 5 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_UpgradeNodeGroupRequest_NodeGroupID_Pattern.MatchString(m.GetNodeGroupID()) {
+		err := UpgradeNodeGroupRequestValidationError{
+			field:  "NodeGroupID",
+			reason: "value does not match regex pattern \"^[0-9a-zA-Z-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxUnavailable() > 100 {
+		err := UpgradeNodeGroupRequestValidationError{
+			field:  "MaxUnavailable",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetOperator()) > 100 {
+		err := UpgradeNodeGroupRequestValidationError{
+			field:  "Operator",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpgradeNodeGroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpgradeNodeGroupRequestMultiError is an error wrapping multiple validation
+// errors returned by UpgradeNodeGroupRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpgradeNodeGroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpgradeNodeGroupRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpgradeNodeGroupRequestMultiError) AllErrors() []error { return m }
+
+// UpgradeNodeGroupRequestValidationError is the validation error returned by
+// UpgradeNodeGroupRequest.Validate if the designated constraints aren't met.
+type UpgradeNodeGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpgradeNodeGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpgradeNodeGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpgradeNodeGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpgradeNodeGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpgradeNodeGroupRequestValidationError) ErrorName() string {
+	return "UpgradeNodeGroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpgradeNodeGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpgradeNodeGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpgradeNodeGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpgradeNodeGroupRequestValidationError{}
+
+var _UpgradeNodeGroupRequest_NodeGroupID_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]+$")
+
+// Validate checks the field values on UpgradeNodeGroupResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *UpgradeNodeGroupResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpgradeNodeGroupResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpgradeNodeGroupResponseMultiError, or nil if none found.
+func (m *UpgradeNodeGroupResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpgradeNodeGroupResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Result
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpgradeNodeGroupResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpgradeNodeGroupResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpgradeNodeGroupResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpgradeNodeGroupResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpgradeNodeGroupResponseMultiError is an error wrapping multiple validation
+// errors returned by UpgradeNodeGroupResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpgradeNodeGroupResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpgradeNodeGroupResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpgradeNodeGroupResponseMultiError) AllErrors() []error { return m }
+
+// UpgradeNodeGroupResponseValidationError is the validation error returned by
+// UpgradeNodeGroupResponse.Validate if the designated constraints aren't met.
+type UpgradeNodeGroupResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpgradeNodeGroupResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpgradeNodeGroupResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpgradeNodeGroupResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpgradeNodeGroupResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpgradeNodeGroupResponseValidationError) ErrorName() string {
+	return "UpgradeNodeGroupResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpgradeNodeGroupResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpgradeNodeGroupResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpgradeNodeGroupResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpgradeNodeGroupResponseValidationError{}
+
+// Validate checks the field values on DrainOption with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DrainOption) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DrainOption with the rules defined in
+// the proto definition for this message. If any rules are violated, the result
+// is a list of violation errors wrapped in DrainOptionMultiError, or nil if
+// none found.
+func (m *DrainOption) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DrainOption) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetTimeout() > 3600 {
+		err := DrainOptionValidationError{
+			field:  "Timeout",
+			reason: "value must be less than or equal to 3600",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Force
+
+	if m.GetGracePeriodSeconds() < 0 {
+		err := DrainOptionValidationError{
+			field:  "GracePeriodSeconds",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Skip
+
+	if len(errors) > 0 {
+		return DrainOptionMultiError(errors)
+	}
+
+	return nil
+}
+
+// DrainOptionMultiError is an error wrapping multiple validation errors
+// returned by DrainOption.ValidateAll() if the designated constraints aren't
+// met.
+type DrainOptionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DrainOptionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DrainOptionMultiError) AllErrors() []error { return m }
+
+// DrainOptionValidationError is the validation error returned by
+// DrainOption.Validate if the designated constraints aren't met.
+type DrainOptionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DrainOptionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DrainOptionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DrainOptionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DrainOptionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DrainOptionValidationError) ErrorName() string {
+	return "DrainOptionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DrainOptionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDrainOption.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DrainOptionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DrainOptionValidationError{}
+
+// Validate checks the field values on DrainNodeRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DrainNodeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DrainNodeRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// DrainNodeRequestMultiError, or nil if none found.
+func (m *DrainNodeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DrainNodeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetInnerIPs()); l < 1 || l > 100 {
+		err := DrainNodeRequestValidationError{
+			field:  "InnerIPs",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetClusterID()); l < 2 || l > 100 {
+		err := DrainNodeRequestValidationError{
+			field:  "ClusterID",
+			reason: "value length must be between 2 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetTimeout() > 3600 {
+		err := DrainNodeRequestValidationError{
+			field:  "Timeout",
+			reason: "value must be less than or equal to 3600",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Force
+
+	if m.GetGracePeriodSeconds() < 0 {
+		err := DrainNodeRequestValidationError{
+			field:  "GracePeriodSeconds",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetOperator()); l < 1 || l > 100 {
+		err := DrainNodeRequestValidationError{
+			field:  "Operator",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DrainNodeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DrainNodeRequestMultiError is an error wrapping multiple validation errors
+// returned by DrainNodeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DrainNodeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DrainNodeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DrainNodeRequestMultiError) AllErrors() []error { return m }
+
+// DrainNodeRequestValidationError is the validation error returned by
+// DrainNodeRequest.Validate if the designated constraints aren't met.
+type DrainNodeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DrainNodeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DrainNodeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DrainNodeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DrainNodeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DrainNodeRequestValidationError) ErrorName() string {
+	return "DrainNodeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DrainNodeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDrainNodeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DrainNodeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DrainNodeRequestValidationError{}
+
+// Validate checks the field values on DrainNodeResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DrainNodeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DrainNodeResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// DrainNodeResponseMultiError, or nil if none found.
+func (m *DrainNodeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DrainNodeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Result
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DrainNodeResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DrainNodeResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DrainNodeResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DrainNodeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DrainNodeResponseMultiError is an error wrapping multiple validation errors
+// returned by DrainNodeResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DrainNodeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DrainNodeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DrainNodeResponseMultiError) AllErrors() []error { return m }
+
+// DrainNodeResponseValidationError is the validation error returned by
+// DrainNodeResponse.Validate if the designated constraints aren't met.
+type DrainNodeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DrainNodeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DrainNodeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DrainNodeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DrainNodeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DrainNodeResponseValidationError) ErrorName() string {
+	return "DrainNodeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DrainNodeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDrainNodeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DrainNodeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DrainNodeResponseValidationError{}
+
+// Validate checks the field values on SkipTaskStepRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *SkipTaskStepRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SkipTaskStepRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// SkipTaskStepRequestMultiError, or nil if none found.
+func (m *SkipTaskStepRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SkipTaskStepRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetTaskID()); l < 2 || l > 1024 {
+		err := SkipTaskStepRequestValidationError{
+			field:  "TaskID",
+			reason: "value length must be between 2 and 1024 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SkipTaskStepRequest_TaskID_Pattern.MatchString(m.GetTaskID()) {
+		err := SkipTaskStepRequestValidationError{
+			field:  "TaskID",
+			reason: "value does not match regex pattern \"^[0-9a-zA-Z-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetStepName()); l < 1 || l > 1024 {
+		err := SkipTaskStepRequestValidationError{
+			field:  "StepName",
+			reason: "value length must be between 1 and 1024 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetReason()); l < 1 || l > 1024 {
+		err := SkipTaskStepRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be between 1 and 1024 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetUpdater()); l < 2 || l > 1024 {
+		err := SkipTaskStepRequestValidationError{
+			field:  "Updater",
+			reason: "value length must be between 2 and 1024 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SkipTaskStepRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SkipTaskStepRequestMultiError is an error wrapping multiple validation
+// errors returned by SkipTaskStepRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SkipTaskStepRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SkipTaskStepRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SkipTaskStepRequestMultiError) AllErrors() []error { return m }
+
+// SkipTaskStepRequestValidationError is the validation error returned by
+// SkipTaskStepRequest.Validate if the designated constraints aren't met.
+type SkipTaskStepRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SkipTaskStepRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SkipTaskStepRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SkipTaskStepRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SkipTaskStepRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SkipTaskStepRequestValidationError) ErrorName() string {
+	return "SkipTaskStepRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SkipTaskStepRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSkipTaskStepRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SkipTaskStepRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SkipTaskStepRequestValidationError{}
+
+var _SkipTaskStepRequest_TaskID_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]+$")
+
+// Validate checks the field values on SkipTaskStepResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *SkipTaskStepResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SkipTaskStepResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// SkipTaskStepResponseMultiError, or nil if none found.
+func (m *SkipTaskStepResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SkipTaskStepResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Result
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SkipTaskStepResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SkipTaskStepResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SkipTaskStepResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SkipTaskStepResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SkipTaskStepResponseMultiError is an error wrapping multiple validation
+// errors returned by SkipTaskStepResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SkipTaskStepResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SkipTaskStepResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SkipTaskStepResponseMultiError) AllErrors() []error { return m }
+
+// SkipTaskStepResponseValidationError is the validation error returned by
+// SkipTaskStepResponse.Validate if the designated constraints aren't met.
+type SkipTaskStepResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SkipTaskStepResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SkipTaskStepResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SkipTaskStepResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SkipTaskStepResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SkipTaskStepResponseValidationError) ErrorName() string {
+	return "SkipTaskStepResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SkipTaskStepResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSkipTaskStepResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SkipTaskStepResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SkipTaskStepResponseValidationError{}
+
+// Validate checks the field values on TemplateParameter with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TemplateParameter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TemplateParameter with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// TemplateParameterMultiError, or nil if none found.
+func (m *TemplateParameter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TemplateParameter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
+		err := TemplateParameterValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_TemplateParameter_Name_Pattern.MatchString(m.GetName()) {
+		err := TemplateParameterValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[a-zA-Z][a-zA-Z0-9_]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _TemplateParameter_Type_InLookup[m.GetType()]; !ok {
+		err := TemplateParameterValidationError{
+			field:  "Type",
+			reason: "value must be in list [string int bool]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetPath()); l < 1 || l > 256 {
+		err := TemplateParameterValidationError{
+			field:  "Path",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Description
+
+	// no validation rules for Required
+
+	// no validation rules for DefaultValue
+
+	// no validation rules for Pattern
+
+	// no validation rules for Min
+
+	// no validation rules for Max
+
+	if len(errors) > 0 {
+		return TemplateParameterMultiError(errors)
+	}
+
+	return nil
+}
+
+// TemplateParameterMultiError is an error wrapping multiple validation errors
+// returned by TemplateParameter.ValidateAll() if the designated constraints
+// aren't met.
+type TemplateParameterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TemplateParameterMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TemplateParameterMultiError) AllErrors() []error { return m }
+
+// TemplateParameterValidationError is the validation error returned by
+// TemplateParameter.Validate if the designated constraints aren't met.
+type TemplateParameterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TemplateParameterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TemplateParameterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TemplateParameterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TemplateParameterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TemplateParameterValidationError) ErrorName() string {
+	return "TemplateParameterValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TemplateParameterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTemplateParameter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TemplateParameterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TemplateParameterValidationError{}
+
+var _TemplateParameter_Name_Pattern = regexp.MustCompile("^[a-zA-Z][a-zA-Z0-9_]*$")
+
+var _TemplateParameter_Type_InLookup = map[string]struct{}{
+	"string": {},
+	"int":    {},
+	"bool":   {},
+}
+
+// Validate checks the field values on ClusterTemplate with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ClusterTemplate) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClusterTemplate with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ClusterTemplateMultiError, or nil if none found.
+func (m *ClusterTemplate) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClusterTemplate) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TemplateID
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for ProjectID
+
+	// no validation rules for Provider
+
+	// no validation rules for Version
+
+	for idx, item := range m.GetParameters() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ClusterTemplateValidationError{
+						field:  fmt.Sprintf("Parameters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ClusterTemplateValidationError{
+						field:  fmt.Sprintf("Parameters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ClusterTemplateValidationError{
+					field:  fmt.Sprintf("Parameters[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// skipping validation for Cluster
+
+	// no validation rules for Creator
+
+	// no validation rules for Updater
+
+	// no validation rules for CreateTime
+
+	// no validation rules for UpdateTime
+
+	if len(errors) > 0 {
+		return ClusterTemplateMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClusterTemplateMultiError is an error wrapping multiple validation errors
+// returned by ClusterTemplate.ValidateAll() if the designated constraints
+// aren't met.
+type ClusterTemplateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClusterTemplateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClusterTemplateMultiError) AllErrors() []error { return m }
+
+// ClusterTemplateValidationError is the validation error returned by
+// ClusterTemplate.Validate if the designated constraints aren't met.
+type ClusterTemplateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClusterTemplateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClusterTemplateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClusterTemplateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClusterTemplateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClusterTemplateValidationError) ErrorName() string {
+	return "ClusterTemplateValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ClusterTemplateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClusterTemplate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClusterTemplateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClusterTemplateValidationError{}
+
+// Validate checks the field values on CreateClusterTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *CreateClusterTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateClusterTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateClusterTemplateRequestMultiError, or nil if none found.
+func (m *CreateClusterTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateClusterTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 100 {
+		err := CreateClusterTemplateRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 1024 {
+		err := CreateClusterTemplateRequestValidationError{
+			field:  "Description",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProjectID()) > 100 {
+		err := CreateClusterTemplateRequestValidationError{
+			field:  "ProjectID",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetProvider()); l < 2 || l > 100 {
+		err := CreateClusterTemplateRequestValidationError{
+			field:  "Provider",
+			reason: "value length must be between 2 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetParameters()) > 100 {
+		err := CreateClusterTemplateRequestValidationError{
+			field:  "Parameters",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetParameters() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateClusterTemplateRequestValidationError{
+						field:  fmt.Sprintf("Parameters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateClusterTemplateRequestValidationError{
+						field:  fmt.Sprintf("Parameters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateClusterTemplateRequestValidationError{
+					field:  fmt.Sprintf("Parameters[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// skipping validation for Cluster
+
+	if l := utf8.RuneCountInString(m.GetCreator()); l < 1 || l > 100 {
+		err := CreateClusterTemplateRequestValidationError{
+			field:  "Creator",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateClusterTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateClusterTemplateRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateClusterTemplateRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateClusterTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateClusterTemplateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateClusterTemplateRequestMultiError) AllErrors() []error { return m }
+
+// CreateClusterTemplateRequestValidationError is the validation error returned
+// by CreateClusterTemplateRequest.Validate if the designated constraints
+// aren't met.
+type CreateClusterTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateClusterTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateClusterTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateClusterTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateClusterTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateClusterTemplateRequestValidationError) ErrorName() string {
+	return "CreateClusterTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateClusterTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateClusterTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateClusterTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateClusterTemplateRequestValidationError{}
+
+// Validate checks the field values on CreateClusterTemplateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *CreateClusterTemplateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateClusterTemplateResponse with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateClusterTemplateResponseMultiError, or nil if none found.
+func (m *CreateClusterTemplateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateClusterTemplateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Result
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateClusterTemplateResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateClusterTemplateResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateClusterTemplateResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateClusterTemplateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateClusterTemplateResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateClusterTemplateResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateClusterTemplateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateClusterTemplateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateClusterTemplateResponseMultiError) AllErrors() []error { return m }
+
+// CreateClusterTemplateResponseValidationError is the validation error
+// returned by CreateClusterTemplateResponse.Validate if the designated
+// constraints aren't met.
+type CreateClusterTemplateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateClusterTemplateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateClusterTemplateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateClusterTemplateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateClusterTemplateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateClusterTemplateResponseValidationError) ErrorName() string {
+	return "CreateClusterTemplateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateClusterTemplateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateClusterTemplateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateClusterTemplateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateClusterTemplateResponseValidationError{}
+
+// Validate checks the field values on UpdateClusterTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *UpdateClusterTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateClusterTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateClusterTemplateRequestMultiError, or nil if none found.
+func (m *UpdateClusterTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateClusterTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetTemplateID()); l < 2 || l > 100 {
+		err := UpdateClusterTemplateRequestValidationError{
+			field:  "TemplateID",
+			reason: "value length must be between 2 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_UpdateClusterTemplateRequest_TemplateID_Pattern.MatchString(m.GetTemplateID()) {
+		err := UpdateClusterTemplateRequestValidationError{
+			field:  "TemplateID",
+			reason: "value does not match regex pattern \"^[0-9a-zA-Z-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) > 100 {
+		err := UpdateClusterTemplateRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 1024 {
+		err := UpdateClusterTemplateRequestValidationError{
+			field:  "Description",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetParameters()) > 100 {
+		err := UpdateClusterTemplateRequestValidationError{
+			field:  "Parameters",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetParameters() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateClusterTemplateRequestValidationError{
+						field:  fmt.Sprintf("Parameters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateClusterTemplateRequestValidationError{
+						field:  fmt.Sprintf("Parameters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateClusterTemplateRequestValidationError{
+					field:  fmt.Sprintf("Parameters[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// skipping validation for Cluster
+
+	if l := utf8.RuneCountInString(m.GetUpdater()); l < 1 || l > 100 {
+		err := UpdateClusterTemplateRequestValidationError{
+			field:  "Updater",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateClusterTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateClusterTemplateRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateClusterTemplateRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateClusterTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateClusterTemplateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateClusterTemplateRequestMultiError) AllErrors() []error { return m }
+
+// UpdateClusterTemplateRequestValidationError is the validation error returned
+// by UpdateClusterTemplateRequest.Validate if the designated constraints
+// aren't met.
+type UpdateClusterTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateClusterTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateClusterTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateClusterTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateClusterTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateClusterTemplateRequestValidationError) ErrorName() string {
+	return "UpdateClusterTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateClusterTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateClusterTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateClusterTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateClusterTemplateRequestValidationError{}
+
+var _UpdateClusterTemplateRequest_TemplateID_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]+$")
+
+// Validate checks the field values on UpdateClusterTemplateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *UpdateClusterTemplateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateClusterTemplateResponse with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateClusterTemplateResponseMultiError, or nil if none found.
+func (m *UpdateClusterTemplateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateClusterTemplateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Result
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateClusterTemplateResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateClusterTemplateResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateClusterTemplateResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateClusterTemplateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateClusterTemplateResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateClusterTemplateResponse.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateClusterTemplateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateClusterTemplateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateClusterTemplateResponseMultiError) AllErrors() []error { return m }
+
+// UpdateClusterTemplateResponseValidationError is the validation error
+// returned by UpdateClusterTemplateResponse.Validate if the designated
+// constraints aren't met.
+type UpdateClusterTemplateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateClusterTemplateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateClusterTemplateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateClusterTemplateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateClusterTemplateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateClusterTemplateResponseValidationError) ErrorName() string {
+	return "UpdateClusterTemplateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateClusterTemplateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateClusterTemplateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateClusterTemplateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateClusterTemplateResponseValidationError{}
+
+// Validate checks the field values on DeleteClusterTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *DeleteClusterTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteClusterTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteClusterTemplateRequestMultiError, or nil if none found.
+func (m *DeleteClusterTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteClusterTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetTemplateID()); l < 2 || l > 100 {
+		err := DeleteClusterTemplateRequestValidationError{
+			field:  "TemplateID",
+			reason: "value length must be between 2 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_DeleteClusterTemplateRequest_TemplateID_Pattern.MatchString(m.GetTemplateID()) {
+		err := DeleteClusterTemplateRequestValidationError{
+			field:  "TemplateID",
+			reason: "value does not match regex pattern \"^[0-9a-zA-Z-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteClusterTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteClusterTemplateRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteClusterTemplateRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteClusterTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteClusterTemplateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteClusterTemplateRequestMultiError) AllErrors() []error { return m }
+
+// DeleteClusterTemplateRequestValidationError is the validation error returned
+// by DeleteClusterTemplateRequest.Validate if the designated constraints
+// aren't met.
+type DeleteClusterTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteClusterTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteClusterTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteClusterTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteClusterTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteClusterTemplateRequestValidationError) ErrorName() string {
+	return "DeleteClusterTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteClusterTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteClusterTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteClusterTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteClusterTemplateRequestValidationError{}
+
+var _DeleteClusterTemplateRequest_TemplateID_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]+$")
+
+// Validate checks the field values on DeleteClusterTemplateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *DeleteClusterTemplateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteClusterTemplateResponse with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteClusterTemplateResponseMultiError, or nil if none found.
+func (m *DeleteClusterTemplateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteClusterTemplateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Result
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeleteClusterTemplateResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeleteClusterTemplateResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeleteClusterTemplateResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DeleteClusterTemplateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteClusterTemplateResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteClusterTemplateResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteClusterTemplateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteClusterTemplateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteClusterTemplateResponseMultiError) AllErrors() []error { return m }
+
+// DeleteClusterTemplateResponseValidationError is the validation error
+// returned by DeleteClusterTemplateResponse.Validate if the designated
+// constraints aren't met.
+type DeleteClusterTemplateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteClusterTemplateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteClusterTemplateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteClusterTemplateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteClusterTemplateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteClusterTemplateResponseValidationError) ErrorName() string {
+	return "DeleteClusterTemplateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteClusterTemplateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteClusterTemplateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteClusterTemplateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteClusterTemplateResponseValidationError{}
+
+// Validate checks the field values on GetClusterTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *GetClusterTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetClusterTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetClusterTemplateRequestMultiError, or nil if none found.
+func (m *GetClusterTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetClusterTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetTemplateID()); l < 2 || l > 100 {
+		err := GetClusterTemplateRequestValidationError{
+			field:  "TemplateID",
+			reason: "value length must be between 2 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_GetClusterTemplateRequest_TemplateID_Pattern.MatchString(m.GetTemplateID()) {
+		err := GetClusterTemplateRequestValidationError{
+			field:  "TemplateID",
+			reason: "value does not match regex pattern \"^[0-9a-zA-Z-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Version
+
+	if len(errors) > 0 {
+		return GetClusterTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetClusterTemplateRequestMultiError is an error wrapping multiple validation
+// errors returned by GetClusterTemplateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetClusterTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetClusterTemplateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetClusterTemplateRequestMultiError) AllErrors() []error { return m }
+
+// GetClusterTemplateRequestValidationError is the validation error returned by
+// GetClusterTemplateRequest.Validate if the designated constraints aren't met.
+type GetClusterTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetClusterTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetClusterTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetClusterTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetClusterTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetClusterTemplateRequestValidationError) ErrorName() string {
+	return "GetClusterTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetClusterTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetClusterTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetClusterTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetClusterTemplateRequestValidationError{}
+
+var _GetClusterTemplateRequest_TemplateID_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]+$")
+
+// Validate checks the field values on GetClusterTemplateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *GetClusterTemplateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetClusterTemplateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetClusterTemplateResponseMultiError, or nil if none found.
+func (m *GetClusterTemplateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetClusterTemplateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Result
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetClusterTemplateResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetClusterTemplateResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetClusterTemplateResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetClusterTemplateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetClusterTemplateResponseMultiError is an error wrapping multiple
+// validation errors returned by GetClusterTemplateResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetClusterTemplateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetClusterTemplateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetClusterTemplateResponseMultiError) AllErrors() []error { return m }
+
+// GetClusterTemplateResponseValidationError is the validation error returned
+// by GetClusterTemplateResponse.Validate if the designated constraints aren't
+// met.
+type GetClusterTemplateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetClusterTemplateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetClusterTemplateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetClusterTemplateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetClusterTemplateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetClusterTemplateResponseValidationError) ErrorName() string {
+	return "GetClusterTemplateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetClusterTemplateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetClusterTemplateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetClusterTemplateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetClusterTemplateResponseValidationError{}
+
+// Validate checks the field values on ListClusterTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ListClusterTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListClusterTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListClusterTemplateRequestMultiError, or nil if none found.
+func (m *ListClusterTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListClusterTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTemplateID()) > 100 {
+		err := ListClusterTemplateRequestValidationError{
+			field:  "TemplateID",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) > 100 {
+		err := ListClusterTemplateRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProjectID()) > 100 {
+		err := ListClusterTemplateRequestValidationError{
+			field:  "ProjectID",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProvider()) > 100 {
+		err := ListClusterTemplateRequestValidationError{
+			field:  "Provider",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AllVersions
+
+	if len(errors) > 0 {
+		return ListClusterTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListClusterTemplateRequestMultiError is an error wrapping multiple
+// validation errors returned by ListClusterTemplateRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListClusterTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListClusterTemplateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListClusterTemplateRequestMultiError) AllErrors() []error { return m }
+
+// ListClusterTemplateRequestValidationError is the validation error returned
+// by ListClusterTemplateRequest.Validate if the designated constraints aren't
+// met.
+type ListClusterTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListClusterTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListClusterTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListClusterTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListClusterTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListClusterTemplateRequestValidationError) ErrorName() string {
+	return "ListClusterTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListClusterTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListClusterTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListClusterTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListClusterTemplateRequestValidationError{}
+
+// Validate checks the field values on ListClusterTemplateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ListClusterTemplateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListClusterTemplateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListClusterTemplateResponseMultiError, or nil if none found.
+func (m *ListClusterTemplateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListClusterTemplateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Result
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListClusterTemplateResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListClusterTemplateResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListClusterTemplateResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListClusterTemplateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListClusterTemplateResponseMultiError is an error wrapping multiple
+// validation errors returned by ListClusterTemplateResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListClusterTemplateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListClusterTemplateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListClusterTemplateResponseMultiError) AllErrors() []error { return m }
+
+// ListClusterTemplateResponseValidationError is the validation error returned
+// by ListClusterTemplateResponse.Validate if the designated constraints aren't
+// met.
+type ListClusterTemplateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListClusterTemplateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListClusterTemplateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListClusterTemplateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListClusterTemplateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListClusterTemplateResponseValidationError) ErrorName() string {
+	return "ListClusterTemplateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListClusterTemplateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListClusterTemplateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListClusterTemplateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListClusterTemplateResponseValidationError{}
+
+// Validate checks the field values on NodeGroupTemplate with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *NodeGroupTemplate) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NodeGroupTemplate with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// NodeGroupTemplateMultiError, or nil if none found.
+func (m *NodeGroupTemplate) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NodeGroupTemplate) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TemplateID
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for ProjectID
+
+	// no validation rules for Provider
+
+	// no validation rules for Version
+
+	for idx, item := range m.GetParameters() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, NodeGroupTemplateValidationError{
+						field:  fmt.Sprintf("Parameters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, NodeGroupTemplateValidationError{
+						field:  fmt.Sprintf("Parameters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return NodeGroupTemplateValidationError{
+					field:  fmt.Sprintf("Parameters[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// skipping validation for NodeGroup
+
+	// no validation rules for Creator
+
+	// no validation rules for Updater
+
+	// no validation rules for CreateTime
+
+	// no validation rules for UpdateTime
+
+	if len(errors) > 0 {
+		return NodeGroupTemplateMultiError(errors)
+	}
+
+	return nil
+}
+
+// NodeGroupTemplateMultiError is an error wrapping multiple validation errors
+// returned by NodeGroupTemplate.ValidateAll() if the designated constraints
+// aren't met.
+type NodeGroupTemplateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NodeGroupTemplateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NodeGroupTemplateMultiError) AllErrors() []error { return m }
+
+// NodeGroupTemplateValidationError is the validation error returned by
+// NodeGroupTemplate.Validate if the designated constraints aren't met.
+type NodeGroupTemplateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NodeGroupTemplateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NodeGroupTemplateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NodeGroupTemplateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NodeGroupTemplateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NodeGroupTemplateValidationError) ErrorName() string {
+	return "NodeGroupTemplateValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NodeGroupTemplateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNodeGroupTemplate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NodeGroupTemplateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NodeGroupTemplateValidationError{}
+
+// Validate checks the field values on CreateNodeGroupTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *CreateNodeGroupTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateNodeGroupTemplateRequest with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateNodeGroupTemplateRequestMultiError, or nil if none found.
+func (m *CreateNodeGroupTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateNodeGroupTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 100 {
+		err := CreateNodeGroupTemplateRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 1024 {
+		err := CreateNodeGroupTemplateRequestValidationError{
+			field:  "Description",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProjectID()) > 100 {
+		err := CreateNodeGroupTemplateRequestValidationError{
+			field:  "ProjectID",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetProvider()); l < 2 || l > 100 {
+		err := CreateNodeGroupTemplateRequestValidationError{
+			field:  "Provider",
+			reason: "value length must be between 2 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetParameters()) > 100 {
+		err := CreateNodeGroupTemplateRequestValidationError{
+			field:  "Parameters",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetParameters() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateNodeGroupTemplateRequestValidationError{
+						field:  fmt.Sprintf("Parameters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateNodeGroupTemplateRequestValidationError{
+						field:  fmt.Sprintf("Parameters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateNodeGroupTemplateRequestValidationError{
+					field:  fmt.Sprintf("Parameters[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// skipping validation for NodeGroup
+
+	if l := utf8.RuneCountInString(m.GetCreator()); l < 1 || l > 100 {
+		err := CreateNodeGroupTemplateRequestValidationError{
+			field:  "Creator",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateNodeGroupTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateNodeGroupTemplateRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateNodeGroupTemplateRequest.ValidateAll()
+// if the designated constraints aren't met.
+type CreateNodeGroupTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateNodeGroupTemplateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateNodeGroupTemplateRequestMultiError) AllErrors() []error { return m }
+
+// CreateNodeGroupTemplateRequestValidationError is the validation error
+// returned by CreateNodeGroupTemplateRequest.Validate if the designated
+// constraints aren't met.
+type CreateNodeGroupTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateNodeGroupTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateNodeGroupTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateNodeGroupTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateNodeGroupTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateNodeGroupTemplateRequestValidationError) ErrorName() string {
+	return "CreateNodeGroupTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateNodeGroupTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateNodeGroupTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateNodeGroupTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateNodeGroupTemplateRequestValidationError{}
+
+// Validate checks the field values on CreateNodeGroupTemplateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *CreateNodeGroupTemplateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateNodeGroupTemplateResponse with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateNodeGroupTemplateResponseMultiError, or nil if none found.
+func (m *CreateNodeGroupTemplateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateNodeGroupTemplateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Result
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateNodeGroupTemplateResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateNodeGroupTemplateResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateNodeGroupTemplateResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateNodeGroupTemplateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateNodeGroupTemplateResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateNodeGroupTemplateResponse.ValidateAll()
+// if the designated constraints aren't met.
+type CreateNodeGroupTemplateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateNodeGroupTemplateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateNodeGroupTemplateResponseMultiError) AllErrors() []error { return m }
+
+// CreateNodeGroupTemplateResponseValidationError is the validation error
+// returned by CreateNodeGroupTemplateResponse.Validate if the designated
+// constraints aren't met.
+type CreateNodeGroupTemplateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateNodeGroupTemplateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateNodeGroupTemplateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateNodeGroupTemplateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateNodeGroupTemplateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateNodeGroupTemplateResponseValidationError) ErrorName() string {
+	return "CreateNodeGroupTemplateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateNodeGroupTemplateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateNodeGroupTemplateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateNodeGroupTemplateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateNodeGroupTemplateResponseValidationError{}
+
+// Validate checks the field values on UpdateNodeGroupTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *UpdateNodeGroupTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateNodeGroupTemplateRequest with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateNodeGroupTemplateRequestMultiError, or nil if none found.
+func (m *UpdateNodeGroupTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateNodeGroupTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetTemplateID()); l < 2 || l > 100 {
+		err := UpdateNodeGroupTemplateRequestValidationError{
+			field:  "TemplateID",
+			reason: "value length must be between 2 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_UpdateNodeGroupTemplateRequest_TemplateID_Pattern.MatchString(m.GetTemplateID()) {
+		err := UpdateNodeGroupTemplateRequestValidationError{
+			field:  "TemplateID",
+			reason: "value does not match regex pattern \"^[0-9a-zA-Z-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) > 100 {
+		err := UpdateNodeGroupTemplateRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 1024 {
+		err := UpdateNodeGroupTemplateRequestValidationError{
+			field:  "Description",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetParameters()) > 100 {
+		err := UpdateNodeGroupTemplateRequestValidationError{
+			field:  "Parameters",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetParameters() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateNodeGroupTemplateRequestValidationError{
+						field:  fmt.Sprintf("Parameters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateNodeGroupTemplateRequestValidationError{
+						field:  fmt.Sprintf("Parameters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateNodeGroupTemplateRequestValidationError{
+					field:  fmt.Sprintf("Parameters[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// skipping validation for NodeGroup
+
+	if l := utf8.RuneCountInString(m.GetUpdater()); l < 1 || l > 100 {
+		err := UpdateNodeGroupTemplateRequestValidationError{
+			field:  "Updater",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateNodeGroupTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateNodeGroupTemplateRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateNodeGroupTemplateRequest.ValidateAll()
+// if the designated constraints aren't met.
+type UpdateNodeGroupTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateNodeGroupTemplateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateNodeGroupTemplateRequestMultiError) AllErrors() []error { return m }
+
+// UpdateNodeGroupTemplateRequestValidationError is the validation error
+// returned by UpdateNodeGroupTemplateRequest.Validate if the designated
+// constraints aren't met.
+type UpdateNodeGroupTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateNodeGroupTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateNodeGroupTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateNodeGroupTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateNodeGroupTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateNodeGroupTemplateRequestValidationError) ErrorName() string {
+	return "UpdateNodeGroupTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateNodeGroupTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateNodeGroupTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateNodeGroupTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateNodeGroupTemplateRequestValidationError{}
+
+var _UpdateNodeGroupTemplateRequest_TemplateID_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]+$")
+
+// Validate checks the field values on UpdateNodeGroupTemplateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *UpdateNodeGroupTemplateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateNodeGroupTemplateResponse with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateNodeGroupTemplateResponseMultiError, or nil if none found.
+func (m *UpdateNodeGroupTemplateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateNodeGroupTemplateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Result
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateNodeGroupTemplateResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateNodeGroupTemplateResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateNodeGroupTemplateResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateNodeGroupTemplateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateNodeGroupTemplateResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateNodeGroupTemplateResponse.ValidateAll()
+// if the designated constraints aren't met.
+type UpdateNodeGroupTemplateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateNodeGroupTemplateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateNodeGroupTemplateResponseMultiError) AllErrors() []error { return m }
+
+// UpdateNodeGroupTemplateResponseValidationError is the validation error
+// returned by UpdateNodeGroupTemplateResponse.Validate if the designated
+// constraints aren't met.
+type UpdateNodeGroupTemplateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateNodeGroupTemplateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateNodeGroupTemplateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateNodeGroupTemplateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateNodeGroupTemplateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateNodeGroupTemplateResponseValidationError) ErrorName() string {
+	return "UpdateNodeGroupTemplateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateNodeGroupTemplateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateNodeGroupTemplateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateNodeGroupTemplateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateNodeGroupTemplateResponseValidationError{}
+
+// Validate checks the field values on DeleteNodeGroupTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *DeleteNodeGroupTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteNodeGroupTemplateRequest with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteNodeGroupTemplateRequestMultiError, or nil if none found.
+func (m *DeleteNodeGroupTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteNodeGroupTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetTemplateID()); l < 2 || l > 100 {
+		err := DeleteNodeGroupTemplateRequestValidationError{
+			field:  "TemplateID",
+			reason: "value length must be between 2 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_DeleteNodeGroupTemplateRequest_TemplateID_Pattern.MatchString(m.GetTemplateID()) {
+		err := DeleteNodeGroupTemplateRequestValidationError{
+			field:  "TemplateID",
+			reason: "value does not match regex pattern \"^[0-9a-zA-Z-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteNodeGroupTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteNodeGroupTemplateRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteNodeGroupTemplateRequest.ValidateAll()
+// if the designated constraints aren't met.
+type DeleteNodeGroupTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteNodeGroupTemplateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteNodeGroupTemplateRequestMultiError) AllErrors() []error { return m }
+
+// DeleteNodeGroupTemplateRequestValidationError is the validation error
+// returned by DeleteNodeGroupTemplateRequest.Validate if the designated
+// constraints aren't met.
+type DeleteNodeGroupTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteNodeGroupTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteNodeGroupTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteNodeGroupTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteNodeGroupTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteNodeGroupTemplateRequestValidationError) ErrorName() string {
+	return "DeleteNodeGroupTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteNodeGroupTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteNodeGroupTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteNodeGroupTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteNodeGroupTemplateRequestValidationError{}
+
+var _DeleteNodeGroupTemplateRequest_TemplateID_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]+$")
+
+// Validate checks the field values on DeleteNodeGroupTemplateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *DeleteNodeGroupTemplateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteNodeGroupTemplateResponse with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteNodeGroupTemplateResponseMultiError, or nil if none found.
+func (m *DeleteNodeGroupTemplateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteNodeGroupTemplateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Result
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeleteNodeGroupTemplateResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeleteNodeGroupTemplateResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeleteNodeGroupTemplateResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DeleteNodeGroupTemplateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteNodeGroupTemplateResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteNodeGroupTemplateResponse.ValidateAll()
+// if the designated constraints aren't met.
+type DeleteNodeGroupTemplateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteNodeGroupTemplateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteNodeGroupTemplateResponseMultiError) AllErrors() []error { return m }
+
+// DeleteNodeGroupTemplateResponseValidationError is the validation error
+// returned by DeleteNodeGroupTemplateResponse.Validate if the designated
+// constraints aren't met.
+type DeleteNodeGroupTemplateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteNodeGroupTemplateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteNodeGroupTemplateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteNodeGroupTemplateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteNodeGroupTemplateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteNodeGroupTemplateResponseValidationError) ErrorName() string {
+	return "DeleteNodeGroupTemplateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteNodeGroupTemplateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteNodeGroupTemplateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteNodeGroupTemplateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteNodeGroupTemplateResponseValidationError{}
+
+// Validate checks the field values on GetNodeGroupTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *GetNodeGroupTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetNodeGroupTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetNodeGroupTemplateRequestMultiError, or nil if none found.
+func (m *GetNodeGroupTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetNodeGroupTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetTemplateID()); l < 2 || l > 100 {
+		err := GetNodeGroupTemplateRequestValidationError{
+			field:  "TemplateID",
+			reason: "value length must be between 2 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_GetNodeGroupTemplateRequest_TemplateID_Pattern.MatchString(m.GetTemplateID()) {
+		err := GetNodeGroupTemplateRequestValidationError{
+			field:  "TemplateID",
+			reason: "value does not match regex pattern \"^[0-9a-zA-Z-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Version
+
+	if len(errors) > 0 {
+		return GetNodeGroupTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetNodeGroupTemplateRequestMultiError is an error wrapping multiple
+// validation errors returned by GetNodeGroupTemplateRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetNodeGroupTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetNodeGroupTemplateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetNodeGroupTemplateRequestMultiError) AllErrors() []error { return m }
+
+// GetNodeGroupTemplateRequestValidationError is the validation error returned
+// by GetNodeGroupTemplateRequest.Validate if the designated constraints aren't
+// met.
+type GetNodeGroupTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetNodeGroupTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetNodeGroupTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetNodeGroupTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetNodeGroupTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetNodeGroupTemplateRequestValidationError) ErrorName() string {
+	return "GetNodeGroupTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetNodeGroupTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetNodeGroupTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetNodeGroupTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetNodeGroupTemplateRequestValidationError{}
+
+var _GetNodeGroupTemplateRequest_TemplateID_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]+$")
+
+// Validate checks the field values on GetNodeGroupTemplateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *GetNodeGroupTemplateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetNodeGroupTemplateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetNodeGroupTemplateResponseMultiError, or nil if none found.
+func (m *GetNodeGroupTemplateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetNodeGroupTemplateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Result
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetNodeGroupTemplateResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetNodeGroupTemplateResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetNodeGroupTemplateResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetNodeGroupTemplateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetNodeGroupTemplateResponseMultiError is an error wrapping multiple
+// validation errors returned by GetNodeGroupTemplateResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetNodeGroupTemplateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetNodeGroupTemplateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetNodeGroupTemplateResponseMultiError) AllErrors() []error { return m }
+
+// GetNodeGroupTemplateResponseValidationError is the validation error returned
+// by GetNodeGroupTemplateResponse.Validate if the designated constraints
+// aren't met.
+type GetNodeGroupTemplateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetNodeGroupTemplateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetNodeGroupTemplateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetNodeGroupTemplateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetNodeGroupTemplateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetNodeGroupTemplateResponseValidationError) ErrorName() string {
+	return "GetNodeGroupTemplateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetNodeGroupTemplateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetNodeGroupTemplateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetNodeGroupTemplateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetNodeGroupTemplateResponseValidationError{}
+
+// Validate checks the field values on ListNodeGroupTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ListNodeGroupTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListNodeGroupTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListNodeGroupTemplateRequestMultiError, or nil if none found.
+func (m *ListNodeGroupTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListNodeGroupTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTemplateID()) > 100 {
+		err := ListNodeGroupTemplateRequestValidationError{
+			field:  "TemplateID",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) > 100 {
+		err := ListNodeGroupTemplateRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProjectID()) > 100 {
+		err := ListNodeGroupTemplateRequestValidationError{
+			field:  "ProjectID",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProvider()) > 100 {
+		err := ListNodeGroupTemplateRequestValidationError{
+			field:  "Provider",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AllVersions
+
+	if len(errors) > 0 {
+		return ListNodeGroupTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListNodeGroupTemplateRequestMultiError is an error wrapping multiple
+// validation errors returned by ListNodeGroupTemplateRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListNodeGroupTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListNodeGroupTemplateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListNodeGroupTemplateRequestMultiError) AllErrors() []error { return m }
+
+// ListNodeGroupTemplateRequestValidationError is the validation error returned
+// by ListNodeGroupTemplateRequest.Validate if the designated constraints
+// aren't met.
+type ListNodeGroupTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListNodeGroupTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListNodeGroupTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListNodeGroupTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListNodeGroupTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListNodeGroupTemplateRequestValidationError) ErrorName() string {
+	return "ListNodeGroupTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListNodeGroupTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListNodeGroupTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListNodeGroupTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListNodeGroupTemplateRequestValidationError{}
+
+// Validate checks the field values on ListNodeGroupTemplateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ListNodeGroupTemplateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListNodeGroupTemplateResponse with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListNodeGroupTemplateResponseMultiError, or nil if none found.
+func (m *ListNodeGroupTemplateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListNodeGroupTemplateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Result
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListNodeGroupTemplateResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListNodeGroupTemplateResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListNodeGroupTemplateResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListNodeGroupTemplateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListNodeGroupTemplateResponseMultiError is an error wrapping multiple
+// validation errors returned by ListNodeGroupTemplateResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListNodeGroupTemplateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListNodeGroupTemplateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListNodeGroupTemplateResponseMultiError) AllErrors() []error { return m }
+
+// ListNodeGroupTemplateResponseValidationError is the validation error
+// returned by ListNodeGroupTemplateResponse.Validate if the designated
+// constraints aren't met.
+type ListNodeGroupTemplateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListNodeGroupTemplateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListNodeGroupTemplateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListNodeGroupTemplateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListNodeGroupTemplateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListNodeGroupTemplateResponseValidationError) ErrorName() string {
+	return "ListNodeGroupTemplateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListNodeGroupTemplateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListNodeGroupTemplateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListNodeGroupTemplateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListNodeGroupTemplateResponseValidationError{}
+
+// Validate checks the field values on ClusterDrift with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ClusterDrift) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClusterDrift with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ClusterDriftMultiError, or
+// nil if none found.
+func (m *ClusterDrift) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClusterDrift) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ClusterID
+
+	// no validation rules for Provider
+
+	// no validation rules for ResourceType
+
+	// no validation rules for ResourceID
+
+	// no validation rules for Field
+
+	// no validation rules for StoreValue
+
+	// no validation rules for CloudValue
+
+	// no validation rules for AutoCorrected
+
+	// no validation rules for DetectTime
+
+	if len(errors) > 0 {
+		return ClusterDriftMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClusterDriftMultiError is an error wrapping multiple validation errors
+// returned by ClusterDrift.ValidateAll() if the designated constraints aren't
+// met.
+type ClusterDriftMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClusterDriftMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClusterDriftMultiError) AllErrors() []error { return m }
+
+// ClusterDriftValidationError is the validation error returned by
+// ClusterDrift.Validate if the designated constraints aren't met.
+type ClusterDriftValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClusterDriftValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClusterDriftValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClusterDriftValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClusterDriftValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClusterDriftValidationError) ErrorName() string {
+	return "ClusterDriftValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ClusterDriftValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClusterDrift.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClusterDriftValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClusterDriftValidationError{}
+
+// Validate checks the field values on ListClusterDriftRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *ListClusterDriftRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListClusterDriftRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListClusterDriftRequestMultiError, or nil if none found.
+func (m *ListClusterDriftRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListClusterDriftRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetClusterID()) > 100 {
+		err := ListClusterDriftRequestValidationError{
+			field:  "ClusterID",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProvider()) > 100 {
+		err := ListClusterDriftRequestValidationError{
+			field:  "Provider",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ListClusterDriftRequest_ResourceType_InLookup[m.GetResourceType()]; !ok {
+		err := ListClusterDriftRequestValidationError{
+			field:  "ResourceType",
+			reason: "value must be in list [ cluster nodegroup node]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetResourceID()) > 100 {
+		err := ListClusterDriftRequestValidationError{
+			field:  "ResourceID",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListClusterDriftRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListClusterDriftRequestMultiError is an error wrapping multiple validation
+// errors returned by ListClusterDriftRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListClusterDriftRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListClusterDriftRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListClusterDriftRequestMultiError) AllErrors() []error { return m }
+
+// ListClusterDriftRequestValidationError is the validation error returned by
+// ListClusterDriftRequest.Validate if the designated constraints aren't met.
+type ListClusterDriftRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListClusterDriftRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListClusterDriftRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListClusterDriftRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListClusterDriftRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListClusterDriftRequestValidationError) ErrorName() string {
+	return "ListClusterDriftRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListClusterDriftRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListClusterDriftRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListClusterDriftRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListClusterDriftRequestValidationError{}
+
+var _ListClusterDriftRequest_ResourceType_InLookup = map[string]struct{}{
+	"":          {},
+	"cluster":   {},
+	"nodegroup": {},
+	"node":      {},
+}
+
+// Validate checks the field values on ListClusterDriftResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *ListClusterDriftResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListClusterDriftResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListClusterDriftResponseMultiError, or nil if none found.
+func (m *ListClusterDriftResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListClusterDriftResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Result
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListClusterDriftResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListClusterDriftResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListClusterDriftResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListClusterDriftResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListClusterDriftResponseMultiError is an error wrapping multiple validation
+// errors returned by ListClusterDriftResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListClusterDriftResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListClusterDriftResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListClusterDriftResponseMultiError) AllErrors() []error { return m }
+
+// ListClusterDriftResponseValidationError is the validation error returned by
+// ListClusterDriftResponse.Validate if the designated constraints aren't met.
+type ListClusterDriftResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListClusterDriftResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListClusterDriftResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListClusterDriftResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListClusterDriftResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListClusterDriftResponseValidationError) ErrorName() string {
+	return "ListClusterDriftResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListClusterDriftResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListClusterDriftResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListClusterDriftResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListClusterDriftResponseValidationError{}
@@ -22,6 +22,7 @@ import (
 	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/drivers"
 	cmproto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/actions"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/actions/template"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/common"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/lock"
@@ -397,6 +398,18 @@ func (ca *CreateAction) Handle(ctx context.Context, req *cmproto.CreateClusterRe
 	ca.req = req
 	ca.resp = resp
 
+	// render request by referenced cluster template
+	if len(req.TemplateID) != 0 {
+		rendered, err := template.RenderCreateClusterReq(ctx, ca.model, req)
+		if err != nil {
+			blog.Errorf("CreateCluster render clusterTemplate %s failed: %v", req.TemplateID, err)
+			ca.setResp(common.BcsErrClusterManagerInvalidParameter, err.Error())
+			return
+		}
+		req = rendered
+		ca.req = rendered
+	}
+
 	if err := ca.req.Validate(); err != nil {
 		ca.setResp(common.BcsErrClusterManagerInvalidParameter, err.Error())
 		return
@@ -409,6 +422,14 @@ func (ca *CreateAction) Handle(ctx context.Context, req *cmproto.CreateClusterRe
 		ca.setResp(common.BcsErrClusterManagerDBOperation, err.Error())
 		return
 	}
+	// cluster rendered by template is validated by cloud
+	if len(req.TemplateID) != 0 {
+		if err = template.CloudValidateCreateClusterReq(ca.cloud, req); err != nil {
+			blog.Errorf("CreateCluster cloud validate by clusterTemplate %s failed: %v", req.TemplateID, err)
+			ca.setResp(common.BcsErrClusterManagerInvalidParameter, err.Error())
+			return
+		}
+	}
 	// init cluster and set cloud default info
 	cls, err := ca.constructCluster(ca.cloud)
 	if err != nil {
@@ -524,6 +545,7 @@ func (ca *CreateAction) createClusterTask(ctx context.Context, cls *cmproto.Clus
 		InitPassword: ca.req.InitLoginPassword,
 		Operator:     ca.req.Creator,
 		Cloud:        ca.cloud,
+		Nodes:        ca.req.Nodes,
 	})
 	if err != nil {
 		blog.Errorf("create Cluster %s by Cloud %s with provider %s failed, %s",
@@ -818,6 +818,7 @@ func (da *DeleteNodesAction) deleteNodesFromClusterTask() error {
 		Operator:     da.req.Operator,
 		DeleteMode:   da.req.DeleteMode,
 		Cloud:        da.cloud,
+		Drain:        da.req.DrainOption,
 	})
 	if err != nil {
 		blog.Errorf("cloudprovider %s deleteNodes %v from Cluster %s failed, %s",
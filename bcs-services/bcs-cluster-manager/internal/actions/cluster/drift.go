@@ -0,0 +1,103 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cluster
+
+import (
+	"context"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/operator"
+	cmproto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/common"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store"
+	storeopt "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/options"
+)
+
+// ListDriftAction action for list cluster drifts
+type ListDriftAction struct {
+	ctx       context.Context
+	model     store.ClusterManagerModel
+	req       *cmproto.ListClusterDriftRequest
+	resp      *cmproto.ListClusterDriftResponse
+	driftList []*cmproto.ClusterDrift
+}
+
+// NewListDriftAction create list action for cluster drifts
+func NewListDriftAction(model store.ClusterManagerModel) *ListDriftAction {
+	return &ListDriftAction{
+		model: model,
+	}
+}
+
+func (la *ListDriftAction) listClusterDrift() error {
+	condM := make(operator.M)
+	if len(la.req.ClusterID) != 0 {
+		condM["clusterid"] = la.req.ClusterID
+	}
+	if len(la.req.Provider) != 0 {
+		condM["provider"] = la.req.Provider
+	}
+	if len(la.req.ResourceType) != 0 {
+		condM["resourcetype"] = la.req.ResourceType
+	}
+	if len(la.req.ResourceID) != 0 {
+		condM["resourceid"] = la.req.ResourceID
+	}
+
+	cond := operator.NewLeafCondition(operator.Eq, condM)
+	drifts, err := la.model.ListClusterDrift(la.ctx, cond, &storeopt.ListOption{
+		Sort: map[string]int{
+			"clusterid":    1,
+			"resourcetype": 1,
+			"resourceid":   1,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	for i := range drifts {
+		la.driftList = append(la.driftList, &drifts[i])
+	}
+	return nil
+}
+
+func (la *ListDriftAction) setResp(code uint32, msg string) {
+	la.resp.Code = code
+	la.resp.Message = msg
+	la.resp.Result = (code == common.BcsErrClusterManagerSuccess)
+	la.resp.Data = la.driftList
+}
+
+// Handle list cluster drifts detected by drift reconciler
+func (la *ListDriftAction) Handle(
+	ctx context.Context, req *cmproto.ListClusterDriftRequest, resp *cmproto.ListClusterDriftResponse) {
+	if req == nil || resp == nil {
+		blog.Errorf("list cluster drift failed, req or resp is empty")
+		return
+	}
+	la.ctx = ctx
+	la.req = req
+	la.resp = resp
+
+	if err := req.Validate(); err != nil {
+		la.setResp(common.BcsErrClusterManagerInvalidParameter, err.Error())
+		return
+	}
+	if err := la.listClusterDrift(); err != nil {
+		la.setResp(common.BcsErrClusterManagerDBOperation, err.Error())
+		return
+	}
+	la.setResp(common.BcsErrClusterManagerSuccess, common.BcsErrClusterManagerSuccessStr)
+	return
+}
@@ -0,0 +1,232 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/operator"
+	cmproto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/common"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store"
+	storeopt "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/options"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/taskserver"
+)
+
+// UpgradeAction action for upgrade cluster version
+type UpgradeAction struct {
+	ctx   context.Context
+	model store.ClusterManagerModel
+	req   *cmproto.UpgradeClusterReq
+	resp  *cmproto.UpgradeClusterResp
+
+	cluster    *cmproto.Cluster
+	cloud      *cmproto.Cloud
+	nodeGroups []*cmproto.NodeGroup
+	groupNodes map[string][]*cmproto.Node
+	task       *cmproto.Task
+}
+
+// NewUpgradeAction upgrade cluster action
+func NewUpgradeAction(model store.ClusterManagerModel) *UpgradeAction {
+	return &UpgradeAction{
+		model:      model,
+		groupNodes: make(map[string][]*cmproto.Node),
+	}
+}
+
+func (ua *UpgradeAction) setResp(code uint32, msg string) {
+	ua.resp.Code = code
+	ua.resp.Message = msg
+	ua.resp.Result = (code == common.BcsErrClusterManagerSuccess)
+}
+
+func (ua *UpgradeAction) validate() error {
+	if err := ua.req.Validate(); err != nil {
+		return err
+	}
+
+	cluster, err := ua.model.GetCluster(ua.ctx, ua.req.ClusterID)
+	if err != nil {
+		blog.Errorf("get cluster %s failed when upgrade cluster, %s", ua.req.ClusterID, err.Error())
+		return err
+	}
+	if cluster.Status != common.StatusRunning {
+		return fmt.Errorf("cluster[%s] current status %s, not allow upgrade", cluster.ClusterID, cluster.Status)
+	}
+	ua.cluster = cluster
+
+	return nil
+}
+
+func (ua *UpgradeAction) getRelativeData() error {
+	cloud, err := ua.model.GetCloud(ua.ctx, ua.cluster.Provider)
+	if err != nil {
+		blog.Errorf("get cluster %s relative Cloud %s failed, %s",
+			ua.cluster.ClusterID, ua.cluster.Provider, err.Error())
+		return err
+	}
+	ua.cloud = cloud
+
+	// all nodeGroups in cluster are rolling upgraded when nodeGroupIDs is empty
+	cond := operator.NewLeafCondition(operator.Eq, operator.M{
+		"clusterid": ua.cluster.ClusterID,
+	})
+	groups, err := ua.model.ListNodeGroup(ua.ctx, cond, &storeopt.ListOption{})
+	if err != nil {
+		blog.Errorf("list cluster %s NodeGroup failed when upgrade cluster, %s", ua.cluster.ClusterID, err.Error())
+		return err
+	}
+	allGroups := make(map[string]*cmproto.NodeGroup)
+	for i := range groups {
+		allGroups[groups[i].NodeGroupID] = &groups[i]
+	}
+	if len(ua.req.NodeGroupIDs) == 0 {
+		for i := range groups {
+			ua.nodeGroups = append(ua.nodeGroups, &groups[i])
+		}
+	}
+	for _, groupID := range ua.req.NodeGroupIDs {
+		group, ok := allGroups[groupID]
+		if !ok {
+			return fmt.Errorf("NodeGroup %s is not under cluster %s", groupID, ua.cluster.ClusterID)
+		}
+		ua.nodeGroups = append(ua.nodeGroups, group)
+	}
+
+	for _, group := range ua.nodeGroups {
+		nodes, err := getNodeGroupRunningNodes(ua.ctx, ua.model, group)
+		if err != nil {
+			blog.Errorf("list NodeGroup %s nodes failed when upgrade cluster %s, %s",
+				group.NodeGroupID, ua.cluster.ClusterID, err.Error())
+			return err
+		}
+		ua.groupNodes[group.NodeGroupID] = nodes
+	}
+
+	return nil
+}
+
+// getNodeGroupRunningNodes get running nodes in nodeGroup, other nodes are skipped when upgrading
+func getNodeGroupRunningNodes(ctx context.Context, model store.ClusterManagerModel,
+	group *cmproto.NodeGroup) ([]*cmproto.Node, error) {
+	cond := operator.NewLeafCondition(operator.Eq, operator.M{
+		"clusterid":   group.ClusterID,
+		"nodegroupid": group.NodeGroupID,
+		"status":      common.StatusRunning,
+	})
+	return model.ListNode(ctx, cond, &storeopt.ListOption{})
+}
+
+func (ua *UpgradeAction) handleTask() error {
+	mgr, err := cloudprovider.GetTaskManager(ua.cloud.CloudProvider)
+	if err != nil {
+		blog.Errorf("get cloud %s TaskManager when upgrade cluster %s failed, %s",
+			ua.cloud.CloudProvider, ua.cluster.ClusterID, err.Error())
+		return err
+	}
+	coption, err := cloudprovider.GetCredential(&cloudprovider.CredentialData{
+		Cloud:     ua.cloud,
+		AccountID: ua.cluster.CloudAccountID,
+	})
+	if err != nil {
+		blog.Errorf("get credential from cloud %s when upgrade cluster %s failed, %s",
+			ua.cloud.CloudID, ua.cluster.ClusterID, err.Error())
+		return err
+	}
+	coption.Region = ua.cluster.Region
+
+	// build upgrade cluster task and dispatch to run
+	task, err := mgr.BuildUpgradeClusterTask(ua.cluster, &cloudprovider.UpgradeClusterOption{
+		CommonOption:   *coption,
+		Cloud:          ua.cloud,
+		Version:        ua.req.Version,
+		MaxUnavailable: ua.req.MaxUnavailable,
+		NodeGroups:     ua.nodeGroups,
+		GroupNodes:     ua.groupNodes,
+		Operator:       ua.req.Operator,
+	})
+	if err != nil {
+		blog.Errorf("build upgrade task for cluster %s with cloudprovider %s failed, %s",
+			ua.cluster.ClusterID, ua.cloud.CloudProvider, err.Error())
+		return err
+	}
+
+	// update cluster status before task running
+	ua.cluster.Status = common.StatusUpgrading
+	ua.cluster.Updater = ua.req.Operator
+	if err = ua.model.UpdateCluster(ua.ctx, ua.cluster); err != nil {
+		blog.Errorf("update cluster %s status to %s failed, %s",
+			ua.cluster.ClusterID, common.StatusUpgrading, err.Error())
+		return err
+	}
+	if err = ua.model.CreateTask(ua.ctx, task); err != nil {
+		blog.Errorf("save upgrade cluster task for cluster %s failed, %s", ua.cluster.ClusterID, err.Error())
+		return err
+	}
+	if err = taskserver.GetTaskServer().Dispatch(task); err != nil {
+		blog.Errorf("dispatch upgrade cluster task for cluster %s failed, %s", ua.cluster.ClusterID, err.Error())
+		return err
+	}
+
+	ua.task = task
+	ua.resp.Data = task
+	blog.Infof("upgrade cluster %s to version %s task %s dispatch successfully",
+		ua.cluster.ClusterID, ua.req.Version, task.TaskID)
+	return nil
+}
+
+// Handle upgrade cluster request
+func (ua *UpgradeAction) Handle(ctx context.Context, req *cmproto.UpgradeClusterReq, resp *cmproto.UpgradeClusterResp) {
+	if req == nil || resp == nil {
+		blog.Errorf("upgrade cluster failed, req or resp is empty")
+		return
+	}
+	ua.ctx = ctx
+	ua.req = req
+	ua.resp = resp
+
+	if err := ua.validate(); err != nil {
+		ua.setResp(common.BcsErrClusterManagerInvalidParameter, err.Error())
+		return
+	}
+	if err := ua.getRelativeData(); err != nil {
+		ua.setResp(common.BcsErrClusterManagerDBOperation, err.Error())
+		return
+	}
+	if err := ua.handleTask(); err != nil {
+		ua.setResp(common.BcsErrClusterManagerTaskErr, err.Error())
+		return
+	}
+
+	// record operation log
+	err := ua.model.CreateOperationLog(ua.ctx, &cmproto.OperationLog{
+		ResourceType: common.Cluster.String(),
+		ResourceID:   req.ClusterID,
+		TaskID:       ua.task.TaskID,
+		Message:      fmt.Sprintf("升级集群%s版本至%s", req.ClusterID, req.Version),
+		OpUser:       req.Operator,
+		CreateTime:   time.Now().String(),
+	})
+	if err != nil {
+		blog.Errorf("upgrade cluster[%s] CreateOperationLog failed: %v", req.ClusterID, err)
+	}
+
+	ua.setResp(common.BcsErrClusterManagerSuccess, common.BcsErrClusterManagerSuccessStr)
+	return
+}
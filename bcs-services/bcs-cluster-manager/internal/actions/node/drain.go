@@ -0,0 +1,140 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package node
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	cmproto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/common"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/taskserver"
+)
+
+// DrainNodeAction action for cordon nodes and drain nodes gracefully
+type DrainNodeAction struct {
+	ctx   context.Context
+	model store.ClusterManagerModel
+	req   *cmproto.DrainNodeRequest
+	resp  *cmproto.DrainNodeResponse
+
+	cluster *cmproto.Cluster
+	task    *cmproto.Task
+}
+
+// NewDrainNodeAction create drain action
+func NewDrainNodeAction(model store.ClusterManagerModel) *DrainNodeAction {
+	return &DrainNodeAction{
+		model: model,
+	}
+}
+
+func (da *DrainNodeAction) validate() error {
+	if err := da.req.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (da *DrainNodeAction) getRelativeData() error {
+	cluster, err := da.model.GetCluster(da.ctx, da.req.ClusterID)
+	if err != nil {
+		blog.Errorf("get Cluster %s failed when drain nodes, %s", da.req.ClusterID, err.Error())
+		return err
+	}
+	da.cluster = cluster
+
+	return nil
+}
+
+// handleTask drain nodes in background task, step logs record eviction progress of pods
+func (da *DrainNodeAction) handleTask() error {
+	task, err := cloudprovider.BuildDrainNodeTask(da.cluster, da.req.InnerIPs, &cmproto.DrainOption{
+		Timeout:            da.req.Timeout,
+		Force:              da.req.Force,
+		GracePeriodSeconds: da.req.GracePeriodSeconds,
+	}, da.req.Operator)
+	if err != nil {
+		blog.Errorf("build drain nodes %v task for Cluster %s failed, %s",
+			da.req.InnerIPs, da.req.ClusterID, err.Error(),
+		)
+		return err
+	}
+	if err := da.model.CreateTask(da.ctx, task); err != nil {
+		blog.Errorf("save drain nodes task for Cluster %s failed, %s", da.req.ClusterID, err.Error())
+		return err
+	}
+	if err := taskserver.GetTaskServer().Dispatch(task); err != nil {
+		blog.Errorf("dispatch drain nodes task for Cluster %s failed, %s", da.req.ClusterID, err.Error())
+		return err
+	}
+
+	da.task = task
+	da.resp.Data = task
+	blog.Infof("drain Cluster %s nodes %v task %s dispatch successfully",
+		da.req.ClusterID, da.req.InnerIPs, task.TaskID)
+	return nil
+}
+
+func (da *DrainNodeAction) setResp(code uint32, msg string) {
+	da.resp.Code = code
+	da.resp.Message = msg
+	da.resp.Result = (code == common.BcsErrClusterManagerSuccess)
+}
+
+// Handle handles node drain
+func (da *DrainNodeAction) Handle(ctx context.Context, req *cmproto.DrainNodeRequest, resp *cmproto.DrainNodeResponse) {
+	if req == nil || resp == nil {
+		blog.Errorf("drain cluster node failed, req or resp is empty")
+		return
+	}
+	da.ctx = ctx
+	da.req = req
+	da.resp = resp
+
+	if err := da.validate(); err != nil {
+		da.setResp(common.BcsErrClusterManagerInvalidParameter, err.Error())
+		return
+	}
+	if err := da.getRelativeData(); err != nil {
+		da.setResp(common.BcsErrClusterManagerDBOperation, err.Error())
+		return
+	}
+	if err := da.handleTask(); err != nil {
+		da.setResp(common.BcsErrClusterManagerTaskErr, err.Error())
+		return
+	}
+
+	// record operation log
+	err := da.model.CreateOperationLog(da.ctx, &cmproto.OperationLog{
+		ResourceType: common.Cluster.String(),
+		ResourceID:   req.ClusterID,
+		TaskID:       da.task.TaskID,
+		Message:      fmt.Sprintf("集群%s驱逐节点%s", req.ClusterID, strings.Join(req.InnerIPs, ",")),
+		OpUser:       req.Operator,
+		CreateTime:   time.Now().String(),
+	})
+	if err != nil {
+		blog.Errorf("DrainNode[%s] CreateOperationLog failed: %v", req.ClusterID, err)
+	}
+
+	da.setResp(common.BcsErrClusterManagerSuccess, common.BcsErrClusterManagerSuccessStr)
+	return
+}
@@ -23,6 +23,7 @@ import (
 
 	cmproto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/actions"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/actions/template"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/common"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store"
@@ -257,6 +258,17 @@ func (ca *CreateAction) Handle(ctx context.Context,
 	ca.resp = resp
 	ca.resp.Data = &cmproto.CreateNodeGroupResponseData{}
 
+	// render request by referenced nodeGroup template
+	if len(req.TemplateID) != 0 {
+		rendered, err := template.RenderCreateNodeGroupReq(ctx, ca.model, req)
+		if err != nil {
+			blog.Errorf("CreateNodeGroup render nodeGroupTemplate %s failed: %v", req.TemplateID, err)
+			ca.setResp(common.BcsErrClusterManagerInvalidParameter, err.Error())
+			return
+		}
+		ca.req = rendered
+	}
+
 	if err := ca.validate(); err != nil {
 		ca.setResp(common.BcsErrClusterManagerInvalidParameter, err.Error())
 		return
@@ -267,6 +279,14 @@ func (ca *CreateAction) Handle(ctx context.Context,
 		ca.setResp(common.BcsErrClusterManagerDBOperation, err.Error())
 		return
 	}
+	// nodeGroup rendered by template is validated by cloud
+	if len(ca.req.TemplateID) != 0 {
+		if err := template.CloudValidateCreateNodeGroupReq(ca.cloud, ca.cluster, ca.req); err != nil {
+			blog.Errorf("CreateNodeGroup cloud validate by nodeGroupTemplate %s failed: %v", ca.req.TemplateID, err)
+			ca.setResp(common.BcsErrClusterManagerInvalidParameter, err.Error())
+			return
+		}
+	}
 
 	// save nodegroup to storage
 	if err := ca.save(); err != nil {
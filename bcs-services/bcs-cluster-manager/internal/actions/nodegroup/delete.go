@@ -551,6 +551,7 @@ func (da *CleanNodesAction) handleTask() error {
 		Cloud:    da.cloud,
 		Cluster:  da.cluster,
 		Operator: da.req.Operator,
+		Drain:    da.req.DrainOption,
 	})
 	if err != nil {
 		blog.Errorf("build clean Node %v task from NodeGroup %s with cloudprovider %s failed, %s",
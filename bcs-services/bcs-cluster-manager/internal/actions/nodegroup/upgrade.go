@@ -0,0 +1,211 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package nodegroup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/operator"
+
+	cmproto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/actions"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/common"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/options"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/taskserver"
+)
+
+// UpgradeAction action for rolling upgrade nodegroup nodes to cluster version
+type UpgradeAction struct {
+	ctx   context.Context
+	model store.ClusterManagerModel
+	req   *cmproto.UpgradeNodeGroupRequest
+	resp  *cmproto.UpgradeNodeGroupResponse
+
+	group   *cmproto.NodeGroup
+	cluster *cmproto.Cluster
+	cloud   *cmproto.Cloud
+	nodes   []*cmproto.Node
+	task    *cmproto.Task
+}
+
+// NewUpgradeAction create upgrade action for nodegroup
+func NewUpgradeAction(model store.ClusterManagerModel) *UpgradeAction {
+	return &UpgradeAction{
+		model: model,
+	}
+}
+
+func (ua *UpgradeAction) setResp(code uint32, msg string) {
+	ua.resp.Code = code
+	ua.resp.Message = msg
+	ua.resp.Result = (code == common.BcsErrClusterManagerSuccess)
+}
+
+func (ua *UpgradeAction) validate() error {
+	if err := ua.req.Validate(); err != nil {
+		ua.setResp(common.BcsErrClusterManagerInvalidParameter, err.Error())
+		return err
+	}
+	// validate nodegroup exist
+	group, err := ua.model.GetNodeGroup(ua.ctx, ua.req.NodeGroupID)
+	if err != nil {
+		blog.Errorf("get NodeGroup %s failed when upgrade NodeGroup, %s", ua.req.NodeGroupID, err.Error())
+		ua.setResp(common.BcsErrClusterManagerDBOperation, err.Error())
+		return err
+	}
+	if group.Status != common.StatusRunning {
+		retErr := fmt.Errorf("NodeGroup %s current status %s, not allow upgrade", group.NodeGroupID, group.Status)
+		ua.setResp(common.BcsErrClusterManagerInvalidParameter, retErr.Error())
+		return retErr
+	}
+	ua.group = group
+
+	return nil
+}
+
+func (ua *UpgradeAction) getRelativeData() error {
+	cloud, cluster, err := actions.GetCloudAndCluster(ua.model, ua.group.Provider, ua.group.ClusterID)
+	if err != nil {
+		blog.Errorf("get cloud %s and cluster %s when upgrade NodeGroup %s failed, %s",
+			ua.group.Provider, ua.group.ClusterID, ua.group.NodeGroupID, err.Error(),
+		)
+		return err
+	}
+	// nodes are upgraded to cluster version, cluster must not be upgrading
+	if cluster.Status != common.StatusRunning {
+		return fmt.Errorf("cluster %s current status %s, not allow upgrade NodeGroup",
+			cluster.ClusterID, cluster.Status)
+	}
+	ua.cluster = cluster
+	ua.cloud = cloud
+
+	// only running nodes are upgraded
+	condM := make(operator.M)
+	condM["clusterid"] = ua.group.ClusterID
+	condM["nodegroupid"] = ua.group.NodeGroupID
+	condM["status"] = common.StatusRunning
+	cond := operator.NewLeafCondition(operator.Eq, condM)
+	nodes, err := ua.model.ListNode(ua.ctx, cond, &options.ListOption{})
+	if err != nil {
+		blog.Errorf("get NodeGroup %s Nodes failed when upgrade NodeGroup, %s", ua.group.NodeGroupID, err.Error())
+		return err
+	}
+	if len(nodes) == 0 {
+		return fmt.Errorf("NodeGroup %s has no running nodes to upgrade", ua.group.NodeGroupID)
+	}
+	ua.nodes = nodes
+
+	return nil
+}
+
+func (ua *UpgradeAction) handleTask() error {
+	mgr, err := cloudprovider.GetTaskManager(ua.cloud.CloudProvider)
+	if err != nil {
+		blog.Errorf("get cloud %s TaskManager when upgrade NodeGroup %s failed, %s",
+			ua.cloud.CloudProvider, ua.group.NodeGroupID, err.Error(),
+		)
+		return err
+	}
+
+	// build upgrade nodegroup task and dispatch to run
+	task, err := mgr.BuildUpgradeNodeGroupTask(ua.group, &cloudprovider.UpgradeNodeGroupOption{
+		Cluster:        ua.cluster,
+		Cloud:          ua.cloud,
+		Nodes:          ua.nodes,
+		MaxUnavailable: ua.req.MaxUnavailable,
+		Operator:       ua.req.Operator,
+	})
+	if err != nil {
+		blog.Errorf("build upgrade task for NodeGroup %s with cloudprovider %s failed, %s",
+			ua.group.NodeGroupID, ua.cloud.CloudProvider, err.Error(),
+		)
+		return err
+	}
+
+	// update nodegroup status before task running
+	ua.group.Status = common.StatusUpgrading
+	ua.group.Updater = ua.req.Operator
+	if err := ua.model.UpdateNodeGroup(ua.ctx, ua.group); err != nil {
+		blog.Errorf("update NodeGroup %s status to %s failed, %s",
+			ua.group.NodeGroupID, common.StatusUpgrading, err.Error(),
+		)
+		return err
+	}
+	if err := ua.model.CreateTask(ua.ctx, task); err != nil {
+		blog.Errorf("save upgrade task for NodeGroup %s failed, %s",
+			ua.group.NodeGroupID, err.Error(),
+		)
+		return err
+	}
+	if err := taskserver.GetTaskServer().Dispatch(task); err != nil {
+		blog.Errorf("dispatch upgrade task for NodeGroup %s failed, %s",
+			ua.group.NodeGroupID, err.Error(),
+		)
+		return err
+	}
+
+	ua.task = task
+	ua.resp.Data = task
+	blog.Infof("upgrade NodeGroup %s task %s dispatch successfully", ua.group.NodeGroupID, task.TaskID)
+	return nil
+}
+
+// Handle handle upgrade nodegroup request
+func (ua *UpgradeAction) Handle(
+	ctx context.Context, req *cmproto.UpgradeNodeGroupRequest, resp *cmproto.UpgradeNodeGroupResponse) {
+
+	if req == nil || resp == nil {
+		blog.Errorf("upgrade NodeGroup failed, req or resp is empty")
+		return
+	}
+	ua.ctx = ctx
+	ua.req = req
+	ua.resp = resp
+
+	if err := ua.validate(); err != nil {
+		// validation already setting response
+		return
+	}
+	if err := ua.getRelativeData(); err != nil {
+		ua.setResp(common.BcsErrClusterManagerDBOperation, err.Error())
+		return
+	}
+	if err := ua.handleTask(); err != nil {
+		ua.setResp(common.BcsErrClusterManagerTaskErr, err.Error())
+		return
+	}
+
+	// record operation log
+	err := ua.model.CreateOperationLog(ua.ctx, &cmproto.OperationLog{
+		ResourceType: common.NodeGroup.String(),
+		ResourceID:   req.NodeGroupID,
+		TaskID:       ua.task.TaskID,
+		Message: fmt.Sprintf("集群%s滚动升级节点池%s节点至版本%s", ua.cluster.ClusterID, req.NodeGroupID,
+			ua.cluster.GetClusterBasicSettings().GetVersion()),
+		OpUser:     req.Operator,
+		CreateTime: time.Now().String(),
+	})
+	if err != nil {
+		blog.Errorf("UpgradeNodeGroup[%s] CreateOperationLog failed: %v", req.NodeGroupID, err)
+	}
+
+	blog.Infof("upgrade NodeGroup %s successfully", req.NodeGroupID)
+	ua.setResp(common.BcsErrClusterManagerSuccess, common.BcsErrClusterManagerSuccessStr)
+	return
+}
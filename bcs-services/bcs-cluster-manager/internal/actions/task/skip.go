@@ -0,0 +1,159 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under,
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package task
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/operator"
+	cmproto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/common"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/taskserver"
+)
+
+// SkipStepAction action for skipping step of failed task
+type SkipStepAction struct {
+	ctx   context.Context
+	model store.ClusterManagerModel
+	req   *cmproto.SkipTaskStepRequest
+	resp  *cmproto.SkipTaskStepResponse
+	task  *cmproto.Task
+}
+
+// NewSkipStepAction create skip step action for failed task
+func NewSkipStepAction(model store.ClusterManagerModel) *SkipStepAction {
+	return &SkipStepAction{
+		model: model,
+	}
+}
+
+func (sa *SkipStepAction) setResp(code uint32, msg string) {
+	sa.resp.Code = code
+	sa.resp.Message = msg
+	sa.resp.Result = (code == common.BcsErrClusterManagerSuccess)
+}
+
+func (sa *SkipStepAction) validate() error {
+	switch sa.task.Status {
+	case cloudprovider.TaskStatusInit, cloudprovider.TaskStatusRunning, cloudprovider.TaskStatusSuccess:
+		return fmt.Errorf("task[%s] status[%s] doing or done when skip step", sa.task.TaskID, sa.task.Status)
+	}
+	step, ok := sa.task.Steps[sa.req.StepName]
+	if !ok {
+		return fmt.Errorf("task[%s] step[%s] not found", sa.task.TaskID, sa.req.StepName)
+	}
+	switch step.Status {
+	case cloudprovider.TaskStatusRunning, cloudprovider.TaskStatusSuccess, cloudprovider.TaskStatusSkip:
+		return fmt.Errorf("task[%s] step[%s] status[%s] can't be skipped", sa.task.TaskID, step.Name, step.Status)
+	}
+	return nil
+}
+
+// skipStep mark step skipped and task running, steps depend on it continue to run
+func (sa *SkipStepAction) skipStep() error {
+	now := time.Now().Format(time.RFC3339)
+	step := sa.task.Steps[sa.req.StepName]
+	oldStatus := step.Status
+	step.Status = cloudprovider.TaskStatusSkip
+	step.Message = fmt.Sprintf("step skipped by %s, reason: %s", sa.req.Updater, sa.req.Reason)
+	step.SkipReason = sa.req.Reason
+	step.SkipOperator = sa.req.Updater
+	step.LastUpdate = now
+
+	sa.task.Status = cloudprovider.TaskStatusRunning
+	sa.task.Message = fmt.Sprintf("step %s skipped, task continue", step.Name)
+	sa.task.Updater = sa.req.Updater
+	sa.task.LastUpdate = now
+
+	cond := operator.NewBranchCondition(operator.And, taskRetryCondition(),
+		operator.NewLeafCondition(operator.Eq, operator.M{
+			"steps." + step.Name + ".status": oldStatus,
+		}))
+	ok, err := sa.model.PatchTaskWithCondition(sa.ctx, sa.task.TaskID, cond, map[string]interface{}{
+		"steps." + step.Name: step,
+		"status":             sa.task.Status,
+		"message":            sa.task.Message,
+		"updater":            sa.task.Updater,
+		"lastupdate":         sa.task.LastUpdate,
+	})
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("task[%s] status changed when skip step %s", sa.task.TaskID, step.Name)
+	}
+	return nil
+}
+
+// Handle handle skip task step
+func (sa *SkipStepAction) Handle(
+	ctx context.Context, req *cmproto.SkipTaskStepRequest, resp *cmproto.SkipTaskStepResponse) {
+	if req == nil || resp == nil {
+		blog.Errorf("skip task step failed, req or resp is empty")
+		return
+	}
+	sa.ctx = ctx
+	sa.req = req
+	sa.resp = resp
+
+	if err := req.Validate(); err != nil {
+		sa.setResp(common.BcsErrClusterManagerInvalidParameter, err.Error())
+		return
+	}
+	task, err := sa.model.GetTask(sa.ctx, req.TaskID)
+	if err != nil {
+		sa.setResp(common.BcsErrClusterManagerDBOperation, err.Error())
+		blog.Errorf("find Task %s failed when skip step, err %s", req.TaskID, err.Error())
+		return
+	}
+	sa.task = task
+
+	if err := sa.validate(); err != nil {
+		sa.setResp(common.BcsErrClusterManagerInvalidParameter, err.Error())
+		return
+	}
+	if err := sa.skipStep(); err != nil {
+		blog.Errorf("skip task[%s] step[%s] failed, %s", req.TaskID, req.StepName, err.Error())
+		sa.setResp(common.BcsErrClusterManagerTaskErr, err.Error())
+		return
+	}
+
+	// record operation log for audit
+	err = sa.model.CreateOperationLog(sa.ctx, &cmproto.OperationLog{
+		ResourceType: common.Task.String(),
+		ResourceID:   task.TaskID,
+		TaskID:       task.TaskID,
+		Message:      fmt.Sprintf("任务%s跳过步骤%s, 原因: %s", task.TaskID, req.StepName, req.Reason),
+		OpUser:       req.Updater,
+		CreateTime:   time.Now().String(),
+	})
+	if err != nil {
+		blog.Errorf("SkipTaskStep[%s] CreateOperationLog failed: %v", task.TaskID, err)
+	}
+
+	if err := taskserver.GetTaskServer().Dispatch(task); err != nil {
+		blog.Errorf("dispatch task[%s] after skip step %s failed, %s", task.TaskID, req.StepName, err.Error())
+		sa.setResp(common.BcsErrClusterManagerTaskErr, err.Error())
+		return
+	}
+	blog.Infof("task[%s] skip step %s by %s successfully", task.TaskID, req.StepName, req.Updater)
+
+	sa.resp.Data = task
+	sa.setResp(common.BcsErrClusterManagerSuccess, common.BcsErrClusterManagerSuccessStr)
+	return
+}
@@ -162,14 +162,48 @@ func (ua *RetryAction) Handle(
 	case cloudprovider.TaskStatusFailure, cloudprovider.TaskStatusTimeout:
 	}
 
+	patchs := make(map[string]interface{})
+	// retry from specified step, step and steps depend on it run again
+	if len(req.StepName) != 0 {
+		if _, ok := task.Steps[req.StepName]; !ok {
+			errMsg := fmt.Sprintf("task[%s] step[%s] not found when retry task", task.TaskID, req.StepName)
+			blog.Errorf(errMsg)
+			ua.setResp(common.BcsErrClusterManagerInvalidParameter, errMsg)
+			return
+		}
+		for _, name := range cloudprovider.GetDescendantSteps(task, req.StepName) {
+			step := task.Steps[name]
+			step.Status = cloudprovider.TaskStatusNotStarted
+			step.Retry = 0
+			step.Message = fmt.Sprintf("retry from step %s", req.StepName)
+			step.SkipReason = ""
+			step.SkipOperator = ""
+			step.LastUpdate = time.Now().Format(time.RFC3339)
+			patchs["steps."+name] = step
+		}
+	}
+
 	task.Status = cloudprovider.TaskStatusRunning
 	task.Message = "task retrying"
-
-	err = ua.model.UpdateTask(ua.ctx, task)
+	task.Updater = req.Updater
+	task.LastUpdate = time.Now().Format(time.RFC3339)
+	patchs["status"] = task.Status
+	patchs["message"] = task.Message
+	patchs["updater"] = task.Updater
+	patchs["lastupdate"] = task.LastUpdate
+
+	// task is retried only once when retry concurrently
+	ok, err := ua.model.PatchTaskWithCondition(ua.ctx, task.TaskID, taskRetryCondition(), patchs)
 	if err != nil {
 		ua.setResp(common.BcsErrClusterManagerDBOperation, err.Error())
 		return
 	}
+	if !ok {
+		errMsg := fmt.Sprintf("task[%s] status changed when retry task", task.TaskID)
+		blog.Errorf(errMsg)
+		ua.setResp(common.BcsErrClusterManagerTaskErr, errMsg)
+		return
+	}
 	if err := taskserver.GetTaskServer().Dispatch(task); err != nil {
 		blog.Errorf("dispatch retry task[%s] for cluster %s failed, %s", task.TaskID, task.ClusterID, err.Error())
 		ua.setResp(common.BcsErrClusterManagerTaskErr, err.Error())
@@ -13,7 +13,12 @@
 
 package task
 
-import "strings"
+import (
+	"strings"
+
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/operator"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+)
 
 // Passwd flag
 var Passwd = []string{"password", "passwd"}
@@ -26,3 +31,12 @@ func strContains(ipList []string, ip string) bool {
 	}
 	return false
 }
+
+// taskRetryCondition condition matches task which is not running or done, only these
+// tasks are allowed to retry or skip step
+func taskRetryCondition() *operator.Condition {
+	return operator.NewLeafCondition(operator.Nin, operator.M{
+		"status": []string{cloudprovider.TaskStatusInit, cloudprovider.TaskStatusRunning,
+			cloudprovider.TaskStatusSuccess},
+	})
+}
@@ -0,0 +1,429 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package template
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/drivers"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/operator"
+	cmproto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/common"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store"
+	storeopt "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/options"
+)
+
+// CreateNodeGroupTemplateAction action for create nodeGroupTemplate
+type CreateNodeGroupTemplateAction struct {
+	ctx      context.Context
+	model    store.ClusterManagerModel
+	req      *cmproto.CreateNodeGroupTemplateRequest
+	resp     *cmproto.CreateNodeGroupTemplateResponse
+	template *cmproto.NodeGroupTemplate
+}
+
+// NewCreateNodeGroupTemplateAction create nodeGroupTemplate action
+func NewCreateNodeGroupTemplateAction(model store.ClusterManagerModel) *CreateNodeGroupTemplateAction {
+	return &CreateNodeGroupTemplateAction{
+		model: model,
+	}
+}
+
+func (ca *CreateNodeGroupTemplateAction) validate() error {
+	if err := ca.req.Validate(); err != nil {
+		return err
+	}
+	if ca.req.NodeGroup == nil {
+		return fmt.Errorf("nodeGroupTemplate nodeGroup config is empty")
+	}
+
+	timeStr := time.Now().Format(time.RFC3339)
+	ca.template = &cmproto.NodeGroupTemplate{
+		TemplateID:  generateTemplateID(nodeGroupTemplatePrefix),
+		Name:        ca.req.Name,
+		Description: ca.req.Description,
+		ProjectID:   ca.req.ProjectID,
+		Provider:    ca.req.Provider,
+		Version:     1,
+		Parameters:  ca.req.Parameters,
+		NodeGroup:   ca.req.NodeGroup,
+		Creator:     ca.req.Creator,
+		Updater:     ca.req.Creator,
+		CreateTime:  timeStr,
+		UpdateTime:  timeStr,
+	}
+	return validateNodeGroupTemplate(ca.template, ca.model)
+}
+
+func (ca *CreateNodeGroupTemplateAction) setResp(code uint32, msg string) {
+	ca.resp.Code = code
+	ca.resp.Message = msg
+	ca.resp.Result = (code == common.BcsErrClusterManagerSuccess)
+}
+
+// Handle create nodeGroupTemplate request
+func (ca *CreateNodeGroupTemplateAction) Handle(ctx context.Context,
+	req *cmproto.CreateNodeGroupTemplateRequest, resp *cmproto.CreateNodeGroupTemplateResponse) {
+	if req == nil || resp == nil {
+		blog.Errorf("create nodeGroupTemplate failed, req or resp is empty")
+		return
+	}
+	ca.ctx = ctx
+	ca.req = req
+	ca.resp = resp
+
+	if err := ca.validate(); err != nil {
+		ca.setResp(common.BcsErrClusterManagerInvalidParameter, err.Error())
+		return
+	}
+	if err := ca.model.CreateNodeGroupTemplate(ca.ctx, ca.template); err != nil {
+		if errors.Is(err, drivers.ErrTableRecordDuplicateKey) {
+			ca.setResp(common.BcsErrClusterManagerDatabaseRecordDuplicateKey, err.Error())
+			return
+		}
+		ca.setResp(common.BcsErrClusterManagerDBOperation, err.Error())
+		return
+	}
+
+	err := ca.model.CreateOperationLog(ca.ctx, &cmproto.OperationLog{
+		ResourceType: common.NodeGroupTemplate.String(),
+		ResourceID:   ca.template.TemplateID,
+		TaskID:       "",
+		Message:      fmt.Sprintf("创建节点池模板[%s]", ca.template.TemplateID),
+		OpUser:       req.Creator,
+		CreateTime:   time.Now().String(),
+	})
+	if err != nil {
+		blog.Errorf("CreateNodeGroupTemplate[%s] CreateOperationLog failed: %v", ca.template.TemplateID, err)
+	}
+
+	ca.resp.Data = ca.template
+	ca.setResp(common.BcsErrClusterManagerSuccess, common.BcsErrClusterManagerSuccessStr)
+	return
+}
+
+// UpdateNodeGroupTemplateAction action for update nodeGroupTemplate, every update saves a new template version
+type UpdateNodeGroupTemplateAction struct {
+	ctx      context.Context
+	model    store.ClusterManagerModel
+	req      *cmproto.UpdateNodeGroupTemplateRequest
+	resp     *cmproto.UpdateNodeGroupTemplateResponse
+	template *cmproto.NodeGroupTemplate
+}
+
+// NewUpdateNodeGroupTemplateAction create update action for nodeGroupTemplate
+func NewUpdateNodeGroupTemplateAction(model store.ClusterManagerModel) *UpdateNodeGroupTemplateAction {
+	return &UpdateNodeGroupTemplateAction{
+		model: model,
+	}
+}
+
+// newVersion build next template version based on latest version
+func (ua *UpdateNodeGroupTemplateAction) newVersion(latest *cmproto.NodeGroupTemplate) error {
+	template := *latest
+	template.Version = latest.Version + 1
+	template.Updater = ua.req.Updater
+	template.UpdateTime = time.Now().Format(time.RFC3339)
+
+	if len(ua.req.Name) != 0 {
+		template.Name = ua.req.Name
+	}
+	if len(ua.req.Description) != 0 {
+		template.Description = ua.req.Description
+	}
+	if len(ua.req.Parameters) != 0 {
+		template.Parameters = ua.req.Parameters
+	}
+	if ua.req.NodeGroup != nil {
+		template.NodeGroup = ua.req.NodeGroup
+	}
+	ua.template = &template
+
+	return validateNodeGroupTemplate(ua.template, ua.model)
+}
+
+func (ua *UpdateNodeGroupTemplateAction) setResp(code uint32, msg string) {
+	ua.resp.Code = code
+	ua.resp.Message = msg
+	ua.resp.Result = (code == common.BcsErrClusterManagerSuccess)
+}
+
+// Handle handle update nodeGroupTemplate
+func (ua *UpdateNodeGroupTemplateAction) Handle(
+	ctx context.Context, req *cmproto.UpdateNodeGroupTemplateRequest, resp *cmproto.UpdateNodeGroupTemplateResponse) {
+	if req == nil || resp == nil {
+		blog.Errorf("update nodeGroupTemplate failed, req or resp is empty")
+		return
+	}
+	ua.ctx = ctx
+	ua.req = req
+	ua.resp = resp
+
+	if err := req.Validate(); err != nil {
+		ua.setResp(common.BcsErrClusterManagerInvalidParameter, err.Error())
+		return
+	}
+	latest, err := ua.model.GetNodeGroupTemplate(ua.ctx, req.TemplateID, 0)
+	if err != nil {
+		if errors.Is(err, drivers.ErrTableRecordNotFound) {
+			ua.setResp(common.BcsErrClusterManagerDatabaseRecordNotFound, err.Error())
+			return
+		}
+		ua.setResp(common.BcsErrClusterManagerDBOperation, err.Error())
+		blog.Errorf("find nodeGroupTemplate %s failed when pre-update checking, err %s", req.TemplateID, err.Error())
+		return
+	}
+	if err := ua.newVersion(latest); err != nil {
+		ua.setResp(common.BcsErrClusterManagerInvalidParameter, err.Error())
+		return
+	}
+	// version conflicts with concurrent update is rejected by unique index
+	if err := ua.model.CreateNodeGroupTemplate(ua.ctx, ua.template); err != nil {
+		if errors.Is(err, drivers.ErrTableRecordDuplicateKey) {
+			ua.setResp(common.BcsErrClusterManagerDatabaseRecordDuplicateKey, err.Error())
+			return
+		}
+		ua.setResp(common.BcsErrClusterManagerDBOperation, err.Error())
+		return
+	}
+
+	err = ua.model.CreateOperationLog(ua.ctx, &cmproto.OperationLog{
+		ResourceType: common.NodeGroupTemplate.String(),
+		ResourceID:   req.TemplateID,
+		TaskID:       "",
+		Message:      fmt.Sprintf("更新节点池模板[%s]至版本[%d]", req.TemplateID, ua.template.Version),
+		OpUser:       req.Updater,
+		CreateTime:   time.Now().String(),
+	})
+	if err != nil {
+		blog.Errorf("UpdateNodeGroupTemplate[%s] CreateOperationLog failed: %v", req.TemplateID, err)
+	}
+
+	ua.resp.Data = ua.template
+	ua.setResp(common.BcsErrClusterManagerSuccess, common.BcsErrClusterManagerSuccessStr)
+	return
+}
+
+// DeleteNodeGroupTemplateAction action for delete nodeGroupTemplate with all versions
+type DeleteNodeGroupTemplateAction struct {
+	ctx   context.Context
+	model store.ClusterManagerModel
+	req   *cmproto.DeleteNodeGroupTemplateRequest
+	resp  *cmproto.DeleteNodeGroupTemplateResponse
+}
+
+// NewDeleteNodeGroupTemplateAction create delete action for nodeGroupTemplate
+func NewDeleteNodeGroupTemplateAction(model store.ClusterManagerModel) *DeleteNodeGroupTemplateAction {
+	return &DeleteNodeGroupTemplateAction{
+		model: model,
+	}
+}
+
+func (da *DeleteNodeGroupTemplateAction) setResp(code uint32, msg string) {
+	da.resp.Code = code
+	da.resp.Message = msg
+	da.resp.Result = (code == common.BcsErrClusterManagerSuccess)
+}
+
+// Handle handle delete nodeGroupTemplate
+func (da *DeleteNodeGroupTemplateAction) Handle(
+	ctx context.Context, req *cmproto.DeleteNodeGroupTemplateRequest, resp *cmproto.DeleteNodeGroupTemplateResponse) {
+	if req == nil || resp == nil {
+		blog.Errorf("delete nodeGroupTemplate failed, req or resp is empty")
+		return
+	}
+	da.ctx = ctx
+	da.req = req
+	da.resp = resp
+
+	if err := req.Validate(); err != nil {
+		da.setResp(common.BcsErrClusterManagerInvalidParameter, err.Error())
+		return
+	}
+	deleted, err := da.model.GetNodeGroupTemplate(da.ctx, req.TemplateID, 0)
+	if err != nil {
+		if errors.Is(err, drivers.ErrTableRecordNotFound) {
+			da.setResp(common.BcsErrClusterManagerDatabaseRecordNotFound, err.Error())
+			return
+		}
+		da.setResp(common.BcsErrClusterManagerDBOperation, err.Error())
+		return
+	}
+	if err = da.model.DeleteNodeGroupTemplate(da.ctx, req.TemplateID); err != nil {
+		da.setResp(common.BcsErrClusterManagerDBOperation, err.Error())
+		return
+	}
+
+	err = da.model.CreateOperationLog(da.ctx, &cmproto.OperationLog{
+		ResourceType: common.NodeGroupTemplate.String(),
+		ResourceID:   req.TemplateID,
+		TaskID:       "",
+		Message:      fmt.Sprintf("删除节点池模板[%s]", req.TemplateID),
+		OpUser:       deleted.Updater,
+		CreateTime:   time.Now().String(),
+	})
+	if err != nil {
+		blog.Errorf("DeleteNodeGroupTemplate[%s] CreateOperationLog failed: %v", req.TemplateID, err)
+	}
+
+	da.resp.Data = deleted
+	da.setResp(common.BcsErrClusterManagerSuccess, common.BcsErrClusterManagerSuccessStr)
+	return
+}
+
+// GetNodeGroupTemplateAction action for get nodeGroupTemplate
+type GetNodeGroupTemplateAction struct {
+	ctx   context.Context
+	model store.ClusterManagerModel
+	req   *cmproto.GetNodeGroupTemplateRequest
+	resp  *cmproto.GetNodeGroupTemplateResponse
+}
+
+// NewGetNodeGroupTemplateAction create get action for nodeGroupTemplate
+func NewGetNodeGroupTemplateAction(model store.ClusterManagerModel) *GetNodeGroupTemplateAction {
+	return &GetNodeGroupTemplateAction{
+		model: model,
+	}
+}
+
+func (ga *GetNodeGroupTemplateAction) setResp(code uint32, msg string) {
+	ga.resp.Code = code
+	ga.resp.Message = msg
+	ga.resp.Result = (code == common.BcsErrClusterManagerSuccess)
+}
+
+// Handle handle get nodeGroupTemplate
+func (ga *GetNodeGroupTemplateAction) Handle(
+	ctx context.Context, req *cmproto.GetNodeGroupTemplateRequest, resp *cmproto.GetNodeGroupTemplateResponse) {
+	if req == nil || resp == nil {
+		blog.Errorf("get nodeGroupTemplate failed, req or resp is empty")
+		return
+	}
+	ga.ctx = ctx
+	ga.req = req
+	ga.resp = resp
+
+	if err := req.Validate(); err != nil {
+		ga.setResp(common.BcsErrClusterManagerInvalidParameter, err.Error())
+		return
+	}
+	template, err := ga.model.GetNodeGroupTemplate(ga.ctx, req.TemplateID, req.Version)
+	if err != nil {
+		if errors.Is(err, drivers.ErrTableRecordNotFound) {
+			ga.setResp(common.BcsErrClusterManagerDatabaseRecordNotFound, err.Error())
+			return
+		}
+		ga.setResp(common.BcsErrClusterManagerDBOperation, err.Error())
+		return
+	}
+
+	ga.resp.Data = template
+	ga.setResp(common.BcsErrClusterManagerSuccess, common.BcsErrClusterManagerSuccessStr)
+	return
+}
+
+// ListNodeGroupTemplateAction action for list nodeGroupTemplate
+type ListNodeGroupTemplateAction struct {
+	ctx          context.Context
+	model        store.ClusterManagerModel
+	req          *cmproto.ListNodeGroupTemplateRequest
+	resp         *cmproto.ListNodeGroupTemplateResponse
+	templateList []*cmproto.NodeGroupTemplate
+}
+
+// NewListNodeGroupTemplateAction create list action for nodeGroupTemplate
+func NewListNodeGroupTemplateAction(model store.ClusterManagerModel) *ListNodeGroupTemplateAction {
+	return &ListNodeGroupTemplateAction{
+		model: model,
+	}
+}
+
+func (la *ListNodeGroupTemplateAction) listNodeGroupTemplate() error {
+	condM := make(operator.M)
+	//! we don't setting bson tag in proto file
+	//! all fields are in lowcase
+	if len(la.req.TemplateID) != 0 {
+		condM["templateid"] = la.req.TemplateID
+	}
+	if len(la.req.Name) != 0 {
+		condM["name"] = la.req.Name
+	}
+	if len(la.req.Provider) != 0 {
+		condM["provider"] = la.req.Provider
+	}
+	conds := []*operator.Condition{operator.NewLeafCondition(operator.Eq, condM)}
+	// project templates and templates shared by all projects
+	if len(la.req.ProjectID) != 0 {
+		conds = append(conds, operator.NewLeafCondition(operator.In, operator.M{
+			"projectid": []string{la.req.ProjectID, ""},
+		}))
+	}
+
+	cond := operator.NewBranchCondition(operator.And, conds...)
+	templates, err := la.model.ListNodeGroupTemplate(la.ctx, cond, &storeopt.ListOption{})
+	if err != nil {
+		return err
+	}
+	latest := make(map[string]int)
+	for i := range templates {
+		if la.req.AllVersions {
+			la.templateList = append(la.templateList, &templates[i])
+			continue
+		}
+		idx, ok := latest[templates[i].TemplateID]
+		if !ok {
+			latest[templates[i].TemplateID] = len(la.templateList)
+			la.templateList = append(la.templateList, &templates[i])
+			continue
+		}
+		if templates[i].Version > la.templateList[idx].Version {
+			la.templateList[idx] = &templates[i]
+		}
+	}
+	return nil
+}
+
+func (la *ListNodeGroupTemplateAction) setResp(code uint32, msg string) {
+	la.resp.Code = code
+	la.resp.Message = msg
+	la.resp.Result = (code == common.BcsErrClusterManagerSuccess)
+	la.resp.Data = la.templateList
+}
+
+// Handle handle list nodeGroupTemplate
+func (la *ListNodeGroupTemplateAction) Handle(
+	ctx context.Context, req *cmproto.ListNodeGroupTemplateRequest, resp *cmproto.ListNodeGroupTemplateResponse) {
+	if req == nil || resp == nil {
+		blog.Errorf("list nodeGroupTemplate failed, req or resp is empty")
+		return
+	}
+	la.ctx = ctx
+	la.req = req
+	la.resp = resp
+
+	if err := req.Validate(); err != nil {
+		la.setResp(common.BcsErrClusterManagerInvalidParameter, err.Error())
+		return
+	}
+	if err := la.listNodeGroupTemplate(); err != nil {
+		la.setResp(common.BcsErrClusterManagerDBOperation, err.Error())
+		return
+	}
+	la.setResp(common.BcsErrClusterManagerSuccess, common.BcsErrClusterManagerSuccessStr)
+	return
+}
@@ -0,0 +1,366 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package template
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	cmproto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+)
+
+const (
+	// ParamTypeString string parameter
+	ParamTypeString = "string"
+	// ParamTypeInt int parameter
+	ParamTypeInt = "int"
+	// ParamTypeBool bool parameter
+	ParamTypeBool = "bool"
+)
+
+// template reference fields in create request, they are not part of rendered config
+var templateRefKeys = []string{"templateID", "templateVersion", "templateParams", "templateOverrides"}
+
+// validateParameters check template parameter definitions. base config is rendered with
+// parameter default or sample values into out, so that parameter path & type mismatch with
+// base config is rejected when template saved instead of when template used
+func validateParameters(params []*cmproto.TemplateParameter, base interface{}, out interface{}) error {
+	names := make(map[string]struct{}, len(params))
+	paths := make(map[string]string, len(params))
+	values := make(map[string]interface{}, len(params))
+	for _, p := range params {
+		if p == nil {
+			return fmt.Errorf("template parameter is empty")
+		}
+		if _, ok := names[p.Name]; ok {
+			return fmt.Errorf("template parameter[%s] duplicated", p.Name)
+		}
+		names[p.Name] = struct{}{}
+		if name, ok := paths[p.Path]; ok {
+			return fmt.Errorf("template parameter[%s] path[%s] already used by parameter[%s]", p.Name, p.Path, name)
+		}
+		paths[p.Path] = p.Name
+		if len(p.Pattern) > 0 {
+			if p.Type != ParamTypeString {
+				return fmt.Errorf("template parameter[%s] pattern only support string type", p.Name)
+			}
+			if _, err := regexp.Compile(p.Pattern); err != nil {
+				return fmt.Errorf("template parameter[%s] pattern invalid: %v", p.Name, err)
+			}
+		}
+		sample := p.DefaultValue
+		switch {
+		case len(sample) > 0:
+		case len(p.Options) > 0:
+			sample = p.Options[0]
+		case p.Type == ParamTypeInt && p.Max > p.Min:
+			sample = strconv.FormatInt(p.Min, 10)
+		}
+		for _, option := range p.Options {
+			if _, err := parseParameter(p, option); err != nil {
+				return fmt.Errorf("template parameter[%s] option invalid: %v", p.Name, err)
+			}
+		}
+		value, err := parseParameter(p, sample)
+		if err != nil && len(p.DefaultValue) > 0 {
+			return fmt.Errorf("template parameter[%s] defaultValue invalid: %v", p.Name, err)
+		}
+		if err != nil {
+			// sample for path check only, value restriction not applied
+			value, err = parseType(p, sample)
+			if err != nil {
+				return fmt.Errorf("template parameter[%s] invalid: %v", p.Name, err)
+			}
+		}
+		values[p.Name] = value
+	}
+
+	if err := renderTemplate(base, params, values, nil, nil, out); err != nil {
+		return fmt.Errorf("template parameters not match template config: %v", err)
+	}
+	return nil
+}
+
+// resolveParameters check parameter values by template parameter definitions, return typed values
+// by parameter name. parameter without value and default value keeps base config unchanged
+func resolveParameters(params []*cmproto.TemplateParameter, values map[string]string) (
+	map[string]interface{}, error) {
+	defined := make(map[string]*cmproto.TemplateParameter, len(params))
+	for _, p := range params {
+		defined[p.Name] = p
+	}
+	for name := range values {
+		if _, ok := defined[name]; !ok {
+			return nil, fmt.Errorf("template parameter[%s] not defined", name)
+		}
+	}
+
+	resolved := make(map[string]interface{}, len(params))
+	for _, p := range params {
+		raw, ok := values[p.Name]
+		if !ok {
+			raw = p.DefaultValue
+		}
+		if len(raw) == 0 {
+			if p.Required {
+				return nil, fmt.Errorf("template parameter[%s] is required", p.Name)
+			}
+			continue
+		}
+		value, err := parseParameter(p, raw)
+		if err != nil {
+			return nil, err
+		}
+		resolved[p.Name] = value
+	}
+	return resolved, nil
+}
+
+// parseParameter parse raw value by parameter type & check value restriction
+func parseParameter(p *cmproto.TemplateParameter, raw string) (interface{}, error) {
+	value, err := parseType(p, raw)
+	if err != nil {
+		return nil, err
+	}
+	if len(p.Options) > 0 && !containsString(p.Options, raw) {
+		return nil, fmt.Errorf("template parameter[%s] value[%s] not in options %v", p.Name, raw, p.Options)
+	}
+	switch v := value.(type) {
+	case string:
+		if len(p.Pattern) > 0 {
+			matched, err := regexp.MatchString(p.Pattern, v)
+			if err != nil {
+				return nil, fmt.Errorf("template parameter[%s] pattern invalid: %v", p.Name, err)
+			}
+			if !matched {
+				return nil, fmt.Errorf("template parameter[%s] value[%s] not match pattern[%s]",
+					p.Name, v, p.Pattern)
+			}
+		}
+	case int64:
+		if p.Max > p.Min && (v < p.Min || v > p.Max) {
+			return nil, fmt.Errorf("template parameter[%s] value[%d] out of range[%d, %d]",
+				p.Name, v, p.Min, p.Max)
+		}
+	}
+	return value, nil
+}
+
+// parseType parse raw value by parameter type
+func parseType(p *cmproto.TemplateParameter, raw string) (interface{}, error) {
+	switch p.Type {
+	case ParamTypeString:
+		return raw, nil
+	case ParamTypeInt:
+		if len(raw) == 0 {
+			return int64(0), nil
+		}
+		v, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("template parameter[%s] value[%s] is not int", p.Name, raw)
+		}
+		return v, nil
+	case ParamTypeBool:
+		if len(raw) == 0 {
+			return false, nil
+		}
+		v, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, fmt.Errorf("template parameter[%s] value[%s] is not bool", p.Name, raw)
+		}
+		return v, nil
+	default:
+		return nil, fmt.Errorf("template parameter[%s] type[%s] not supported", p.Name, p.Type)
+	}
+}
+
+// renderTemplate render base config into out: parameter values are set by parameter path,
+// then non-empty fields of override are merged, object merged by key and others replaced.
+// fields listed in overridePaths are replaced by override even if they are empty(false/0/""),
+// these zero values are omitted by json marshal so they can't be merged like other fields
+func renderTemplate(base interface{}, params []*cmproto.TemplateParameter, values map[string]interface{},
+	override interface{}, overridePaths []string, out interface{}) error {
+	doc, err := toJSONObject(base)
+	if err != nil {
+		return err
+	}
+	for _, p := range params {
+		value, ok := values[p.Name]
+		if !ok {
+			continue
+		}
+		if err := setJSONPath(doc, p.Path, value); err != nil {
+			return fmt.Errorf("set parameter[%s] failed: %v", p.Name, err)
+		}
+	}
+	if override != nil {
+		overrideDoc, err := toJSONObject(override)
+		if err != nil {
+			return err
+		}
+		for _, key := range templateRefKeys {
+			delete(overrideDoc, key)
+		}
+		mergeJSONObject(doc, overrideDoc)
+		if err := overrideJSONPaths(doc, overrideDoc, overridePaths); err != nil {
+			return err
+		}
+	}
+
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(out)
+}
+
+// overrideJSONPaths replace doc fields by override fields in paths. field omitted in override
+// is empty in request, it is reset to empty value of the same type in doc
+func overrideJSONPaths(doc, override map[string]interface{}, paths []string) error {
+	for _, path := range paths {
+		if containsString(templateRefKeys, strings.Split(path, ".")[0]) {
+			return fmt.Errorf("override path[%s] is template reference field", path)
+		}
+		value, found, err := getJSONPath(override, path)
+		if err != nil {
+			return fmt.Errorf("override path[%s] failed: %v", path, err)
+		}
+		if !found {
+			current, exist, err := getJSONPath(doc, path)
+			if err != nil {
+				return fmt.Errorf("override path[%s] failed: %v", path, err)
+			}
+			if !exist {
+				// empty in both template and request
+				continue
+			}
+			value = emptyJSONValue(current)
+		}
+		if err := setJSONPath(doc, path, value); err != nil {
+			return fmt.Errorf("override path[%s] failed: %v", path, err)
+		}
+	}
+	return nil
+}
+
+// emptyJSONValue return empty value with the same json type of value
+func emptyJSONValue(value interface{}) interface{} {
+	switch value.(type) {
+	case bool:
+		return false
+	case json.Number:
+		return json.Number("0")
+	case string:
+		return ""
+	default:
+		// object & array
+		return nil
+	}
+}
+
+func toJSONObject(obj interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	doc := make(map[string]interface{})
+	if string(data) == "null" {
+		return doc, nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// getJSONPath get value by dot separated path, found is false when any field in path is missing
+func getJSONPath(doc map[string]interface{}, path string) (interface{}, bool, error) {
+	keys := strings.Split(path, ".")
+	current := doc
+	for i, key := range keys {
+		if len(key) == 0 {
+			return nil, false, fmt.Errorf("path[%s] invalid", path)
+		}
+		next, ok := current[key]
+		if !ok || next == nil {
+			return nil, false, nil
+		}
+		if i == len(keys)-1 {
+			return next, true, nil
+		}
+		child, ok := next.(map[string]interface{})
+		if !ok {
+			return nil, false, fmt.Errorf("path[%s] field[%s] is not object", path, strings.Join(keys[:i+1], "."))
+		}
+		current = child
+	}
+	return nil, false, nil
+}
+
+// setJSONPath set value by dot separated path, missing objects are created
+func setJSONPath(doc map[string]interface{}, path string, value interface{}) error {
+	keys := strings.Split(path, ".")
+	current := doc
+	for i, key := range keys {
+		if len(key) == 0 {
+			return fmt.Errorf("path[%s] invalid", path)
+		}
+		if i == len(keys)-1 {
+			current[key] = value
+			return nil
+		}
+		next, ok := current[key]
+		if !ok || next == nil {
+			child := make(map[string]interface{})
+			current[key] = child
+			current = child
+			continue
+		}
+		child, ok := next.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("path[%s] field[%s] is not object", path, strings.Join(keys[:i+1], "."))
+		}
+		current = child
+	}
+	return nil
+}
+
+func mergeJSONObject(dst, src map[string]interface{}) {
+	for key, value := range src {
+		srcObj, srcOK := value.(map[string]interface{})
+		dstObj, dstOK := dst[key].(map[string]interface{})
+		if srcOK && dstOK {
+			mergeJSONObject(dstObj, srcObj)
+			continue
+		}
+		dst[key] = value
+	}
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
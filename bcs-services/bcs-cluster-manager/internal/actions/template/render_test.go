@@ -0,0 +1,330 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package template
+
+import (
+	"reflect"
+	"testing"
+
+	cmproto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+)
+
+func newTestClusterBase() *cmproto.CreateClusterReq {
+	return &cmproto.CreateClusterReq{
+		ClusterName: "standard",
+		Provider:    "tencentCloud",
+		Region:      "ap-guangzhou",
+		VpcID:       "vpc-1",
+		IsExclusive: true,
+		Labels:      map[string]string{"tier": "base"},
+		NetworkSettings: &cmproto.NetworkSetting{
+			ClusterIPv4CIDR: "10.0.0.0/16",
+			MaxNodePodNum:   64,
+		},
+		ClusterBasicSettings: &cmproto.ClusterBasicSetting{Version: "1.20.6"},
+	}
+}
+
+func newTestParameters() []*cmproto.TemplateParameter {
+	return []*cmproto.TemplateParameter{
+		{Name: "podNum", Type: ParamTypeInt, Path: "networkSettings.maxNodePodNum", Min: 16, Max: 128,
+			DefaultValue: "32"},
+		{Name: "env", Type: ParamTypeString, Path: "environment", Options: []string{"prod", "debug"},
+			Required: true},
+		{Name: "team", Type: ParamTypeString, Path: "labels.team", Pattern: "^[a-z]+$"},
+		{Name: "exclusive", Type: ParamTypeBool, Path: "isExclusive"},
+	}
+}
+
+func TestValidateParameters(t *testing.T) {
+	tests := []struct {
+		name   string
+		params []*cmproto.TemplateParameter
+		hasErr bool
+	}{
+		{
+			name:   "valid parameters",
+			params: newTestParameters(),
+		},
+		{
+			name:   "empty parameter",
+			params: []*cmproto.TemplateParameter{nil},
+			hasErr: true,
+		},
+		{
+			name: "duplicated parameter name",
+			params: []*cmproto.TemplateParameter{
+				{Name: "env", Type: ParamTypeString, Path: "environment"},
+				{Name: "env", Type: ParamTypeString, Path: "description"},
+			},
+			hasErr: true,
+		},
+		{
+			name: "path conflict",
+			params: []*cmproto.TemplateParameter{
+				{Name: "env", Type: ParamTypeString, Path: "environment"},
+				{Name: "stage", Type: ParamTypeString, Path: "environment"},
+			},
+			hasErr: true,
+		},
+		{
+			name: "path not exist in config",
+			params: []*cmproto.TemplateParameter{
+				{Name: "unknown", Type: ParamTypeString, Path: "networkSettings.noSuchField"},
+			},
+			hasErr: true,
+		},
+		{
+			name: "path type mismatch",
+			params: []*cmproto.TemplateParameter{
+				{Name: "podNum", Type: ParamTypeString, Path: "networkSettings.maxNodePodNum"},
+			},
+			hasErr: true,
+		},
+		{
+			name: "path through non object field",
+			params: []*cmproto.TemplateParameter{
+				{Name: "name", Type: ParamTypeString, Path: "clusterName.value"},
+			},
+			hasErr: true,
+		},
+		{
+			name: "pattern on int parameter",
+			params: []*cmproto.TemplateParameter{
+				{Name: "podNum", Type: ParamTypeInt, Path: "networkSettings.maxNodePodNum", Pattern: "^[0-9]+$"},
+			},
+			hasErr: true,
+		},
+		{
+			name: "invalid pattern",
+			params: []*cmproto.TemplateParameter{
+				{Name: "team", Type: ParamTypeString, Path: "labels.team", Pattern: "^[a-z"},
+			},
+			hasErr: true,
+		},
+		{
+			name: "option not match type",
+			params: []*cmproto.TemplateParameter{
+				{Name: "podNum", Type: ParamTypeInt, Path: "networkSettings.maxNodePodNum",
+					Options: []string{"32", "many"}},
+			},
+			hasErr: true,
+		},
+		{
+			name: "default value out of range",
+			params: []*cmproto.TemplateParameter{
+				{Name: "podNum", Type: ParamTypeInt, Path: "networkSettings.maxNodePodNum", Min: 16, Max: 128,
+					DefaultValue: "256"},
+			},
+			hasErr: true,
+		},
+		{
+			name: "unsupported type",
+			params: []*cmproto.TemplateParameter{
+				{Name: "env", Type: "float", Path: "environment"},
+			},
+			hasErr: true,
+		},
+	}
+	for _, test := range tests {
+		err := validateParameters(test.params, newTestClusterBase(), &cmproto.CreateClusterReq{})
+		if (err != nil) != test.hasErr {
+			t.Errorf("%s: expect error %t, but get %v", test.name, test.hasErr, err)
+		}
+	}
+}
+
+func TestResolveParameters(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   map[string]string
+		resolved map[string]interface{}
+		hasErr   bool
+	}{
+		{
+			name:     "default value is used",
+			values:   map[string]string{"env": "prod"},
+			resolved: map[string]interface{}{"env": "prod", "podNum": int64(32)},
+		},
+		{
+			name:   "values of all types",
+			values: map[string]string{"env": "debug", "podNum": "128", "team": "infra", "exclusive": "false"},
+			resolved: map[string]interface{}{"env": "debug", "podNum": int64(128), "team": "infra",
+				"exclusive": false},
+		},
+		{
+			name:   "required parameter lost",
+			values: map[string]string{"podNum": "64"},
+			hasErr: true,
+		},
+		{
+			name:   "parameter not defined",
+			values: map[string]string{"env": "prod", "unknown": "1"},
+			hasErr: true,
+		},
+		{
+			name:   "value not in options",
+			values: map[string]string{"env": "test"},
+			hasErr: true,
+		},
+		{
+			name:   "value not match pattern",
+			values: map[string]string{"env": "prod", "team": "Infra-1"},
+			hasErr: true,
+		},
+		{
+			name:   "value less than min",
+			values: map[string]string{"env": "prod", "podNum": "8"},
+			hasErr: true,
+		},
+		{
+			name:   "value greater than max",
+			values: map[string]string{"env": "prod", "podNum": "256"},
+			hasErr: true,
+		},
+		{
+			name:   "value not int",
+			values: map[string]string{"env": "prod", "podNum": "64a"},
+			hasErr: true,
+		},
+		{
+			name:   "value not bool",
+			values: map[string]string{"env": "prod", "exclusive": "yes"},
+			hasErr: true,
+		},
+	}
+	for _, test := range tests {
+		resolved, err := resolveParameters(newTestParameters(), test.values)
+		if (err != nil) != test.hasErr {
+			t.Errorf("%s: expect error %t, but get %v", test.name, test.hasErr, err)
+			continue
+		}
+		if !test.hasErr && !reflect.DeepEqual(resolved, test.resolved) {
+			t.Errorf("%s: expect resolved %v, but get %v", test.name, test.resolved, resolved)
+		}
+	}
+}
+
+func TestRenderTemplate(t *testing.T) {
+	params := newTestParameters()
+	values := map[string]interface{}{"env": "prod", "podNum": int64(32), "team": "infra"}
+	tests := []struct {
+		name      string
+		override  *cmproto.CreateClusterReq
+		paths     []string
+		hasErr    bool
+		checkFunc func(rendered *cmproto.CreateClusterReq) bool
+	}{
+		{
+			name:     "parameters set by path",
+			override: &cmproto.CreateClusterReq{},
+			checkFunc: func(rendered *cmproto.CreateClusterReq) bool {
+				return rendered.Environment == "prod" && rendered.NetworkSettings.MaxNodePodNum == 32 &&
+					rendered.Labels["team"] == "infra" && rendered.Labels["tier"] == "base" &&
+					rendered.NetworkSettings.ClusterIPv4CIDR == "10.0.0.0/16" && rendered.IsExclusive
+			},
+		},
+		{
+			name: "non-empty request fields override parameters and template",
+			override: &cmproto.CreateClusterReq{
+				ClusterName:     "mine",
+				Environment:     "debug",
+				Labels:          map[string]string{"tier": "mine"},
+				NetworkSettings: &cmproto.NetworkSetting{MaxNodePodNum: 110, ServiceIPv4CIDR: "10.1.0.0/20"},
+			},
+			checkFunc: func(rendered *cmproto.CreateClusterReq) bool {
+				return rendered.ClusterName == "mine" && rendered.Environment == "debug" &&
+					rendered.Labels["tier"] == "mine" && rendered.Labels["team"] == "infra" &&
+					rendered.NetworkSettings.MaxNodePodNum == 110 &&
+					rendered.NetworkSettings.ServiceIPv4CIDR == "10.1.0.0/20" &&
+					rendered.NetworkSettings.ClusterIPv4CIDR == "10.0.0.0/16"
+			},
+		},
+		{
+			name:     "empty request fields keep template config",
+			override: &cmproto.CreateClusterReq{IsExclusive: false, VpcID: ""},
+			checkFunc: func(rendered *cmproto.CreateClusterReq) bool {
+				return rendered.IsExclusive && rendered.VpcID == "vpc-1"
+			},
+		},
+		{
+			name:     "override paths set empty values",
+			override: &cmproto.CreateClusterReq{},
+			paths:    []string{"isExclusive", "vpcID", "networkSettings.maxNodePodNum", "labels"},
+			checkFunc: func(rendered *cmproto.CreateClusterReq) bool {
+				return !rendered.IsExclusive && rendered.VpcID == "" &&
+					rendered.NetworkSettings.MaxNodePodNum == 0 && len(rendered.Labels) == 0 &&
+					rendered.NetworkSettings.ClusterIPv4CIDR == "10.0.0.0/16"
+			},
+		},
+		{
+			name:     "override path replaces object instead of merging",
+			override: &cmproto.CreateClusterReq{Labels: map[string]string{"owner": "me"}},
+			paths:    []string{"labels"},
+			checkFunc: func(rendered *cmproto.CreateClusterReq) bool {
+				return reflect.DeepEqual(rendered.Labels, map[string]string{"owner": "me"})
+			},
+		},
+		{
+			name:     "override path empty in both template and request",
+			override: &cmproto.CreateClusterReq{},
+			paths:    []string{"description", "networkSettings.serviceIPv4CIDR"},
+			checkFunc: func(rendered *cmproto.CreateClusterReq) bool {
+				return rendered.Description == "" && rendered.NetworkSettings.ServiceIPv4CIDR == ""
+			},
+		},
+		{
+			name: "template reference fields are not rendered",
+			override: &cmproto.CreateClusterReq{TemplateID: "BCS-CT-test", TemplateVersion: 2,
+				TemplateParams: map[string]string{"env": "prod"}, TemplateOverrides: []string{"isExclusive"}},
+			checkFunc: func(rendered *cmproto.CreateClusterReq) bool {
+				return rendered.TemplateID == "" && rendered.TemplateVersion == 0 &&
+					len(rendered.TemplateParams) == 0 && len(rendered.TemplateOverrides) == 0
+			},
+		},
+		{
+			name:     "override path of template reference field",
+			override: &cmproto.CreateClusterReq{},
+			paths:    []string{"templateID"},
+			hasErr:   true,
+		},
+		{
+			name:     "override path through non object field",
+			override: &cmproto.CreateClusterReq{},
+			paths:    []string{"clusterName.value"},
+			hasErr:   true,
+		},
+		{
+			name:     "invalid override path",
+			override: &cmproto.CreateClusterReq{},
+			paths:    []string{"networkSettings..maxNodePodNum"},
+			hasErr:   true,
+		},
+	}
+	for _, test := range tests {
+		base := newTestClusterBase()
+		rendered := &cmproto.CreateClusterReq{}
+		err := renderTemplate(base, params, values, test.override, test.paths, rendered)
+		if (err != nil) != test.hasErr {
+			t.Errorf("%s: expect error %t, but get %v", test.name, test.hasErr, err)
+			continue
+		}
+		if !reflect.DeepEqual(base, newTestClusterBase()) {
+			t.Errorf("%s: template config is modified when rendering", test.name)
+		}
+		if !test.hasErr && !test.checkFunc(rendered) {
+			t.Errorf("%s: unexpected rendered request %s", test.name, rendered.String())
+		}
+	}
+}
@@ -0,0 +1,218 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package template
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	cmproto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/actions"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/utils"
+)
+
+const (
+	clusterTemplatePrefix   = "BCS-CT"
+	nodeGroupTemplatePrefix = "BCS-NT"
+)
+
+// generate random templateID
+func generateTemplateID(prefix string) string {
+	return fmt.Sprintf("%s-%s", prefix, utils.RandomString(8))
+}
+
+// RenderCreateClusterReq render createCluster request by referenced cluster template: template
+// parameters are filled by request templateParams, then non-empty request fields and fields listed in
+// request templateOverrides override template config
+func RenderCreateClusterReq(ctx context.Context, model store.ClusterManagerModel,
+	req *cmproto.CreateClusterReq) (*cmproto.CreateClusterReq, error) {
+	template, err := model.GetClusterTemplate(ctx, req.TemplateID, req.TemplateVersion)
+	if err != nil {
+		return nil, fmt.Errorf("get clusterTemplate[%s] version[%d] failed: %v",
+			req.TemplateID, req.TemplateVersion, err)
+	}
+	if len(template.ProjectID) > 0 && template.ProjectID != req.ProjectID {
+		return nil, fmt.Errorf("clusterTemplate[%s] not belong to project[%s]", req.TemplateID, req.ProjectID)
+	}
+	values, err := resolveParameters(template.Parameters, req.TemplateParams)
+	if err != nil {
+		return nil, err
+	}
+
+	rendered := &cmproto.CreateClusterReq{}
+	if err := renderTemplate(template.Cluster, template.Parameters, values, req, req.TemplateOverrides,
+		rendered); err != nil {
+		return nil, fmt.Errorf("render clusterTemplate[%s] version[%d] failed: %v",
+			template.TemplateID, template.Version, err)
+	}
+	if len(rendered.Provider) == 0 {
+		rendered.Provider = template.Provider
+	}
+	if rendered.Provider != template.Provider {
+		return nil, fmt.Errorf("provider[%s] differ from clusterTemplate[%s] provider[%s]",
+			rendered.Provider, template.TemplateID, template.Provider)
+	}
+	rendered.TemplateID = template.TemplateID
+	rendered.TemplateVersion = template.Version
+	rendered.TemplateParams = req.TemplateParams
+	rendered.TemplateOverrides = req.TemplateOverrides
+
+	blog.Infof("render createCluster request by clusterTemplate[%s] version[%d] successfully",
+		template.TemplateID, template.Version)
+	return rendered, nil
+}
+
+// RenderCreateNodeGroupReq render createNodeGroup request by referenced nodeGroup template: template
+// parameters are filled by request templateParams, then non-empty request fields and fields listed in
+// request templateOverrides override template config
+func RenderCreateNodeGroupReq(ctx context.Context, model store.ClusterManagerModel,
+	req *cmproto.CreateNodeGroupRequest) (*cmproto.CreateNodeGroupRequest, error) {
+	template, err := model.GetNodeGroupTemplate(ctx, req.TemplateID, req.TemplateVersion)
+	if err != nil {
+		return nil, fmt.Errorf("get nodeGroupTemplate[%s] version[%d] failed: %v",
+			req.TemplateID, req.TemplateVersion, err)
+	}
+	if len(template.ProjectID) > 0 {
+		cluster, err := model.GetCluster(ctx, req.ClusterID)
+		if err != nil {
+			return nil, fmt.Errorf("get relative cluster %s info err, %s", req.ClusterID, err.Error())
+		}
+		if cluster.ProjectID != template.ProjectID {
+			return nil, fmt.Errorf("nodeGroupTemplate[%s] not belong to project[%s]",
+				req.TemplateID, cluster.ProjectID)
+		}
+	}
+	values, err := resolveParameters(template.Parameters, req.TemplateParams)
+	if err != nil {
+		return nil, err
+	}
+
+	rendered := &cmproto.CreateNodeGroupRequest{}
+	if err := renderTemplate(template.NodeGroup, template.Parameters, values, req, req.TemplateOverrides,
+		rendered); err != nil {
+		return nil, fmt.Errorf("render nodeGroupTemplate[%s] version[%d] failed: %v",
+			template.TemplateID, template.Version, err)
+	}
+	if len(rendered.Provider) == 0 {
+		rendered.Provider = template.Provider
+	}
+	if rendered.Provider != template.Provider {
+		return nil, fmt.Errorf("provider[%s] differ from nodeGroupTemplate[%s] provider[%s]",
+			rendered.Provider, template.TemplateID, template.Provider)
+	}
+	rendered.TemplateID = template.TemplateID
+	rendered.TemplateVersion = template.Version
+	rendered.TemplateParams = req.TemplateParams
+	rendered.TemplateOverrides = req.TemplateOverrides
+
+	blog.Infof("render createNodeGroup request by nodeGroupTemplate[%s] version[%d] successfully",
+		template.TemplateID, template.Version)
+	return rendered, nil
+}
+
+// CloudValidateCreateClusterReq validate createCluster request by cloud CloudValidateManager
+func CloudValidateCreateClusterReq(cloud *cmproto.Cloud, req *cmproto.CreateClusterReq) error {
+	validate, opt, err := getCloudValidateMgr(cloud, req.CloudAccountID, req.Region)
+	if err != nil {
+		return err
+	}
+	err = validate.CreateClusterValidate(req, opt)
+	if errors.Is(err, cloudprovider.ErrCloudNotImplemented) {
+		return nil
+	}
+	return err
+}
+
+// CloudValidateCreateNodeGroupReq validate createNodeGroup request by cloud CloudValidateManager
+func CloudValidateCreateNodeGroupReq(cloud *cmproto.Cloud, cluster *cmproto.Cluster,
+	req *cmproto.CreateNodeGroupRequest) error {
+	accountID, region := "", req.Region
+	if cluster != nil {
+		accountID = cluster.CloudAccountID
+		if len(region) == 0 {
+			region = cluster.Region
+		}
+	}
+	validate, opt, err := getCloudValidateMgr(cloud, accountID, region)
+	if err != nil {
+		return err
+	}
+	err = validate.CreateNodeGroupValidate(req, opt)
+	if errors.Is(err, cloudprovider.ErrCloudNotImplemented) {
+		return nil
+	}
+	return err
+}
+
+// getCloudValidateMgr get cloud validate manager & credential option, cloud resource check is skipped
+// by validate manager when credential is unavailable
+func getCloudValidateMgr(cloud *cmproto.Cloud, accountID, region string) (
+	cloudprovider.CloudValidateManager, *cloudprovider.CommonOption, error) {
+	validate, err := cloudprovider.GetCloudValidateMgr(cloud.CloudProvider)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get cloud %s validate manager failed: %v", cloud.CloudProvider, err)
+	}
+	opt, err := cloudprovider.GetCredential(&cloudprovider.CredentialData{
+		Cloud:     cloud,
+		AccountID: accountID,
+	})
+	if err != nil {
+		blog.Warnf("get credential for cloud %s/%s failed, cloud resource check skipped: %v",
+			cloud.CloudID, cloud.CloudProvider, err)
+		return validate, nil, nil
+	}
+	opt.Region = region
+
+	return validate, opt, nil
+}
+
+// validateClusterTemplate check cluster template parameters and config rendered with default values
+func validateClusterTemplate(template *cmproto.ClusterTemplate, model store.ClusterManagerModel) error {
+	rendered := &cmproto.CreateClusterReq{}
+	if err := validateParameters(template.Parameters, template.Cluster, rendered); err != nil {
+		return err
+	}
+	if len(rendered.Provider) > 0 && rendered.Provider != template.Provider {
+		return fmt.Errorf("cluster provider[%s] differ from template provider[%s]",
+			rendered.Provider, template.Provider)
+	}
+	cloud, err := actions.GetCloudByCloudID(model, template.Provider)
+	if err != nil {
+		return err
+	}
+
+	return CloudValidateCreateClusterReq(cloud, rendered)
+}
+
+// validateNodeGroupTemplate check nodeGroup template parameters and config rendered with default values
+func validateNodeGroupTemplate(template *cmproto.NodeGroupTemplate, model store.ClusterManagerModel) error {
+	rendered := &cmproto.CreateNodeGroupRequest{}
+	if err := validateParameters(template.Parameters, template.NodeGroup, rendered); err != nil {
+		return err
+	}
+	if len(rendered.Provider) > 0 && rendered.Provider != template.Provider {
+		return fmt.Errorf("nodeGroup provider[%s] differ from template provider[%s]",
+			rendered.Provider, template.Provider)
+	}
+	cloud, err := actions.GetCloudByCloudID(model, template.Provider)
+	if err != nil {
+		return err
+	}
+
+	return CloudValidateCreateNodeGroupReq(cloud, nil, rendered)
+}
@@ -44,6 +44,7 @@ import (
 	clusterops "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/clusterops"
 	cmcommon "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/common"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/discovery"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/drift"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/handler"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/lock"
 	etcdlock "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/lock/etcd"
@@ -448,6 +449,21 @@ func (cm *ClusterManager) updateCloudConfig(cloud *cmproto.Cloud) error {
 	return nil
 }
 
+// init drift reconciler for detecting drifts between store and cloud
+func (cm *ClusterManager) initDriftReconciler() {
+	if !cm.opt.Drift.Enable {
+		return
+	}
+	reconciler := drift.NewReconciler(&drift.Options{
+		Model:       cm.model,
+		Locker:      cm.locker,
+		Interval:    time.Duration(cm.opt.Drift.Interval) * time.Second,
+		AutoCorrect: cm.opt.Drift.AutoCorrect,
+	})
+	go reconciler.Run(cm.ctx)
+	blog.Infof("init drift reconciler successfully")
+}
+
 // init k8s operator
 func (cm *ClusterManager) initK8SOperator() {
 	cm.k8sops = clusterops.NewK8SOperator(cm.opt, cm.model)
@@ -797,6 +813,8 @@ func (cm *ClusterManager) Init() error {
 	if err != nil {
 		blog.Errorf("initCloudTemplateConfig failed: %v", err)
 	}
+	// init drift reconciler
+	cm.initDriftReconciler()
 
 	// init metric, pprof
 	cm.initExtraModules()
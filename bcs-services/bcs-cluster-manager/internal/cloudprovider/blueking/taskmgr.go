@@ -71,6 +71,14 @@ func (t *Task) GetAllTask() map[string]interface{} {
 	return t.works
 }
 
+var (
+	// bksopsStepOption bk-sops task waits for result at most 30 minutes, it's not retried
+	// because a new bk-sops task is created when retrying
+	bksopsStepOption = cloudprovider.StepOption{Timeout: 2100}
+	// updateDBStepOption steps updating DB info
+	updateDBStepOption = cloudprovider.StepOption{Timeout: 300, MaxRetry: 2, RetryInterval: 10}
+)
+
 // BuildCreateClusterTask build create cluster task
 func (t *Task) BuildCreateClusterTask(cls *proto.Cluster, opt *cloudprovider.CreateClusterOption) (*proto.Task, error) {
 	// create cluster currently only has three steps:
@@ -108,7 +116,8 @@ func (t *Task) BuildCreateClusterTask(cls *proto.Cluster, opt *cloudprovider.Cre
 	taskName := fmt.Sprintf(createClusterTaskTemplate, cls.ClusterID)
 	task.CommonParams["taskName"] = taskName
 
-	// step1: call bkops preAction operation
+	// step1: call bkops preAction operation one by one
+	lastSteps := make([]string, 0)
 	if opt.Cloud != nil && opt.Cloud.ClusterManagement != nil && opt.Cloud.ClusterManagement.CreateCluster != nil {
 		action := opt.Cloud.ClusterManagement.CreateCluster
 
@@ -128,8 +137,10 @@ func (t *Task) BuildCreateClusterTask(cls *proto.Cluster, opt *cloudprovider.Cre
 				if err != nil {
 					return nil, fmt.Errorf("BuildCreateClusterTask task failed: %v", err)
 				}
+				cloudprovider.SetStepOption(step, bksopsStepOption.WithDependsOn(lastSteps...))
 				task.Steps[stepName] = step
 				task.StepSequence = append(task.StepSequence, stepName)
+				lastSteps = []string{stepName}
 			}
 		}
 	}
@@ -146,6 +157,7 @@ func (t *Task) BuildCreateClusterTask(cls *proto.Cluster, opt *cloudprovider.Cre
 	}
 	updateStep.Params["ClusterID"] = cls.ClusterID
 	updateStep.Params["CloudID"] = cls.Provider
+	cloudprovider.SetStepOption(updateStep, updateDBStepOption.WithDependsOn(lastSteps...))
 
 	task.Steps[updateCreateClusterDBInfoTask] = updateStep
 	task.StepSequence = append(task.StepSequence, updateCreateClusterDBInfoTask)
@@ -375,6 +387,7 @@ func (t *Task) BuildAddNodesToClusterTask(cls *proto.Cluster, nodes []*proto.Nod
 	}
 
 	// attention: bksops only need to generate one task
+	lastSteps := make([]string, 0)
 	for i := range action.PreActions {
 		plugin, ok := action.Plugins[action.PreActions[i]]
 		if ok {
@@ -388,8 +401,10 @@ func (t *Task) BuildAddNodesToClusterTask(cls *proto.Cluster, nodes []*proto.Nod
 			if err != nil {
 				return nil, fmt.Errorf("BuildAddNodesToClusterTask task failed: %v", err)
 			}
+			cloudprovider.SetStepOption(step, bksopsStepOption.WithDependsOn(lastSteps...))
 			task.Steps[stepName] = step
 			task.StepSequence = append(task.StepSequence, stepName)
+			lastSteps = []string{stepName}
 		}
 	}
 
@@ -406,6 +421,7 @@ func (t *Task) BuildAddNodesToClusterTask(cls *proto.Cluster, nodes []*proto.Nod
 	updateStep.Params["ClusterID"] = cls.ClusterID
 	updateStep.Params["CloudID"] = opt.Cloud.CloudID
 	updateStep.Params["NodeIPs"] = strings.Join(nodeIPs, ",")
+	cloudprovider.SetStepOption(updateStep, updateDBStepOption.WithDependsOn(lastSteps...))
 
 	task.Steps[updateAddNodeDBInfoTask] = updateStep
 	task.StepSequence = append(task.StepSequence, updateAddNodeDBInfoTask)
@@ -470,6 +486,11 @@ func (t *Task) BuildRemoveNodesFromClusterTask(cls *proto.Cluster, nodes []*prot
 	taskName := fmt.Sprintf(deleteClusterNodesTaskTemplate, cls.ClusterID)
 	task.CommonParams["taskName"] = taskName
 
+	// cordon nodes and drain pods gracefully before removing
+	if err := cloudprovider.BuildDrainNodesStep(task, cls.ClusterID, nodeIPs, opt.Drain); err != nil {
+		return nil, fmt.Errorf("BuildRemoveNodesFromClusterTask task failed: %v", err)
+	}
+
 	// step1: build bkops task
 	// validate bkops config
 	if opt.Cloud != nil && opt.Cloud.ClusterManagement != nil && opt.Cloud.ClusterManagement.DeleteNodesFromCluster != nil {
@@ -576,3 +597,223 @@ func (t *Task) BuildUpdateDesiredNodesTask(desired uint32, group *proto.NodeGrou
 func (t *Task) BuildSwitchNodeGroupAutoScalingTask(group *proto.NodeGroup, enable bool, opt *cloudprovider.SwitchNodeGroupAutoScalingOption) (*proto.Task, error) {
 	return nil, cloudprovider.ErrCloudNotImplemented
 }
+
+// BuildUpgradeNodeGroupTask rolling upgrade nodegroup nodes to cluster version
+func (t *Task) BuildUpgradeNodeGroupTask(group *proto.NodeGroup, opt *cloudprovider.UpgradeNodeGroupOption) (*proto.Task, error) {
+	// upgradeNodeGroup rolling upgrade nodes by batch, every batch has three steps:
+	// 1. cordon batch nodes and evict pods
+	// 2. call bkops upgradeNodes actions to upgrade batch nodes
+	// 3. check batch nodes ready with cluster version, then uncordon nodes
+
+	// validate request params
+	if group == nil {
+		return nil, fmt.Errorf("BuildUpgradeNodeGroupTask group info empty")
+	}
+	if opt == nil || opt.Cloud == nil || opt.Cluster == nil || opt.Operator == "" {
+		return nil, fmt.Errorf("BuildUpgradeNodeGroupTask TaskOptions is lost")
+	}
+	version := opt.Cluster.GetClusterBasicSettings().GetVersion()
+	if version == "" {
+		return nil, fmt.Errorf("BuildUpgradeNodeGroupTask cluster %s version is empty", opt.Cluster.ClusterID)
+	}
+	// validate bkops config
+	if opt.Cloud.ClusterManagement == nil || opt.Cloud.ClusterManagement.UpgradeNodes == nil ||
+		len(opt.Cloud.ClusterManagement.UpgradeNodes.PreActions) == 0 {
+		errMsg := fmt.Sprintf("cloud clusterManagement upgradeNodes preActions empty")
+		return nil, fmt.Errorf("%s BuildUpgradeNodeGroupTask failed: %v", cloudName, errMsg)
+	}
+
+	// init task information
+	nowStr := time.Now().Format(time.RFC3339)
+	task := &proto.Task{
+		TaskID:         uuid.New().String(),
+		TaskType:       cloudprovider.GetTaskType(cloudName, cloudprovider.UpgradeNodeGroup),
+		TaskName:       cloudprovider.UpgradeNodeGroupTask.String(),
+		Status:         cloudprovider.TaskStatusInit,
+		Message:        "task initializing",
+		Start:          nowStr,
+		Steps:          make(map[string]*proto.Step),
+		StepSequence:   make([]string, 0),
+		ClusterID:      group.ClusterID,
+		ProjectID:      group.ProjectID,
+		Creator:        opt.Operator,
+		Updater:        opt.Operator,
+		LastUpdate:     nowStr,
+		CommonParams:   make(map[string]string),
+		ForceTerminate: false,
+		NodeGroupID:    group.NodeGroupID,
+	}
+	taskName := fmt.Sprintf(upgradeNodeGroupTaskTemplate, group.ClusterID, group.Name)
+	task.CommonParams["taskName"] = taskName
+
+	err := cloudprovider.BuildRollingUpgradeSteps(task, cloudprovider.RollingUpgradeInfo{
+		ClusterID:      group.ClusterID,
+		CloudID:        opt.Cloud.CloudID,
+		NodeGroupID:    group.NodeGroupID,
+		Version:        version,
+		MaxUnavailable: opt.MaxUnavailable,
+		Nodes:          opt.Nodes,
+	}, buildUpgradeNodesBKopsStep(taskName, opt.Cluster, opt.Cloud.ClusterManagement.UpgradeNodes, opt.Operator))
+	if err != nil {
+		return nil, fmt.Errorf("BuildUpgradeNodeGroupTask failed: %v", err)
+	}
+
+	// set current step
+	if len(task.StepSequence) == 0 {
+		return nil, fmt.Errorf("BuildUpgradeNodeGroupTask task StepSequence empty")
+	}
+	task.CurrentStep = task.StepSequence[0]
+	task.CommonParams["operator"] = opt.Operator
+	task.CommonParams["user"] = opt.Operator
+
+	task.CommonParams[cloudprovider.UpgradeVersionKey.String()] = version
+	// must set job-type
+	task.CommonParams[cloudprovider.JobTypeKey.String()] = cloudprovider.UpgradeNodeGroupJob.String()
+
+	return task, nil
+}
+
+// BuildUpgradeClusterTask build upgrade cluster task
+func (t *Task) BuildUpgradeClusterTask(cls *proto.Cluster, opt *cloudprovider.UpgradeClusterOption) (*proto.Task, error) {
+	// upgradeCluster steps:
+	// 1. check upgrade version, deprecated apis and PodDisruptionBudgets in cluster
+	// 2. call bkops upgradeCluster actions to upgrade control plane
+	// 3. update cluster version in DB
+	// 4. rolling upgrade nodegroups nodes by batch, call bkops upgradeNodes actions for every batch
+
+	// validate request params
+	if cls == nil {
+		return nil, fmt.Errorf("BuildUpgradeClusterTask cluster info empty")
+	}
+	if opt == nil || opt.Cloud == nil || opt.Operator == "" || opt.Version == "" {
+		return nil, fmt.Errorf("BuildUpgradeClusterTask TaskOptions is lost")
+	}
+	// validate bkops config
+	if opt.Cloud.ClusterManagement == nil || opt.Cloud.ClusterManagement.UpgradeCluster == nil {
+		errMsg := fmt.Sprintf("cloud clusterManagement or upgradeCluster config empty")
+		return nil, fmt.Errorf("%s BuildUpgradeClusterTask failed: %v", cloudName, errMsg)
+	}
+	upgradeAction := opt.Cloud.ClusterManagement.UpgradeCluster
+	if len(upgradeAction.PreActions) == 0 {
+		errMsg := fmt.Sprintf("cloud clusterManagement upgradeCluster preActions empty")
+		return nil, fmt.Errorf("%s BuildUpgradeClusterTask failed: %v", cloudName, errMsg)
+	}
+	nodesAction := opt.Cloud.ClusterManagement.UpgradeNodes
+	if len(opt.NodeGroups) > 0 && (nodesAction == nil || len(nodesAction.PreActions) == 0) {
+		errMsg := fmt.Sprintf("cloud clusterManagement upgradeNodes preActions empty")
+		return nil, fmt.Errorf("%s BuildUpgradeClusterTask failed: %v", cloudName, errMsg)
+	}
+
+	// init task information
+	nowStr := time.Now().Format(time.RFC3339)
+	task := &proto.Task{
+		TaskID:         uuid.New().String(),
+		TaskType:       cloudprovider.GetTaskType(cloudName, cloudprovider.UpgradeCluster),
+		TaskName:       cloudprovider.UpgradeClusterTask.String(),
+		Status:         cloudprovider.TaskStatusInit,
+		Message:        "task initializing",
+		Start:          nowStr,
+		Steps:          make(map[string]*proto.Step),
+		StepSequence:   make([]string, 0),
+		ClusterID:      cls.ClusterID,
+		ProjectID:      cls.ProjectID,
+		Creator:        opt.Operator,
+		Updater:        opt.Operator,
+		LastUpdate:     nowStr,
+		CommonParams:   make(map[string]string),
+		ForceTerminate: false,
+	}
+	taskName := fmt.Sprintf(upgradeClusterTaskTemplate, cls.ClusterID)
+	task.CommonParams["taskName"] = taskName
+
+	// step1: upgrade pre-check
+	preCheckStep := cloudprovider.NewCommonStep(cloudprovider.UpgradePreCheckTask, cloudprovider.UpgradePreCheckTask,
+		cloudprovider.UpgradePreCheckStep)
+	preCheckStep.Params[cloudprovider.ClusterIDKey.String()] = cls.ClusterID
+	preCheckStep.Params[cloudprovider.UpgradeVersionKey.String()] = opt.Version
+
+	task.Steps[preCheckStep.Name] = preCheckStep
+	task.StepSequence = append(task.StepSequence, preCheckStep.Name)
+
+	// step2: call bkops operation to upgrade control plane
+	for i := range upgradeAction.PreActions {
+		plugin, ok := upgradeAction.Plugins[upgradeAction.PreActions[i]]
+		if ok {
+			stepName := cloudprovider.BKSOPTask + "-" + upgradeAction.PreActions[i]
+			step, err := template.GenerateBKopsStep(taskName, stepName, cls, plugin, template.ExtraInfo{
+				NodeOperator: opt.Operator,
+				BusinessID:   cls.BusinessID,
+				Operator:     opt.Operator,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("BuildUpgradeClusterTask task failed: %v", err)
+			}
+			task.Steps[stepName] = step
+			task.StepSequence = append(task.StepSequence, stepName)
+		}
+	}
+
+	// step3: update cluster version in DB
+	dbStep := cloudprovider.NewCommonStep(cloudprovider.UpdateClusterVersionDBTask,
+		cloudprovider.UpdateClusterVersionDBTask, cloudprovider.UpdateClusterVersionDBStep)
+	dbStep.Params[cloudprovider.ClusterIDKey.String()] = cls.ClusterID
+	dbStep.Params[cloudprovider.UpgradeVersionKey.String()] = opt.Version
+
+	task.Steps[dbStep.Name] = dbStep
+	task.StepSequence = append(task.StepSequence, dbStep.Name)
+
+	// step4: rolling upgrade nodegroups
+	for _, group := range opt.NodeGroups {
+		err := cloudprovider.BuildRollingUpgradeSteps(task, cloudprovider.RollingUpgradeInfo{
+			ClusterID:      cls.ClusterID,
+			CloudID:        opt.Cloud.CloudID,
+			NodeGroupID:    group.NodeGroupID,
+			Version:        opt.Version,
+			MaxUnavailable: opt.MaxUnavailable,
+			Nodes:          opt.GroupNodes[group.NodeGroupID],
+		}, buildUpgradeNodesBKopsStep(taskName, cls, nodesAction, opt.Operator))
+		if err != nil {
+			return nil, fmt.Errorf("BuildUpgradeClusterTask failed: %v", err)
+		}
+	}
+
+	// set current step
+	task.CurrentStep = task.StepSequence[0]
+	task.CommonParams["operator"] = opt.Operator
+	task.CommonParams["user"] = opt.Operator
+
+	task.CommonParams[cloudprovider.UpgradeVersionKey.String()] = opt.Version
+	task.CommonParams[cloudprovider.OriginVersionKey.String()] = cls.GetClusterBasicSettings().GetVersion()
+	// must set job-type
+	task.CommonParams[cloudprovider.JobTypeKey.String()] = cloudprovider.UpgradeClusterJob.String()
+
+	return task, nil
+}
+
+// buildUpgradeNodesBKopsStep build bkops step which upgrades batch nodes, bkops only need
+// to generate one step for every batch
+func buildUpgradeNodesBKopsStep(taskName string, cls *proto.Cluster, action *proto.Action,
+	operator string) cloudprovider.UpgradeNodesStepFunc {
+	return func(batchID string, nodes []*proto.Node) (*proto.Step, error) {
+		nodeIPs := make([]string, 0)
+		for i := range nodes {
+			nodeIPs = append(nodeIPs, nodes[i].InnerIP)
+		}
+
+		for i := range action.PreActions {
+			plugin, ok := action.Plugins[action.PreActions[i]]
+			if !ok {
+				continue
+			}
+			stepName := cloudprovider.BKSOPTask + "-" + action.PreActions[i] + "-" + batchID
+			return template.GenerateBKopsStep(taskName, stepName, cls, plugin, template.ExtraInfo{
+				NodeIPList:   strings.Join(nodeIPs, ","),
+				NodeOperator: operator,
+				BusinessID:   cls.BusinessID,
+				Operator:     operator,
+			})
+		}
+
+		return nil, fmt.Errorf("upgradeNodes plugin not found")
+	}
+}
@@ -30,6 +30,10 @@ const (
 	deleteClusterTaskTemplate = "blueking-delete cluster: %s"
 	// createClusterTaskTemplate bk-sops delete cluster task template
 	createClusterTaskTemplate = "blueking-create cluster: %s"
+	// upgradeClusterTaskTemplate bk-sops upgrade cluster task template
+	upgradeClusterTaskTemplate = "blueking-upgrade cluster: %s"
+	// upgradeNodeGroupTaskTemplate bk-sops upgrade nodegroup task template
+	upgradeNodeGroupTaskTemplate = "blueking-upgrade node group: %s/%s"
 )
 
 var (
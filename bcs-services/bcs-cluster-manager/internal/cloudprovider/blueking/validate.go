@@ -16,6 +16,7 @@ package blueking
 import (
 	"encoding/base64"
 	"fmt"
+	"net"
 	"sync"
 
 	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
@@ -115,3 +116,24 @@ func (c *CloudValidate) ListInstanceTypeValidate(req *proto.ListCloudInstanceTyp
 func (c *CloudValidate) ListCloudOsImageValidate(req *proto.ListCloudOsImageRequest, account *proto.Account) error {
 	return nil
 }
+
+// CreateClusterValidate check createCluster operation, blueking cluster is created by master & node IPs
+func (c *CloudValidate) CreateClusterValidate(req *proto.CreateClusterReq, opt *cloudprovider.CommonOption) error {
+	if c == nil || req == nil {
+		return fmt.Errorf("%s CreateClusterValidate request is empty", cloudName)
+	}
+
+	for _, ip := range append(append([]string{}, req.Master...), req.Nodes...) {
+		if net.ParseIP(ip) == nil {
+			return fmt.Errorf("%s CreateClusterValidate invalid IP[%s]", cloudName, ip)
+		}
+	}
+
+	return nil
+}
+
+// CreateNodeGroupValidate check createNodeGroup operation
+func (c *CloudValidate) CreateNodeGroupValidate(req *proto.CreateNodeGroupRequest,
+	opt *cloudprovider.CommonOption) error {
+	return cloudprovider.ErrCloudNotImplemented
+}
@@ -0,0 +1,117 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/clusterops"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+)
+
+// DrainNodesTask cordon nodes and drain nodes gracefully, progress of pods is appended to
+// step logs. nodes keep cordoned when draining failed
+func DrainNodesTask(taskID string, stepName string) error {
+	start := time.Now()
+
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		return nil
+	}
+
+	// extract parameter && check validate
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	nodeIPs := step.Params[cloudprovider.NodeIPsKey.String()]
+	timeout, errTimeout := strconv.ParseUint(step.Params[cloudprovider.DrainTimeoutKey.String()], 10, 32)
+	force, errForce := strconv.ParseBool(step.Params[cloudprovider.DrainForceKey.String()])
+	grace, errGrace := strconv.ParseInt(step.Params[cloudprovider.DrainGracePeriodKey.String()], 10, 64)
+	if len(clusterID) == 0 || len(nodeIPs) == 0 || errTimeout != nil || errForce != nil || errGrace != nil {
+		blog.Errorf("DrainNodesTask[%s]: check parameter validate failed", taskID)
+		retErr := fmt.Errorf("DrainNodesTask check parameters failed")
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	ctx := cloudprovider.WithTaskIDForContext(context.Background(), taskID)
+	err = cordonDrainNodes(ctx, getK8SOperator(), clusterID, strings.Split(nodeIPs, ","), clusterops.DrainNodeInfo{
+		Timeout:            time.Duration(timeout) * time.Second,
+		Force:              force,
+		GracePeriodSeconds: grace,
+		Progress:           appendStepLogFunc(state, stepName),
+	})
+	if err != nil {
+		blog.Errorf("DrainNodesTask[%s]: cluster %s drain nodes %s failed: %v", taskID, clusterID, nodeIPs, err)
+		retErr := fmt.Errorf("DrainNodesTask failed: %v", err)
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	// update step
+	if err := state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("DrainNodesTask[%s] task %s %s update to storage fatal", taskID, taskID, stepName)
+		return err
+	}
+	return nil
+}
+
+// cordonDrainNodes cordon all nodes first, then drain nodes one by one. ClusterID & NodeName
+// of drain info are set by nodes, nodes not in cluster are skipped
+func cordonDrainNodes(ctx context.Context, k8sOperator *clusterops.K8SOperator, clusterID string,
+	nodeIPs []string, info clusterops.DrainNodeInfo) error {
+	nodes := make([]string, 0)
+	for _, ip := range nodeIPs {
+		err := k8sOperator.ClusterUpdateScheduleNode(ctx, clusterops.NodeInfo{
+			ClusterID: clusterID,
+			NodeIP:    ip,
+			Desired:   true,
+		})
+		if errors.IsNotFound(err) {
+			if info.Progress != nil {
+				info.Progress(fmt.Sprintf("node %s not found in cluster, skip draining", ip))
+			}
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("cordon node %s failed: %v", ip, err)
+		}
+		nodes = append(nodes, ip)
+	}
+	for _, ip := range nodes {
+		info.ClusterID = clusterID
+		info.NodeName = ip
+		if err := k8sOperator.ClusterDrainNode(ctx, info); err != nil {
+			return fmt.Errorf("drain node %s failed: %v", ip, err)
+		}
+	}
+	return nil
+}
+
+// appendStepLogFunc append drain progress to step logs
+func appendStepLogFunc(state *cloudprovider.TaskState, stepName string) clusterops.DrainProgressFunc {
+	return func(msg string) {
+		_ = state.AppendStepLog(stepName, msg)
+	}
+}
@@ -0,0 +1,355 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/clusterops"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/options"
+
+	"k8s.io/apimachinery/pkg/util/version"
+)
+
+//* here are common steps for cluster & nodegroup upgrade, progress and rollback point
+//* are recorded in task commonParams & step params:
+//* originVersion: cluster version before upgrade, control plane rollback point
+//* cordonedNodes: nodes cordoned by running batch, uncordon them when rollback
+//* cordonedStep: drain step of running batch, batch is retried from it after rollback
+//* upgradedNodes: nodes which are upgraded successfully
+
+var (
+	// drainNodeTimeout timeout for draining one node
+	drainNodeTimeout = 10 * time.Minute
+	// checkUpgradedNodesTimeout timeout for waiting batch nodes ready
+	checkUpgradedNodesTimeout = 20 * time.Minute
+)
+
+func getK8SOperator() *clusterops.K8SOperator {
+	return clusterops.NewK8SOperator(options.GetGlobalCMOptions(), cloudprovider.GetStorageModel())
+}
+
+// UpgradePreCheckTask check upgrade version, deprecated apis removed in upgrade version and
+// PodDisruptionBudgets which will block node draining
+func UpgradePreCheckTask(taskID string, stepName string) error {
+	start := time.Now()
+
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		return nil
+	}
+
+	// extract parameter && check validate
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	dstVersion := step.Params[cloudprovider.UpgradeVersionKey.String()]
+	if len(clusterID) == 0 || len(dstVersion) == 0 {
+		blog.Errorf("UpgradePreCheckTask[%s]: check parameter validate failed", taskID)
+		retErr := fmt.Errorf("UpgradePreCheckTask check parameters failed")
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	cluster, err := cloudprovider.GetStorageModel().GetCluster(context.Background(), clusterID)
+	if err != nil {
+		blog.Errorf("UpgradePreCheckTask[%s]: get cluster %s failed: %v", taskID, clusterID, err)
+		retErr := fmt.Errorf("UpgradePreCheckTask get cluster failed: %v", err)
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	curVersion := cluster.GetClusterBasicSettings().GetVersion()
+	if err = checkUpgradeVersion(curVersion, dstVersion); err != nil {
+		blog.Errorf("UpgradePreCheckTask[%s]: cluster %s %v", taskID, clusterID, err)
+		_ = state.UpdateStepFailure(start, stepName, err)
+		return err
+	}
+	// record control plane rollback point, keep the first one when retry task
+	if _, ok := state.Task.CommonParams[cloudprovider.OriginVersionKey.String()]; !ok {
+		state.Task.CommonParams[cloudprovider.OriginVersionKey.String()] = curVersion
+	}
+
+	ctx := cloudprovider.WithTaskIDForContext(context.Background(), taskID)
+	k8sOperator := getK8SOperator()
+	deprecated, err := k8sOperator.ListDeprecatedAPIResources(ctx, clusterID, curVersion, dstVersion)
+	if err != nil {
+		blog.Errorf("UpgradePreCheckTask[%s]: ListDeprecatedAPIResources failed: %v", taskID, err)
+		retErr := fmt.Errorf("UpgradePreCheckTask check deprecated apis failed: %v", err)
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	pdbs, err := k8sOperator.ListBlockingPodDisruptionBudgets(ctx, clusterID)
+	if err != nil {
+		blog.Errorf("UpgradePreCheckTask[%s]: ListBlockingPodDisruptionBudgets failed: %v", taskID, err)
+		retErr := fmt.Errorf("UpgradePreCheckTask check PodDisruptionBudgets failed: %v", err)
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	failures := make([]string, 0)
+	for _, resource := range deprecated {
+		failures = append(failures, fmt.Sprintf("deprecated api %s", resource.String()))
+	}
+	if len(pdbs) > 0 {
+		failures = append(failures, fmt.Sprintf("PodDisruptionBudgets allow no disruption: %s",
+			strings.Join(pdbs, ",")))
+	}
+	if len(failures) > 0 {
+		blog.Errorf("UpgradePreCheckTask[%s]: cluster %s pre-check failed: %v", taskID, clusterID, failures)
+		retErr := fmt.Errorf("UpgradePreCheckTask failed: %s", strings.Join(failures, "; "))
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	// update step
+	if err := state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("UpgradePreCheckTask[%s] task %s %s update to storage fatal", taskID, taskID, stepName)
+		return err
+	}
+	return nil
+}
+
+// checkUpgradeVersion kubernetes only supports upgrading one minor version once
+func checkUpgradeVersion(curVersion, dstVersion string) error {
+	target, err := version.ParseGeneric(dstVersion)
+	if err != nil {
+		return fmt.Errorf("invalid upgrade version %s: %v", dstVersion, err)
+	}
+	// imported cluster may lost version info
+	if len(curVersion) == 0 {
+		return nil
+	}
+	current, err := version.ParseGeneric(curVersion)
+	if err != nil {
+		return fmt.Errorf("invalid cluster version %s: %v", curVersion, err)
+	}
+	if target.LessThan(current) {
+		return fmt.Errorf("upgrade version %s is lower than cluster version %s", dstVersion, curVersion)
+	}
+	if target.Major() != current.Major() || target.Minor() > current.Minor()+1 {
+		return fmt.Errorf("upgrade version %s skips minor version of cluster version %s", dstVersion, curVersion)
+	}
+	return nil
+}
+
+// UpdateClusterVersionDBTask update cluster version in DB when control plane upgraded
+func UpdateClusterVersionDBTask(taskID string, stepName string) error {
+	start := time.Now()
+
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		return nil
+	}
+
+	// extract parameter && check validate
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	dstVersion := step.Params[cloudprovider.UpgradeVersionKey.String()]
+	if len(clusterID) == 0 || len(dstVersion) == 0 {
+		blog.Errorf("UpdateClusterVersionDBTask[%s]: check parameter validate failed", taskID)
+		retErr := fmt.Errorf("UpdateClusterVersionDBTask check parameters failed")
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	cluster, err := cloudprovider.GetStorageModel().GetCluster(context.Background(), clusterID)
+	if err != nil {
+		blog.Errorf("UpdateClusterVersionDBTask[%s]: get cluster %s failed: %v", taskID, clusterID, err)
+		retErr := fmt.Errorf("UpdateClusterVersionDBTask get cluster failed: %v", err)
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	if cluster.ClusterBasicSettings == nil {
+		cluster.ClusterBasicSettings = &proto.ClusterBasicSetting{}
+	}
+	cluster.ClusterBasicSettings.Version = dstVersion
+	if err = cloudprovider.UpdateCluster(cluster); err != nil {
+		blog.Errorf("UpdateClusterVersionDBTask[%s]: update cluster %s failed: %v", taskID, clusterID, err)
+		retErr := fmt.Errorf("UpdateClusterVersionDBTask update cluster failed: %v", err)
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	// update step
+	if err := state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("UpdateClusterVersionDBTask[%s] task %s %s update to storage fatal", taskID, taskID, stepName)
+		return err
+	}
+	return nil
+}
+
+// CordonDrainNodesTask cordon batch nodes and evict pods on nodes, nodes are uncordoned
+// when draining failed
+func CordonDrainNodesTask(taskID string, stepName string) error {
+	start := time.Now()
+
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		return nil
+	}
+
+	// extract parameter && check validate
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	nodeIPs := step.Params[cloudprovider.NodeIPsKey.String()]
+	if len(clusterID) == 0 || len(nodeIPs) == 0 {
+		blog.Errorf("CordonDrainNodesTask[%s]: check parameter validate failed", taskID)
+		retErr := fmt.Errorf("CordonDrainNodesTask check parameters failed")
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	// record rollback point before cordon nodes
+	state.Task.CommonParams[cloudprovider.CordonedNodesKey.String()] = nodeIPs
+	state.Task.CommonParams[cloudprovider.CordonedStepKey.String()] = stepName
+	if err = state.UpdateCommonParams(); err != nil {
+		blog.Errorf("CordonDrainNodesTask[%s]: record cordoned nodes failed: %v", taskID, err)
+		return err
+	}
+
+	ctx := cloudprovider.WithTaskIDForContext(context.Background(), taskID)
+	k8sOperator := getK8SOperator()
+	ips := strings.Split(nodeIPs, ",")
+	err = cordonDrainNodes(ctx, k8sOperator, clusterID, ips, clusterops.DrainNodeInfo{
+		Timeout:  drainNodeTimeout,
+		Progress: appendStepLogFunc(state, stepName),
+	})
+	if err != nil {
+		blog.Errorf("CordonDrainNodesTask[%s]: cluster %s batch %s failed: %v, rollback nodes %v",
+			taskID, clusterID, step.Params[cloudprovider.BatchKey.String()], err, ips)
+		_ = uncordonNodes(ctx, k8sOperator, clusterID, ips)
+		delete(state.Task.CommonParams, cloudprovider.CordonedNodesKey.String())
+		delete(state.Task.CommonParams, cloudprovider.CordonedStepKey.String())
+		retErr := fmt.Errorf("CordonDrainNodesTask failed: %v", err)
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	// update step
+	if err := state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("CordonDrainNodesTask[%s] task %s %s update to storage fatal", taskID, taskID, stepName)
+		return err
+	}
+	return nil
+}
+
+func uncordonNodes(ctx context.Context, k8sOperator *clusterops.K8SOperator, clusterID string,
+	nodeIPs []string) error {
+	taskID := cloudprovider.GetTaskIDFromContext(ctx)
+	var lastErr error
+	for _, ip := range nodeIPs {
+		err := k8sOperator.ClusterUpdateScheduleNode(ctx, clusterops.NodeInfo{
+			ClusterID: clusterID,
+			NodeIP:    ip,
+			Desired:   false,
+		})
+		if err != nil {
+			blog.Errorf("uncordonNodes[%s] cluster %s node %s failed: %v", taskID, clusterID, ip, err)
+			lastErr = err
+		}
+	}
+	return lastErr
+}
+
+// CheckUpgradedNodesTask wait batch nodes ready with upgrade version, then uncordon nodes
+// and record upgrade progress
+func CheckUpgradedNodesTask(taskID string, stepName string) error {
+	start := time.Now()
+
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		return nil
+	}
+
+	// extract parameter && check validate
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	nodeIPs := step.Params[cloudprovider.NodeIPsKey.String()]
+	dstVersion := step.Params[cloudprovider.UpgradeVersionKey.String()]
+	if len(clusterID) == 0 || len(nodeIPs) == 0 || len(dstVersion) == 0 {
+		blog.Errorf("CheckUpgradedNodesTask[%s]: check parameter validate failed", taskID)
+		retErr := fmt.Errorf("CheckUpgradedNodesTask check parameters failed")
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	ctx := cloudprovider.WithTaskIDForContext(context.Background(), taskID)
+	k8sOperator := getK8SOperator()
+	ips := strings.Split(nodeIPs, ",")
+
+	timeCtx, cancel := context.WithTimeout(ctx, checkUpgradedNodesTimeout)
+	defer cancel()
+	var notReady []string
+	err = cloudprovider.LoopDoFunc(timeCtx, func() error {
+		notReady, err = k8sOperator.CheckNodesVersionReady(timeCtx, clusterID, ips, dstVersion)
+		if err != nil {
+			blog.Errorf("CheckUpgradedNodesTask[%s]: CheckNodesVersionReady failed: %v", taskID, err)
+			return nil
+		}
+		if len(notReady) == 0 {
+			return cloudprovider.EndLoop
+		}
+		blog.Infof("CheckUpgradedNodesTask[%s]: cluster %s nodes %v are upgrading", taskID, clusterID, notReady)
+		return nil
+	}, cloudprovider.LoopInterval(20*time.Second))
+	if err != nil {
+		blog.Errorf("CheckUpgradedNodesTask[%s]: cluster %s nodes %v not ready: %v", taskID, clusterID, notReady, err)
+		retErr := fmt.Errorf("CheckUpgradedNodesTask nodes %v not ready with version %s: %v",
+			notReady, dstVersion, err)
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	if err = uncordonNodes(ctx, k8sOperator, clusterID, ips); err != nil {
+		retErr := fmt.Errorf("CheckUpgradedNodesTask uncordon nodes failed: %v", err)
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	// record upgrade progress
+	delete(state.Task.CommonParams, cloudprovider.CordonedNodesKey.String())
+	delete(state.Task.CommonParams, cloudprovider.CordonedStepKey.String())
+	upgraded := state.Task.CommonParams[cloudprovider.UpgradedNodesKey.String()]
+	if len(upgraded) > 0 {
+		upgraded += ","
+	}
+	state.Task.CommonParams[cloudprovider.UpgradedNodesKey.String()] = upgraded + nodeIPs
+
+	// update step
+	if err := state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("CheckUpgradedNodesTask[%s] task %s %s update to storage fatal", taskID, taskID, stepName)
+		return err
+	}
+	return nil
+}
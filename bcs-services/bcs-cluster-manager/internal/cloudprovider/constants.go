@@ -44,6 +44,28 @@ var (
 	CleanNodesInGroupTask TaskName = "缩容节点池"
 	// CleanNodeGroupNodesStep step
 	CleanNodeGroupNodesStep StepName = "下架节点池节点"
+
+	// UpgradeClusterTask task
+	UpgradeClusterTask TaskName = "升级集群版本"
+	// UpgradeNodeGroupTask task
+	UpgradeNodeGroupTask TaskName = "滚动升级节点池"
+	// UpgradePreCheckStep step
+	UpgradePreCheckStep StepName = "升级前置检查"
+	// UpgradeClusterMasterStep step
+	UpgradeClusterMasterStep StepName = "升级集群控制面"
+	// UpdateClusterVersionDBStep step
+	UpdateClusterVersionDBStep StepName = "更新集群版本信息"
+	// CordonDrainNodesStep step
+	CordonDrainNodesStep StepName = "封锁并驱逐节点"
+	// UpgradeNodesStep step
+	UpgradeNodesStep StepName = "替换升级节点"
+	// CheckUpgradedNodesStep step
+	CheckUpgradedNodesStep StepName = "检测升级节点状态"
+
+	// DrainNodeTask task
+	DrainNodeTask TaskName = "驱逐节点"
+	// DrainNodesStep step
+	DrainNodesStep StepName = "封锁节点并驱逐Pod"
 )
 
 // ParamKey xxx
@@ -95,4 +117,26 @@ var (
 
 	// JobTypeKey xxx
 	JobTypeKey ParamKey = "jobType"
+
+	// cluster upgrade progress & rollback point
+	// UpgradeVersionKey xxx
+	UpgradeVersionKey ParamKey = "upgradeVersion"
+	// OriginVersionKey cluster version before upgrade, rollback point of control plane
+	OriginVersionKey ParamKey = "originVersion"
+	// BatchKey node group rolling batch, format index/total
+	BatchKey ParamKey = "batch"
+	// CordonedNodesKey nodes cordoned by current batch, rollback point of node batch
+	CordonedNodesKey ParamKey = "cordonedNodes"
+	// CordonedStepKey drain step of current batch, batch is retried from it after rollback
+	CordonedStepKey ParamKey = "cordonedStep"
+	// UpgradedNodesKey nodes which have been upgraded successfully
+	UpgradedNodesKey ParamKey = "upgradedNodes"
+
+	// node drain options
+	// DrainTimeoutKey timeout seconds for draining one node
+	DrainTimeoutKey ParamKey = "drainTimeout"
+	// DrainForceKey delete pods directly when draining failed
+	DrainForceKey ParamKey = "drainForce"
+	// DrainGracePeriodKey pod grace period seconds when draining
+	DrainGracePeriodKey ParamKey = "drainGracePeriod"
 )
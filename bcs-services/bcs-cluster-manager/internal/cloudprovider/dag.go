@@ -0,0 +1,197 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cloudprovider
+
+import (
+	"fmt"
+
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+)
+
+// steps of task are organized as DAG by Step.DependsOn, steps are running concurrently when
+// dependencies are all finished. tasks without any step dependency keep running steps one by
+// one in StepSequence for compatibility
+
+// StepOption timeout, retry and dependencies of step
+type StepOption struct {
+	// Timeout seconds of step running, step is not limited when it's 0
+	Timeout uint32
+	// MaxRetry retry times when step failed
+	MaxRetry uint32
+	// RetryInterval seconds between step retries
+	RetryInterval uint32
+	// DependsOn steps must be finished before step running
+	DependsOn []string
+}
+
+// WithDependsOn copy option with step dependencies
+func (opt StepOption) WithDependsOn(steps ...string) StepOption {
+	opt.DependsOn = append([]string{}, steps...)
+	return opt
+}
+
+// SetStepOption set timeout, retry and dependencies of step
+func SetStepOption(step *proto.Step, opt StepOption) {
+	step.Timeout = opt.Timeout
+	step.MaxRetry = opt.MaxRetry
+	step.RetryInterval = opt.RetryInterval
+	step.DependsOn = opt.DependsOn
+}
+
+// IsStepDone step success or skipped, steps depend on it are ready to run
+func IsStepDone(step *proto.Step) bool {
+	return step != nil && (step.Status == TaskStatusSuccess || step.Status == TaskStatusSkip)
+}
+
+// IsDAGTask check any step of task declares dependency
+func IsDAGTask(task *proto.Task) bool {
+	for _, step := range task.Steps {
+		if len(step.DependsOn) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// GetStepDependencies get steps that step depends on, step in linear task depends on
+// previous step in StepSequence
+func GetStepDependencies(task *proto.Task, stepName string) []string {
+	if IsDAGTask(task) {
+		if step, ok := task.Steps[stepName]; ok {
+			return step.DependsOn
+		}
+		return nil
+	}
+	for i, name := range task.StepSequence {
+		if name == stepName && i > 0 {
+			return []string{task.StepSequence[i-1]}
+		}
+	}
+	return nil
+}
+
+// ValidateTaskSteps check all steps in StepSequence are defined and dependencies
+// of steps are existed without cycle
+func ValidateTaskSteps(task *proto.Task) error {
+	if len(task.StepSequence) != len(task.Steps) {
+		return fmt.Errorf("task %s steps not match StepSequence", task.TaskID)
+	}
+	// in-degree of steps, topological sorting for detecting cycle
+	degree := make(map[string]int, len(task.StepSequence))
+	children := make(map[string][]string)
+	for _, name := range task.StepSequence {
+		if _, ok := task.Steps[name]; !ok {
+			return fmt.Errorf("task %s lost step %s in definition", task.TaskID, name)
+		}
+		if _, ok := degree[name]; ok {
+			return fmt.Errorf("task %s step %s duplicated", task.TaskID, name)
+		}
+		degree[name] = 0
+	}
+	for _, name := range task.StepSequence {
+		for _, dep := range GetStepDependencies(task, name) {
+			if _, ok := task.Steps[dep]; !ok {
+				return fmt.Errorf("task %s step %s depends on unknown step %s", task.TaskID, name, dep)
+			}
+			degree[name]++
+			children[dep] = append(children[dep], name)
+		}
+	}
+
+	queue := make([]string, 0)
+	for _, name := range task.StepSequence {
+		if degree[name] == 0 {
+			queue = append(queue, name)
+		}
+	}
+	visited := 0
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		visited++
+		for _, child := range children[name] {
+			degree[child]--
+			if degree[child] == 0 {
+				queue = append(queue, child)
+			}
+		}
+	}
+	if visited != len(task.StepSequence) {
+		return fmt.Errorf("task %s steps dependency has cycle", task.TaskID)
+	}
+	return nil
+}
+
+// GetReadySteps get steps that are not running or finished and dependencies are all done,
+// steps are returned in StepSequence order
+func GetReadySteps(task *proto.Task) []string {
+	ready := make([]string, 0)
+	for _, name := range task.StepSequence {
+		step, ok := task.Steps[name]
+		if !ok || IsStepDone(step) || step.Status == TaskStatusRunning {
+			continue
+		}
+		depsDone := true
+		for _, dep := range GetStepDependencies(task, name) {
+			if !IsStepDone(task.Steps[dep]) {
+				depsDone = false
+				break
+			}
+		}
+		if depsDone {
+			ready = append(ready, name)
+		}
+	}
+	return ready
+}
+
+// GetDescendantSteps get step and all steps depend on it directly or indirectly
+func GetDescendantSteps(task *proto.Task, stepName string) []string {
+	children := make(map[string][]string)
+	for _, name := range task.StepSequence {
+		for _, dep := range GetStepDependencies(task, name) {
+			children[dep] = append(children[dep], name)
+		}
+	}
+
+	visited := map[string]bool{stepName: true}
+	queue := []string{stepName}
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		for _, child := range children[name] {
+			if !visited[child] {
+				visited[child] = true
+				queue = append(queue, child)
+			}
+		}
+	}
+	steps := make([]string, 0, len(visited))
+	for _, name := range task.StepSequence {
+		if visited[name] {
+			steps = append(steps, name)
+		}
+	}
+	return steps
+}
+
+// IsTaskStepsDone check all steps of task are success or skipped
+func IsTaskStepsDone(task *proto.Task) bool {
+	for _, name := range task.StepSequence {
+		if !IsStepDone(task.Steps[name]) {
+			return false
+		}
+	}
+	return true
+}
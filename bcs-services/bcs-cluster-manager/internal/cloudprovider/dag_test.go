@@ -0,0 +1,244 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cloudprovider
+
+import (
+	"reflect"
+	"testing"
+
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+)
+
+// newTestTask build task with steps in sequence, status of steps are NotStarted by default
+func newTestTask(sequence []string, dependsOn map[string][]string, status map[string]string) *proto.Task {
+	task := &proto.Task{TaskID: "task-1", StepSequence: sequence, Steps: make(map[string]*proto.Step)}
+	for _, name := range sequence {
+		step := &proto.Step{Name: name, Status: TaskStatusNotStarted, DependsOn: dependsOn[name]}
+		if s, ok := status[name]; ok {
+			step.Status = s
+		}
+		task.Steps[name] = step
+	}
+	return task
+}
+
+// diamond: create -> (nodes, addons) -> check
+var testDiamondDeps = map[string][]string{
+	"nodes":  {"create"},
+	"addons": {"create"},
+	"check":  {"nodes", "addons"},
+}
+
+func TestValidateTaskSteps(t *testing.T) {
+	tests := []struct {
+		name   string
+		task   *proto.Task
+		hasErr bool
+	}{
+		{
+			name: "linear task",
+			task: newTestTask([]string{"a", "b", "c"}, nil, nil),
+		},
+		{
+			name: "diamond dag",
+			task: newTestTask([]string{"create", "nodes", "addons", "check"}, testDiamondDeps, nil),
+		},
+		{
+			name:   "cycle of two steps",
+			task:   newTestTask([]string{"a", "b"}, map[string][]string{"a": {"b"}, "b": {"a"}}, nil),
+			hasErr: true,
+		},
+		{
+			name:   "self dependency",
+			task:   newTestTask([]string{"a", "b"}, map[string][]string{"b": {"b"}}, nil),
+			hasErr: true,
+		},
+		{
+			name: "cycle behind valid steps",
+			task: newTestTask([]string{"a", "b", "c", "d"},
+				map[string][]string{"b": {"a"}, "c": {"b", "d"}, "d": {"c"}}, nil),
+			hasErr: true,
+		},
+		{
+			name:   "missing dependency",
+			task:   newTestTask([]string{"a", "b"}, map[string][]string{"b": {"x"}}, nil),
+			hasErr: true,
+		},
+		{
+			name: "step in sequence without definition",
+			task: func() *proto.Task {
+				task := newTestTask([]string{"a", "b"}, nil, nil)
+				delete(task.Steps, "b")
+				task.Steps["c"] = &proto.Step{Name: "c"}
+				return task
+			}(),
+			hasErr: true,
+		},
+		{
+			name: "steps not match sequence",
+			task: func() *proto.Task {
+				task := newTestTask([]string{"a", "b"}, nil, nil)
+				task.StepSequence = []string{"a"}
+				return task
+			}(),
+			hasErr: true,
+		},
+		{
+			name: "duplicated step in sequence",
+			task: func() *proto.Task {
+				task := newTestTask([]string{"a", "b"}, nil, nil)
+				task.StepSequence = []string{"a", "a"}
+				return task
+			}(),
+			hasErr: true,
+		},
+	}
+	for _, test := range tests {
+		err := ValidateTaskSteps(test.task)
+		if (err != nil) != test.hasErr {
+			t.Errorf("%s: expect error %t, but get %v", test.name, test.hasErr, err)
+		}
+	}
+}
+
+func TestGetReadySteps(t *testing.T) {
+	diamond := []string{"create", "nodes", "addons", "check"}
+	tests := []struct {
+		name       string
+		task       *proto.Task
+		readySteps []string
+	}{
+		{
+			name:       "linear task runs first step",
+			task:       newTestTask([]string{"a", "b", "c"}, nil, nil),
+			readySteps: []string{"a"},
+		},
+		{
+			name:       "linear task runs next step of skipped step",
+			task:       newTestTask([]string{"a", "b", "c"}, nil, map[string]string{"a": TaskStatusSkip}),
+			readySteps: []string{"b"},
+		},
+		{
+			name: "failed step blocks following steps in linear task",
+			task: newTestTask([]string{"a", "b", "c"}, nil,
+				map[string]string{"a": TaskStatusSuccess, "b": TaskStatusFailure}),
+			readySteps: []string{"b"},
+		},
+		{
+			name:       "dag runs root step",
+			task:       newTestTask(diamond, testDiamondDeps, nil),
+			readySteps: []string{"create"},
+		},
+		{
+			name:       "dag runs independent steps concurrently",
+			task:       newTestTask(diamond, testDiamondDeps, map[string]string{"create": TaskStatusSuccess}),
+			readySteps: []string{"nodes", "addons"},
+		},
+		{
+			name: "running step is not ready again",
+			task: newTestTask(diamond, testDiamondDeps,
+				map[string]string{"create": TaskStatusSuccess, "nodes": TaskStatusSuccess, "addons": TaskStatusRunning}),
+			readySteps: []string{},
+		},
+		{
+			name: "failed step only blocks its descendants",
+			task: newTestTask(diamond, testDiamondDeps,
+				map[string]string{"create": TaskStatusSuccess, "nodes": TaskStatusFailure}),
+			readySteps: []string{"nodes", "addons"},
+		},
+		{
+			name: "skipped step unblocks its descendants",
+			task: newTestTask(diamond, testDiamondDeps, map[string]string{"create": TaskStatusSuccess,
+				"nodes": TaskStatusSkip, "addons": TaskStatusSuccess}),
+			readySteps: []string{"check"},
+		},
+		{
+			name: "finished task has no ready step",
+			task: newTestTask(diamond, testDiamondDeps, map[string]string{"create": TaskStatusSuccess,
+				"nodes": TaskStatusSkip, "addons": TaskStatusSuccess, "check": TaskStatusSuccess}),
+			readySteps: []string{},
+		},
+	}
+	for _, test := range tests {
+		steps := GetReadySteps(test.task)
+		if !reflect.DeepEqual(steps, test.readySteps) {
+			t.Errorf("%s: expect ready steps %v, but get %v", test.name, test.readySteps, steps)
+		}
+	}
+}
+
+func TestGetDescendantSteps(t *testing.T) {
+	diamond := newTestTask([]string{"create", "nodes", "addons", "check"}, testDiamondDeps, nil)
+	linear := newTestTask([]string{"a", "b", "c"}, nil, nil)
+	tests := []struct {
+		name  string
+		task  *proto.Task
+		step  string
+		steps []string
+	}{
+		{name: "linear task from middle", task: linear, step: "b", steps: []string{"b", "c"}},
+		{name: "linear task from last", task: linear, step: "c", steps: []string{"c"}},
+		{name: "dag from root", task: diamond, step: "create", steps: []string{"create", "nodes", "addons", "check"}},
+		{name: "dag from branch", task: diamond, step: "nodes", steps: []string{"nodes", "check"}},
+		{name: "dag from leaf", task: diamond, step: "check", steps: []string{"check"}},
+		{name: "unknown step", task: diamond, step: "unknown", steps: []string{}},
+	}
+	for _, test := range tests {
+		steps := GetDescendantSteps(test.task, test.step)
+		if !reflect.DeepEqual(steps, test.steps) {
+			t.Errorf("%s: expect descendant steps %v, but get %v", test.name, test.steps, steps)
+		}
+	}
+}
+
+func TestIsTaskStepsDone(t *testing.T) {
+	sequence := []string{"create", "nodes", "addons", "check"}
+	tests := []struct {
+		name   string
+		status map[string]string
+		done   bool
+	}{
+		{name: "not started", done: false},
+		{
+			name: "all success",
+			status: map[string]string{"create": TaskStatusSuccess, "nodes": TaskStatusSuccess,
+				"addons": TaskStatusSuccess, "check": TaskStatusSuccess},
+			done: true,
+		},
+		{
+			name: "success and skipped",
+			status: map[string]string{"create": TaskStatusSuccess, "nodes": TaskStatusSkip,
+				"addons": TaskStatusSuccess, "check": TaskStatusSkip},
+			done: true,
+		},
+		{
+			name: "one step failed",
+			status: map[string]string{"create": TaskStatusSuccess, "nodes": TaskStatusFailure,
+				"addons": TaskStatusSuccess, "check": TaskStatusSuccess},
+			done: false,
+		},
+		{
+			name: "one step running",
+			status: map[string]string{"create": TaskStatusSuccess, "nodes": TaskStatusSuccess,
+				"addons": TaskStatusRunning, "check": TaskStatusNotStarted},
+			done: false,
+		},
+	}
+	for _, test := range tests {
+		task := newTestTask(sequence, testDiamondDeps, test.status)
+		if done := IsTaskStepsDone(task); done != test.done {
+			t.Errorf("%s: expect done %t, but get %t", test.name, test.done, done)
+		}
+	}
+}
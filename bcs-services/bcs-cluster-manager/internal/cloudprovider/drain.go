@@ -0,0 +1,121 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cloudprovider
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// defaultDrainTimeout default timeout seconds for draining one node
+	defaultDrainTimeout uint32 = 600
+)
+
+// DefaultDrainOption drain option for removing nodes when it's not specified, pods are never
+// deleted by force thus the step fails when eviction is refused by PodDisruptionBudget until
+// timeout or PreDelete hook fails. Force must be set in request explicitly
+func DefaultDrainOption() *proto.DrainOption {
+	return &proto.DrainOption{
+		Timeout: defaultDrainTimeout,
+		Force:   false,
+	}
+}
+
+// BuildDrainNodesStep append common step which cordons nodes and drains nodes gracefully,
+// step is skipped when option skip is set
+func BuildDrainNodesStep(task *proto.Task, clusterID string, nodeIPs []string, opt *proto.DrainOption) error {
+	if task == nil {
+		return fmt.Errorf("BuildDrainNodesStep task is empty")
+	}
+	if opt == nil {
+		opt = DefaultDrainOption()
+	}
+	if opt.Skip || len(nodeIPs) == 0 {
+		return nil
+	}
+	if _, ok := task.Steps[DrainNodesTask]; ok {
+		return fmt.Errorf("BuildDrainNodesStep step %s duplicated", DrainNodesTask)
+	}
+	timeout := opt.Timeout
+	if timeout == 0 {
+		timeout = defaultDrainTimeout
+	}
+
+	step := NewCommonStep(DrainNodesTask, DrainNodesTask, DrainNodesStep)
+	step.Params[ClusterIDKey.String()] = clusterID
+	step.Params[NodeIPsKey.String()] = strings.Join(nodeIPs, ",")
+	step.Params[DrainTimeoutKey.String()] = strconv.FormatUint(uint64(timeout), 10)
+	step.Params[DrainForceKey.String()] = strconv.FormatBool(opt.Force)
+	step.Params[DrainGracePeriodKey.String()] = strconv.FormatInt(int64(opt.GracePeriodSeconds), 10)
+
+	task.Steps[step.Name] = step
+	task.StepSequence = append(task.StepSequence, step.Name)
+	return nil
+}
+
+// BuildDrainNodeTask build task which only cordons nodes and drains nodes, it's
+// same for all cloudproviders
+func BuildDrainNodeTask(cls *proto.Cluster, nodeIPs []string, opt *proto.DrainOption,
+	operator string) (*proto.Task, error) {
+	// validate request params
+	if cls == nil {
+		return nil, fmt.Errorf("BuildDrainNodeTask cluster info empty")
+	}
+	if len(nodeIPs) == 0 || opt == nil {
+		return nil, fmt.Errorf("BuildDrainNodeTask nodes or drain option is empty")
+	}
+
+	//init task information
+	nowStr := time.Now().Format(time.RFC3339)
+	task := &proto.Task{
+		TaskID:         uuid.New().String(),
+		TaskType:       GetTaskType(cls.Provider, DrainNode),
+		TaskName:       DrainNodeTask.String(),
+		Status:         TaskStatusInit,
+		Message:        "task initializing",
+		Start:          nowStr,
+		Steps:          make(map[string]*proto.Step),
+		StepSequence:   make([]string, 0),
+		ClusterID:      cls.ClusterID,
+		ProjectID:      cls.ProjectID,
+		Creator:        operator,
+		Updater:        operator,
+		LastUpdate:     nowStr,
+		CommonParams:   make(map[string]string),
+		ForceTerminate: false,
+		NodeIPList:     nodeIPs,
+	}
+
+	// drain task never skips drain step
+	drainOpt := &proto.DrainOption{
+		Timeout:            opt.Timeout,
+		Force:              opt.Force,
+		GracePeriodSeconds: opt.GracePeriodSeconds,
+	}
+	if err := BuildDrainNodesStep(task, cls.ClusterID, nodeIPs, drainOpt); err != nil {
+		return nil, err
+	}
+	task.CurrentStep = task.StepSequence[0]
+	task.CommonParams[JobTypeKey.String()] = DrainNodeJob.String()
+	task.CommonParams[NodeIPsKey.String()] = strings.Join(nodeIPs, ",")
+
+	return task, nil
+}
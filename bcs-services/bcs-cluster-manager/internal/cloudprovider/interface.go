@@ -244,6 +244,10 @@ type CloudValidateManager interface {
 	ListInstanceTypeValidate(req *proto.ListCloudInstanceTypeRequest, account *proto.Account) error
 	// ListCloudImageOsValidate list tke image os validate
 	ListCloudOsImageValidate(req *proto.ListCloudOsImageRequest, account *proto.Account) error
+	// CreateClusterValidate create cluster validate, fields not set are skipped
+	CreateClusterValidate(req *proto.CreateClusterReq, opt *CommonOption) error
+	// CreateNodeGroupValidate create nodegroup validate, fields not set are skipped
+	CreateNodeGroupValidate(req *proto.CreateNodeGroupRequest, opt *CommonOption) error
 }
 
 // ClusterManager cloud interface for kubernetes cluster management
@@ -335,6 +339,8 @@ type TaskManager interface {
 	BuildUpdateDesiredNodesTask(desired uint32, group *proto.NodeGroup, opt *UpdateDesiredNodeOption) (*proto.Task, error)
 	// BuildSwitchNodeGroupAutoScalingTask switch nodegroup autoscaling
 	BuildSwitchNodeGroupAutoScalingTask(group *proto.NodeGroup, enable bool, opt *SwitchNodeGroupAutoScalingOption) (*proto.Task, error)
+	// BuildUpgradeNodeGroupTask rolling cordon/drain/replace nodegroup nodes to cluster version
+	BuildUpgradeNodeGroupTask(group *proto.NodeGroup, opt *UpgradeNodeGroupOption) (*proto.Task, error)
 
 	// ClusterManager taskList
 
@@ -348,4 +354,6 @@ type TaskManager interface {
 	BuildAddNodesToClusterTask(cls *proto.Cluster, nodes []*proto.Node, opt *AddNodesOption) (*proto.Task, error)
 	// BuildRemoveNodesFromClusterTask remove instances from cluster
 	BuildRemoveNodesFromClusterTask(cls *proto.Cluster, nodes []*proto.Node, opt *DeleteNodesOption) (*proto.Task, error)
+	// BuildUpgradeClusterTask upgrade cluster control plane, then rolling upgrade nodegroups
+	BuildUpgradeClusterTask(cls *proto.Cluster, opt *UpgradeClusterOption) (*proto.Task, error)
 }
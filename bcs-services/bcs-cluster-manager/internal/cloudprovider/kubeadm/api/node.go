@@ -0,0 +1,146 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package api
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+)
+
+var nodeMgr sync.Once
+
+func init() {
+	nodeMgr.Do(func() {
+		//init Node
+		cloudprovider.InitNodeManager("kubeadm", &NodeManager{})
+	})
+}
+
+var (
+	// probeTimeout timeout for probing host info
+	probeTimeout = 30 * time.Second
+	// probeScript print cpu cores and memory GB of host line by line
+	probeScript = `nproc
+awk '/MemTotal/{printf "%d\n", $2/1048576+0.5}' /proc/meminfo`
+)
+
+// NodeManager bare-metal hosts management, hosts are accessed by ssh
+type NodeManager struct {
+}
+
+// GetNodeByIP get specified Node by innerIP address, host is probed by ssh when
+// credential is set, thus unreachable host is found before creating task
+func (nm *NodeManager) GetNodeByIP(ip string, opt *cloudprovider.GetNodeOption) (*proto.Node, error) {
+	if net.ParseIP(ip) == nil {
+		return nil, fmt.Errorf("kubeadm GetNodeByIP invalid IP[%s]", ip)
+	}
+	if opt == nil || opt.Common == nil {
+		return nil, fmt.Errorf("kubeadm GetNodeByIP option is empty")
+	}
+
+	node := &proto.Node{}
+	node.InnerIP = ip
+	node.Region = opt.Common.Region
+	if len(opt.Common.Key) == 0 || len(opt.Common.Secret) == 0 {
+		return node, nil
+	}
+
+	if err := probeNode(node, opt.Common); err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+// ListNodesByIP list node by IP set
+func (nm *NodeManager) ListNodesByIP(ips []string, opt *cloudprovider.ListNodesOption) ([]*proto.Node, error) {
+	if opt == nil {
+		return nil, fmt.Errorf("kubeadm ListNodesByIP option is empty")
+	}
+
+	var nodes []*proto.Node
+	for _, ip := range ips {
+		node, err := nm.GetNodeByIP(ip, &cloudprovider.GetNodeOption{
+			Common:       opt.Common,
+			ClusterVPCID: opt.ClusterVPCID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
+
+// probeNode fill node cpu and memory by ssh
+func probeNode(node *proto.Node, opt *cloudprovider.CommonOption) error {
+	conf, err := GetSSHConfig(opt)
+	if err != nil {
+		return fmt.Errorf("kubeadm probe node %s failed: %v", node.InnerIP, err)
+	}
+	output, err := RunHostScript(node.InnerIP, conf, probeScript, probeTimeout)
+	if err != nil {
+		return fmt.Errorf("kubeadm probe node %s failed: %v", node.InnerIP, err)
+	}
+
+	lines := strings.Split(output, "\n")
+	if len(lines) != 2 {
+		return fmt.Errorf("kubeadm probe node %s unexpected output: %s", node.InnerIP, output)
+	}
+	cpu, errCPU := strconv.ParseUint(strings.TrimSpace(lines[0]), 10, 32)
+	mem, errMem := strconv.ParseUint(strings.TrimSpace(lines[1]), 10, 32)
+	if errCPU != nil || errMem != nil {
+		return fmt.Errorf("kubeadm probe node %s unexpected output: %s", node.InnerIP, output)
+	}
+	node.CPU = uint32(cpu)
+	node.Mem = uint32(mem)
+
+	return nil
+}
+
+// GetCloudRegions get regionInfo
+func (nm *NodeManager) GetCloudRegions(opt *cloudprovider.CommonOption) ([]*proto.RegionInfo, error) {
+	// bare-metal hosts have no region
+	return nil, nil
+}
+
+// GetZoneList get zoneList
+func (nm *NodeManager) GetZoneList(opt *cloudprovider.CommonOption) ([]*proto.ZoneInfo, error) {
+	// bare-metal hosts have no zone
+	return nil, nil
+}
+
+// GetCVMImageIDByImageName get imageID by imageName
+func (nm *NodeManager) GetCVMImageIDByImageName(imageName string, opt *cloudprovider.CommonOption) (string, error) {
+	// os of bare-metal hosts is installed by users
+	return "", nil
+}
+
+// ListNodeInstanceType list node type by zone and node family
+func (nm *NodeManager) ListNodeInstanceType(zone, nodeFamily string, cpu, memory uint32, opt *cloudprovider.CommonOption) (
+	[]*proto.InstanceType, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// ListOsImage list image os
+func (nm *NodeManager) ListOsImage(provider string, opt *cloudprovider.CommonOption) (
+	[]*proto.OsImage, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
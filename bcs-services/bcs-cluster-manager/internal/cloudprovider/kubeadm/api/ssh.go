@@ -0,0 +1,246 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package api
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/drivers"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/hostkey"
+
+	"golang.org/x/crypto/ssh"
+)
+
+var (
+	// defaultSSHPort default ssh port of hosts
+	defaultSSHPort = 22
+	// defaultDialTimeout timeout for connecting host
+	defaultDialTimeout = 10 * time.Second
+	// rootUser root user runs commands without sudo
+	rootUser = "root"
+)
+
+// HostKeyStore persist pinned ssh host keys
+type HostKeyStore interface {
+	CreateHostKey(ctx context.Context, key *hostkey.HostKey) error
+	GetHostKey(ctx context.Context, host string) (*hostkey.HostKey, error)
+	DeleteHostKey(ctx context.Context, host string) error
+}
+
+// SSHConfig ssh login info of hosts, it's extracted from cloud account:
+// SecretID is ssh user with optional port, format user[:port], such as root:22
+// SecretKey is PEM private key or password of user
+type SSHConfig struct {
+	User   string
+	Port   int
+	Secret string
+	// HostKeys host keys pinned when hosts are connected for the first time
+	HostKeys HostKeyStore
+}
+
+// GetSSHConfig get ssh login info from cloud credential
+func GetSSHConfig(opt *cloudprovider.CommonOption) (*SSHConfig, error) {
+	if opt == nil || len(opt.Key) == 0 || len(opt.Secret) == 0 {
+		return nil, fmt.Errorf("ssh user or secret is empty")
+	}
+
+	conf := &SSHConfig{
+		User:   opt.Key,
+		Port:   defaultSSHPort,
+		Secret: opt.Secret,
+	}
+	if model := cloudprovider.GetStorageModel(); model != nil {
+		conf.HostKeys = model
+	}
+	if idx := strings.LastIndex(opt.Key, ":"); idx >= 0 {
+		port, err := strconv.Atoi(opt.Key[idx+1:])
+		if err != nil || port <= 0 || port > 65535 {
+			return nil, fmt.Errorf("ssh user %s port is invalid", opt.Key)
+		}
+		conf.User = opt.Key[:idx]
+		conf.Port = port
+	}
+	if len(conf.User) == 0 {
+		return nil, fmt.Errorf("ssh user is empty")
+	}
+
+	return conf, nil
+}
+
+// authMethod private key is used when secret is PEM block, otherwise secret is password
+func (conf *SSHConfig) authMethod() (ssh.AuthMethod, error) {
+	if strings.Contains(conf.Secret, "PRIVATE KEY-----") {
+		signer, err := ssh.ParsePrivateKey([]byte(conf.Secret))
+		if err != nil {
+			return nil, fmt.Errorf("parse ssh private key failed: %v", err)
+		}
+		return ssh.PublicKeys(signer), nil
+	}
+
+	return ssh.Password(conf.Secret), nil
+}
+
+// verifyHostKey trust host key on first use: key is pinned when host is connected for the
+// first time, then connection is rejected when host presents a different key, so that
+// password or sudo privilege is never sent to an intercepted connection
+func (conf *SSHConfig) verifyHostKey(host string, key ssh.PublicKey) error {
+	if conf.HostKeys == nil {
+		return fmt.Errorf("host key store is empty, refuse to trust host %s", host)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), defaultDialTimeout)
+	defer cancel()
+
+	publicKey := base64.StdEncoding.EncodeToString(key.Marshal())
+	fingerprint := ssh.FingerprintSHA256(key)
+	pinned, err := conf.HostKeys.GetHostKey(ctx, host)
+	if err != nil && !errors.Is(err, drivers.ErrTableRecordNotFound) {
+		return fmt.Errorf("get pinned key of host %s failed: %v", host, err)
+	}
+	if err != nil {
+		err = conf.HostKeys.CreateHostKey(ctx, &hostkey.HostKey{
+			Host:        host,
+			KeyType:     key.Type(),
+			PublicKey:   publicKey,
+			Fingerprint: fingerprint,
+			CreateTime:  time.Now().Format(time.RFC3339),
+		})
+		if err == nil {
+			blog.Infof("host %s key %s %s is trusted on first use", host, key.Type(), fingerprint)
+			return nil
+		}
+		if !errors.Is(err, drivers.ErrTableRecordDuplicateKey) {
+			return fmt.Errorf("pin key of host %s failed: %v", host, err)
+		}
+		// key is pinned by concurrent connection, verify with it
+		if pinned, err = conf.HostKeys.GetHostKey(ctx, host); err != nil {
+			return fmt.Errorf("get pinned key of host %s failed: %v", host, err)
+		}
+	}
+
+	if pinned.KeyType != key.Type() || pinned.PublicKey != publicKey {
+		return fmt.Errorf("host %s key %s %s mismatch with pinned key %s %s, connection may be intercepted. "+
+			"if host is reinstalled, remove it from cluster to forget pinned key", host, key.Type(), fingerprint,
+			pinned.KeyType, pinned.Fingerprint)
+	}
+	return nil
+}
+
+// ForgetHostKey delete pinned key of host when host leaves cluster, key is trusted again
+// on first use when host is added next time
+func ForgetHostKey(host string, conf *SSHConfig) error {
+	if conf == nil || conf.HostKeys == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), defaultDialTimeout)
+	defer cancel()
+	if err := conf.HostKeys.DeleteHostKey(ctx, host); err != nil {
+		return fmt.Errorf("forget pinned key of host %s failed: %v", host, err)
+	}
+	return nil
+}
+
+// SSHClient run commands on host by ssh
+type SSHClient struct {
+	host   string
+	user   string
+	client *ssh.Client
+}
+
+// NewSSHClient connect host by ssh login info
+func NewSSHClient(host string, conf *SSHConfig) (*SSHClient, error) {
+	if conf == nil {
+		return nil, fmt.Errorf("ssh config of host %s is empty", host)
+	}
+	auth, err := conf.authMethod()
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := ssh.Dial("tcp", net.JoinHostPort(host, strconv.Itoa(conf.Port)), &ssh.ClientConfig{
+		User: conf.User,
+		Auth: []ssh.AuthMethod{auth},
+		HostKeyCallback: func(_ string, _ net.Addr, key ssh.PublicKey) error {
+			return conf.verifyHostKey(host, key)
+		},
+		Timeout: defaultDialTimeout,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("ssh connect host %s failed: %v", host, err)
+	}
+
+	return &SSHClient{host: host, user: conf.User, client: client}, nil
+}
+
+// Close close ssh connection
+func (c *SSHClient) Close() error {
+	return c.client.Close()
+}
+
+// RunScript run shell script by bash with root privilege, script is sent by stdin thus it's
+// not exposed in process list of host. combined output is returned, command is killed at timeout
+func (c *SSHClient) RunScript(script string, timeout time.Duration) (string, error) {
+	cmd := "bash -s"
+	if c.user != rootUser {
+		cmd = "sudo -n bash -s"
+	}
+
+	session, err := c.client.NewSession()
+	if err != nil {
+		return "", fmt.Errorf("host %s create ssh session failed: %v", c.host, err)
+	}
+	defer session.Close()
+	session.Stdin = strings.NewReader(script)
+
+	type result struct {
+		output []byte
+		err    error
+	}
+	done := make(chan result, 1)
+	go func() {
+		output, err := session.CombinedOutput(cmd)
+		done <- result{output: output, err: err}
+	}()
+
+	select {
+	case ret := <-done:
+		output := strings.TrimSpace(string(ret.output))
+		if ret.err != nil {
+			return output, fmt.Errorf("host %s run script failed: %v, output: %s", c.host, ret.err, output)
+		}
+		return output, nil
+	case <-time.After(timeout):
+		_ = session.Signal(ssh.SIGKILL)
+		return "", fmt.Errorf("host %s run script timeout after %s", c.host, timeout.String())
+	}
+}
+
+// RunHostScript connect host and run script once
+func RunHostScript(host string, conf *SSHConfig, script string, timeout time.Duration) (string, error) {
+	client, err := NewSSHClient(host, conf)
+	if err != nil {
+		return "", err
+	}
+	defer client.Close()
+
+	return client.RunScript(script, timeout)
+}
@@ -0,0 +1,97 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package api
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/drivers"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/hostkey"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// memoryHostKeys host key store in memory for testing
+type memoryHostKeys map[string]*hostkey.HostKey
+
+func (m memoryHostKeys) CreateHostKey(ctx context.Context, key *hostkey.HostKey) error {
+	if _, ok := m[key.Host]; ok {
+		return drivers.ErrTableRecordDuplicateKey
+	}
+	m[key.Host] = key
+	return nil
+}
+
+func (m memoryHostKeys) GetHostKey(ctx context.Context, host string) (*hostkey.HostKey, error) {
+	key, ok := m[host]
+	if !ok {
+		return nil, drivers.ErrTableRecordNotFound
+	}
+	return key, nil
+}
+
+func (m memoryHostKeys) DeleteHostKey(ctx context.Context, host string) error {
+	delete(m, host)
+	return nil
+}
+
+func newTestHostKey(t *testing.T) ssh.PublicKey {
+	public, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("generate host key failed, %s", err.Error())
+	}
+	key, err := ssh.NewPublicKey(public)
+	if err != nil {
+		t.Fatalf("create ssh public key failed, %s", err.Error())
+	}
+	return key
+}
+
+func TestVerifyHostKey(t *testing.T) {
+	keys := memoryHostKeys{}
+	conf := &SSHConfig{User: "root", Port: defaultSSHPort, Secret: "password", HostKeys: keys}
+	hostKey, otherKey := newTestHostKey(t), newTestHostKey(t)
+
+	if err := conf.verifyHostKey("127.0.0.1", hostKey); err != nil {
+		t.Fatalf("host key should be trusted on first use, but get %v", err)
+	}
+	pinned, ok := keys["127.0.0.1"]
+	if !ok || pinned.Fingerprint != ssh.FingerprintSHA256(hostKey) {
+		t.Fatalf("host key is not pinned, %+v", pinned)
+	}
+	if err := conf.verifyHostKey("127.0.0.1", hostKey); err != nil {
+		t.Errorf("pinned host key should be trusted, but get %v", err)
+	}
+	if err := conf.verifyHostKey("127.0.0.1", otherKey); err == nil {
+		t.Errorf("host key different from pinned key should be rejected")
+	}
+	if err := conf.verifyHostKey("127.0.0.2", otherKey); err != nil {
+		t.Errorf("key of another host should be trusted on first use, but get %v", err)
+	}
+
+	if err := ForgetHostKey("127.0.0.1", conf); err != nil {
+		t.Fatalf("forget host key failed, %s", err.Error())
+	}
+	if err := conf.verifyHostKey("127.0.0.1", otherKey); err != nil {
+		t.Errorf("new key should be trusted after pinned key forgotten, but get %v", err)
+	}
+
+	noStore := &SSHConfig{User: "root", Port: defaultSSHPort, Secret: "password"}
+	if err := noStore.verifyHostKey("127.0.0.1", hostKey); err == nil {
+		t.Errorf("host key should be rejected without host key store")
+	}
+}
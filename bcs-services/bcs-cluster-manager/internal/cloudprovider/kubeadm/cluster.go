@@ -0,0 +1,189 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package kubeadm
+
+import (
+	"fmt"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+)
+
+func init() {
+	cloudprovider.InitClusterManager(cloudName, &Cluster{})
+}
+
+// Cluster kubeadm kubernetes cluster management implementation
+type Cluster struct {
+}
+
+// CreateCluster create kubernetes cluster by kubeadm on master & node hosts
+func (c *Cluster) CreateCluster(cls *proto.Cluster, opt *cloudprovider.CreateClusterOption) (*proto.Task, error) {
+	if cls == nil {
+		return nil, fmt.Errorf("kubeadm CreateCluster cluster is empty")
+	}
+	if len(cls.Master) == 0 {
+		return nil, fmt.Errorf("kubeadm CreateCluster cluster master is empty")
+	}
+
+	if opt == nil || opt.Cloud == nil {
+		return nil, fmt.Errorf("kubeadm CreateCluster cluster opt or cloud is empty")
+	}
+
+	if len(opt.Key) == 0 || len(opt.Secret) == 0 {
+		return nil, fmt.Errorf("kubeadm CreateCluster opt lost valid ssh crendential info")
+	}
+
+	mgr, err := cloudprovider.GetTaskManager(opt.Cloud.CloudProvider)
+	if err != nil {
+		blog.Errorf("get cloud %s TaskManager when CreateCluster %s failed, %s",
+			opt.Cloud.CloudID, cls.ClusterName, err.Error(),
+		)
+		return nil, err
+	}
+
+	// build create cluster task
+	task, err := mgr.BuildCreateClusterTask(cls, opt)
+	if err != nil {
+		blog.Errorf("build CreateCluster task for cluster %s with cloudprovider %s failed, %s",
+			cls.ClusterName, cls.Provider, err.Error(),
+		)
+		return nil, err
+	}
+
+	return task, nil
+}
+
+// ImportCluster import cluster according cloudprovider
+func (c *Cluster) ImportCluster(cls *proto.Cluster, opt *cloudprovider.ImportClusterOption) (*proto.Task, error) {
+	// kubeadm clusters are only created by cluster-manager, existed clusters are imported by blueking
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// DeleteCluster reset all hosts of cluster by kubeadm
+func (c *Cluster) DeleteCluster(cls *proto.Cluster, opt *cloudprovider.DeleteClusterOption) (*proto.Task, error) {
+	if cls == nil {
+		return nil, fmt.Errorf("kubeadm DeleteCluster cluster is empty")
+	}
+
+	if opt == nil || opt.Cloud == nil || opt.Cluster == nil || len(opt.Operator) == 0 {
+		return nil, fmt.Errorf("kubeadm DeleteCluster cluster lost operation")
+	}
+
+	mgr, err := cloudprovider.GetTaskManager(opt.Cloud.CloudProvider)
+	if err != nil {
+		blog.Errorf("get cloud %s TaskManager when DeleteCluster %s failed, %s",
+			opt.Cloud.CloudID, cls.ClusterName, err.Error(),
+		)
+		return nil, err
+	}
+
+	// build delete cluster task
+	task, err := mgr.BuildDeleteClusterTask(cls, opt)
+	if err != nil {
+		blog.Errorf("build DeleteCluster task for cluster %s with cloudprovider %s failed, %s",
+			cls.ClusterName, cls.Provider, err.Error(),
+		)
+		return nil, err
+	}
+
+	return task, nil
+}
+
+// GetCluster get kubernetes cluster detail information according cloudprovider
+func (c *Cluster) GetCluster(cloudID string, opt *cloudprovider.GetClusterOption) (*proto.Cluster, error) {
+	return nil, nil
+}
+
+// ListCluster list cloud cluster by region
+func (c *Cluster) ListCluster(opt *cloudprovider.ListClusterOption) ([]*proto.CloudClusterInfo, error) {
+	return nil, nil
+}
+
+// GetNodesInCluster get all nodes belong to cluster according cloudprovider
+func (c *Cluster) GetNodesInCluster(cls *proto.Cluster, opt *cloudprovider.GetNodesOption) ([]*proto.Node, error) {
+	return nil, nil
+}
+
+// AddNodesToCluster join hosts to cluster by kubeadm
+func (c *Cluster) AddNodesToCluster(cls *proto.Cluster, nodes []*proto.Node, opt *cloudprovider.AddNodesOption) (*proto.Task, error) {
+	if cls == nil {
+		return nil, fmt.Errorf("kubeadm AddNodesToCluster cluster is empty")
+	}
+	if len(nodes) == 0 {
+		return nil, fmt.Errorf("kubeadm AddNodesToCluster nodes is empty")
+	}
+
+	if opt == nil || opt.Operator == "" || opt.Cloud == nil {
+		return nil, fmt.Errorf("kubeadm AddNodesToCluster cluster lost operation|operator|cloud")
+	}
+
+	mgr, err := cloudprovider.GetTaskManager(opt.Cloud.CloudProvider)
+	if err != nil {
+		blog.Errorf("get cloud %s TaskManager when AddNodesToCluster %s failed, %s",
+			opt.Cloud.CloudID, cls.ClusterName, err.Error(),
+		)
+		return nil, err
+	}
+
+	// build add nodes to cluster task
+	task, err := mgr.BuildAddNodesToClusterTask(cls, nodes, opt)
+	if err != nil {
+		blog.Errorf("build AddNodesToCluster task for cluster %s with cloudprovider %s failed, %s",
+			cls.ClusterName, cls.Provider, err.Error(),
+		)
+		return nil, err
+	}
+
+	return task, nil
+}
+
+// DeleteNodesFromCluster delete nodes from cluster and reset hosts by kubeadm
+func (c *Cluster) DeleteNodesFromCluster(cls *proto.Cluster, nodes []*proto.Node, opt *cloudprovider.DeleteNodesOption) (*proto.Task, error) {
+	if cls == nil {
+		return nil, fmt.Errorf("kubeadm DeleteNodesFromCluster cluster is empty")
+	}
+	if len(nodes) == 0 {
+		return nil, fmt.Errorf("kubeadm DeleteNodesFromCluster nodes is empty")
+	}
+
+	if opt == nil || opt.Operator == "" || opt.Cloud == nil {
+		return nil, fmt.Errorf("kubeadm DeleteNodesFromCluster cluster lost operation")
+	}
+
+	mgr, err := cloudprovider.GetTaskManager(opt.Cloud.CloudProvider)
+	if err != nil {
+		blog.Errorf("get cloud %s TaskManager when DeleteNodesFromCluster %s failed, %s",
+			opt.Cloud.CloudID, cls.ClusterName, err.Error(),
+		)
+		return nil, err
+	}
+
+	// build delete nodes from cluster task
+	task, err := mgr.BuildRemoveNodesFromClusterTask(cls, nodes, opt)
+	if err != nil {
+		blog.Errorf("build DeleteNodesFromCluster task for cluster %s with cloudprovider %s failed, %s",
+			cls.ClusterName, cls.Provider, err.Error(),
+		)
+		return nil, err
+	}
+
+	return task, nil
+}
+
+// CheckClusterCidrAvailable check cluster CIDR nodesNum when add nodes
+func (c *Cluster) CheckClusterCidrAvailable(cls *proto.Cluster, opt *cloudprovider.CheckClusterCIDROption) (bool, error) {
+	return true, nil
+}
@@ -0,0 +1,95 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package kubeadm
+
+import (
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+)
+
+func init() {
+	cloudprovider.InitNodeGroupManager(cloudName, &NodeGroup{})
+}
+
+// NodeGroup kubeadm clusters have no nodegroup, hosts are added to cluster directly
+type NodeGroup struct {
+}
+
+// CreateNodeGroup create nodegroup by cloudprovider api, only create NodeGroup entity
+func (ng *NodeGroup) CreateNodeGroup(group *proto.NodeGroup, opt *cloudprovider.CreateNodeGroupOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// DeleteNodeGroup delete nodegroup by cloudprovider api, all nodes belong to NodeGroup
+// will be released. Task is backgroup automatic task
+func (ng *NodeGroup) DeleteNodeGroup(group *proto.NodeGroup, nodes []*proto.Node, opt *cloudprovider.DeleteNodeGroupOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// UpdateNodeGroup update specified nodegroup configuration
+func (ng *NodeGroup) UpdateNodeGroup(group *proto.NodeGroup, opt *cloudprovider.CommonOption) error {
+	return cloudprovider.ErrCloudNotImplemented
+}
+
+// GetNodesInGroup get all nodes belong to NodeGroup
+func (ng *NodeGroup) GetNodesInGroup(group *proto.NodeGroup, opt *cloudprovider.CommonOption) ([]*proto.Node, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// MoveNodesToGroup add cluster nodes to NodeGroup
+func (ng *NodeGroup) MoveNodesToGroup(nodes []*proto.Node, group *proto.NodeGroup, opt *cloudprovider.MoveNodesOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// RemoveNodesFromGroup remove nodes from NodeGroup, nodes are still in cluster
+func (ng *NodeGroup) RemoveNodesFromGroup(nodes []*proto.Node, group *proto.NodeGroup, opt *cloudprovider.RemoveNodesOption) error {
+	return cloudprovider.ErrCloudNotImplemented
+}
+
+// CleanNodesInGroup clean specified nodes in NodeGroup,
+func (ng *NodeGroup) CleanNodesInGroup(nodes []*proto.Node, group *proto.NodeGroup,
+	opt *cloudprovider.CleanNodesOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// UpdateDesiredNodes update nodegroup desired node
+func (ng *NodeGroup) UpdateDesiredNodes(desired uint32, group *proto.NodeGroup,
+	opt *cloudprovider.UpdateDesiredNodeOption) (*cloudprovider.ScalingResponse, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// SwitchNodeGroupAutoScaling switch nodegroup autoscaling
+func (ng *NodeGroup) SwitchNodeGroupAutoScaling(group *proto.NodeGroup, enable bool,
+	opt *cloudprovider.SwitchNodeGroupAutoScalingOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// CreateAutoScalingOption create cluster autoscaling option, cloudprovider will
+// deploy cluster-autoscaler in backgroup according cloudprovider implementation
+func (ng *NodeGroup) CreateAutoScalingOption(scalingOption *proto.ClusterAutoScalingOption, opt *cloudprovider.CreateScalingOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// DeleteAutoScalingOption delete cluster autoscaling, cloudprovider will clean
+// cluster-autoscaler in backgroup according cloudprovider implementation
+func (ng *NodeGroup) DeleteAutoScalingOption(scalingOption *proto.ClusterAutoScalingOption, opt *cloudprovider.DeleteScalingOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// UpdateAutoScalingOption update cluster autoscaling option, cloudprovider will update
+// cluster-autoscaler configuration in backgroup according cloudprovider implementation.
+// Implementation is optional.
+func (ng *NodeGroup) UpdateAutoScalingOption(scalingOption *proto.ClusterAutoScalingOption, opt *cloudprovider.DeleteScalingOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
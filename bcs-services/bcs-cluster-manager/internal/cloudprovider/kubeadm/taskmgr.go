@@ -0,0 +1,489 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package kubeadm
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider/kubeadm/tasks"
+
+	"github.com/google/uuid"
+)
+
+var taskMgr sync.Once
+
+func init() {
+	taskMgr.Do(func() {
+		cloudprovider.InitTaskManager(cloudName, newtask())
+	})
+}
+
+func newtask() *Task {
+	task := &Task{
+		works: make(map[string]interface{}),
+	}
+
+	// create cluster task
+	task.works[prepareNodesTask] = tasks.PrepareNodesTask
+	task.works[initControlPlaneTask] = tasks.InitControlPlaneTask
+	task.works[joinMasterTask] = tasks.JoinMasterTask
+	task.works[joinNodesTask] = tasks.JoinNodesTask
+	task.works[updateCreateClusterDBInfoTask] = tasks.UpdateCreateClusterDBInfoTask
+	// delete cluster task
+	task.works[resetClusterHostsTask] = tasks.ResetClusterHostsTask
+	task.works[cleanClusterDBInfoTask] = tasks.CleanClusterDBInfoTask
+	// add node to cluster
+	task.works[updateAddNodeDBInfoTask] = tasks.UpdateAddNodeDBInfoTask
+	// remove node from cluster
+	task.works[resetNodesTask] = tasks.ResetNodesTask
+	task.works[updateRemoveNodeDBInfoTask] = tasks.UpdateRemoveNodeDBInfoTask
+
+	return task
+}
+
+// Task background task manager
+type Task struct {
+	works map[string]interface{}
+}
+
+// Name get task cloudName
+func (t *Task) Name() string {
+	return cloudName
+}
+
+// GetAllTask register all backgroup task for worker running
+func (t *Task) GetAllTask() map[string]interface{} {
+	return t.works
+}
+
+// stepOption timeout & retry of kubeadm step, steps running scripts on hosts may fail
+// temporarily by network, they are retried automatically
+type stepOption struct {
+	// timeout seconds of step
+	timeout uint32
+	// maxRetry retry times when step failed
+	maxRetry uint32
+	// dependsOn steps must be finished before step running
+	dependsOn []string
+}
+
+var (
+	// prepareStepOption prepare host environment
+	prepareStepOption = stepOption{timeout: 600, maxRetry: 2}
+	// initStepOption kubeadm init pulls images, it takes more time
+	initStepOption = stepOption{timeout: 1800, maxRetry: 1}
+	// joinStepOption kubeadm join
+	joinStepOption = stepOption{timeout: 1200, maxRetry: 2}
+	// resetStepOption kubeadm reset
+	resetStepOption = stepOption{timeout: 1200, maxRetry: 1}
+	// stepRetryInterval seconds between step retries
+	stepRetryInterval uint32 = 30
+)
+
+// newStep init kubeadm step, hosts of step are set by NodeIPs
+func newStep(name, method, taskName string, cls *proto.Cluster, nodeIPs []string, opt stepOption) *proto.Step {
+	step := &proto.Step{
+		Name:       name,
+		System:     "api",
+		Params:     make(map[string]string),
+		Retry:      0,
+		Status:     cloudprovider.TaskStatusNotStarted,
+		TaskMethod: method,
+		TaskName:   taskName,
+		DependsOn:  opt.dependsOn,
+		Timeout:    opt.timeout,
+		MaxRetry:   opt.maxRetry,
+	}
+	if opt.maxRetry > 0 {
+		step.RetryInterval = stepRetryInterval
+	}
+	step.Params[cloudprovider.ClusterIDKey.String()] = cls.ClusterID
+	step.Params[cloudprovider.CloudIDKey.String()] = cls.Provider
+	if len(nodeIPs) > 0 {
+		step.Params[cloudprovider.NodeIPsKey.String()] = strings.Join(nodeIPs, ",")
+	}
+
+	return step
+}
+
+// addStep append step to task
+func addStep(task *proto.Task, step *proto.Step) error {
+	if _, ok := task.Steps[step.Name]; ok {
+		return fmt.Errorf("step %s duplicated", step.Name)
+	}
+	task.Steps[step.Name] = step
+	task.StepSequence = append(task.StepSequence, step.Name)
+	return nil
+}
+
+// getMasterIPs get sorted master IPs of cluster, the first master inits control plane
+func getMasterIPs(cls *proto.Cluster) []string {
+	masterIPs := make([]string, 0, len(cls.Master))
+	for ip := range cls.Master {
+		masterIPs = append(masterIPs, ip)
+	}
+	sort.Strings(masterIPs)
+	return masterIPs
+}
+
+func newTaskInfo(cls *proto.Cluster, taskType cloudprovider.TaskType, taskName, operator string) *proto.Task {
+	nowStr := time.Now().Format(time.RFC3339)
+	return &proto.Task{
+		TaskID:         uuid.New().String(),
+		TaskType:       cloudprovider.GetTaskType(cloudName, taskType),
+		TaskName:       taskName,
+		Status:         cloudprovider.TaskStatusInit,
+		Message:        "task initializing",
+		Start:          nowStr,
+		Steps:          make(map[string]*proto.Step),
+		StepSequence:   make([]string, 0),
+		ClusterID:      cls.ClusterID,
+		ProjectID:      cls.ProjectID,
+		Creator:        operator,
+		Updater:        operator,
+		LastUpdate:     nowStr,
+		CommonParams:   make(map[string]string),
+		ForceTerminate: false,
+	}
+}
+
+// BuildCreateClusterTask build create cluster task
+func (t *Task) BuildCreateClusterTask(cls *proto.Cluster, opt *cloudprovider.CreateClusterOption) (*proto.Task, error) {
+	// create cluster steps are organized as DAG:
+	// 1. prepare all hosts concurrently: swap, kernel modules, sysctl and kubeadm version
+	// 2. kubeadm init control plane on first master when it's prepared
+	// 3. join other masters one by one, join nodes concurrently when they are prepared
+	// 4. update cluster DB info when all hosts joined
+
+	// validate request params
+	if cls == nil {
+		return nil, fmt.Errorf("BuildCreateClusterTask cluster info empty")
+	}
+	if opt == nil || opt.Cloud == nil || opt.Operator == "" {
+		return nil, fmt.Errorf("BuildCreateClusterTask TaskOptions is lost")
+	}
+	if len(cls.Master) == 0 {
+		return nil, fmt.Errorf("BuildCreateClusterTask cluster master is empty")
+	}
+	if cls.GetClusterBasicSettings().GetVersion() == "" {
+		return nil, fmt.Errorf("BuildCreateClusterTask cluster version is empty")
+	}
+
+	// init task information
+	task := newTaskInfo(cls, cloudprovider.CreateCluster, "创建kubeadm集群", opt.Operator)
+	task.CommonParams["taskName"] = fmt.Sprintf(createClusterTaskTemplate, cls.ClusterID)
+
+	masterIPs := getMasterIPs(cls)
+	nodeIPs := make([]string, 0)
+	for _, ip := range opt.Nodes {
+		if _, ok := cls.Master[ip]; !ok {
+			nodeIPs = append(nodeIPs, ip)
+		}
+	}
+	initMaster := masterIPs[0]
+
+	// step1: prepare all hosts
+	prepareSteps := make(map[string]string)
+	for _, ip := range append(append([]string{}, masterIPs...), nodeIPs...) {
+		step := newStep(fmt.Sprintf("%s-%s", prepareNodesTask, ip), prepareNodesTask, "初始化主机环境",
+			cls, []string{ip}, prepareStepOption)
+		if err := addStep(task, step); err != nil {
+			return nil, fmt.Errorf("BuildCreateClusterTask failed: %v", err)
+		}
+		prepareSteps[ip] = step.Name
+	}
+
+	// step2: kubeadm init control plane
+	initOpt := initStepOption
+	initOpt.dependsOn = []string{prepareSteps[initMaster]}
+	initStep := newStep(initControlPlaneTask, initControlPlaneTask, "初始化集群控制面", cls,
+		[]string{initMaster}, initOpt)
+	if err := addStep(task, initStep); err != nil {
+		return nil, fmt.Errorf("BuildCreateClusterTask failed: %v", err)
+	}
+
+	// step3: join masters one by one, etcd members are added serially
+	joinSteps := []string{initStep.Name}
+	lastMasterStep := initStep.Name
+	for _, ip := range masterIPs[1:] {
+		joinOpt := joinStepOption
+		joinOpt.dependsOn = []string{lastMasterStep, prepareSteps[ip]}
+		step := newStep(fmt.Sprintf("%s-%s", joinMasterTask, ip), joinMasterTask, "加入集群控制面", cls,
+			[]string{ip}, joinOpt)
+		step.Params[tasks.InitMasterKey] = initMaster
+		if err := addStep(task, step); err != nil {
+			return nil, fmt.Errorf("BuildCreateClusterTask failed: %v", err)
+		}
+		lastMasterStep = step.Name
+		joinSteps = append(joinSteps, step.Name)
+	}
+	// join nodes concurrently
+	for _, ip := range nodeIPs {
+		joinOpt := joinStepOption
+		joinOpt.dependsOn = []string{initStep.Name, prepareSteps[ip]}
+		step := newStep(fmt.Sprintf("%s-%s", joinNodesTask, ip), joinNodesTask, "节点加入集群", cls,
+			[]string{ip}, joinOpt)
+		step.Params[tasks.InitMasterKey] = initMaster
+		if err := addStep(task, step); err != nil {
+			return nil, fmt.Errorf("BuildCreateClusterTask failed: %v", err)
+		}
+		joinSteps = append(joinSteps, step.Name)
+	}
+
+	// step4: update cluster DB info and associated data
+	updateStep := newStep(updateCreateClusterDBInfoTask, updateCreateClusterDBInfoTask, "更新任务状态", cls,
+		nodeIPs, stepOption{dependsOn: joinSteps})
+	if err := addStep(task, updateStep); err != nil {
+		return nil, fmt.Errorf("BuildCreateClusterTask failed: %v", err)
+	}
+
+	// set current step
+	task.CurrentStep = task.StepSequence[0]
+	task.CommonParams["operator"] = opt.Operator
+	task.CommonParams["user"] = opt.Operator
+	task.CommonParams[cloudprovider.JobTypeKey.String()] = cloudprovider.CreateClusterJob.String()
+
+	return task, nil
+}
+
+// BuildImportClusterTask build import cluster task
+func (t *Task) BuildImportClusterTask(cls *proto.Cluster, opt *cloudprovider.ImportClusterOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// BuildDeleteClusterTask build deleteCluster task
+func (t *Task) BuildDeleteClusterTask(cls *proto.Cluster, opt *cloudprovider.DeleteClusterOption) (*proto.Task, error) {
+	// delete cluster has two steps:
+	// 1. kubeadm reset all masters & nodes of cluster
+	// 2. clean DB cluster info and associated data info when reset successful
+
+	// validate request params
+	if cls == nil {
+		return nil, fmt.Errorf("BuildDeleteClusterTask cluster info empty")
+	}
+	if opt == nil || opt.Operator == "" || opt.Cloud == nil || opt.Cluster == nil {
+		return nil, fmt.Errorf("BuildDeleteClusterTask TaskOptions is lost")
+	}
+
+	// init task information
+	task := newTaskInfo(cls, cloudprovider.DeleteCluster, "删除kubeadm集群", opt.Operator)
+	task.CommonParams["taskName"] = fmt.Sprintf(deleteClusterTaskTemplate, cls.ClusterID)
+
+	// step1: reset hosts, nodes are listed from DB when step running
+	resetStep := newStep(resetClusterHostsTask, resetClusterHostsTask, "重置集群主机", cls, nil, resetStepOption)
+	resetStep.Params[tasks.ForceKey] = strconv.FormatBool(opt.IsForce)
+	if err := addStep(task, resetStep); err != nil {
+		return nil, fmt.Errorf("BuildDeleteClusterTask failed: %v", err)
+	}
+
+	// step2: clean cluster DB info and associated data
+	updateStep := newStep(cleanClusterDBInfoTask, cleanClusterDBInfoTask, "更新任务状态", cls, nil, stepOption{})
+	if err := addStep(task, updateStep); err != nil {
+		return nil, fmt.Errorf("BuildDeleteClusterTask failed: %v", err)
+	}
+
+	// set current step
+	task.CurrentStep = task.StepSequence[0]
+	task.CommonParams["operator"] = opt.Operator
+	task.CommonParams[cloudprovider.JobTypeKey.String()] = cloudprovider.DeleteClusterJob.String()
+
+	return task, nil
+}
+
+// BuildAddNodesToClusterTask build addNodes task
+func (t *Task) BuildAddNodesToClusterTask(cls *proto.Cluster, nodes []*proto.Node, opt *cloudprovider.AddNodesOption) (*proto.Task, error) {
+	// addNodesToCluster steps are organized as DAG:
+	// 1. prepare hosts concurrently
+	// 2. join hosts concurrently when they are prepared
+	// 3. update DB operation
+
+	// validate request params
+	if cls == nil {
+		return nil, fmt.Errorf("BuildAddNodesToClusterTask cluster info empty")
+	}
+	if len(nodes) == 0 {
+		return nil, fmt.Errorf("BuildAddNodesToClusterTask lost nodes info")
+	}
+	if opt == nil || opt.Cloud == nil || opt.Operator == "" {
+		return nil, fmt.Errorf("BuildAddNodesToClusterTask TaskOptions is lost")
+	}
+	if len(cls.Master) == 0 {
+		return nil, fmt.Errorf("BuildAddNodesToClusterTask cluster master is empty")
+	}
+
+	// format node IPs
+	nodeIPs := make([]string, 0)
+	for i := range nodes {
+		nodeIPs = append(nodeIPs, nodes[i].InnerIP)
+	}
+	initMaster := getMasterIPs(cls)[0]
+
+	// init task information
+	task := newTaskInfo(cls, cloudprovider.AddNodesToCluster, "集群添加节点任务", opt.Operator)
+	task.NodeIPList = nodeIPs
+	task.CommonParams["taskName"] = fmt.Sprintf(addClusterNodesTaskTemplate, cls.ClusterID)
+
+	// step1 & step2: prepare and join hosts
+	joinSteps := make([]string, 0)
+	for _, ip := range nodeIPs {
+		prepareStep := newStep(fmt.Sprintf("%s-%s", prepareNodesTask, ip), prepareNodesTask, "初始化主机环境",
+			cls, []string{ip}, prepareStepOption)
+		if err := addStep(task, prepareStep); err != nil {
+			return nil, fmt.Errorf("BuildAddNodesToClusterTask failed: %v", err)
+		}
+
+		joinOpt := joinStepOption
+		joinOpt.dependsOn = []string{prepareStep.Name}
+		joinStep := newStep(fmt.Sprintf("%s-%s", joinNodesTask, ip), joinNodesTask, "节点加入集群", cls,
+			[]string{ip}, joinOpt)
+		joinStep.Params[tasks.InitMasterKey] = initMaster
+		if err := addStep(task, joinStep); err != nil {
+			return nil, fmt.Errorf("BuildAddNodesToClusterTask failed: %v", err)
+		}
+		joinSteps = append(joinSteps, joinStep.Name)
+	}
+
+	// step3: update DB node info by instanceIP
+	updateStep := newStep(updateAddNodeDBInfoTask, updateAddNodeDBInfoTask, "更新任务状态", cls, nodeIPs,
+		stepOption{dependsOn: joinSteps})
+	if err := addStep(task, updateStep); err != nil {
+		return nil, fmt.Errorf("BuildAddNodesToClusterTask failed: %v", err)
+	}
+
+	// set current step
+	task.CurrentStep = task.StepSequence[0]
+	task.CommonParams["operator"] = opt.Operator
+	task.CommonParams["user"] = opt.Operator
+	task.CommonParams[cloudprovider.JobTypeKey.String()] = cloudprovider.AddNodeJob.String()
+	task.CommonParams[cloudprovider.NodeIPsKey.String()] = strings.Join(nodeIPs, ",")
+
+	return task, nil
+}
+
+// BuildRemoveNodesFromClusterTask build removeNodes task
+func (t *Task) BuildRemoveNodesFromClusterTask(cls *proto.Cluster, nodes []*proto.Node, opt *cloudprovider.DeleteNodesOption) (*proto.Task, error) {
+	// removeNodesFromCluster steps:
+	// 1. cordon nodes and drain pods gracefully
+	// 2. delete nodes from cluster, kubeadm reset hosts
+	// 3. update node DB info when reset successful
+
+	// validate request params
+	if cls == nil {
+		return nil, fmt.Errorf("BuildRemoveNodesFromClusterTask cluster info empty")
+	}
+	if opt == nil || opt.Cloud == nil {
+		return nil, fmt.Errorf("BuildRemoveNodesFromClusterTask TaskOptions is lost")
+	}
+
+	// format all nodes InnerIP
+	var (
+		nodeIPs []string
+	)
+	for _, node := range nodes {
+		nodeIPs = append(nodeIPs, node.InnerIP)
+	}
+
+	// init task information
+	task := newTaskInfo(cls, cloudprovider.RemoveNodesFromCluster, "集群删除节点任务", opt.Operator)
+	task.NodeIPList = nodeIPs
+	task.CommonParams["taskName"] = fmt.Sprintf(deleteClusterNodesTaskTemplate, cls.ClusterID)
+
+	// step1: cordon nodes and drain pods gracefully before removing
+	if err := cloudprovider.BuildDrainNodesStep(task, cls.ClusterID, nodeIPs, opt.Drain); err != nil {
+		return nil, fmt.Errorf("BuildRemoveNodesFromClusterTask task failed: %v", err)
+	}
+
+	// step2: delete nodes from cluster and reset hosts
+	resetStep := newStep(resetNodesTask, resetNodesTask, "移除并重置节点", cls, nodeIPs, resetStepOption)
+	resetStep.Params[tasks.ForceKey] = strconv.FormatBool(opt.IsForce)
+	if err := addStep(task, resetStep); err != nil {
+		return nil, fmt.Errorf("BuildRemoveNodesFromClusterTask failed: %v", err)
+	}
+
+	// step3: update node DB info
+	updateStep := newStep(updateRemoveNodeDBInfoTask, updateRemoveNodeDBInfoTask, "更新任务状态", cls, nodeIPs,
+		stepOption{})
+	if err := addStep(task, updateStep); err != nil {
+		return nil, fmt.Errorf("BuildRemoveNodesFromClusterTask failed: %v", err)
+	}
+
+	// set current step
+	task.CurrentStep = task.StepSequence[0]
+	task.CommonParams["operator"] = opt.Operator
+	task.CommonParams[cloudprovider.JobTypeKey.String()] = cloudprovider.DeleteNodeJob.String()
+	task.CommonParams[cloudprovider.NodeIPsKey.String()] = strings.Join(nodeIPs, ",")
+
+	return task, nil
+}
+
+// BuildCleanNodesInGroupTask clean specified nodes in NodeGroup
+func (t *Task) BuildCleanNodesInGroupTask(nodes []*proto.Node, group *proto.NodeGroup,
+	opt *cloudprovider.CleanNodesOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// BuildScalingNodesTask when scaling nodes, we need to create background
+// task to verify scaling status and update new nodes to local storage
+func (t *Task) BuildScalingNodesTask(scaling uint32, group *proto.NodeGroup, opt *cloudprovider.TaskOptions) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// BuildDeleteNodeGroupTask when delete nodegroup, we need to create background
+// task to clean all nodes in nodegroup
+func (t *Task) BuildDeleteNodeGroupTask(group *proto.NodeGroup, nodes []*proto.Node,
+	opt *cloudprovider.DeleteNodeGroupOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// BuildCreateNodeGroupTask build create nodegroup task
+func (t *Task) BuildCreateNodeGroupTask(group *proto.NodeGroup, opt *cloudprovider.CreateNodeGroupOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// BuildUpdateNodeGroupTask when update nodegroup, we need to create background task,
+func (t *Task) BuildUpdateNodeGroupTask(group *proto.NodeGroup, opt *cloudprovider.CommonOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// BuildMoveNodesToGroupTask build move nodes to nodegroup task
+func (t *Task) BuildMoveNodesToGroupTask(nodes []*proto.Node, group *proto.NodeGroup, opt *cloudprovider.MoveNodesOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// BuildUpdateDesiredNodesTask build update nodegroup desired nodes task
+func (t *Task) BuildUpdateDesiredNodesTask(desired uint32, group *proto.NodeGroup, opt *cloudprovider.UpdateDesiredNodeOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// BuildSwitchNodeGroupAutoScalingTask switch nodegroup auto scaling
+func (t *Task) BuildSwitchNodeGroupAutoScalingTask(group *proto.NodeGroup, enable bool, opt *cloudprovider.SwitchNodeGroupAutoScalingOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// BuildUpgradeNodeGroupTask rolling upgrade nodegroup nodes to cluster version
+func (t *Task) BuildUpgradeNodeGroupTask(group *proto.NodeGroup, opt *cloudprovider.UpgradeNodeGroupOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// BuildUpgradeClusterTask build upgrade cluster task
+func (t *Task) BuildUpgradeClusterTask(cls *proto.Cluster, opt *cloudprovider.UpgradeClusterOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
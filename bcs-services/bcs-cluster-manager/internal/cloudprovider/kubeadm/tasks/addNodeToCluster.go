@@ -0,0 +1,72 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package tasks
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+)
+
+// UpdateAddNodeDBInfoTask save joined nodes to DB
+func UpdateAddNodeDBInfoTask(taskID string, stepName string) error {
+	start := time.Now()
+
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		return nil
+	}
+
+	// extract parameter && check validate
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	cloudID := step.Params[cloudprovider.CloudIDKey.String()]
+	nodeIPs := step.Params[cloudprovider.NodeIPsKey.String()]
+	if len(clusterID) == 0 || len(cloudID) == 0 || len(nodeIPs) == 0 {
+		blog.Errorf("UpdateAddNodeDBInfoTask[%s]: check parameter validate failed", taskID)
+		retErr := fmt.Errorf("UpdateAddNodeDBInfoTask check parameters failed")
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	basicInfo, err := cloudprovider.GetClusterDependBasicInfo(clusterID, cloudID, "")
+	if err != nil {
+		blog.Errorf("UpdateAddNodeDBInfoTask[%s]: getClusterDependBasicInfo failed: %v", taskID, err)
+		retErr := fmt.Errorf("getClusterDependBasicInfo failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	err = saveClusterNodes(clusterID, strings.Split(nodeIPs, ","), basicInfo.CmOption)
+	if err != nil {
+		blog.Errorf("UpdateAddNodeDBInfoTask[%s]: save cluster %s nodes failed: %v", taskID, clusterID, err)
+		retErr := fmt.Errorf("save cluster nodes failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	blog.Infof("UpdateAddNodeDBInfoTask[%s]: save cluster[%s] nodes %s successful", taskID, clusterID, nodeIPs)
+
+	// update step
+	if err := state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("UpdateAddNodeDBInfoTask[%s] task %s %s update to storage fatal", taskID, taskID, stepName)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,332 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package tasks
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/drivers"
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider/kubeadm/api"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider/utils"
+	icommon "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/common"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/types"
+)
+
+// PrepareNodesTask prepare host environment for kubernetes, and check kubeadm & kubelet
+// installed on host match cluster version
+func PrepareNodesTask(taskID string, stepName string) error {
+	start := time.Now()
+
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		return nil
+	}
+
+	// extract parameter && check validate
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	cloudID := step.Params[cloudprovider.CloudIDKey.String()]
+	nodeIP := step.Params[cloudprovider.NodeIPsKey.String()]
+	if len(clusterID) == 0 || len(cloudID) == 0 || len(nodeIP) == 0 {
+		blog.Errorf("PrepareNodesTask[%s]: check parameter validate failed", taskID)
+		retErr := fmt.Errorf("PrepareNodesTask check parameters failed")
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	basicInfo, conf, err := getClusterSSHInfo(clusterID, cloudID)
+	if err != nil {
+		blog.Errorf("PrepareNodesTask[%s]: get cluster %s ssh info failed: %v", taskID, clusterID, err)
+		retErr := fmt.Errorf("PrepareNodesTask failed: %v", err)
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	// prepare host and check versions
+	output, err := api.RunHostScript(nodeIP, conf, prepareScript, prepareTimeout)
+	if err == nil {
+		err = checkHostVersion(output, basicInfo.Cluster.GetClusterBasicSettings().GetVersion())
+	}
+	if err != nil {
+		blog.Errorf("PrepareNodesTask[%s]: prepare host %s failed: %v", taskID, nodeIP, err)
+		retErr := fmt.Errorf("PrepareNodesTask host %s failed: %v", nodeIP, err)
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	_ = state.AppendStepLog(stepName, fmt.Sprintf("host %s prepared successfully", nodeIP))
+
+	// update step
+	if err := state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("PrepareNodesTask[%s] task %s %s update to storage fatal", taskID, taskID, stepName)
+		return err
+	}
+	return nil
+}
+
+// InitControlPlaneTask kubeadm init control plane on first master, admin kubeconfig is
+// saved as cluster kubeconfig & credential
+func InitControlPlaneTask(taskID string, stepName string) error {
+	start := time.Now()
+
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		return nil
+	}
+
+	// extract parameter && check validate
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	cloudID := step.Params[cloudprovider.CloudIDKey.String()]
+	masterIP := step.Params[cloudprovider.NodeIPsKey.String()]
+	if len(clusterID) == 0 || len(cloudID) == 0 || len(masterIP) == 0 {
+		blog.Errorf("InitControlPlaneTask[%s]: check parameter validate failed", taskID)
+		retErr := fmt.Errorf("InitControlPlaneTask check parameters failed")
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	basicInfo, conf, err := getClusterSSHInfo(clusterID, cloudID)
+	if err != nil {
+		blog.Errorf("InitControlPlaneTask[%s]: get cluster %s ssh info failed: %v", taskID, clusterID, err)
+		retErr := fmt.Errorf("InitControlPlaneTask failed: %v", err)
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	// kubeadm init
+	kubeadmConfig, err := renderKubeadmConfig(basicInfo.Cluster, masterIP)
+	if err != nil {
+		blog.Errorf("InitControlPlaneTask[%s]: render kubeadm config failed: %v", taskID, err)
+		retErr := fmt.Errorf("InitControlPlaneTask failed: %v", err)
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	kubeConfig, err := api.RunHostScript(masterIP, conf, fmt.Sprintf(initScript, kubeadmConfig), initTimeout)
+	if err != nil {
+		blog.Errorf("InitControlPlaneTask[%s]: kubeadm init on master %s failed: %v", taskID, masterIP, err)
+		retErr := fmt.Errorf("InitControlPlaneTask kubeadm init failed: %v", err)
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	_ = state.AppendStepLog(stepName, fmt.Sprintf("control plane initialized on master %s", masterIP))
+
+	// save cluster kubeconfig & credential
+	err = saveClusterKubeConfig(basicInfo.Cluster, kubeConfig)
+	if err != nil {
+		blog.Errorf("InitControlPlaneTask[%s]: save cluster %s kubeconfig failed: %v", taskID, clusterID, err)
+		retErr := fmt.Errorf("InitControlPlaneTask save kubeconfig failed: %v", err)
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	// update step
+	if err := state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("InitControlPlaneTask[%s] task %s %s update to storage fatal", taskID, taskID, stepName)
+		return err
+	}
+	return nil
+}
+
+// saveClusterKubeConfig save admin kubeconfig to cluster and cluster credential
+func saveClusterKubeConfig(cls *proto.Cluster, kubeConfig string) error {
+	config, err := types.GetKubeConfigFromYAMLBody(false, types.YamlInput{
+		YamlContent: kubeConfig,
+	})
+	if err != nil {
+		return err
+	}
+	err = cloudprovider.UpdateClusterCredentialByConfig(cls.ClusterID, config)
+	if err != nil {
+		return err
+	}
+
+	cluster, err := cloudprovider.GetStorageModel().GetCluster(context.Background(), cls.ClusterID)
+	if err != nil {
+		return err
+	}
+	cluster.KubeConfig = base64.StdEncoding.EncodeToString([]byte(kubeConfig))
+	return cloudprovider.GetStorageModel().UpdateCluster(context.Background(), cluster)
+}
+
+// JoinMasterTask kubeadm join host to control plane
+func JoinMasterTask(taskID string, stepName string) error {
+	return joinHost(taskID, stepName, "JoinMasterTask", true)
+}
+
+// JoinNodesTask kubeadm join host as worker node
+func JoinNodesTask(taskID string, stepName string) error {
+	return joinHost(taskID, stepName, "JoinNodesTask", false)
+}
+
+// joinHost join host to cluster, join command is generated on init master when joining
+func joinHost(taskID string, stepName string, method string, controlPlane bool) error {
+	start := time.Now()
+
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		return nil
+	}
+
+	// extract parameter && check validate
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	cloudID := step.Params[cloudprovider.CloudIDKey.String()]
+	nodeIP := step.Params[cloudprovider.NodeIPsKey.String()]
+	initMaster := step.Params[InitMasterKey]
+	if len(clusterID) == 0 || len(cloudID) == 0 || len(nodeIP) == 0 || len(initMaster) == 0 {
+		blog.Errorf("%s[%s]: check parameter validate failed", method, taskID)
+		retErr := fmt.Errorf("%s check parameters failed", method)
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	_, conf, err := getClusterSSHInfo(clusterID, cloudID)
+	if err != nil {
+		blog.Errorf("%s[%s]: get cluster %s ssh info failed: %v", method, taskID, clusterID, err)
+		retErr := fmt.Errorf("%s failed: %v", method, err)
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	// kubeadm join
+	command, err := getJoinCommand(initMaster, nodeIP, conf, controlPlane)
+	if err == nil {
+		_, err = api.RunHostScript(nodeIP, conf, fmt.Sprintf(joinScript, command), joinTimeout)
+	}
+	if err != nil {
+		blog.Errorf("%s[%s]: host %s join cluster %s failed: %v", method, taskID, nodeIP, clusterID, err)
+		retErr := fmt.Errorf("%s host %s failed: %v", method, nodeIP, err)
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	_ = state.AppendStepLog(stepName, fmt.Sprintf("host %s joined cluster successfully", nodeIP))
+
+	// update step
+	if err := state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("%s[%s] task %s %s update to storage fatal", method, taskID, taskID, stepName)
+		return err
+	}
+	return nil
+}
+
+// UpdateCreateClusterDBInfoTask update cluster masters and save joined nodes to DB
+func UpdateCreateClusterDBInfoTask(taskID string, stepName string) error {
+	start := time.Now()
+
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		return nil
+	}
+
+	// step login started here
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	cloudID := step.Params[cloudprovider.CloudIDKey.String()]
+	basicInfo, err := cloudprovider.GetClusterDependBasicInfo(clusterID, cloudID, "")
+	if err != nil {
+		blog.Errorf("UpdateCreateClusterDBInfoTask[%s]: getClusterDependBasicInfo failed: %v", taskID, err)
+		retErr := fmt.Errorf("getClusterDependBasicInfo failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	cluster := basicInfo.Cluster
+	for _, master := range cluster.Master {
+		master.Status = icommon.StatusRunning
+	}
+	err = cloudprovider.GetStorageModel().UpdateCluster(context.Background(), cluster)
+	if err != nil {
+		blog.Errorf("UpdateCreateClusterDBInfoTask[%s]: update cluster %s failed: %v", taskID, clusterID, err)
+		retErr := fmt.Errorf("update cluster information failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	// save joined nodes
+	if nodeIPs := step.Params[cloudprovider.NodeIPsKey.String()]; len(nodeIPs) > 0 {
+		err = saveClusterNodes(clusterID, strings.Split(nodeIPs, ","), basicInfo.CmOption)
+		if err != nil {
+			blog.Errorf("UpdateCreateClusterDBInfoTask[%s]: save cluster %s nodes failed: %v", taskID, clusterID, err)
+			retErr := fmt.Errorf("save cluster nodes failed, %s", err.Error())
+			_ = state.UpdateStepFailure(start, stepName, retErr)
+			return retErr
+		}
+	}
+
+	// sync clusterData to pass-cc
+	utils.SyncClusterInfoToPassCC(taskID, cluster)
+
+	// update step
+	if err := state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("UpdateCreateClusterDBInfoTask[%s] task %s %s update to storage fatal", taskID, taskID, stepName)
+		return err
+	}
+	return nil
+}
+
+// saveClusterNodes create or update running nodes of cluster, node resources are probed by ssh
+func saveClusterNodes(clusterID string, nodeIPs []string, opt *cloudprovider.CommonOption) error {
+	nodeMgr := api.NodeManager{}
+	for _, ip := range nodeIPs {
+		node, err := nodeMgr.GetNodeByIP(ip, &cloudprovider.GetNodeOption{Common: opt})
+		if err != nil {
+			// node resources are not necessary
+			blog.Warnf("saveClusterNodes cluster %s probe node %s failed: %v", clusterID, ip, err)
+			node = &proto.Node{InnerIP: ip, Region: opt.Region}
+		}
+		node.ClusterID = clusterID
+		node.Status = icommon.StatusRunning
+
+		oldNode, err := cloudprovider.GetStorageModel().GetNodeByIP(context.Background(), ip)
+		if err != nil && !errors.Is(err, drivers.ErrTableRecordNotFound) {
+			return fmt.Errorf("get node %s failed: %v", ip, err)
+		}
+		if oldNode == nil {
+			err = cloudprovider.GetStorageModel().CreateNode(context.Background(), node)
+		} else {
+			oldNode.ClusterID = clusterID
+			oldNode.Status = icommon.StatusRunning
+			if node.CPU > 0 {
+				oldNode.CPU, oldNode.Mem = node.CPU, node.Mem
+			}
+			err = cloudprovider.GetStorageModel().UpdateNode(context.Background(), oldNode)
+		}
+		if err != nil {
+			return fmt.Errorf("save node %s failed: %v", ip, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,162 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/operator"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider/utils"
+	icommon "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/common"
+	storeopt "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/options"
+)
+
+// ResetClusterHostsTask kubeadm reset all nodes and masters of cluster, nodes are reset
+// before masters
+func ResetClusterHostsTask(taskID string, stepName string) error {
+	start := time.Now()
+
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		return nil
+	}
+
+	// extract parameter && check validate
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	cloudID := step.Params[cloudprovider.CloudIDKey.String()]
+	force, _ := strconv.ParseBool(step.Params[ForceKey])
+	if len(clusterID) == 0 || len(cloudID) == 0 {
+		blog.Errorf("ResetClusterHostsTask[%s]: check parameter validate failed", taskID)
+		retErr := fmt.Errorf("ResetClusterHostsTask check parameters failed")
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	basicInfo, conf, err := getClusterSSHInfo(clusterID, cloudID)
+	if err != nil {
+		blog.Errorf("ResetClusterHostsTask[%s]: get cluster %s ssh info failed: %v", taskID, clusterID, err)
+		retErr := fmt.Errorf("ResetClusterHostsTask failed: %v", err)
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	// list cluster hosts
+	cond := operator.NewLeafCondition(operator.Eq, operator.M{"clusterid": clusterID})
+	nodes, err := cloudprovider.GetStorageModel().ListNode(context.Background(), cond, &storeopt.ListOption{})
+	if err != nil {
+		blog.Errorf("ResetClusterHostsTask[%s]: list cluster %s nodes failed: %v", taskID, clusterID, err)
+		retErr := fmt.Errorf("ResetClusterHostsTask list nodes failed: %v", err)
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	hosts := make([]string, 0)
+	for _, node := range nodes {
+		if _, ok := basicInfo.Cluster.Master[node.InnerIP]; !ok {
+			hosts = append(hosts, node.InnerIP)
+		}
+	}
+	masterIPs := make([]string, 0)
+	for ip := range basicInfo.Cluster.Master {
+		masterIPs = append(masterIPs, ip)
+	}
+	sort.Strings(masterIPs)
+	hosts = append(hosts, masterIPs...)
+
+	// kubeadm reset hosts
+	warnings, err := resetHosts(hosts, conf, force)
+	for _, warning := range warnings {
+		_ = state.AppendStepLog(stepName, warning)
+	}
+	if err != nil {
+		blog.Errorf("ResetClusterHostsTask[%s]: reset cluster %s hosts failed: %v", taskID, clusterID, err)
+		retErr := fmt.Errorf("ResetClusterHostsTask failed: %v", err)
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	blog.Infof("ResetClusterHostsTask[%s]: reset cluster[%s] hosts %v successful", taskID, clusterID, hosts)
+
+	// update step
+	if err := state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("ResetClusterHostsTask[%s] task %s %s update to storage fatal", taskID, taskID, stepName)
+		return err
+	}
+	return nil
+}
+
+// CleanClusterDBInfoTask clean cluster DB info
+func CleanClusterDBInfoTask(taskID string, stepName string) error {
+	start := time.Now()
+
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		return nil
+	}
+
+	// step login started here
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	cluster, err := cloudprovider.GetStorageModel().GetCluster(context.Background(), clusterID)
+	if err != nil {
+		blog.Errorf("CleanClusterDBInfoTask[%s]: get cluster for %s failed", taskID, clusterID)
+		retErr := fmt.Errorf("get cluster information failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	// delete nodes
+	err = cloudprovider.GetStorageModel().DeleteNodesByClusterID(context.Background(), cluster.ClusterID)
+	if err != nil {
+		blog.Errorf("CleanClusterDBInfoTask[%s]: delete nodes for %s failed", taskID, clusterID)
+		retErr := fmt.Errorf("delete node for %s failed, %s", clusterID, err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	blog.Infof("CleanClusterDBInfoTask[%s]: delete nodes for cluster[%s] in DB successful", taskID, clusterID)
+
+	// delete cluster
+	cluster.Status = icommon.StatusDeleting
+	err = cloudprovider.GetStorageModel().UpdateCluster(context.Background(), cluster)
+	if err != nil {
+		blog.Errorf("CleanClusterDBInfoTask[%s]: delete cluster for %s failed", taskID, clusterID)
+		retErr := fmt.Errorf("delete cluster for %s failed, %s", clusterID, err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	blog.Infof("CleanClusterDBInfoTask[%s]: delete cluster[%s] in DB successful", taskID, clusterID)
+
+	// sync clean cluster dependency(pass-cc/token/credential)
+	utils.SyncDeletePassCCCluster(taskID, cluster)
+	_ = utils.DeleteBcsAgentToken(cluster)
+	_ = utils.DeleteClusterCredentialInfo(cluster.ClusterID)
+
+	if err := state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("CleanClusterDBInfoTask[%s]: task %s %s update to storage fatal", taskID, taskID, stepName)
+		return err
+	}
+	return nil
+}
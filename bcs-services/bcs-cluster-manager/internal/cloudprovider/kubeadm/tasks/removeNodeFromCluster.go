@@ -0,0 +1,169 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/clusterops"
+
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ResetNodesTask delete node objects from cluster, then kubeadm reset hosts
+func ResetNodesTask(taskID string, stepName string) error {
+	start := time.Now()
+
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		return nil
+	}
+
+	// extract parameter && check validate
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	cloudID := step.Params[cloudprovider.CloudIDKey.String()]
+	nodeIPs := step.Params[cloudprovider.NodeIPsKey.String()]
+	force, _ := strconv.ParseBool(step.Params[ForceKey])
+	if len(clusterID) == 0 || len(cloudID) == 0 || len(nodeIPs) == 0 {
+		blog.Errorf("ResetNodesTask[%s]: check parameter validate failed", taskID)
+		retErr := fmt.Errorf("ResetNodesTask check parameters failed")
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	basicInfo, conf, err := getClusterSSHInfo(clusterID, cloudID)
+	if err != nil {
+		blog.Errorf("ResetNodesTask[%s]: get cluster %s ssh info failed: %v", taskID, clusterID, err)
+		retErr := fmt.Errorf("ResetNodesTask failed: %v", err)
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	hosts := strings.Split(nodeIPs, ",")
+
+	// delete node objects, hosts are still reset when force
+	err = deleteClusterNodes(basicInfo.Cluster.KubeConfig, hosts)
+	if err != nil {
+		if !force {
+			blog.Errorf("ResetNodesTask[%s]: delete cluster %s nodes failed: %v", taskID, clusterID, err)
+			retErr := fmt.Errorf("ResetNodesTask delete nodes failed: %v", err)
+			_ = state.UpdateStepFailure(start, stepName, retErr)
+			return retErr
+		}
+		_ = state.AppendStepLog(stepName, fmt.Sprintf("delete nodes failed and skipped: %v", err))
+	}
+
+	// kubeadm reset hosts
+	warnings, err := resetHosts(hosts, conf, force)
+	for _, warning := range warnings {
+		_ = state.AppendStepLog(stepName, warning)
+	}
+	if err != nil {
+		blog.Errorf("ResetNodesTask[%s]: reset cluster %s nodes failed: %v", taskID, clusterID, err)
+		retErr := fmt.Errorf("ResetNodesTask failed: %v", err)
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	blog.Infof("ResetNodesTask[%s]: reset cluster[%s] nodes %v successful", taskID, clusterID, hosts)
+
+	// update step
+	if err := state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("ResetNodesTask[%s] task %s %s update to storage fatal", taskID, taskID, stepName)
+		return err
+	}
+	return nil
+}
+
+// deleteClusterNodes delete node objects of hosts, nodes which not found are skipped
+func deleteClusterNodes(kubeConfig string, hosts []string) error {
+	kubeCli, err := clusterops.NewKubeClient(kubeConfig)
+	if err != nil {
+		return err
+	}
+	nodeList, err := kubeCli.CoreV1().Nodes().List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	ips := make(map[string]struct{}, len(hosts))
+	for _, ip := range hosts {
+		ips[ip] = struct{}{}
+	}
+	for i := range nodeList.Items {
+		if _, ok := ips[getNodeIP(nodeList.Items[i])]; !ok {
+			continue
+		}
+		err = kubeCli.CoreV1().Nodes().Delete(context.Background(), nodeList.Items[i].Name, metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("delete node %s failed: %v", nodeList.Items[i].Name, err)
+		}
+	}
+	return nil
+}
+
+func getNodeIP(node v1.Node) string {
+	nodeIP := ""
+
+	for _, address := range node.Status.Addresses {
+		if address.Type == v1.NodeInternalIP {
+			nodeIP = address.Address
+		}
+	}
+
+	return nodeIP
+}
+
+// UpdateRemoveNodeDBInfoTask delete removed nodes from DB
+func UpdateRemoveNodeDBInfoTask(taskID string, stepName string) error {
+	start := time.Now()
+
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		return nil
+	}
+
+	nodeIPs := strings.Split(step.Params[cloudprovider.NodeIPsKey.String()], ",")
+	for _, ip := range nodeIPs {
+		if len(ip) == 0 {
+			continue
+		}
+		err = cloudprovider.GetStorageModel().DeleteNodeByIP(context.Background(), ip)
+		if err != nil {
+			blog.Errorf("UpdateRemoveNodeDBInfoTask[%s]: DeleteNodeByIP %s failed: %v", taskID, ip, err)
+		}
+	}
+
+	// update step
+	if err := state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("UpdateRemoveNodeDBInfoTask[%s] task %s %s update to storage fatal", taskID, taskID, stepName)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,235 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package tasks
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider/kubeadm/api"
+
+	"k8s.io/apimachinery/pkg/util/version"
+)
+
+const (
+	// InitMasterKey step param, master which inits control plane, join commands are generated on it
+	InitMasterKey = "initMaster"
+	// ForceKey step param, errors of resetting hosts are ignored when force is true
+	ForceKey = "force"
+	// ControlPlaneEndpointKey cluster extraInfo, load balancer address of apiservers for
+	// high availability, first master is used when it's empty
+	ControlPlaneEndpointKey = "controlPlaneEndpoint"
+)
+
+var (
+	// prepareTimeout timeout for preparing host
+	prepareTimeout = 8 * time.Minute
+	// initTimeout timeout for kubeadm init
+	initTimeout = 25 * time.Minute
+	// joinCommandTimeout timeout for generating join command
+	joinCommandTimeout = 2 * time.Minute
+	// joinTimeout timeout for kubeadm join
+	joinTimeout = 15 * time.Minute
+	// resetTimeout timeout for kubeadm reset one host
+	resetTimeout = 5 * time.Minute
+
+	// apiServerPort default apiserver port
+	apiServerPort = "6443"
+	// kubeadmV1beta3Version kubeadm config v1beta3 is supported since v1.22
+	kubeadmV1beta3Version = version.MustParseGeneric("1.22.0")
+)
+
+// prepareScript disable swap, load kernel modules and sysctl for kubernetes, then print
+// versions of kubeadm & kubelet installed on host
+var prepareScript = `set -e
+swapoff -a
+sed -ri '/\sswap\s/s/^#?/#/' /etc/fstab
+cat > /etc/modules-load.d/k8s.conf <<EOF
+overlay
+br_netfilter
+EOF
+modprobe overlay
+modprobe br_netfilter
+cat > /etc/sysctl.d/k8s.conf <<EOF
+net.bridge.bridge-nf-call-iptables = 1
+net.bridge.bridge-nf-call-ip6tables = 1
+net.ipv4.ip_forward = 1
+EOF
+sysctl --system >/dev/null
+systemctl enable kubelet >/dev/null 2>&1
+kubeadm version -o short
+kubelet --version | awk '{print $2}'`
+
+// initScript kubeadm init by config, host which is already initialized is skipped
+// thus step is retried safely, admin kubeconfig is printed
+var initScript = `set -e
+mkdir -p /etc/kubernetes
+cat > /etc/kubernetes/kubeadm-config.yaml <<'EOF'
+%s
+EOF
+if [ ! -f /etc/kubernetes/admin.conf ]; then
+  kubeadm init --config /etc/kubernetes/kubeadm-config.yaml --upload-certs >/var/log/kubeadm-init.log 2>&1 || \
+    { tail -n 20 /var/log/kubeadm-init.log; exit 1; }
+fi
+cat /etc/kubernetes/admin.conf`
+
+// joinCommandScript print join command with short-lived token, certificate key is
+// printed for joining control plane
+var joinCommandScript = `set -e
+kubeadm token create --ttl 30m --print-join-command 2>/dev/null
+%s`
+
+// uploadCertsScript upload control plane certs and print certificate key
+var uploadCertsScript = `kubeadm init phase upload-certs --upload-certs 2>/dev/null | tail -n 1`
+
+// joinScript kubeadm join, host which is already joined is skipped
+var joinScript = `set -e
+if [ ! -f /etc/kubernetes/kubelet.conf ]; then
+  %s >/var/log/kubeadm-join.log 2>&1 || { tail -n 20 /var/log/kubeadm-join.log; exit 1; }
+fi`
+
+// resetScript kubeadm reset host and clean cni config
+var resetScript = `set -e
+if command -v kubeadm >/dev/null 2>&1; then
+  kubeadm reset -f >/dev/null 2>&1
+fi
+rm -rf /etc/cni/net.d /etc/kubernetes/kubeadm-config.yaml`
+
+// getClusterSSHInfo get cluster depend info and ssh login info of cluster hosts, ssh login info
+// is loaded from cloud account when step running, it's never persisted in task
+func getClusterSSHInfo(clusterID, cloudID string) (*cloudprovider.CloudDependBasicInfo, *api.SSHConfig, error) {
+	basicInfo, err := cloudprovider.GetClusterDependBasicInfo(clusterID, cloudID, "")
+	if err != nil {
+		return nil, nil, fmt.Errorf("getClusterDependBasicInfo failed: %v", err)
+	}
+	conf, err := api.GetSSHConfig(basicInfo.CmOption)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get ssh config of cluster %s failed: %v", clusterID, err)
+	}
+	return basicInfo, conf, nil
+}
+
+// checkHostVersion check kubeadm & kubelet versions printed by prepareScript match cluster version
+func checkHostVersion(output string, clusterVersion string) error {
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	if len(lines) < 2 {
+		return fmt.Errorf("unexpected output: %s", output)
+	}
+	expect := "v" + strings.TrimPrefix(clusterVersion, "v")
+	kubeadmVersion := strings.TrimSpace(lines[len(lines)-2])
+	kubeletVersion := strings.TrimSpace(lines[len(lines)-1])
+	if kubeadmVersion != expect || kubeletVersion != expect {
+		return fmt.Errorf("kubeadm version %s or kubelet version %s not match cluster version %s",
+			kubeadmVersion, kubeletVersion, expect)
+	}
+	return nil
+}
+
+// getControlPlaneEndpoint get apiserver endpoint of cluster
+func getControlPlaneEndpoint(cls *proto.Cluster, initMaster string) string {
+	if endpoint := cls.ExtraInfo[ControlPlaneEndpointKey]; endpoint != "" {
+		return endpoint
+	}
+	return net.JoinHostPort(initMaster, apiServerPort)
+}
+
+// renderKubeadmConfig render kubeadm init config by cluster version & network settings
+func renderKubeadmConfig(cls *proto.Cluster, initMaster string) (string, error) {
+	clusterVersion := strings.TrimPrefix(cls.GetClusterBasicSettings().GetVersion(), "v")
+	ver, err := version.ParseGeneric(clusterVersion)
+	if err != nil {
+		return "", fmt.Errorf("parse cluster version %s failed: %v", clusterVersion, err)
+	}
+	apiVersion := "kubeadm.k8s.io/v1beta2"
+	if ver.AtLeast(kubeadmV1beta3Version) {
+		apiVersion = "kubeadm.k8s.io/v1beta3"
+	}
+
+	lines := []string{
+		"apiVersion: " + apiVersion,
+		"kind: InitConfiguration",
+		"localAPIEndpoint:",
+		fmt.Sprintf("  advertiseAddress: %q", initMaster),
+		"---",
+		"apiVersion: " + apiVersion,
+		"kind: ClusterConfiguration",
+		fmt.Sprintf("kubernetesVersion: v%s", clusterVersion),
+		fmt.Sprintf("controlPlaneEndpoint: %q", getControlPlaneEndpoint(cls, initMaster)),
+	}
+	podCIDR := cls.GetNetworkSettings().GetClusterIPv4CIDR()
+	serviceCIDR := cls.GetNetworkSettings().GetServiceIPv4CIDR()
+	if podCIDR != "" || serviceCIDR != "" {
+		lines = append(lines, "networking:")
+	}
+	if podCIDR != "" {
+		lines = append(lines, fmt.Sprintf("  podSubnet: %q", podCIDR))
+	}
+	if serviceCIDR != "" {
+		lines = append(lines, fmt.Sprintf("  serviceSubnet: %q", serviceCIDR))
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
+
+// getJoinCommand generate join command on init master, the command contains bootstrap token
+// thus it's generated when joining and never persisted
+func getJoinCommand(initMaster, nodeIP string, conf *api.SSHConfig, controlPlane bool) (string, error) {
+	uploadCerts := ""
+	if controlPlane {
+		uploadCerts = uploadCertsScript
+	}
+	output, err := api.RunHostScript(initMaster, conf, fmt.Sprintf(joinCommandScript, uploadCerts),
+		joinCommandTimeout)
+	if err != nil {
+		return "", fmt.Errorf("generate join command on master %s failed: %v", initMaster, err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	command := strings.TrimSpace(lines[0])
+	if !strings.HasPrefix(command, "kubeadm join ") {
+		return "", fmt.Errorf("generate join command on master %s failed: unexpected output", initMaster)
+	}
+	if !controlPlane {
+		return command, nil
+	}
+
+	if len(lines) != 2 || strings.TrimSpace(lines[1]) == "" {
+		return "", fmt.Errorf("upload certs on master %s failed: certificate key not found", initMaster)
+	}
+	return fmt.Sprintf("%s --control-plane --certificate-key %s --apiserver-advertise-address %s",
+		command, strings.TrimSpace(lines[1]), nodeIP), nil
+}
+
+// resetHosts kubeadm reset hosts one by one, errors are ignored and returned as warnings when force.
+// pinned ssh keys of hosts are forgotten since hosts leave cluster
+func resetHosts(hosts []string, conf *api.SSHConfig, force bool) ([]string, error) {
+	warnings := make([]string, 0)
+	for _, ip := range hosts {
+		_, err := api.RunHostScript(ip, conf, resetScript, resetTimeout)
+		if err != nil && !force {
+			return warnings, err
+		}
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("reset host %s failed and skipped: %v", ip, err))
+		}
+		if err := api.ForgetHostKey(ip, conf); err != nil {
+			warnings = append(warnings, err.Error())
+		}
+	}
+	return warnings, nil
+}
@@ -0,0 +1,70 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package tasks
+
+import (
+	"strings"
+	"testing"
+
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+)
+
+func TestCheckHostVersion(t *testing.T) {
+	output := "some warnings\nv1.20.6\nv1.20.6"
+	if err := checkHostVersion(output, "1.20.6"); err != nil {
+		t.Fatal(err)
+	}
+	if err := checkHostVersion(output, "v1.20.6"); err != nil {
+		t.Fatal(err)
+	}
+	if err := checkHostVersion("v1.20.6\nv1.18.4", "1.20.6"); err == nil {
+		t.Fatal("kubelet version mismatch should be failed")
+	}
+	if err := checkHostVersion("v1.20.6", "1.20.6"); err == nil {
+		t.Fatal("incomplete output should be failed")
+	}
+}
+
+func TestRenderKubeadmConfig(t *testing.T) {
+	cls := &proto.Cluster{
+		ClusterBasicSettings: &proto.ClusterBasicSetting{Version: "1.20.6"},
+		NetworkSettings:      &proto.NetworkSetting{ClusterIPv4CIDR: "172.16.0.0/16"},
+	}
+	config, err := renderKubeadmConfig(cls, "10.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expect := range []string{"kubeadm.k8s.io/v1beta2", `advertiseAddress: "10.0.0.1"`,
+		"kubernetesVersion: v1.20.6", `controlPlaneEndpoint: "10.0.0.1:6443"`, `podSubnet: "172.16.0.0/16"`} {
+		if !strings.Contains(config, expect) {
+			t.Fatalf("config %s not contains %s", config, expect)
+		}
+	}
+	if strings.Contains(config, "serviceSubnet") {
+		t.Fatalf("config %s should not contain serviceSubnet", config)
+	}
+
+	cls.ClusterBasicSettings.Version = "v1.22.5"
+	cls.NetworkSettings = nil
+	cls.ExtraInfo = map[string]string{ControlPlaneEndpointKey: "lb.example.com:6443"}
+	config, err = renderKubeadmConfig(cls, "10.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(config, "kubeadm.k8s.io/v1beta3") ||
+		!strings.Contains(config, `controlPlaneEndpoint: "lb.example.com:6443"`) ||
+		strings.Contains(config, "networking:") {
+		t.Fatalf("unexpected config %s", config)
+	}
+}
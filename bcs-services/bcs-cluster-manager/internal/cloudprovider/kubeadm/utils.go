@@ -0,0 +1,56 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+// Package kubeadm bare-metal cloudprovider, clusters are bootstrapped by kubeadm on hosts which
+// are accessed by ssh. ssh login info is stored in cloud account: SecretID is ssh user with
+// optional port(user[:port]), SecretKey is PEM private key or password of user
+package kubeadm
+
+import (
+	"fmt"
+)
+
+var (
+	cloudName = "kubeadm"
+)
+
+const (
+	// createClusterTaskTemplate create cluster task template
+	createClusterTaskTemplate = "kubeadm-create cluster: %s"
+	// deleteClusterTaskTemplate delete cluster task template
+	deleteClusterTaskTemplate = "kubeadm-delete cluster: %s"
+	// addClusterNodesTaskTemplate add clusterNodes task template
+	addClusterNodesTaskTemplate = "kubeadm-add nodes: %s"
+	// deleteClusterNodesTaskTemplate delete clusterNodes task template
+	deleteClusterNodesTaskTemplate = "kubeadm-remove nodes: %s"
+)
+
+var (
+	// create cluster task
+	prepareNodesTask              = fmt.Sprintf("%s-PrepareNodesTask", cloudName)
+	initControlPlaneTask          = fmt.Sprintf("%s-InitControlPlaneTask", cloudName)
+	joinMasterTask                = fmt.Sprintf("%s-JoinMasterTask", cloudName)
+	joinNodesTask                 = fmt.Sprintf("%s-JoinNodesTask", cloudName)
+	updateCreateClusterDBInfoTask = fmt.Sprintf("%s-UpdateCreateClusterDBInfoTask", cloudName)
+
+	// delete cluster task
+	resetClusterHostsTask  = fmt.Sprintf("%s-ResetClusterHostsTask", cloudName)
+	cleanClusterDBInfoTask = fmt.Sprintf("%s-CleanClusterDBInfoTask", cloudName)
+
+	// add node to cluster
+	updateAddNodeDBInfoTask = fmt.Sprintf("%s-UpdateAddNodeDBInfoTask", cloudName)
+
+	// remove node from cluster
+	resetNodesTask             = fmt.Sprintf("%s-ResetNodesTask", cloudName)
+	updateRemoveNodeDBInfoTask = fmt.Sprintf("%s-UpdateRemoveNodeDBInfoTask", cloudName)
+)
@@ -0,0 +1,128 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package kubeadm
+
+import (
+	"fmt"
+	"net"
+	"sync"
+
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider/kubeadm/api"
+)
+
+var validateMgr sync.Once
+
+func init() {
+	validateMgr.Do(func() {
+		//init Cluster
+		cloudprovider.InitCloudValidateManager(cloudName, &CloudValidate{})
+	})
+}
+
+// CloudValidate kubeadm cloud validate management implementation
+type CloudValidate struct {
+}
+
+// ImportClusterValidate check importCluster operation
+func (c *CloudValidate) ImportClusterValidate(req *proto.ImportClusterReq, opt *cloudprovider.CommonOption) error {
+	return cloudprovider.ErrCloudNotImplemented
+}
+
+// ImportCloudAccountValidate check ssh login info of cloud account
+func (c *CloudValidate) ImportCloudAccountValidate(account *proto.Account) error {
+	if c == nil || account == nil {
+		return fmt.Errorf("%s ImportCloudAccountValidate request is empty", cloudName)
+	}
+
+	_, err := api.GetSSHConfig(&cloudprovider.CommonOption{
+		Key:    account.SecretID,
+		Secret: account.SecretKey,
+	})
+	if err != nil {
+		return fmt.Errorf("%s ImportCloudAccountValidate failed: %v", cloudName, err)
+	}
+
+	return nil
+}
+
+// GetCloudRegionZonesValidate xxx
+func (c *CloudValidate) GetCloudRegionZonesValidate(req *proto.GetCloudRegionZonesRequest, account *proto.Account) error {
+	// bare-metal hosts have no region
+	return nil
+}
+
+// ListCloudRegionClusterValidate xxx
+func (c *CloudValidate) ListCloudRegionClusterValidate(req *proto.ListCloudRegionClusterRequest, account *proto.Account) error {
+	// bare-metal hosts have no region
+	return nil
+}
+
+// ListCloudSubnetsValidate xxx
+func (c *CloudValidate) ListCloudSubnetsValidate(req *proto.ListCloudSubnetsRequest, account *proto.Account) error {
+	return nil
+}
+
+// ListSecurityGroupsValidate xxx
+func (c *CloudValidate) ListSecurityGroupsValidate(req *proto.ListCloudSecurityGroupsRequest, account *proto.Account) error {
+	return nil
+}
+
+// ListInstanceTypeValidate xxx
+func (c *CloudValidate) ListInstanceTypeValidate(req *proto.ListCloudInstanceTypeRequest, account *proto.Account) error {
+	return nil
+}
+
+// ListCloudOsImageValidate xxx
+func (c *CloudValidate) ListCloudOsImageValidate(req *proto.ListCloudOsImageRequest, account *proto.Account) error {
+	return nil
+}
+
+// CreateClusterValidate check createCluster operation, kubeadm cluster is created by master & node IPs
+// and cluster version is required by kubeadm
+func (c *CloudValidate) CreateClusterValidate(req *proto.CreateClusterReq, opt *cloudprovider.CommonOption) error {
+	if c == nil || req == nil {
+		return fmt.Errorf("%s CreateClusterValidate request is empty", cloudName)
+	}
+
+	if len(req.Master) == 0 {
+		return fmt.Errorf("%s CreateClusterValidate master is empty", cloudName)
+	}
+	if len(req.CloudAccountID) == 0 {
+		return fmt.Errorf("%s CreateClusterValidate cloudAccountID is empty", cloudName)
+	}
+	if len(req.GetClusterBasicSettings().GetVersion()) == 0 {
+		return fmt.Errorf("%s CreateClusterValidate cluster version is empty", cloudName)
+	}
+
+	hosts := make(map[string]struct{})
+	for _, ip := range append(append([]string{}, req.Master...), req.Nodes...) {
+		if net.ParseIP(ip) == nil {
+			return fmt.Errorf("%s CreateClusterValidate invalid IP[%s]", cloudName, ip)
+		}
+		if _, ok := hosts[ip]; ok {
+			return fmt.Errorf("%s CreateClusterValidate IP[%s] duplicated", cloudName, ip)
+		}
+		hosts[ip] = struct{}{}
+	}
+
+	return nil
+}
+
+// CreateNodeGroupValidate check createNodeGroup operation
+func (c *CloudValidate) CreateNodeGroupValidate(req *proto.CreateNodeGroupRequest,
+	opt *cloudprovider.CommonOption) error {
+	return cloudprovider.ErrCloudNotImplemented
+}
@@ -68,6 +68,8 @@ const (
 	TaskStatusForceTerminate = "FORCETERMINATE"
 	// TaskStatusNotStarted force task terminate
 	TaskStatusNotStarted = "NOTSTARTED"
+	// TaskStatusSkip step skipped by user
+	TaskStatusSkip = "SKIP"
 )
 
 // CommonOption for all option
@@ -151,6 +153,8 @@ type CreateClusterOption struct {
 	Operator     string
 	// cloud is used for cloudprovider template
 	Cloud *proto.Cloud
+	// Nodes node IPs which join cluster when cluster is created
+	Nodes []string
 }
 
 // ImportClusterOption import cluster option
@@ -236,6 +240,8 @@ type DeleteNodesOption struct {
 	DeleteMode string
 	// cloud is used for cloudprovider template
 	Cloud *proto.Cloud
+	// Drain drain nodes before deleting, use default option when empty
+	Drain *proto.DrainOption
 }
 
 // CreateNodeGroupOption create nodegroup option
@@ -280,6 +286,8 @@ type CleanNodesOption struct {
 	Cluster  *proto.Cluster
 	Cloud    *proto.Cloud
 	Operator string
+	// Drain drain nodes before cleaning, use default option when empty
+	Drain *proto.DrainOption
 }
 
 //CleanNodesResponse response for clean nodes in NodeGroup
@@ -299,6 +307,32 @@ type UpdateDesiredNodeOption struct {
 	Operator  string
 }
 
+// UpgradeClusterOption upgrade cluster kubernetes version
+type UpgradeClusterOption struct {
+	CommonOption
+	Cloud *proto.Cloud
+	// Version target kubernetes version
+	Version string
+	// MaxUnavailable max nodes cordoned and replaced at the same time in one nodegroup, nodes are
+	// upgraded in place and no extra node is added, capacity of nodegroup is reduced during upgrade
+	MaxUnavailable uint32
+	// NodeGroups nodegroups for rolling upgrade after control plane upgraded
+	NodeGroups []*proto.NodeGroup
+	// GroupNodes nodes of each nodegroup, key is nodeGroupID
+	GroupNodes map[string][]*proto.Node
+	Operator   string
+}
+
+// UpgradeNodeGroupOption rolling upgrade nodegroup nodes to cluster version
+type UpgradeNodeGroupOption struct {
+	CommonOption
+	Cluster        *proto.Cluster
+	Cloud          *proto.Cloud
+	Nodes          []*proto.Node
+	MaxUnavailable uint32
+	Operator       string
+}
+
 // SwitchNodeGroupAutoScalingOption switch nodegroup auto scaling
 type SwitchNodeGroupAutoScalingOption struct {
 	CommonOption
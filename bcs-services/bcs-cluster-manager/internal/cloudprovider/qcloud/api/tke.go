@@ -210,6 +210,38 @@ func (cli *TkeClient) DeleteTKECluster(clusterID string, deleteMode DeleteMode)
 	return nil
 }
 
+// UpdateClusterVersion upgrade tke cluster master to dstVersion, master upgrade run in background
+func (cli *TkeClient) UpdateClusterVersion(clusterID string, dstVersion string) error {
+	if cli == nil {
+		return cloudprovider.ErrServerIsNil
+	}
+
+	if len(clusterID) == 0 || len(dstVersion) == 0 {
+		return fmt.Errorf("UpdateClusterVersion failed: clusterID or dstVersion is empty")
+	}
+
+	req := tke.NewUpdateClusterVersionRequest()
+	req.ClusterId = common.StringPtr(clusterID)
+	req.DstVersion = common.StringPtr(dstVersion)
+
+	resp, err := cli.tke.UpdateClusterVersion(req)
+	if err != nil {
+		blog.Errorf("UpdateClusterVersion client UpdateClusterVersion[%s] failed: %v", clusterID, err)
+		return err
+	}
+
+	// check response
+	response := resp.Response
+	if response == nil {
+		blog.Errorf("UpdateClusterVersion client UpdateClusterVersion[%s] but lost response information", clusterID)
+		return cloudprovider.ErrCloudLostResponse
+	}
+	blog.Infof("RequestId[%s] tke client UpdateClusterVersion[%s] to %s response successful",
+		*response.RequestId, clusterID, dstVersion)
+
+	return nil
+}
+
 // TKE node relative interface
 
 // QueryTkeClusterAllInstances query all cluster instances
@@ -403,6 +435,42 @@ func (cli *TkeClient) AddExistedInstancesToCluster(addReq *AddExistedInstanceReq
 	return result, nil
 }
 
+// UpgradeClusterInstances reset cluster instances to upgrade kubernetes version to cluster master version,
+// instance will be reinstalled and data disk is not retained
+func (cli *TkeClient) UpgradeClusterInstances(clusterID string, instanceIDs []string) error {
+	if cli == nil {
+		return cloudprovider.ErrServerIsNil
+	}
+
+	if len(clusterID) == 0 || len(instanceIDs) == 0 {
+		return fmt.Errorf("UpgradeClusterInstances failed: clusterID or instanceIDs is empty")
+	}
+
+	req := tke.NewUpgradeClusterInstancesRequest()
+	req.ClusterId = common.StringPtr(clusterID)
+	req.Operation = common.StringPtr(UpgradeOperationCreate.String())
+	req.UpgradeType = common.StringPtr(UpgradeTypeReset.String())
+	req.InstanceIds = common.StringPtrs(instanceIDs)
+
+	resp, err := cli.tke.UpgradeClusterInstances(req)
+	if err != nil {
+		blog.Errorf("UpgradeClusterInstances client UpgradeClusterInstances[%s] failed: %v", clusterID, err)
+		return err
+	}
+
+	// check response
+	response := resp.Response
+	if response == nil {
+		blog.Errorf("UpgradeClusterInstances client UpgradeClusterInstances[%s] but lost response information",
+			clusterID)
+		return cloudprovider.ErrCloudLostResponse
+	}
+	blog.Infof("RequestId[%s] tke client UpgradeClusterInstances[%s] instances %v response successful",
+		*response.RequestId, clusterID, instanceIDs)
+
+	return nil
+}
+
 // TKE network relative interface
 
 // EnableTKEVpcCniMode enable vpc-cni plugin
@@ -215,6 +215,36 @@ func (dm DeleteMode) String() string {
 	return string(dm)
 }
 
+// UpgradeOperation cluster instances upgrade operation
+type UpgradeOperation string
+
+var (
+	// UpgradeOperationCreate create upgrade job
+	UpgradeOperationCreate UpgradeOperation = "create"
+	// UpgradeOperationAbort abort upgrade job
+	UpgradeOperationAbort UpgradeOperation = "abort"
+)
+
+// String to string
+func (uo UpgradeOperation) String() string {
+	return string(uo)
+}
+
+// UpgradeType cluster instances upgrade type
+type UpgradeType string
+
+var (
+	// UpgradeTypeReset reinstall instance with cluster master version
+	UpgradeTypeReset UpgradeType = "reset"
+	// UpgradeTypeHot only upgrade kubelet/kube-proxy in place
+	UpgradeTypeHot UpgradeType = "hot"
+)
+
+// String to string
+func (ut UpgradeType) String() string {
+	return string(ut)
+}
+
 // Versions cluster k8s version
 type Versions struct {
 	Name    string
@@ -149,14 +149,21 @@ func clusterAdvancedSettingByQCloud(cls *cmproto.Cluster, cluster *tke.Cluster)
 }
 
 func clusterBasicSettingByQCloud(cls *cmproto.Cluster, cluster *tke.Cluster) {
-	cls.ClusterBasicSettings = &cmproto.ClusterBasicSetting{
-		OS:          *cluster.ClusterOs,
-		Version:     *cluster.ClusterVersion,
-		VersionName: *cluster.ClusterVersion,
+	cls.ClusterBasicSettings = &cmproto.ClusterBasicSetting{}
+	if cluster.ClusterOs != nil {
+		cls.ClusterBasicSettings.OS = *cluster.ClusterOs
+	}
+	if cluster.ClusterVersion != nil {
+		cls.ClusterBasicSettings.Version = *cluster.ClusterVersion
+		cls.ClusterBasicSettings.VersionName = *cluster.ClusterVersion
 	}
 }
 
 func clusterNetworkSettingByQCloud(cls *cmproto.Cluster, cluster *tke.Cluster) error {
+	if cluster.Property == nil || cluster.ClusterNetworkSettings == nil ||
+		cluster.ClusterNetworkSettings.ClusterCIDR == nil {
+		return fmt.Errorf("cluster[%s] network setting is empty", cls.SystemID)
+	}
 	property := *cluster.Property
 	propertyInfo := make(map[string]interface{})
 	err := json.Unmarshal([]byte(property), &propertyInfo)
@@ -178,11 +185,15 @@ func clusterNetworkSettingByQCloud(cls *cmproto.Cluster, cluster *tke.Cluster) e
 
 	cls.NetworkSettings = &cmproto.NetworkSetting{
 		ClusterIPv4CIDR:  masterCIDR,
-		MaxNodePodNum:    uint32(*cluster.ClusterNetworkSettings.MaxNodePodNum),
-		MaxServiceNum:    uint32(*cluster.ClusterNetworkSettings.MaxClusterServiceNum),
 		MultiClusterCIDR: multiCIDRList,
 		CidrStep:         step,
 	}
+	if cluster.ClusterNetworkSettings.MaxNodePodNum != nil {
+		cls.NetworkSettings.MaxNodePodNum = uint32(*cluster.ClusterNetworkSettings.MaxNodePodNum)
+	}
+	if cluster.ClusterNetworkSettings.MaxClusterServiceNum != nil {
+		cls.NetworkSettings.MaxServiceNum = uint32(*cluster.ClusterNetworkSettings.MaxClusterServiceNum)
+	}
 
 	return nil
 }
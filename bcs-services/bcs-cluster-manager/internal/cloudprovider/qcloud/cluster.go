@@ -144,9 +144,43 @@ func (c *Cluster) DeleteCluster(cls *proto.Cluster, opt *cloudprovider.DeleteClu
 
 // GetCluster get kubernetes cluster detail information according cloudprovider
 func (c *Cluster) GetCluster(cloudID string, opt *cloudprovider.GetClusterOption) (*proto.Cluster, error) {
-	//qcloud.GetClusterClient(opt)
+	if opt == nil || len(opt.Key) == 0 || len(opt.Secret) == 0 || len(opt.Region) == 0 {
+		return nil, fmt.Errorf("qcloud GetCluster cluster lost operation")
+	}
 
-	return nil, cloudprovider.ErrCloudNotImplemented
+	cli, err := api.NewTkeClient(&opt.CommonOption)
+	if err != nil {
+		return nil, err
+	}
+	tkeCluster, err := cli.GetTKECluster(cloudID)
+	if err != nil {
+		return nil, err
+	}
+
+	if tkeCluster == nil || tkeCluster.ClusterId == nil {
+		return nil, fmt.Errorf("qcloud GetCluster[%s] cluster not found", cloudID)
+	}
+
+	cls := &proto.Cluster{
+		SystemID: *tkeCluster.ClusterId,
+		Region:   opt.Region,
+	}
+	if tkeCluster.ClusterName != nil {
+		cls.ClusterName = *tkeCluster.ClusterName
+	}
+	if tkeCluster.ClusterNetworkSettings != nil && tkeCluster.ClusterNetworkSettings.VpcId != nil {
+		cls.VpcID = *tkeCluster.ClusterNetworkSettings.VpcId
+	}
+	if tkeCluster.ClusterStatus != nil {
+		cls.Status = *tkeCluster.ClusterStatus
+	}
+	clusterBasicSettingByQCloud(cls, tkeCluster)
+	err = clusterNetworkSettingByQCloud(cls, tkeCluster)
+	if err != nil {
+		blog.Errorf("qcloud GetCluster[%s] clusterNetworkSettingByQCloud failed: %v", cloudID, err)
+	}
+
+	return cls, nil
 }
 
 // GetNodesInCluster get all nodes belong to cluster according cloudprovider
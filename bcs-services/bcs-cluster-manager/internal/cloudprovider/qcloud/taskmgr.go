@@ -97,6 +97,10 @@ func newtask() *Task {
 
 	// move nodes to nodeGroup task
 
+	// upgrade cluster & nodeGroup task
+	task.works[upgradeTKEClusterMasterTask] = tasks.UpgradeTKEClusterMasterTask
+	task.works[upgradeTKEClusterNodesTask] = tasks.UpgradeTKEClusterNodesTask
+
 	return task
 }
 
@@ -115,15 +119,30 @@ func (t *Task) GetAllTask() map[string]interface{} {
 	return t.works
 }
 
+var (
+	// callCloudStepOption steps calling tke api which creates resources are not retried
+	callCloudStepOption = cloudprovider.StepOption{Timeout: 600}
+	// waitClusterStepOption steps waiting for cluster ready at most 30 minutes
+	waitClusterStepOption = cloudprovider.StepOption{Timeout: 2100, MaxRetry: 1, RetryInterval: 30}
+	// waitNodesStepOption steps waiting for nodes ready at most 20 minutes
+	waitNodesStepOption = cloudprovider.StepOption{Timeout: 1500, MaxRetry: 1, RetryInterval: 30}
+	// updateDBStepOption steps updating DB info
+	updateDBStepOption = cloudprovider.StepOption{Timeout: 300, MaxRetry: 2, RetryInterval: 10}
+	// bksopsStepOption bk-sops task waits for result at most 30 minutes, it's not retried
+	// because a new bk-sops task is created when retrying
+	bksopsStepOption = cloudprovider.StepOption{Timeout: 2100}
+)
+
 // BuildCreateClusterTask build create cluster task
 func (t *Task) BuildCreateClusterTask(cls *proto.Cluster, opt *cloudprovider.CreateClusterOption) (*proto.Task, error) {
-	// create cluster currently only has three steps:
+	// create cluster steps are organized as DAG:
 	// 0. check if need to generate master instance. you need to call cvm api to produce master instance if necessary.
 	//    but we only support add existed instance to cluster as master currently.
 	// 1. call qcloud CreateTKECluster to create tke cluster
 	// 2. call GetTKECluster to check cluster run status(cluster status: Running Creating Abnormal))
-	// 3. update cluster DB info when create cluster successful
-	// may be need to call external previous or behind operation by bkops
+	// 3. enable vpc-cni and update cluster DB info concurrently when cluster is running
+	// 4. bkops post actions(e.g. install addons) run one by one when vpc-cni enabled, they are
+	//    running concurrently with updating DB info
 
 	// validate request params
 	if cls == nil {
@@ -173,6 +192,7 @@ func (t *Task) BuildCreateClusterTask(cls *proto.Cluster, opt *cloudprovider.Cre
 	}
 	createStep.Params["ClusterID"] = cls.ClusterID
 	createStep.Params["CloudID"] = cls.Provider
+	cloudprovider.SetStepOption(createStep, callCloudStepOption)
 
 	task.Steps[createTKEClusterTask] = createStep
 	task.StepSequence = append(task.StepSequence, createTKEClusterTask)
@@ -189,6 +209,7 @@ func (t *Task) BuildCreateClusterTask(cls *proto.Cluster, opt *cloudprovider.Cre
 	}
 	checkStep.Params["ClusterID"] = cls.ClusterID
 	checkStep.Params["CloudID"] = cls.Provider
+	cloudprovider.SetStepOption(checkStep, waitClusterStepOption.WithDependsOn(createTKEClusterTask))
 
 	task.Steps[checkTKEClusterStatusTask] = checkStep
 	task.StepSequence = append(task.StepSequence, checkTKEClusterStatusTask)
@@ -205,6 +226,7 @@ func (t *Task) BuildCreateClusterTask(cls *proto.Cluster, opt *cloudprovider.Cre
 	}
 	enableVpcCniStep.Params["ClusterID"] = cls.ClusterID
 	enableVpcCniStep.Params["CloudID"] = cls.Provider
+	cloudprovider.SetStepOption(enableVpcCniStep, waitClusterStepOption.WithDependsOn(checkTKEClusterStatusTask))
 
 	task.Steps[enableTkeClusterVpcCniTask] = enableVpcCniStep
 	task.StepSequence = append(task.StepSequence, enableTkeClusterVpcCniTask)
@@ -221,6 +243,7 @@ func (t *Task) BuildCreateClusterTask(cls *proto.Cluster, opt *cloudprovider.Cre
 	}
 	updateStep.Params["ClusterID"] = cls.ClusterID
 	updateStep.Params["CloudID"] = cls.Provider
+	cloudprovider.SetStepOption(updateStep, updateDBStepOption.WithDependsOn(checkTKEClusterStatusTask))
 
 	task.Steps[updateCreateClusterDBInfoTask] = updateStep
 	task.StepSequence = append(task.StepSequence, updateCreateClusterDBInfoTask)
@@ -228,6 +251,7 @@ func (t *Task) BuildCreateClusterTask(cls *proto.Cluster, opt *cloudprovider.Cre
 	// run bk-sops when need to postActions
 	if opt.Cloud != nil && opt.Cloud.ClusterManagement != nil && opt.Cloud.ClusterManagement.CreateCluster != nil {
 		action := opt.Cloud.ClusterManagement.CreateCluster
+		lastStep := enableTkeClusterVpcCniTask
 		for i := range action.PostActions {
 			plugin, ok := action.Plugins[action.PostActions[i]]
 			if ok {
@@ -239,8 +263,10 @@ func (t *Task) BuildCreateClusterTask(cls *proto.Cluster, opt *cloudprovider.Cre
 				if err != nil {
 					return nil, fmt.Errorf("BuildCreateClusterTask task failed: %v", err)
 				}
+				cloudprovider.SetStepOption(step, bksopsStepOption.WithDependsOn(lastStep))
 				task.Steps[stepName] = step
 				task.StepSequence = append(task.StepSequence, stepName)
+				lastStep = stepName
 			}
 		}
 	}
@@ -444,11 +470,12 @@ func (t *Task) BuildDeleteClusterTask(cls *proto.Cluster, opt *cloudprovider.Del
 
 // BuildAddNodesToClusterTask build addNodes task
 func (t *Task) BuildAddNodesToClusterTask(cls *proto.Cluster, nodes []*proto.Node, opt *cloudprovider.AddNodesOption) (*proto.Task, error) {
-	// addNodesToCluster has three steps:
+	// addNodesToCluster steps are organized as DAG:
 	// 1. call qcloud AddExistedInstancesToCluster to add node
 	// 2. call qcloud QueryTkeClusterInstances to check instance status(running initializing failed))
-	// 3. update node DB info when add node successful
-	// may be need to call external previous or behind operation by bkops
+	// 3. update node DB info when nodes are running
+	// 4. bkops post actions run one by one when nodes are running, they are running concurrently
+	//    with updating DB info
 
 	// validate request params
 	if cls == nil {
@@ -515,6 +542,7 @@ func (t *Task) BuildAddNodesToClusterTask(cls *proto.Cluster, nodes []*proto.Nod
 	addStep.Params["InitPasswd"] = opt.InitPassword
 	addStep.Params["NodeIPs"] = strings.Join(nodeIPs, ",")
 	addStep.Params["NodeIDs"] = strings.Join(nodeIDs, ",")
+	cloudprovider.SetStepOption(addStep, callCloudStepOption)
 
 	task.Steps[addNodesToClusterTask] = addStep
 	task.StepSequence = append(task.StepSequence, addNodesToClusterTask)
@@ -534,6 +562,7 @@ func (t *Task) BuildAddNodesToClusterTask(cls *proto.Cluster, nodes []*proto.Nod
 	checkStep.Params["NodeGroupID"] = opt.NodeGroupID
 	checkStep.Params["NodeIPs"] = strings.Join(nodeIPs, ",")
 	checkStep.Params["NodeIDs"] = strings.Join(nodeIDs, ",")
+	cloudprovider.SetStepOption(checkStep, waitNodesStepOption.WithDependsOn(addNodesToClusterTask))
 
 	task.Steps[checkAddNodesStatusTask] = checkStep
 	task.StepSequence = append(task.StepSequence, checkAddNodesStatusTask)
@@ -552,6 +581,7 @@ func (t *Task) BuildAddNodesToClusterTask(cls *proto.Cluster, nodes []*proto.Nod
 	updateStep.Params["CloudID"] = opt.Cloud.CloudID
 	updateStep.Params["NodeIPs"] = strings.Join(nodeIPs, ",")
 	updateStep.Params["NodeIDs"] = strings.Join(nodeIDs, ",")
+	cloudprovider.SetStepOption(updateStep, updateDBStepOption.WithDependsOn(checkAddNodesStatusTask))
 
 	task.Steps[updateAddNodeDBInfoTask] = updateStep
 	task.StepSequence = append(task.StepSequence, updateAddNodeDBInfoTask)
@@ -560,6 +590,7 @@ func (t *Task) BuildAddNodesToClusterTask(cls *proto.Cluster, nodes []*proto.Nod
 	if opt.Cloud != nil && opt.Cloud.ClusterManagement != nil && opt.Cloud.ClusterManagement.AddNodesToCluster != nil {
 		action := opt.Cloud.ClusterManagement.AddNodesToCluster
 
+		lastStep := checkAddNodesStatusTask
 		for i := range action.PostActions {
 			plugin, ok := action.Plugins[action.PostActions[i]]
 			if ok {
@@ -572,8 +603,10 @@ func (t *Task) BuildAddNodesToClusterTask(cls *proto.Cluster, nodes []*proto.Nod
 				if err != nil {
 					return nil, fmt.Errorf("BuildAddNodesToClusterTask task failed: %v", err)
 				}
+				cloudprovider.SetStepOption(step, bksopsStepOption.WithDependsOn(lastStep))
 				task.Steps[stepName] = step
 				task.StepSequence = append(task.StepSequence, stepName)
+				lastStep = stepName
 			}
 		}
 	}
@@ -641,6 +674,11 @@ func (t *Task) BuildRemoveNodesFromClusterTask(cls *proto.Cluster, nodes []*prot
 	taskName := fmt.Sprintf(tkeCleanNodeTaskTemplate, cls.ClusterID)
 	task.CommonParams["taskName"] = taskName
 
+	// cordon nodes and drain pods gracefully before removing
+	if err := cloudprovider.BuildDrainNodesStep(task, cls.ClusterID, nodeIPs, opt.Drain); err != nil {
+		return nil, fmt.Errorf("BuildRemoveNodesFromClusterTask task failed: %v", err)
+	}
+
 	// bk-sops preAction
 	if opt.Cloud != nil && opt.Cloud.ClusterManagement != nil && opt.Cloud.ClusterManagement.DeleteNodesFromCluster != nil {
 		action := opt.Cloud.ClusterManagement.DeleteNodesFromCluster
@@ -866,6 +904,11 @@ func (t *Task) BuildCleanNodesInGroupTask(nodes []*proto.Node, group *proto.Node
 	passwd := group.LaunchTemplate.InitLoginPassword
 	task.CommonParams[cloudprovider.PasswordKey.String()] = passwd
 
+	// cordon nodes and drain pods gracefully before cleaning
+	if err := cloudprovider.BuildDrainNodesStep(task, group.ClusterID, nodeIPs, opt.Drain); err != nil {
+		return nil, fmt.Errorf("BuildCleanNodesInGroupTask task failed: %v", err)
+	}
+
 	// step1. bcs default steps
 	if group.NodeTemplate.BcsScaleInAddons != nil && len(group.NodeTemplate.BcsScaleInAddons.PreActions) > 0 {
 		step := &template.BkSopsStepAction{
@@ -1214,3 +1257,188 @@ func (t *Task) BuildSwitchNodeGroupAutoScalingTask(group *proto.NodeGroup, enabl
 	task.CommonParams[cloudprovider.JobTypeKey.String()] = cloudprovider.SwitchNodeGroupAutoScaling.String()
 	return task, nil
 }
+
+// BuildUpgradeNodeGroupTask rolling upgrade nodegroup nodes to cluster version
+func (t *Task) BuildUpgradeNodeGroupTask(group *proto.NodeGroup, opt *cloudprovider.UpgradeNodeGroupOption) (
+	*proto.Task, error) {
+	// rolling upgrade nodegroup nodes by batch, every batch has three steps:
+	// 1. cordon batch nodes and evict pods
+	// 2. call qcloud UpgradeClusterInstances to reset batch instances with cluster version
+	// 3. check batch nodes ready with cluster version, then uncordon nodes
+
+	// validate request params
+	if group == nil {
+		return nil, fmt.Errorf("BuildUpgradeNodeGroupTask group info empty")
+	}
+	if opt == nil || len(opt.Operator) == 0 || opt.Cluster == nil {
+		return nil, fmt.Errorf("BuildUpgradeNodeGroupTask TaskOptions is lost")
+	}
+	version := opt.Cluster.GetClusterBasicSettings().GetVersion()
+	if len(version) == 0 {
+		return nil, fmt.Errorf("BuildUpgradeNodeGroupTask cluster %s version is empty", opt.Cluster.ClusterID)
+	}
+
+	// generate main task
+	nowStr := time.Now().Format(time.RFC3339)
+	task := &proto.Task{
+		TaskID:         uuid.New().String(),
+		TaskType:       cloudprovider.GetTaskType(cloudName, cloudprovider.UpgradeNodeGroup),
+		TaskName:       cloudprovider.UpgradeNodeGroupTask.String(),
+		Status:         cloudprovider.TaskStatusInit,
+		Message:        "task initializing",
+		Start:          nowStr,
+		Steps:          make(map[string]*proto.Step),
+		StepSequence:   make([]string, 0),
+		ClusterID:      group.ClusterID,
+		ProjectID:      group.ProjectID,
+		Creator:        opt.Operator,
+		Updater:        opt.Operator,
+		LastUpdate:     nowStr,
+		CommonParams:   make(map[string]string),
+		ForceTerminate: false,
+		NodeGroupID:    group.NodeGroupID,
+	}
+	// generate taskName
+	taskName := fmt.Sprintf(upgradeNodeGroupTaskTemplate, group.ClusterID, group.Name)
+	task.CommonParams[cloudprovider.TaskNameKey.String()] = taskName
+
+	err := cloudprovider.BuildRollingUpgradeSteps(task, cloudprovider.RollingUpgradeInfo{
+		ClusterID:      group.ClusterID,
+		CloudID:        group.Provider,
+		NodeGroupID:    group.NodeGroupID,
+		Version:        version,
+		MaxUnavailable: opt.MaxUnavailable,
+		Nodes:          opt.Nodes,
+	}, buildUpgradeTKENodesStep(group.ClusterID, group.Provider))
+	if err != nil {
+		return nil, fmt.Errorf("BuildUpgradeNodeGroupTask failed: %v", err)
+	}
+
+	// set current step
+	if len(task.StepSequence) == 0 {
+		return nil, fmt.Errorf("BuildUpgradeNodeGroupTask task StepSequence empty")
+	}
+	task.CurrentStep = task.StepSequence[0]
+
+	// must set job-type
+	task.CommonParams[cloudprovider.UpgradeVersionKey.String()] = version
+	task.CommonParams[cloudprovider.JobTypeKey.String()] = cloudprovider.UpgradeNodeGroupJob.String()
+	return task, nil
+}
+
+// BuildUpgradeClusterTask upgrade tke cluster master, then rolling upgrade nodegroups
+func (t *Task) BuildUpgradeClusterTask(cls *proto.Cluster, opt *cloudprovider.UpgradeClusterOption) (
+	*proto.Task, error) {
+	// upgrade cluster steps:
+	// 1. check upgrade version, deprecated apis and PodDisruptionBudgets in cluster
+	// 2. call qcloud UpdateClusterVersion to upgrade master and wait cluster running
+	// 3. update cluster version in DB
+	// 4. rolling upgrade nodegroups nodes by batch
+
+	// validate request params
+	if cls == nil {
+		return nil, fmt.Errorf("BuildUpgradeClusterTask cluster info empty")
+	}
+	if opt == nil || len(opt.Operator) == 0 || len(opt.Version) == 0 {
+		return nil, fmt.Errorf("BuildUpgradeClusterTask TaskOptions is lost")
+	}
+
+	// generate main task
+	nowStr := time.Now().Format(time.RFC3339)
+	task := &proto.Task{
+		TaskID:         uuid.New().String(),
+		TaskType:       cloudprovider.GetTaskType(cloudName, cloudprovider.UpgradeCluster),
+		TaskName:       cloudprovider.UpgradeClusterTask.String(),
+		Status:         cloudprovider.TaskStatusInit,
+		Message:        "task initializing",
+		Start:          nowStr,
+		Steps:          make(map[string]*proto.Step),
+		StepSequence:   make([]string, 0),
+		ClusterID:      cls.ClusterID,
+		ProjectID:      cls.ProjectID,
+		Creator:        opt.Operator,
+		Updater:        opt.Operator,
+		LastUpdate:     nowStr,
+		CommonParams:   make(map[string]string),
+		ForceTerminate: false,
+	}
+	// generate taskName
+	taskName := fmt.Sprintf(upgradeClusterTaskTemplate, cls.ClusterID)
+	task.CommonParams[cloudprovider.TaskNameKey.String()] = taskName
+
+	// step1: upgrade pre-check
+	preCheckStep := cloudprovider.NewCommonStep(cloudprovider.UpgradePreCheckTask, cloudprovider.UpgradePreCheckTask,
+		cloudprovider.UpgradePreCheckStep)
+	preCheckStep.Params[cloudprovider.ClusterIDKey.String()] = cls.ClusterID
+	preCheckStep.Params[cloudprovider.UpgradeVersionKey.String()] = opt.Version
+
+	task.Steps[preCheckStep.Name] = preCheckStep
+	task.StepSequence = append(task.StepSequence, preCheckStep.Name)
+
+	// step2: upgrade tke master
+	masterStep := cloudprovider.NewCommonStep(upgradeTKEClusterMasterTask, upgradeTKEClusterMasterTask,
+		cloudprovider.UpgradeClusterMasterStep)
+	masterStep.Params[cloudprovider.ClusterIDKey.String()] = cls.ClusterID
+	masterStep.Params[cloudprovider.CloudIDKey.String()] = cls.Provider
+	masterStep.Params[cloudprovider.UpgradeVersionKey.String()] = opt.Version
+
+	task.Steps[masterStep.Name] = masterStep
+	task.StepSequence = append(task.StepSequence, masterStep.Name)
+
+	// step3: update cluster version in DB
+	dbStep := cloudprovider.NewCommonStep(cloudprovider.UpdateClusterVersionDBTask,
+		cloudprovider.UpdateClusterVersionDBTask, cloudprovider.UpdateClusterVersionDBStep)
+	dbStep.Params[cloudprovider.ClusterIDKey.String()] = cls.ClusterID
+	dbStep.Params[cloudprovider.UpgradeVersionKey.String()] = opt.Version
+
+	task.Steps[dbStep.Name] = dbStep
+	task.StepSequence = append(task.StepSequence, dbStep.Name)
+
+	// step4: rolling upgrade nodegroups
+	for _, group := range opt.NodeGroups {
+		err := cloudprovider.BuildRollingUpgradeSteps(task, cloudprovider.RollingUpgradeInfo{
+			ClusterID:      cls.ClusterID,
+			CloudID:        cls.Provider,
+			NodeGroupID:    group.NodeGroupID,
+			Version:        opt.Version,
+			MaxUnavailable: opt.MaxUnavailable,
+			Nodes:          opt.GroupNodes[group.NodeGroupID],
+		}, buildUpgradeTKENodesStep(cls.ClusterID, cls.Provider))
+		if err != nil {
+			return nil, fmt.Errorf("BuildUpgradeClusterTask failed: %v", err)
+		}
+	}
+
+	// set current step
+	task.CurrentStep = task.StepSequence[0]
+
+	// must set job-type
+	task.CommonParams[cloudprovider.UpgradeVersionKey.String()] = opt.Version
+	task.CommonParams[cloudprovider.OriginVersionKey.String()] = cls.GetClusterBasicSettings().GetVersion()
+	task.CommonParams[cloudprovider.JobTypeKey.String()] = cloudprovider.UpgradeClusterJob.String()
+	return task, nil
+}
+
+// buildUpgradeTKENodesStep build step which resets batch instances with cluster version, instances are
+// reinstalled in place and data disks are not retained
+func buildUpgradeTKENodesStep(clusterID, cloudID string) cloudprovider.UpgradeNodesStepFunc {
+	return func(batchID string, nodes []*proto.Node) (*proto.Step, error) {
+		nodeIDs := make([]string, 0)
+		nodeIPs := make([]string, 0)
+		for _, node := range nodes {
+			if len(node.NodeID) == 0 {
+				return nil, fmt.Errorf("node %s instanceID is empty", node.InnerIP)
+			}
+			nodeIDs = append(nodeIDs, node.NodeID)
+			nodeIPs = append(nodeIPs, node.InnerIP)
+		}
+
+		step := cloudprovider.NewCommonStep(fmt.Sprintf("%s-%s", upgradeTKEClusterNodesTask, batchID),
+			upgradeTKEClusterNodesTask, cloudprovider.UpgradeNodesStep)
+		step.Params[cloudprovider.ClusterIDKey.String()] = clusterID
+		step.Params[cloudprovider.CloudIDKey.String()] = cloudID
+		step.Params[cloudprovider.NodeIDsKey.String()] = strings.Join(nodeIDs, ",")
+		step.Params[cloudprovider.NodeIPsKey.String()] = strings.Join(nodeIPs, ",")
+		return step, nil
+	}
+}
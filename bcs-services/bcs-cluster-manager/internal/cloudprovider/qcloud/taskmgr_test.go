@@ -0,0 +1,129 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package qcloud
+
+import (
+	"reflect"
+	"testing"
+
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+)
+
+// testAddonsStep bk-sops post action step which installs addons
+var testAddonsStep = cloudprovider.BKSOPTask + "-addons"
+
+func newTestCloud() *proto.Cloud {
+	action := &proto.Action{
+		PostActions: []string{"addons"},
+		Plugins: map[string]*proto.BKOpsPlugin{
+			"addons": {
+				System: "bksops",
+				Link:   "http://bksops.example.com/addons",
+				Params: map[string]string{"template_id": "1"},
+			},
+		},
+	}
+	return &proto.Cloud{
+		CloudID: cloudName,
+		ClusterManagement: &proto.ClusterMgr{
+			CreateCluster:     action,
+			AddNodesToCluster: action,
+		},
+	}
+}
+
+// finishSteps mark steps success
+func finishSteps(task *proto.Task, steps ...string) {
+	for _, name := range steps {
+		task.Steps[name].Status = cloudprovider.TaskStatusSuccess
+	}
+}
+
+// checkDAGTask check task is a valid DAG and every step is limited by timeout
+func checkDAGTask(t *testing.T, task *proto.Task) {
+	if err := cloudprovider.ValidateTaskSteps(task); err != nil {
+		t.Fatalf("ValidateTaskSteps failed: %v", err)
+	}
+	if !cloudprovider.IsDAGTask(task) {
+		t.Fatalf("task steps are not organized as DAG")
+	}
+	for name, step := range task.Steps {
+		if step.Timeout == 0 {
+			t.Errorf("step %s has no timeout", name)
+		}
+	}
+}
+
+func TestBuildCreateClusterTaskDAG(t *testing.T) {
+	cls := &proto.Cluster{ClusterID: "BCS-K8S-00001", ProjectID: "project", Provider: cloudName}
+	task, err := newtask().BuildCreateClusterTask(cls, &cloudprovider.CreateClusterOption{
+		Operator: "admin",
+		Cloud:    newTestCloud(),
+	})
+	if err != nil {
+		t.Fatalf("BuildCreateClusterTask failed: %v", err)
+	}
+	checkDAGTask(t, task)
+
+	steps := []struct {
+		finished []string
+		ready    []string
+	}{
+		{ready: []string{createTKEClusterTask}},
+		{finished: []string{createTKEClusterTask}, ready: []string{checkTKEClusterStatusTask}},
+		// enable vpc-cni and update DB concurrently when cluster is running
+		{
+			finished: []string{checkTKEClusterStatusTask},
+			ready:    []string{enableTkeClusterVpcCniTask, updateCreateClusterDBInfoTask},
+		},
+		// addons are installed while DB info is updating
+		{
+			finished: []string{enableTkeClusterVpcCniTask},
+			ready:    []string{updateCreateClusterDBInfoTask, testAddonsStep},
+		},
+	}
+	for _, s := range steps {
+		finishSteps(task, s.finished...)
+		if ready := cloudprovider.GetReadySteps(task); !reflect.DeepEqual(ready, s.ready) {
+			t.Errorf("after steps %v finished, expect ready steps %v, got %v", s.finished, s.ready, ready)
+		}
+	}
+}
+
+func TestBuildAddNodesToClusterTaskDAG(t *testing.T) {
+	cls := &proto.Cluster{ClusterID: "BCS-K8S-00001", ProjectID: "project", Provider: cloudName}
+	nodes := []*proto.Node{
+		{NodeID: "ins-1", InnerIP: "127.0.0.1"},
+		{NodeID: "ins-2", InnerIP: "127.0.0.2"},
+	}
+	task, err := newtask().BuildAddNodesToClusterTask(cls, nodes, &cloudprovider.AddNodesOption{
+		Operator: "admin",
+		Cloud:    newTestCloud(),
+	})
+	if err != nil {
+		t.Fatalf("BuildAddNodesToClusterTask failed: %v", err)
+	}
+	checkDAGTask(t, task)
+
+	finishSteps(task, addNodesToClusterTask, checkAddNodesStatusTask)
+	// post actions run while DB info is updating
+	expect := []string{updateAddNodeDBInfoTask, testAddonsStep}
+	if ready := cloudprovider.GetReadySteps(task); !reflect.DeepEqual(ready, expect) {
+		t.Errorf("expect ready steps %v when nodes are running, got %v", expect, ready)
+	}
+	if task.Steps[addNodesToClusterTask].MaxRetry != 0 {
+		t.Errorf("step %s calling tke api should not be retried", addNodesToClusterTask)
+	}
+}
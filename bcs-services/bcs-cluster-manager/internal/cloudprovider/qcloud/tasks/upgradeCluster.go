@@ -0,0 +1,176 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider/qcloud/api"
+)
+
+// UpgradeTKEClusterMasterTask upgrade tke cluster master version and wait cluster running
+func UpgradeTKEClusterMasterTask(taskID string, stepName string) error {
+	start := time.Now()
+
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		return nil
+	}
+
+	// extract parameter && check validate
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	cloudID := step.Params[cloudprovider.CloudIDKey.String()]
+	dstVersion := step.Params[cloudprovider.UpgradeVersionKey.String()]
+	if len(clusterID) == 0 || len(cloudID) == 0 || len(dstVersion) == 0 {
+		blog.Errorf("UpgradeTKEClusterMasterTask[%s]: check parameter validate failed", taskID)
+		retErr := fmt.Errorf("UpgradeTKEClusterMasterTask check parameters failed")
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	dependInfo, err := cloudprovider.GetClusterDependBasicInfo(clusterID, cloudID, "")
+	if err != nil {
+		blog.Errorf("UpgradeTKEClusterMasterTask[%s]: GetClusterDependBasicInfo failed: %s", taskID, err.Error())
+		retErr := fmt.Errorf("UpgradeTKEClusterMasterTask GetClusterDependBasicInfo failed")
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	// inject taskID
+	ctx := cloudprovider.WithTaskIDForContext(context.Background(), taskID)
+	err = upgradeTKEClusterMaster(ctx, dependInfo, dstVersion)
+	if err != nil {
+		blog.Errorf("UpgradeTKEClusterMasterTask[%s]: upgradeTKEClusterMaster failed: %v", taskID, err)
+		retErr := fmt.Errorf("UpgradeTKEClusterMasterTask failed: %v", err)
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	// update step
+	if err := state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("UpgradeTKEClusterMasterTask[%s] task %s %s update to storage fatal", taskID, taskID, stepName)
+		return err
+	}
+	return nil
+}
+
+// upgradeTKEClusterMaster master upgrade is idempotent when retry task, skip calling cloud api
+// if master is upgraded to dstVersion
+func upgradeTKEClusterMaster(ctx context.Context, info *cloudprovider.CloudDependBasicInfo, dstVersion string) error {
+	taskID := cloudprovider.GetTaskIDFromContext(ctx)
+
+	cli, err := api.NewTkeClient(info.CmOption)
+	if err != nil {
+		return err
+	}
+	systemID := info.Cluster.SystemID
+	tkeCluster, err := cli.GetTKECluster(systemID)
+	if err != nil {
+		return err
+	}
+	if tkeCluster.ClusterVersion == nil || *tkeCluster.ClusterVersion != dstVersion {
+		err = cli.UpdateClusterVersion(systemID, dstVersion)
+		if err != nil {
+			return err
+		}
+	}
+
+	timeCtx, cancel := context.WithTimeout(ctx, time.Minute*60)
+	defer cancel()
+	return cloudprovider.LoopDoFunc(timeCtx, func() error {
+		tkeCluster, err = cli.GetTKECluster(systemID)
+		if err != nil {
+			blog.Errorf("upgradeTKEClusterMaster[%s] GetTKECluster[%s] failed: %v", taskID, systemID, err)
+			return nil
+		}
+		if tkeCluster.ClusterVersion == nil || tkeCluster.ClusterStatus == nil {
+			return nil
+		}
+		blog.Infof("upgradeTKEClusterMaster[%s] cluster[%s] current version[%s] status[%s]",
+			taskID, systemID, *tkeCluster.ClusterVersion, *tkeCluster.ClusterStatus)
+		if *tkeCluster.ClusterStatus == "Abnormal" {
+			return fmt.Errorf("cluster %s status abnormal", systemID)
+		}
+		if *tkeCluster.ClusterVersion == dstVersion && *tkeCluster.ClusterStatus == "Running" {
+			return cloudprovider.EndLoop
+		}
+		return nil
+	}, cloudprovider.LoopInterval(30*time.Second))
+}
+
+// UpgradeTKEClusterNodesTask reset batch instances to upgrade kubernetes version to cluster version,
+// nodes status & version are checked by common step
+func UpgradeTKEClusterNodesTask(taskID string, stepName string) error {
+	start := time.Now()
+
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		return nil
+	}
+
+	// extract parameter && check validate
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	cloudID := step.Params[cloudprovider.CloudIDKey.String()]
+	nodeIDs := step.Params[cloudprovider.NodeIDsKey.String()]
+	if len(clusterID) == 0 || len(cloudID) == 0 || len(nodeIDs) == 0 {
+		blog.Errorf("UpgradeTKEClusterNodesTask[%s]: check parameter validate failed", taskID)
+		retErr := fmt.Errorf("UpgradeTKEClusterNodesTask check parameters failed")
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	dependInfo, err := cloudprovider.GetClusterDependBasicInfo(clusterID, cloudID, "")
+	if err != nil {
+		blog.Errorf("UpgradeTKEClusterNodesTask[%s]: GetClusterDependBasicInfo failed: %s", taskID, err.Error())
+		retErr := fmt.Errorf("UpgradeTKEClusterNodesTask GetClusterDependBasicInfo failed")
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	cli, err := api.NewTkeClient(dependInfo.CmOption)
+	if err != nil {
+		blog.Errorf("UpgradeTKEClusterNodesTask[%s]: get tke client failed: %v", taskID, err)
+		retErr := fmt.Errorf("UpgradeTKEClusterNodesTask get tke client failed: %v", err)
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	err = cli.UpgradeClusterInstances(dependInfo.Cluster.SystemID, strings.Split(nodeIDs, ","))
+	if err != nil {
+		blog.Errorf("UpgradeTKEClusterNodesTask[%s]: UpgradeClusterInstances[%s] failed: %v",
+			taskID, nodeIDs, err)
+		retErr := fmt.Errorf("UpgradeTKEClusterNodesTask UpgradeClusterInstances failed: %v", err)
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	// update step
+	if err := state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("UpgradeTKEClusterNodesTask[%s] task %s %s update to storage fatal", taskID, taskID, stepName)
+		return err
+	}
+	return nil
+}
@@ -45,6 +45,10 @@ const (
 	moveNodesToNodeGroupTaskTemplate = "tke-move nodes to node group: %s/%s"
 	// switchNodeGroupAutoScalingTaskTemplate bk-sops add task template
 	switchNodeGroupAutoScalingTaskTemplate = "tke-switch node group auto scaling: %s/%s"
+	// upgradeClusterTaskTemplate bk-sops add task template
+	upgradeClusterTaskTemplate = "tke-upgrade cluster: %s"
+	// upgradeNodeGroupTaskTemplate bk-sops add task template
+	upgradeNodeGroupTaskTemplate = "tke-upgrade node group: %s/%s"
 )
 
 var (
@@ -99,4 +103,8 @@ var (
 	updateNodeGroupAutoScalingDBTask = fmt.Sprintf("%s-UpdateNodeGroupAutoScalingDBTask", cloudName)
 
 	// move nodes to nodeGroup task
+
+	// upgrade cluster & nodeGroup task
+	upgradeTKEClusterMasterTask = fmt.Sprintf("%s-UpgradeTKEClusterMasterTask", cloudName)
+	upgradeTKEClusterNodesTask  = fmt.Sprintf("%s-UpgradeTKEClusterNodesTask", cloudName)
 )
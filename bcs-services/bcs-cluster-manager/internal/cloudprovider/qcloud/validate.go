@@ -16,6 +16,7 @@ package qcloud
 import (
 	"encoding/base64"
 	"fmt"
+	"net"
 	"sync"
 
 	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
@@ -224,3 +225,136 @@ func (c *CloudValidate) ListCloudOsImageValidate(req *proto.ListCloudOsImageRequ
 
 	return nil
 }
+
+// CreateClusterValidate check createCluster operation, only fields already set are checked so
+// that template base config with parameters unfilled can be validated too
+func (c *CloudValidate) CreateClusterValidate(req *proto.CreateClusterReq, opt *cloudprovider.CommonOption) error {
+	if c == nil || req == nil {
+		return fmt.Errorf("%s CreateClusterValidate request is empty", cloudName)
+	}
+
+	var (
+		subnetIDs        []string
+		securityGroupIDs []string
+	)
+	if req.NetworkSettings != nil {
+		cidrs := append([]string{req.NetworkSettings.ClusterIPv4CIDR, req.NetworkSettings.ServiceIPv4CIDR},
+			req.NetworkSettings.MultiClusterCIDR...)
+		for _, cidr := range cidrs {
+			if cidr == "" {
+				continue
+			}
+			if _, _, err := net.ParseCIDR(cidr); err != nil {
+				return fmt.Errorf("%s CreateClusterValidate invalid cidr[%s]: %v", cloudName, cidr, err)
+			}
+		}
+		subnetIDs = append(subnetIDs, req.NetworkSettings.EniSubnetIDs...)
+	}
+	for _, ins := range req.Instances {
+		if ins == nil {
+			continue
+		}
+		if ins.VpcID != "" && req.VpcID != "" && ins.VpcID != req.VpcID {
+			return fmt.Errorf("%s CreateClusterValidate instance vpc[%s] differ from cluster vpc[%s]",
+				cloudName, ins.VpcID, req.VpcID)
+		}
+		if ins.SubnetID != "" {
+			subnetIDs = append(subnetIDs, ins.SubnetID)
+		}
+		securityGroupIDs = append(securityGroupIDs, ins.SecurityGroupIDs...)
+	}
+
+	return validateVPCResources(req.VpcID, subnetIDs, securityGroupIDs, opt)
+}
+
+// CreateNodeGroupValidate check createNodeGroup operation, only fields already set are checked so
+// that template base config with parameters unfilled can be validated too
+func (c *CloudValidate) CreateNodeGroupValidate(req *proto.CreateNodeGroupRequest,
+	opt *cloudprovider.CommonOption) error {
+	if c == nil || req == nil {
+		return fmt.Errorf("%s CreateNodeGroupValidate request is empty", cloudName)
+	}
+
+	var (
+		vpcID            string
+		subnetIDs        []string
+		securityGroupIDs []string
+	)
+	if req.AutoScaling != nil {
+		asg := req.AutoScaling
+		if asg.MaxSize > 0 && asg.MinSize > asg.MaxSize {
+			return fmt.Errorf("%s CreateNodeGroupValidate autoScaling minSize[%d] larger than maxSize[%d]",
+				cloudName, asg.MinSize, asg.MaxSize)
+		}
+		if asg.MaxSize > 0 && (asg.DesiredSize < asg.MinSize || asg.DesiredSize > asg.MaxSize) {
+			return fmt.Errorf("%s CreateNodeGroupValidate autoScaling desiredSize[%d] out of range[%d, %d]",
+				cloudName, asg.DesiredSize, asg.MinSize, asg.MaxSize)
+		}
+		vpcID = asg.VpcID
+		subnetIDs = asg.SubnetIDs
+	}
+	if req.LaunchTemplate != nil {
+		securityGroupIDs = req.LaunchTemplate.SecurityGroupIDs
+	}
+
+	return validateVPCResources(vpcID, subnetIDs, securityGroupIDs, opt)
+}
+
+// validateVPCResources check subnets & securityGroups exist in cloud, subnets must belong to vpcID
+// when vpcID is set. check is skipped when credential or region is absent
+func validateVPCResources(vpcID string, subnetIDs, securityGroupIDs []string,
+	opt *cloudprovider.CommonOption) error {
+	if len(subnetIDs) == 0 && len(securityGroupIDs) == 0 {
+		return nil
+	}
+	if opt == nil || len(opt.Key) == 0 || len(opt.Secret) == 0 || len(opt.Region) == 0 {
+		blog.Warnf("%s validateVPCResources lost valid crendential info, skip cloud check", cloudName)
+		return nil
+	}
+
+	cli, err := api.NewVPCClient(opt)
+	if err != nil {
+		return fmt.Errorf("%s validateVPCResources getVPCClient failed: %v", cloudName, err)
+	}
+	if len(subnetIDs) > 0 {
+		subnets, err := cli.DescribeSubnets(subnetIDs, nil)
+		if err != nil {
+			return fmt.Errorf("%s validateVPCResources DescribeSubnets %v failed: %v", cloudName, subnetIDs, err)
+		}
+		found := make(map[string]string, len(subnets))
+		for _, subnet := range subnets {
+			if subnet.SubnetID != nil && subnet.VpcID != nil {
+				found[*subnet.SubnetID] = *subnet.VpcID
+			}
+		}
+		for _, id := range subnetIDs {
+			subnetVpc, ok := found[id]
+			if !ok {
+				return fmt.Errorf("%s validateVPCResources subnet[%s] not found in region[%s]",
+					cloudName, id, opt.Region)
+			}
+			if vpcID != "" && subnetVpc != vpcID {
+				return fmt.Errorf("%s validateVPCResources subnet[%s] not belong to vpc[%s]", cloudName, id, vpcID)
+			}
+		}
+	}
+	if len(securityGroupIDs) > 0 {
+		sgs, err := cli.DescribeSecurityGroups(securityGroupIDs, nil)
+		if err != nil {
+			return fmt.Errorf("%s validateVPCResources DescribeSecurityGroups %v failed: %v", cloudName,
+				securityGroupIDs, err)
+		}
+		found := make(map[string]struct{}, len(sgs))
+		for _, sg := range sgs {
+			found[sg.ID] = struct{}{}
+		}
+		for _, id := range securityGroupIDs {
+			if _, ok := found[id]; !ok {
+				return fmt.Errorf("%s validateVPCResources securityGroup[%s] not found in region[%s]",
+					cloudName, id, opt.Region)
+			}
+		}
+	}
+
+	return nil
+}
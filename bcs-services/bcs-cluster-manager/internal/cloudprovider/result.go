@@ -20,8 +20,12 @@ import (
 
 	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
 	cmproto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/clusterops"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/common"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/options"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/utils"
+
+	"k8s.io/apimachinery/pkg/api/errors"
 )
 
 var (
@@ -56,6 +60,12 @@ var (
 	CleanNodeGroupNodesJob JobType = "clean-nodegroup-nodes"
 	// MoveNodesToNodeGroupJob for moveNodesToNodeGroup job
 	MoveNodesToNodeGroupJob JobType = "move-nodes-to-nodegroup"
+	// UpgradeClusterJob for upgradeCluster job
+	UpgradeClusterJob JobType = "upgrade-cluster"
+	// UpgradeNodeGroupJob for upgradeNodeGroup job
+	UpgradeNodeGroupJob JobType = "upgrade-nodegroup"
+	// DrainNodeJob for drainNode job
+	DrainNodeJob JobType = "drain-node"
 )
 
 // String to string
@@ -117,6 +127,17 @@ func (sjr *SyncJobResult) UpdateJobResultStatus(isSuccess bool) error {
 	case CreateNodeGroupJob:
 		sjr.Status = generateStatusResult(common.StatusRunning, common.StatusCreateNodeGroupFailed)
 		return sjr.updateNodeGroupStatus(isSuccess)
+	case UpgradeClusterJob:
+		sjr.Status = generateStatusResult(common.StatusRunning, common.StatusUpgradeClusterFailed)
+		sjr.rollbackUpgradeBatch(isSuccess)
+		return sjr.updateClusterResultStatus(isSuccess)
+	case UpgradeNodeGroupJob:
+		sjr.Status = generateStatusResult(common.StatusRunning, common.StatusUpgradeNodeGroupFailed)
+		sjr.rollbackUpgradeBatch(isSuccess)
+		return sjr.updateNodeGroupStatus(isSuccess)
+	case DrainNodeJob:
+		// drained nodes keep cordoned, node status is not changed
+		return nil
 	}
 
 	return fmt.Errorf(ErrJobType, sjr.JobType)
@@ -217,6 +238,66 @@ func (sjr *SyncJobResult) updateClusterResultStatus(isSuccess bool) error {
 	return nil
 }
 
+// rollbackUpgradeBatch rollback running batch when upgrade task failed: nodes cordoned by the batch
+// are uncordoned, and steps of the batch are reset so retrying task drains nodes again before replacing
+// them. upgraded nodes are reinstalled in place and control plane can't be downgraded, they are reported
+// in task message with origin version for manual intervention
+func (sjr *SyncJobResult) rollbackUpgradeBatch(isSuccess bool) {
+	if isSuccess {
+		return
+	}
+	ctx := WithTaskIDForContext(context.Background(), sjr.TaskID)
+	task, err := GetStorageModel().GetTask(ctx, sjr.TaskID)
+	if err != nil {
+		blog.Errorf("task[%s] rollbackUpgradeBatch get task failed: %v", sjr.TaskID, err)
+		return
+	}
+
+	patchs := make(map[string]interface{})
+	cordoned := task.CommonParams[CordonedNodesKey.String()]
+	if len(cordoned) > 0 {
+		k8sOperator := clusterops.NewK8SOperator(options.GetGlobalCMOptions(), GetStorageModel())
+		for _, ip := range strings.Split(cordoned, ",") {
+			err = k8sOperator.ClusterUpdateScheduleNode(ctx, clusterops.NodeInfo{
+				ClusterID: task.ClusterID,
+				NodeIP:    ip,
+				Desired:   false,
+			})
+			if err != nil && !errors.IsNotFound(err) {
+				// keep rollback point, nodes are uncordoned by retrying or manually
+				blog.Errorf("task[%s] rollbackUpgradeBatch uncordon node %s failed: %v", sjr.TaskID, ip, err)
+				return
+			}
+		}
+
+		batchStep := task.CommonParams[CordonedStepKey.String()]
+		if _, ok := task.Steps[batchStep]; ok {
+			for _, name := range GetDescendantSteps(task, batchStep) {
+				step := task.Steps[name]
+				if step.Status == TaskStatusNotStarted {
+					continue
+				}
+				step.Status = TaskStatusNotStarted
+				step.Retry = 0
+				step.Message = fmt.Sprintf("batch nodes %s are uncordoned by rollback, retry from step %s",
+					cordoned, batchStep)
+				patchs[stepField(name, "")] = step
+			}
+		}
+		patchs["commonparams."+CordonedNodesKey.String()] = nil
+		patchs["commonparams."+CordonedStepKey.String()] = nil
+	}
+
+	patchs["message"] = fmt.Sprintf("%s. rollback: uncordoned nodes [%s], upgraded nodes [%s], "+
+		"control plane origin version %s", task.Message, cordoned, task.CommonParams[UpgradedNodesKey.String()],
+		task.CommonParams[OriginVersionKey.String()])
+	if err = GetStorageModel().PatchTask(ctx, sjr.TaskID, patchs); err != nil {
+		blog.Errorf("task[%s] rollbackUpgradeBatch update task failed: %v", sjr.TaskID, err)
+		return
+	}
+	blog.Infof("task[%s] rollbackUpgradeBatch nodes [%s] successfully", sjr.TaskID, cordoned)
+}
+
 func (sjr *SyncJobResult) updateCANodesResultStatus(isSuccess bool) error {
 	if len(sjr.NodeIPs) == 0 {
 		return fmt.Errorf("SyncJobResult updateCANodesResultStatus failed: %v", "NodeIPs is empty")
@@ -19,9 +19,13 @@ import (
 	"time"
 
 	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/operator"
 	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
 )
 
+// maxStepLogs max logs reserved in step
+var maxStepLogs = 500
+
 // GetTaskStateAndCurrentStep get task and task current step
 func GetTaskStateAndCurrentStep(taskID, stepName string) (*TaskState, *proto.Step, error) {
 	task, err := GetStorageModel().GetTask(context.Background(), taskID)
@@ -34,7 +38,7 @@ func GetTaskStateAndCurrentStep(taskID, stepName string) (*TaskState, *proto.Ste
 		task.CommonParams = make(map[string]string)
 	}
 
-	state := &TaskState{Task: task, JobResult: NewJobSyncResult(task)}
+	state := NewTaskState(task)
 	if state.IsTerminated() {
 		blog.Errorf("task[%s] is terminated, step %s skip", taskID, stepName)
 		return nil, nil, fmt.Errorf("task %s terminated", taskID)
@@ -55,10 +59,24 @@ func GetTaskStateAndCurrentStep(taskID, stepName string) (*TaskState, *proto.Ste
 	return state, step, nil
 }
 
-// TaskState handle task state
+// NewTaskState create task state, common params of task are recorded for
+// persisting changed params only
+func NewTaskState(task *proto.Task) *TaskState {
+	params := make(map[string]string, len(task.CommonParams))
+	for k, v := range task.CommonParams {
+		params[k] = v
+	}
+	return &TaskState{Task: task, JobResult: NewJobSyncResult(task), params: params}
+}
+
+// TaskState handle task state. steps of one task may run concurrently, thus state only
+// persists fields of current step, changed common params and task status with condition
 type TaskState struct {
 	Task      *proto.Task
 	JobResult *SyncJobResult
+
+	// params common params loaded from storage
+	params map[string]string
 }
 
 // IsTerminated check task already terminated
@@ -70,7 +88,69 @@ func (stat *TaskState) IsTerminated() bool {
 	return false
 }
 
-// IsReadyToStep check current step or switch step
+func stepField(stepName string, field string) string {
+	if len(field) == 0 {
+		return "steps." + stepName
+	}
+	return "steps." + stepName + "." + field
+}
+
+// stepCondition condition matches step status and retry times
+func stepCondition(stepName, status string, retry uint32) *operator.Condition {
+	return operator.NewLeafCondition(operator.Eq, operator.M{
+		stepField(stepName, "status"): status,
+		stepField(stepName, "retry"):  retry,
+	})
+}
+
+// taskRunningCondition condition matches task not terminated
+func taskRunningCondition() *operator.Condition {
+	return operator.NewLeafCondition(operator.In, operator.M{
+		"status": []string{TaskStatusInit, TaskStatusRunning},
+	})
+}
+
+// changedParams common params patch for params changed by step, params deleted by step
+// are patched with nil value thus they are removed from storage
+func (stat *TaskState) changedParams(patchs map[string]interface{}) map[string]interface{} {
+	for k, v := range stat.Task.CommonParams {
+		if old, ok := stat.params[k]; !ok || old != v {
+			patchs["commonparams."+k] = v
+		}
+	}
+	for k := range stat.params {
+		if _, ok := stat.Task.CommonParams[k]; !ok {
+			patchs["commonparams."+k] = nil
+		}
+	}
+	return patchs
+}
+
+// commitParams mark common params persisted
+func (stat *TaskState) commitParams() {
+	stat.params = make(map[string]string, len(stat.Task.CommonParams))
+	for k, v := range stat.Task.CommonParams {
+		stat.params[k] = v
+	}
+}
+
+// UpdateCommonParams update common params changed by current step to storage
+func (stat *TaskState) UpdateCommonParams() error {
+	patchs := stat.changedParams(make(map[string]interface{}))
+	if len(patchs) == 0 {
+		return nil
+	}
+	if err := GetStorageModel().PatchTask(context.Background(), stat.Task.TaskID, patchs); err != nil {
+		blog.Errorf("task %s update common params failed, %s", stat.Task.TaskID, err.Error())
+		return err
+	}
+	stat.commitParams()
+	return nil
+}
+
+// IsReadyToStep check step dependencies are all finished and switch step to running.
+// step is switched with condition, only one worker can run the step when step is
+// dispatched repeatedly
 func (stat *TaskState) IsReadyToStep(stepName string) (*proto.Step, error) {
 	switch stat.Task.Status {
 	case TaskStatusRunning, TaskStatusInit:
@@ -86,45 +166,29 @@ func (stat *TaskState) IsReadyToStep(stepName string) (*proto.Step, error) {
 		return nil, fmt.Errorf("lost step")
 	}
 
-	// previous step success when retry task scene
-	if curStep.Status == TaskStatusSuccess {
-		blog.Infof("task[%s] current step[%s] successful", stat.Task.TaskID, stepName)
+	// previous step success or skipped when retry task scene
+	if IsStepDone(curStep) {
+		blog.Infof("task[%s] current step[%s] %s", stat.Task.TaskID, stepName, curStep.Status)
 		return nil, nil
 	}
+	if curStep.Status == TaskStatusRunning {
+		return nil, fmt.Errorf("task %s step %s is already running", stat.Task.TaskID, stepName)
+	}
 
-	// check turn to step
-	if stepName != stat.Task.CurrentStep {
-		// check if pre steps are all ok, then we can set sthi step running
-		ok := true
-		for _, name := range stat.Task.StepSequence {
-			step, found := stat.Task.Steps[name]
-			if !found {
-				return nil, fmt.Errorf("task %s fatal, lost step %s in definition", stat.Task.TaskID, name)
-			}
-
-			// found current step
-			if name == stepName && ok {
-				if step.Status == TaskStatusSuccess {
-					return nil, fmt.Errorf("task %s step %s already success", stat.Task.TaskID, stepName)
-				}
-				stat.Task.CurrentStep = stepName
-				step.Status = TaskStatusRunning
-				step.Message = "step ready to run"
-				step.LastUpdate = time.Now().Format(time.RFC3339)
-				stat.Task.Steps[name] = step
-				GetStorageModel().UpdateTask(context.Background(), stat.Task)
-				return step, nil
-			}
-			//check this step is ok
-			if step.Status != TaskStatusSuccess {
-				ok = false
-				break
-			}
+	// check all dependent steps are ok
+	for _, name := range GetStepDependencies(stat.Task, stepName) {
+		step, found := stat.Task.Steps[name]
+		if !found {
+			return nil, fmt.Errorf("task %s fatal, lost step %s in definition", stat.Task.TaskID, name)
+		}
+		if !IsStepDone(step) {
+			return nil, fmt.Errorf("step %s don't turn to run, depends on step %s with state %s",
+				stepName, name, step.Status)
 		}
-		return nil, fmt.Errorf("step %s don't turn to run, task already failed", stepName)
 	}
 
 	// refresh step status & task status
+	oldStatus, oldRetry := curStep.Status, curStep.Retry
 	if curStep.Status == TaskStatusFailure {
 		curStep.Retry++
 	}
@@ -132,20 +196,59 @@ func (stat *TaskState) IsReadyToStep(stepName string) (*proto.Step, error) {
 	curStep.Message = "step ready to run"
 	curStep.LastUpdate = time.Now().Format(time.RFC3339)
 
+	stat.Task.CurrentStep = stepName
 	stat.Task.Status = TaskStatusRunning
 	stat.Task.Message = fmt.Sprintf("step %s is running", stepName)
 	stat.Task.LastUpdate = curStep.LastUpdate
 
 	//update state in storage
-	if err := GetStorageModel().UpdateTask(context.Background(), stat.Task); err != nil {
+	ok, err := GetStorageModel().PatchTaskWithCondition(context.Background(), stat.Task.TaskID,
+		operator.NewBranchCondition(operator.And, stepCondition(stepName, oldStatus, oldRetry),
+			taskRunningCondition()),
+		map[string]interface{}{
+			stepField(stepName, ""): curStep,
+			"currentstep":           stat.Task.CurrentStep,
+			"status":                stat.Task.Status,
+			"message":               stat.Task.Message,
+			"lastupdate":            stat.Task.LastUpdate,
+		})
+	if err != nil {
 		blog.Errorf("task %s fatal, update task status failed, %s. required admin intervetion",
 			stat.Task.TaskID, err.Error())
 		return nil, err
 	}
+	if !ok {
+		return nil, fmt.Errorf("task %s step %s is changed by others or task is terminated",
+			stat.Task.TaskID, stepName)
+	}
 	blog.Infof("task %s step %s turn to running", stat.Task.TaskID, stepName)
 	return curStep, nil
 }
 
+// updateRunningStep update step and changed common params when step is still running
+// by current worker, step may be timeout and switched by task server
+func (stat *TaskState) updateRunningStep(stepName string, retry uint32) error {
+	patchs := stat.changedParams(map[string]interface{}{
+		stepField(stepName, ""): stat.Task.Steps[stepName],
+	})
+	ok, err := GetStorageModel().PatchTaskWithCondition(context.Background(), stat.Task.TaskID,
+		stepCondition(stepName, TaskStatusRunning, retry), patchs)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("step %s is not running, maybe step timeout", stepName)
+	}
+	stat.commitParams()
+	return nil
+}
+
+// updateTaskStatus update task status when task is not terminated
+func (stat *TaskState) updateTaskStatus(patchs map[string]interface{}) (bool, error) {
+	return GetStorageModel().PatchTaskWithCondition(context.Background(), stat.Task.TaskID,
+		taskRunningCondition(), patchs)
+}
+
 //UpdateStepSucc update step to success
 func (stat *TaskState) UpdateStepSucc(start time.Time, stepName string) error {
 	step := stat.Task.Steps[stepName]
@@ -156,38 +259,99 @@ func (stat *TaskState) UpdateStepSucc(start time.Time, stepName string) error {
 	step.Status = TaskStatusSuccess
 	step.LastUpdate = step.End
 	step.Message = "running successfully"
-	stat.Task.Status = TaskStatusRunning
+	if err := stat.updateRunningStep(stepName, step.Retry); err != nil {
+		blog.Errorf("task %s fatal, update step %s success status failed, %s. required admin intervetion",
+			stat.Task.TaskID, stepName, err.Error())
+		return err
+	}
+
 	stat.Task.Message = fmt.Sprintf("step %s running successfully", step.Name)
 	stat.Task.LastUpdate = step.End
+	if _, err := stat.updateTaskStatus(map[string]interface{}{
+		"message":    stat.Task.Message,
+		"lastupdate": stat.Task.LastUpdate,
+	}); err != nil {
+		blog.Errorf("task %s update task message failed, %s", stat.Task.TaskID, err.Error())
+	}
+	blog.Infof("task %s step %s running successfully", stat.Task.TaskID, stepName)
 
-	if stepName == stat.Task.StepSequence[len(stat.Task.StepSequence)-1] {
-		// last step in task, just make whole task success
-		taskStart, _ := time.Parse(time.RFC3339, stat.Task.Start)
-		stat.Task.End = end.Format(time.RFC3339)
-		stat.Task.ExecutionTime = uint32(end.Unix() - taskStart.Unix())
-		stat.Task.Status = TaskStatusSuccess
-		stat.Task.Message = fmt.Sprintf("whole task running successfully")
-
-		if stat.JobResult != nil {
-			err := stat.JobResult.UpdateJobResultStatus(true)
-			if err != nil {
-				blog.Errorf("task[%s] stepName[%s] UpdateJobResultStatus failed: %v", stat.Task.TaskID, stepName, err)
-			} else {
-				blog.Infof("task[%s] stepName[%s] UpdateJobResultStatus successful", stat.Task.TaskID, stepName)
-			}
-		}
+	// steps may be finished concurrently, check all steps by latest task
+	task, err := GetStorageModel().GetTask(context.Background(), stat.Task.TaskID)
+	if err != nil {
+		blog.Errorf("task %s get task failed when step %s success, %s", stat.Task.TaskID, stepName, err.Error())
+		return err
+	}
+	if IsTaskStepsDone(task) {
+		return stat.UpdateTaskSucc(end)
 	}
+	return nil
+}
 
-	if err := GetStorageModel().UpdateTask(context.Background(), stat.Task); err != nil {
+// UpdateTaskSucc make whole task success when all steps are success or skipped, only
+// one worker updates task when last steps are finished concurrently
+func (stat *TaskState) UpdateTaskSucc(end time.Time) error {
+	taskStart, _ := time.Parse(time.RFC3339, stat.Task.Start)
+	stat.Task.End = end.Format(time.RFC3339)
+	stat.Task.ExecutionTime = uint32(end.Unix() - taskStart.Unix())
+	stat.Task.Status = TaskStatusSuccess
+	stat.Task.Message = fmt.Sprintf("whole task running successfully")
+	stat.Task.LastUpdate = stat.Task.End
+
+	ok, err := stat.updateTaskStatus(map[string]interface{}{
+		"end":           stat.Task.End,
+		"executiontime": stat.Task.ExecutionTime,
+		"status":        stat.Task.Status,
+		"message":       stat.Task.Message,
+		"lastupdate":    stat.Task.LastUpdate,
+	})
+	if err != nil {
 		blog.Errorf("task %s fatal, update task success status failed, %s. required admin intervetion",
 			stat.Task.TaskID, err.Error())
 		return err
 	}
-	blog.Infof("task %s step %s running successfully", stat.Task.TaskID, stepName)
+	if !ok {
+		blog.Infof("task %s is already terminated", stat.Task.TaskID)
+		return nil
+	}
+
+	if stat.JobResult != nil {
+		err := stat.JobResult.UpdateJobResultStatus(true)
+		if err != nil {
+			blog.Errorf("task[%s] UpdateJobResultStatus failed: %v", stat.Task.TaskID, err)
+		} else {
+			blog.Infof("task[%s] UpdateJobResultStatus successful", stat.Task.TaskID)
+		}
+	}
+	blog.Infof("task %s running successfully", stat.Task.TaskID)
+	return nil
+}
+
+// AppendStepLog append step progress log and update to storage, only latest
+// maxStepLogs logs are reserved
+func (stat *TaskState) AppendStepLog(stepName string, msg string) error {
+	step, ok := stat.Task.Steps[stepName]
+	if !ok {
+		return fmt.Errorf("task %s lost step %s", stat.Task.TaskID, stepName)
+	}
+	now := time.Now()
+	step.Logs = append(step.Logs, fmt.Sprintf("%s %s", now.Format(time.RFC3339), msg))
+	if len(step.Logs) > maxStepLogs {
+		step.Logs = step.Logs[len(step.Logs)-maxStepLogs:]
+	}
+	step.LastUpdate = now.Format(time.RFC3339)
+
+	if err := GetStorageModel().PatchTask(context.Background(), stat.Task.TaskID, map[string]interface{}{
+		stepField(stepName, "logs"):       step.Logs,
+		stepField(stepName, "lastupdate"): step.LastUpdate,
+	}); err != nil {
+		blog.Errorf("task %s update step %s logs failed, %s", stat.Task.TaskID, stepName, err.Error())
+		return err
+	}
 	return nil
 }
 
-// UpdateStepFailure update step failure
+// UpdateStepFailure update step failure, task keeps running when step is retried
+// automatically by task server
 func (stat *TaskState) UpdateStepFailure(start time.Time, stepName string, err error) error {
 	step := stat.Task.Steps[stepName]
 	end := time.Now()
@@ -197,6 +361,25 @@ func (stat *TaskState) UpdateStepFailure(start time.Time, stepName string, err e
 	step.Status = TaskStatusFailure
 	step.LastUpdate = step.End
 	step.Message = fmt.Sprintf("running fialed, %s", err.Error())
+	if err := stat.updateRunningStep(stepName, step.Retry); err != nil {
+		blog.Errorf("task %s fatal, update task step %s failure status failed, %s. required admin intervetion",
+			stat.Task.TaskID, stepName, err.Error())
+		return err
+	}
+
+	if step.Retry < step.MaxRetry {
+		stat.Task.Message = fmt.Sprintf("step %s running failed, %s. retry %d/%d after %ds",
+			step.Name, err.Error(), step.Retry+1, step.MaxRetry, step.RetryInterval)
+		stat.Task.LastUpdate = step.End
+		if _, err := stat.updateTaskStatus(map[string]interface{}{
+			"message":    stat.Task.Message,
+			"lastupdate": stat.Task.LastUpdate,
+		}); err != nil {
+			blog.Errorf("task %s update task message failed, %s", stat.Task.TaskID, err.Error())
+		}
+		blog.Infof("task %s step %s running failure, retry later", stat.Task.TaskID, stepName)
+		return nil
+	}
 
 	taskStart, _ := time.Parse(time.RFC3339, stat.Task.Start)
 	stat.Task.End = end.Format(time.RFC3339)
@@ -204,11 +387,23 @@ func (stat *TaskState) UpdateStepFailure(start time.Time, stepName string, err e
 	stat.Task.Status = TaskStatusFailure
 	stat.Task.Message = fmt.Sprintf("step %s running failed, %s", step.Name, err.Error())
 	stat.Task.LastUpdate = step.End
-	if err := GetStorageModel().UpdateTask(context.Background(), stat.Task); err != nil {
+	ok, err := stat.updateTaskStatus(map[string]interface{}{
+		"end":           stat.Task.End,
+		"executiontime": stat.Task.ExecutionTime,
+		"status":        stat.Task.Status,
+		"message":       stat.Task.Message,
+		"lastupdate":    stat.Task.LastUpdate,
+	})
+	if err != nil {
 		blog.Errorf("task %s fatal, update task step %s failure status failed, %s. required admin intervetion",
 			stat.Task.TaskID, stepName, err.Error())
 		return err
 	}
+	// task is already failed by other step
+	if !ok {
+		blog.Infof("task %s step %s running failure, task is already terminated", stat.Task.TaskID, stepName)
+		return nil
+	}
 
 	if stat.JobResult != nil {
 		err = stat.JobResult.UpdateJobResultStatus(false)
@@ -0,0 +1,125 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cloudprovider
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+)
+
+// RollingUpgradeInfo nodegroup rolling upgrade info
+type RollingUpgradeInfo struct {
+	ClusterID   string
+	CloudID     string
+	NodeGroupID string
+	// Version target kubernetes version of nodes
+	Version string
+	// MaxUnavailable max nodes cordoned and replaced in one batch. upgrade is in place and capacity
+	// reducing: no extra node is added before draining batch, surge is not supported
+	MaxUnavailable uint32
+	Nodes          []*proto.Node
+}
+
+// UpgradeNodesStepFunc build cloudprovider step which replaces batch nodes with target version,
+// batchID is unique in task and must be used as part of step name
+type UpgradeNodesStepFunc func(batchID string, nodes []*proto.Node) (*proto.Step, error)
+
+// SplitNodesBatch split nodes to batches by maxUnavailable, maxUnavailable is 1 at least
+func SplitNodesBatch(nodes []*proto.Node, maxUnavailable uint32) [][]*proto.Node {
+	if maxUnavailable == 0 {
+		maxUnavailable = 1
+	}
+	batches := make([][]*proto.Node, 0)
+	for start := 0; start < len(nodes); start += int(maxUnavailable) {
+		end := start + int(maxUnavailable)
+		if end > len(nodes) {
+			end = len(nodes)
+		}
+		batches = append(batches, nodes[start:end])
+	}
+	return batches
+}
+
+// NewCommonStep init common step which is registered by taskServer
+func NewCommonStep(name string, method string, stepName StepName) *proto.Step {
+	return &proto.Step{
+		Name:       name,
+		System:     "api",
+		Params:     make(map[string]string),
+		Retry:      0,
+		Start:      time.Now().Format(time.RFC3339),
+		Status:     TaskStatusNotStarted,
+		TaskMethod: method,
+		TaskName:   stepName.String(),
+	}
+}
+
+// BuildRollingUpgradeSteps split nodegroup nodes to batches by maxUnavailable and upgrades nodes in place,
+// every batch runs three steps:
+// 1. cordon & drain batch nodes
+// 2. cloudprovider replace batch nodes with target version
+// 3. check batch nodes ready with target version, then uncordon nodes
+func BuildRollingUpgradeSteps(task *proto.Task, info RollingUpgradeInfo, upgrade UpgradeNodesStepFunc) error {
+	if task == nil || upgrade == nil {
+		return fmt.Errorf("BuildRollingUpgradeSteps task or upgrade func is empty")
+	}
+
+	batches := SplitNodesBatch(info.Nodes, info.MaxUnavailable)
+	for i, nodes := range batches {
+		batchID := fmt.Sprintf("%s-%d", info.NodeGroupID, i+1)
+		batch := fmt.Sprintf("%d/%d", i+1, len(batches))
+		nodeIPs := make([]string, 0)
+		for _, node := range nodes {
+			nodeIPs = append(nodeIPs, node.InnerIP)
+		}
+
+		// step1: cordon & drain nodes
+		drainStep := NewCommonStep(fmt.Sprintf("%s-%s", CordonDrainNodesTask, batchID), CordonDrainNodesTask,
+			CordonDrainNodesStep)
+		drainStep.Params[ClusterIDKey.String()] = info.ClusterID
+		drainStep.Params[NodeGroupIDKey.String()] = info.NodeGroupID
+		drainStep.Params[NodeIPsKey.String()] = strings.Join(nodeIPs, ",")
+		drainStep.Params[BatchKey.String()] = batch
+
+		// step2: cloudprovider replace nodes
+		upgradeStep, err := upgrade(batchID, nodes)
+		if err != nil {
+			return fmt.Errorf("BuildRollingUpgradeSteps nodegroup %s batch %s failed: %v",
+				info.NodeGroupID, batch, err)
+		}
+		upgradeStep.Params[BatchKey.String()] = batch
+
+		// step3: check nodes status & version, uncordon nodes
+		checkStep := NewCommonStep(fmt.Sprintf("%s-%s", CheckUpgradedNodesTask, batchID), CheckUpgradedNodesTask,
+			CheckUpgradedNodesStep)
+		checkStep.Params[ClusterIDKey.String()] = info.ClusterID
+		checkStep.Params[NodeGroupIDKey.String()] = info.NodeGroupID
+		checkStep.Params[NodeIPsKey.String()] = strings.Join(nodeIPs, ",")
+		checkStep.Params[UpgradeVersionKey.String()] = info.Version
+		checkStep.Params[BatchKey.String()] = batch
+
+		for _, step := range []*proto.Step{drainStep, upgradeStep, checkStep} {
+			if _, ok := task.Steps[step.Name]; ok {
+				return fmt.Errorf("BuildRollingUpgradeSteps step %s duplicated", step.Name)
+			}
+			task.Steps[step.Name] = step
+			task.StepSequence = append(task.StepSequence, step.Name)
+		}
+	}
+
+	return nil
+}
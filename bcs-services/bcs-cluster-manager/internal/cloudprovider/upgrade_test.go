@@ -0,0 +1,114 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cloudprovider
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+)
+
+func newTestNodes(num int) []*proto.Node {
+	nodes := make([]*proto.Node, 0, num)
+	for i := 0; i < num; i++ {
+		nodes = append(nodes, &proto.Node{InnerIP: fmt.Sprintf("127.0.0.%d", i+1)})
+	}
+	return nodes
+}
+
+func TestSplitNodesBatch(t *testing.T) {
+	tests := []struct {
+		name           string
+		nodes          int
+		maxUnavailable uint32
+		batchSizes     []int
+	}{
+		{name: "no nodes", nodes: 0, maxUnavailable: 2, batchSizes: []int{}},
+		{name: "zero maxUnavailable means one by one", nodes: 3, maxUnavailable: 0, batchSizes: []int{1, 1, 1}},
+		{name: "last batch is smaller", nodes: 5, maxUnavailable: 2, batchSizes: []int{2, 2, 1}},
+		{name: "exact batches", nodes: 4, maxUnavailable: 2, batchSizes: []int{2, 2}},
+		{name: "maxUnavailable larger than nodes", nodes: 3, maxUnavailable: 10, batchSizes: []int{3}},
+	}
+	for _, test := range tests {
+		nodes := newTestNodes(test.nodes)
+		batches := SplitNodesBatch(nodes, test.maxUnavailable)
+		sizes := make([]int, 0)
+		split := make([]*proto.Node, 0)
+		for _, batch := range batches {
+			sizes = append(sizes, len(batch))
+			split = append(split, batch...)
+		}
+		if !reflect.DeepEqual(sizes, test.batchSizes) {
+			t.Errorf("%s: expect batch sizes %v, but get %v", test.name, test.batchSizes, sizes)
+		}
+		// every node is in exactly one batch, and the order is kept
+		if !reflect.DeepEqual(split, nodes) {
+			t.Errorf("%s: nodes are not split in order", test.name)
+		}
+	}
+}
+
+func TestBuildRollingUpgradeSteps(t *testing.T) {
+	upgrade := func(batchID string, nodes []*proto.Node) (*proto.Step, error) {
+		return NewCommonStep("upgrade-"+batchID, "upgradeNodes", "upgrade"), nil
+	}
+	info := RollingUpgradeInfo{
+		ClusterID:      "BCS-K8S-00001",
+		NodeGroupID:    "ng-1",
+		Version:        "1.20.6",
+		MaxUnavailable: 2,
+		Nodes:          newTestNodes(3),
+	}
+
+	task := &proto.Task{Steps: make(map[string]*proto.Step)}
+	if err := BuildRollingUpgradeSteps(task, info, upgrade); err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{
+		CordonDrainNodesTask + "-ng-1-1", "upgrade-ng-1-1", CheckUpgradedNodesTask + "-ng-1-1",
+		CordonDrainNodesTask + "-ng-1-2", "upgrade-ng-1-2", CheckUpgradedNodesTask + "-ng-1-2",
+	}
+	if !reflect.DeepEqual(task.StepSequence, expected) {
+		t.Fatalf("expect step sequence %v, but get %v", expected, task.StepSequence)
+	}
+	drain := task.Steps[CordonDrainNodesTask+"-ng-1-1"]
+	if drain.Params[NodeIPsKey.String()] != "127.0.0.1,127.0.0.2" || drain.Params[BatchKey.String()] != "1/2" {
+		t.Errorf("unexpected drain step params %v", drain.Params)
+	}
+	check := task.Steps[CheckUpgradedNodesTask+"-ng-1-2"]
+	if check.Params[NodeIPsKey.String()] != "127.0.0.3" || check.Params[UpgradeVersionKey.String()] != "1.20.6" ||
+		check.Params[ClusterIDKey.String()] != "BCS-K8S-00001" {
+		t.Errorf("unexpected check step params %v", check.Params)
+	}
+	if task.Steps["upgrade-ng-1-2"].Params[BatchKey.String()] != "2/2" {
+		t.Errorf("expect upgrade step of batch 2/2, but get %v", task.Steps["upgrade-ng-1-2"].Params)
+	}
+
+	// steps of the same nodegroup cannot be built twice in one task
+	if err := BuildRollingUpgradeSteps(task, info, upgrade); err == nil {
+		t.Errorf("expect duplicated steps error")
+	}
+	// failure of cloudprovider step is returned
+	failed := func(batchID string, nodes []*proto.Node) (*proto.Step, error) {
+		return nil, fmt.Errorf("not supported")
+	}
+	if err := BuildRollingUpgradeSteps(&proto.Task{Steps: make(map[string]*proto.Step)}, info, failed); err == nil {
+		t.Errorf("expect upgrade step error")
+	}
+	if err := BuildRollingUpgradeSteps(nil, info, upgrade); err == nil {
+		t.Errorf("expect empty task error")
+	}
+}
@@ -35,6 +35,16 @@ import (
 var (
 	// BKSOPTask bk-sops common job
 	BKSOPTask = "bksopsjob"
+	// UpgradePreCheckTask cluster upgrade pre-check common job
+	UpgradePreCheckTask = "upgradeprecheckjob"
+	// UpdateClusterVersionDBTask update cluster version common job
+	UpdateClusterVersionDBTask = "updateclusterversiondbjob"
+	// CordonDrainNodesTask cordon and drain nodes common job
+	CordonDrainNodesTask = "cordondrainnodesjob"
+	// CheckUpgradedNodesTask check upgraded nodes common job
+	CheckUpgradedNodesTask = "checkupgradednodesjob"
+	// DrainNodesTask cordon nodes and drain nodes gracefully common job
+	DrainNodesTask = "drainnodesjob"
 	// TaskID inject taskID into ctx
 	TaskID = "taskID"
 )
@@ -173,6 +183,14 @@ var (
 
 	// CleanNodeGroupNodes task
 	CleanNodeGroupNodes TaskType = "CleanNodeGroupNodes"
+
+	// UpgradeCluster task
+	UpgradeCluster TaskType = "UpgradeCluster"
+	// UpgradeNodeGroup task
+	UpgradeNodeGroup TaskType = "UpgradeNodeGroup"
+
+	// DrainNode task
+	DrainNode TaskType = "DrainNode"
 )
 
 // GetTaskType getTaskType by cloud
@@ -26,6 +26,7 @@ import (
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store"
 
 	"github.com/Tencent/bk-bcs/bcs-common/common/modules"
+	"k8s.io/client-go/dynamic"
 	k8scorecliset "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -74,6 +75,31 @@ func NewKubeClient(kubeConfig string) (k8scorecliset.Interface, error) {
 
 // GetClusterClient get cluster client
 func (ko *K8SOperator) GetClusterClient(clusterID string) (k8scorecliset.Interface, error) {
+	cfg, err := ko.getClusterRestConfig(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	cliset, err := k8scorecliset.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return cliset, nil
+}
+
+// GetClusterDynamicClient get cluster dynamic client for custom resources
+func (ko *K8SOperator) GetClusterDynamicClient(clusterID string) (dynamic.Interface, error) {
+	cfg, err := ko.getClusterRestConfig(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	cli, err := dynamic.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return cli, nil
+}
+
+func (ko *K8SOperator) getClusterRestConfig(clusterID string) (*rest.Config, error) {
 	cred, found, err := ko.model.GetClusterCredential(context.TODO(), clusterID)
 	if err != nil {
 		return nil, err
@@ -100,11 +126,7 @@ func (ko *K8SOperator) GetClusterClient(clusterID string) (k8scorecliset.Interfa
 				Insecure: true,
 			}
 		}
-		cliset, err := k8scorecliset.NewForConfig(cfg)
-		if err != nil {
-			return nil, err
-		}
-		return cliset, nil
+		return cfg, nil
 	} else if cred.ConnectMode == modules.BCSConnectModeDirect {
 		addressList := strings.Split(cred.ServerAddress, ",")
 		if len(addressList) == 0 {
@@ -120,11 +142,7 @@ func (ko *K8SOperator) GetClusterClient(clusterID string) (k8scorecliset.Interfa
 			KeyData:  []byte(cred.ClientKey),
 		}
 		cfg.BearerToken = cred.UserToken
-		cliset, err := k8scorecliset.NewForConfig(cfg)
-		if err != nil {
-			return nil, err
-		}
-		return cliset, nil
+		return cfg, nil
 	}
 
 	return nil, fmt.Errorf("invalid credential mode %s of cluster %s", cred.ConnectMode, clusterID)
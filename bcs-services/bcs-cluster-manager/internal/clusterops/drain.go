@@ -0,0 +1,286 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package clusterops
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/dynamic"
+	k8scorecliset "k8s.io/client-go/kubernetes"
+)
+
+var (
+	// evictRetryInterval retry interval when eviction is refused by PodDisruptionBudget
+	evictRetryInterval = 5 * time.Second
+	// forceDeleteTimeout timeout for deleting pods directly when drain timeout
+	forceDeleteTimeout = 2 * time.Minute
+)
+
+// DrainProgressFunc receive drain progress of every pod
+type DrainProgressFunc func(msg string)
+
+// DrainNodeInfo drain node info
+type DrainNodeInfo struct {
+	ClusterID string
+	NodeName  string
+	// Timeout total time for evicting pods and waiting pods deleted
+	Timeout time.Duration
+	// Force evict pods not managed by controller, evict pods when PreDelete hook failed and
+	// delete pods directly without grace period when pods are still not deleted at timeout
+	Force bool
+	// GracePeriodSeconds overrides pod terminationGracePeriodSeconds when greater than 0
+	GracePeriodSeconds int64
+	// Progress receive drain progress, calls are serialized
+	Progress DrainProgressFunc
+}
+
+// ClusterDrainNode evict pods on node except daemonSet and mirror pods. eviction is refused
+// by apiserver when PodDisruptionBudget is violated, thus retry until timeout. pods of
+// GameDeployment/GameStatefulSet are evicted after PreDelete hook successful
+func (ko *K8SOperator) ClusterDrainNode(ctx context.Context, info DrainNodeInfo) error {
+	if ko == nil {
+		return ErrServerNotInit
+	}
+	clientInterface, err := ko.GetClusterClient(info.ClusterID)
+	if err != nil {
+		blog.Errorf("ClusterDrainNode GetClusterClient failed: %v", err)
+		return err
+	}
+	if info.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, info.Timeout)
+		defer cancel()
+	}
+
+	podList, err := clientInterface.CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.nodeName", info.NodeName).String(),
+	})
+	if err != nil {
+		blog.Errorf("ClusterDrainNode cluster[%s] list node[%s] pods failed: %v", info.ClusterID, info.NodeName, err)
+		return err
+	}
+
+	pods, unmanaged := make([]corev1.Pod, 0), make([]string, 0)
+	for _, pod := range podList.Items {
+		if skipDrainPod(pod) {
+			continue
+		}
+		if metav1.GetControllerOf(&pod) == nil {
+			unmanaged = append(unmanaged, pod.Namespace+"/"+pod.Name)
+		}
+		pods = append(pods, pod)
+	}
+	// pods not managed by controller will never be recreated
+	if len(unmanaged) > 0 && !info.Force {
+		return fmt.Errorf("pods %s are not managed by controller, drain node with force",
+			strings.Join(unmanaged, ","))
+	}
+
+	d := &drainer{
+		ko:   ko,
+		info: info,
+		cli:  clientInterface,
+	}
+	d.progress("node %s has %d pods to evict", info.NodeName, len(pods))
+
+	var (
+		wg   sync.WaitGroup
+		lock sync.Mutex
+		errs = make([]error, 0)
+	)
+	for i := range pods {
+		wg.Add(1)
+		go func(pod *corev1.Pod) {
+			defer wg.Done()
+			if err := d.drainPod(ctx, pod); err != nil {
+				d.progress("pod %s/%s drain failed: %v", pod.Namespace, pod.Name, err)
+				lock.Lock()
+				errs = append(errs, err)
+				lock.Unlock()
+			}
+		}(&pods[i])
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		blog.Errorf("ClusterDrainNode cluster[%s] node[%s] drain pods failed: %v",
+			info.ClusterID, info.NodeName, utilerrors.NewAggregate(errs))
+		return utilerrors.NewAggregate(errs)
+	}
+	blog.Infof("ClusterDrainNode cluster[%s] node[%s] drain %d pods successful",
+		info.ClusterID, info.NodeName, len(pods))
+
+	return nil
+}
+
+// skipDrainPod daemonSet pods are recreated on node and mirror pods are managed by kubelet
+func skipDrainPod(pod corev1.Pod) bool {
+	if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		return true
+	}
+	if _, ok := pod.Annotations[corev1.MirrorPodAnnotationKey]; ok {
+		return true
+	}
+	controller := metav1.GetControllerOf(&pod)
+	return controller != nil && controller.Kind == "DaemonSet"
+}
+
+// drainer evict pods of one node
+type drainer struct {
+	ko   *K8SOperator
+	info DrainNodeInfo
+	cli  k8scorecliset.Interface
+
+	lock   sync.Mutex
+	dynCli dynamic.Interface
+}
+
+func (d *drainer) progress(format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	blog.Infof("ClusterDrainNode cluster[%s] node[%s]: %s", d.info.ClusterID, d.info.NodeName, msg)
+
+	if d.info.Progress == nil {
+		return
+	}
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.info.Progress(msg)
+}
+
+// getDynamicClient dynamic client is only required by pods with PreDelete hook
+func (d *drainer) getDynamicClient() (dynamic.Interface, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if d.dynCli != nil {
+		return d.dynCli, nil
+	}
+	cli, err := d.ko.GetClusterDynamicClient(d.info.ClusterID)
+	if err != nil {
+		return nil, err
+	}
+	d.dynCli = cli
+	return cli, nil
+}
+
+func (d *drainer) deleteOptions() *metav1.DeleteOptions {
+	opt := &metav1.DeleteOptions{}
+	if d.info.GracePeriodSeconds > 0 {
+		grace := d.info.GracePeriodSeconds
+		opt.GracePeriodSeconds = &grace
+	}
+	return opt
+}
+
+// drainPod run PreDelete hook, evict pod and wait pod deleted
+func (d *drainer) drainPod(ctx context.Context, pod *corev1.Pod) error {
+	key := pod.Namespace + "/" + pod.Name
+
+	if err := d.runPreDeleteHook(ctx, pod); err != nil {
+		if !d.info.Force {
+			return fmt.Errorf("pod %s PreDelete hook failed: %v", key, err)
+		}
+		d.progress("pod %s PreDelete hook failed: %v, evict pod by force", key, err)
+	}
+
+	err := d.evictPod(ctx, pod)
+	if err == nil {
+		err = waitPodDeleted(ctx, d.cli, pod)
+	}
+	if err == nil {
+		d.progress("pod %s deleted", key)
+		return nil
+	}
+	if !d.info.Force || ctx.Err() == nil {
+		return err
+	}
+
+	// eviction is still refused or pod is still terminating at timeout, kubelet of node
+	// may be unavailable thus pod is deleted without grace period
+	d.progress("pod %s drain timeout: %v, delete pod by force", key, err)
+	forceCtx, cancel := context.WithTimeout(context.Background(), forceDeleteTimeout)
+	defer cancel()
+	var grace int64
+	err = d.cli.CoreV1().Pods(pod.Namespace).Delete(forceCtx, pod.Name, metav1.DeleteOptions{
+		GracePeriodSeconds: &grace,
+		Preconditions:      metav1.NewUIDPreconditions(string(pod.UID)),
+	})
+	// pod with same name may be recreated by statefulset
+	if err != nil && !errors.IsNotFound(err) && !errors.IsConflict(err) {
+		return fmt.Errorf("delete pod %s failed: %v", key, err)
+	}
+	if err = waitPodDeleted(forceCtx, d.cli, pod); err != nil {
+		return err
+	}
+	d.progress("pod %s deleted by force", key)
+	return nil
+}
+
+func (d *drainer) evictPod(ctx context.Context, pod *corev1.Pod) error {
+	eviction := &policyv1beta1.Eviction{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pod.Name,
+			Namespace: pod.Namespace,
+		},
+		DeleteOptions: d.deleteOptions(),
+	}
+	d.progress("evicting pod %s/%s", pod.Namespace, pod.Name)
+
+	blocked := false
+	for {
+		err := d.cli.PolicyV1beta1().Evictions(pod.Namespace).Evict(ctx, eviction)
+		if err == nil || errors.IsNotFound(err) {
+			return nil
+		}
+		// eviction violates PodDisruptionBudget, retry later
+		if !errors.IsTooManyRequests(err) {
+			return err
+		}
+		if !blocked {
+			blocked = true
+			d.progress("pod %s/%s eviction is refused: %v, retry every %s",
+				pod.Namespace, pod.Name, err, evictRetryInterval)
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("evict pod %s/%s timeout: %v", pod.Namespace, pod.Name, err)
+		case <-time.After(evictRetryInterval):
+		}
+	}
+}
+
+func waitPodDeleted(ctx context.Context, cli k8scorecliset.Interface, pod *corev1.Pod) error {
+	for {
+		p, err := cli.CoreV1().Pods(pod.Namespace).Get(ctx, pod.Name, metav1.GetOptions{})
+		if errors.IsNotFound(err) || (err == nil && p.UID != pod.UID) {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("wait pod %s/%s deleted timeout", pod.Namespace, pod.Name)
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
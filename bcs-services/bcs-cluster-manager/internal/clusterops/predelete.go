@@ -0,0 +1,256 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package clusterops
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// pods of GameDeployment/GameStatefulSet are deleted by bcs-gamedeployment-operator and
+// bcs-gamestatefulset-operator after PreDelete hook successful, eviction bypasses the hook.
+// thus drainer creates PreDelete HookRun same as operators do and waits it successful.
+
+const (
+	hookRunTypeLabel      = "hookrun-type"
+	hookRunTypePreDelete  = "pre-delete-step"
+	workloadRevisionLabel = "workload-revision"
+	instanceIDLabel       = "instance-id"
+	podDeletingAnnotation = "io.tencent.bcs.dev/game-pod-deleting"
+
+	hookPhaseSuccessful   = "Successful"
+	hookPhaseFailed       = "Failed"
+	hookPhaseError        = "Error"
+	hookPhaseInconclusive = "Inconclusive"
+)
+
+var (
+	tkexGroupVersion = schema.GroupVersion{Group: "tkex.tencent.com", Version: "v1alpha1"}
+	hookRunGVR       = tkexGroupVersion.WithResource("hookruns")
+	hookTemplateGVR  = tkexGroupVersion.WithResource("hooktemplates")
+
+	// hookRunCheckInterval interval for checking HookRun phase
+	hookRunCheckInterval = 5 * time.Second
+)
+
+// hookWorkload workload supports PreDelete hook
+type hookWorkload struct {
+	resource schema.GroupVersionResource
+	// instanceLabel pod label of instance id
+	instanceLabel string
+}
+
+var hookWorkloads = map[string]hookWorkload{
+	"GameDeployment": {
+		resource:      tkexGroupVersion.WithResource("gamedeployments"),
+		instanceLabel: "tkex.bkbcs.tencent.com/gamedeployment-instance-id",
+	},
+	"GameStatefulSet": {
+		resource:      tkexGroupVersion.WithResource("gamestatefulsets"),
+		instanceLabel: "gamestatefulset.kubernetes.io/pod-ordinal",
+	},
+}
+
+// runPreDeleteHook create PreDelete HookRun of pod if not exist and wait HookRun finished,
+// pods without PreDelete hook return nil directly
+func (d *drainer) runPreDeleteHook(ctx context.Context, pod *corev1.Pod) error {
+	// operators only run hook for running pods
+	if pod.Status.Phase != corev1.PodRunning {
+		return nil
+	}
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil || owner.APIVersion != tkexGroupVersion.String() {
+		return nil
+	}
+	workload, ok := hookWorkloads[owner.Kind]
+	if !ok {
+		return nil
+	}
+
+	cli, err := d.getDynamicClient()
+	if err != nil {
+		return err
+	}
+	obj, err := cli.Resource(workload.resource).Namespace(pod.Namespace).Get(ctx, owner.Name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("get %s %s/%s failed: %v", owner.Kind, pod.Namespace, owner.Name, err)
+	}
+	templateName, _, _ := unstructured.NestedString(obj.Object, "spec", "preDeleteUpdateStrategy", "hook",
+		"templateName")
+	if len(templateName) == 0 {
+		return nil
+	}
+
+	hookRun, err := d.getOrCreatePreDeleteHookRun(ctx, pod, obj, workload, templateName)
+	if err != nil {
+		return err
+	}
+	d.progress("pod %s/%s waiting PreDelete HookRun %s", pod.Namespace, pod.Name, hookRun)
+
+	for {
+		run, err := cli.Resource(hookRunGVR).Namespace(pod.Namespace).Get(ctx, hookRun, metav1.GetOptions{})
+		if err == nil {
+			phase, _, _ := unstructured.NestedString(run.Object, "status", "phase")
+			switch phase {
+			case hookPhaseSuccessful:
+				d.progress("pod %s/%s PreDelete HookRun %s successful", pod.Namespace, pod.Name, hookRun)
+				return nil
+			case hookPhaseFailed, hookPhaseError, hookPhaseInconclusive:
+				message, _, _ := unstructured.NestedString(run.Object, "status", "message")
+				return fmt.Errorf("HookRun %s phase %s: %s", hookRun, phase, message)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("wait HookRun %s timeout", hookRun)
+		case <-time.After(hookRunCheckInterval):
+		}
+	}
+}
+
+// getOrCreatePreDeleteHookRun reuse HookRun created by operator or previous drain
+func (d *drainer) getOrCreatePreDeleteHookRun(ctx context.Context, pod *corev1.Pod,
+	workloadObj *unstructured.Unstructured, workload hookWorkload, templateName string) (string, error) {
+	cli, err := d.getDynamicClient()
+	if err != nil {
+		return "", err
+	}
+	revision := pod.Labels["controller-revision-hash"]
+	instanceID := pod.Labels[workload.instanceLabel]
+	hookLabels := map[string]string{
+		hookRunTypeLabel:      hookRunTypePreDelete,
+		workloadRevisionLabel: revision,
+		instanceIDLabel:       instanceID,
+	}
+
+	runs, err := cli.Resource(hookRunGVR).Namespace(pod.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(hookLabels).String(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("list HookRuns failed: %v", err)
+	}
+	for _, run := range runs.Items {
+		if ref := metav1.GetControllerOf(&run); ref != nil && ref.UID == workloadObj.GetUID() {
+			return run.GetName(), nil
+		}
+	}
+
+	tpl, err := cli.Resource(hookTemplateGVR).Namespace(pod.Namespace).Get(ctx, templateName, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("get HookTemplate %s failed: %v", templateName, err)
+	}
+	args, err := buildPreDeleteHookArgs(pod, workloadObj, tpl)
+	if err != nil {
+		return "", err
+	}
+	metrics, _, _ := unstructured.NestedSlice(tpl.Object, "spec", "metrics")
+	policy, _, _ := unstructured.NestedString(tpl.Object, "spec", "policy")
+
+	run := &unstructured.Unstructured{}
+	run.SetAPIVersion(tkexGroupVersion.String())
+	run.SetKind("HookRun")
+	run.SetName(fmt.Sprintf("predelete-%s-%s-%s", revision, instanceID, templateName))
+	run.SetNamespace(pod.Namespace)
+	run.SetLabels(hookLabels)
+	isController := true
+	run.SetOwnerReferences([]metav1.OwnerReference{{
+		APIVersion: workloadObj.GetAPIVersion(),
+		Kind:       workloadObj.GetKind(),
+		Name:       workloadObj.GetName(),
+		UID:        workloadObj.GetUID(),
+		Controller: &isController,
+	}})
+	run.Object["spec"] = map[string]interface{}{
+		"metrics": metrics,
+		"args":    args,
+	}
+	if len(policy) > 0 {
+		_ = unstructured.SetNestedField(run.Object, policy, "spec", "policy")
+	}
+
+	created, err := cli.Resource(hookRunGVR).Namespace(pod.Namespace).Create(ctx, run, metav1.CreateOptions{})
+	if err != nil {
+		return "", fmt.Errorf("create HookRun %s failed: %v", run.GetName(), err)
+	}
+	d.progress("pod %s/%s PreDelete HookRun %s created", pod.Namespace, pod.Name, created.GetName())
+
+	// mark pod deleting as operators do
+	patch := fmt.Sprintf(`{"metadata":{"annotations":{%q:"true"}}}`, podDeletingAnnotation)
+	_, err = d.cli.CoreV1().Pods(pod.Namespace).Patch(ctx, pod.Name, types.MergePatchType, []byte(patch),
+		metav1.PatchOptions{})
+	if err != nil {
+		d.progress("pod %s/%s patch deleting annotation failed: %v", pod.Namespace, pod.Name, err)
+	}
+
+	return created.GetName(), nil
+}
+
+// buildPreDeleteHookArgs template args are overridden by workload hook args, then pod args
+// are appended. all args must be resolved
+func buildPreDeleteHookArgs(pod *corev1.Pod, workloadObj, tpl *unstructured.Unstructured) ([]interface{}, error) {
+	values := make(map[string]string)
+	hookArgs, _, _ := unstructured.NestedSlice(workloadObj.Object, "spec", "preDeleteUpdateStrategy", "hook",
+		"args")
+	for _, arg := range hookArgs {
+		m, ok := arg.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _, _ := unstructured.NestedString(m, "name")
+		value, _, _ := unstructured.NestedString(m, "value")
+		values[name] = value
+	}
+
+	args := make([]interface{}, 0)
+	tplArgs, _, _ := unstructured.NestedSlice(tpl.Object, "spec", "args")
+	for _, arg := range tplArgs {
+		m, ok := arg.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _, _ := unstructured.NestedString(m, "name")
+		value, found, _ := unstructured.NestedString(m, "value")
+		if v, ok := values[name]; ok {
+			value, found = v, true
+		}
+		if !found {
+			return nil, fmt.Errorf("HookTemplate %s args %s unresolved", tpl.GetName(), name)
+		}
+		args = append(args, map[string]interface{}{"name": name, "value": value})
+	}
+
+	podArgs := [][2]string{
+		{"PodName", pod.Name},
+		{"PodNamespace", pod.Namespace},
+		{"PodIP", pod.Status.PodIP},
+		{"HostIP", pod.Status.HostIP},
+	}
+	for i, c := range pod.Spec.Containers {
+		podArgs = append(podArgs, [2]string{"PodContainer[" + strconv.Itoa(i) + "]", c.Name})
+	}
+	for _, arg := range podArgs {
+		args = append(args, map[string]interface{}{"name": arg[0], "value": arg[1]})
+	}
+
+	return args, nil
+}
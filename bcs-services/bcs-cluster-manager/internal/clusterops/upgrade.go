@@ -0,0 +1,236 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package clusterops
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/version"
+)
+
+const (
+	// lastAppliedConfigAnnotation kubectl apply annotation, record the apiVersion user used
+	lastAppliedConfigAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+)
+
+// deprecatedAPI resource groupVersion which is removed in kubernetes version
+type deprecatedAPI struct {
+	GroupVersion string
+	Resource     string
+	RemovedIn    string
+}
+
+// deprecatedAPIs apis which are removed in kubernetes upstream
+var deprecatedAPIs = []deprecatedAPI{
+	{GroupVersion: "extensions/v1beta1", Resource: "deployments", RemovedIn: "1.16"},
+	{GroupVersion: "extensions/v1beta1", Resource: "daemonsets", RemovedIn: "1.16"},
+	{GroupVersion: "extensions/v1beta1", Resource: "replicasets", RemovedIn: "1.16"},
+	{GroupVersion: "extensions/v1beta1", Resource: "networkpolicies", RemovedIn: "1.16"},
+	{GroupVersion: "apps/v1beta1", Resource: "deployments", RemovedIn: "1.16"},
+	{GroupVersion: "apps/v1beta1", Resource: "statefulsets", RemovedIn: "1.16"},
+	{GroupVersion: "apps/v1beta2", Resource: "deployments", RemovedIn: "1.16"},
+	{GroupVersion: "apps/v1beta2", Resource: "statefulsets", RemovedIn: "1.16"},
+	{GroupVersion: "apps/v1beta2", Resource: "daemonsets", RemovedIn: "1.16"},
+	{GroupVersion: "apps/v1beta2", Resource: "replicasets", RemovedIn: "1.16"},
+	{GroupVersion: "extensions/v1beta1", Resource: "ingresses", RemovedIn: "1.22"},
+	{GroupVersion: "networking.k8s.io/v1beta1", Resource: "ingresses", RemovedIn: "1.22"},
+	{GroupVersion: "rbac.authorization.k8s.io/v1beta1", Resource: "clusterroles", RemovedIn: "1.22"},
+	{GroupVersion: "rbac.authorization.k8s.io/v1beta1", Resource: "clusterrolebindings", RemovedIn: "1.22"},
+	{GroupVersion: "rbac.authorization.k8s.io/v1beta1", Resource: "roles", RemovedIn: "1.22"},
+	{GroupVersion: "rbac.authorization.k8s.io/v1beta1", Resource: "rolebindings", RemovedIn: "1.22"},
+	{GroupVersion: "apiextensions.k8s.io/v1beta1", Resource: "customresourcedefinitions", RemovedIn: "1.22"},
+	{GroupVersion: "admissionregistration.k8s.io/v1beta1", Resource: "mutatingwebhookconfigurations",
+		RemovedIn: "1.22"},
+	{GroupVersion: "admissionregistration.k8s.io/v1beta1", Resource: "validatingwebhookconfigurations",
+		RemovedIn: "1.22"},
+	{GroupVersion: "scheduling.k8s.io/v1beta1", Resource: "priorityclasses", RemovedIn: "1.22"},
+	{GroupVersion: "storage.k8s.io/v1beta1", Resource: "storageclasses", RemovedIn: "1.22"},
+	{GroupVersion: "certificates.k8s.io/v1beta1", Resource: "certificatesigningrequests", RemovedIn: "1.22"},
+	{GroupVersion: "batch/v1beta1", Resource: "cronjobs", RemovedIn: "1.25"},
+	{GroupVersion: "policy/v1beta1", Resource: "poddisruptionbudgets", RemovedIn: "1.25"},
+	{GroupVersion: "policy/v1beta1", Resource: "podsecuritypolicies", RemovedIn: "1.25"},
+	{GroupVersion: "autoscaling/v2beta1", Resource: "horizontalpodautoscalers", RemovedIn: "1.25"},
+	{GroupVersion: "autoscaling/v2beta2", Resource: "horizontalpodautoscalers", RemovedIn: "1.26"},
+}
+
+// DeprecatedAPIResource resource which still use removed api
+type DeprecatedAPIResource struct {
+	GroupVersion string
+	Resource     string
+	RemovedIn    string
+	// Objects namespace/name list
+	Objects []string
+}
+
+// String to string
+func (dr DeprecatedAPIResource) String() string {
+	return fmt.Sprintf("%s/%s(removed in %s): %s", dr.GroupVersion, dr.Resource, dr.RemovedIn,
+		strings.Join(dr.Objects, ","))
+}
+
+// ListDeprecatedAPIResources list resources which are applied by apis removed in dstVersion,
+// apiVersion is recognized by kubectl last-applied-configuration annotation
+func (ko *K8SOperator) ListDeprecatedAPIResources(ctx context.Context, clusterID string,
+	curVersion, dstVersion string) ([]DeprecatedAPIResource, error) {
+	if ko == nil {
+		return nil, ErrServerNotInit
+	}
+	current, err := version.ParseGeneric(curVersion)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cluster version %s: %v", curVersion, err)
+	}
+	target, err := version.ParseGeneric(dstVersion)
+	if err != nil {
+		return nil, fmt.Errorf("invalid upgrade version %s: %v", dstVersion, err)
+	}
+
+	clientInterface, err := ko.GetClusterClient(clusterID)
+	if err != nil {
+		blog.Errorf("ListDeprecatedAPIResources GetClusterClient failed: %v", err)
+		return nil, err
+	}
+
+	results := make([]DeprecatedAPIResource, 0)
+	for _, api := range deprecatedAPIs {
+		removed := version.MustParseGeneric(api.RemovedIn)
+		// api already removed or still served by dstVersion
+		if !current.LessThan(removed) || target.LessThan(removed) {
+			continue
+		}
+		// api is not served by cluster
+		if _, err = clientInterface.Discovery().ServerResourcesForGroupVersion(api.GroupVersion); err != nil {
+			continue
+		}
+		data, err := clientInterface.Discovery().RESTClient().Get().
+			AbsPath("/apis", api.GroupVersion, api.Resource).Do(ctx).Raw()
+		if err != nil {
+			blog.Errorf("ListDeprecatedAPIResources cluster[%s] list %s/%s failed: %v",
+				clusterID, api.GroupVersion, api.Resource, err)
+			return nil, err
+		}
+		objects := &metav1.PartialObjectMetadataList{}
+		if err = json.Unmarshal(data, objects); err != nil {
+			return nil, err
+		}
+
+		resource := DeprecatedAPIResource{
+			GroupVersion: api.GroupVersion,
+			Resource:     api.Resource,
+			RemovedIn:    api.RemovedIn,
+		}
+		for _, obj := range objects.Items {
+			if getLastAppliedAPIVersion(obj.Annotations) != api.GroupVersion {
+				continue
+			}
+			if len(obj.Namespace) > 0 {
+				resource.Objects = append(resource.Objects, obj.Namespace+"/"+obj.Name)
+				continue
+			}
+			resource.Objects = append(resource.Objects, obj.Name)
+		}
+		if len(resource.Objects) > 0 {
+			results = append(results, resource)
+		}
+	}
+
+	return results, nil
+}
+
+func getLastAppliedAPIVersion(annotations map[string]string) string {
+	config, ok := annotations[lastAppliedConfigAnnotation]
+	if !ok {
+		return ""
+	}
+	typeMeta := &metav1.TypeMeta{}
+	if err := json.Unmarshal([]byte(config), typeMeta); err != nil {
+		return ""
+	}
+	return typeMeta.APIVersion
+}
+
+// ListBlockingPodDisruptionBudgets list pdbs which don't allow any disruption,
+// node drain will be blocked by these pdbs
+func (ko *K8SOperator) ListBlockingPodDisruptionBudgets(ctx context.Context, clusterID string) ([]string, error) {
+	if ko == nil {
+		return nil, ErrServerNotInit
+	}
+	clientInterface, err := ko.GetClusterClient(clusterID)
+	if err != nil {
+		blog.Errorf("ListBlockingPodDisruptionBudgets GetClusterClient failed: %v", err)
+		return nil, err
+	}
+
+	pdbs, err := clientInterface.PolicyV1beta1().PodDisruptionBudgets(metav1.NamespaceAll).
+		List(ctx, metav1.ListOptions{})
+	if err != nil {
+		blog.Errorf("ListBlockingPodDisruptionBudgets cluster[%s] list pdb failed: %v", clusterID, err)
+		return nil, err
+	}
+
+	blocking := make([]string, 0)
+	for _, pdb := range pdbs.Items {
+		if pdb.Status.ExpectedPods > 0 && pdb.Status.DisruptionsAllowed == 0 {
+			blocking = append(blocking, pdb.Namespace+"/"+pdb.Name)
+		}
+	}
+	return blocking, nil
+}
+
+// CheckNodesVersionReady check nodes are ready and kubelet version is upgraded to dstVersion,
+// return nodes which are not ready
+func (ko *K8SOperator) CheckNodesVersionReady(ctx context.Context, clusterID string, nodeNames []string,
+	dstVersion string) ([]string, error) {
+	if ko == nil {
+		return nil, ErrServerNotInit
+	}
+	target, err := version.ParseGeneric(dstVersion)
+	if err != nil {
+		return nil, fmt.Errorf("invalid upgrade version %s: %v", dstVersion, err)
+	}
+	clientInterface, err := ko.GetClusterClient(clusterID)
+	if err != nil {
+		blog.Errorf("CheckNodesVersionReady GetClusterClient failed: %v", err)
+		return nil, err
+	}
+
+	notReady := make([]string, 0)
+	for _, name := range nodeNames {
+		node, err := clientInterface.CoreV1().Nodes().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			blog.Warnf("CheckNodesVersionReady cluster[%s] get node[%s] failed: %v", clusterID, name, err)
+			notReady = append(notReady, name)
+			continue
+		}
+		kubelet, err := version.ParseGeneric(node.Status.NodeInfo.KubeletVersion)
+		if err != nil || !isNodeReady(node) || kubelet.LessThan(target) {
+			notReady = append(notReady, name)
+		}
+	}
+	return notReady, nil
+}
+
+func isNodeReady(node *corev1.Node) bool {
+	for _, cond := range node.Status.Conditions {
+		if cond.Type == corev1.NodeReady {
+			return cond.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
@@ -35,12 +35,16 @@ var (
 	CloudVPC ResourceType = "cloudvpc"
 	// ClusterCredential type
 	ClusterCredential ResourceType = "clustercredential"
+	// ClusterTemplate type
+	ClusterTemplate ResourceType = "clustertemplate"
 	// NameSpace type
 	NameSpace ResourceType = "namespace"
 	// NameSpaceQuota type
 	NameSpaceQuota ResourceType = "namespacequota"
 	// NodeGroup type
 	NodeGroup ResourceType = "nodegroup"
+	// NodeGroupTemplate type
+	NodeGroupTemplate ResourceType = "nodegrouptemplate"
 	// Project type
 	Project ResourceType = "project"
 	// Task type
@@ -153,6 +157,12 @@ const (
 	StatusCreateNodeGroupFailed = "CREATE-FAILURE"
 	// StatusNodeGroupUpdating xxx
 	StatusNodeGroupUpdating = "UPDATING"
+	// StatusUpgrading cluster/nodegroup status upgrading
+	StatusUpgrading = "UPGRADING"
+	// StatusUpgradeClusterFailed status upgrade cluster failed
+	StatusUpgradeClusterFailed = "UPGRADE-FAILURE"
+	// StatusUpgradeNodeGroupFailed status upgrade nodegroup failed
+	StatusUpgradeNodeGroupFailed = "UPGRADE-FAILURE"
 )
 
 const (
@@ -0,0 +1,217 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package drift
+
+import (
+	"sort"
+	"strconv"
+
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+)
+
+const (
+	// ResourceTypeCluster drift of cluster
+	ResourceTypeCluster = "cluster"
+	// ResourceTypeNodeGroup drift of nodegroup
+	ResourceTypeNodeGroup = "nodegroup"
+	// ResourceTypeNode drift of node
+	ResourceTypeNode = "node"
+)
+
+// fieldDrift field value differs between store and cloud, patch corrects store value with
+// cloud value and is nil when field is unsafe to be corrected automatically
+type fieldDrift struct {
+	resourceType string
+	resourceID   string
+	field        string
+	storeValue   string
+	cloudValue   string
+	patch        *fieldPatch
+}
+
+// fieldPatch set field by store key to cloud value, only when field in store still keeps
+// the value detected, thus field changed after detection is never overwritten
+type fieldPatch struct {
+	key        string
+	storeValue interface{}
+	cloudValue interface{}
+}
+
+// diffString append drift when cloud value is known and differs from store value,
+// drift is corrected by storeKey when storeKey is not empty
+func diffString(drifts []fieldDrift, resourceType, resourceID, field, storeValue, cloudValue string,
+	storeKey string) []fieldDrift {
+	if cloudValue == "" || storeValue == cloudValue {
+		return drifts
+	}
+	var patch *fieldPatch
+	if storeKey != "" {
+		patch = &fieldPatch{key: storeKey, storeValue: storeValue, cloudValue: cloudValue}
+	}
+	return append(drifts, fieldDrift{
+		resourceType: resourceType,
+		resourceID:   resourceID,
+		field:        field,
+		storeValue:   storeValue,
+		cloudValue:   cloudValue,
+		patch:        patch,
+	})
+}
+
+// diffUint32 append drift when cloud value is known and differs from store value,
+// drift is corrected by storeKey when storeKey is not empty
+func diffUint32(drifts []fieldDrift, resourceType, resourceID, field string, storeValue, cloudValue uint32,
+	storeKey string) []fieldDrift {
+	if cloudValue == 0 || storeValue == cloudValue {
+		return drifts
+	}
+	var patch *fieldPatch
+	if storeKey != "" {
+		patch = &fieldPatch{key: storeKey, storeValue: storeValue, cloudValue: cloudValue}
+	}
+	return append(drifts, fieldDrift{
+		resourceType: resourceType,
+		resourceID:   resourceID,
+		field:        field,
+		storeValue:   strconv.FormatUint(uint64(storeValue), 10),
+		cloudValue:   strconv.FormatUint(uint64(cloudValue), 10),
+		patch:        patch,
+	})
+}
+
+// compareCluster compare stored cluster with cluster got from cloud, fields which cloud
+// doesn't return are skipped. version & network capacity are safe to be corrected, vpc &
+// cidr drifts are only reported because network of cluster can't change silently
+func compareCluster(stored, cloud *proto.Cluster) []fieldDrift {
+	drifts := make([]fieldDrift, 0)
+	id := stored.ClusterID
+
+	drifts = diffString(drifts, ResourceTypeCluster, id, "clusterBasicSettings.version",
+		stored.GetClusterBasicSettings().GetVersion(), cloud.GetClusterBasicSettings().GetVersion(),
+		"clusterbasicsettings.version")
+	drifts = diffString(drifts, ResourceTypeCluster, id, "vpcID", stored.VpcID, cloud.VpcID, "")
+	drifts = diffString(drifts, ResourceTypeCluster, id, "networkSettings.clusterIPv4CIDR",
+		stored.GetNetworkSettings().GetClusterIPv4CIDR(), cloud.GetNetworkSettings().GetClusterIPv4CIDR(), "")
+	drifts = diffUint32(drifts, ResourceTypeCluster, id, "networkSettings.maxNodePodNum",
+		stored.GetNetworkSettings().GetMaxNodePodNum(), cloud.GetNetworkSettings().GetMaxNodePodNum(),
+		"networksettings.maxnodepodnum")
+	drifts = diffUint32(drifts, ResourceTypeCluster, id, "networkSettings.maxServiceNum",
+		stored.GetNetworkSettings().GetMaxServiceNum(), cloud.GetNetworkSettings().GetMaxServiceNum(),
+		"networksettings.maxservicenum")
+
+	return drifts
+}
+
+// compareNode compare stored node with node got from cloud, instance specification is safe
+// to be corrected, a different nodeID or vpc means instance is replaced and only reported
+func compareNode(stored, cloud *proto.Node) []fieldDrift {
+	drifts := make([]fieldDrift, 0)
+	id := stored.InnerIP
+
+	drifts = diffString(drifts, ResourceTypeNode, id, "nodeID", stored.NodeID, cloud.NodeID, "")
+	drifts = diffString(drifts, ResourceTypeNode, id, "VPC", stored.VPC, cloud.VPC, "")
+	drifts = diffString(drifts, ResourceTypeNode, id, "instanceType", stored.InstanceType, cloud.InstanceType,
+		"instancetype")
+	drifts = diffUint32(drifts, ResourceTypeNode, id, "CPU", stored.CPU, cloud.CPU, "cpu")
+	drifts = diffUint32(drifts, ResourceTypeNode, id, "mem", stored.Mem, cloud.Mem, "mem")
+	drifts = diffUint32(drifts, ResourceTypeNode, id, "GPU", stored.GPU, cloud.GPU, "gpu")
+	drifts = diffString(drifts, ResourceTypeNode, id, "zoneID", stored.ZoneID, cloud.ZoneID, "zoneid")
+
+	return drifts
+}
+
+// compareNodesExistence report stored nodes which are not found in cloud
+func compareNodesExistence(stored []*proto.Node, cloud map[string]*proto.Node) []fieldDrift {
+	drifts := make([]fieldDrift, 0)
+	for _, node := range stored {
+		if _, ok := cloud[node.InnerIP]; ok {
+			continue
+		}
+		drifts = append(drifts, fieldDrift{
+			resourceType: ResourceTypeNode,
+			resourceID:   node.InnerIP,
+			field:        "existence",
+			storeValue:   "true",
+			cloudValue:   "false",
+		})
+	}
+	return drifts
+}
+
+// compareNodeGroups compare nodegroup membership of stored nodes with nodes got from cloud
+// nodegroups, cloudGroups is keyed by nodeGroupID and only contains nodegroups listed
+// successfully. membership is only reported, nodes are moved by nodegroup workflows
+func compareNodeGroups(stored []*proto.Node, cloudGroups map[string][]*proto.Node) []fieldDrift {
+	drifts := make([]fieldDrift, 0)
+
+	cloudGroupOf := make(map[string]string)
+	groupIDs := make([]string, 0, len(cloudGroups))
+	for groupID, nodes := range cloudGroups {
+		groupIDs = append(groupIDs, groupID)
+		for _, node := range nodes {
+			cloudGroupOf[node.InnerIP] = groupID
+		}
+	}
+	sort.Strings(groupIDs)
+
+	storeNum := make(map[string]uint32)
+	storeIPs := make(map[string]struct{})
+	for _, node := range stored {
+		storeIPs[node.InnerIP] = struct{}{}
+		if node.NodeGroupID != "" {
+			storeNum[node.NodeGroupID]++
+		}
+		_, listed := cloudGroups[node.NodeGroupID]
+		cloudGroupID, inCloudGroup := cloudGroupOf[node.InnerIP]
+		if !listed && !inCloudGroup {
+			continue
+		}
+		if node.NodeGroupID != cloudGroupID {
+			drifts = append(drifts, fieldDrift{
+				resourceType: ResourceTypeNode,
+				resourceID:   node.InnerIP,
+				field:        "nodeGroupID",
+				storeValue:   node.NodeGroupID,
+				cloudValue:   cloudGroupID,
+			})
+		}
+	}
+
+	for _, groupID := range groupIDs {
+		cloudNum := uint32(len(cloudGroups[groupID]))
+		if storeNum[groupID] != cloudNum {
+			drifts = append(drifts, fieldDrift{
+				resourceType: ResourceTypeNodeGroup,
+				resourceID:   groupID,
+				field:        "nodeNum",
+				storeValue:   strconv.FormatUint(uint64(storeNum[groupID]), 10),
+				cloudValue:   strconv.FormatUint(uint64(cloudNum), 10),
+			})
+		}
+		for _, node := range cloudGroups[groupID] {
+			if _, ok := storeIPs[node.InnerIP]; ok {
+				continue
+			}
+			drifts = append(drifts, fieldDrift{
+				resourceType: ResourceTypeNode,
+				resourceID:   node.InnerIP,
+				field:        "existence",
+				storeValue:   "false",
+				cloudValue:   "true",
+			})
+		}
+	}
+
+	return drifts
+}
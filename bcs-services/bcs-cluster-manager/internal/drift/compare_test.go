@@ -0,0 +1,141 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package drift
+
+import (
+	"testing"
+
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/operator"
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+)
+
+func TestCompareCluster(t *testing.T) {
+	stored := &proto.Cluster{
+		ClusterID:            "BCS-K8S-40000",
+		VpcID:                "vpc-1",
+		ClusterBasicSettings: &proto.ClusterBasicSetting{Version: "1.18.4"},
+	}
+	cloud := &proto.Cluster{
+		VpcID:                "vpc-2",
+		ClusterBasicSettings: &proto.ClusterBasicSetting{Version: "1.20.6"},
+		NetworkSettings:      &proto.NetworkSetting{MaxNodePodNum: 64},
+	}
+
+	drifts := compareCluster(stored, cloud)
+	if len(drifts) != 3 {
+		t.Fatalf("expect 3 drifts, got %+v", drifts)
+	}
+	patchs := make(map[string]interface{})
+	for _, drift := range drifts {
+		if drift.field == "vpcID" && drift.patch != nil {
+			t.Fatalf("vpcID should not be corrected automatically")
+		}
+		if drift.patch != nil {
+			patchs[drift.patch.key] = drift.patch.cloudValue
+		}
+	}
+	if patchs["clusterbasicsettings.version"] != "1.20.6" || patchs["networksettings.maxnodepodnum"] != uint32(64) ||
+		len(patchs) != 2 {
+		t.Fatalf("unexpected cluster patchs: %+v", patchs)
+	}
+}
+
+func TestCompareNode(t *testing.T) {
+	stored := &proto.Node{InnerIP: "10.0.0.1", NodeID: "ins-1", CPU: 4, Mem: 8}
+	// fields which cloud doesn't return are skipped
+	if drifts := compareNode(stored, &proto.Node{InnerIP: "10.0.0.1", CPU: 4}); len(drifts) != 0 {
+		t.Fatalf("expect no drift, got %+v", drifts)
+	}
+
+	drifts := compareNode(stored, &proto.Node{InnerIP: "10.0.0.1", NodeID: "ins-2", CPU: 8, Mem: 8})
+	if len(drifts) != 2 {
+		t.Fatalf("expect 2 drifts, got %+v", drifts)
+	}
+	for _, drift := range drifts {
+		switch drift.field {
+		case "nodeID":
+			if drift.patch != nil {
+				t.Fatalf("nodeID should not be corrected automatically")
+			}
+		case "CPU":
+			if drift.storeValue != "4" || drift.cloudValue != "8" || drift.patch == nil ||
+				drift.patch.key != "cpu" || drift.patch.storeValue != uint32(4) || drift.patch.cloudValue != uint32(8) {
+				t.Fatalf("unexpected drift %+v", drift)
+			}
+		}
+	}
+}
+
+func TestCompareNodeGroups(t *testing.T) {
+	stored := []*proto.Node{
+		{InnerIP: "10.0.0.1", NodeGroupID: "group-a"},
+		{InnerIP: "10.0.0.2", NodeGroupID: "group-a"},
+		{InnerIP: "10.0.0.3"},
+		{InnerIP: "10.0.0.4", NodeGroupID: "group-c"},
+	}
+	cloudGroups := map[string][]*proto.Node{
+		"group-a": {{InnerIP: "10.0.0.1"}},
+		"group-b": {{InnerIP: "10.0.0.2"}, {InnerIP: "10.0.0.5"}},
+	}
+
+	expect := map[string]fieldDrift{
+		"node/10.0.0.2/nodeGroupID": {storeValue: "group-a", cloudValue: "group-b"},
+		"nodegroup/group-a/nodeNum": {storeValue: "2", cloudValue: "1"},
+		"nodegroup/group-b/nodeNum": {storeValue: "0", cloudValue: "2"},
+		"node/10.0.0.5/existence":   {storeValue: "false", cloudValue: "true"},
+	}
+	drifts := compareNodeGroups(stored, cloudGroups)
+	if len(drifts) != len(expect) {
+		t.Fatalf("expect %d drifts, got %+v", len(expect), drifts)
+	}
+	for _, drift := range drifts {
+		key := drift.resourceType + "/" + drift.resourceID + "/" + drift.field
+		e, ok := expect[key]
+		if !ok || e.storeValue != drift.storeValue || e.cloudValue != drift.cloudValue {
+			t.Fatalf("unexpected drift %s: %+v", key, drift)
+		}
+		if drift.patch != nil {
+			t.Fatalf("nodegroup membership should not be corrected automatically")
+		}
+	}
+}
+
+func TestCompareNodesExistence(t *testing.T) {
+	stored := []*proto.Node{{InnerIP: "10.0.0.1"}, {InnerIP: "10.0.0.2"}}
+	drifts := compareNodesExistence(stored, map[string]*proto.Node{"10.0.0.1": {InnerIP: "10.0.0.1"}})
+	if len(drifts) != 1 || drifts[0].resourceID != "10.0.0.2" || drifts[0].cloudValue != "false" {
+		t.Fatalf("unexpected drifts %+v", drifts)
+	}
+}
+
+func TestPatchCondition(t *testing.T) {
+	drifts := []fieldDrift{
+		{patch: &fieldPatch{key: "cpu", storeValue: uint32(4), cloudValue: uint32(8)}},
+		{patch: &fieldPatch{key: "zoneid", storeValue: "", cloudValue: "ap-guangzhou-3"}},
+		{patch: &fieldPatch{key: "mem", storeValue: uint32(8), cloudValue: uint32(16)}},
+	}
+	cond, patchs := patchCondition(drifts, []int{0, 1})
+	if len(patchs) != 2 || patchs["cpu"] != uint32(8) || patchs["zoneid"] != "ap-guangzhou-3" {
+		t.Fatalf("unexpected patchs %+v", patchs)
+	}
+	if len(cond.Children) != 2 {
+		t.Fatalf("expect condition of 2 fields, got %+v", cond)
+	}
+	if v := cond.Children[0].Value.(operator.M)["cpu"]; v != uint32(4) {
+		t.Fatalf("expect cpu condition with detected value 4, got %v", v)
+	}
+	if cond.Children[1].Op != operator.In {
+		t.Fatalf("empty store value should also match null field, got %+v", cond.Children[1])
+	}
+}
@@ -0,0 +1,468 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package drift
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/operator"
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/common"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/lock"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/metrics"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store"
+	storeopt "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/options"
+)
+
+const (
+	// driftLockKey lock for reconciling, only one cluster-manager instance reconciles in every interval
+	driftLockKey = "clusterdrift"
+	// defaultInterval default interval between reconciles
+	defaultInterval = 30 * time.Minute
+)
+
+var (
+	// errClusterBusy cluster has running tasks, store is changing and reconcile is skipped
+	errClusterBusy = errors.New("cluster has running tasks")
+
+	resourceTypes = []string{ResourceTypeCluster, ResourceTypeNodeGroup, ResourceTypeNode}
+)
+
+// Options options for drift reconciler
+type Options struct {
+	Model  store.ClusterManagerModel
+	Locker lock.DistributedLock
+	// Interval interval between reconciles
+	Interval time.Duration
+	// AutoCorrect correct safe fields in store by cloud
+	AutoCorrect bool
+}
+
+// Reconciler compares clusters/nodegroups/nodes in store with cloud periodically, detected
+// drifts are saved to store and reported as metrics
+type Reconciler struct {
+	model       store.ClusterManagerModel
+	locker      lock.DistributedLock
+	interval    time.Duration
+	autoCorrect bool
+	// reported clusters whose metrics are reported by this instance
+	reported map[string]struct{}
+}
+
+// NewReconciler create drift reconciler
+func NewReconciler(opt *Options) *Reconciler {
+	interval := opt.Interval
+	if interval <= 0 {
+		interval = defaultInterval
+	}
+	return &Reconciler{
+		model:       opt.Model,
+		locker:      opt.Locker,
+		interval:    interval,
+		autoCorrect: opt.AutoCorrect,
+		reported:    make(map[string]struct{}),
+	}
+}
+
+// Run reconcile periodically until context done. lock is held for a whole interval, thus
+// cluster-manager instances reconcile in turn instead of calling cloud at the same time
+func (r *Reconciler) Run(ctx context.Context) {
+	for {
+		if err := r.locker.Lock(driftLockKey); err != nil {
+			blog.Errorf("drift reconciler lock %s failed: %v", driftLockKey, err)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(r.interval):
+				continue
+			}
+		}
+
+		r.reconcile(ctx)
+		select {
+		case <-ctx.Done():
+		case <-time.After(r.interval):
+		}
+		if err := r.locker.Unlock(driftLockKey); err != nil {
+			blog.Errorf("drift reconciler unlock %s failed: %v", driftLockKey, err)
+		}
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// reconcile detect drifts of all running clusters
+func (r *Reconciler) reconcile(ctx context.Context) {
+	start := time.Now()
+	cond := operator.NewLeafCondition(operator.Eq, operator.M{"status": common.StatusRunning})
+	clusters, err := r.model.ListCluster(ctx, cond, &storeopt.ListOption{})
+	if err != nil {
+		blog.Errorf("drift reconciler list clusters failed: %v", err)
+		metrics.ReportClusterDriftReconcileMetric(metrics.LibCallStatusErr)
+		return
+	}
+
+	running := make(map[string]struct{}, len(clusters))
+	for i := range clusters {
+		clusterID := clusters[i].ClusterID
+		running[clusterID] = struct{}{}
+
+		drifts, err := r.reconcileCluster(ctx, &clusters[i])
+		if errors.Is(err, errClusterBusy) {
+			blog.Infof("drift reconciler skip cluster %s: %v", clusterID, err)
+			continue
+		}
+		if err != nil {
+			blog.Errorf("drift reconciler reconcile cluster %s failed: %v", clusterID, err)
+			metrics.ReportClusterDriftReconcileMetric(metrics.LibCallStatusErr)
+			continue
+		}
+		if err = r.model.UpdateClusterDrift(ctx, clusterID, drifts); err != nil {
+			blog.Errorf("drift reconciler save cluster %s drifts failed: %v", clusterID, err)
+			metrics.ReportClusterDriftReconcileMetric(metrics.LibCallStatusErr)
+			continue
+		}
+		r.reportMetric(clusterID, drifts)
+		metrics.ReportClusterDriftReconcileMetric(metrics.LibCallStatusOK)
+	}
+
+	r.cleanRemovedClusters(ctx, running)
+	blog.Infof("drift reconciler reconcile %d clusters cost %s", len(clusters), time.Since(start))
+}
+
+// reportMetric report drift num of cluster, drifts corrected automatically are excluded
+func (r *Reconciler) reportMetric(clusterID string, drifts []*proto.ClusterDrift) {
+	num := make(map[string]int, len(resourceTypes))
+	corrected := make(map[string]int, len(resourceTypes))
+	for _, resourceType := range resourceTypes {
+		num[resourceType] = 0
+	}
+	for _, drift := range drifts {
+		if drift.AutoCorrected {
+			corrected[drift.ResourceType]++
+			continue
+		}
+		num[drift.ResourceType]++
+	}
+	metrics.ReportClusterDriftMetric(clusterID, num)
+	for resourceType, n := range corrected {
+		metrics.ReportClusterDriftCorrectedMetric(resourceType, n)
+	}
+	r.reported[clusterID] = struct{}{}
+}
+
+// cleanRemovedClusters clean drifts & metrics of clusters which are not running anymore
+func (r *Reconciler) cleanRemovedClusters(ctx context.Context, running map[string]struct{}) {
+	for clusterID := range r.reported {
+		if _, ok := running[clusterID]; !ok {
+			metrics.DeleteClusterDriftMetric(clusterID, resourceTypes)
+			delete(r.reported, clusterID)
+		}
+	}
+
+	drifts, err := r.model.ListClusterDrift(ctx, operator.EmptyCondition, &storeopt.ListOption{})
+	if err != nil {
+		blog.Errorf("drift reconciler list drifts failed: %v", err)
+		return
+	}
+	removed := make(map[string]struct{})
+	for i := range drifts {
+		if _, ok := running[drifts[i].ClusterID]; !ok {
+			removed[drifts[i].ClusterID] = struct{}{}
+		}
+	}
+	for clusterID := range removed {
+		if err = r.model.DeleteClusterDrift(ctx, clusterID); err != nil {
+			blog.Errorf("drift reconciler delete cluster %s drifts failed: %v", clusterID, err)
+		}
+	}
+}
+
+// checkClusterBusy tasks change cluster & nodes in store, drifts during task are transient
+func (r *Reconciler) checkClusterBusy(ctx context.Context, clusterID string) error {
+	taskCond := operator.NewBranchCondition(operator.And,
+		operator.NewLeafCondition(operator.Eq, operator.M{"clusterid": clusterID}),
+		operator.NewLeafCondition(operator.In, operator.M{
+			"status": []string{cloudprovider.TaskStatusInit, cloudprovider.TaskStatusRunning},
+		}))
+	tasks, err := r.model.ListTask(ctx, taskCond, &storeopt.ListOption{Limit: 1})
+	if err != nil {
+		return fmt.Errorf("list cluster running tasks failed: %v", err)
+	}
+	if len(tasks) > 0 {
+		return errClusterBusy
+	}
+	return nil
+}
+
+// reconcileCluster detect drifts of cluster, its nodegroups and nodes, then correct safe
+// fields when autoCorrect is enabled
+func (r *Reconciler) reconcileCluster(ctx context.Context, cls *proto.Cluster) ([]*proto.ClusterDrift, error) {
+	if err := r.checkClusterBusy(ctx, cls.ClusterID); err != nil {
+		return nil, err
+	}
+
+	basicInfo, err := cloudprovider.GetClusterDependBasicInfo(cls.ClusterID, cls.Provider, "")
+	if err != nil {
+		return nil, fmt.Errorf("getClusterDependBasicInfo failed: %v", err)
+	}
+	provider := basicInfo.Cloud.CloudProvider
+	cluster := basicInfo.Cluster
+
+	drifts, err := r.detectClusterDrift(provider, cluster, basicInfo.CmOption)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeCond := operator.NewLeafCondition(operator.Eq, operator.M{"clusterid": cls.ClusterID})
+	nodes, err := r.model.ListNode(ctx, nodeCond, &storeopt.ListOption{})
+	if err != nil {
+		return nil, fmt.Errorf("list cluster nodes failed: %v", err)
+	}
+	nodeDrifts, err := r.detectNodeDrift(provider, cluster, nodes, basicInfo.CmOption)
+	if err != nil {
+		return nil, err
+	}
+	drifts = append(drifts, nodeDrifts...)
+	groupDrifts, err := r.detectNodeGroupDrift(ctx, provider, cluster, nodes, basicInfo.CmOption)
+	if err != nil {
+		return nil, err
+	}
+	drifts = append(drifts, groupDrifts...)
+
+	corrected := make(map[int]bool)
+	if r.autoCorrect {
+		corrected = r.correct(ctx, cluster, nodes, drifts)
+	}
+
+	now := time.Now().Format(time.RFC3339)
+	result := make([]*proto.ClusterDrift, 0, len(drifts))
+	for i, drift := range drifts {
+		result = append(result, &proto.ClusterDrift{
+			ClusterID:     cluster.ClusterID,
+			Provider:      cluster.Provider,
+			ResourceType:  drift.resourceType,
+			ResourceID:    drift.resourceID,
+			Field:         drift.field,
+			StoreValue:    drift.storeValue,
+			CloudValue:    drift.cloudValue,
+			AutoCorrected: corrected[i],
+			DetectTime:    now,
+		})
+	}
+	return result, nil
+}
+
+// detectClusterDrift compare cluster with cloud, cluster which is not created by cloud is skipped
+func (r *Reconciler) detectClusterDrift(provider string, cluster *proto.Cluster,
+	opt *cloudprovider.CommonOption) ([]fieldDrift, error) {
+	if cluster.SystemID == "" {
+		return nil, nil
+	}
+	clsMgr, err := cloudprovider.GetClusterMgr(provider)
+	if err != nil {
+		return nil, fmt.Errorf("get cloudprovider %s ClusterManager failed: %v", provider, err)
+	}
+	cloudCluster, err := clsMgr.GetCluster(cluster.SystemID, &cloudprovider.GetClusterOption{CommonOption: *opt})
+	if errors.Is(err, cloudprovider.ErrCloudNotImplemented) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get cloud cluster %s failed: %v", cluster.SystemID, err)
+	}
+	if cloudCluster == nil {
+		return nil, nil
+	}
+	return compareCluster(cluster, cloudCluster), nil
+}
+
+// detectNodeDrift compare nodes with cloud instances
+func (r *Reconciler) detectNodeDrift(provider string, cluster *proto.Cluster, nodes []*proto.Node,
+	opt *cloudprovider.CommonOption) ([]fieldDrift, error) {
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	nodeMgr, err := cloudprovider.GetNodeMgr(provider)
+	if err != nil {
+		return nil, fmt.Errorf("get cloudprovider %s NodeManager failed: %v", provider, err)
+	}
+	ips := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		ips = append(ips, node.InnerIP)
+	}
+	cloudNodes, err := nodeMgr.ListNodesByIP(ips, &cloudprovider.ListNodesOption{
+		Common:       opt,
+		ClusterVPCID: cluster.VpcID,
+		ClusterID:    cluster.ClusterID,
+	})
+	if errors.Is(err, cloudprovider.ErrCloudNotImplemented) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("list cloud nodes failed: %v", err)
+	}
+
+	cloudNodeMap := make(map[string]*proto.Node, len(cloudNodes))
+	for _, node := range cloudNodes {
+		cloudNodeMap[node.InnerIP] = node
+	}
+	drifts := compareNodesExistence(nodes, cloudNodeMap)
+	for _, node := range nodes {
+		if cloudNode, ok := cloudNodeMap[node.InnerIP]; ok {
+			drifts = append(drifts, compareNode(node, cloudNode)...)
+		}
+	}
+	return drifts, nil
+}
+
+// detectNodeGroupDrift compare nodegroup members with cloud nodegroups, nodegroup which fails
+// to be listed from cloud is skipped
+func (r *Reconciler) detectNodeGroupDrift(ctx context.Context, provider string, cluster *proto.Cluster,
+	nodes []*proto.Node, opt *cloudprovider.CommonOption) ([]fieldDrift, error) {
+	groupCond := operator.NewLeafCondition(operator.Eq, operator.M{"clusterid": cluster.ClusterID})
+	groups, err := r.model.ListNodeGroup(ctx, groupCond, &storeopt.ListOption{})
+	if err != nil {
+		return nil, fmt.Errorf("list cluster nodegroups failed: %v", err)
+	}
+	if len(groups) == 0 {
+		return nil, nil
+	}
+	groupMgr, err := cloudprovider.GetNodeGroupMgr(provider)
+	if err != nil {
+		return nil, fmt.Errorf("get cloudprovider %s NodeGroupManager failed: %v", provider, err)
+	}
+
+	cloudGroups := make(map[string][]*proto.Node)
+	for i := range groups {
+		// nodegroup is not created in cloud yet
+		if groups[i].CloudNodeGroupID == "" {
+			continue
+		}
+		groupNodes, err := groupMgr.GetNodesInGroup(&groups[i], opt)
+		if errors.Is(err, cloudprovider.ErrCloudNotImplemented) {
+			return nil, nil
+		}
+		if err != nil {
+			blog.Warnf("drift reconciler get cloud nodes of nodegroup %s failed: %v", groups[i].NodeGroupID, err)
+			continue
+		}
+		cloudGroups[groups[i].NodeGroupID] = groupNodes
+	}
+	return compareNodeGroups(nodes, cloudGroups), nil
+}
+
+// correct safe fields of cluster & nodes by cloud, returns indexes of drifts corrected. cloud
+// is slow and tasks may start after drifts detected, so running tasks are checked again and
+// only drifted fields are patched when they still keep detected values, other fields and
+// fields changed by tasks or users after detection are never overwritten by the snapshot
+func (r *Reconciler) correct(ctx context.Context, cluster *proto.Cluster, nodes []*proto.Node,
+	drifts []fieldDrift) map[int]bool {
+	corrected := make(map[int]bool)
+	if err := r.checkClusterBusy(ctx, cluster.ClusterID); err != nil {
+		blog.Infof("drift reconciler skip correcting cluster %s: %v", cluster.ClusterID, err)
+		return corrected
+	}
+
+	clusterDrifts := make([]int, 0)
+	nodeDrifts := make(map[string][]int)
+	for i, drift := range drifts {
+		if drift.patch == nil {
+			continue
+		}
+		switch drift.resourceType {
+		case ResourceTypeCluster:
+			clusterDrifts = append(clusterDrifts, i)
+		case ResourceTypeNode:
+			nodeDrifts[drift.resourceID] = append(nodeDrifts[drift.resourceID], i)
+		}
+	}
+
+	if len(clusterDrifts) > 0 {
+		cond, patchs := patchCondition(drifts, clusterDrifts)
+		// cluster being deleted or changed by tasks is not running anymore
+		cond = operator.NewBranchCondition(operator.And, cond,
+			operator.NewLeafCondition(operator.Eq, operator.M{"status": common.StatusRunning}))
+		ok, err := r.model.PatchClusterWithCondition(ctx, cluster.ClusterID, cond, patchs)
+		switch {
+		case err != nil:
+			blog.Errorf("drift reconciler correct cluster %s failed: %v", cluster.ClusterID, err)
+		case !ok:
+			blog.Infof("drift reconciler skip correcting cluster %s: changed since detected", cluster.ClusterID)
+		default:
+			for _, i := range clusterDrifts {
+				corrected[i] = true
+			}
+		}
+	}
+	for _, node := range nodes {
+		indexes, ok := nodeDrifts[node.InnerIP]
+		if !ok {
+			continue
+		}
+		cond, patchs := patchCondition(drifts, indexes)
+		ok, err := r.model.PatchNodeWithCondition(ctx, node.NodeID, node.InnerIP, cond, patchs)
+		if err != nil {
+			blog.Errorf("drift reconciler correct node %s failed: %v", node.InnerIP, err)
+			continue
+		}
+		if !ok {
+			blog.Infof("drift reconciler skip correcting node %s: changed since detected", node.InnerIP)
+			continue
+		}
+		for _, i := range indexes {
+			corrected[i] = true
+		}
+	}
+	for i := range corrected {
+		blog.Infof("drift reconciler correct %s %s field %s from %s to %s", drifts[i].resourceType,
+			drifts[i].resourceID, drifts[i].field, drifts[i].storeValue, drifts[i].cloudValue)
+	}
+	return corrected
+}
+
+// patchCondition build patch of drifts by indexes, and condition that fields keep detected
+// store values. empty store value also matches field which is null or missing in store
+func patchCondition(drifts []fieldDrift, indexes []int) (*operator.Condition, map[string]interface{}) {
+	conds := make([]*operator.Condition, 0, len(indexes))
+	patchs := make(map[string]interface{}, len(indexes))
+	for _, i := range indexes {
+		patch := drifts[i].patch
+		patchs[patch.key] = patch.cloudValue
+		if isEmptyValue(patch.storeValue) {
+			conds = append(conds, operator.NewLeafCondition(operator.In, operator.M{
+				patch.key: []interface{}{patch.storeValue, nil},
+			}))
+			continue
+		}
+		conds = append(conds, operator.NewLeafCondition(operator.Eq, operator.M{patch.key: patch.storeValue}))
+	}
+	return operator.NewBranchCondition(operator.And, conds...), patchs
+}
+
+func isEmptyValue(value interface{}) bool {
+	switch v := value.(type) {
+	case string:
+		return v == ""
+	case uint32:
+		return v == 0
+	default:
+		return value == nil
+	}
+}
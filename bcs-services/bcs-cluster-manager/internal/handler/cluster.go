@@ -99,6 +99,21 @@ func (cm *ClusterManager) UpdateCluster(ctx context.Context,
 	return nil
 }
 
+// UpgradeCluster implements interface cmproto.ClusterManagerServer
+func (cm *ClusterManager) UpgradeCluster(ctx context.Context,
+	req *cmproto.UpgradeClusterReq, resp *cmproto.UpgradeClusterResp) error {
+	reqID, err := requestIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	ca := clusterac.NewUpgradeAction(cm.model)
+	ca.Handle(ctx, req, resp)
+	metrics.ReportAPIRequestMetric("UpgradeCluster", "grpc", strconv.Itoa(int(resp.Code)), start)
+	blog.Infof("reqID: %s, action: UpgradeCluster, req %v, resp %v", reqID, req, resp)
+	return nil
+}
+
 // AddNodesToCluster implements interface cmproto.ClusterManagerServer
 func (cm *ClusterManager) AddNodesToCluster(ctx context.Context,
 	req *cmproto.AddNodesRequest, resp *cmproto.AddNodesResponse) error {
@@ -196,6 +211,23 @@ func (cm *ClusterManager) ListCommonCluster(ctx context.Context,
 	return nil
 }
 
+// ListClusterDrift implements interface cmproto.ClusterManagerServer
+func (cm *ClusterManager) ListClusterDrift(ctx context.Context,
+	req *cmproto.ListClusterDriftRequest, resp *cmproto.ListClusterDriftResponse) error {
+	reqID, err := requestIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	ca := clusterac.NewListDriftAction(cm.model)
+	ca.Handle(ctx, req, resp)
+	metrics.ReportAPIRequestMetric("ListClusterDrift", "grpc", strconv.Itoa(int(resp.Code)), start)
+	blog.Infof("reqID: %s, action: ListClusterDrift, req %v, resp.Code %d, resp.Message %s, resp.Data.Length %d",
+		reqID, req, resp.Code, resp.Message, len(resp.Data))
+	blog.V(5).Infof("reqID: %s, action: ListClusterDrift, req %v, resp %v", reqID, req, resp)
+	return nil
+}
+
 // InitFederationCluster implements interface cmproto.ClusterManagerServer
 func (cm *ClusterManager) InitFederationCluster(ctx context.Context,
 	req *cmproto.InitFederationClusterReq, resp *cmproto.InitFederationClusterResp) error {
@@ -53,3 +53,18 @@ func (cm *ClusterManager) UnCordonNode(ctx context.Context,
 	blog.Infof("reqID: %s, action: UnCordonNode, req %v, resp %v", reqID, req, resp)
 	return nil
 }
+
+// DrainNode implements interface cmproto.ClusterManagerServer
+func (cm *ClusterManager) DrainNode(ctx context.Context,
+	req *cmproto.DrainNodeRequest, resp *cmproto.DrainNodeResponse) error {
+	reqID, err := requestIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	da := node.NewDrainNodeAction(cm.model)
+	da.Handle(ctx, req, resp)
+	metrics.ReportAPIRequestMetric("DrainNode", "grpc", strconv.Itoa(int(resp.Code)), start)
+	blog.Infof("reqID: %s, action: DrainNode, req %v, resp %v", reqID, req, resp)
+	return nil
+}
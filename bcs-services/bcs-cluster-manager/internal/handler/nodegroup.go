@@ -54,6 +54,21 @@ func (cm *ClusterManager) UpdateNodeGroup(ctx context.Context,
 	return nil
 }
 
+// UpgradeNodeGroup implements interface cmproto.ClusterManagerServer
+func (cm *ClusterManager) UpgradeNodeGroup(ctx context.Context,
+	req *cmproto.UpgradeNodeGroupRequest, resp *cmproto.UpgradeNodeGroupResponse) error {
+	reqID, err := requestIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	ca := nodegroup.NewUpgradeAction(cm.model)
+	ca.Handle(ctx, req, resp)
+	metrics.ReportAPIRequestMetric("UpgradeNodeGroup", "grpc", strconv.Itoa(int(resp.Code)), start)
+	blog.Infof("reqID: %s, action: UpgradeNodeGroup, req %v, resp %v", reqID, req, resp)
+	return nil
+}
+
 // DeleteNodeGroup implements interface cmproto.ClusterManagerServer
 func (cm *ClusterManager) DeleteNodeGroup(ctx context.Context,
 	req *cmproto.DeleteNodeGroupRequest, resp *cmproto.DeleteNodeGroupResponse) error {
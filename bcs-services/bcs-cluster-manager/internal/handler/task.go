@@ -118,3 +118,18 @@ func (cm *ClusterManager) RetryTask(ctx context.Context,
 	blog.Infof("reqID: %s, action: RetryTask, req %v, resp %v", reqID, req, resp)
 	return nil
 }
+
+// SkipTaskStep implements interface cmproto.ClusterManagerServer
+func (cm *ClusterManager) SkipTaskStep(ctx context.Context,
+	req *cmproto.SkipTaskStepRequest, resp *cmproto.SkipTaskStepResponse) error {
+	reqID, err := requestIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	ca := task.NewSkipStepAction(cm.model)
+	ca.Handle(ctx, req, resp)
+	metrics.ReportAPIRequestMetric("SkipTaskStep", "grpc", strconv.Itoa(int(resp.Code)), start)
+	blog.Infof("reqID: %s, action: SkipTaskStep, req %v, resp %v", reqID, req, resp)
+	return nil
+}
@@ -0,0 +1,175 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package handler
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	cmproto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/actions/template"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/metrics"
+)
+
+// CreateClusterTemplate implements interface cmproto.ClusterManagerServer
+func (cm *ClusterManager) CreateClusterTemplate(ctx context.Context,
+	req *cmproto.CreateClusterTemplateRequest, resp *cmproto.CreateClusterTemplateResponse) error {
+	reqID, err := requestIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	ca := template.NewCreateClusterTemplateAction(cm.model)
+	ca.Handle(ctx, req, resp)
+	metrics.ReportAPIRequestMetric("CreateClusterTemplate", "grpc", strconv.Itoa(int(resp.Code)), start)
+	blog.Infof("reqID: %s, action: CreateClusterTemplate, req %v, resp %v", reqID, req, resp)
+	return nil
+}
+
+// UpdateClusterTemplate implements interface cmproto.ClusterManagerServer
+func (cm *ClusterManager) UpdateClusterTemplate(ctx context.Context,
+	req *cmproto.UpdateClusterTemplateRequest, resp *cmproto.UpdateClusterTemplateResponse) error {
+	reqID, err := requestIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	ua := template.NewUpdateClusterTemplateAction(cm.model)
+	ua.Handle(ctx, req, resp)
+	metrics.ReportAPIRequestMetric("UpdateClusterTemplate", "grpc", strconv.Itoa(int(resp.Code)), start)
+	blog.Infof("reqID: %s, action: UpdateClusterTemplate, req %v, resp %v", reqID, req, resp)
+	return nil
+}
+
+// DeleteClusterTemplate implements interface cmproto.ClusterManagerServer
+func (cm *ClusterManager) DeleteClusterTemplate(ctx context.Context,
+	req *cmproto.DeleteClusterTemplateRequest, resp *cmproto.DeleteClusterTemplateResponse) error {
+	reqID, err := requestIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	da := template.NewDeleteClusterTemplateAction(cm.model)
+	da.Handle(ctx, req, resp)
+	metrics.ReportAPIRequestMetric("DeleteClusterTemplate", "grpc", strconv.Itoa(int(resp.Code)), start)
+	blog.Infof("reqID: %s, action: DeleteClusterTemplate, req %v, resp %v", reqID, req, resp)
+	return nil
+}
+
+// GetClusterTemplate implements interface cmproto.ClusterManagerServer
+func (cm *ClusterManager) GetClusterTemplate(ctx context.Context,
+	req *cmproto.GetClusterTemplateRequest, resp *cmproto.GetClusterTemplateResponse) error {
+	reqID, err := requestIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	ga := template.NewGetClusterTemplateAction(cm.model)
+	ga.Handle(ctx, req, resp)
+	metrics.ReportAPIRequestMetric("GetClusterTemplate", "grpc", strconv.Itoa(int(resp.Code)), start)
+	blog.Infof("reqID: %s, action: GetClusterTemplate, req %v, resp %v", reqID, req, resp)
+	return nil
+}
+
+// ListClusterTemplate implements interface cmproto.ClusterManagerServer
+func (cm *ClusterManager) ListClusterTemplate(ctx context.Context,
+	req *cmproto.ListClusterTemplateRequest, resp *cmproto.ListClusterTemplateResponse) error {
+	reqID, err := requestIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	la := template.NewListClusterTemplateAction(cm.model)
+	la.Handle(ctx, req, resp)
+	metrics.ReportAPIRequestMetric("ListClusterTemplate", "grpc", strconv.Itoa(int(resp.Code)), start)
+	blog.Infof("reqID: %s, action: ListClusterTemplate, req %v, resp %v", reqID, req, resp)
+	return nil
+}
+
+// CreateNodeGroupTemplate implements interface cmproto.ClusterManagerServer
+func (cm *ClusterManager) CreateNodeGroupTemplate(ctx context.Context,
+	req *cmproto.CreateNodeGroupTemplateRequest, resp *cmproto.CreateNodeGroupTemplateResponse) error {
+	reqID, err := requestIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	ca := template.NewCreateNodeGroupTemplateAction(cm.model)
+	ca.Handle(ctx, req, resp)
+	metrics.ReportAPIRequestMetric("CreateNodeGroupTemplate", "grpc", strconv.Itoa(int(resp.Code)), start)
+	blog.Infof("reqID: %s, action: CreateNodeGroupTemplate, req %v, resp %v", reqID, req, resp)
+	return nil
+}
+
+// UpdateNodeGroupTemplate implements interface cmproto.ClusterManagerServer
+func (cm *ClusterManager) UpdateNodeGroupTemplate(ctx context.Context,
+	req *cmproto.UpdateNodeGroupTemplateRequest, resp *cmproto.UpdateNodeGroupTemplateResponse) error {
+	reqID, err := requestIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	ua := template.NewUpdateNodeGroupTemplateAction(cm.model)
+	ua.Handle(ctx, req, resp)
+	metrics.ReportAPIRequestMetric("UpdateNodeGroupTemplate", "grpc", strconv.Itoa(int(resp.Code)), start)
+	blog.Infof("reqID: %s, action: UpdateNodeGroupTemplate, req %v, resp %v", reqID, req, resp)
+	return nil
+}
+
+// DeleteNodeGroupTemplate implements interface cmproto.ClusterManagerServer
+func (cm *ClusterManager) DeleteNodeGroupTemplate(ctx context.Context,
+	req *cmproto.DeleteNodeGroupTemplateRequest, resp *cmproto.DeleteNodeGroupTemplateResponse) error {
+	reqID, err := requestIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	da := template.NewDeleteNodeGroupTemplateAction(cm.model)
+	da.Handle(ctx, req, resp)
+	metrics.ReportAPIRequestMetric("DeleteNodeGroupTemplate", "grpc", strconv.Itoa(int(resp.Code)), start)
+	blog.Infof("reqID: %s, action: DeleteNodeGroupTemplate, req %v, resp %v", reqID, req, resp)
+	return nil
+}
+
+// GetNodeGroupTemplate implements interface cmproto.ClusterManagerServer
+func (cm *ClusterManager) GetNodeGroupTemplate(ctx context.Context,
+	req *cmproto.GetNodeGroupTemplateRequest, resp *cmproto.GetNodeGroupTemplateResponse) error {
+	reqID, err := requestIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	ga := template.NewGetNodeGroupTemplateAction(cm.model)
+	ga.Handle(ctx, req, resp)
+	metrics.ReportAPIRequestMetric("GetNodeGroupTemplate", "grpc", strconv.Itoa(int(resp.Code)), start)
+	blog.Infof("reqID: %s, action: GetNodeGroupTemplate, req %v, resp %v", reqID, req, resp)
+	return nil
+}
+
+// ListNodeGroupTemplate implements interface cmproto.ClusterManagerServer
+func (cm *ClusterManager) ListNodeGroupTemplate(ctx context.Context,
+	req *cmproto.ListNodeGroupTemplateRequest, resp *cmproto.ListNodeGroupTemplateResponse) error {
+	reqID, err := requestIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	la := template.NewListNodeGroupTemplateAction(cm.model)
+	la.Handle(ctx, req, resp)
+	metrics.ReportAPIRequestMetric("ListNodeGroupTemplate", "grpc", strconv.Itoa(int(resp.Code)), start)
+	blog.Infof("reqID: %s, action: ListNodeGroupTemplate, req %v, resp %v", reqID, req, resp)
+	return nil
+}
@@ -54,6 +54,22 @@ var (
 		Help:      "api request latency statistic for cluster manager api",
 		Buckets:   []float64{0.01, 0.1, 0.5, 0.75, 1.0, 2.0, 3.0, 5.0, 10.0},
 	}, []string{"handler", "method", "status"})
+
+	clusterDriftNum = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: BkBcsClusterManager,
+		Name:      "cluster_drift_num",
+		Help:      "The number of drifted fields between cluster manager store and cloud detected in last reconcile",
+	}, []string{"cluster_id", "resource_type"})
+	clusterDriftCorrectedTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: BkBcsClusterManager,
+		Name:      "cluster_drift_corrected_total_num",
+		Help:      "The total number of drifted fields corrected automatically by cloud",
+	}, []string{"resource_type"})
+	clusterDriftReconcileTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: BkBcsClusterManager,
+		Name:      "cluster_drift_reconcile_total_num",
+		Help:      "The total number of cluster drift reconciles",
+	}, []string{"status"})
 )
 
 func init() {
@@ -61,6 +77,9 @@ func init() {
 	prometheus.MustRegister(requestLatencyLib)
 	prometheus.MustRegister(requestsTotalAPI)
 	prometheus.MustRegister(requestLatencyAPI)
+	prometheus.MustRegister(clusterDriftNum)
+	prometheus.MustRegister(clusterDriftCorrectedTotal)
+	prometheus.MustRegister(clusterDriftReconcileTotal)
 }
 
 // ReportLibRequestMetric report lib call metrics
@@ -74,3 +93,27 @@ func ReportAPIRequestMetric(handler, method, status string, started time.Time) {
 	requestsTotalAPI.WithLabelValues(handler, method, status).Inc()
 	requestLatencyAPI.WithLabelValues(handler, method, status).Observe(time.Since(started).Seconds())
 }
+
+// ReportClusterDriftMetric report drift num of cluster resources, num is keyed by resource type
+func ReportClusterDriftMetric(clusterID string, num map[string]int) {
+	for resourceType, n := range num {
+		clusterDriftNum.WithLabelValues(clusterID, resourceType).Set(float64(n))
+	}
+}
+
+// DeleteClusterDriftMetric delete drift metrics of cluster which is no longer reconciled
+func DeleteClusterDriftMetric(clusterID string, resourceTypes []string) {
+	for _, resourceType := range resourceTypes {
+		clusterDriftNum.DeleteLabelValues(clusterID, resourceType)
+	}
+}
+
+// ReportClusterDriftCorrectedMetric report num of drifted fields corrected automatically
+func ReportClusterDriftCorrectedMetric(resourceType string, num int) {
+	clusterDriftCorrectedTotal.WithLabelValues(resourceType).Add(float64(num))
+}
+
+// ReportClusterDriftReconcileMetric report cluster drift reconcile
+func ReportClusterDriftReconcileMetric(status string) {
+	clusterDriftReconcileTotal.WithLabelValues(status).Inc()
+}
@@ -157,6 +157,14 @@ type IAMConfig struct {
 	Debug         bool   `json:"debug"`
 }
 
+// DriftConfig for drift detection between store and cloud
+type DriftConfig struct {
+	Enable bool `json:"enable"`
+	// Interval seconds between reconciles
+	Interval    uint `json:"interval"`
+	AutoCorrect bool `json:"autoCorrect"`
+}
+
 // ClusterManagerOptions options of cluster manager
 type ClusterManagerOptions struct {
 	Etcd              EtcdOption            `json:"etcd"`
@@ -174,6 +182,7 @@ type ClusterManagerOptions struct {
 	UserManager       UserConfig            `json:"user"`
 	Alarm             AlarmConfig           `json:"alarm"`
 	IAM               IAMConfig             `json:"iam_config"`
+	Drift             DriftConfig           `json:"drift"`
 	Debug             bool                  `json:"debug"`
 	ServerConfig
 	ClientConfig
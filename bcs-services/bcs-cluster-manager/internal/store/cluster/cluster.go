@@ -113,6 +113,26 @@ func (m *ModelCluster) UpdateCluster(ctx context.Context, cluster *types.Cluster
 	return m.db.Table(m.tableName).Upsert(ctx, cond, operator.M{"$set": cluster})
 }
 
+// PatchClusterWithCondition update cluster fields only when cluster matches extra condition,
+// returns false when cluster is not matched
+func (m *ModelCluster) PatchClusterWithCondition(ctx context.Context, clusterID string, cond *operator.Condition,
+	patchs map[string]interface{}) (bool, error) {
+	if err := m.ensureTable(ctx); err != nil {
+		return false, err
+	}
+	clusterCond := operator.NewLeafCondition(operator.Eq, operator.M{
+		clusterKeyName: clusterID,
+	})
+	if cond != nil {
+		clusterCond = operator.NewBranchCondition(operator.And, clusterCond, cond)
+	}
+	count, err := m.db.Table(m.tableName).UpdateMany(ctx, clusterCond, operator.M{"$set": patchs})
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
 // DeleteCluster delete cluster
 func (m *ModelCluster) DeleteCluster(ctx context.Context, clusterID string) error {
 	if err := m.ensureTable(ctx); err != nil {
@@ -0,0 +1,149 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package clusterdrift
+
+import (
+	"context"
+	"sync"
+
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/drivers"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/operator"
+	types "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/options"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/util"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	tableName = "clusterdrift"
+	//! we don't setting bson tag in proto file,
+	//! all struct key in mongo is lowcase in default
+	clusterIDKey                  = "clusterid"
+	resourceTypeKey               = "resourcetype"
+	resourceIDKey                 = "resourceid"
+	fieldKey                      = "field"
+	defaultClusterDriftListLength = 5000
+)
+
+var (
+	driftIndexes = []drivers.Index{
+		{
+			Name: tableName + "_idx",
+			Key: bson.D{
+				bson.E{Key: clusterIDKey, Value: 1},
+				bson.E{Key: resourceTypeKey, Value: 1},
+				bson.E{Key: resourceIDKey, Value: 1},
+				bson.E{Key: fieldKey, Value: 1},
+			},
+			Unique: true,
+		},
+	}
+)
+
+// ModelClusterDrift database operation for clusterDrift, every drifted
+// field of cluster resource is stored as a single record
+type ModelClusterDrift struct {
+	tableName           string
+	indexes             []drivers.Index
+	db                  drivers.DB
+	isTableEnsured      bool
+	isTableEnsuredMutex sync.RWMutex
+}
+
+// New create clusterDrift model
+func New(db drivers.DB) *ModelClusterDrift {
+	return &ModelClusterDrift{
+		tableName: util.DataTableNamePrefix + tableName,
+		indexes:   driftIndexes,
+		db:        db,
+	}
+}
+
+// ensure table
+func (m *ModelClusterDrift) ensureTable(ctx context.Context) error {
+	m.isTableEnsuredMutex.RLock()
+	if m.isTableEnsured {
+		m.isTableEnsuredMutex.RUnlock()
+		return nil
+	}
+	if err := util.EnsureTable(ctx, m.db, m.tableName, m.indexes); err != nil {
+		m.isTableEnsuredMutex.RUnlock()
+		return err
+	}
+	m.isTableEnsuredMutex.RUnlock()
+
+	m.isTableEnsuredMutex.Lock()
+	m.isTableEnsured = true
+	m.isTableEnsuredMutex.Unlock()
+	return nil
+}
+
+// UpdateClusterDrift replace drifts of cluster with latest detected drifts
+func (m *ModelClusterDrift) UpdateClusterDrift(ctx context.Context, clusterID string,
+	drifts []*types.ClusterDrift) error {
+	if err := m.DeleteClusterDrift(ctx, clusterID); err != nil {
+		return err
+	}
+	if len(drifts) == 0 {
+		return nil
+	}
+
+	docs := make([]interface{}, 0, len(drifts))
+	for _, drift := range drifts {
+		docs = append(docs, drift)
+	}
+	if _, err := m.db.Table(m.tableName).Insert(ctx, docs); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DeleteClusterDrift delete all drifts of cluster
+func (m *ModelClusterDrift) DeleteClusterDrift(ctx context.Context, clusterID string) error {
+	if err := m.ensureTable(ctx); err != nil {
+		return err
+	}
+	cond := operator.NewLeafCondition(operator.Eq, operator.M{
+		clusterIDKey: clusterID,
+	})
+	_, err := m.db.Table(m.tableName).Delete(ctx, cond)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// ListClusterDrift list clusterDrift
+func (m *ModelClusterDrift) ListClusterDrift(ctx context.Context, cond *operator.Condition,
+	opt *options.ListOption) ([]types.ClusterDrift, error) {
+	driftList := make([]types.ClusterDrift, 0)
+	finder := m.db.Table(m.tableName).Find(cond)
+	if len(opt.Sort) != 0 {
+		finder = finder.WithSort(util.MapInt2MapIf(opt.Sort))
+	}
+	if opt.Offset != 0 {
+		finder = finder.WithStart(opt.Offset)
+	}
+	if opt.Limit == 0 {
+		finder = finder.WithLimit(defaultClusterDriftListLength)
+	} else {
+		finder = finder.WithLimit(opt.Limit)
+	}
+	if err := finder.All(ctx, &driftList); err != nil {
+		return nil, err
+	}
+
+	return driftList, nil
+}
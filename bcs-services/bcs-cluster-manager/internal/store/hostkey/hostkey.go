@@ -0,0 +1,137 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package hostkey
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/drivers"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/operator"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/util"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	tableName = "hostkey"
+	hostKey   = "host"
+)
+
+var (
+	hostKeyIndexes = []drivers.Index{
+		{
+			Name: tableName + "_idx",
+			Key: bson.D{
+				bson.E{Key: hostKey, Value: 1},
+			},
+			Unique: true,
+		},
+	}
+)
+
+// HostKey ssh public key of host, it's trusted when host is connected
+// for the first time and pinned for following connections
+type HostKey struct {
+	Host        string `json:"host" bson:"host"`
+	KeyType     string `json:"keyType" bson:"keytype"`
+	PublicKey   string `json:"publicKey" bson:"publickey"`
+	Fingerprint string `json:"fingerprint" bson:"fingerprint"`
+	CreateTime  string `json:"createTime" bson:"createtime"`
+}
+
+// ModelHostKey database operation for ssh host keys
+type ModelHostKey struct {
+	tableName           string
+	indexes             []drivers.Index
+	db                  drivers.DB
+	isTableEnsured      bool
+	isTableEnsuredMutex sync.RWMutex
+}
+
+// New create hostKey model
+func New(db drivers.DB) *ModelHostKey {
+	return &ModelHostKey{
+		tableName: util.DataTableNamePrefix + tableName,
+		indexes:   hostKeyIndexes,
+		db:        db,
+	}
+}
+
+// ensure table
+func (m *ModelHostKey) ensureTable(ctx context.Context) error {
+	m.isTableEnsuredMutex.RLock()
+	if m.isTableEnsured {
+		m.isTableEnsuredMutex.RUnlock()
+		return nil
+	}
+	if err := util.EnsureTable(ctx, m.db, m.tableName, m.indexes); err != nil {
+		m.isTableEnsuredMutex.RUnlock()
+		return err
+	}
+	m.isTableEnsuredMutex.RUnlock()
+
+	m.isTableEnsuredMutex.Lock()
+	m.isTableEnsured = true
+	m.isTableEnsuredMutex.Unlock()
+	return nil
+}
+
+// CreateHostKey insert host key, key of the same host is rejected by unique index
+// so that pinned key is never replaced silently
+func (m *ModelHostKey) CreateHostKey(ctx context.Context, key *HostKey) error {
+	if key == nil {
+		return fmt.Errorf("hostKey to be created cannot be empty")
+	}
+	if err := m.ensureTable(ctx); err != nil {
+		return err
+	}
+
+	if _, err := m.db.Table(m.tableName).Insert(ctx, []interface{}{key}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetHostKey get pinned key of host
+func (m *ModelHostKey) GetHostKey(ctx context.Context, host string) (*HostKey, error) {
+	if err := m.ensureTable(ctx); err != nil {
+		return nil, err
+	}
+	cond := operator.NewLeafCondition(operator.Eq, operator.M{
+		hostKey: host,
+	})
+	key := &HostKey{}
+	if err := m.db.Table(m.tableName).Find(cond).One(ctx, key); err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+// DeleteHostKey delete pinned key of host, new key is trusted at next connection
+func (m *ModelHostKey) DeleteHostKey(ctx context.Context, host string) error {
+	if err := m.ensureTable(ctx); err != nil {
+		return err
+	}
+	cond := operator.NewLeafCondition(operator.Eq, operator.M{
+		hostKey: host,
+	})
+	_, err := m.db.Table(m.tableName).Delete(ctx, cond)
+	if err != nil {
+		return err
+	}
+	return nil
+}
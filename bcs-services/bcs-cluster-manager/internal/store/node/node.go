@@ -119,6 +119,27 @@ func (m *ModelNode) UpdateNode(ctx context.Context, node *types.Node) error {
 	return m.db.Table(m.tableName).Upsert(ctx, cond, operator.M{"$set": node})
 }
 
+// PatchNodeWithCondition update node fields only when node matches extra condition,
+// returns false when node is not matched
+func (m *ModelNode) PatchNodeWithCondition(ctx context.Context, nodeID, innerIP string, cond *operator.Condition,
+	patchs map[string]interface{}) (bool, error) {
+	if err := m.ensureTable(ctx); err != nil {
+		return false, err
+	}
+	nodeCond := operator.NewLeafCondition(operator.Eq, operator.M{
+		nodeIDKeyName: nodeID,
+		nodeIPKeyName: innerIP,
+	})
+	if cond != nil {
+		nodeCond = operator.NewBranchCondition(operator.And, nodeCond, cond)
+	}
+	count, err := m.db.Table(m.tableName).UpdateMany(ctx, nodeCond, operator.M{"$set": patchs})
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
 // DeleteNode delete node
 func (m *ModelNode) DeleteNode(ctx context.Context, nodeID string) error {
 	if err := m.ensureTable(ctx); err != nil {
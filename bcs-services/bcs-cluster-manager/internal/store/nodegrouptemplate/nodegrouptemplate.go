@@ -0,0 +1,166 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package nodegrouptemplate
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/drivers"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/operator"
+	types "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/options"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/util"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	tableName = "nodegrouptemplate"
+	//! we don't setting bson tag in proto file,
+	//! all struct key in mongo is lowcase in default
+	templateIDKey                      = "templateid"
+	versionKey                         = "version"
+	defaultNodeGroupTemplateListLength = 1000
+)
+
+var (
+	templateIndexes = []drivers.Index{
+		{
+			Name: tableName + "_idx",
+			Key: bson.D{
+				bson.E{Key: templateIDKey, Value: 1},
+				bson.E{Key: versionKey, Value: 1},
+			},
+			Unique: true,
+		},
+	}
+)
+
+// ModelNodeGroupTemplate database operation for nodeGroupTemplate, every
+// template version is stored as a single record
+type ModelNodeGroupTemplate struct {
+	tableName           string
+	indexes             []drivers.Index
+	db                  drivers.DB
+	isTableEnsured      bool
+	isTableEnsuredMutex sync.RWMutex
+}
+
+// New create nodeGroupTemplate model
+func New(db drivers.DB) *ModelNodeGroupTemplate {
+	return &ModelNodeGroupTemplate{
+		tableName: util.DataTableNamePrefix + tableName,
+		indexes:   templateIndexes,
+		db:        db,
+	}
+}
+
+// ensure table
+func (m *ModelNodeGroupTemplate) ensureTable(ctx context.Context) error {
+	m.isTableEnsuredMutex.RLock()
+	if m.isTableEnsured {
+		m.isTableEnsuredMutex.RUnlock()
+		return nil
+	}
+	if err := util.EnsureTable(ctx, m.db, m.tableName, m.indexes); err != nil {
+		m.isTableEnsuredMutex.RUnlock()
+		return err
+	}
+	m.isTableEnsuredMutex.RUnlock()
+
+	m.isTableEnsuredMutex.Lock()
+	m.isTableEnsured = true
+	m.isTableEnsuredMutex.Unlock()
+	return nil
+}
+
+// CreateNodeGroupTemplate insert nodeGroupTemplate version, version conflict
+// is rejected by unique index
+func (m *ModelNodeGroupTemplate) CreateNodeGroupTemplate(ctx context.Context, template *types.NodeGroupTemplate) error {
+	if template == nil {
+		return fmt.Errorf("nodeGroupTemplate to be created cannot be empty")
+	}
+	if err := m.ensureTable(ctx); err != nil {
+		return err
+	}
+
+	if _, err := m.db.Table(m.tableName).Insert(ctx, []interface{}{template}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DeleteNodeGroupTemplate delete all versions of nodeGroupTemplate
+func (m *ModelNodeGroupTemplate) DeleteNodeGroupTemplate(ctx context.Context, templateID string) error {
+	if err := m.ensureTable(ctx); err != nil {
+		return err
+	}
+	cond := operator.NewLeafCondition(operator.Eq, operator.M{
+		templateIDKey: templateID,
+	})
+	_, err := m.db.Table(m.tableName).Delete(ctx, cond)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetNodeGroupTemplate get nodeGroupTemplate version, latest version when version is 0
+func (m *ModelNodeGroupTemplate) GetNodeGroupTemplate(ctx context.Context, templateID string, version uint32) (
+	*types.NodeGroupTemplate, error) {
+	if err := m.ensureTable(ctx); err != nil {
+		return nil, err
+	}
+	cond := operator.NewLeafCondition(operator.Eq, operator.M{
+		templateIDKey: templateID,
+	})
+	if version != 0 {
+		cond = operator.NewLeafCondition(operator.Eq, operator.M{
+			templateIDKey: templateID,
+			versionKey:    version,
+		})
+	}
+	template := &types.NodeGroupTemplate{}
+	finder := m.db.Table(m.tableName).Find(cond).WithSort(map[string]interface{}{versionKey: -1})
+	if err := finder.One(ctx, template); err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+// ListNodeGroupTemplate list nodeGroupTemplate versions
+func (m *ModelNodeGroupTemplate) ListNodeGroupTemplate(ctx context.Context, cond *operator.Condition,
+	opt *options.ListOption) ([]types.NodeGroupTemplate, error) {
+	templateList := make([]types.NodeGroupTemplate, 0)
+	finder := m.db.Table(m.tableName).Find(cond)
+	if len(opt.Sort) != 0 {
+		finder = finder.WithSort(util.MapInt2MapIf(opt.Sort))
+	}
+	if opt.Offset != 0 {
+		finder = finder.WithStart(opt.Offset)
+	}
+	if opt.Limit == 0 {
+		finder = finder.WithLimit(defaultNodeGroupTemplateListLength)
+	} else {
+		finder = finder.WithLimit(opt.Limit)
+	}
+	if err := finder.All(ctx, &templateList); err != nil {
+		return nil, err
+	}
+
+	return templateList, nil
+}
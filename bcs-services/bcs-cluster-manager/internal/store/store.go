@@ -24,9 +24,13 @@ import (
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/cloudvpc"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/cluster"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/clustercredential"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/clusterdrift"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/clustertemplate"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/hostkey"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/namespace"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/node"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/nodegroup"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/nodegrouptemplate"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/operationlog"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/options"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/project"
@@ -40,12 +44,16 @@ import (
 type ClusterManagerModel interface {
 	CreateCluster(ctx context.Context, cluster *types.Cluster) error
 	UpdateCluster(ctx context.Context, cluster *types.Cluster) error
+	PatchClusterWithCondition(ctx context.Context, clusterID string, cond *operator.Condition,
+		patchs map[string]interface{}) (bool, error)
 	DeleteCluster(ctx context.Context, clusterID string) error
 	GetCluster(ctx context.Context, clusterID string) (*types.Cluster, error)
 	ListCluster(ctx context.Context, cond *operator.Condition, opt *options.ListOption) ([]types.Cluster, error)
 
 	CreateNode(ctx context.Context, node *types.Node) error
 	UpdateNode(ctx context.Context, node *types.Node) error
+	PatchNodeWithCondition(ctx context.Context, nodeID, innerIP string, cond *operator.Condition,
+		patchs map[string]interface{}) (bool, error)
 	DeleteNode(ctx context.Context, nodeID string) error
 	DeleteNodesByIPs(ctx context.Context, ips []string) error
 	DeleteNodesByNodeIDs(ctx context.Context, nodeIDs []string) error
@@ -118,10 +126,36 @@ type ClusterManagerModel interface {
 	ListNodeGroup(ctx context.Context, cond *operator.Condition, opt *options.ListOption) ([]types.NodeGroup, error)
 	DeleteNodeGroupByClusterID(ctx context.Context, clusterID string) error
 
+	//cluster & nodegroup template storage management
+	CreateClusterTemplate(ctx context.Context, template *types.ClusterTemplate) error
+	DeleteClusterTemplate(ctx context.Context, templateID string) error
+	GetClusterTemplate(ctx context.Context, templateID string, version uint32) (*types.ClusterTemplate, error)
+	ListClusterTemplate(ctx context.Context, cond *operator.Condition, opt *options.ListOption) (
+		[]types.ClusterTemplate, error)
+	CreateNodeGroupTemplate(ctx context.Context, template *types.NodeGroupTemplate) error
+	DeleteNodeGroupTemplate(ctx context.Context, templateID string) error
+	GetNodeGroupTemplate(ctx context.Context, templateID string, version uint32) (*types.NodeGroupTemplate, error)
+	ListNodeGroupTemplate(ctx context.Context, cond *operator.Condition, opt *options.ListOption) (
+		[]types.NodeGroupTemplate, error)
+
+	//cluster drift storage management
+	UpdateClusterDrift(ctx context.Context, clusterID string, drifts []*types.ClusterDrift) error
+	DeleteClusterDrift(ctx context.Context, clusterID string) error
+	ListClusterDrift(ctx context.Context, cond *operator.Condition, opt *options.ListOption) (
+		[]types.ClusterDrift, error)
+
+	//ssh host key storage management
+	CreateHostKey(ctx context.Context, key *hostkey.HostKey) error
+	GetHostKey(ctx context.Context, host string) (*hostkey.HostKey, error)
+	DeleteHostKey(ctx context.Context, host string) error
+
 	//task information storage management
 	CreateTask(ctx context.Context, task *types.Task) error
 	UpdateTask(ctx context.Context, task *types.Task) error
+	// PatchTask & PatchTaskWithCondition update task fields partially, fields patched with nil value are removed
 	PatchTask(ctx context.Context, taskID string, patchs map[string]interface{}) error
+	PatchTaskWithCondition(ctx context.Context, taskID string, cond *operator.Condition,
+		patchs map[string]interface{}) (bool, error)
 	DeleteTask(ctx context.Context, taskID string) error
 	GetTask(ctx context.Context, taskID string) (*types.Task, error)
 	ListTask(ctx context.Context, cond *operator.Condition, opt *options.ListOption) ([]types.Task, error)
@@ -156,6 +190,10 @@ type ModelSet struct {
 	*cloudvpc.ModelCloudVPC
 	*operationlog.ModelOperationLog
 	*account.ModelCloudAccount
+	*clustertemplate.ModelClusterTemplate
+	*nodegrouptemplate.ModelNodeGroupTemplate
+	*clusterdrift.ModelClusterDrift
+	*hostkey.ModelHostKey
 }
 
 // NewModelSet create model set
@@ -175,5 +213,9 @@ func NewModelSet(db drivers.DB) ClusterManagerModel {
 		ModelCloudVPC:          cloudvpc.New(db),
 		ModelOperationLog:      operationlog.New(db),
 		ModelCloudAccount:      account.New(db),
+		ModelClusterTemplate:   clustertemplate.New(db),
+		ModelNodeGroupTemplate: nodegrouptemplate.New(db),
+		ModelClusterDrift:      clusterdrift.New(db),
+		ModelHostKey:           hostkey.New(db),
 	}
 }
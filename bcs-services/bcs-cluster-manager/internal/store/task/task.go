@@ -113,7 +113,28 @@ func (m *ModelTask) UpdateTask(ctx context.Context, task *types.Task) error {
 	return m.db.Table(m.tableName).Upsert(ctx, cond, operator.M{"$set": task})
 }
 
-// PatchTask update task partially
+// patchUpdate build update document of task patchs, fields patched with nil value are removed
+func patchUpdate(patchs map[string]interface{}) operator.M {
+	sets := make(map[string]interface{})
+	unsets := make(map[string]interface{})
+	for k, v := range patchs {
+		if v == nil {
+			unsets[k] = ""
+			continue
+		}
+		sets[k] = v
+	}
+	update := operator.M{}
+	if len(sets) > 0 {
+		update["$set"] = sets
+	}
+	if len(unsets) > 0 {
+		update["$unset"] = unsets
+	}
+	return update
+}
+
+// PatchTask update task partially, fields patched with nil value are removed
 func (m *ModelTask) PatchTask(ctx context.Context, taskID string, patchs map[string]interface{}) error {
 	if err := m.ensureTable(ctx); err != nil {
 		return err
@@ -122,7 +143,28 @@ func (m *ModelTask) PatchTask(ctx context.Context, taskID string, patchs map[str
 		tableKey: taskID,
 	})
 	//! we patch fields that need to be updated
-	return m.db.Table(m.tableName).Upsert(ctx, cond, operator.M{"$set": patchs})
+	return m.db.Table(m.tableName).Upsert(ctx, cond, patchUpdate(patchs))
+}
+
+// PatchTaskWithCondition update task partially only when task matches extra condition,
+// returns false when task is not matched. it's used to switch step status atomically
+// when steps of one task are running concurrently
+func (m *ModelTask) PatchTaskWithCondition(ctx context.Context, taskID string, cond *operator.Condition,
+	patchs map[string]interface{}) (bool, error) {
+	if err := m.ensureTable(ctx); err != nil {
+		return false, err
+	}
+	taskCond := operator.NewLeafCondition(operator.Eq, operator.M{
+		tableKey: taskID,
+	})
+	if cond != nil {
+		taskCond = operator.NewBranchCondition(operator.And, taskCond, cond)
+	}
+	count, err := m.db.Table(m.tableName).UpdateMany(ctx, taskCond, patchUpdate(patchs))
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
 }
 
 // DeleteTask delete task
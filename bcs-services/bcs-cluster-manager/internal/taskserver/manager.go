@@ -98,54 +98,53 @@ func (ts *TaskServer) Stop() {
 	ts.cancel()
 }
 
-//Dispatch dispatch task to worker
+//Dispatch dispatch task to worker, steps of which dependencies are all done are sent to
+//workers, subsequent steps are sent by workers when steps finished
 func (ts *TaskServer) Dispatch(task *proto.Task) error {
 	//store all task information and then dispatch to remote worker
 	if err := validateTask(task); err != nil {
 		blog.Errorf("task %s/%s is not validate, %s", task.TaskID, task.TaskType, err.Error())
 		return err
 	}
-	//create all task to signature
-	blog.Infof("task %s/%s with steps %v ready to dispatch worker", task.TaskID, task.TaskType, task.StepSequence)
-	var signatures []*tasks.Signature
-	for _, stepName := range task.StepSequence {
-		step := task.Steps[stepName]
-		signature := &tasks.Signature{
-			UUID: fmt.Sprintf("task-%s-%s", task.TaskID, stepName),
-			Name: step.TaskMethod,
-			// two parameters: taskID, stepName
-			Args: []tasks.Arg{{Type: "string", Value: task.TaskID}, {Type: "string", Value: stepName}},
+	if err := initStepIdempotencyKey(task); err != nil {
+		blog.Errorf("task %s/%s init steps idempotency key failed, %s", task.TaskID, task.TaskType, err.Error())
+		return err
+	}
+
+	steps := cloudprovider.GetReadySteps(task)
+	if len(steps) == 0 {
+		// all steps skipped
+		if cloudprovider.IsTaskStepsDone(task) {
+			return cloudprovider.NewTaskState(task).UpdateTaskSucc(time.Now())
 		}
-		signatures = append(signatures, signature)
+		return fmt.Errorf("task %s has no step ready to run", task.TaskID)
 	}
+	blog.Infof("task %s/%s with steps %v ready to dispatch worker, steps %v are sent",
+		task.TaskID, task.TaskType, task.StepSequence, steps)
+	return ts.sendSteps(task, steps)
+}
+
+// sendSteps sending steps to workers
+func (ts *TaskServer) sendSteps(task *proto.Task, steps []string) error {
 	ts.lock.Lock()
 	defer ts.lock.Unlock()
 
-	//sending to workers
-	chain, _ := tasks.NewChain(signatures...)
 	cxt, cancel := context.WithCancel(ts.cxt)
 	defer cancel()
-
-	asyncResult, err := ts.server.SendChainWithContext(cxt, chain)
-	if err != nil {
-		// try to re-send tasks with back-off strategy in for loop?
-		blog.Errorf("sending task %s to worker failed: %s", task.TaskID, err.Error())
-		return err
-	}
-
-	go func(t *proto.Task, c *tasks.Chain) {
-		//check async results
-		for retry := 3; retry > 0; retry-- {
-			results, err := asyncResult.Get(time.Second * 5)
-			if err != nil {
-				blog.Errorf("tracing task %s result failed, %s. retry %d", t.TaskID, err.Error(), retry)
-				continue
-			}
-			//check results
-			blog.Infof("tracing task %s result %s", t.TaskID, tasks.HumanReadableResults(results))
+	for _, stepName := range steps {
+		step := task.Steps[stepName]
+		signature := &tasks.Signature{
+			UUID: fmt.Sprintf("task-%s-%s", task.TaskID, stepName),
+			Name: step.TaskMethod,
+			// two parameters: taskID, stepName
+			Args: []tasks.Arg{{Type: "string", Value: task.TaskID}, {Type: "string", Value: stepName}},
 		}
-	}(task, chain)
-
+		if _, err := ts.server.SendTaskWithContext(cxt, signature); err != nil {
+			// try to re-send tasks with back-off strategy in for loop?
+			blog.Errorf("sending task %s step %s to worker failed: %s", task.TaskID, stepName, err.Error())
+			return err
+		}
+	}
 	return nil
 }
 
@@ -201,6 +200,19 @@ func (ts *TaskServer) initServer() error {
 
 	//register bksops job task
 	allTasks["bksopsjob"] = localtask.RunBKsopsJob
+	//register cluster & nodegroup upgrade common task
+	allTasks[cloudprovider.UpgradePreCheckTask] = localtask.UpgradePreCheckTask
+	allTasks[cloudprovider.UpdateClusterVersionDBTask] = localtask.UpdateClusterVersionDBTask
+	allTasks[cloudprovider.CordonDrainNodesTask] = localtask.CordonDrainNodesTask
+	allTasks[cloudprovider.CheckUpgradedNodesTask] = localtask.CheckUpgradedNodesTask
+	//register node drain common task
+	allTasks[cloudprovider.DrainNodesTask] = localtask.DrainNodesTask
+	//steps are scheduled by task server
+	for name, task := range allTasks {
+		if fn, ok := task.(func(string, string) error); ok {
+			allTasks[name] = ts.wrapStepFunc(fn)
+		}
+	}
 	if err := ts.server.RegisterTasks(allTasks); err != nil {
 		blog.Errorf("task server register tasks failed, %s", err.Error())
 		return err
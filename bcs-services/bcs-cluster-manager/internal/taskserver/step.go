@@ -0,0 +1,140 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package taskserver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/RichardKnop/machinery/v2/tasks"
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+)
+
+// wrapStepFunc wrap step implementation registered in go-machinery: step is failed when
+// running timeout, failed step is retried later when retry times not exceed, and steps
+// depend on it are sent to workers when step finished
+func (ts *TaskServer) wrapStepFunc(fn func(taskID, stepName string) error) func(taskID, stepName string) error {
+	return func(taskID, stepName string) error {
+		task, err := cloudprovider.GetStorageModel().GetTask(context.Background(), taskID)
+		if err != nil {
+			blog.Errorf("task[%s] get task failed before step %s, %s", taskID, stepName, err.Error())
+			return err
+		}
+		step, ok := task.Steps[stepName]
+		if !ok {
+			return fmt.Errorf("task %s lost step %s", taskID, stepName)
+		}
+
+		err = runStepWithTimeout(fn, taskID, stepName, time.Duration(step.Timeout)*time.Second)
+		return ts.afterStep(taskID, stepName, err)
+	}
+}
+
+// runStepWithTimeout run step and make step failure when running timeout. step implementation
+// can't be canceled and keeps running in background, it can't update step state any more, but
+// its side effects conflict with the retried one. So it waits for the timeout step returning
+// before returning error, and the step is retried only after the old run finished
+func runStepWithTimeout(fn func(taskID, stepName string) error, taskID, stepName string,
+	timeout time.Duration) error {
+	if timeout <= 0 {
+		return fn(taskID, stepName)
+	}
+
+	start := time.Now()
+	done := make(chan error, 1)
+	go func() {
+		done <- fn(taskID, stepName)
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-time.After(timeout):
+	}
+
+	timeoutErr := fmt.Errorf("step %s running timeout after %s", stepName, timeout)
+	blog.Errorf("task[%s] %s", taskID, timeoutErr.Error())
+	markStepTimeout(taskID, stepName, start, timeoutErr)
+
+	blog.Infof("task[%s] wait for timeout step %s returning before retry", taskID, stepName)
+	if err := <-done; err != nil {
+		blog.Errorf("task[%s] timeout step %s returned after %s, %s", taskID, stepName,
+			time.Since(start), err.Error())
+	} else {
+		blog.Infof("task[%s] timeout step %s returned after %s", taskID, stepName, time.Since(start))
+	}
+	return timeoutErr
+}
+
+// markStepTimeout make running step failure, so that the timeout step can't update step state
+func markStepTimeout(taskID, stepName string, start time.Time, timeoutErr error) {
+	task, err := cloudprovider.GetStorageModel().GetTask(context.Background(), taskID)
+	if err != nil {
+		blog.Errorf("task[%s] get task failed when step %s timeout, %s", taskID, stepName, err.Error())
+		return
+	}
+	if step := task.Steps[stepName]; step == nil || step.Status != cloudprovider.TaskStatusRunning {
+		return
+	}
+	_ = cloudprovider.NewTaskState(task).UpdateStepFailure(start, stepName, timeoutErr)
+}
+
+// afterStep send ready steps when step finished, or retry failed step later
+func (ts *TaskServer) afterStep(taskID, stepName string, stepErr error) error {
+	task, err := cloudprovider.GetStorageModel().GetTask(context.Background(), taskID)
+	if err != nil {
+		blog.Errorf("task[%s] get task failed after step %s, %s", taskID, stepName, err.Error())
+		if stepErr != nil {
+			return stepErr
+		}
+		return err
+	}
+	step := task.Steps[stepName]
+	if cloudprovider.IsStepDone(step) {
+		return ts.sendNextSteps(task)
+	}
+	if stepErr == nil {
+		return nil
+	}
+
+	// step failure keeps task running when step can be retried, details in UpdateStepFailure
+	if step != nil && step.Status == cloudprovider.TaskStatusFailure &&
+		task.Status == cloudprovider.TaskStatusRunning && step.Retry < step.MaxRetry {
+		blog.Infof("task[%s] step %s failed, retry %d/%d after %ds", taskID, stepName, step.Retry+1,
+			step.MaxRetry, step.RetryInterval)
+		return tasks.NewErrRetryTaskLater(stepErr.Error(), time.Duration(step.RetryInterval)*time.Second)
+	}
+	return stepErr
+}
+
+// sendNextSteps send steps ready to run except failed steps waiting for retry, steps finished
+// concurrently may send the same step, but only one worker can switch step to running
+func (ts *TaskServer) sendNextSteps(task *proto.Task) error {
+	if task.Status != cloudprovider.TaskStatusRunning {
+		return nil
+	}
+	steps := make([]string, 0)
+	for _, name := range cloudprovider.GetReadySteps(task) {
+		if task.Steps[name].Status != cloudprovider.TaskStatusFailure {
+			steps = append(steps, name)
+		}
+	}
+	if len(steps) == 0 {
+		return nil
+	}
+	blog.Infof("task %s steps %v ready to dispatch worker", task.TaskID, steps)
+	return ts.sendSteps(task, steps)
+}
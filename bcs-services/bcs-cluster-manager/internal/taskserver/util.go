@@ -14,9 +14,11 @@
 package taskserver
 
 import (
+	"context"
 	"fmt"
 
 	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
 )
 
 func validateTask(task *proto.Task) error {
@@ -42,5 +44,24 @@ func validateTask(task *proto.Task) error {
 	if !currentFound {
 		return fmt.Errorf("current step %s lost detail information", task.CurrentStep)
 	}
-	return nil
+	return cloudprovider.ValidateTaskSteps(task)
+}
+
+// initStepIdempotencyKey steps without idempotency key are set to taskID-stepName,
+// idempotency key is kept when step retry
+func initStepIdempotencyKey(task *proto.Task) error {
+	patchs := make(map[string]interface{})
+	for _, stepName := range task.StepSequence {
+		step := task.Steps[stepName]
+		if len(step.IdempotencyKey) != 0 {
+			continue
+		}
+		step.IdempotencyKey = fmt.Sprintf("%s-%s", task.TaskID, stepName)
+		patchs["steps."+stepName+".idempotencykey"] = step.IdempotencyKey
+	}
+	if len(patchs) == 0 {
+		return nil
+	}
+	_, err := cloudprovider.GetStorageModel().PatchTaskWithCondition(context.Background(), task.TaskID, nil, patchs)
+	return err
 }
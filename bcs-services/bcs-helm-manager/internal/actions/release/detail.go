@@ -97,6 +97,8 @@ func (g *GetReleaseDetailAction) getDetail() error {
 	}
 
 	rls.Values = storedRelease.Values
+	rls.Drifted = common.GetBoolP(storedRelease.Drifted)
+	rls.DriftedResources = storedRelease.DriftedResources
 	g.setResp(common.ErrHelmManagerSuccess, "ok", rls)
 	blog.Infof("get release detail successfully, "+
 		"clusterID: %s namespace: %s, name: %s, revision: %d",
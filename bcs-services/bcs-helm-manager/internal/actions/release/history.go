@@ -0,0 +1,134 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under,
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package release
+
+import (
+	"context"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/operator"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/common"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/store"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/store/entity"
+	storerelease "github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/store/release"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/store/utils"
+	helmmanager "github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/proto/bcs-helm-manager"
+)
+
+// NewListReleaseHistoryAction return a new ListReleaseHistoryAction instance
+func NewListReleaseHistoryAction(model store.HelmManagerModel) *ListReleaseHistoryAction {
+	return &ListReleaseHistoryAction{
+		model: model,
+	}
+}
+
+// ListReleaseHistoryAction provides the action to do list release history
+type ListReleaseHistoryAction struct {
+	ctx context.Context
+
+	model store.HelmManagerModel
+
+	req  *helmmanager.ListReleaseHistoryReq
+	resp *helmmanager.ListReleaseHistoryResp
+}
+
+// Handle the listing process
+func (l *ListReleaseHistoryAction) Handle(ctx context.Context,
+	req *helmmanager.ListReleaseHistoryReq, resp *helmmanager.ListReleaseHistoryResp) error {
+
+	if req == nil || resp == nil {
+		blog.Errorf("list release history failed, req or resp is empty")
+		return common.ErrHelmManagerReqOrRespEmpty.GenError()
+	}
+	l.ctx = ctx
+	l.req = req
+	l.resp = resp
+
+	if err := l.req.Validate(); err != nil {
+		blog.Errorf("list release history failed, invalid request, %s, param: %v", err.Error(), l.req)
+		l.setResp(common.ErrHelmManagerRequestParamInvalid, err.Error(), nil)
+		return nil
+	}
+
+	return l.list()
+}
+
+func (l *ListReleaseHistoryAction) list() error {
+	clusterID := l.req.GetClusterID()
+	namespace := l.req.GetNamespace()
+	name := l.req.GetName()
+	option := l.getOption()
+
+	// 默认只返回当前安装的revision, 指定includeUninstalled时同时返回已卸载的revision
+	eq := operator.M{
+		entity.FieldKeyClusterID: clusterID,
+		entity.FieldKeyNamespace: namespace,
+		entity.FieldKeyName:      name,
+	}
+	cond := storerelease.InstalledCondition(eq)
+	if l.req.GetIncludeUninstalled() {
+		cond = operator.NewLeafCondition(operator.Eq, eq)
+	}
+	total, origin, err := l.model.ListRelease(l.ctx, cond, option)
+	if err != nil {
+		blog.Errorf("list release history failed, %s, clusterID: %s, namespace: %s, name: %s",
+			err.Error(), clusterID, namespace, name)
+		l.setResp(common.ErrHelmManagerListActionFailed, err.Error(), nil)
+		return nil
+	}
+
+	// 列表中不返回manifest, 需要时通过GetReleaseRevision获取
+	r := make([]*helmmanager.ReleaseRevision, 0, len(origin))
+	for _, item := range origin {
+		item.Manifest = ""
+		r = append(r, item.Transfer2Proto())
+	}
+	l.setResp(common.ErrHelmManagerSuccess, "ok", &helmmanager.ReleaseHistoryListData{
+		Page:  common.GetUint32P(uint32(option.Page)),
+		Size:  common.GetUint32P(uint32(option.Size)),
+		Total: common.GetUint32P(uint32(total)),
+		Data:  r,
+	})
+	blog.Infof("list release history successfully, clusterID: %s, namespace: %s, name: %s",
+		clusterID, namespace, name)
+	return nil
+}
+
+func (l *ListReleaseHistoryAction) getOption() *utils.ListOption {
+	size := l.req.GetSize()
+	if size == 0 {
+		size = defaultSize
+	}
+
+	// 重新安装后revision从1开始, 包含已卸载的revision时按创建时间排序
+	sort := map[string]int{entity.FieldKeyRevision: -1}
+	if l.req.GetIncludeUninstalled() {
+		sort = map[string]int{entity.FieldKeyCreateTime: -1}
+	}
+
+	return &utils.ListOption{
+		Sort: sort,
+		Page: int64(l.req.GetPage()),
+		Size: int64(size),
+	}
+}
+
+func (l *ListReleaseHistoryAction) setResp(
+	err common.HelmManagerError, message string, r *helmmanager.ReleaseHistoryListData) {
+	code := err.Int32()
+	msg := err.ErrorMessage(message)
+	l.resp.Code = &code
+	l.resp.Message = &msg
+	l.resp.Result = err.OK()
+	l.resp.Data = r
+}
@@ -156,9 +156,10 @@ func (i *InstallReleaseAction) install() error {
 		return nil
 	}
 
-	// 存储release信息到store中, 首先先删掉所有revision的数据
-	if err = i.model.DeleteReleases(i.ctx, clusterID, releaseNamespace, releaseNamespace); err != nil {
-		blog.Errorf("install release, delete release in store failed, %s, "+
+	// 存储release信息到store中, 重新安装的revision从1开始, 先将之前安装的revision标记为已卸载,
+	// 这些revision可能是在helm-manager之外卸载的, 记录保留为历史
+	if err = i.model.UninstallReleases(i.ctx, clusterID, releaseNamespace, releaseName); err != nil {
+		blog.Errorf("install release, mark previous releases uninstalled in store failed, %s, "+
 			"projectID: %s, clusterID: %s, chartName: %s, chartVersion: %s, namespace: %s, name: %s, operator: %s",
 			err.Error(), projectID, clusterID, chartName, chartVersion, releaseNamespace, releaseName, username)
 		i.setResp(common.ErrHelmManagerInstallActionFailed, err.Error(), nil)
@@ -172,6 +173,10 @@ func (i *InstallReleaseAction) install() error {
 		ChartVersion: chartVersion,
 		Revision:     result.Revision,
 		Values:       values,
+		Action:       entity.ReleaseActionInstall,
+		Status:       result.Status,
+		Manifest:     result.Manifest,
+		CreateBy:     username,
 	}); err != nil {
 		blog.Errorf("install release, create release in store failed, %s, "+
 			"projectID: %s, clusterID: %s, chartName: %s, chartVersion: %s, namespace: %s, name: %s, operator: %s",
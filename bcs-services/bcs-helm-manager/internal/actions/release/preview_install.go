@@ -0,0 +1,173 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under,
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package release
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/auth"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/common"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/component/project"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/release"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/repo"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/store"
+	helmmanager "github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/proto/bcs-helm-manager"
+)
+
+// NewPreviewInstallReleaseAction return a new PreviewInstallReleaseAction instance
+func NewPreviewInstallReleaseAction(
+	model store.HelmManagerModel, platform repo.Platform, releaseHandler release.Handler) *PreviewInstallReleaseAction {
+	return &PreviewInstallReleaseAction{
+		model:          model,
+		platform:       platform,
+		releaseHandler: releaseHandler,
+	}
+}
+
+// PreviewInstallReleaseAction provides the actions to do preview install release
+type PreviewInstallReleaseAction struct {
+	ctx context.Context
+
+	model          store.HelmManagerModel
+	platform       repo.Platform
+	releaseHandler release.Handler
+
+	req  *helmmanager.PreviewInstallReleaseReq
+	resp *helmmanager.PreviewInstallReleaseResp
+}
+
+// Handle the preview install process
+func (i *PreviewInstallReleaseAction) Handle(ctx context.Context,
+	req *helmmanager.PreviewInstallReleaseReq, resp *helmmanager.PreviewInstallReleaseResp) error {
+
+	if req == nil || resp == nil {
+		blog.Errorf("preview install release failed, req or resp is empty")
+		return common.ErrHelmManagerReqOrRespEmpty.GenError()
+	}
+	i.ctx = ctx
+	i.req = req
+	i.resp = resp
+
+	if err := i.req.Validate(); err != nil {
+		blog.Errorf("preview install release failed, invalid request, %s, param: %v", err.Error(), i.req)
+		i.setResp(common.ErrHelmManagerRequestParamInvalid, err.Error(), nil)
+		return nil
+	}
+
+	return i.preview()
+}
+
+func (i *PreviewInstallReleaseAction) preview() error {
+	releaseName := i.req.GetName()
+	releaseNamespace := i.req.GetNamespace()
+	clusterID := i.req.GetClusterID()
+	projectID := i.req.GetProjectID()
+	repoName := i.req.GetRepository()
+	chartName := i.req.GetChart()
+	chartVersion := i.req.GetVersion()
+	values := i.req.GetValues()
+	username := auth.GetUserFromCtx(i.ctx)
+
+	// 获取对应的仓库信息
+	repository, err := i.model.GetRepository(i.ctx, projectID, repoName)
+	if err != nil {
+		blog.Errorf("preview install release get repository failed, %s, "+
+			"projectID: %s, clusterID: %s, chartName: %s, chartVersion: %s, namespace: %s, name: %s, operator: %s",
+			err.Error(), projectID, clusterID, chartName, chartVersion, releaseNamespace, releaseName, username)
+		i.setResp(common.ErrHelmManagerPreviewActionFailed, err.Error(), nil)
+		return nil
+	}
+
+	// 下载到具体的chart version信息
+	contents, err := i.platform.
+		User(repo.User{
+			Name:     repository.Username,
+			Password: repository.Password,
+		}).
+		Project(repository.ProjectID).
+		Repository(
+			repo.GetRepositoryType(repository.Type),
+			repository.Name,
+		).
+		Chart(chartName).
+		Download(i.ctx, chartVersion)
+	if err != nil {
+		blog.Errorf("preview install release get chart detail failed, %s, "+
+			"projectID: %s, clusterID: %s, chartName: %s, chartVersion: %s, namespace: %s, name: %s, operator: %s",
+			err.Error(), projectID, clusterID, chartName, chartVersion, releaseNamespace, releaseName, username)
+		i.setResp(common.ErrHelmManagerPreviewActionFailed, err.Error(), nil)
+		return nil
+	}
+
+	vls := make([]*release.File, 0, len(values))
+	for index, v := range values {
+		vls = append(vls, &release.File{
+			Name:    "values-" + strconv.Itoa(index) + ".yaml",
+			Content: []byte(v),
+		})
+	}
+	// 获取项目 32 位长度ID
+	patchProjectID, err := project.GetProjectIDByCode(username, projectID)
+	if err != nil {
+		blog.Errorf("get project id error, projectCode: %s, err: %s", projectID, err.Error())
+		patchProjectID = ""
+	}
+	// 以dry-run的方式执行install操作, 与实际执行时使用相同的参数
+	result, err := i.releaseHandler.Cluster(clusterID).PreviewInstall(
+		i.ctx,
+		release.HelmInstallConfig{
+			Name:      releaseName,
+			Namespace: releaseNamespace,
+			Chart: &release.File{
+				Name:    chartName + "-" + chartVersion + ".tgz",
+				Content: contents,
+			},
+			Args:   i.req.GetArgs(),
+			Values: vls,
+			PatchTemplateValues: map[string]string{
+				common.PTKProjectID: patchProjectID,
+				common.PTKClusterID: clusterID,
+				common.PTKNamespace: releaseNamespace,
+				common.PTKCreator:   username,
+				common.PTKUpdator:   username,
+				common.PTKVersion:   chartVersion,
+				common.PTKName:      "",
+			},
+			VarTemplateValues: i.req.GetBcsSysVar(),
+		})
+	if err != nil {
+		blog.Errorf("preview install release failed, %s, "+
+			"projectID: %s, clusterID: %s, chartName: %s, chartVersion: %s, namespace: %s, name: %s, operator: %s",
+			err.Error(), projectID, clusterID, chartName, chartVersion, releaseNamespace, releaseName, username)
+		i.setResp(common.ErrHelmManagerPreviewActionFailed, err.Error(), nil)
+		return nil
+	}
+
+	blog.Infof("preview install release successfully, with revision %d, "+
+		"projectID: %s, clusterID: %s, chartName: %s, chartVersion: %s, namespace: %s, name: %s, operator: %s",
+		result.Revision, projectID, clusterID, chartName, chartVersion, releaseNamespace, releaseName, username)
+	i.setResp(common.ErrHelmManagerSuccess, "ok", result.Transfer2Proto(releaseName, releaseNamespace))
+	return nil
+}
+
+func (i *PreviewInstallReleaseAction) setResp(
+	err common.HelmManagerError, message string, r *helmmanager.ReleasePreview) {
+	code := err.Int32()
+	msg := err.ErrorMessage(message)
+	i.resp.Code = &code
+	i.resp.Message = &msg
+	i.resp.Result = err.OK()
+	i.resp.Data = r
+}
@@ -0,0 +1,107 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under,
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package release
+
+import (
+	"context"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/common"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/release/bcs/sdk"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/store"
+	helmmanager "github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/proto/bcs-helm-manager"
+)
+
+// NewGetReleaseRevisionAction return a new GetReleaseRevisionAction instance
+func NewGetReleaseRevisionAction(model store.HelmManagerModel) *GetReleaseRevisionAction {
+	return &GetReleaseRevisionAction{
+		model: model,
+	}
+}
+
+// GetReleaseRevisionAction provides the action to do get release revision
+type GetReleaseRevisionAction struct {
+	ctx context.Context
+
+	model store.HelmManagerModel
+
+	req  *helmmanager.GetReleaseRevisionReq
+	resp *helmmanager.GetReleaseRevisionResp
+}
+
+// Handle the getting process
+func (g *GetReleaseRevisionAction) Handle(ctx context.Context,
+	req *helmmanager.GetReleaseRevisionReq, resp *helmmanager.GetReleaseRevisionResp) error {
+
+	if req == nil || resp == nil {
+		blog.Errorf("get release revision failed, req or resp is empty")
+		return common.ErrHelmManagerReqOrRespEmpty.GenError()
+	}
+	g.ctx = ctx
+	g.req = req
+	g.resp = resp
+
+	if err := g.req.Validate(); err != nil {
+		blog.Errorf("get release revision failed, invalid request, %s, param: %v", err.Error(), g.req)
+		g.setResp(common.ErrHelmManagerRequestParamInvalid, err.Error(), nil)
+		return nil
+	}
+	if g.req.GetRevision() == 0 {
+		blog.Errorf("get release revision failed, invalid request, revision is empty, param: %v", g.req)
+		g.setResp(common.ErrHelmManagerRequestParamInvalid, "revision must be positive", nil)
+		return nil
+	}
+
+	return g.get()
+}
+
+func (g *GetReleaseRevisionAction) get() error {
+	clusterID := g.req.GetClusterID()
+	namespace := g.req.GetNamespace()
+	name := g.req.GetName()
+	revision := g.req.GetRevision()
+
+	r, err := g.model.GetRelease(g.ctx, clusterID, namespace, name, int(revision))
+	if err != nil {
+		blog.Errorf("get release revision failed, %s, clusterID: %s, namespace: %s, name: %s, revision: %d",
+			err.Error(), clusterID, namespace, name, revision)
+		g.setResp(common.ErrHelmManagerGetActionFailed, err.Error(), nil)
+		return nil
+	}
+
+	// manifest中Secret的数据不返回, 同时避免被记录到请求日志中
+	manifest, err := sdk.RedactManifest(r.Manifest)
+	if err != nil {
+		blog.Errorf("get release revision and redact manifest failed, %s, "+
+			"clusterID: %s, namespace: %s, name: %s, revision: %d",
+			err.Error(), clusterID, namespace, name, revision)
+		g.setResp(common.ErrHelmManagerGetActionFailed, err.Error(), nil)
+		return nil
+	}
+	r.Manifest = manifest
+
+	g.setResp(common.ErrHelmManagerSuccess, "ok", r.Transfer2Proto())
+	blog.Infof("get release revision successfully, clusterID: %s, namespace: %s, name: %s, revision: %d",
+		clusterID, namespace, name, revision)
+	return nil
+}
+
+func (g *GetReleaseRevisionAction) setResp(
+	err common.HelmManagerError, message string, r *helmmanager.ReleaseRevision) {
+	code := err.Int32()
+	msg := err.ErrorMessage(message)
+	g.resp.Code = &code
+	g.resp.Message = &msg
+	g.resp.Result = err.OK()
+	g.resp.Data = r
+}
@@ -75,9 +75,8 @@ func (r *RollbackReleaseAction) rollback() error {
 	clusterID := r.req.GetClusterID()
 	username := auth.GetUserFromCtx(r.ctx)
 
-	handler := r.releaseHandler.Cluster(clusterID)
 	// 执行rollback操作
-	_, err := handler.Rollback(
+	result, err := r.releaseHandler.Cluster(clusterID).Rollback(
 		r.ctx,
 		release.HelmRollbackConfig{
 			Name:      releaseName,
@@ -91,32 +90,21 @@ func (r *RollbackReleaseAction) rollback() error {
 		r.setResp(common.ErrHelmManagerRollbackActionFailed, err.Error())
 		return nil
 	}
+	currentRevision := result.Revision
 
-	// 查询当前release的revision
-	_, record, err := handler.List(r.ctx,
-		release.ListOption{
-			Size:      1,
-			Namespace: releaseNamespace,
-			Name:      releaseName,
-		})
+	// rollback使用的是目标revision的values, 目标revision不是通过helm manager部署时, 不记录values
+	var values []string
+	target, err := r.model.GetRelease(r.ctx, clusterID, releaseNamespace, releaseName, int(revision))
 	if err != nil {
-		blog.Errorf("rollback release get current revision failed, %s, "+
+		blog.Warnf("rollback release, get target revision in store failed, %s, "+
 			"clusterID: %s, namespace: %s, name: %s, rollback to revision %d, operator: %s",
 			err.Error(), clusterID, releaseNamespace, releaseName, revision, username)
-		r.setResp(common.ErrHelmManagerRollbackActionFailed, err.Error())
-		return nil
-	}
-	if len(record) == 0 {
-		blog.Errorf("rollback release get current revision failed, release not found, "+
-			"clusterID: %s, namespace: %s, name: %s, rollback to revision %d, operator: %s",
-			clusterID, releaseNamespace, releaseName, revision, username)
-		r.setResp(common.ErrHelmManagerRollbackActionFailed, "release not found")
-		return nil
+	} else {
+		values = target.Values
 	}
-	currentRevision := record[0].Revision
 
 	// 存储release信息到store中, 首先先删掉原来的同revision的数据
-	if err = r.model.DeleteRelease(r.ctx, clusterID, releaseNamespace, releaseNamespace, currentRevision); err != nil {
+	if err = r.model.DeleteRelease(r.ctx, clusterID, releaseNamespace, releaseName, currentRevision); err != nil {
 		blog.Errorf("rollback release, delete release in store failed, %s, "+
 			"clusterID: %s, namespace: %s, name: %s, revision: %d, operator: %s",
 			err.Error(), clusterID, releaseNamespace, releaseName, currentRevision, username)
@@ -124,11 +112,18 @@ func (r *RollbackReleaseAction) rollback() error {
 		return nil
 	}
 	if err = r.model.CreateRelease(r.ctx, &entity.Release{
-		Name:       releaseName,
-		Namespace:  releaseNamespace,
-		ClusterID:  clusterID,
-		Revision:   currentRevision,
-		RollbackTo: int(revision),
+		Name:         releaseName,
+		Namespace:    releaseNamespace,
+		ClusterID:    clusterID,
+		ChartName:    result.Chart,
+		ChartVersion: result.ChartVersion,
+		Revision:     currentRevision,
+		Values:       values,
+		RollbackTo:   int(revision),
+		Action:       entity.ReleaseActionRollback,
+		Status:       result.Status,
+		Manifest:     result.Manifest,
+		CreateBy:     username,
 	}); err != nil {
 		blog.Errorf("rollback release, create release in store failed, %s, "+
 			"clusterID: %s, namespace: %s, name: %s, revision: %d, operator: %s",
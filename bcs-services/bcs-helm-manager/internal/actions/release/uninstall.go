@@ -87,9 +87,9 @@ func (u *UninstallReleaseAction) uninstall() error {
 		return nil
 	}
 
-	// 删掉所有revision的数据
-	if err = u.model.DeleteReleases(u.ctx, clusterID, releaseNamespace, releaseName); err != nil {
-		blog.Errorf("uninstall release, delete releases in store failed, %s, "+
+	// 所有revision标记为已卸载, 记录保留为历史
+	if err = u.model.UninstallReleases(u.ctx, clusterID, releaseNamespace, releaseName); err != nil {
+		blog.Errorf("uninstall release, mark releases uninstalled in store failed, %s, "+
 			"clusterID: %s, namespace: %s, name: %s, operator: %s",
 			err.Error(), clusterID, releaseNamespace, releaseName, username)
 		u.setResp(common.ErrHelmManagerUninstallActionFailed, err.Error())
@@ -155,7 +155,7 @@ func (u *UpgradeReleaseAction) upgrade() error {
 	}
 
 	// 存储release信息到store中, 首先先删掉原来的同revision的数据
-	if err = u.model.DeleteRelease(u.ctx, clusterID, releaseNamespace, releaseNamespace, result.Revision); err != nil {
+	if err = u.model.DeleteRelease(u.ctx, clusterID, releaseNamespace, releaseName, result.Revision); err != nil {
 		blog.Errorf("upgrade release, delete release in store failed, %s, "+
 			"projectID: %s, clusterID: %s, chartName: %s, chartVersion: %s, namespace: %s, name: %s, operator: %s",
 			err.Error(), projectID, clusterID, chartName, chartVersion, releaseNamespace, releaseName, username)
@@ -170,6 +170,10 @@ func (u *UpgradeReleaseAction) upgrade() error {
 		ChartVersion: chartVersion,
 		Revision:     result.Revision,
 		Values:       values,
+		Action:       entity.ReleaseActionUpgrade,
+		Status:       result.Status,
+		Manifest:     result.Manifest,
+		CreateBy:     username,
 	}); err != nil {
 		blog.Errorf("upgrade release, create release in store failed, %s, "+
 			"projectID: %s, clusterID: %s, chartName: %s, chartVersion: %s, namespace: %s, name: %s, operator: %s",
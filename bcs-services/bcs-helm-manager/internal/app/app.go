@@ -49,6 +49,7 @@ import (
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/options"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/release"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/release/bcs"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/release/drift"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/repo"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/repo/bkrepo"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/store"
@@ -106,6 +107,7 @@ func (hm *HelmManager) Init() error {
 		hm.initModel,
 		hm.initPlatform,
 		hm.initReleaseHandler,
+		hm.initDriftChecker,
 		hm.initRegistry,
 		hm.initDiscovery,
 		hm.initMicro,
@@ -249,6 +251,19 @@ func (hm *HelmManager) initReleaseHandler() error {
 	return nil
 }
 
+// initDriftChecker brings up a checker to mark the releases whose live objects drift from the last applied manifest
+func (hm *HelmManager) initDriftChecker() error {
+	if hm.opt.Release.DriftCheckInterval == 0 {
+		blog.Infof("release drift checker is disabled")
+		return nil
+	}
+
+	checker := drift.NewChecker(hm.model, hm.releaseHandler,
+		time.Duration(hm.opt.Release.DriftCheckInterval)*time.Second)
+	go checker.Run(hm.ctx)
+	return nil
+}
+
 func (hm *HelmManager) initRegistry() error {
 	etcdEndpoints := common.SplitAddrString(hm.opt.Etcd.EtcdEndpoints)
 	etcdSecure := false
@@ -35,6 +35,7 @@ const (
 	ErrHelmManagerRollbackActionFailed
 	ErrHelmManagerAuthFailed
 	ErrHelmManagerRequestComponentFailed
+	ErrHelmManagerPreviewActionFailed
 )
 
 // Int32 return HelmManagerError's code value
@@ -83,4 +84,5 @@ var errorCodeMapping = map[HelmManagerError]string{
 	ErrHelmManagerRollbackActionFailed:   "rollback action failed",
 	ErrHelmManagerAuthFailed:             "user auth failed",
 	ErrHelmManagerRequestComponentFailed: "request third party failed",
+	ErrHelmManagerPreviewActionFailed:    "preview action failed",
 }
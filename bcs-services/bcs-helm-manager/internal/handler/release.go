@@ -37,6 +37,24 @@ func (hm *HelmManager) GetReleaseDetail(ctx context.Context,
 	return action.Handle(ctx, req, resp)
 }
 
+// ListReleaseHistory provide the actions to do list release history
+func (hm *HelmManager) ListReleaseHistory(ctx context.Context,
+	req *helmmanager.ListReleaseHistoryReq, resp *helmmanager.ListReleaseHistoryResp) error {
+
+	defer recorder(ctx, "ListReleaseHistory", req, resp)()
+	action := actionRelease.NewListReleaseHistoryAction(hm.model)
+	return action.Handle(ctx, req, resp)
+}
+
+// GetReleaseRevision provide the actions to do get release revision
+func (hm *HelmManager) GetReleaseRevision(ctx context.Context,
+	req *helmmanager.GetReleaseRevisionReq, resp *helmmanager.GetReleaseRevisionResp) error {
+
+	defer recorder(ctx, "GetReleaseRevision", req, resp)()
+	action := actionRelease.NewGetReleaseRevisionAction(hm.model)
+	return action.Handle(ctx, req, resp)
+}
+
 // InstallRelease provide the actions to do install release
 func (hm *HelmManager) InstallRelease(ctx context.Context,
 	req *helmmanager.InstallReleaseReq, resp *helmmanager.InstallReleaseResp) error {
@@ -72,3 +90,21 @@ func (hm *HelmManager) RollbackRelease(ctx context.Context,
 	action := actionRelease.NewRollbackReleaseAction(hm.model, hm.platform, hm.releaseHandler)
 	return action.Handle(ctx, req, resp)
 }
+
+// PreviewInstallRelease provide the actions to do preview install release
+func (hm *HelmManager) PreviewInstallRelease(ctx context.Context,
+	req *helmmanager.PreviewInstallReleaseReq, resp *helmmanager.PreviewInstallReleaseResp) error {
+
+	defer recorder(ctx, "PreviewInstallRelease", req, resp)()
+	action := actionRelease.NewPreviewInstallReleaseAction(hm.model, hm.platform, hm.releaseHandler)
+	return action.Handle(ctx, req, resp)
+}
+
+// PreviewUpgradeRelease provide the actions to do preview upgrade release
+func (hm *HelmManager) PreviewUpgradeRelease(ctx context.Context,
+	req *helmmanager.PreviewUpgradeReleaseReq, resp *helmmanager.PreviewUpgradeReleaseResp) error {
+
+	defer recorder(ctx, "PreviewUpgradeRelease", req, resp)()
+	action := actionRelease.NewPreviewUpgradeReleaseAction(hm.model, hm.platform, hm.releaseHandler)
+	return action.Handle(ctx, req, resp)
+}
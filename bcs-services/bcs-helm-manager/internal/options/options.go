@@ -89,6 +89,8 @@ type ReleaseConfig struct {
 	PatchDir           string `json:"patchdir"`
 	VarDir             string `json:"vardir"`
 	Encrypted          bool   `json:"encrypted"`
+	// DriftCheckInterval release偏离检查的间隔, 单位为秒, 为0时不检查
+	DriftCheckInterval uint `json:"driftcheckinterval"`
 }
 
 // JWTConfig option for jwt config
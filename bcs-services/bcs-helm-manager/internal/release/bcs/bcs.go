@@ -93,3 +93,20 @@ func (c *cluster) Upgrade(ctx context.Context, conf release.HelmUpgradeConfig) (
 func (c *cluster) Rollback(ctx context.Context, conf release.HelmRollbackConfig) (*release.HelmRollbackResult, error) {
 	return c.rollback(ctx, conf)
 }
+
+// PreviewInstall release
+func (c *cluster) PreviewInstall(ctx context.Context, conf release.HelmInstallConfig) (
+	*release.HelmPreviewResult, error) {
+	return c.previewInstall(ctx, conf)
+}
+
+// PreviewUpgrade release
+func (c *cluster) PreviewUpgrade(ctx context.Context, conf release.HelmUpgradeConfig) (
+	*release.HelmPreviewResult, error) {
+	return c.previewUpgrade(ctx, conf)
+}
+
+// CheckDrift release
+func (c *cluster) CheckDrift(ctx context.Context, conf release.HelmDriftConfig) (*release.HelmDriftResult, error) {
+	return c.checkDrift(ctx, conf)
+}
@@ -0,0 +1,476 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under,
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/release"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/pmezard/go-difflib/difflib"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/kubernetes/scheme"
+	sigsyaml "sigs.k8s.io/yaml"
+)
+
+const (
+	helmReleaseNameAnnotation      = "meta.helm.sh/release-name"
+	helmReleaseNamespaceAnnotation = "meta.helm.sh/release-namespace"
+	helmManagedByLabel             = "app.kubernetes.io/managed-by"
+	helmManagedByValue             = "Helm"
+	helmResourcePolicyAnnotation   = "helm.sh/resource-policy"
+	helmResourcePolicyKeep         = "keep"
+)
+
+// immutableFields 各类资源中创建后不允许修改的字段, 修改这些字段需要删除资源后重建
+var immutableFields = map[string][][]string{
+	"Deployment":  {{"spec", "selector"}},
+	"ReplicaSet":  {{"spec", "selector"}},
+	"DaemonSet":   {{"spec", "selector"}},
+	"StatefulSet": {{"spec", "selector"}, {"spec", "serviceName"}, {"spec", "volumeClaimTemplates"}, {"spec", "podManagementPolicy"}},
+	"Job":         {{"spec", "selector"}, {"spec", "template"}},
+	"Service":     {{"spec", "clusterIP"}},
+	"PersistentVolumeClaim": {{"spec", "accessModes"}, {"spec", "storageClassName"}, {"spec", "volumeName"},
+		{"spec", "volumeMode"}, {"spec", "selector"}},
+}
+
+// ignoredMetadataFields 对比及展示时忽略的由集群维护的metadata字段
+var ignoredMetadataFields = []string{
+	"managedFields", "resourceVersion", "uid", "generation", "creationTimestamp", "selfLink",
+}
+
+// ignoredAnnotations 对比及展示时忽略的由集群维护的annotations
+var ignoredAnnotations = []string{
+	"kubectl.kubernetes.io/last-applied-configuration",
+	"deployment.kubernetes.io/revision",
+}
+
+// resourceKey 标识release中的一个资源, 不包含version, 因此apiVersion的变化不影响资源的对应关系
+type resourceKey struct {
+	group     string
+	kind      string
+	namespace string
+	name      string
+}
+
+func (k resourceKey) String() string {
+	return k.group + "/" + k.kind + "/" + k.namespace + "/" + k.name
+}
+
+// manifestResources 定义了从manifest中解析出来的资源, keys保留资源在manifest中出现的顺序
+type manifestResources struct {
+	keys    []resourceKey
+	objects map[resourceKey]*unstructured.Unstructured
+}
+
+// parseManifest 解析manifest中的资源, 未指定namespace的资源使用release所在的namespace
+func parseManifest(manifest, namespace string) (*manifestResources, error) {
+	r := &manifestResources{objects: make(map[resourceKey]*unstructured.Unstructured)}
+	decoder := yaml.NewYAMLOrJSONDecoder(strings.NewReader(manifest), 4096)
+	for {
+		obj := make(map[string]interface{})
+		if err := decoder.Decode(&obj); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+		if len(obj) == 0 {
+			continue
+		}
+
+		u := &unstructured.Unstructured{Object: obj}
+		ns := u.GetNamespace()
+		if ns == "" {
+			ns = namespace
+		}
+		key := resourceKey{
+			group:     u.GroupVersionKind().Group,
+			kind:      u.GetKind(),
+			namespace: ns,
+			name:      u.GetName(),
+		}
+		if _, ok := r.objects[key]; !ok {
+			r.keys = append(r.keys, key)
+		}
+		r.objects[key] = u
+	}
+
+	return r, nil
+}
+
+// diffResources 计算release中各资源在执行install/upgrade后的变更
+// deployed为当前部署的manifest中的资源, rendered为新渲染的manifest中的资源, lives为资源在集群中的对象
+// 与helm一致, 已部署的资源以deployed为original, rendered为target, 计算三方合并的结果, 合并结果与集群中的对象对比即为实际的变更
+// 返回的资源内容及diff中, Secret的数据由redactor替换为哈希值
+func diffResources(releaseName, releaseNamespace string, deployed, rendered *manifestResources,
+	lives map[resourceKey]*unstructured.Unstructured, redactor *secretRedactor) []*release.ResourceDiff {
+
+	keys := append([]resourceKey{}, rendered.keys...)
+	for _, key := range deployed.keys {
+		if _, ok := rendered.objects[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+
+	result := make([]*release.ResourceDiff, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, diffResource(releaseName, releaseNamespace,
+			key, deployed.objects[key], rendered.objects[key], lives[key], redactor))
+	}
+	return result
+}
+
+func diffResource(releaseName, releaseNamespace string, key resourceKey,
+	deployed, rendered, live *unstructured.Unstructured, redactor *secretRedactor) *release.ResourceDiff {
+
+	d := computeResourceDiff(releaseName, releaseNamespace, key, deployed, rendered, live, redactor)
+
+	// 集群中不属于当前release的资源, 不返回其内容, 避免通过预览读取release之外的资源
+	if live != nil && !isOwnedByRelease(live, releaseName, releaseNamespace) {
+		d.Live = ""
+		d.Diff = ""
+		if d.Reason == "" {
+			d.Reason = fmt.Sprintf("resource in cluster is not managed by release %s/%s, its content is hidden",
+				releaseNamespace, releaseName)
+		}
+	}
+	return d
+}
+
+func computeResourceDiff(releaseName, releaseNamespace string, key resourceKey,
+	deployed, rendered, live *unstructured.Unstructured, redactor *secretRedactor) *release.ResourceDiff {
+
+	d := &release.ResourceDiff{
+		Kind:      key.kind,
+		Name:      key.name,
+		Namespace: key.namespace,
+		Deployed:  redactor.toYaml(deployed),
+		Rendered:  redactor.toYaml(rendered),
+	}
+	if rendered != nil {
+		d.APIVersion = rendered.GetAPIVersion()
+	} else {
+		d.APIVersion = deployed.GetAPIVersion()
+	}
+
+	var liveYaml string
+	if live != nil {
+		// 集群范围的资源没有namespace, 以集群中的对象为准
+		d.Namespace = live.GetNamespace()
+		live = cleanObject(live)
+		liveYaml = redactor.toYaml(live)
+		d.Live = liveYaml
+		d.Drifted = deployed != nil && !containsObject(normalizeObject(deployed), normalizeObject(live))
+	}
+
+	switch {
+	// 不存在于新渲染的manifest中的资源, 将会被删除
+	case rendered == nil:
+		if live == nil {
+			d.Action = release.ResourceActionUnchanged
+			return d
+		}
+		if deployed.GetAnnotations()[helmResourcePolicyAnnotation] == helmResourcePolicyKeep {
+			d.Action = release.ResourceActionKeep
+			d.Reason = fmt.Sprintf("resource has annotation %s: %s, it will be kept in cluster",
+				helmResourcePolicyAnnotation, helmResourcePolicyKeep)
+			return d
+		}
+		d.Action = release.ResourceActionDelete
+		d.Reason = "resource is not in the rendered manifest any more"
+		d.Diff = unifiedDiff(liveYaml, "")
+		return d
+
+	case live == nil:
+		d.Action = release.ResourceActionCreate
+		d.Diff = unifiedDiff("", d.Rendered)
+		return d
+
+	// 集群中已经存在的资源, 只有属于当前release时helm才会接管, 否则执行时将会失败
+	case deployed == nil && !isOwnedByRelease(live, releaseName, releaseNamespace):
+		d.Action = release.ResourceActionConflict
+		d.Reason = fmt.Sprintf("resource already exists in cluster and is not managed by release %s/%s",
+			releaseNamespace, releaseName)
+		return d
+	}
+
+	// 已经被helm接管的资源, helm以自身作为original
+	original := deployed
+	if original == nil {
+		original = rendered
+	}
+	merged, err := mergeObject(original, rendered, live)
+	if err != nil {
+		d.Action = release.ResourceActionUpdate
+		d.Reason = fmt.Sprintf("compute three-way merge failed, %s", err.Error())
+		return d
+	}
+	merged = cleanObject(merged)
+	d.Diff = unifiedDiff(liveYaml, redactor.toYaml(merged))
+
+	if fields := changedImmutableFields(live, merged); len(fields) > 0 {
+		d.Action = release.ResourceActionRecreate
+		d.Reason = fmt.Sprintf("immutable fields %s changed, resource must be deleted and recreated",
+			strings.Join(fields, ", "))
+		return d
+	}
+	if d.Diff == "" {
+		d.Action = release.ResourceActionUnchanged
+		return d
+	}
+	d.Action = release.ResourceActionUpdate
+	return d
+}
+
+// driftedResources 返回集群中不存在或者已经偏离manifest的资源, 格式为 kind/name
+func driftedResources(resources *manifestResources, lives map[resourceKey]*unstructured.Unstructured) []string {
+	drifted := make([]string, 0)
+	for _, key := range resources.keys {
+		live, ok := lives[key]
+		if !ok || !containsObject(normalizeObject(resources.objects[key]), normalizeObject(cleanObject(live))) {
+			drifted = append(drifted, key.kind+"/"+key.name)
+		}
+	}
+	return drifted
+}
+
+// mergeObject 以helm upgrade的方式计算资源的三方合并结果
+// 内置资源使用strategic merge patch, 其他资源(CRD等)使用json merge patch
+func mergeObject(original, target, live *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	originalData, err := json.Marshal(original.Object)
+	if err != nil {
+		return nil, err
+	}
+	targetData, err := json.Marshal(target.Object)
+	if err != nil {
+		return nil, err
+	}
+	liveData, err := json.Marshal(live.Object)
+	if err != nil {
+		return nil, err
+	}
+
+	var mergedData []byte
+	versionedObject, err := scheme.Scheme.New(target.GroupVersionKind())
+	if err != nil {
+		patch, pErr := jsonpatch.CreateMergePatch(originalData, targetData)
+		if pErr != nil {
+			return nil, pErr
+		}
+		if mergedData, err = jsonpatch.MergePatch(liveData, patch); err != nil {
+			return nil, err
+		}
+	} else {
+		patchMeta, pErr := strategicpatch.NewPatchMetaFromStruct(versionedObject)
+		if pErr != nil {
+			return nil, pErr
+		}
+		patch, pErr := strategicpatch.CreateThreeWayMergePatch(originalData, targetData, liveData, patchMeta, true)
+		if pErr != nil {
+			return nil, pErr
+		}
+		if mergedData, err = strategicpatch.StrategicMergePatch(liveData, patch, versionedObject); err != nil {
+			return nil, err
+		}
+	}
+
+	merged := make(map[string]interface{})
+	if err = json.Unmarshal(mergedData, &merged); err != nil {
+		return nil, err
+	}
+	return &unstructured.Unstructured{Object: merged}, nil
+}
+
+// changedImmutableFields 返回live与merged之间发生变化的不可变字段
+func changedImmutableFields(live, merged *unstructured.Unstructured) []string {
+	fields := make([]string, 0)
+	for _, path := range immutableFields[live.GetKind()] {
+		if fieldChanged(live, merged, path) {
+			fields = append(fields, strings.Join(path, "."))
+		}
+	}
+
+	// 设置了immutable的ConfigMap和Secret, 数据不允许修改
+	if kind := live.GetKind(); kind == "ConfigMap" || kind == "Secret" {
+		if immutable, _, _ := unstructured.NestedBool(live.Object, "immutable"); immutable {
+			for _, field := range []string{"data", "binaryData", "stringData"} {
+				if fieldChanged(live, merged, []string{field}) {
+					fields = append(fields, field)
+				}
+			}
+		}
+	}
+	return fields
+}
+
+func fieldChanged(a, b *unstructured.Unstructured, path []string) bool {
+	av, _, _ := unstructured.NestedFieldNoCopy(a.Object, path...)
+	bv, _, _ := unstructured.NestedFieldNoCopy(b.Object, path...)
+	return !reflect.DeepEqual(normalizeValue(av), normalizeValue(bv))
+}
+
+// isOwnedByRelease 判断集群中的资源是否属于指定的release, 与helm接管资源的规则一致
+func isOwnedByRelease(obj *unstructured.Unstructured, releaseName, releaseNamespace string) bool {
+	annotations := obj.GetAnnotations()
+	return obj.GetLabels()[helmManagedByLabel] == helmManagedByValue &&
+		annotations[helmReleaseNameAnnotation] == releaseName &&
+		annotations[helmReleaseNamespaceAnnotation] == releaseNamespace
+}
+
+// cleanObject 去除由集群维护的字段, 返回新的对象
+func cleanObject(obj *unstructured.Unstructured) *unstructured.Unstructured {
+	r := obj.DeepCopy()
+	unstructured.RemoveNestedField(r.Object, "status")
+	for _, field := range ignoredMetadataFields {
+		unstructured.RemoveNestedField(r.Object, "metadata", field)
+	}
+
+	annotations := r.GetAnnotations()
+	for _, key := range ignoredAnnotations {
+		delete(annotations, key)
+	}
+	if len(annotations) == 0 {
+		unstructured.RemoveNestedField(r.Object, "metadata", "annotations")
+	} else {
+		r.SetAnnotations(annotations)
+	}
+	return r
+}
+
+// containsObject 判断actual中是否包含了expected中定义的所有字段
+// 集群中的对象会带有默认值等额外字段, 因此只对比expected中定义的部分
+func containsObject(expected, actual interface{}) bool {
+	switch e := expected.(type) {
+	case map[string]interface{}:
+		a, _ := actual.(map[string]interface{})
+		for k, v := range e {
+			av, ok := a[k]
+			if !ok {
+				if isEmptyValue(v) {
+					continue
+				}
+				return false
+			}
+			if !containsObject(v, av) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		a, _ := actual.([]interface{})
+		if len(e) != len(a) {
+			return false
+		}
+		for i := range e {
+			if !containsObject(e[i], a[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		if isEmptyValue(expected) && isEmptyValue(actual) {
+			return true
+		}
+		return reflect.DeepEqual(expected, actual) || quantityEqual(expected, actual)
+	}
+}
+
+// quantityEqual 判断两个值是否为相等的资源数量, 集群会将资源数量转换为规范格式, 如 1024Mi 会被保存为 1Gi
+func quantityEqual(expected, actual interface{}) bool {
+	e, ok := expected.(string)
+	if !ok {
+		return false
+	}
+	a, ok := actual.(string)
+	if !ok {
+		return false
+	}
+	eq, err := resource.ParseQuantity(e)
+	if err != nil {
+		return false
+	}
+	aq, err := resource.ParseQuantity(a)
+	if err != nil {
+		return false
+	}
+	return eq.Cmp(aq) == 0
+}
+
+func isEmptyValue(v interface{}) bool {
+	switch t := v.(type) {
+	case nil:
+		return true
+	case string:
+		return t == ""
+	case map[string]interface{}:
+		return len(t) == 0
+	case []interface{}:
+		return len(t) == 0
+	}
+	return false
+}
+
+// normalizeObject 将对象统一转换为json的数据类型, 避免int64与float64等类型差异影响对比
+func normalizeObject(obj *unstructured.Unstructured) interface{} {
+	return normalizeValue(obj.Object)
+}
+
+func normalizeValue(v interface{}) interface{} {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return v
+	}
+	var r interface{}
+	if err = json.Unmarshal(data, &r); err != nil {
+		return v
+	}
+	return r
+}
+
+func toYaml(obj *unstructured.Unstructured) string {
+	if obj == nil {
+		return ""
+	}
+	data, err := sigsyaml.Marshal(obj.Object)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// unifiedDiff 返回from到to的unified diff, 没有差异时返回空
+func unifiedDiff(from, to string) string {
+	if from == to {
+		return ""
+	}
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(from),
+		B:        difflib.SplitLines(to),
+		FromFile: "live",
+		ToFile:   "target",
+		Context:  3,
+	})
+	if err != nil {
+		return ""
+	}
+	return diff
+}
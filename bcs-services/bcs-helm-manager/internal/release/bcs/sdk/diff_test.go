@@ -0,0 +1,516 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under,
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdk
+
+import (
+	"testing"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/release"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	sigsyaml "sigs.k8s.io/yaml"
+)
+
+const (
+	testDeployedManifest = `---
+# Source: demo/templates/configmap.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: demo-config
+data:
+  key: v1
+---
+# Source: demo/templates/deployment.yaml
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: demo
+spec:
+  replicas: 1
+  selector:
+    matchLabels:
+      app: demo
+  template:
+    metadata:
+      labels:
+        app: demo
+    spec:
+      containers:
+      - name: demo
+        image: demo:v1
+---
+# Source: demo/templates/secret.yaml
+apiVersion: v1
+kind: Secret
+metadata:
+  name: demo-secret
+  annotations:
+    helm.sh/resource-policy: keep
+---
+# Source: demo/templates/service.yaml
+apiVersion: v1
+kind: Service
+metadata:
+  name: demo-old
+spec:
+  ports:
+  - port: 80
+`
+
+	testRenderedManifest = `---
+# Source: demo/templates/configmap.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: demo-config
+data:
+  key: v1
+---
+# Source: demo/templates/deployment.yaml
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: demo
+spec:
+  replicas: 1
+  selector:
+    matchLabels:
+      app: demo
+  template:
+    metadata:
+      labels:
+        app: demo
+    spec:
+      containers:
+      - name: demo
+        image: demo:v2
+---
+# Source: demo/templates/job.yaml
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: demo-job
+spec:
+  template:
+    spec:
+      containers:
+      - name: job
+        image: job:v1
+      restartPolicy: Never
+---
+# Source: demo/templates/pvc.yaml
+apiVersion: v1
+kind: PersistentVolumeClaim
+metadata:
+  name: demo-data
+spec:
+  accessModes:
+  - ReadWriteOnce
+  resources:
+    requests:
+      storage: 1Gi
+`
+)
+
+func testLiveObject(t *testing.T, data string) *unstructured.Unstructured {
+	obj := make(map[string]interface{})
+	if err := sigsyaml.Unmarshal([]byte(data), &obj); err != nil {
+		t.Fatal(err)
+	}
+	return &unstructured.Unstructured{Object: obj}
+}
+
+// testOwnedObject 属于release default/demo的集群中的对象
+func testOwnedObject(t *testing.T, data string) *unstructured.Unstructured {
+	obj := testLiveObject(t, data)
+	labels := obj.GetLabels()
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	labels[helmManagedByLabel] = helmManagedByValue
+	obj.SetLabels(labels)
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[helmReleaseNameAnnotation] = "demo"
+	annotations[helmReleaseNamespaceAnnotation] = "default"
+	obj.SetAnnotations(annotations)
+	return obj
+}
+
+func testRedactor(t *testing.T) *secretRedactor {
+	redactor, err := newSecretRedactor()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return redactor
+}
+
+func TestParseManifest(t *testing.T) {
+	r, err := parseManifest(testDeployedManifest, "default")
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(r.keys))
+	assert.Equal(t, resourceKey{group: "apps", kind: "Deployment", namespace: "default", name: "demo"}, r.keys[1])
+
+	r, err = parseManifest("", "default")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(r.keys))
+}
+
+func TestDiffResources(t *testing.T) {
+	deployed, err := parseManifest(testDeployedManifest, "default")
+	assert.Nil(t, err)
+	rendered, err := parseManifest(testRenderedManifest, "default")
+	assert.Nil(t, err)
+
+	lives := map[resourceKey]*unstructured.Unstructured{
+		// 集群中的ConfigMap被手动修改过, upgrade时会被恢复为manifest中的值
+		{kind: "ConfigMap", namespace: "default", name: "demo-config"}: testOwnedObject(t, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: demo-config
+  namespace: default
+  resourceVersion: "100"
+data:
+  key: changed
+`),
+		{group: "apps", kind: "Deployment", namespace: "default", name: "demo"}: testOwnedObject(t, `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: demo
+  namespace: default
+  generation: 3
+spec:
+  replicas: 1
+  selector:
+    matchLabels:
+      app: demo
+  template:
+    metadata:
+      labels:
+        app: demo
+    spec:
+      containers:
+      - name: demo
+        image: demo:v1
+        imagePullPolicy: IfNotPresent
+status:
+  replicas: 1
+`),
+		{kind: "Secret", namespace: "default", name: "demo-secret"}: testOwnedObject(t, `
+apiVersion: v1
+kind: Secret
+metadata:
+  name: demo-secret
+  namespace: default
+  annotations:
+    helm.sh/resource-policy: keep
+`),
+		{kind: "Service", namespace: "default", name: "demo-old"}: testOwnedObject(t, `
+apiVersion: v1
+kind: Service
+metadata:
+  name: demo-old
+  namespace: default
+spec:
+  clusterIP: 10.0.0.1
+  ports:
+  - port: 80
+    protocol: TCP
+`),
+		// 集群中已经存在且不属于当前release的资源
+		{group: "batch", kind: "Job", namespace: "default", name: "demo-job"}: testLiveObject(t, `
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: demo-job
+  namespace: default
+`),
+	}
+
+	result := diffResources("demo", "default", deployed, rendered, lives, testRedactor(t))
+	actions := make(map[string]release.ResourceAction)
+	drifted := make(map[string]bool)
+	for _, r := range result {
+		actions[r.Kind+"/"+r.Name] = r.Action
+		drifted[r.Kind+"/"+r.Name] = r.Drifted
+	}
+	assert.Equal(t, map[string]release.ResourceAction{
+		"ConfigMap/demo-config":           release.ResourceActionUpdate,
+		"Deployment/demo":                 release.ResourceActionUpdate,
+		"Job/demo-job":                    release.ResourceActionConflict,
+		"PersistentVolumeClaim/demo-data": release.ResourceActionCreate,
+		"Secret/demo-secret":              release.ResourceActionKeep,
+		"Service/demo-old":                release.ResourceActionDelete,
+	}, actions)
+	assert.True(t, drifted["ConfigMap/demo-config"])
+	assert.False(t, drifted["Deployment/demo"])
+	assert.False(t, drifted["Service/demo-old"])
+
+	for _, r := range result {
+		switch r.Kind {
+		case "Deployment":
+			assert.Contains(t, r.Diff, "-      - image: demo:v1")
+			assert.Contains(t, r.Diff, "+      - image: demo:v2")
+			assert.NotContains(t, r.Diff, "generation")
+			assert.NotContains(t, r.Diff, "status")
+		case "Job":
+			// 不属于当前release的资源不返回集群中的内容
+			assert.Empty(t, r.Live)
+			assert.Empty(t, r.Diff)
+			assert.NotEmpty(t, r.Rendered)
+		}
+	}
+}
+
+func TestDiffResourceNotOwned(t *testing.T) {
+	deployed, err := parseManifest(`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: demo-config
+data:
+  key: v1
+`, "default")
+	assert.Nil(t, err)
+
+	// 集群中的对象已经被其他release接管
+	live := testLiveObject(t, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: demo-config
+  namespace: default
+  labels:
+    app.kubernetes.io/managed-by: Helm
+  annotations:
+    meta.helm.sh/release-name: other
+    meta.helm.sh/release-namespace: default
+data:
+  key: other
+`)
+	key := resourceKey{kind: "ConfigMap", namespace: "default", name: "demo-config"}
+	result := diffResources("demo", "default", deployed, deployed,
+		map[resourceKey]*unstructured.Unstructured{key: live}, testRedactor(t))
+	assert.Equal(t, 1, len(result))
+	assert.Equal(t, release.ResourceActionUpdate, result[0].Action)
+	assert.True(t, result[0].Drifted)
+	assert.Empty(t, result[0].Live)
+	assert.Empty(t, result[0].Diff)
+	assert.Contains(t, result[0].Reason, "not managed by release default/demo")
+}
+
+func TestDiffResourceSecret(t *testing.T) {
+	deployed, err := parseManifest(`
+apiVersion: v1
+kind: Secret
+metadata:
+  name: demo-secret
+data:
+  password: cGFzc3dvcmQtdjE=
+  token: dG9rZW4=
+`, "default")
+	assert.Nil(t, err)
+	rendered, err := parseManifest(`
+apiVersion: v1
+kind: Secret
+metadata:
+  name: demo-secret
+stringData:
+  password: password-v2
+data:
+  token: dG9rZW4=
+`, "default")
+	assert.Nil(t, err)
+
+	key := resourceKey{kind: "Secret", namespace: "default", name: "demo-secret"}
+	result := diffResources("demo", "default", deployed, rendered, map[resourceKey]*unstructured.Unstructured{
+		key: testOwnedObject(t, `
+apiVersion: v1
+kind: Secret
+metadata:
+  name: demo-secret
+  namespace: default
+data:
+  password: cGFzc3dvcmQtdjE=
+  token: dG9rZW4=
+`),
+	}, testRedactor(t))
+	assert.Equal(t, 1, len(result))
+	d := result[0]
+	assert.Equal(t, release.ResourceActionUpdate, d.Action)
+	for _, content := range []string{d.Deployed, d.Rendered, d.Live, d.Diff} {
+		assert.Contains(t, content, redactPrefix)
+		for _, secret := range []string{"cGFzc3dvcmQtdjE=", "password-v1", "password-v2", "dG9rZW4="} {
+			assert.NotContains(t, content, secret)
+		}
+	}
+	// 相同的数据哈希值相同, 未变化的token不出现在diff的变更行中
+	assert.Contains(t, d.Diff, "+stringData:")
+	assert.NotContains(t, d.Diff, "-  token:")
+	assert.NotContains(t, d.Diff, "+  token:")
+}
+
+func TestRedactManifest(t *testing.T) {
+	redactor := testRedactor(t)
+	manifest := redactor.redactManifest(`---
+# Source: demo/templates/configmap.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: demo-config
+data:
+  key: v1
+---
+# Source: demo/templates/secret.yaml
+apiVersion: v1
+kind: Secret
+metadata:
+  name: demo-secret
+data:
+  password: cGFzc3dvcmQ=
+stringData:
+  token: token
+`)
+	assert.Contains(t, manifest, "# Source: demo/templates/configmap.yaml\napiVersion: v1\nkind: ConfigMap")
+	assert.Contains(t, manifest, "  key: v1\n---\n# Source: demo/templates/secret.yaml\n")
+	assert.NotContains(t, manifest, "cGFzc3dvcmQ=")
+	assert.NotContains(t, manifest, "token: token")
+	assert.Contains(t, manifest, "password: "+redactPrefix)
+
+	r, err := parseManifest(manifest, "default")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(r.keys))
+}
+
+func TestDiffResourceRecreate(t *testing.T) {
+	deployed, err := parseManifest(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: demo
+spec:
+  selector:
+    matchLabels:
+      app: demo
+`, "default")
+	assert.Nil(t, err)
+	rendered, err := parseManifest(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: demo
+spec:
+  selector:
+    matchLabels:
+      app: demo-v2
+`, "default")
+	assert.Nil(t, err)
+
+	key := resourceKey{group: "apps", kind: "Deployment", namespace: "default", name: "demo"}
+	result := diffResources("demo", "default", deployed, rendered, map[resourceKey]*unstructured.Unstructured{
+		key: testOwnedObject(t, `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: demo
+  namespace: default
+spec:
+  selector:
+    matchLabels:
+      app: demo
+`),
+	}, testRedactor(t))
+	assert.Equal(t, 1, len(result))
+	assert.Equal(t, release.ResourceActionRecreate, result[0].Action)
+	assert.Contains(t, result[0].Reason, "spec.selector")
+}
+
+func TestContainsObject(t *testing.T) {
+	expected := map[string]interface{}{
+		"a": "1",
+		"b": map[string]interface{}{"c": []interface{}{"x"}},
+		"d": nil,
+	}
+	assert.True(t, containsObject(expected, map[string]interface{}{
+		"a": "1",
+		"b": map[string]interface{}{"c": []interface{}{"x"}, "e": "f"},
+	}))
+	assert.False(t, containsObject(expected, map[string]interface{}{
+		"a": "2",
+		"b": map[string]interface{}{"c": []interface{}{"x"}},
+	}))
+	assert.False(t, containsObject(expected, map[string]interface{}{
+		"a": "1",
+		"b": map[string]interface{}{"c": []interface{}{"x", "y"}},
+	}))
+}
+
+func TestDriftedResources(t *testing.T) {
+	resources, err := parseManifest(testRenderedManifest, "default")
+	assert.Nil(t, err)
+
+	lives := map[resourceKey]*unstructured.Unstructured{
+		{kind: "ConfigMap", namespace: "default", name: "demo-config"}: testLiveObject(t, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: demo-config
+  namespace: default
+data:
+  key: v1
+`),
+		{group: "apps", kind: "Deployment", namespace: "default", name: "demo"}: testLiveObject(t, `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: demo
+  namespace: default
+spec:
+  replicas: 3
+  selector:
+    matchLabels:
+      app: demo
+  template:
+    metadata:
+      labels:
+        app: demo
+    spec:
+      containers:
+      - name: demo
+        image: demo:v2
+`),
+		{kind: "PersistentVolumeClaim", namespace: "default", name: "demo-data"}: testLiveObject(t, `
+apiVersion: v1
+kind: PersistentVolumeClaim
+metadata:
+  name: demo-data
+  namespace: default
+spec:
+  accessModes:
+  - ReadWriteOnce
+  resources:
+    requests:
+      storage: 1024Mi
+  volumeMode: Filesystem
+`),
+	}
+
+	// Deployment的副本数被修改, Job在集群中不存在
+	assert.Equal(t, []string{"Deployment/demo", "Job/demo-job"}, driftedResources(resources, lives))
+}
@@ -0,0 +1,49 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under,
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdk
+
+import (
+	"context"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/release"
+
+	"helm.sh/helm/v3/pkg/action"
+)
+
+// CheckDrift 对比最近一次部署的manifest与集群中的资源对象, 返回集群中不存在或者已经偏离manifest的资源
+func (c *client) CheckDrift(_ context.Context, config release.HelmDriftConfig) (*release.HelmDriftResult, error) {
+	conf := new(action.Configuration)
+	if err := conf.Init(c.getConfigFlag(config.Namespace), config.Namespace, "", blog.Infof); err != nil {
+		blog.Errorf("sdk client check drift and init configuration failed, %s, "+
+			"namespace %s, name %s", err.Error(), config.Namespace, config.Name)
+		return nil, err
+	}
+
+	resources, err := parseManifest(config.Manifest, config.Namespace)
+	if err != nil {
+		blog.Errorf("sdk client check drift and parse manifest failed, %s, "+
+			"namespace %s, name %s", err.Error(), config.Namespace, config.Name)
+		return nil, err
+	}
+
+	// 获取失败的资源会被误判为不存在, 因此不能忽略错误
+	lives, err := getLiveObjects(conf.KubeClient, resources.objects)
+	if err != nil {
+		blog.Errorf("sdk client check drift and get live objects failed, %s, "+
+			"namespace %s, name %s", err.Error(), config.Namespace, config.Name)
+		return nil, err
+	}
+
+	return &release.HelmDriftResult{DriftedResources: driftedResources(resources, lives)}, nil
+}
@@ -0,0 +1,170 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under,
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/release"
+
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/kube"
+	rspb "helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// PreviewInstall 以dry-run的方式渲染release, 并对比集群中已经存在的资源, 不会对集群做任何修改
+func (c *client) PreviewInstall(_ context.Context, config release.HelmInstallConfig) (
+	*release.HelmPreviewResult, error) {
+
+	blog.Infof("sdk client try preview install release name %s, namespace %s", config.Name, config.Namespace)
+
+	config.DryRun = true
+	conf, r, err := c.install(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.preview(conf, config.Namespace, nil, r)
+}
+
+// PreviewUpgrade 以dry-run的方式渲染release, 并基于当前部署的版本计算各资源的三方合并结果,
+// 不会对集群做任何修改
+func (c *client) PreviewUpgrade(_ context.Context, config release.HelmUpgradeConfig) (
+	*release.HelmPreviewResult, error) {
+
+	blog.Infof("sdk client try preview upgrade release name %s, namespace %s", config.Name, config.Namespace)
+
+	config.DryRun = true
+	conf, r, err := c.upgrade(config)
+	if err != nil {
+		return nil, err
+	}
+
+	// 与helm upgrade一致, 以最后一个deployed的版本作为对比基准, 不存在时使用最后一个版本
+	current, err := conf.Releases.Deployed(config.Name)
+	if err != nil {
+		if !errors.Is(err, driver.ErrNoDeployedReleases) {
+			blog.Errorf("sdk client preview upgrade and get deployed release failed, %s, "+
+				"namespace %s, name %s", err.Error(), config.Namespace, config.Name)
+			return nil, err
+		}
+		if current, err = conf.Releases.Last(config.Name); err != nil {
+			blog.Errorf("sdk client preview upgrade and get last release failed, %s, "+
+				"namespace %s, name %s", err.Error(), config.Namespace, config.Name)
+			return nil, err
+		}
+	}
+
+	return c.preview(conf, config.Namespace, current, r)
+}
+
+// preview 对比current与target两个版本的manifest以及集群中的资源, current为空时表示首次安装
+func (c *client) preview(conf *action.Configuration, namespace string, current, target *rspb.Release) (
+	*release.HelmPreviewResult, error) {
+
+	var currentManifest string
+	var currentRevision int
+	if current != nil {
+		currentManifest = current.Manifest
+		currentRevision = current.Version
+	}
+
+	deployed, err := parseManifest(currentManifest, namespace)
+	if err != nil {
+		blog.Errorf("sdk client preview and parse deployed manifest failed, %s, "+
+			"namespace %s, name %s", err.Error(), namespace, target.Name)
+		return nil, err
+	}
+	rendered, err := parseManifest(target.Manifest, namespace)
+	if err != nil {
+		blog.Errorf("sdk client preview and parse rendered manifest failed, %s, "+
+			"namespace %s, name %s", err.Error(), namespace, target.Name)
+		return nil, err
+	}
+
+	// 新渲染的资源优先, 以新的apiVersion获取集群中的对象
+	objects := make(map[resourceKey]*unstructured.Unstructured)
+	for key, obj := range deployed.objects {
+		objects[key] = obj
+	}
+	for key, obj := range rendered.objects {
+		objects[key] = obj
+	}
+	// 部分资源获取失败时, 仍然返回能够获取到的资源的diff
+	lives, err := getLiveObjects(conf.KubeClient, objects)
+	if err != nil {
+		blog.Warnf("sdk client preview and get live objects failed, %s, namespace %s, name %s",
+			err.Error(), namespace, target.Name)
+	}
+
+	redactor, err := newSecretRedactor()
+	if err != nil {
+		blog.Errorf("sdk client preview and create secret redactor failed, %s, namespace %s, name %s",
+			err.Error(), namespace, target.Name)
+		return nil, err
+	}
+	return &release.HelmPreviewResult{
+		Revision:        target.Version,
+		CurrentRevision: currentRevision,
+		Manifest:        redactor.redactManifest(target.Manifest),
+		Resources:       diffResources(target.Name, namespace, deployed, rendered, lives, redactor),
+	}, nil
+}
+
+// getLiveObjects 获取资源在集群中的对象, 资源不存在时不返回
+// 获取失败的资源同样不返回, 并返回最后一个错误
+func getLiveObjects(kubeClient kube.Interface, objects map[resourceKey]*unstructured.Unstructured) (
+	map[resourceKey]*unstructured.Unstructured, error) {
+
+	var lastErr error
+	lives := make(map[resourceKey]*unstructured.Unstructured)
+	for key, obj := range objects {
+		data, err := json.Marshal(obj.Object)
+		if err != nil {
+			lastErr = fmt.Errorf("marshal resource %s failed, %s", key.String(), err.Error())
+			continue
+		}
+
+		resources, err := kubeClient.Build(bytes.NewReader(data), false)
+		if err != nil {
+			lastErr = fmt.Errorf("build resource %s failed, %s", key.String(), err.Error())
+			continue
+		}
+
+		for _, info := range resources {
+			if err = info.Get(); err != nil {
+				if !apierrors.IsNotFound(err) {
+					lastErr = fmt.Errorf("get live resource %s failed, %s", key.String(), err.Error())
+				}
+				continue
+			}
+
+			live, err := runtime.DefaultUnstructuredConverter.ToUnstructured(info.Object)
+			if err != nil {
+				lastErr = fmt.Errorf("convert live resource %s failed, %s", key.String(), err.Error())
+				continue
+			}
+			lives[key] = &unstructured.Unstructured{Object: live}
+		}
+	}
+	return lives, lastErr
+}
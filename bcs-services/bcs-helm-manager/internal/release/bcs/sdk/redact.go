@@ -0,0 +1,129 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under,
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdk
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	sigsyaml "sigs.k8s.io/yaml"
+)
+
+const (
+	redactKeySize = 32
+	redactPrefix  = "redacted-sha256:"
+)
+
+// secretDataFields Secret中需要隐藏的数据字段
+var secretDataFields = []string{"data", "stringData"}
+
+// manifestSeparator manifest中资源之间的分隔行
+var manifestSeparator = regexp.MustCompile(`(?m)^---[ \t]*$`)
+
+// secretRedactor 将Secret中的数据替换为哈希值, 避免预览结果泄露Secret的内容
+// 哈希使用每次预览随机生成的key, 无法通过哈希值反推数据, 同一次预览中相同的数据哈希值相同, 因此仍然可以看出数据是否变化
+type secretRedactor struct {
+	key []byte
+}
+
+func newSecretRedactor() (*secretRedactor, error) {
+	key := make([]byte, redactKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	return &secretRedactor{key: key}, nil
+}
+
+// redact 返回Secret的数据被替换为哈希值的新对象, 其他资源原样返回
+func (r *secretRedactor) redact(obj *unstructured.Unstructured) *unstructured.Unstructured {
+	if obj == nil || !isSecret(obj) {
+		return obj
+	}
+
+	redacted := obj.DeepCopy()
+	for _, field := range secretDataFields {
+		data, ok := redacted.Object[field].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for k, v := range data {
+			data[k] = r.hash(field, v)
+		}
+	}
+	return redacted
+}
+
+// hash 计算数据的哈希值, data中的值为base64编码, 解码后计算, 使得data与stringData中相同的数据哈希值相同
+func (r *secretRedactor) hash(field string, v interface{}) string {
+	value := []byte(fmt.Sprint(v))
+	if field == "data" {
+		if decoded, err := base64.StdEncoding.DecodeString(string(value)); err == nil {
+			value = decoded
+		}
+	}
+
+	mac := hmac.New(sha256.New, r.key)
+	mac.Write(value)
+	return fmt.Sprintf("%s%x", redactPrefix, mac.Sum(nil)[:8])
+}
+
+// toYaml 返回隐藏了Secret数据的yaml
+func (r *secretRedactor) toYaml(obj *unstructured.Unstructured) string {
+	return toYaml(r.redact(obj))
+}
+
+// redactManifest 将manifest中Secret的数据替换为哈希值, 保留资源前的注释, 其他资源保持不变
+func (r *secretRedactor) redactManifest(manifest string) string {
+	docs := manifestSeparator.Split(manifest, -1)
+	for i, doc := range docs {
+		obj := make(map[string]interface{})
+		if err := sigsyaml.Unmarshal([]byte(doc), &obj); err != nil || len(obj) == 0 {
+			continue
+		}
+		u := &unstructured.Unstructured{Object: obj}
+		if !isSecret(u) {
+			continue
+		}
+
+		// 保留helm生成的 # Source: 等注释
+		lines := []string{""}
+		for _, line := range strings.Split(strings.TrimLeft(doc, "\n"), "\n") {
+			if !strings.HasPrefix(line, "#") {
+				break
+			}
+			lines = append(lines, line)
+		}
+		lines = append(lines, toYaml(r.redact(u)))
+		docs[i] = strings.Join(lines, "\n")
+	}
+	return strings.Join(docs, "---")
+}
+
+// RedactManifest 将release manifest中Secret的数据替换为哈希值, 用于返回manifest, 避免Secret的内容被返回或者记录到日志中
+func RedactManifest(manifest string) (string, error) {
+	redactor, err := newSecretRedactor()
+	if err != nil {
+		return "", err
+	}
+	return redactor.redactManifest(manifest), nil
+}
+
+func isSecret(obj *unstructured.Unstructured) bool {
+	return obj.GetKind() == "Secret" && obj.GroupVersionKind().Group == ""
+}
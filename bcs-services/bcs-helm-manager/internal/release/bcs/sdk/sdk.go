@@ -107,6 +107,9 @@ type Client interface {
 	Upgrade(ctx context.Context, config release.HelmUpgradeConfig) (*release.HelmUpgradeResult, error)
 	Uninstall(ctx context.Context, config release.HelmUninstallConfig) (*release.HelmUninstallResult, error)
 	Rollback(ctx context.Context, config release.HelmRollbackConfig) (*release.HelmRollbackResult, error)
+	PreviewInstall(ctx context.Context, config release.HelmInstallConfig) (*release.HelmPreviewResult, error)
+	PreviewUpgrade(ctx context.Context, config release.HelmUpgradeConfig) (*release.HelmPreviewResult, error)
+	CheckDrift(ctx context.Context, config release.HelmDriftConfig) (*release.HelmDriftResult, error)
 }
 
 type client struct {
@@ -130,10 +133,64 @@ func (c *client) List(_ context.Context, namespace string) ([]*rspb.Release, err
 func (c *client) Install(_ context.Context, config release.HelmInstallConfig) (*release.HelmInstallResult, error) {
 	blog.Infof("sdk client try install release name %s, namespace %s", config.Name, config.Namespace)
 
+	_, r, err := c.install(config)
+	if err != nil {
+		return nil, err
+	}
+
+	var status, appVersion, lastDeployed string
+	if r.Info != nil {
+		status = r.Info.Status.String()
+		lastDeployed = r.Info.LastDeployed.Local().String()
+	}
+	if r.Chart != nil && r.Chart.Metadata != nil {
+		appVersion = r.Chart.Metadata.AppVersion
+	}
+	blog.Infof("sdk client install release successfully name %s, namespace %s, revision: %d",
+		config.Name, config.Namespace, r.Version)
+	return &release.HelmInstallResult{
+		Revision:   r.Version,
+		Status:     status,
+		AppVersion: appVersion,
+		UpdateTime: lastDeployed,
+		Manifest:   r.Manifest,
+	}, nil
+}
+
+// Upgrade helm release through helm client
+func (c *client) Upgrade(_ context.Context, config release.HelmUpgradeConfig) (*release.HelmUpgradeResult, error) {
+	blog.Infof("sdk client try upgrade release name %s, namespace %s", config.Name, config.Namespace)
+
+	_, r, err := c.upgrade(config)
+	if err != nil {
+		return nil, err
+	}
+
+	var status, appVersion, lastDeployed string
+	if r.Info != nil {
+		status = r.Info.Status.String()
+		lastDeployed = r.Info.LastDeployed.Local().String()
+	}
+	if r.Chart != nil && r.Chart.Metadata != nil {
+		appVersion = r.Chart.Metadata.AppVersion
+	}
+	blog.Infof("sdk client upgrade release successfully name %s, namespace %s, revision: %d",
+		config.Name, config.Namespace, r.Version)
+	return &release.HelmUpgradeResult{
+		Revision:   r.Version,
+		Status:     status,
+		AppVersion: appVersion,
+		UpdateTime: lastDeployed,
+		Manifest:   r.Manifest,
+	}, nil
+}
+
+// install 执行helm install, 返回helm的action配置以及生成的release
+func (c *client) install(config release.HelmInstallConfig) (*action.Configuration, *rspb.Release, error) {
 	conf := new(action.Configuration)
 	if err := conf.Init(c.getConfigFlag(config.Namespace), config.Namespace, "", blog.Infof); err != nil {
 		blog.Errorf("sdk client install and init configuration failed, %s, %v", err.Error(), config)
-		return nil, err
+		return nil, nil, err
 	}
 
 	installer := action.NewInstall(conf)
@@ -143,7 +200,7 @@ func (c *client) Install(_ context.Context, config release.HelmInstallConfig) (*
 	installer.PostRenderer = newPatcher(c.group.config.PatchTemplates, config.PatchTemplateValues)
 	if err := parseArgs4Install(installer, config.Args); err != nil {
 		blog.Errorf("sdk client install and parse from args failed, %s, args: %v", err.Error(), config.Args)
-		return nil, err
+		return nil, nil, err
 	}
 
 	// chart文件数据
@@ -151,7 +208,7 @@ func (c *client) Install(_ context.Context, config release.HelmInstallConfig) (*
 	if err != nil {
 		blog.Errorf("sdk client install and load chart files failed, %s, "+
 			"namespace %s, name %s", err.Error(), config.Namespace, config.Name)
-		return nil, err
+		return nil, nil, err
 	}
 
 	// values数据, 增加Var values在最后
@@ -159,42 +216,25 @@ func (c *client) Install(_ context.Context, config release.HelmInstallConfig) (*
 	if err != nil {
 		blog.Errorf("sdk client install and get values failed, %s, "+
 			"namespace %s, name %s", err.Error(), config.Namespace, config.Name)
-		return nil, err
+		return nil, nil, err
 	}
 
 	r, err := installer.Run(chartF, values)
 	if err != nil {
 		blog.Errorf("sdk client install failed, %s, "+
 			"namespace %s, name %s", err.Error(), config.Namespace, config.Name)
-		return nil, err
+		return nil, nil, err
 	}
 
-	var status, appVersion, lastDeployed string
-	if r.Info != nil {
-		status = r.Info.Status.String()
-		lastDeployed = r.Info.LastDeployed.Local().String()
-	}
-	if r.Chart != nil && r.Chart.Metadata != nil {
-		appVersion = r.Chart.Metadata.AppVersion
-	}
-	blog.Infof("sdk client install release successfully name %s, namespace %s, revision: %d",
-		config.Name, config.Namespace, r.Version)
-	return &release.HelmInstallResult{
-		Revision:   r.Version,
-		Status:     status,
-		AppVersion: appVersion,
-		UpdateTime: lastDeployed,
-	}, nil
+	return conf, r, nil
 }
 
-// Upgrade helm release through helm client
-func (c *client) Upgrade(_ context.Context, config release.HelmUpgradeConfig) (*release.HelmUpgradeResult, error) {
-	blog.Infof("sdk client try upgrade release name %s, namespace %s", config.Name, config.Namespace)
-
+// upgrade 执行helm upgrade, 返回helm的action配置以及生成的release
+func (c *client) upgrade(config release.HelmUpgradeConfig) (*action.Configuration, *rspb.Release, error) {
 	conf := new(action.Configuration)
 	if err := conf.Init(c.getConfigFlag(config.Namespace), config.Namespace, "", blog.Infof); err != nil {
 		blog.Errorf("sdk client upgrade and init configuration failed, %s, %v", err.Error(), config)
-		return nil, err
+		return nil, nil, err
 	}
 
 	upgrader := action.NewUpgrade(conf)
@@ -203,7 +243,7 @@ func (c *client) Upgrade(_ context.Context, config release.HelmUpgradeConfig) (*
 	upgrader.PostRenderer = newPatcher(c.group.config.PatchTemplates, config.PatchTemplateValues)
 	if err := parseArgs4Upgrade(upgrader, config.Args); err != nil {
 		blog.Errorf("sdk client upgrade and parse from args failed, %s, args: %v", err.Error(), config.Args)
-		return nil, err
+		return nil, nil, err
 	}
 
 	// chart文件数据
@@ -211,7 +251,7 @@ func (c *client) Upgrade(_ context.Context, config release.HelmUpgradeConfig) (*
 	if err != nil {
 		blog.Errorf("sdk client upgrade and load chart files failed, %s, "+
 			"namespace %s, name %s", err.Error(), config.Namespace, config.Name)
-		return nil, err
+		return nil, nil, err
 	}
 
 	// values数据, 增加Var values在最后
@@ -219,32 +259,17 @@ func (c *client) Upgrade(_ context.Context, config release.HelmUpgradeConfig) (*
 	if err != nil {
 		blog.Errorf("sdk client upgrade and get values failed, %s, "+
 			"namespace %s, name %s", err.Error(), config.Namespace, config.Name)
-		return nil, err
+		return nil, nil, err
 	}
 
 	r, err := upgrader.Run(config.Name, chartF, values)
 	if err != nil {
 		blog.Errorf("sdk client upgrade failed, %s, "+
 			"namespace %s, name %s", err.Error(), config.Namespace, config.Name)
-		return nil, err
+		return nil, nil, err
 	}
 
-	var status, appVersion, lastDeployed string
-	if r.Info != nil {
-		status = r.Info.Status.String()
-		lastDeployed = r.Info.LastDeployed.Local().String()
-	}
-	if r.Chart != nil && r.Chart.Metadata != nil {
-		appVersion = r.Chart.Metadata.AppVersion
-	}
-	blog.Infof("sdk client upgrade release successfully name %s, namespace %s, revision: %d",
-		config.Name, config.Namespace, r.Version)
-	return &release.HelmUpgradeResult{
-		Revision:   r.Version,
-		Status:     status,
-		AppVersion: appVersion,
-		UpdateTime: lastDeployed,
-	}, nil
+	return conf, r, nil
 }
 
 // Uninstall helm release through helm client
@@ -288,7 +313,33 @@ func (c *client) Rollback(_ context.Context, config release.HelmRollbackConfig)
 		return nil, err
 	}
 
-	return &release.HelmRollbackResult{}, nil
+	// rollback会以目标版本的内容生成一个新的版本, 获取新版本的信息
+	r, err := conf.Releases.Last(config.Name)
+	if err != nil {
+		blog.Errorf("sdk client rollback and get current release failed, %s, "+
+			"namespace %s, name %s", err.Error(), config.Namespace, config.Name)
+		return nil, err
+	}
+
+	var status, chartName, chartVersion, appVersion, lastDeployed string
+	if r.Info != nil {
+		status = r.Info.Status.String()
+		lastDeployed = r.Info.LastDeployed.Local().String()
+	}
+	if r.Chart != nil && r.Chart.Metadata != nil {
+		chartName = r.Chart.Metadata.Name
+		chartVersion = r.Chart.Metadata.Version
+		appVersion = r.Chart.Metadata.AppVersion
+	}
+	return &release.HelmRollbackResult{
+		Revision:     r.Version,
+		Status:       status,
+		Chart:        chartName,
+		ChartVersion: chartVersion,
+		AppVersion:   appVersion,
+		UpdateTime:   lastDeployed,
+		Manifest:     r.Manifest,
+	}, nil
 }
 
 // getConfigFlag 获取helm-client配置
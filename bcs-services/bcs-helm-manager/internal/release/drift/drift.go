@@ -0,0 +1,134 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under,
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package drift
+
+import (
+	"context"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/release"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/store"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/store/entity"
+	storerelease "github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/store/release"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/store/utils"
+)
+
+const (
+	listPageSize = 100
+)
+
+// NewChecker return a new Checker instance
+func NewChecker(model store.HelmManagerModel, releaseHandler release.Handler, interval time.Duration) *Checker {
+	return &Checker{
+		model:          model,
+		releaseHandler: releaseHandler,
+		interval:       interval,
+	}
+}
+
+// Checker 定期检查通过helm manager部署的release, 集群中的资源不存在或者偏离最近一次部署的manifest时,
+// 将release最新的revision标记为drifted
+type Checker struct {
+	model          store.HelmManagerModel
+	releaseHandler release.Handler
+	interval       time.Duration
+}
+
+// Run 启动定期检查, 直到ctx结束
+func (c *Checker) Run(ctx context.Context) {
+	blog.Infof("release drift checker start, interval %s", c.interval.String())
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			blog.Infof("release drift checker stopped")
+			return
+		case <-ticker.C:
+			c.checkAll(ctx)
+		}
+	}
+}
+
+// checkAll 按revision倒序分页遍历store中的release, 每个release只检查最新的revision
+func (c *Checker) checkAll(ctx context.Context) {
+	checked := make(map[string]bool)
+	var drifted int
+	for page := int64(0); ; page++ {
+		_, releases, err := c.model.ListRelease(ctx, storerelease.InstalledCondition(nil), &utils.ListOption{
+			Sort: map[string]int{entity.FieldKeyRevision: -1},
+			Page: page,
+			Size: listPageSize,
+		})
+		if err != nil {
+			blog.Errorf("release drift checker list release failed, %s", err.Error())
+			return
+		}
+
+		for _, r := range releases {
+			key := r.ClusterID + "/" + r.Namespace + "/" + r.Name
+			if checked[key] {
+				continue
+			}
+			checked[key] = true
+
+			// 没有记录manifest的revision无法检查
+			if r.Manifest == "" {
+				continue
+			}
+			if c.check(ctx, r) {
+				drifted++
+			}
+		}
+
+		if len(releases) < listPageSize {
+			break
+		}
+	}
+	blog.Infof("release drift checker finished, %d releases checked, %d drifted", len(checked), drifted)
+}
+
+// check 检查单个release, 返回release是否偏离
+func (c *Checker) check(ctx context.Context, r *entity.Release) bool {
+	result, err := c.releaseHandler.Cluster(r.ClusterID).CheckDrift(ctx, release.HelmDriftConfig{
+		Name:      r.Name,
+		Namespace: r.Namespace,
+		Manifest:  r.Manifest,
+	})
+	if err != nil {
+		blog.Errorf("release drift checker check release failed, %s, "+
+			"clusterID: %s, namespace: %s, name: %s, revision: %d",
+			err.Error(), r.ClusterID, r.Namespace, r.Name, r.Revision)
+		return false
+	}
+
+	isDrifted := len(result.DriftedResources) > 0
+	if err = c.model.UpdateRelease(ctx, r.ClusterID, r.Namespace, r.Name, r.Revision, entity.M{
+		entity.FieldKeyDrifted:          isDrifted,
+		entity.FieldKeyDriftedResources: result.DriftedResources,
+		entity.FieldKeyDriftCheckTime:   time.Now().UTC().Unix(),
+	}); err != nil {
+		blog.Errorf("release drift checker update release failed, %s, "+
+			"clusterID: %s, namespace: %s, name: %s, revision: %d",
+			err.Error(), r.ClusterID, r.Namespace, r.Name, r.Revision)
+		return false
+	}
+
+	if isDrifted {
+		blog.Warnf("release drifted, clusterID: %s, namespace: %s, name: %s, revision: %d, resources: %v",
+			r.ClusterID, r.Namespace, r.Name, r.Revision, result.DriftedResources)
+	}
+	return isDrifted
+}
@@ -30,6 +30,9 @@ type Cluster interface {
 	Uninstall(ctx context.Context, conf HelmUninstallConfig) (*HelmUninstallResult, error)
 	Upgrade(ctx context.Context, conf HelmUpgradeConfig) (*HelmUpgradeResult, error)
 	Rollback(ctx context.Context, conf HelmRollbackConfig) (*HelmRollbackResult, error)
+	PreviewInstall(ctx context.Context, conf HelmInstallConfig) (*HelmPreviewResult, error)
+	PreviewUpgrade(ctx context.Context, conf HelmUpgradeConfig) (*HelmPreviewResult, error)
+	CheckDrift(ctx context.Context, conf HelmDriftConfig) (*HelmDriftResult, error)
 }
 
 // Release 定义了集群中的helm release信息, 一般在命令行通过 helm list 获取
@@ -114,6 +117,7 @@ type HelmInstallResult struct {
 	Status     string
 	AppVersion string
 	UpdateTime string
+	Manifest   string
 }
 
 // HelmUninstallConfig 定义了helm执行uninstall时的控制参数
@@ -151,6 +155,7 @@ type HelmUpgradeResult struct {
 	Status     string
 	AppVersion string
 	UpdateTime string
+	Manifest   string
 }
 
 // HelmRollbackConfig 定义了helm执行rollback时的控制参数
@@ -165,6 +170,111 @@ type HelmRollbackConfig struct {
 
 // HelmRollbackResult 定义了helm执行rollback时的返回结果
 type HelmRollbackResult struct {
+	Revision     int
+	Status       string
+	Chart        string
+	ChartVersion string
+	AppVersion   string
+	UpdateTime   string
+	Manifest     string
+}
+
+// HelmDriftConfig 定义了检查release是否偏离manifest时的参数
+type HelmDriftConfig struct {
+	Name      string
+	Namespace string
+	// Manifest 最近一次部署的manifest
+	Manifest string
+}
+
+// HelmDriftResult 定义了检查release是否偏离manifest的返回结果
+type HelmDriftResult struct {
+	// DriftedResources 集群中不存在或者已经偏离manifest的资源, 格式为 kind/name
+	DriftedResources []string
+}
+
+// HelmPreviewResult 定义了helm预览install/upgrade的返回结果
+type HelmPreviewResult struct {
+	// Revision 执行后将生成的版本
+	Revision int
+	// CurrentRevision 当前已部署的版本, install时为0
+	CurrentRevision int
+	// Manifest 使用新values渲染得到的manifest
+	Manifest  string
+	Resources []*ResourceDiff
+}
+
+// Transfer2Proto transfer the data into protobuf struct
+func (r *HelmPreviewResult) Transfer2Proto(name, namespace string) *helmmanager.ReleasePreview {
+	resources := make([]*helmmanager.ResourceDiff, 0, len(r.Resources))
+	for _, item := range r.Resources {
+		resources = append(resources, item.Transfer2Proto())
+	}
+
+	return &helmmanager.ReleasePreview{
+		Name:            common.GetStringP(name),
+		Namespace:       common.GetStringP(namespace),
+		Revision:        common.GetUint32P(uint32(r.Revision)),
+		CurrentRevision: common.GetUint32P(uint32(r.CurrentRevision)),
+		Manifest:        common.GetStringP(r.Manifest),
+		Resources:       resources,
+	}
+}
+
+// ResourceAction 定义了release中的资源在执行install/upgrade后的变更动作
+type ResourceAction string
+
+const (
+	// ResourceActionCreate 资源将被创建
+	ResourceActionCreate ResourceAction = "create"
+	// ResourceActionUpdate 资源将被更新
+	ResourceActionUpdate ResourceAction = "update"
+	// ResourceActionDelete 资源不再存在于新的manifest中, 将被删除
+	ResourceActionDelete ResourceAction = "delete"
+	// ResourceActionRecreate 资源的不可变字段发生了变化, 需要删除后重建
+	ResourceActionRecreate ResourceAction = "recreate"
+	// ResourceActionKeep 资源不再存在于新的manifest中, 但设置了keep策略, 将被保留
+	ResourceActionKeep ResourceAction = "keep"
+	// ResourceActionConflict 资源已经存在于集群中且不属于该release, 执行将会失败
+	ResourceActionConflict ResourceAction = "conflict"
+	// ResourceActionUnchanged 资源没有变化
+	ResourceActionUnchanged ResourceAction = "unchanged"
+)
+
+// ResourceDiff 定义了release中单个资源在deployed manifest, 新渲染的manifest以及集群中对象之间的三方diff
+type ResourceDiff struct {
+	Kind       string
+	APIVersion string
+	Name       string
+	Namespace  string
+
+	Action ResourceAction
+	Reason string
+	// Drifted 集群中的对象已经偏离了当前部署的manifest
+	Drifted bool
+
+	Deployed string
+	Rendered string
+	Live     string
+	// Diff 集群中的对象与执行后的对象之间的unified diff
+	Diff string
+}
+
+// Transfer2Proto transfer the data into protobuf struct
+func (r *ResourceDiff) Transfer2Proto() *helmmanager.ResourceDiff {
+	return &helmmanager.ResourceDiff{
+		Kind:       common.GetStringP(r.Kind),
+		ApiVersion: common.GetStringP(r.APIVersion),
+		Name:       common.GetStringP(r.Name),
+		Namespace:  common.GetStringP(r.Namespace),
+		Action:     common.GetStringP(string(r.Action)),
+		Reason:     common.GetStringP(r.Reason),
+		Drifted:    common.GetBoolP(r.Drifted),
+		Deployed:   common.GetStringP(r.Deployed),
+		Rendered:   common.GetStringP(r.Rendered),
+		Live:       common.GetStringP(r.Live),
+		Diff:       common.GetStringP(r.Diff),
+	}
 }
 
 // File 定义了release中需要的文件信息
@@ -28,6 +28,13 @@ const (
 	FieldKeyUsername       = "username"
 	FieldKeyPassword       = "password"
 
+	FieldKeyDrifted          = "drifted"
+	FieldKeyDriftedResources = "driftedResources"
+	FieldKeyDriftCheckTime   = "driftCheckTime"
+
+	FieldKeyUninstalled   = "uninstalled"
+	FieldKeyUninstallTime = "uninstallTime"
+
 	FieldKeyCreateBy   = "createBy"
 	FieldKeyUpdateBy   = "updateBy"
 	FieldKeyCreateTime = "createTime"
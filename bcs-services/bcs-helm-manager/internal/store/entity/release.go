@@ -12,6 +12,20 @@
 
 package entity
 
+import (
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/internal/common"
+	helmmanager "github.com/Tencent/bk-bcs/bcs-services/bcs-helm-manager/proto/bcs-helm-manager"
+)
+
+// 产生release revision的操作
+const (
+	ReleaseActionInstall  = "install"
+	ReleaseActionUpgrade  = "upgrade"
+	ReleaseActionRollback = "rollback"
+)
+
 // Release 定义了chart的部署信息, 存储在helm-manager的数据库中, 用于对部署版本做记录
 type Release struct {
 	Name         string   `json:"name" bson:"name"`
@@ -22,6 +36,47 @@ type Release struct {
 	Revision     int      `json:"revision" bson:"revision"`
 	Values       []string `json:"values" bson:"values"`
 	RollbackTo   int      `json:"rollbackTo" bson:"rollbackTo"`
+	Action       string   `json:"action" bson:"action"`
+	Status       string   `json:"status" bson:"status"`
+	Manifest     string   `json:"manifest" bson:"manifest"`
 	CreateBy     string   `json:"createBy" bson:"createBy"`
 	CreateTime   int64    `json:"createTime" bson:"createTime"`
+
+	// drift info, 由定时检查更新, 只对最新的revision有效
+	Drifted          bool     `json:"drifted" bson:"drifted"`
+	DriftedResources []string `json:"driftedResources" bson:"driftedResources"`
+	DriftCheckTime   int64    `json:"driftCheckTime" bson:"driftCheckTime"`
+
+	// uninstall info, release被卸载后保留所有revision的记录, 重新安装后revision从1开始,
+	// 已卸载的记录不再参与查询和更新
+	Uninstalled   bool  `json:"uninstalled" bson:"uninstalled"`
+	UninstallTime int64 `json:"uninstallTime" bson:"uninstallTime"`
+}
+
+// Transfer2Proto transfer the data into protobuf struct, manifest is returned as it is,
+// secret data in manifest should be redacted before transferring
+func (r *Release) Transfer2Proto() *helmmanager.ReleaseRevision {
+	var driftCheckTime string
+	if r.DriftCheckTime != 0 {
+		driftCheckTime = time.Unix(r.DriftCheckTime, 0).Local().String()
+	}
+	return &helmmanager.ReleaseRevision{
+		Name:             common.GetStringP(r.Name),
+		Namespace:        common.GetStringP(r.Namespace),
+		ClusterID:        common.GetStringP(r.ClusterID),
+		Revision:         common.GetUint32P(uint32(r.Revision)),
+		Action:           common.GetStringP(r.Action),
+		Status:           common.GetStringP(r.Status),
+		Chart:            common.GetStringP(r.ChartName),
+		ChartVersion:     common.GetStringP(r.ChartVersion),
+		Values:           r.Values,
+		Manifest:         common.GetStringP(r.Manifest),
+		RollbackTo:       common.GetUint32P(uint32(r.RollbackTo)),
+		Operator:         common.GetStringP(r.CreateBy),
+		CreateTime:       common.GetStringP(time.Unix(r.CreateTime, 0).Local().String()),
+		Drifted:          common.GetBoolP(r.Drifted),
+		DriftedResources: r.DriftedResources,
+		DriftCheckTime:   common.GetStringP(driftCheckTime),
+		Uninstalled:      common.GetBoolP(r.Uninstalled),
+	}
 }
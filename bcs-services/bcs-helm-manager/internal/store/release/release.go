@@ -119,7 +119,7 @@ func (m *ModelRelease) GetRelease(ctx context.Context, clusterID, namespace, nam
 		return nil, err
 	}
 
-	cond := operator.NewLeafCondition(operator.Eq, operator.M{
+	cond := InstalledCondition(operator.M{
 		entity.FieldKeyClusterID: clusterID,
 		entity.FieldKeyNamespace: namespace,
 		entity.FieldKeyName:      name,
@@ -134,6 +134,42 @@ func (m *ModelRelease) GetRelease(ctx context.Context, clusterID, namespace, nam
 	return release, nil
 }
 
+// UpdateRelease update a specific entity.Release in database
+func (m *ModelRelease) UpdateRelease(ctx context.Context, clusterID, namespace, name string, revision int,
+	release entity.M) error {
+	if clusterID == "" {
+		return fmt.Errorf("can not update with empty clusterID")
+	}
+	if namespace == "" {
+		return fmt.Errorf("can not update with empty namespace")
+	}
+	if name == "" {
+		return fmt.Errorf("can not update with empty name")
+	}
+	if revision <= 0 {
+		return fmt.Errorf("can not update with no-positive revision")
+	}
+	if release == nil {
+		return fmt.Errorf("can not update empty release")
+	}
+
+	if err := m.ensureTable(ctx); err != nil {
+		return err
+	}
+
+	cond := InstalledCondition(operator.M{
+		entity.FieldKeyClusterID: clusterID,
+		entity.FieldKeyNamespace: namespace,
+		entity.FieldKeyName:      name,
+		entity.FieldKeyRevision:  revision,
+	})
+	if err := m.db.Table(m.tableName).Update(ctx, cond, operator.M{"$set": release}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // ListRelease get a list of entity.Release by condition and option from database
 func (m *ModelRelease) ListRelease(ctx context.Context, cond *operator.Condition, opt *utils.ListOption) (
 	int64, []*entity.Release, error) {
@@ -181,7 +217,7 @@ func (m *ModelRelease) DeleteRelease(ctx context.Context, clusterID, namespace,
 		return err
 	}
 
-	cond := operator.NewLeafCondition(operator.Eq, operator.M{
+	cond := InstalledCondition(operator.M{
 		entity.FieldKeyClusterID: clusterID,
 		entity.FieldKeyNamespace: namespace,
 		entity.FieldKeyName:      name,
@@ -195,35 +231,61 @@ func (m *ModelRelease) DeleteRelease(ctx context.Context, clusterID, namespace,
 	return nil
 }
 
-// DeleteReleases delete a batch of entity.Release with specific clusterID-namespace-name and delete all the revisions
-func (m *ModelRelease) DeleteReleases(ctx context.Context, clusterID, namespace, name string) error {
+// UninstallReleases mark all the installed revisions of entity.Release with specific clusterID-namespace-name
+// as uninstalled, the records are kept as history and never be deleted
+func (m *ModelRelease) UninstallReleases(ctx context.Context, clusterID, namespace, name string) error {
 	if clusterID == "" {
-		return fmt.Errorf("can not get with empty clusterID")
+		return fmt.Errorf("can not uninstall with empty clusterID")
 	}
 	if namespace == "" {
-		return fmt.Errorf("can not get with empty namespace")
+		return fmt.Errorf("can not uninstall with empty namespace")
 	}
 	if name == "" {
-		return fmt.Errorf("can not get with empty name")
+		return fmt.Errorf("can not uninstall with empty name")
 	}
 
 	if err := m.ensureTable(ctx); err != nil {
 		return err
 	}
 
-	cond := operator.NewLeafCondition(operator.Eq, operator.M{
+	cond := InstalledCondition(operator.M{
 		entity.FieldKeyClusterID: clusterID,
 		entity.FieldKeyNamespace: namespace,
 		entity.FieldKeyName:      name,
 	})
 
-	blog.Infof("going to delete from %s with clusterID %s, namespace %s, name %s",
-		m.tableName, clusterID, namespace, name)
-	num, err := m.db.Table(m.tableName).Delete(ctx, cond)
-	if err != nil {
+	l := make([]*entity.Release, 0)
+	if err := m.db.Table(m.tableName).Find(cond).All(ctx, &l); err != nil {
 		return err
 	}
 
-	blog.Infof("success to delete %d records from %s", num, m.tableName)
+	timestamp := time.Now().UTC().Unix()
+	for _, release := range l {
+		revisionCond := InstalledCondition(operator.M{
+			entity.FieldKeyClusterID: clusterID,
+			entity.FieldKeyNamespace: namespace,
+			entity.FieldKeyName:      name,
+			entity.FieldKeyRevision:  release.Revision,
+		})
+		if err := m.db.Table(m.tableName).Update(ctx, revisionCond, operator.M{"$set": entity.M{
+			entity.FieldKeyUninstalled:   true,
+			entity.FieldKeyUninstallTime: timestamp,
+		}}); err != nil {
+			return err
+		}
+	}
+
+	blog.Infof("success to mark %d records uninstalled in %s with clusterID %s, namespace %s, name %s",
+		len(l), m.tableName, clusterID, namespace, name)
 	return nil
 }
+
+// InstalledCondition return the condition matching the records of release not uninstalled, with the given
+// equal conditions
+func InstalledCondition(eq operator.M) *operator.Condition {
+	installed := operator.NewLeafCondition(operator.Ne, operator.M{entity.FieldKeyUninstalled: true})
+	if len(eq) == 0 {
+		return installed
+	}
+	return operator.NewBranchCondition(operator.And, operator.NewLeafCondition(operator.Eq, eq), installed)
+}
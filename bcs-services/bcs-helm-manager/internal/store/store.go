@@ -51,6 +51,9 @@ type HelmManagerModel interface {
 	// GetRelease 精确到revision, 获取一个release
 	GetRelease(ctx context.Context, clusterID, namespace, name string, revision int) (*entity.Release, error)
 
+	// UpdateRelease 更新对应revision的release
+	UpdateRelease(ctx context.Context, clusterID, namespace, name string, revision int, release entity.M) error
+
 	// ListRelease 根据条件查询仓库列表
 	// 其中分页配置详见 utils.ListOption, 采用 page + size 的模式
 	ListRelease(ctx context.Context, cond *operator.Condition, opt *utils.ListOption) (int64, []*entity.Release, error)
@@ -58,8 +61,8 @@ type HelmManagerModel interface {
 	// DeleteRelease 删除对应revision的release
 	DeleteRelease(ctx context.Context, clusterID, namespace, name string, revision int) error
 
-	// DeleteReleases 删除指定clusterID-namespace-name下的所有revision
-	DeleteReleases(ctx context.Context, clusterID, namespace, name string) error
+	// UninstallReleases 将指定clusterID-namespace-name下的所有revision标记为已卸载, 记录保留为历史
+	UninstallReleases(ctx context.Context, clusterID, namespace, name string) error
 }
 
 type modelSet struct {
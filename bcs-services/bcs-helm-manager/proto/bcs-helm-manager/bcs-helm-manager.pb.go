@@ -2435,6 +2435,8 @@ type ReleaseDetail struct {
 	UpdateTime           *string  `protobuf:"bytes,7,opt,name=updateTime" json:"updateTime,omitempty"`
 	ChartVersion         *string  `protobuf:"bytes,8,opt,name=chartVersion" json:"chartVersion,omitempty"`
 	Values               []string `protobuf:"bytes,9,rep,name=values" json:"values,omitempty"`
+	Drifted              *bool    `protobuf:"varint,10,opt,name=drifted" json:"drifted,omitempty"`
+	DriftedResources     []string `protobuf:"bytes,11,rep,name=driftedResources" json:"driftedResources,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2528,6 +2530,20 @@ func (m *ReleaseDetail) GetValues() []string {
 	return nil
 }
 
+func (m *ReleaseDetail) GetDrifted() bool {
+	if m != nil && m.Drifted != nil {
+		return *m.Drifted
+	}
+	return false
+}
+
+func (m *ReleaseDetail) GetDriftedResources() []string {
+	if m != nil {
+		return m.DriftedResources
+	}
+	return nil
+}
+
 type InstallReleaseReq struct {
 	Name                 *string           `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
 	Namespace            *string           `protobuf:"bytes,2,opt,name=namespace" json:"namespace,omitempty"`
@@ -2919,219 +2935,1279 @@ func (m *UpgradeReleaseReq) GetVersion() string {
 	return ""
 }
 
-func (m *UpgradeReleaseReq) GetOperator() string {
-	if m != nil && m.Operator != nil {
-		return *m.Operator
+func (m *UpgradeReleaseReq) GetOperator() string {
+	if m != nil && m.Operator != nil {
+		return *m.Operator
+	}
+	return ""
+}
+
+func (m *UpgradeReleaseReq) GetValues() []string {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func (m *UpgradeReleaseReq) GetArgs() []string {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *UpgradeReleaseReq) GetBcsSysVar() map[string]string {
+	if m != nil {
+		return m.BcsSysVar
+	}
+	return nil
+}
+
+type UpgradeReleaseResp struct {
+	Code                 *uint32        `protobuf:"varint,1,opt,name=code" json:"code,omitempty"`
+	Message              *string        `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
+	Result               *bool          `protobuf:"varint,3,opt,name=result" json:"result,omitempty"`
+	Data                 *ReleaseDetail `protobuf:"bytes,4,opt,name=data" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *UpgradeReleaseResp) Reset()         { *m = UpgradeReleaseResp{} }
+func (m *UpgradeReleaseResp) String() string { return proto.CompactTextString(m) }
+func (*UpgradeReleaseResp) ProtoMessage()    {}
+func (*UpgradeReleaseResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29783c92bc89288d, []int{40}
+}
+
+func (m *UpgradeReleaseResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpgradeReleaseResp.Unmarshal(m, b)
+}
+func (m *UpgradeReleaseResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpgradeReleaseResp.Marshal(b, m, deterministic)
+}
+func (m *UpgradeReleaseResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeReleaseResp.Merge(m, src)
+}
+func (m *UpgradeReleaseResp) XXX_Size() int {
+	return xxx_messageInfo_UpgradeReleaseResp.Size(m)
+}
+func (m *UpgradeReleaseResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeReleaseResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradeReleaseResp proto.InternalMessageInfo
+
+func (m *UpgradeReleaseResp) GetCode() uint32 {
+	if m != nil && m.Code != nil {
+		return *m.Code
+	}
+	return 0
+}
+
+func (m *UpgradeReleaseResp) GetMessage() string {
+	if m != nil && m.Message != nil {
+		return *m.Message
+	}
+	return ""
+}
+
+func (m *UpgradeReleaseResp) GetResult() bool {
+	if m != nil && m.Result != nil {
+		return *m.Result
+	}
+	return false
+}
+
+func (m *UpgradeReleaseResp) GetData() *ReleaseDetail {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type RollbackReleaseReq struct {
+	Name                 *string  `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Namespace            *string  `protobuf:"bytes,2,opt,name=namespace" json:"namespace,omitempty"`
+	ClusterID            *string  `protobuf:"bytes,3,opt,name=clusterID" json:"clusterID,omitempty"`
+	Revision             *uint32  `protobuf:"varint,4,opt,name=revision" json:"revision,omitempty"`
+	Operator             *string  `protobuf:"bytes,5,opt,name=operator" json:"operator,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RollbackReleaseReq) Reset()         { *m = RollbackReleaseReq{} }
+func (m *RollbackReleaseReq) String() string { return proto.CompactTextString(m) }
+func (*RollbackReleaseReq) ProtoMessage()    {}
+func (*RollbackReleaseReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29783c92bc89288d, []int{41}
+}
+
+func (m *RollbackReleaseReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RollbackReleaseReq.Unmarshal(m, b)
+}
+func (m *RollbackReleaseReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RollbackReleaseReq.Marshal(b, m, deterministic)
+}
+func (m *RollbackReleaseReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackReleaseReq.Merge(m, src)
+}
+func (m *RollbackReleaseReq) XXX_Size() int {
+	return xxx_messageInfo_RollbackReleaseReq.Size(m)
+}
+func (m *RollbackReleaseReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackReleaseReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackReleaseReq proto.InternalMessageInfo
+
+func (m *RollbackReleaseReq) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *RollbackReleaseReq) GetNamespace() string {
+	if m != nil && m.Namespace != nil {
+		return *m.Namespace
+	}
+	return ""
+}
+
+func (m *RollbackReleaseReq) GetClusterID() string {
+	if m != nil && m.ClusterID != nil {
+		return *m.ClusterID
+	}
+	return ""
+}
+
+func (m *RollbackReleaseReq) GetRevision() uint32 {
+	if m != nil && m.Revision != nil {
+		return *m.Revision
+	}
+	return 0
+}
+
+func (m *RollbackReleaseReq) GetOperator() string {
+	if m != nil && m.Operator != nil {
+		return *m.Operator
+	}
+	return ""
+}
+
+type RollbackReleaseResp struct {
+	Code                 *uint32  `protobuf:"varint,1,opt,name=code" json:"code,omitempty"`
+	Message              *string  `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
+	Result               *bool    `protobuf:"varint,3,opt,name=result" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RollbackReleaseResp) Reset()         { *m = RollbackReleaseResp{} }
+func (m *RollbackReleaseResp) String() string { return proto.CompactTextString(m) }
+func (*RollbackReleaseResp) ProtoMessage()    {}
+func (*RollbackReleaseResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29783c92bc89288d, []int{42}
+}
+
+func (m *RollbackReleaseResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RollbackReleaseResp.Unmarshal(m, b)
+}
+func (m *RollbackReleaseResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RollbackReleaseResp.Marshal(b, m, deterministic)
+}
+func (m *RollbackReleaseResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackReleaseResp.Merge(m, src)
+}
+func (m *RollbackReleaseResp) XXX_Size() int {
+	return xxx_messageInfo_RollbackReleaseResp.Size(m)
+}
+func (m *RollbackReleaseResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackReleaseResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackReleaseResp proto.InternalMessageInfo
+
+func (m *RollbackReleaseResp) GetCode() uint32 {
+	if m != nil && m.Code != nil {
+		return *m.Code
+	}
+	return 0
+}
+
+func (m *RollbackReleaseResp) GetMessage() string {
+	if m != nil && m.Message != nil {
+		return *m.Message
+	}
+	return ""
+}
+
+func (m *RollbackReleaseResp) GetResult() bool {
+	if m != nil && m.Result != nil {
+		return *m.Result
+	}
+	return false
+}
+
+type PreviewInstallReleaseReq struct {
+	Name                 *string           `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Namespace            *string           `protobuf:"bytes,2,opt,name=namespace" json:"namespace,omitempty"`
+	ClusterID            *string           `protobuf:"bytes,3,opt,name=clusterID" json:"clusterID,omitempty"`
+	ProjectID            *string           `protobuf:"bytes,4,opt,name=projectID" json:"projectID,omitempty"`
+	Repository           *string           `protobuf:"bytes,5,opt,name=repository" json:"repository,omitempty"`
+	Chart                *string           `protobuf:"bytes,6,opt,name=chart" json:"chart,omitempty"`
+	Version              *string           `protobuf:"bytes,7,opt,name=version" json:"version,omitempty"`
+	Operator             *string           `protobuf:"bytes,8,opt,name=operator" json:"operator,omitempty"`
+	Values               []string          `protobuf:"bytes,9,rep,name=values" json:"values,omitempty"`
+	Args                 []string          `protobuf:"bytes,10,rep,name=args" json:"args,omitempty"`
+	BcsSysVar            map[string]string `protobuf:"bytes,11,rep,name=bcsSysVar" json:"bcsSysVar,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *PreviewInstallReleaseReq) Reset()         { *m = PreviewInstallReleaseReq{} }
+func (m *PreviewInstallReleaseReq) String() string { return proto.CompactTextString(m) }
+func (*PreviewInstallReleaseReq) ProtoMessage()    {}
+func (*PreviewInstallReleaseReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29783c92bc89288d, []int{43}
+}
+
+func (m *PreviewInstallReleaseReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PreviewInstallReleaseReq.Unmarshal(m, b)
+}
+func (m *PreviewInstallReleaseReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PreviewInstallReleaseReq.Marshal(b, m, deterministic)
+}
+func (m *PreviewInstallReleaseReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreviewInstallReleaseReq.Merge(m, src)
+}
+func (m *PreviewInstallReleaseReq) XXX_Size() int {
+	return xxx_messageInfo_PreviewInstallReleaseReq.Size(m)
+}
+func (m *PreviewInstallReleaseReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreviewInstallReleaseReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PreviewInstallReleaseReq proto.InternalMessageInfo
+
+func (m *PreviewInstallReleaseReq) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *PreviewInstallReleaseReq) GetNamespace() string {
+	if m != nil && m.Namespace != nil {
+		return *m.Namespace
+	}
+	return ""
+}
+
+func (m *PreviewInstallReleaseReq) GetClusterID() string {
+	if m != nil && m.ClusterID != nil {
+		return *m.ClusterID
+	}
+	return ""
+}
+
+func (m *PreviewInstallReleaseReq) GetProjectID() string {
+	if m != nil && m.ProjectID != nil {
+		return *m.ProjectID
+	}
+	return ""
+}
+
+func (m *PreviewInstallReleaseReq) GetRepository() string {
+	if m != nil && m.Repository != nil {
+		return *m.Repository
+	}
+	return ""
+}
+
+func (m *PreviewInstallReleaseReq) GetChart() string {
+	if m != nil && m.Chart != nil {
+		return *m.Chart
+	}
+	return ""
+}
+
+func (m *PreviewInstallReleaseReq) GetVersion() string {
+	if m != nil && m.Version != nil {
+		return *m.Version
+	}
+	return ""
+}
+
+func (m *PreviewInstallReleaseReq) GetOperator() string {
+	if m != nil && m.Operator != nil {
+		return *m.Operator
+	}
+	return ""
+}
+
+func (m *PreviewInstallReleaseReq) GetValues() []string {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func (m *PreviewInstallReleaseReq) GetArgs() []string {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *PreviewInstallReleaseReq) GetBcsSysVar() map[string]string {
+	if m != nil {
+		return m.BcsSysVar
+	}
+	return nil
+}
+
+type PreviewInstallReleaseResp struct {
+	Code                 *uint32         `protobuf:"varint,1,opt,name=code" json:"code,omitempty"`
+	Message              *string         `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
+	Result               *bool           `protobuf:"varint,3,opt,name=result" json:"result,omitempty"`
+	Data                 *ReleasePreview `protobuf:"bytes,4,opt,name=data" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *PreviewInstallReleaseResp) Reset()         { *m = PreviewInstallReleaseResp{} }
+func (m *PreviewInstallReleaseResp) String() string { return proto.CompactTextString(m) }
+func (*PreviewInstallReleaseResp) ProtoMessage()    {}
+func (*PreviewInstallReleaseResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29783c92bc89288d, []int{44}
+}
+
+func (m *PreviewInstallReleaseResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PreviewInstallReleaseResp.Unmarshal(m, b)
+}
+func (m *PreviewInstallReleaseResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PreviewInstallReleaseResp.Marshal(b, m, deterministic)
+}
+func (m *PreviewInstallReleaseResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreviewInstallReleaseResp.Merge(m, src)
+}
+func (m *PreviewInstallReleaseResp) XXX_Size() int {
+	return xxx_messageInfo_PreviewInstallReleaseResp.Size(m)
+}
+func (m *PreviewInstallReleaseResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreviewInstallReleaseResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PreviewInstallReleaseResp proto.InternalMessageInfo
+
+func (m *PreviewInstallReleaseResp) GetCode() uint32 {
+	if m != nil && m.Code != nil {
+		return *m.Code
+	}
+	return 0
+}
+
+func (m *PreviewInstallReleaseResp) GetMessage() string {
+	if m != nil && m.Message != nil {
+		return *m.Message
+	}
+	return ""
+}
+
+func (m *PreviewInstallReleaseResp) GetResult() bool {
+	if m != nil && m.Result != nil {
+		return *m.Result
+	}
+	return false
+}
+
+func (m *PreviewInstallReleaseResp) GetData() *ReleasePreview {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type PreviewUpgradeReleaseReq struct {
+	Name                 *string           `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Namespace            *string           `protobuf:"bytes,2,opt,name=namespace" json:"namespace,omitempty"`
+	ClusterID            *string           `protobuf:"bytes,3,opt,name=clusterID" json:"clusterID,omitempty"`
+	ProjectID            *string           `protobuf:"bytes,4,opt,name=projectID" json:"projectID,omitempty"`
+	Repository           *string           `protobuf:"bytes,5,opt,name=repository" json:"repository,omitempty"`
+	Chart                *string           `protobuf:"bytes,6,opt,name=chart" json:"chart,omitempty"`
+	Version              *string           `protobuf:"bytes,7,opt,name=version" json:"version,omitempty"`
+	Operator             *string           `protobuf:"bytes,8,opt,name=operator" json:"operator,omitempty"`
+	Values               []string          `protobuf:"bytes,9,rep,name=values" json:"values,omitempty"`
+	Args                 []string          `protobuf:"bytes,10,rep,name=args" json:"args,omitempty"`
+	BcsSysVar            map[string]string `protobuf:"bytes,11,rep,name=bcsSysVar" json:"bcsSysVar,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *PreviewUpgradeReleaseReq) Reset()         { *m = PreviewUpgradeReleaseReq{} }
+func (m *PreviewUpgradeReleaseReq) String() string { return proto.CompactTextString(m) }
+func (*PreviewUpgradeReleaseReq) ProtoMessage()    {}
+func (*PreviewUpgradeReleaseReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29783c92bc89288d, []int{45}
+}
+
+func (m *PreviewUpgradeReleaseReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PreviewUpgradeReleaseReq.Unmarshal(m, b)
+}
+func (m *PreviewUpgradeReleaseReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PreviewUpgradeReleaseReq.Marshal(b, m, deterministic)
+}
+func (m *PreviewUpgradeReleaseReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreviewUpgradeReleaseReq.Merge(m, src)
+}
+func (m *PreviewUpgradeReleaseReq) XXX_Size() int {
+	return xxx_messageInfo_PreviewUpgradeReleaseReq.Size(m)
+}
+func (m *PreviewUpgradeReleaseReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreviewUpgradeReleaseReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PreviewUpgradeReleaseReq proto.InternalMessageInfo
+
+func (m *PreviewUpgradeReleaseReq) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *PreviewUpgradeReleaseReq) GetNamespace() string {
+	if m != nil && m.Namespace != nil {
+		return *m.Namespace
+	}
+	return ""
+}
+
+func (m *PreviewUpgradeReleaseReq) GetClusterID() string {
+	if m != nil && m.ClusterID != nil {
+		return *m.ClusterID
+	}
+	return ""
+}
+
+func (m *PreviewUpgradeReleaseReq) GetProjectID() string {
+	if m != nil && m.ProjectID != nil {
+		return *m.ProjectID
+	}
+	return ""
+}
+
+func (m *PreviewUpgradeReleaseReq) GetRepository() string {
+	if m != nil && m.Repository != nil {
+		return *m.Repository
+	}
+	return ""
+}
+
+func (m *PreviewUpgradeReleaseReq) GetChart() string {
+	if m != nil && m.Chart != nil {
+		return *m.Chart
+	}
+	return ""
+}
+
+func (m *PreviewUpgradeReleaseReq) GetVersion() string {
+	if m != nil && m.Version != nil {
+		return *m.Version
+	}
+	return ""
+}
+
+func (m *PreviewUpgradeReleaseReq) GetOperator() string {
+	if m != nil && m.Operator != nil {
+		return *m.Operator
+	}
+	return ""
+}
+
+func (m *PreviewUpgradeReleaseReq) GetValues() []string {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func (m *PreviewUpgradeReleaseReq) GetArgs() []string {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *PreviewUpgradeReleaseReq) GetBcsSysVar() map[string]string {
+	if m != nil {
+		return m.BcsSysVar
+	}
+	return nil
+}
+
+type PreviewUpgradeReleaseResp struct {
+	Code                 *uint32         `protobuf:"varint,1,opt,name=code" json:"code,omitempty"`
+	Message              *string         `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
+	Result               *bool           `protobuf:"varint,3,opt,name=result" json:"result,omitempty"`
+	Data                 *ReleasePreview `protobuf:"bytes,4,opt,name=data" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *PreviewUpgradeReleaseResp) Reset()         { *m = PreviewUpgradeReleaseResp{} }
+func (m *PreviewUpgradeReleaseResp) String() string { return proto.CompactTextString(m) }
+func (*PreviewUpgradeReleaseResp) ProtoMessage()    {}
+func (*PreviewUpgradeReleaseResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29783c92bc89288d, []int{46}
+}
+
+func (m *PreviewUpgradeReleaseResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PreviewUpgradeReleaseResp.Unmarshal(m, b)
+}
+func (m *PreviewUpgradeReleaseResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PreviewUpgradeReleaseResp.Marshal(b, m, deterministic)
+}
+func (m *PreviewUpgradeReleaseResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreviewUpgradeReleaseResp.Merge(m, src)
+}
+func (m *PreviewUpgradeReleaseResp) XXX_Size() int {
+	return xxx_messageInfo_PreviewUpgradeReleaseResp.Size(m)
+}
+func (m *PreviewUpgradeReleaseResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreviewUpgradeReleaseResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PreviewUpgradeReleaseResp proto.InternalMessageInfo
+
+func (m *PreviewUpgradeReleaseResp) GetCode() uint32 {
+	if m != nil && m.Code != nil {
+		return *m.Code
+	}
+	return 0
+}
+
+func (m *PreviewUpgradeReleaseResp) GetMessage() string {
+	if m != nil && m.Message != nil {
+		return *m.Message
+	}
+	return ""
+}
+
+func (m *PreviewUpgradeReleaseResp) GetResult() bool {
+	if m != nil && m.Result != nil {
+		return *m.Result
+	}
+	return false
+}
+
+func (m *PreviewUpgradeReleaseResp) GetData() *ReleasePreview {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type ReleasePreview struct {
+	Name                 *string         `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Namespace            *string         `protobuf:"bytes,2,opt,name=namespace" json:"namespace,omitempty"`
+	Revision             *uint32         `protobuf:"varint,3,opt,name=revision" json:"revision,omitempty"`
+	CurrentRevision      *uint32         `protobuf:"varint,4,opt,name=currentRevision" json:"currentRevision,omitempty"`
+	Manifest             *string         `protobuf:"bytes,5,opt,name=manifest" json:"manifest,omitempty"`
+	Resources            []*ResourceDiff `protobuf:"bytes,6,rep,name=resources" json:"resources,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ReleasePreview) Reset()         { *m = ReleasePreview{} }
+func (m *ReleasePreview) String() string { return proto.CompactTextString(m) }
+func (*ReleasePreview) ProtoMessage()    {}
+func (*ReleasePreview) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29783c92bc89288d, []int{47}
+}
+
+func (m *ReleasePreview) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReleasePreview.Unmarshal(m, b)
+}
+func (m *ReleasePreview) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReleasePreview.Marshal(b, m, deterministic)
+}
+func (m *ReleasePreview) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleasePreview.Merge(m, src)
+}
+func (m *ReleasePreview) XXX_Size() int {
+	return xxx_messageInfo_ReleasePreview.Size(m)
+}
+func (m *ReleasePreview) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleasePreview.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleasePreview proto.InternalMessageInfo
+
+func (m *ReleasePreview) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *ReleasePreview) GetNamespace() string {
+	if m != nil && m.Namespace != nil {
+		return *m.Namespace
+	}
+	return ""
+}
+
+func (m *ReleasePreview) GetRevision() uint32 {
+	if m != nil && m.Revision != nil {
+		return *m.Revision
+	}
+	return 0
+}
+
+func (m *ReleasePreview) GetCurrentRevision() uint32 {
+	if m != nil && m.CurrentRevision != nil {
+		return *m.CurrentRevision
+	}
+	return 0
+}
+
+func (m *ReleasePreview) GetManifest() string {
+	if m != nil && m.Manifest != nil {
+		return *m.Manifest
+	}
+	return ""
+}
+
+func (m *ReleasePreview) GetResources() []*ResourceDiff {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
+}
+
+type ResourceDiff struct {
+	Kind                 *string  `protobuf:"bytes,1,opt,name=kind" json:"kind,omitempty"`
+	ApiVersion           *string  `protobuf:"bytes,2,opt,name=apiVersion" json:"apiVersion,omitempty"`
+	Name                 *string  `protobuf:"bytes,3,opt,name=name" json:"name,omitempty"`
+	Namespace            *string  `protobuf:"bytes,4,opt,name=namespace" json:"namespace,omitempty"`
+	Action               *string  `protobuf:"bytes,5,opt,name=action" json:"action,omitempty"`
+	Reason               *string  `protobuf:"bytes,6,opt,name=reason" json:"reason,omitempty"`
+	Drifted              *bool    `protobuf:"varint,7,opt,name=drifted" json:"drifted,omitempty"`
+	Deployed             *string  `protobuf:"bytes,8,opt,name=deployed" json:"deployed,omitempty"`
+	Rendered             *string  `protobuf:"bytes,9,opt,name=rendered" json:"rendered,omitempty"`
+	Live                 *string  `protobuf:"bytes,10,opt,name=live" json:"live,omitempty"`
+	Diff                 *string  `protobuf:"bytes,11,opt,name=diff" json:"diff,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResourceDiff) Reset()         { *m = ResourceDiff{} }
+func (m *ResourceDiff) String() string { return proto.CompactTextString(m) }
+func (*ResourceDiff) ProtoMessage()    {}
+func (*ResourceDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29783c92bc89288d, []int{48}
+}
+
+func (m *ResourceDiff) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResourceDiff.Unmarshal(m, b)
+}
+func (m *ResourceDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResourceDiff.Marshal(b, m, deterministic)
+}
+func (m *ResourceDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceDiff.Merge(m, src)
+}
+func (m *ResourceDiff) XXX_Size() int {
+	return xxx_messageInfo_ResourceDiff.Size(m)
+}
+func (m *ResourceDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceDiff proto.InternalMessageInfo
+
+func (m *ResourceDiff) GetKind() string {
+	if m != nil && m.Kind != nil {
+		return *m.Kind
+	}
+	return ""
+}
+
+func (m *ResourceDiff) GetApiVersion() string {
+	if m != nil && m.ApiVersion != nil {
+		return *m.ApiVersion
+	}
+	return ""
+}
+
+func (m *ResourceDiff) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *ResourceDiff) GetNamespace() string {
+	if m != nil && m.Namespace != nil {
+		return *m.Namespace
+	}
+	return ""
+}
+
+func (m *ResourceDiff) GetAction() string {
+	if m != nil && m.Action != nil {
+		return *m.Action
+	}
+	return ""
+}
+
+func (m *ResourceDiff) GetReason() string {
+	if m != nil && m.Reason != nil {
+		return *m.Reason
+	}
+	return ""
+}
+
+func (m *ResourceDiff) GetDrifted() bool {
+	if m != nil && m.Drifted != nil {
+		return *m.Drifted
+	}
+	return false
+}
+
+func (m *ResourceDiff) GetDeployed() string {
+	if m != nil && m.Deployed != nil {
+		return *m.Deployed
+	}
+	return ""
+}
+
+func (m *ResourceDiff) GetRendered() string {
+	if m != nil && m.Rendered != nil {
+		return *m.Rendered
+	}
+	return ""
+}
+
+func (m *ResourceDiff) GetLive() string {
+	if m != nil && m.Live != nil {
+		return *m.Live
+	}
+	return ""
+}
+
+func (m *ResourceDiff) GetDiff() string {
+	if m != nil && m.Diff != nil {
+		return *m.Diff
+	}
+	return ""
+}
+
+type ListReleaseHistoryReq struct {
+	ClusterID            *string  `protobuf:"bytes,1,opt,name=clusterID" json:"clusterID,omitempty"`
+	Namespace            *string  `protobuf:"bytes,2,opt,name=namespace" json:"namespace,omitempty"`
+	Name                 *string  `protobuf:"bytes,3,opt,name=name" json:"name,omitempty"`
+	Page                 *uint32  `protobuf:"varint,4,opt,name=page" json:"page,omitempty"`
+	Size                 *uint32  `protobuf:"varint,5,opt,name=size" json:"size,omitempty"`
+	IncludeUninstalled   *bool    `protobuf:"varint,6,opt,name=includeUninstalled" json:"includeUninstalled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListReleaseHistoryReq) Reset()         { *m = ListReleaseHistoryReq{} }
+func (m *ListReleaseHistoryReq) String() string { return proto.CompactTextString(m) }
+func (*ListReleaseHistoryReq) ProtoMessage()    {}
+func (*ListReleaseHistoryReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29783c92bc89288d, []int{49}
+}
+
+func (m *ListReleaseHistoryReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListReleaseHistoryReq.Unmarshal(m, b)
+}
+func (m *ListReleaseHistoryReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListReleaseHistoryReq.Marshal(b, m, deterministic)
+}
+func (m *ListReleaseHistoryReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListReleaseHistoryReq.Merge(m, src)
+}
+func (m *ListReleaseHistoryReq) XXX_Size() int {
+	return xxx_messageInfo_ListReleaseHistoryReq.Size(m)
+}
+func (m *ListReleaseHistoryReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListReleaseHistoryReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListReleaseHistoryReq proto.InternalMessageInfo
+
+func (m *ListReleaseHistoryReq) GetClusterID() string {
+	if m != nil && m.ClusterID != nil {
+		return *m.ClusterID
+	}
+	return ""
+}
+
+func (m *ListReleaseHistoryReq) GetNamespace() string {
+	if m != nil && m.Namespace != nil {
+		return *m.Namespace
+	}
+	return ""
+}
+
+func (m *ListReleaseHistoryReq) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *ListReleaseHistoryReq) GetPage() uint32 {
+	if m != nil && m.Page != nil {
+		return *m.Page
+	}
+	return 0
+}
+
+func (m *ListReleaseHistoryReq) GetSize() uint32 {
+	if m != nil && m.Size != nil {
+		return *m.Size
+	}
+	return 0
+}
+
+func (m *ListReleaseHistoryReq) GetIncludeUninstalled() bool {
+	if m != nil && m.IncludeUninstalled != nil {
+		return *m.IncludeUninstalled
+	}
+	return false
+}
+
+type ListReleaseHistoryResp struct {
+	Code                 *uint32                 `protobuf:"varint,1,opt,name=code" json:"code,omitempty"`
+	Message              *string                 `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
+	Result               *bool                   `protobuf:"varint,3,opt,name=result" json:"result,omitempty"`
+	Data                 *ReleaseHistoryListData `protobuf:"bytes,4,opt,name=data" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *ListReleaseHistoryResp) Reset()         { *m = ListReleaseHistoryResp{} }
+func (m *ListReleaseHistoryResp) String() string { return proto.CompactTextString(m) }
+func (*ListReleaseHistoryResp) ProtoMessage()    {}
+func (*ListReleaseHistoryResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29783c92bc89288d, []int{50}
+}
+
+func (m *ListReleaseHistoryResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListReleaseHistoryResp.Unmarshal(m, b)
+}
+func (m *ListReleaseHistoryResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListReleaseHistoryResp.Marshal(b, m, deterministic)
+}
+func (m *ListReleaseHistoryResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListReleaseHistoryResp.Merge(m, src)
+}
+func (m *ListReleaseHistoryResp) XXX_Size() int {
+	return xxx_messageInfo_ListReleaseHistoryResp.Size(m)
+}
+func (m *ListReleaseHistoryResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListReleaseHistoryResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListReleaseHistoryResp proto.InternalMessageInfo
+
+func (m *ListReleaseHistoryResp) GetCode() uint32 {
+	if m != nil && m.Code != nil {
+		return *m.Code
+	}
+	return 0
+}
+
+func (m *ListReleaseHistoryResp) GetMessage() string {
+	if m != nil && m.Message != nil {
+		return *m.Message
+	}
+	return ""
+}
+
+func (m *ListReleaseHistoryResp) GetResult() bool {
+	if m != nil && m.Result != nil {
+		return *m.Result
+	}
+	return false
+}
+
+func (m *ListReleaseHistoryResp) GetData() *ReleaseHistoryListData {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type GetReleaseRevisionReq struct {
+	ClusterID            *string  `protobuf:"bytes,1,opt,name=clusterID" json:"clusterID,omitempty"`
+	Namespace            *string  `protobuf:"bytes,2,opt,name=namespace" json:"namespace,omitempty"`
+	Name                 *string  `protobuf:"bytes,3,opt,name=name" json:"name,omitempty"`
+	Revision             *uint32  `protobuf:"varint,4,opt,name=revision" json:"revision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetReleaseRevisionReq) Reset()         { *m = GetReleaseRevisionReq{} }
+func (m *GetReleaseRevisionReq) String() string { return proto.CompactTextString(m) }
+func (*GetReleaseRevisionReq) ProtoMessage()    {}
+func (*GetReleaseRevisionReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29783c92bc89288d, []int{51}
+}
+
+func (m *GetReleaseRevisionReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetReleaseRevisionReq.Unmarshal(m, b)
+}
+func (m *GetReleaseRevisionReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetReleaseRevisionReq.Marshal(b, m, deterministic)
+}
+func (m *GetReleaseRevisionReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReleaseRevisionReq.Merge(m, src)
+}
+func (m *GetReleaseRevisionReq) XXX_Size() int {
+	return xxx_messageInfo_GetReleaseRevisionReq.Size(m)
+}
+func (m *GetReleaseRevisionReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReleaseRevisionReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReleaseRevisionReq proto.InternalMessageInfo
+
+func (m *GetReleaseRevisionReq) GetClusterID() string {
+	if m != nil && m.ClusterID != nil {
+		return *m.ClusterID
+	}
+	return ""
+}
+
+func (m *GetReleaseRevisionReq) GetNamespace() string {
+	if m != nil && m.Namespace != nil {
+		return *m.Namespace
+	}
+	return ""
+}
+
+func (m *GetReleaseRevisionReq) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *GetReleaseRevisionReq) GetRevision() uint32 {
+	if m != nil && m.Revision != nil {
+		return *m.Revision
+	}
+	return 0
+}
+
+type GetReleaseRevisionResp struct {
+	Code                 *uint32          `protobuf:"varint,1,opt,name=code" json:"code,omitempty"`
+	Message              *string          `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
+	Result               *bool            `protobuf:"varint,3,opt,name=result" json:"result,omitempty"`
+	Data                 *ReleaseRevision `protobuf:"bytes,4,opt,name=data" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetReleaseRevisionResp) Reset()         { *m = GetReleaseRevisionResp{} }
+func (m *GetReleaseRevisionResp) String() string { return proto.CompactTextString(m) }
+func (*GetReleaseRevisionResp) ProtoMessage()    {}
+func (*GetReleaseRevisionResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29783c92bc89288d, []int{52}
+}
+
+func (m *GetReleaseRevisionResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetReleaseRevisionResp.Unmarshal(m, b)
+}
+func (m *GetReleaseRevisionResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetReleaseRevisionResp.Marshal(b, m, deterministic)
+}
+func (m *GetReleaseRevisionResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReleaseRevisionResp.Merge(m, src)
+}
+func (m *GetReleaseRevisionResp) XXX_Size() int {
+	return xxx_messageInfo_GetReleaseRevisionResp.Size(m)
+}
+func (m *GetReleaseRevisionResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReleaseRevisionResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReleaseRevisionResp proto.InternalMessageInfo
+
+func (m *GetReleaseRevisionResp) GetCode() uint32 {
+	if m != nil && m.Code != nil {
+		return *m.Code
 	}
-	return ""
+	return 0
 }
 
-func (m *UpgradeReleaseReq) GetValues() []string {
-	if m != nil {
-		return m.Values
+func (m *GetReleaseRevisionResp) GetMessage() string {
+	if m != nil && m.Message != nil {
+		return *m.Message
 	}
-	return nil
+	return ""
 }
 
-func (m *UpgradeReleaseReq) GetArgs() []string {
-	if m != nil {
-		return m.Args
+func (m *GetReleaseRevisionResp) GetResult() bool {
+	if m != nil && m.Result != nil {
+		return *m.Result
 	}
-	return nil
+	return false
 }
 
-func (m *UpgradeReleaseReq) GetBcsSysVar() map[string]string {
+func (m *GetReleaseRevisionResp) GetData() *ReleaseRevision {
 	if m != nil {
-		return m.BcsSysVar
+		return m.Data
 	}
 	return nil
 }
 
-type UpgradeReleaseResp struct {
-	Code                 *uint32        `protobuf:"varint,1,opt,name=code" json:"code,omitempty"`
-	Message              *string        `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
-	Result               *bool          `protobuf:"varint,3,opt,name=result" json:"result,omitempty"`
-	Data                 *ReleaseDetail `protobuf:"bytes,4,opt,name=data" json:"data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+type ReleaseHistoryListData struct {
+	Page                 *uint32            `protobuf:"varint,1,opt,name=page" json:"page,omitempty"`
+	Size                 *uint32            `protobuf:"varint,2,opt,name=size" json:"size,omitempty"`
+	Total                *uint32            `protobuf:"varint,3,opt,name=total" json:"total,omitempty"`
+	Data                 []*ReleaseRevision `protobuf:"bytes,4,rep,name=data" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
-func (m *UpgradeReleaseResp) Reset()         { *m = UpgradeReleaseResp{} }
-func (m *UpgradeReleaseResp) String() string { return proto.CompactTextString(m) }
-func (*UpgradeReleaseResp) ProtoMessage()    {}
-func (*UpgradeReleaseResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29783c92bc89288d, []int{40}
+func (m *ReleaseHistoryListData) Reset()         { *m = ReleaseHistoryListData{} }
+func (m *ReleaseHistoryListData) String() string { return proto.CompactTextString(m) }
+func (*ReleaseHistoryListData) ProtoMessage()    {}
+func (*ReleaseHistoryListData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29783c92bc89288d, []int{53}
 }
 
-func (m *UpgradeReleaseResp) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpgradeReleaseResp.Unmarshal(m, b)
+func (m *ReleaseHistoryListData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReleaseHistoryListData.Unmarshal(m, b)
 }
-func (m *UpgradeReleaseResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpgradeReleaseResp.Marshal(b, m, deterministic)
+func (m *ReleaseHistoryListData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReleaseHistoryListData.Marshal(b, m, deterministic)
 }
-func (m *UpgradeReleaseResp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpgradeReleaseResp.Merge(m, src)
+func (m *ReleaseHistoryListData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseHistoryListData.Merge(m, src)
 }
-func (m *UpgradeReleaseResp) XXX_Size() int {
-	return xxx_messageInfo_UpgradeReleaseResp.Size(m)
+func (m *ReleaseHistoryListData) XXX_Size() int {
+	return xxx_messageInfo_ReleaseHistoryListData.Size(m)
 }
-func (m *UpgradeReleaseResp) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpgradeReleaseResp.DiscardUnknown(m)
+func (m *ReleaseHistoryListData) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseHistoryListData.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpgradeReleaseResp proto.InternalMessageInfo
+var xxx_messageInfo_ReleaseHistoryListData proto.InternalMessageInfo
 
-func (m *UpgradeReleaseResp) GetCode() uint32 {
-	if m != nil && m.Code != nil {
-		return *m.Code
+func (m *ReleaseHistoryListData) GetPage() uint32 {
+	if m != nil && m.Page != nil {
+		return *m.Page
 	}
 	return 0
 }
 
-func (m *UpgradeReleaseResp) GetMessage() string {
-	if m != nil && m.Message != nil {
-		return *m.Message
+func (m *ReleaseHistoryListData) GetSize() uint32 {
+	if m != nil && m.Size != nil {
+		return *m.Size
 	}
-	return ""
+	return 0
 }
 
-func (m *UpgradeReleaseResp) GetResult() bool {
-	if m != nil && m.Result != nil {
-		return *m.Result
+func (m *ReleaseHistoryListData) GetTotal() uint32 {
+	if m != nil && m.Total != nil {
+		return *m.Total
 	}
-	return false
+	return 0
 }
 
-func (m *UpgradeReleaseResp) GetData() *ReleaseDetail {
+func (m *ReleaseHistoryListData) GetData() []*ReleaseRevision {
 	if m != nil {
 		return m.Data
 	}
 	return nil
 }
 
-type RollbackReleaseReq struct {
+type ReleaseRevision struct {
 	Name                 *string  `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
 	Namespace            *string  `protobuf:"bytes,2,opt,name=namespace" json:"namespace,omitempty"`
 	ClusterID            *string  `protobuf:"bytes,3,opt,name=clusterID" json:"clusterID,omitempty"`
 	Revision             *uint32  `protobuf:"varint,4,opt,name=revision" json:"revision,omitempty"`
-	Operator             *string  `protobuf:"bytes,5,opt,name=operator" json:"operator,omitempty"`
+	Action               *string  `protobuf:"bytes,5,opt,name=action" json:"action,omitempty"`
+	Status               *string  `protobuf:"bytes,6,opt,name=status" json:"status,omitempty"`
+	Chart                *string  `protobuf:"bytes,7,opt,name=chart" json:"chart,omitempty"`
+	ChartVersion         *string  `protobuf:"bytes,8,opt,name=chartVersion" json:"chartVersion,omitempty"`
+	Values               []string `protobuf:"bytes,9,rep,name=values" json:"values,omitempty"`
+	Manifest             *string  `protobuf:"bytes,10,opt,name=manifest" json:"manifest,omitempty"`
+	RollbackTo           *uint32  `protobuf:"varint,11,opt,name=rollbackTo" json:"rollbackTo,omitempty"`
+	Operator             *string  `protobuf:"bytes,12,opt,name=operator" json:"operator,omitempty"`
+	CreateTime           *string  `protobuf:"bytes,13,opt,name=createTime" json:"createTime,omitempty"`
+	Drifted              *bool    `protobuf:"varint,14,opt,name=drifted" json:"drifted,omitempty"`
+	DriftedResources     []string `protobuf:"bytes,15,rep,name=driftedResources" json:"driftedResources,omitempty"`
+	DriftCheckTime       *string  `protobuf:"bytes,16,opt,name=driftCheckTime" json:"driftCheckTime,omitempty"`
+	Uninstalled          *bool    `protobuf:"varint,17,opt,name=uninstalled" json:"uninstalled,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RollbackReleaseReq) Reset()         { *m = RollbackReleaseReq{} }
-func (m *RollbackReleaseReq) String() string { return proto.CompactTextString(m) }
-func (*RollbackReleaseReq) ProtoMessage()    {}
-func (*RollbackReleaseReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29783c92bc89288d, []int{41}
+func (m *ReleaseRevision) Reset()         { *m = ReleaseRevision{} }
+func (m *ReleaseRevision) String() string { return proto.CompactTextString(m) }
+func (*ReleaseRevision) ProtoMessage()    {}
+func (*ReleaseRevision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29783c92bc89288d, []int{54}
 }
 
-func (m *RollbackReleaseReq) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RollbackReleaseReq.Unmarshal(m, b)
+func (m *ReleaseRevision) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReleaseRevision.Unmarshal(m, b)
 }
-func (m *RollbackReleaseReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RollbackReleaseReq.Marshal(b, m, deterministic)
+func (m *ReleaseRevision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReleaseRevision.Marshal(b, m, deterministic)
 }
-func (m *RollbackReleaseReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RollbackReleaseReq.Merge(m, src)
+func (m *ReleaseRevision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseRevision.Merge(m, src)
 }
-func (m *RollbackReleaseReq) XXX_Size() int {
-	return xxx_messageInfo_RollbackReleaseReq.Size(m)
+func (m *ReleaseRevision) XXX_Size() int {
+	return xxx_messageInfo_ReleaseRevision.Size(m)
 }
-func (m *RollbackReleaseReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_RollbackReleaseReq.DiscardUnknown(m)
+func (m *ReleaseRevision) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseRevision.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RollbackReleaseReq proto.InternalMessageInfo
+var xxx_messageInfo_ReleaseRevision proto.InternalMessageInfo
 
-func (m *RollbackReleaseReq) GetName() string {
+func (m *ReleaseRevision) GetName() string {
 	if m != nil && m.Name != nil {
 		return *m.Name
 	}
 	return ""
 }
 
-func (m *RollbackReleaseReq) GetNamespace() string {
+func (m *ReleaseRevision) GetNamespace() string {
 	if m != nil && m.Namespace != nil {
 		return *m.Namespace
 	}
 	return ""
 }
 
-func (m *RollbackReleaseReq) GetClusterID() string {
+func (m *ReleaseRevision) GetClusterID() string {
 	if m != nil && m.ClusterID != nil {
 		return *m.ClusterID
 	}
 	return ""
 }
 
-func (m *RollbackReleaseReq) GetRevision() uint32 {
+func (m *ReleaseRevision) GetRevision() uint32 {
 	if m != nil && m.Revision != nil {
 		return *m.Revision
 	}
 	return 0
 }
 
-func (m *RollbackReleaseReq) GetOperator() string {
-	if m != nil && m.Operator != nil {
-		return *m.Operator
+func (m *ReleaseRevision) GetAction() string {
+	if m != nil && m.Action != nil {
+		return *m.Action
 	}
 	return ""
 }
 
-type RollbackReleaseResp struct {
-	Code                 *uint32  `protobuf:"varint,1,opt,name=code" json:"code,omitempty"`
-	Message              *string  `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
-	Result               *bool    `protobuf:"varint,3,opt,name=result" json:"result,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ReleaseRevision) GetStatus() string {
+	if m != nil && m.Status != nil {
+		return *m.Status
+	}
+	return ""
 }
 
-func (m *RollbackReleaseResp) Reset()         { *m = RollbackReleaseResp{} }
-func (m *RollbackReleaseResp) String() string { return proto.CompactTextString(m) }
-func (*RollbackReleaseResp) ProtoMessage()    {}
-func (*RollbackReleaseResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29783c92bc89288d, []int{42}
+func (m *ReleaseRevision) GetChart() string {
+	if m != nil && m.Chart != nil {
+		return *m.Chart
+	}
+	return ""
 }
 
-func (m *RollbackReleaseResp) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RollbackReleaseResp.Unmarshal(m, b)
+func (m *ReleaseRevision) GetChartVersion() string {
+	if m != nil && m.ChartVersion != nil {
+		return *m.ChartVersion
+	}
+	return ""
 }
-func (m *RollbackReleaseResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RollbackReleaseResp.Marshal(b, m, deterministic)
+
+func (m *ReleaseRevision) GetValues() []string {
+	if m != nil {
+		return m.Values
+	}
+	return nil
 }
-func (m *RollbackReleaseResp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RollbackReleaseResp.Merge(m, src)
+
+func (m *ReleaseRevision) GetManifest() string {
+	if m != nil && m.Manifest != nil {
+		return *m.Manifest
+	}
+	return ""
 }
-func (m *RollbackReleaseResp) XXX_Size() int {
-	return xxx_messageInfo_RollbackReleaseResp.Size(m)
+
+func (m *ReleaseRevision) GetRollbackTo() uint32 {
+	if m != nil && m.RollbackTo != nil {
+		return *m.RollbackTo
+	}
+	return 0
 }
-func (m *RollbackReleaseResp) XXX_DiscardUnknown() {
-	xxx_messageInfo_RollbackReleaseResp.DiscardUnknown(m)
+
+func (m *ReleaseRevision) GetOperator() string {
+	if m != nil && m.Operator != nil {
+		return *m.Operator
+	}
+	return ""
 }
 
-var xxx_messageInfo_RollbackReleaseResp proto.InternalMessageInfo
+func (m *ReleaseRevision) GetCreateTime() string {
+	if m != nil && m.CreateTime != nil {
+		return *m.CreateTime
+	}
+	return ""
+}
 
-func (m *RollbackReleaseResp) GetCode() uint32 {
-	if m != nil && m.Code != nil {
-		return *m.Code
+func (m *ReleaseRevision) GetDrifted() bool {
+	if m != nil && m.Drifted != nil {
+		return *m.Drifted
 	}
-	return 0
+	return false
 }
 
-func (m *RollbackReleaseResp) GetMessage() string {
-	if m != nil && m.Message != nil {
-		return *m.Message
+func (m *ReleaseRevision) GetDriftedResources() []string {
+	if m != nil {
+		return m.DriftedResources
+	}
+	return nil
+}
+
+func (m *ReleaseRevision) GetDriftCheckTime() string {
+	if m != nil && m.DriftCheckTime != nil {
+		return *m.DriftCheckTime
 	}
 	return ""
 }
 
-func (m *RollbackReleaseResp) GetResult() bool {
-	if m != nil && m.Result != nil {
-		return *m.Result
+func (m *ReleaseRevision) GetUninstalled() bool {
+	if m != nil && m.Uninstalled != nil {
+		return *m.Uninstalled
 	}
 	return false
 }
@@ -3183,270 +4259,326 @@ func init() {
 	proto.RegisterType((*UpgradeReleaseResp)(nil), "helmmanager.UpgradeReleaseResp")
 	proto.RegisterType((*RollbackReleaseReq)(nil), "helmmanager.RollbackReleaseReq")
 	proto.RegisterType((*RollbackReleaseResp)(nil), "helmmanager.RollbackReleaseResp")
+	proto.RegisterType((*PreviewInstallReleaseReq)(nil), "helmmanager.PreviewInstallReleaseReq")
+	proto.RegisterMapType((map[string]string)(nil), "helmmanager.PreviewInstallReleaseReq.BcsSysVarEntry")
+	proto.RegisterType((*PreviewInstallReleaseResp)(nil), "helmmanager.PreviewInstallReleaseResp")
+	proto.RegisterType((*PreviewUpgradeReleaseReq)(nil), "helmmanager.PreviewUpgradeReleaseReq")
+	proto.RegisterMapType((map[string]string)(nil), "helmmanager.PreviewUpgradeReleaseReq.BcsSysVarEntry")
+	proto.RegisterType((*PreviewUpgradeReleaseResp)(nil), "helmmanager.PreviewUpgradeReleaseResp")
+	proto.RegisterType((*ReleasePreview)(nil), "helmmanager.ReleasePreview")
+	proto.RegisterType((*ResourceDiff)(nil), "helmmanager.ResourceDiff")
+	proto.RegisterType((*ListReleaseHistoryReq)(nil), "helmmanager.ListReleaseHistoryReq")
+	proto.RegisterType((*ListReleaseHistoryResp)(nil), "helmmanager.ListReleaseHistoryResp")
+	proto.RegisterType((*GetReleaseRevisionReq)(nil), "helmmanager.GetReleaseRevisionReq")
+	proto.RegisterType((*GetReleaseRevisionResp)(nil), "helmmanager.GetReleaseRevisionResp")
+	proto.RegisterType((*ReleaseHistoryListData)(nil), "helmmanager.ReleaseHistoryListData")
+	proto.RegisterType((*ReleaseRevision)(nil), "helmmanager.ReleaseRevision")
 }
 
 func init() { proto.RegisterFile("bcs-helm-manager.proto", fileDescriptor_29783c92bc89288d) }
 
 var fileDescriptor_29783c92bc89288d = []byte{
-	// 4126 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5d, 0x8c, 0x14, 0x47,
-	0x7e, 0x57, 0xcf, 0xec, 0xd7, 0xd4, 0x7e, 0xb0, 0xd4, 0x0e, 0x30, 0x34, 0x60, 0xc6, 0xcd, 0x81,
-	0x97, 0xde, 0x8f, 0x81, 0x06, 0xfb, 0xee, 0x06, 0x63, 0xe8, 0x61, 0xc1, 0xe0, 0xb3, 0xef, 0x7c,
-	0x7d, 0xe0, 0xcb, 0x59, 0x17, 0x45, 0xcd, 0x6c, 0x6b, 0x3d, 0xe7, 0x61, 0x67, 0x6e, 0x7a, 0xe0,
-	0x84, 0x2d, 0x24, 0x6c, 0xf3, 0xe9, 0x33, 0x2c, 0xea, 0xb5, 0x0d, 0xc7, 0xae, 0x89, 0xf7, 0x82,
-	0xcd, 0xe6, 0xee, 0xc2, 0xae, 0x2d, 0x07, 0x9b, 0xdd, 0x60, 0xa2, 0x3c, 0x9c, 0xf2, 0x12, 0x45,
-	0x97, 0x44, 0xca, 0x4b, 0x1e, 0x92, 0x68, 0xbb, 0x67, 0x37, 0x2f, 0x89, 0x74, 0x8a, 0x14, 0xe5,
-	0xa4, 0x28, 0xea, 0xaa, 0xea, 0xee, 0xaa, 0xee, 0xde, 0x65, 0x66, 0xf1, 0xc9, 0x6b, 0x8e, 0x27,
-	0x98, 0x7f, 0xfd, 0xab, 0xfa, 0xff, 0xff, 0xd5, 0xff, 0xb3, 0xba, 0x6b, 0xc1, 0xca, 0xc3, 0x59,
-	0xbd, 0xe7, 0x25, 0x2d, 0x7f, 0xa4, 0xe7, 0x88, 0x3a, 0xa8, 0x0e, 0x68, 0xa5, 0xde, 0x62, 0xa9,
-	0x50, 0x2e, 0xc0, 0x66, 0x9b, 0x46, 0x48, 0xfc, 0xda, 0x81, 0x42, 0x61, 0x20, 0xaf, 0xa5, 0xd4,
-	0x62, 0x2e, 0xa5, 0x0e, 0x0e, 0x16, 0xca, 0x6a, 0x39, 0x57, 0x18, 0xd4, 0x31, 0x2b, 0xdf, 0x8d,
-	0xfe, 0xc9, 0xf6, 0x0c, 0x68, 0x83, 0x3d, 0xfa, 0x4f, 0xd4, 0x81, 0x01, 0xad, 0x94, 0x2a, 0x14,
-	0x11, 0x47, 0x08, 0xf7, 0xaa, 0x63, 0x6a, 0x3e, 0xd7, 0xaf, 0x96, 0xb5, 0x94, 0xf3, 0x1f, 0x3c,
-	0x20, 0x7c, 0x07, 0xb4, 0xc8, 0xc7, 0xd4, 0x5c, 0x5e, 0x3d, 0x9c, 0xd7, 0x14, 0xed, 0xc7, 0xe9,
-	0x5d, 0x86, 0xfc, 0x24, 0x48, 0x8b, 0x0c, 0x51, 0x12, 0xad, 0x3f, 0xfd, 0x60, 0x76, 0xe2, 0xe7,
-	0xb4, 0xc0, 0xd6, 0xe8, 0x05, 0xf3, 0xcd, 0x31, 0xeb, 0xca, 0x84, 0x79, 0xe9, 0x43, 0x73, 0x68,
-	0xa2, 0xf2, 0xf6, 0xb8, 0x39, 0xf4, 0xba, 0xf5, 0xce, 0xa4, 0xf0, 0x5f, 0x1c, 0x68, 0xa5, 0x26,
-	0xeb, 0x45, 0xd8, 0x0b, 0xea, 0xb2, 0x85, 0x7e, 0x2d, 0xc1, 0x25, 0xb9, 0xce, 0xd6, 0x0c, 0x6f,
-	0xc8, 0xab, 0x44, 0x44, 0x90, 0x96, 0xcd, 0xde, 0x7d, 0xdb, 0x1c, 0xb9, 0x36, 0xf7, 0xf6, 0xcf,
-	0x66, 0x27, 0x26, 0x2a, 0xef, 0xbf, 0xa6, 0x20, 0x32, 0x4c, 0x83, 0xc6, 0x23, 0x9a, 0xae, 0xab,
-	0x03, 0x5a, 0x22, 0x92, 0xe4, 0x3a, 0x63, 0x99, 0xa4, 0x21, 0xaf, 0x13, 0x1d, 0x9a, 0x04, 0xe9,
-	0x59, 0x33, 0x77, 0xc7, 0xac, 0xd7, 0x26, 0x14, 0x67, 0x10, 0x6e, 0x05, 0x0d, 0x25, 0x4d, 0x3f,
-	0x9a, 0x2f, 0x27, 0xa2, 0x49, 0xae, 0xb3, 0x29, 0xb3, 0xda, 0x90, 0x57, 0x8a, 0x84, 0x24, 0xb5,
-	0xe0, 0x99, 0x95, 0xe9, 0x61, 0xeb, 0xda, 0xa8, 0x42, 0xa8, 0xe9, 0xdd, 0x86, 0xbc, 0x13, 0xec,
-	0x10, 0x59, 0xa1, 0xab, 0x53, 0x19, 0xaf, 0x27, 0xfc, 0xae, 0x1e, 0x74, 0xec, 0x29, 0x69, 0x6a,
-	0x59, 0x53, 0xb4, 0x62, 0x41, 0xcf, 0x95, 0x0b, 0xa5, 0xe3, 0x8a, 0xf6, 0x63, 0xb8, 0x0b, 0xc4,
-	0x8a, 0xa5, 0xc2, 0x8f, 0xb4, 0x6c, 0xf9, 0x40, 0x1f, 0xd2, 0x3e, 0x96, 0x79, 0xd4, 0x90, 0x57,
-	0x88, 0x1e, 0x55, 0x6a, 0x9a, 0x1b, 0xbb, 0x5d, 0x19, 0xb9, 0x99, 0xeb, 0xff, 0xdf, 0x4c, 0x43,
-	0xa9, 0xae, 0x9d, 0x4b, 0xec, 0x56, 0xbc, 0x51, 0xb8, 0x1d, 0xd4, 0x0d, 0xaa, 0x47, 0x28, 0x18,
-	0xe2, 0x22, 0x22, 0x48, 0x2d, 0x33, 0xd3, 0xc3, 0xe6, 0xd4, 0xb0, 0x79, 0xe9, 0x42, 0xe5, 0xc6,
-	0xa4, 0x37, 0x15, 0x0d, 0xc2, 0x2e, 0x50, 0x57, 0x3e, 0x5e, 0xd4, 0x10, 0x02, 0xb1, 0xcc, 0x2a,
-	0x34, 0xcb, 0x26, 0x38, 0xb3, 0x2a, 0x1f, 0x4d, 0x9b, 0xd7, 0x7e, 0xaa, 0x20, 0x1a, 0x4c, 0xdb,
-	0x80, 0x1d, 0x29, 0x94, 0xb5, 0x44, 0x1d, 0x02, 0x4c, 0x30, 0xe4, 0xf5, 0x22, 0x21, 0x49, 0x2b,
-	0xb0, 0xb2, 0x33, 0x9f, 0x4d, 0xcd, 0xde, 0x1d, 0xad, 0x8c, 0xff, 0x14, 0xcf, 0x57, 0xc8, 0x30,
-	0xdc, 0x0d, 0x62, 0xf8, 0x7f, 0x87, 0x94, 0x67, 0x13, 0xf5, 0xe8, 0x69, 0x68, 0xba, 0x47, 0xb5,
-	0x37, 0xcb, 0x9b, 0x68, 0x8e, 0x4e, 0x9a, 0xef, 0x9d, 0x54, 0xbc, 0x61, 0xb8, 0x1d, 0x34, 0x1d,
-	0xd5, 0xb5, 0x12, 0x52, 0xb2, 0x01, 0x2d, 0x90, 0xb0, 0x01, 0x72, 0x89, 0x52, 0xac, 0xf2, 0xf6,
-	0xb8, 0x75, 0xee, 0x53, 0xf3, 0xd2, 0x05, 0xc5, 0x25, 0xc2, 0xad, 0xa0, 0xa9, 0xa8, 0xea, 0xfa,
-	0x4f, 0x0a, 0xa5, 0xfe, 0x44, 0x23, 0x9a, 0xb5, 0xc2, 0x90, 0xa1, 0xe8, 0x12, 0xa5, 0x06, 0x73,
-	0xe2, 0x8c, 0x6d, 0x4f, 0x2e, 0xc5, 0x7e, 0x50, 0xa1, 0xa8, 0x95, 0xd4, 0x72, 0xa1, 0x94, 0x68,
-	0xa2, 0x1e, 0xe4, 0x10, 0xa5, 0x98, 0x35, 0x7c, 0x61, 0xe6, 0xce, 0xe8, 0xec, 0xc9, 0xd3, 0x8a,
-	0x4b, 0x84, 0xcf, 0x80, 0xa6, 0xb2, 0xfa, 0xb2, 0x56, 0x38, 0xa6, 0x95, 0x12, 0x31, 0x04, 0x4f,
-	0xaf, 0x21, 0x77, 0x89, 0x2e, 0x51, 0x5a, 0xef, 0x02, 0x64, 0x5d, 0xfc, 0xa0, 0x72, 0x73, 0xcc,
-	0xfc, 0xf4, 0x63, 0xf3, 0x2f, 0xae, 0x98, 0xa3, 0xe3, 0x95, 0xab, 0xa7, 0x4a, 0x5a, 0xb1, 0xa0,
-	0xb8, 0xac, 0xf0, 0xbb, 0xa0, 0x8d, 0xe8, 0xed, 0x28, 0x0c, 0x90, 0x1c, 0x9b, 0x0d, 0x79, 0x93,
-	0xe8, 0x1b, 0x92, 0xe2, 0x34, 0x6c, 0x8e, 0xde, 0x8a, 0x8f, 0xcb, 0x5b, 0xf2, 0x79, 0x07, 0x8d,
-	0xe6, 0xc0, 0x92, 0xce, 0x10, 0xbb, 0xa4, 0x83, 0x8b, 0xe2, 0xe3, 0x4a, 0x6f, 0x35, 0xe4, 0x5e,
-	0xd0, 0x2d, 0x86, 0x99, 0xb3, 0xb4, 0xc2, 0x3c, 0x37, 0x62, 0x4e, 0x4f, 0x11, 0x23, 0xba, 0x7a,
-	0x8a, 0x38, 0xfc, 0x67, 0x11, 0x10, 0x0f, 0xb2, 0x2f, 0x79, 0xbf, 0x87, 0xcf, 0x81, 0xba, 0x7e,
-	0xb5, 0xac, 0x22, 0xbb, 0x6f, 0x96, 0x56, 0xf5, 0x52, 0xa1, 0xb7, 0xd7, 0xd3, 0x04, 0x0b, 0x81,
-	0x38, 0x1d, 0x00, 0x2a, 0x57, 0x4f, 0x61, 0x0c, 0x88, 0x10, 0x68, 0x30, 0x2d, 0x19, 0x72, 0x0a,
-	0xf4, 0x88, 0xa1, 0x50, 0x04, 0xa0, 0x23, 0x81, 0xe3, 0x7c, 0x1d, 0xe8, 0x38, 0x54, 0xec, 0x7f,
-	0x18, 0x38, 0x1e, 0xb0, 0xc0, 0xe1, 0xba, 0x51, 0xc8, 0xe6, 0x4a, 0x2b, 0xac, 0x91, 0x4f, 0xac,
-	0x77, 0x27, 0xc3, 0xdc, 0x28, 0xc8, 0xfe, 0x40, 0xba, 0x11, 0x06, 0x60, 0x61, 0x37, 0x0a, 0x83,
-	0x22, 0x00, 0x1d, 0x71, 0xa3, 0xdb, 0x1c, 0x68, 0x7f, 0x5a, 0x2b, 0x2f, 0x05, 0x1f, 0x4a, 0xdb,
-	0x09, 0x02, 0x6c, 0x16, 0x03, 0xf2, 0x48, 0x2b, 0x70, 0x41, 0xe1, 0xdf, 0xf6, 0x8f, 0x23, 0x60,
-	0xb9, 0x8f, 0xf7, 0xc1, 0xdc, 0x73, 0xa4, 0x7d, 0xf8, 0x9e, 0xa7, 0x0c, 0xb9, 0x1b, 0x88, 0x62,
-	0x10, 0x87, 0x00, 0x68, 0x64, 0xc3, 0x3f, 0x8d, 0x82, 0xe5, 0xcf, 0xe6, 0x74, 0xdf, 0x8e, 0x77,
-	0x81, 0x3a, 0xbd, 0x50, 0x2a, 0x93, 0xcd, 0xc6, 0xe1, 0xcb, 0x26, 0x48, 0x2d, 0xd6, 0x45, 0xc3,
-	0x9c, 0x1a, 0x9a, 0x1b, 0x9b, 0x32, 0xa7, 0x86, 0x14, 0x44, 0x83, 0xdf, 0x01, 0x75, 0xfd, 0x9a,
-	0x9e, 0x45, 0x70, 0x35, 0x65, 0x76, 0x18, 0xf2, 0x37, 0x44, 0x44, 0x90, 0xb6, 0x60, 0x66, 0x37,
-	0x80, 0x99, 0x27, 0xed, 0x9f, 0xdd, 0xc9, 0x99, 0xe9, 0xd3, 0xe6, 0xe8, 0xb8, 0x3d, 0xd7, 0x7a,
-	0xeb, 0xac, 0x79, 0xf3, 0xaa, 0x75, 0xf9, 0x96, 0x35, 0x7a, 0xde, 0x7a, 0xe7, 0x9c, 0x82, 0xe6,
-	0xc1, 0x8d, 0xa0, 0xae, 0x68, 0xe3, 0x1f, 0x45, 0x5b, 0xb6, 0xdc, 0x90, 0xdb, 0x44, 0x44, 0x90,
-	0x1a, 0xe6, 0xc6, 0xfe, 0xd2, 0x7a, 0x67, 0x52, 0x41, 0xbf, 0x90, 0x90, 0xb9, 0x57, 0x70, 0xd0,
-	0x6c, 0x75, 0x84, 0xcc, 0xbd, 0xa2, 0x49, 0x2d, 0xd6, 0xc4, 0x10, 0xe6, 0x9c, 0x3b, 0x6b, 0x0b,
-	0x99, 0x7b, 0x45, 0x63, 0x6d, 0xb8, 0x7e, 0x11, 0x36, 0xdc, 0x45, 0x6c, 0xb8, 0x81, 0x82, 0x24,
-	0x68, 0xc3, 0xbe, 0xf0, 0xdf, 0x58, 0x45, 0xf8, 0x4f, 0x3f, 0x6e, 0xc8, 0x12, 0xd8, 0x22, 0x06,
-	0xb7, 0x41, 0x5a, 0x63, 0x9d, 0xbf, 0x3d, 0x77, 0x76, 0x28, 0xdc, 0xdc, 0xff, 0x26, 0x02, 0xa0,
-	0x7f, 0xca, 0xd2, 0xb7, 0xf7, 0x43, 0x8c, 0xbd, 0xaf, 0x9f, 0xc7, 0xde, 0x6d, 0xbd, 0xfa, 0xd4,
-	0xb2, 0x5a, 0xb5, 0xdd, 0x3f, 0x61, 0xc8, 0xdb, 0xc0, 0x56, 0x31, 0x04, 0x90, 0x79, 0x40, 0x24,
-	0xe6, 0xff, 0xdf, 0x1c, 0xe8, 0xe8, 0xd3, 0xf2, 0xda, 0x12, 0x29, 0x1b, 0xe8, 0x14, 0x19, 0xad,
-	0x39, 0x45, 0x86, 0x28, 0x62, 0x97, 0x4b, 0xef, 0xcf, 0xfd, 0xec, 0xba, 0xdf, 0x78, 0xe6, 0x38,
-	0x10, 0x0f, 0xb2, 0x2f, 0xfd, 0x0e, 0xd3, 0xc9, 0x69, 0x61, 0xb2, 0x07, 0x74, 0x25, 0x7b, 0xfc,
-	0xb7, 0x1c, 0x58, 0xe1, 0xe3, 0xcf, 0x69, 0xfa, 0x17, 0xb2, 0xcb, 0xdb, 0x40, 0xbd, 0xbd, 0x6f,
-	0x7a, 0x22, 0x92, 0x8c, 0x76, 0xc6, 0x32, 0xeb, 0x0c, 0x99, 0x27, 0xdb, 0x0c, 0xe9, 0x6d, 0x36,
-	0xcf, 0x5d, 0x9e, 0x1d, 0x1b, 0x57, 0x30, 0x6f, 0xfa, 0x9b, 0x86, 0xfc, 0x04, 0xd8, 0x2e, 0x86,
-	0xcb, 0xe4, 0x98, 0x6b, 0xf8, 0xb6, 0xfd, 0x96, 0x03, 0x2b, 0xc3, 0xa6, 0x2d, 0xfd, 0x8d, 0x4b,
-	0x1b, 0xf2, 0xd7, 0xc1, 0xe3, 0xe2, 0x3c, 0xd2, 0xcf, 0xa3, 0x35, 0xd9, 0xc0, 0x7f, 0xe3, 0x00,
-	0x0c, 0xc6, 0x06, 0x37, 0x4d, 0x70, 0xd5, 0xa5, 0x89, 0x48, 0x35, 0x69, 0xa2, 0x13, 0xd4, 0x97,
-	0x0b, 0x65, 0x35, 0x4f, 0x72, 0x0f, 0x34, 0xe4, 0x65, 0x22, 0xa6, 0x48, 0x0d, 0xd6, 0xc9, 0x69,
-	0x7b, 0x55, 0xfc, 0x93, 0x4a, 0xdc, 0xd1, 0x2f, 0x20, 0x71, 0x0b, 0xbf, 0x69, 0x00, 0xc0, 0x9b,
-	0x06, 0x9f, 0x08, 0x5a, 0x66, 0x62, 0x3e, 0xcb, 0x0c, 0xcb, 0x52, 0x91, 0x5a, 0xb2, 0xd4, 0xc3,
-	0x26, 0x85, 0x69, 0x52, 0xb2, 0xa8, 0xed, 0xcc, 0x1c, 0x67, 0x9a, 0x14, 0x87, 0x28, 0xc5, 0x70,
-	0xff, 0x89, 0x22, 0xb0, 0x43, 0x44, 0xe2, 0xa1, 0x2a, 0x3b, 0x73, 0x1c, 0x9d, 0x6e, 0xb8, 0xe2,
-	0x11, 0xa2, 0x14, 0xc3, 0xe5, 0x36, 0x9a, 0xe5, 0x10, 0xe1, 0x4e, 0x00, 0xf0, 0x0a, 0x07, 0x73,
-	0xee, 0x19, 0x06, 0x0a, 0x21, 0x14, 0x59, 0x6a, 0xc1, 0xcf, 0xb3, 0x2e, 0xdf, 0x9a, 0xbb, 0xfc,
-	0x89, 0x42, 0x8d, 0xd8, 0xd3, 0xf1, 0x52, 0x68, 0x7a, 0x33, 0x35, 0xdd, 0x23, 0x4b, 0x2d, 0xf8,
-	0xc1, 0xce, 0x74, 0x6f, 0x24, 0xe4, 0x14, 0xa5, 0xe5, 0x8b, 0x3f, 0x45, 0x69, 0xbd, 0xcf, 0x53,
-	0x14, 0x3b, 0x4c, 0x95, 0xb4, 0x62, 0xc1, 0x36, 0x9c, 0x36, 0x2a, 0x4c, 0x11, 0x9a, 0x04, 0xcd,
-	0x3b, 0xc3, 0xe6, 0xf9, 0x0b, 0x6e, 0xc4, 0x38, 0x5a, 0xca, 0x2b, 0xce, 0xa0, 0xf0, 0x0f, 0x11,
-	0xd0, 0x62, 0x47, 0x8b, 0x3d, 0x2f, 0xa9, 0xa5, 0xb2, 0x1d, 0xef, 0x7f, 0x1f, 0x11, 0x83, 0xc9,
-	0x21, 0xd1, 0x45, 0xe4, 0x90, 0x7d, 0x00, 0x94, 0x5c, 0xc7, 0x47, 0xce, 0x15, 0xcb, 0x6c, 0x42,
-	0xdb, 0xe8, 0x91, 0xe7, 0xab, 0x1a, 0x28, 0x16, 0xa6, 0x76, 0xa8, 0x0f, 0xaf, 0x1d, 0x50, 0xfc,
-	0x61, 0x6b, 0x07, 0xd2, 0x30, 0x30, 0x38, 0x49, 0x3c, 0x5d, 0x32, 0x65, 0x6d, 0xaa, 0x97, 0x82,
-	0x3e, 0x89, 0x80, 0x56, 0x8a, 0x79, 0xe9, 0x57, 0x9c, 0x0a, 0x53, 0x71, 0xf2, 0x4c, 0xa0, 0x46,
-	0x4a, 0xb8, 0xc5, 0x26, 0x8a, 0x49, 0x38, 0x56, 0xaf, 0x72, 0x63, 0xb5, 0xa3, 0x3e, 0x53, 0x6e,
-	0x6e, 0x31, 0xe4, 0x1e, 0xd0, 0x25, 0xb2, 0x40, 0x84, 0xc3, 0x46, 0x72, 0xd8, 0x3f, 0x73, 0xa0,
-	0x95, 0x79, 0xda, 0x97, 0x9c, 0xbe, 0xf6, 0x33, 0xe9, 0x0b, 0x06, 0x51, 0xa9, 0x3a, 0x73, 0xfd,
-	0x67, 0x3d, 0xa8, 0x47, 0x33, 0x16, 0x9d, 0xb4, 0x76, 0x32, 0x1e, 0x10, 0xa1, 0x02, 0xd9, 0x7c,
-	0x1e, 0xc0, 0x18, 0x7e, 0x4d, 0x69, 0x6c, 0x23, 0x88, 0xbe, 0xac, 0x39, 0x6e, 0xd6, 0x61, 0xc8,
-	0xed, 0xa2, 0xfd, 0x5b, 0x8a, 0xa1, 0xed, 0x4a, 0xbe, 0xac, 0x1d, 0x57, 0xec, 0xdf, 0x50, 0x24,
-	0x79, 0x14, 0x3b, 0xd2, 0x4a, 0x43, 0xee, 0x20, 0x79, 0xb4, 0x19, 0x31, 0x32, 0x69, 0xf4, 0x79,
-	0xd0, 0x9a, 0x57, 0xcb, 0x9a, 0x5e, 0x7e, 0x41, 0x2b, 0xe9, 0xb9, 0xc2, 0x20, 0x49, 0x50, 0xa2,
-	0x21, 0x3f, 0x26, 0xb2, 0x23, 0xd2, 0x4a, 0x6b, 0xf4, 0x24, 0x3e, 0xaf, 0xc1, 0xcf, 0x3b, 0x86,
-	0xe9, 0x0a, 0xcb, 0x06, 0xbf, 0x0f, 0xda, 0x31, 0x41, 0x2e, 0x16, 0x9d, 0x45, 0x71, 0xfe, 0xea,
-	0x32, 0xe4, 0x4e, 0x31, 0x30, 0x28, 0xc5, 0xdd, 0x75, 0xd5, 0x62, 0xd1, 0x5d, 0x35, 0xc0, 0x07,
-	0x5f, 0x04, 0xcb, 0x31, 0xad, 0x4f, 0xd3, 0xb3, 0xa5, 0x1c, 0x7a, 0xc7, 0x45, 0xd2, 0x5c, 0xb7,
-	0x21, 0x6f, 0x16, 0x83, 0xa3, 0xd4, 0xd2, 0xfd, 0x1e, 0x55, 0x09, 0x32, 0x32, 0x99, 0x33, 0xb6,
-	0xa8, 0xcc, 0x09, 0x16, 0x99, 0x39, 0x9b, 0xef, 0x2f, 0x73, 0xb6, 0xd4, 0x98, 0x39, 0x85, 0x77,
-	0xa2, 0xa0, 0xc3, 0xf5, 0x7c, 0x02, 0xed, 0x03, 0x9f, 0x5e, 0xb6, 0x31, 0x1e, 0xb1, 0x3e, 0xdc,
-	0x23, 0x16, 0xea, 0x67, 0x1b, 0xaa, 0xce, 0x49, 0xa4, 0x99, 0x0f, 0xc3, 0x58, 0xe2, 0xe9, 0xe8,
-	0x7a, 0xfe, 0x9c, 0x35, 0xfa, 0x2b, 0x2f, 0x35, 0xfd, 0x7d, 0x04, 0xc4, 0x83, 0x73, 0x96, 0x7e,
-	0x86, 0xfa, 0x13, 0x26, 0x43, 0x3d, 0x1a, 0x8c, 0xc5, 0x44, 0x17, 0x37, 0x51, 0x75, 0x1a, 0xf2,
-	0x46, 0x12, 0x9a, 0xd7, 0x05, 0x12, 0x15, 0x46, 0x85, 0x49, 0x57, 0x5f, 0x37, 0xe4, 0xed, 0x40,
-	0x12, 0x43, 0xc1, 0x09, 0x47, 0x94, 0x64, 0xad, 0xff, 0xe0, 0x40, 0x3c, 0x4c, 0x82, 0x2f, 0x39,
-	0x79, 0x1d, 0x64, 0x92, 0xd7, 0xea, 0x79, 0x01, 0xcb, 0x6c, 0x34, 0x64, 0x81, 0x00, 0xc5, 0xfb,
-	0x80, 0x42, 0x9a, 0x92, 0xc6, 0x1c, 0x27, 0x32, 0x33, 0x0a, 0x5a, 0xe8, 0xd9, 0x70, 0x33, 0x31,
-	0x79, 0xce, 0x6b, 0x1d, 0xb0, 0xc9, 0x03, 0x1c, 0xbd, 0x51, 0x0d, 0x8c, 0x0d, 0xfd, 0x71, 0xd0,
-	0x48, 0xa2, 0x2e, 0xb1, 0x98, 0x35, 0x86, 0x9c, 0x10, 0x1d, 0x9a, 0xd4, 0xca, 0x86, 0x7b, 0x87,
-	0x0e, 0x33, 0x00, 0xa8, 0x5e, 0x88, 0x8f, 0x7a, 0x9d, 0x11, 0x45, 0x96, 0x96, 0xe3, 0xc9, 0x74,
-	0x64, 0xa7, 0x86, 0xe1, 0x5e, 0xd0, 0x4c, 0x45, 0x66, 0xe2, 0xe1, 0x1b, 0x0c, 0x39, 0x29, 0xd2,
-	0x74, 0x67, 0x15, 0x3a, 0x88, 0xd3, 0xe3, 0x4c, 0xf8, 0xae, 0x5f, 0x54, 0xf8, 0x6e, 0x58, 0x64,
-	0xf8, 0x6e, 0xbc, 0xbf, 0xf0, 0xdd, 0x54, 0x6b, 0xf8, 0x1e, 0x8e, 0xa2, 0xf7, 0x04, 0x68, 0xab,
-	0xfb, 0xb4, 0xb2, 0x9a, 0xcb, 0x07, 0xce, 0x82, 0xbe, 0x72, 0x81, 0xf6, 0x49, 0xcf, 0xfe, 0x1a,
-	0x1c, 0x2b, 0x5a, 0xe9, 0xd9, 0x5f, 0x33, 0x65, 0xf3, 0xde, 0x54, 0xd7, 0x0c, 0xe9, 0x30, 0xdd,
-	0x58, 0x75, 0x98, 0x7e, 0xd6, 0x90, 0x0f, 0x80, 0xa7, 0xc5, 0x20, 0x96, 0x92, 0x84, 0xd9, 0xf1,
-	0xe9, 0xbe, 0x1b, 0x53, 0xb0, 0xf8, 0x6f, 0x9d, 0x9e, 0x9d, 0xf8, 0xb0, 0x32, 0x7d, 0x06, 0x07,
-	0x28, 0x2f, 0x78, 0x5b, 0x11, 0x00, 0xfd, 0x2b, 0x2d, 0xfd, 0xd0, 0x7d, 0x98, 0x09, 0xdd, 0x89,
-	0x60, 0x24, 0xc2, 0xaa, 0x64, 0xb6, 0x1b, 0xf2, 0x56, 0x12, 0x88, 0x36, 0x57, 0x0d, 0x0e, 0x89,
-	0xde, 0xcf, 0x19, 0xf2, 0x33, 0x60, 0xbf, 0x18, 0x82, 0x4e, 0x6d, 0x40, 0x93, 0x98, 0xfe, 0x41,
-	0x14, 0x34, 0x53, 0xeb, 0xb8, 0xa5, 0x2e, 0x57, 0x45, 0xa9, 0xbb, 0xcd, 0x1f, 0xe6, 0x56, 0xcf,
-	0x6b, 0x66, 0x9e, 0x75, 0x6d, 0xb1, 0x61, 0x55, 0xfb, 0x8f, 0x68, 0xf4, 0x91, 0x36, 0x21, 0x91,
-	0x29, 0xb3, 0x67, 0x7f, 0x31, 0x7b, 0x77, 0x52, 0x21, 0x44, 0xb8, 0x07, 0x80, 0x63, 0x6a, 0xfe,
-	0xa8, 0xa6, 0xef, 0xcb, 0xe5, 0x35, 0x14, 0xe5, 0x49, 0x44, 0xa3, 0xc8, 0x12, 0xc4, 0xff, 0xb7,
-	0xde, 0x3d, 0x3b, 0x33, 0x7d, 0x8b, 0x04, 0x72, 0x6a, 0x1c, 0x0e, 0x80, 0xa6, 0x6c, 0x61, 0xb0,
-	0xac, 0x0d, 0x96, 0xf5, 0x44, 0x3d, 0x4a, 0x14, 0x9b, 0xe6, 0xdb, 0x9e, 0xde, 0x3d, 0x84, 0x71,
-	0xef, 0x60, 0xb9, 0x74, 0x1c, 0x3f, 0xca, 0x9d, 0x2c, 0xc5, 0x1d, 0x3c, 0xad, 0xf3, 0x27, 0xcd,
-	0x4b, 0xbf, 0xc4, 0x0f, 0x54, 0xdc, 0x71, 0xfe, 0x10, 0x68, 0x65, 0xe6, 0xc3, 0x76, 0xdc, 0x63,
-	0x20, 0x40, 0x71, 0x3b, 0xd1, 0x0b, 0xea, 0x91, 0x64, 0x08, 0x35, 0xbf, 0x9d, 0xd8, 0xd2, 0x92,
-	0x05, 0x14, 0xcc, 0x96, 0x8e, 0x7c, 0x83, 0x13, 0x3e, 0xe2, 0x40, 0x33, 0x35, 0x04, 0x3b, 0x99,
-	0x7d, 0x8a, 0x1b, 0xf2, 0x72, 0xb2, 0x4f, 0x31, 0x02, 0xc1, 0xa5, 0x0b, 0x64, 0x97, 0x32, 0x76,
-	0x72, 0x2e, 0xbf, 0x44, 0xb6, 0x08, 0x7d, 0x67, 0x83, 0x08, 0xd2, 0x06, 0xcc, 0x59, 0x19, 0xf9,
-	0xcc, 0x9c, 0xb8, 0xed, 0x68, 0x65, 0x9e, 0xfe, 0xc0, 0x1c, 0xfa, 0x33, 0xdb, 0x3e, 0x3e, 0x9d,
-	0x30, 0x3f, 0x3f, 0xa5, 0x20, 0x56, 0xb8, 0x1d, 0x34, 0x12, 0x05, 0xc9, 0xae, 0x21, 0xd7, 0x73,
-	0x68, 0x52, 0x0b, 0x79, 0xe6, 0x99, 0xd3, 0xe6, 0xcd, 0xdb, 0x8a, 0x43, 0x16, 0xce, 0x46, 0x41,
-	0x1b, 0x7e, 0x05, 0x93, 0xd7, 0x54, 0x5d, 0xfb, 0x3d, 0x16, 0xc5, 0xd9, 0xfc, 0x51, 0xbd, 0xac,
-	0x95, 0x7c, 0xb1, 0xda, 0xa5, 0x4a, 0x4d, 0x73, 0x23, 0x67, 0x2a, 0x9f, 0x5f, 0x3f, 0xd0, 0x47,
-	0xc5, 0x6a, 0x77, 0x14, 0x1e, 0x04, 0x31, 0x74, 0x16, 0x5f, 0x54, 0xb3, 0x1a, 0x09, 0xd5, 0x76,
-	0xd1, 0x29, 0x7a, 0x54, 0x69, 0x13, 0xa9, 0x17, 0x46, 0x6e, 0x5a, 0xef, 0x9f, 0x75, 0xc9, 0xdd,
-	0xc9, 0xb9, 0xe9, 0x2b, 0xb3, 0x37, 0xaf, 0x5b, 0x57, 0x26, 0xcc, 0xd3, 0xe3, 0x73, 0x6f, 0x8c,
-	0x2b, 0xde, 0x14, 0xb8, 0x9b, 0x89, 0xdc, 0xa8, 0xa1, 0xc2, 0x3b, 0xf4, 0xa8, 0x7f, 0xad, 0xe0,
-	0x32, 0xf8, 0x95, 0xf7, 0x7e, 0x43, 0xde, 0x0b, 0xf6, 0x88, 0x3e, 0x0c, 0xa5, 0xad, 0xb4, 0x9b,
-	0x63, 0xc5, 0xdc, 0x16, 0xb2, 0x84, 0xd9, 0xfc, 0xe1, 0xf4, 0x5f, 0x22, 0x60, 0x19, 0xb3, 0xca,
-	0x57, 0xad, 0x0c, 0x5e, 0xeb, 0x3b, 0x51, 0x47, 0x6a, 0xb8, 0x15, 0x70, 0x8f, 0x21, 0x8b, 0x24,
-	0x9e, 0x0a, 0xf7, 0x86, 0x85, 0x04, 0xd2, 0x03, 0x86, 0xbc, 0x0f, 0xf4, 0x89, 0x7e, 0x5c, 0x6a,
-	0x82, 0x97, 0x04, 0xd1, 0xbf, 0x8e, 0x80, 0x0e, 0xf4, 0x8e, 0x1d, 0x8d, 0x32, 0x55, 0xc4, 0xfd,
-	0x59, 0xe6, 0x1f, 0x07, 0x2d, 0x73, 0xd7, 0xe2, 0x2c, 0x93, 0x5a, 0xde, 0x33, 0xd1, 0x03, 0x8c,
-	0x89, 0x3e, 0x5e, 0x8b, 0x89, 0xfa, 0x3f, 0xcf, 0x78, 0xca, 0x90, 0x77, 0x80, 0x6f, 0x8a, 0x61,
-	0x30, 0x48, 0x02, 0x8d, 0xe8, 0x3c, 0x16, 0xfa, 0xeb, 0x08, 0x88, 0x07, 0xe7, 0x2e, 0x7d, 0x33,
-	0xfd, 0xf6, 0x02, 0xe7, 0x89, 0x8c, 0x32, 0x99, 0x47, 0x0c, 0x79, 0x0d, 0x31, 0xd2, 0x8e, 0x10,
-	0x28, 0x88, 0x55, 0x92, 0xcf, 0x84, 0x43, 0xb1, 0x58, 0x18, 0x48, 0x62, 0x8b, 0xff, 0xce, 0x81,
-	0x65, 0x3e, 0xff, 0xf8, 0x92, 0xfb, 0xb3, 0xef, 0x32, 0xfd, 0x59, 0x3c, 0x0c, 0xa2, 0xcc, 0x63,
-	0x86, 0xfc, 0x35, 0x02, 0xce, 0x5a, 0xb6, 0x35, 0x73, 0x7c, 0x8e, 0x69, 0xce, 0x7e, 0x1b, 0x05,
-	0x8d, 0x64, 0x2a, 0x94, 0x98, 0x4c, 0x88, 0x51, 0x46, 0x46, 0xdc, 0xc1, 0xce, 0xa7, 0x2b, 0x97,
-	0xdd, 0xb4, 0x5f, 0x45, 0xa8, 0x57, 0x50, 0x9e, 0x5f, 0x41, 0x3b, 0xbf, 0xa3, 0xef, 0x4d, 0x5d,
-	0x1a, 0xed, 0x3a, 0x3b, 0x41, 0x53, 0x49, 0x3b, 0x96, 0x73, 0x3b, 0xb5, 0x56, 0xdb, 0xb3, 0x1f,
-	0x11, 0x5d, 0x22, 0x9e, 0x7f, 0xfd, 0xd4, 0xcc, 0xd4, 0x45, 0xb7, 0x0b, 0x57, 0xdc, 0x51, 0xb8,
-	0x1d, 0x34, 0xe8, 0x65, 0xb5, 0x7c, 0x54, 0x27, 0x5e, 0xbd, 0xd6, 0x90, 0x57, 0x8b, 0x84, 0x24,
-	0x2d, 0xc3, 0xaf, 0x31, 0xec, 0x79, 0x6f, 0xde, 0xb2, 0x4e, 0xbe, 0xa6, 0x90, 0x01, 0xd8, 0x03,
-	0xea, 0x91, 0x4e, 0xc4, 0x61, 0xd1, 0x0e, 0x61, 0x0a, 0x5b, 0x9e, 0x61, 0x1a, 0xdc, 0xcf, 0xf4,
-	0x93, 0xb8, 0x13, 0x40, 0x87, 0x05, 0x74, 0x3f, 0xb9, 0x8a, 0x96, 0x73, 0xbe, 0xae, 0x92, 0xed,
-	0xb1, 0x1a, 0x6b, 0x7d, 0xb9, 0xb4, 0x17, 0xb4, 0x64, 0xa9, 0x56, 0x9a, 0x34, 0x69, 0x08, 0x30,
-	0x66, 0x40, 0x6a, 0x63, 0x8f, 0x2d, 0x14, 0x66, 0x54, 0xf8, 0x75, 0x1d, 0x68, 0x65, 0x9c, 0xe2,
-	0xe1, 0xde, 0xff, 0xa1, 0xec, 0x3d, 0xfc, 0x16, 0x68, 0xc0, 0xd5, 0x7c, 0x22, 0x86, 0x1a, 0x80,
-	0x6d, 0x86, 0xbc, 0x45, 0x24, 0x24, 0x69, 0x13, 0x06, 0xcd, 0x41, 0x78, 0xee, 0x8d, 0xf1, 0xca,
-	0x9d, 0x8f, 0xad, 0xcb, 0xb7, 0x2a, 0x57, 0x4f, 0xd1, 0x6d, 0x81, 0x42, 0xf8, 0x85, 0x8f, 0x1a,
-	0xc1, 0xf2, 0x03, 0x83, 0x7a, 0x59, 0xcd, 0xe7, 0xa9, 0xda, 0x74, 0x07, 0x63, 0x4c, 0x8f, 0x2d,
-	0x6c, 0x4c, 0xfe, 0x96, 0xfb, 0x40, 0xd0, 0xaa, 0xba, 0xee, 0x6d, 0x55, 0xa1, 0x59, 0x79, 0x7f,
-	0xb0, 0x6a, 0x10, 0x6d, 0xb8, 0xa8, 0xaa, 0x61, 0xb9, 0xbb, 0xd4, 0xbd, 0x0a, 0x5b, 0xef, 0x14,
-	0xc3, 0x2d, 0x6c, 0x45, 0xfa, 0x14, 0x63, 0x1d, 0xd2, 0xcd, 0x5e, 0xee, 0xa3, 0x6b, 0xee, 0x7b,
-	0x53, 0x77, 0x38, 0xf4, 0x68, 0xe3, 0xdb, 0xcc, 0xd1, 0x46, 0xbd, 0xd3, 0x56, 0x08, 0xcc, 0xd1,
-	0x46, 0x9c, 0x5a, 0xd7, 0x7d, 0x53, 0x14, 0x7e, 0xc4, 0xf1, 0x84, 0x63, 0xd9, 0x0d, 0xde, 0xd7,
-	0x51, 0x21, 0x96, 0xed, 0x4d, 0x26, 0x26, 0x4e, 0x9d, 0x72, 0x34, 0xde, 0xdf, 0x29, 0xc7, 0x42,
-	0xdf, 0x1f, 0xcf, 0x4c, 0x4d, 0x51, 0x17, 0x17, 0x16, 0x36, 0x43, 0xda, 0xd8, 0xba, 0x93, 0xb3,
-	0xb7, 0xde, 0x9c, 0x7b, 0xef, 0x7d, 0xf3, 0xd2, 0xc5, 0x99, 0xbf, 0xba, 0x62, 0x9e, 0x3e, 0x57,
-	0x99, 0xba, 0x61, 0x53, 0x7e, 0x79, 0xc5, 0x31, 0x43, 0xbb, 0xb4, 0x51, 0x4b, 0x03, 0x7a, 0x02,
-	0xa0, 0xa5, 0x70, 0x69, 0x63, 0x13, 0xa4, 0x65, 0x73, 0x3f, 0x7f, 0xcf, 0xbc, 0xfe, 0xae, 0x5b,
-	0x1d, 0x29, 0x88, 0x0c, 0x4b, 0x20, 0x76, 0x38, 0xab, 0x7f, 0xef, 0xb8, 0xfe, 0x82, 0x5a, 0x4a,
-	0x34, 0xa3, 0x6c, 0xda, 0xc3, 0x64, 0xd3, 0x80, 0x4d, 0xf7, 0x66, 0x1c, 0x7e, 0xdc, 0xcb, 0xa2,
-	0x13, 0x50, 0x6f, 0x11, 0x69, 0xc5, 0xe1, 0xac, 0x6e, 0x0d, 0x5d, 0x9a, 0xf9, 0x7c, 0xa4, 0x72,
-	0xf5, 0x94, 0x7e, 0x5c, 0x27, 0x8f, 0xf3, 0x38, 0xf8, 0x27, 0x41, 0x1b, 0xbb, 0x46, 0x48, 0x3f,
-	0x1b, 0xa7, 0xfb, 0xd9, 0x18, 0xd5, 0xb5, 0x3a, 0x35, 0x76, 0xd0, 0xd9, 0xa4, 0x14, 0x71, 0x52,
-	0x7c, 0x56, 0xe1, 0x7b, 0x03, 0x66, 0x9e, 0x9b, 0x24, 0xce, 0xe6, 0x15, 0x88, 0xbf, 0x89, 0x00,
-	0xe8, 0x5f, 0x66, 0xe9, 0x97, 0x87, 0xdf, 0xab, 0xba, 0x3c, 0x44, 0xc7, 0x0c, 0xb8, 0x02, 0x4a,
-	0xe4, 0xb0, 0x9e, 0xe8, 0xae, 0x0b, 0xe2, 0x49, 0xf6, 0xe3, 0x22, 0x10, 0xd7, 0x88, 0x4f, 0x1b,
-	0x72, 0x1f, 0xc8, 0x04, 0x3b, 0x97, 0xea, 0x31, 0x75, 0x0e, 0xf4, 0x23, 0xa0, 0xe3, 0xd0, 0x60,
-	0xee, 0x0f, 0x20, 0x12, 0xd2, 0xde, 0x5e, 0x57, 0xad, 0xb7, 0xa7, 0xbf, 0x65, 0xc8, 0xfb, 0xc1,
-	0x3e, 0x31, 0x0c, 0x23, 0x29, 0x35, 0x33, 0x7d, 0xd1, 0xc1, 0xe1, 0xc2, 0x67, 0xb3, 0x77, 0xee,
-	0x84, 0xc3, 0xee, 0x19, 0xf0, 0x1b, 0x11, 0x10, 0x0f, 0x2e, 0xb4, 0xf4, 0xbf, 0xd5, 0x23, 0x07,
-	0xbb, 0xa1, 0xb2, 0xd7, 0x80, 0x02, 0x31, 0x39, 0x3b, 0xf5, 0x1e, 0x2a, 0x0e, 0x94, 0xd4, 0x7e,
-	0xed, 0x61, 0xea, 0x7d, 0x98, 0x7a, 0x1f, 0x98, 0xd4, 0x1b, 0xb0, 0xe9, 0xa5, 0x92, 0x7a, 0x03,
-	0x82, 0xb1, 0x3e, 0x7b, 0xb6, 0x32, 0x75, 0xe3, 0x5e, 0x91, 0xeb, 0x9f, 0x22, 0x00, 0xfa, 0x97,
-	0x79, 0x50, 0x53, 0xef, 0x51, 0xac, 0xe7, 0x7c, 0xa9, 0xf7, 0x19, 0x43, 0x7e, 0x1a, 0xec, 0x15,
-	0x43, 0xe0, 0xa8, 0x01, 0x56, 0x12, 0x0a, 0x87, 0xa3, 0x00, 0x2a, 0x85, 0x7c, 0xfe, 0xb0, 0x9a,
-	0x7d, 0xf9, 0x81, 0x8e, 0x85, 0x19, 0xaa, 0x5f, 0xc6, 0xd7, 0x73, 0x36, 0x19, 0xf2, 0x06, 0xaa,
-	0x5f, 0x5e, 0x35, 0xfb, 0xe1, 0x6b, 0xe6, 0xc8, 0x35, 0x6b, 0xfa, 0xaa, 0x79, 0x6e, 0x12, 0x41,
-	0x8f, 0x07, 0x98, 0xa6, 0xf9, 0x9e, 0xdf, 0x33, 0x86, 0x24, 0x70, 0x72, 0x17, 0x22, 0x04, 0x66,
-	0x69, 0xa5, 0x83, 0x23, 0x7a, 0xae, 0x67, 0xec, 0xb3, 0x1c, 0xe8, 0x08, 0xb0, 0x7f, 0x65, 0xae,
-	0x42, 0x84, 0x89, 0x1e, 0x54, 0x15, 0xaf, 0x20, 0xdd, 0xe2, 0x41, 0xf3, 0x7e, 0x2d, 0x7f, 0xe4,
-	0x39, 0xec, 0x15, 0xf0, 0x13, 0x0e, 0xc4, 0xdc, 0xcb, 0xfa, 0x90, 0xfd, 0x90, 0x82, 0xfe, 0xb3,
-	0x05, 0x3c, 0x3f, 0xdf, 0x90, 0x5e, 0x14, 0x8a, 0x86, 0xfc, 0x2c, 0xfc, 0x5a, 0x35, 0x97, 0xfc,
-	0xf9, 0xaa, 0xb8, 0x5e, 0xff, 0xc7, 0x99, 0xe1, 0xc8, 0x1a, 0xb8, 0x3a, 0x45, 0x3d, 0x32, 0x75,
-	0x6c, 0x6b, 0x4a, 0x75, 0x05, 0x9d, 0xe4, 0x40, 0xbb, 0xff, 0x72, 0x30, 0x4c, 0xb2, 0x6f, 0xf7,
-	0x82, 0xb7, 0xae, 0xf9, 0x47, 0xef, 0xc1, 0xa1, 0x17, 0x85, 0xef, 0x1b, 0xf2, 0x5a, 0xd8, 0x42,
-	0x5f, 0x31, 0xe6, 0x99, 0x5f, 0x48, 0x36, 0x49, 0xe8, 0xf1, 0xcb, 0xe6, 0x65, 0xd8, 0xd4, 0xab,
-	0x6e, 0xf2, 0x3e, 0x91, 0x7a, 0xd5, 0x76, 0xae, 0x13, 0x69, 0x4e, 0x44, 0x22, 0xfb, 0x2f, 0x62,
-	0xfa, 0x44, 0x0e, 0xb9, 0xe1, 0xea, 0x13, 0x39, 0xec, 0x26, 0x27, 0x11, 0x99, 0xbe, 0xce, 0xc9,
-	0x33, 0xbf, 0xb0, 0xc8, 0x7c, 0xed, 0x22, 0x5f, 0xe7, 0x40, 0x2b, 0x73, 0x8f, 0x10, 0xae, 0x63,
-	0xa4, 0xf1, 0xdf, 0xcb, 0xe4, 0x1f, 0x59, 0x68, 0x58, 0x2f, 0x0a, 0x07, 0xb1, 0xa4, 0xd4, 0x45,
-	0x2c, 0x9e, 0xf9, 0x85, 0x24, 0x4d, 0xc1, 0xda, 0x24, 0x45, 0xc8, 0xfa, 0xaf, 0x03, 0xf9, 0x90,
-	0x0d, 0xb9, 0x18, 0xe5, 0x43, 0x36, 0xec, 0x3e, 0x91, 0x6b, 0x0c, 0xde, 0x9d, 0x14, 0x9e, 0xf9,
-	0x85, 0x91, 0x15, 0x6b, 0x47, 0xf6, 0x57, 0x9c, 0xf3, 0x9a, 0xd4, 0x15, 0x98, 0xc5, 0x2e, 0x70,
-	0x15, 0x90, 0x5f, 0xbf, 0xe0, 0xb8, 0x5e, 0x14, 0x7e, 0x60, 0xc8, 0x9d, 0x10, 0xd2, 0x70, 0xe2,
-	0xf3, 0x73, 0x3e, 0x84, 0x86, 0x04, 0xef, 0x84, 0x9b, 0xaa, 0x13, 0x1c, 0x4e, 0x73, 0x00, 0x06,
-	0xef, 0xed, 0x40, 0x61, 0x21, 0x04, 0xf1, 0x6d, 0x26, 0x7e, 0xc3, 0x3d, 0x79, 0xf4, 0xa2, 0xf0,
-	0x43, 0x2c, 0x7a, 0xe0, 0x06, 0x10, 0x1f, 0x42, 0x43, 0xa2, 0x77, 0x89, 0x55, 0x8a, 0x6e, 0x83,
-	0xfd, 0xe7, 0x1c, 0x88, 0xb9, 0x1f, 0xbe, 0xf9, 0x62, 0x1c, 0xfd, 0xd5, 0xbb, 0x2f, 0xc6, 0x31,
-	0x9f, 0x76, 0x0b, 0x9a, 0x21, 0x77, 0xc3, 0x78, 0xe0, 0xfb, 0x6e, 0xf3, 0xad, 0xd3, 0x7c, 0x28,
-	0x15, 0x89, 0xb9, 0x05, 0xf6, 0xfa, 0xc5, 0x44, 0xe3, 0xac, 0x55, 0x78, 0x92, 0x9f, 0x80, 0xff,
-	0xca, 0x81, 0x76, 0xff, 0x47, 0x7a, 0x3e, 0x5b, 0x0e, 0xf9, 0x28, 0xd2, 0x67, 0xcb, 0x61, 0x5f,
-	0xf9, 0x09, 0x67, 0x38, 0x43, 0x7e, 0x0a, 0xae, 0x63, 0xa5, 0x74, 0x8f, 0x5e, 0x89, 0xa9, 0x2c,
-	0x3c, 0x8c, 0x74, 0xda, 0x05, 0x77, 0xd6, 0xa6, 0x13, 0x31, 0xfb, 0x94, 0xd3, 0x0a, 0xfc, 0x8e,
-	0x03, 0x6d, 0xec, 0x97, 0x2c, 0x30, 0x10, 0x37, 0xd8, 0xcf, 0x89, 0x7c, 0xb6, 0x1f, 0xfc, 0x0c,
-	0x46, 0xb8, 0xc1, 0x19, 0xf2, 0x0b, 0xb0, 0xfa, 0x0f, 0x6b, 0xf8, 0xea, 0x59, 0x91, 0xd2, 0xfb,
-	0xe1, 0xbe, 0xc5, 0x29, 0x8d, 0x2b, 0xc9, 0xd4, 0xab, 0x44, 0xf9, 0x13, 0xf0, 0xef, 0x38, 0xd0,
-	0x4c, 0x1d, 0xe2, 0xc0, 0x35, 0x21, 0x6e, 0xed, 0x14, 0x2c, 0xfc, 0xda, 0xf9, 0x07, 0xf5, 0xa2,
-	0xf0, 0x3a, 0x67, 0xc8, 0x07, 0x61, 0x67, 0xb5, 0xef, 0xae, 0xf9, 0xaa, 0x39, 0x91, 0xca, 0x1b,
-	0xe1, 0x86, 0xa0, 0x8b, 0x21, 0xa6, 0xd4, 0xab, 0x6e, 0x9d, 0x77, 0x02, 0x56, 0x9c, 0xbf, 0x17,
-	0x40, 0xbf, 0xa3, 0x49, 0x06, 0xf3, 0x00, 0xfb, 0x7e, 0xd8, 0x67, 0xb0, 0x61, 0x6f, 0x3e, 0x85,
-	0x0b, 0x9c, 0x21, 0xef, 0x85, 0xeb, 0x43, 0xde, 0x7f, 0x32, 0x3b, 0x19, 0xf6, 0x82, 0xd4, 0xae,
-	0x77, 0xfc, 0x5b, 0x18, 0x62, 0xb7, 0x21, 0xfa, 0xe0, 0x7d, 0x43, 0xd5, 0xb0, 0x6f, 0x0f, 0xe1,
-	0xff, 0x70, 0xa0, 0x8d, 0x3d, 0x8d, 0xf4, 0xd9, 0x6d, 0xe0, 0xc4, 0xd3, 0x67, 0xb7, 0xc1, 0xa3,
-	0x4c, 0xe1, 0x3a, 0x67, 0xc8, 0x7f, 0x04, 0x45, 0xeb, 0xfc, 0x8d, 0xd9, 0xb1, 0xb7, 0x16, 0xda,
-	0x98, 0x24, 0x39, 0x88, 0xe1, 0x6b, 0xe0, 0x45, 0x6a, 0xef, 0x11, 0x9e, 0x5a, 0xa4, 0xda, 0x64,
-	0x15, 0x3b, 0x82, 0x9e, 0x8a, 0x80, 0x76, 0xff, 0x59, 0x90, 0xbf, 0x76, 0x09, 0x9e, 0x97, 0xf9,
-	0x6b, 0x97, 0x90, 0xc3, 0x24, 0xe1, 0x17, 0x9c, 0x21, 0xff, 0x10, 0x76, 0x57, 0xa1, 0xd4, 0x51,
-	0x67, 0x3a, 0x5f, 0x13, 0x37, 0x02, 0x61, 0xaf, 0xb0, 0x7b, 0x91, 0x20, 0xb8, 0xeb, 0xd8, 0x30,
-	0xd8, 0x16, 0xc0, 0x76, 0x81, 0x3e, 0x0b, 0x08, 0x34, 0xde, 0x3e, 0x0b, 0x08, 0xb6, 0x90, 0x35,
-	0x58, 0x00, 0x69, 0x53, 0xf9, 0x1a, 0x78, 0xef, 0xd3, 0x02, 0xc8, 0x2a, 0xb6, 0xea, 0xff, 0xc7,
-	0x81, 0x65, 0xbe, 0x3e, 0x03, 0xfa, 0xee, 0xee, 0x07, 0xfa, 0x2d, 0x3e, 0xb9, 0x30, 0x03, 0x89,
-	0xdb, 0x2f, 0xc2, 0xae, 0x2a, 0x34, 0x2a, 0x91, 0xd9, 0x7c, 0x2d, 0xcc, 0x48, 0xff, 0x3e, 0x61,
-	0xd7, 0x22, 0xf5, 0x77, 0x96, 0x49, 0x73, 0x62, 0xe6, 0x07, 0x86, 0xbc, 0x0f, 0xae, 0x03, 0x71,
-	0xbb, 0x79, 0x4a, 0x92, 0xee, 0x29, 0x29, 0x3f, 0x7f, 0x20, 0xd9, 0x57, 0xc8, 0x4a, 0xf5, 0x5b,
-	0x7a, 0xb7, 0xf6, 0x6e, 0x11, 0x39, 0x4e, 0x6a, 0x57, 0x8b, 0xc5, 0x7c, 0x2e, 0x8b, 0xfe, 0x5e,
-	0x5c, 0xea, 0x47, 0x7a, 0x61, 0x30, 0x1d, 0xa0, 0xbc, 0xd8, 0xd6, 0x9b, 0xda, 0x41, 0x09, 0xf4,
-	0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0xe5, 0x2a, 0xfd, 0x2c, 0xc1, 0x4e, 0x00, 0x00,
+	// 4792 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x6b, 0x8c, 0x14, 0x47,
+	0x7e, 0x4f, 0xcf, 0x3e, 0xa7, 0xf6, 0xc1, 0x52, 0xfb, 0x60, 0x68, 0x5e, 0x4b, 0x73, 0xac, 0x97,
+	0x86, 0xdd, 0x59, 0x1a, 0x6c, 0xdf, 0x2d, 0xc6, 0xd0, 0xc3, 0x82, 0xc1, 0x67, 0xdf, 0xf9, 0xfa,
+	0xc0, 0x97, 0xb3, 0x2e, 0x3a, 0x35, 0x33, 0xcd, 0x32, 0xc7, 0x30, 0x33, 0x37, 0x3d, 0x8b, 0x85,
+	0x11, 0x12, 0xb6, 0x79, 0xfa, 0x78, 0xa9, 0xb1, 0x0d, 0x07, 0x6b, 0x62, 0x2e, 0xd8, 0x90, 0xbb,
+	0x0b, 0x8b, 0x2d, 0x07, 0x9b, 0xdd, 0x60, 0xa2, 0x7c, 0x88, 0xf2, 0x21, 0x51, 0x74, 0x49, 0xa4,
+	0x7c, 0x49, 0xa4, 0x24, 0xda, 0x9e, 0xd9, 0xcd, 0x97, 0x44, 0x3a, 0x45, 0x8a, 0x72, 0x52, 0x74,
+	0xea, 0xaa, 0xea, 0xee, 0xaa, 0xee, 0x9e, 0xd9, 0x99, 0x05, 0xcb, 0x6b, 0xcc, 0xa7, 0x99, 0xfe,
+	0xd7, 0xbf, 0x5e, 0xff, 0xfa, 0xfd, 0x5f, 0x55, 0xd5, 0x0d, 0xba, 0x76, 0xc7, 0xf5, 0xbe, 0xbd,
+	0x5a, 0x6a, 0x7f, 0xdf, 0x7e, 0x35, 0xad, 0x0e, 0x6b, 0xb9, 0xfe, 0x6c, 0x2e, 0x93, 0xcf, 0xc0,
+	0x26, 0x8b, 0x46, 0x48, 0xfc, 0xe2, 0xe1, 0x4c, 0x66, 0x38, 0xa5, 0x45, 0xd5, 0x6c, 0x32, 0xaa,
+	0xa6, 0xd3, 0x99, 0xbc, 0x9a, 0x4f, 0x66, 0xd2, 0x3a, 0x66, 0xe5, 0xd7, 0xa0, 0x9f, 0x78, 0xdf,
+	0xb0, 0x96, 0xee, 0xd3, 0x5f, 0x55, 0x87, 0x87, 0xb5, 0x5c, 0x34, 0x93, 0x45, 0x1c, 0x01, 0xdc,
+	0x0b, 0x0e, 0xa8, 0xa9, 0x64, 0x42, 0xcd, 0x6b, 0x51, 0xfb, 0x0f, 0x2e, 0x10, 0xbe, 0x0b, 0x9a,
+	0xe5, 0x03, 0x6a, 0x32, 0xa5, 0xee, 0x4e, 0x69, 0x8a, 0xf6, 0xd3, 0xc1, 0x4d, 0x86, 0xfc, 0x0c,
+	0x18, 0x14, 0x19, 0xa2, 0x24, 0x16, 0xfe, 0xf8, 0x83, 0xa9, 0xb1, 0x5f, 0xd0, 0x03, 0x2e, 0x8c,
+	0x9e, 0x31, 0xdf, 0xba, 0x56, 0xb8, 0x34, 0x66, 0x9e, 0xff, 0xd0, 0x3c, 0x37, 0x56, 0x7c, 0xfb,
+	0xa6, 0x79, 0xee, 0x8d, 0xc2, 0x3b, 0xb7, 0x85, 0xff, 0xe6, 0x40, 0x0b, 0x55, 0x59, 0xcf, 0xc2,
+	0x7e, 0x50, 0x1b, 0xcf, 0x24, 0xb4, 0x08, 0xd7, 0xcd, 0xf5, 0xb6, 0xc4, 0x78, 0x43, 0x5e, 0x20,
+	0x22, 0x82, 0x34, 0x6f, 0xea, 0xfe, 0xdb, 0xe6, 0x95, 0xab, 0xd3, 0x6f, 0xff, 0x7c, 0x6a, 0x6c,
+	0xac, 0xf8, 0xfe, 0xeb, 0x0a, 0x22, 0xc3, 0x41, 0xd0, 0xb0, 0x5f, 0xd3, 0x75, 0x75, 0x58, 0x8b,
+	0x84, 0xba, 0xb9, 0xde, 0x70, 0xac, 0xdb, 0x90, 0x97, 0x88, 0x36, 0x4d, 0x82, 0x74, 0xad, 0xc9,
+	0xfb, 0xd7, 0x0a, 0xaf, 0x8f, 0x29, 0x76, 0x21, 0x5c, 0x0b, 0xea, 0x73, 0x9a, 0x3e, 0x92, 0xca,
+	0x47, 0x6a, 0xba, 0xb9, 0xde, 0xc6, 0xd8, 0x42, 0x43, 0xee, 0x12, 0x09, 0x49, 0x6a, 0xc6, 0x35,
+	0x8b, 0x13, 0x17, 0x0a, 0x57, 0x47, 0x15, 0x42, 0x1d, 0xdc, 0x6c, 0xc8, 0x1b, 0xc1, 0x06, 0x91,
+	0x1d, 0x74, 0x65, 0x53, 0xc6, 0xed, 0x09, 0xbf, 0xab, 0x03, 0xed, 0x5b, 0x72, 0x9a, 0x9a, 0xd7,
+	0x14, 0x2d, 0x9b, 0xd1, 0x93, 0xf9, 0x4c, 0xee, 0xa0, 0xa2, 0xfd, 0x14, 0x6e, 0x02, 0xe1, 0x6c,
+	0x2e, 0xf3, 0x13, 0x2d, 0x9e, 0xdf, 0x31, 0x84, 0x66, 0x1f, 0x8e, 0x2d, 0x37, 0xe4, 0x4e, 0xd1,
+	0xa5, 0x4a, 0x8d, 0xd3, 0xd7, 0xee, 0x16, 0xaf, 0xdc, 0x4a, 0x26, 0xfe, 0x2f, 0x56, 0x9f, 0xab,
+	0x6d, 0xe3, 0x22, 0x9b, 0x15, 0xb7, 0x14, 0xae, 0x07, 0xb5, 0x69, 0x75, 0x3f, 0x25, 0x86, 0x0e,
+	0x11, 0x11, 0xa4, 0xe6, 0xc9, 0x89, 0x0b, 0xe6, 0xf8, 0x05, 0xf3, 0xfc, 0x99, 0xe2, 0x8d, 0xdb,
+	0x6e, 0x55, 0x54, 0x08, 0x57, 0x83, 0xda, 0xfc, 0xc1, 0xac, 0x86, 0x24, 0x10, 0x8e, 0x2d, 0x40,
+	0xb5, 0x2c, 0x82, 0x5d, 0xab, 0xf8, 0xd1, 0x84, 0x79, 0xf5, 0x67, 0x0a, 0xa2, 0xc1, 0x41, 0x4b,
+	0x60, 0xfb, 0x33, 0x79, 0x2d, 0x52, 0x8b, 0x04, 0x26, 0x18, 0xf2, 0x32, 0x91, 0x90, 0xa4, 0x4e,
+	0x3c, 0xd9, 0xc9, 0xcf, 0xc6, 0xa7, 0xee, 0x8f, 0x16, 0x6f, 0xfe, 0x0c, 0xd7, 0x57, 0x48, 0x31,
+	0xdc, 0x0c, 0xc2, 0xf8, 0xdf, 0x2e, 0xe5, 0x85, 0x48, 0x1d, 0xea, 0x0d, 0x55, 0x77, 0xa9, 0x12,
+	0xa4, 0x2b, 0x9a, 0xa3, 0xb7, 0xcd, 0xf7, 0x8e, 0x28, 0x6e, 0x31, 0x5c, 0x0f, 0x1a, 0x47, 0x74,
+	0x2d, 0x87, 0x26, 0x59, 0x8f, 0x1a, 0x88, 0x58, 0x02, 0x72, 0x88, 0x52, 0xb8, 0xf8, 0xf6, 0xcd,
+	0xc2, 0xc9, 0x4f, 0xcd, 0xf3, 0x67, 0x14, 0x87, 0x08, 0xd7, 0x82, 0xc6, 0xac, 0xaa, 0xeb, 0xaf,
+	0x66, 0x72, 0x89, 0x48, 0x03, 0xaa, 0xd5, 0x69, 0xc8, 0x50, 0x74, 0x88, 0x52, 0xbd, 0x39, 0x76,
+	0xdc, 0xc2, 0x93, 0x43, 0xb1, 0x3a, 0xca, 0x64, 0xb5, 0x9c, 0x9a, 0xcf, 0xe4, 0x22, 0x8d, 0x54,
+	0x47, 0x36, 0x51, 0x0a, 0x17, 0x2e, 0x9c, 0x99, 0xbc, 0x37, 0x3a, 0x75, 0xe4, 0x98, 0xe2, 0x10,
+	0xe1, 0xf3, 0xa0, 0x31, 0xaf, 0xee, 0xd3, 0x32, 0x07, 0xb4, 0x5c, 0x24, 0x8c, 0xc4, 0xd3, 0x6f,
+	0xc8, 0xab, 0x45, 0x87, 0x28, 0x2d, 0x73, 0x04, 0x54, 0x38, 0xfb, 0x41, 0xf1, 0xd6, 0x35, 0xf3,
+	0xd3, 0x8f, 0xcd, 0x3f, 0xbb, 0x64, 0x8e, 0xde, 0x2c, 0x5e, 0x3e, 0x9a, 0xd3, 0xb2, 0x19, 0xc5,
+	0x61, 0x85, 0xdf, 0x03, 0xad, 0x64, 0xde, 0xf6, 0x84, 0x01, 0x1a, 0xc7, 0x2a, 0x43, 0xee, 0x11,
+	0x3d, 0x45, 0x52, 0x07, 0x2d, 0x36, 0x7b, 0xde, 0x8a, 0x87, 0xcb, 0x6d, 0xf2, 0x25, 0x5b, 0x1a,
+	0x4d, 0xbe, 0x26, 0xed, 0x22, 0xb6, 0x49, 0x5b, 0x2e, 0x8a, 0x87, 0x6b, 0x70, 0xad, 0x21, 0xf7,
+	0x83, 0x35, 0x62, 0x10, 0x9c, 0xa5, 0x4e, 0xf3, 0xe4, 0x15, 0x73, 0x62, 0x9c, 0x80, 0xe8, 0xf2,
+	0x51, 0xa2, 0xf0, 0x9f, 0x85, 0x40, 0x87, 0x9f, 0x7d, 0xce, 0xeb, 0x3d, 0x7c, 0x11, 0xd4, 0x26,
+	0xd4, 0xbc, 0x8a, 0x70, 0xdf, 0x24, 0x2d, 0xe8, 0xa7, 0x4c, 0x6f, 0xbf, 0x3b, 0x13, 0x3c, 0x08,
+	0xc4, 0x69, 0x0b, 0xa0, 0x78, 0xf9, 0x28, 0x96, 0x01, 0x19, 0x04, 0x2a, 0x1c, 0x94, 0x0c, 0x39,
+	0x0a, 0xfa, 0xc4, 0x40, 0x51, 0xf8, 0x44, 0x47, 0x0c, 0xc7, 0xa9, 0x5a, 0xd0, 0xbe, 0x2b, 0x9b,
+	0x78, 0x6c, 0x38, 0x1e, 0x31, 0xc3, 0xe1, 0xa8, 0x51, 0xc0, 0xe2, 0x4a, 0x9d, 0x85, 0x2b, 0x9f,
+	0x14, 0xde, 0xbd, 0x1d, 0xa4, 0x46, 0x7e, 0xf6, 0x47, 0x52, 0x8d, 0xb0, 0x00, 0xca, 0xab, 0x51,
+	0x90, 0x28, 0x7c, 0xa2, 0x23, 0x6a, 0x74, 0x97, 0x03, 0x6d, 0xcf, 0x69, 0xf9, 0xb9, 0xa0, 0x43,
+	0x83, 0x96, 0x83, 0x00, 0xab, 0x44, 0xdf, 0x78, 0xa4, 0x4e, 0x1c, 0x50, 0x78, 0x97, 0xfd, 0xe3,
+	0x10, 0x98, 0xef, 0xe1, 0x7d, 0x34, 0xd7, 0x1c, 0xcd, 0x3e, 0x78, 0xcd, 0xa3, 0x86, 0xbc, 0x06,
+	0x88, 0xa2, 0x5f, 0x0e, 0x3e, 0xa1, 0x91, 0x05, 0xff, 0xb4, 0x06, 0xcc, 0x7f, 0x21, 0xa9, 0x7b,
+	0x56, 0x7c, 0x35, 0xa8, 0xd5, 0x33, 0xb9, 0x7c, 0x84, 0xa3, 0xcc, 0x97, 0x45, 0x90, 0x9a, 0x0b,
+	0x67, 0x0d, 0x73, 0xfc, 0xdc, 0xf4, 0xb5, 0x71, 0x73, 0xfc, 0x9c, 0x82, 0x68, 0xf0, 0xbb, 0xa0,
+	0x36, 0xa1, 0xe9, 0x71, 0x24, 0xae, 0xc6, 0xd8, 0x06, 0x43, 0xfe, 0xa6, 0x88, 0x08, 0xd2, 0x00,
+	0x66, 0x76, 0x0c, 0x98, 0x79, 0xc4, 0x7a, 0x5c, 0xd3, 0x3d, 0x39, 0x71, 0xcc, 0x1c, 0xbd, 0x69,
+	0xd5, 0x2d, 0x9c, 0x3e, 0x61, 0xde, 0xba, 0x5c, 0xb8, 0x78, 0xa7, 0x30, 0x7a, 0xaa, 0xf0, 0xce,
+	0x49, 0x05, 0xd5, 0x83, 0x2b, 0x41, 0x6d, 0xd6, 0x92, 0x7f, 0x0d, 0x5a, 0xb2, 0xf9, 0x86, 0xdc,
+	0x2a, 0x22, 0x82, 0x54, 0x3f, 0x7d, 0xed, 0xcf, 0x0b, 0xef, 0xdc, 0x56, 0xd0, 0x13, 0x1a, 0x64,
+	0xf2, 0x35, 0x6c, 0x34, 0x5b, 0xec, 0x41, 0x26, 0x5f, 0xd3, 0xa4, 0xe6, 0xc2, 0xd8, 0x39, 0xcc,
+	0x39, 0x7d, 0xc2, 0x1a, 0x64, 0xf2, 0x35, 0x8d, 0xc5, 0x70, 0xdd, 0x2c, 0x30, 0xbc, 0x1a, 0xd4,
+	0x52, 0x26, 0x72, 0x41, 0x09, 0x0c, 0x7b, 0xcc, 0x7f, 0x43, 0x05, 0xe6, 0x7f, 0xf0, 0x49, 0x43,
+	0x96, 0xc0, 0x80, 0xe8, 0x5f, 0x06, 0x69, 0x51, 0xe1, 0xd4, 0xdd, 0xe9, 0x13, 0xe7, 0x82, 0xe1,
+	0xfe, 0x57, 0x21, 0x00, 0xbd, 0x55, 0xe6, 0x3e, 0xde, 0x77, 0x31, 0x78, 0x5f, 0x56, 0x02, 0xef,
+	0xd6, 0xbc, 0x86, 0xd4, 0xbc, 0x5a, 0x31, 0xee, 0x9f, 0x32, 0xe4, 0x75, 0x60, 0xad, 0x18, 0x20,
+	0x90, 0x12, 0x42, 0x24, 0xf0, 0xff, 0x1f, 0x0e, 0xb4, 0x0f, 0x69, 0x29, 0x6d, 0x8e, 0x84, 0x0d,
+	0xb4, 0x8b, 0xac, 0xa9, 0xda, 0x45, 0x06, 0x4c, 0xc4, 0x0a, 0x97, 0xde, 0x9f, 0xfe, 0xf9, 0x75,
+	0x2f, 0x78, 0xa6, 0x39, 0xd0, 0xe1, 0x67, 0x9f, 0xfb, 0x19, 0xa6, 0xed, 0xd3, 0x82, 0xc6, 0xee,
+	0x9b, 0x2b, 0x59, 0xe3, 0xbf, 0xe1, 0x40, 0xa7, 0x87, 0x3f, 0xa9, 0xe9, 0x0f, 0x65, 0x95, 0xd7,
+	0x81, 0x3a, 0x6b, 0xdd, 0xf4, 0x48, 0xa8, 0xbb, 0xa6, 0x37, 0x1c, 0x5b, 0x62, 0xc8, 0x3c, 0x59,
+	0x66, 0x48, 0x2f, 0xb3, 0x79, 0xf2, 0xe2, 0xd4, 0xb5, 0x9b, 0x0a, 0xe6, 0x1d, 0xfc, 0x96, 0x21,
+	0x3f, 0x05, 0xd6, 0x8b, 0xc1, 0x63, 0xb2, 0xe1, 0x1a, 0xbc, 0x6c, 0xbf, 0xe5, 0x40, 0x57, 0x50,
+	0xb5, 0xb9, 0xbf, 0x70, 0x83, 0x86, 0xfc, 0x34, 0x78, 0x52, 0x2c, 0x31, 0xfa, 0x12, 0xb3, 0x26,
+	0x0b, 0xf8, 0xef, 0x1c, 0x80, 0x7e, 0xdb, 0xe0, 0xb8, 0x09, 0xae, 0x32, 0x37, 0x11, 0xaa, 0xc4,
+	0x4d, 0xf4, 0x82, 0xba, 0x7c, 0x26, 0xaf, 0xa6, 0x88, 0xef, 0x81, 0x86, 0x3c, 0x4f, 0xc4, 0x14,
+	0xa9, 0xbe, 0x70, 0x64, 0xc2, 0x6a, 0x15, 0x3f, 0x52, 0x8e, 0xbb, 0xe6, 0x21, 0x38, 0x6e, 0xe1,
+	0x37, 0xf5, 0x00, 0xb8, 0xd5, 0xe0, 0x53, 0x7e, 0x64, 0x46, 0x4a, 0x21, 0x33, 0xc8, 0x4b, 0x85,
+	0xaa, 0xf1, 0x52, 0x8f, 0x93, 0x14, 0x26, 0x49, 0x89, 0xa3, 0xb4, 0x33, 0x76, 0x90, 0x49, 0x52,
+	0x6c, 0xa2, 0x14, 0xc6, 0xf9, 0x27, 0xb2, 0xc0, 0x36, 0x11, 0x0d, 0x0f, 0x45, 0xd9, 0xb1, 0x83,
+	0x91, 0x30, 0x55, 0xcb, 0x26, 0x4a, 0x61, 0x1c, 0x6e, 0xa3, 0x5a, 0x36, 0x11, 0x6e, 0x04, 0x00,
+	0xb7, 0xb0, 0x33, 0xe9, 0xec, 0x61, 0x20, 0x13, 0x42, 0x91, 0xa5, 0x66, 0xdc, 0x5f, 0xe1, 0xe2,
+	0x9d, 0xe9, 0x8b, 0x9f, 0x28, 0x54, 0x89, 0x55, 0x1d, 0x37, 0x85, 0xaa, 0x37, 0x51, 0xd5, 0x5d,
+	0xb2, 0xd4, 0x8c, 0x3b, 0xb6, 0xab, 0xbb, 0x25, 0x01, 0xbb, 0x28, 0xcd, 0x0f, 0x7f, 0x17, 0xa5,
+	0xe5, 0x01, 0x77, 0x51, 0x2c, 0x33, 0x65, 0xed, 0xfe, 0x58, 0xc0, 0x69, 0xa5, 0xcc, 0x14, 0xa1,
+	0x49, 0xd0, 0xbc, 0x77, 0xc1, 0x3c, 0x75, 0xc6, 0xb1, 0x18, 0x23, 0xb9, 0x94, 0x62, 0x17, 0x0a,
+	0xff, 0x10, 0x02, 0xcd, 0x96, 0xb5, 0xd8, 0xb2, 0x57, 0xcd, 0xe5, 0x2d, 0x7b, 0xff, 0x45, 0x58,
+	0x0c, 0xc6, 0x87, 0xd4, 0xcc, 0xc2, 0x87, 0x6c, 0x03, 0x20, 0xe7, 0x28, 0x3e, 0x52, 0xae, 0x70,
+	0xac, 0x07, 0x2d, 0xa3, 0x4b, 0x2e, 0x15, 0x35, 0x50, 0x2c, 0x4c, 0xec, 0x50, 0x17, 0x1c, 0x3b,
+	0x20, 0xfb, 0xc3, 0xc6, 0x0e, 0x24, 0x61, 0x60, 0xe4, 0x24, 0xf1, 0x74, 0xc8, 0x14, 0xb7, 0xa8,
+	0xae, 0x0b, 0xfa, 0x24, 0x04, 0x5a, 0x28, 0xe6, 0xb9, 0x1f, 0x71, 0x2a, 0x4c, 0xc4, 0xc9, 0x33,
+	0x86, 0x1a, 0x4d, 0xc2, 0x09, 0x36, 0x91, 0x4d, 0xc2, 0xb6, 0x7a, 0x81, 0x63, 0xab, 0xed, 0xe9,
+	0x33, 0xe1, 0xe6, 0x80, 0x21, 0xf7, 0x81, 0xd5, 0x22, 0x2b, 0x88, 0x60, 0xb1, 0x11, 0x1f, 0xf6,
+	0xcf, 0x1c, 0x68, 0x61, 0x7a, 0xfb, 0x92, 0xdd, 0xd7, 0x76, 0xc6, 0x7d, 0x41, 0xbf, 0x54, 0x2a,
+	0xf6, 0x5c, 0xff, 0x55, 0x07, 0xea, 0x50, 0x8d, 0x59, 0x3b, 0xad, 0x8d, 0x8c, 0x06, 0x84, 0x28,
+	0x43, 0x56, 0x4a, 0x03, 0x18, 0xe0, 0x57, 0xe5, 0xc6, 0x56, 0x82, 0x9a, 0x7d, 0x9a, 0xad, 0x66,
+	0xed, 0x86, 0xdc, 0x26, 0x5a, 0xcf, 0x52, 0x18, 0x2d, 0x57, 0xf7, 0x3e, 0xed, 0xa0, 0x62, 0x3d,
+	0x43, 0x91, 0xf8, 0x51, 0xac, 0x48, 0x5d, 0x86, 0xdc, 0x4e, 0xfc, 0x68, 0x13, 0x62, 0x64, 0xdc,
+	0xe8, 0x4b, 0xa0, 0x25, 0xa5, 0xe6, 0x35, 0x3d, 0xff, 0xb2, 0x96, 0xd3, 0x93, 0x99, 0x34, 0x71,
+	0x50, 0xa2, 0x21, 0x3f, 0x21, 0xb2, 0x25, 0x52, 0x57, 0x61, 0xf4, 0x08, 0xde, 0xaf, 0xc1, 0xfd,
+	0x1d, 0xc0, 0x74, 0x85, 0x65, 0x83, 0x3f, 0x00, 0x6d, 0x98, 0x20, 0x67, 0xb3, 0x76, 0xa3, 0xd8,
+	0x7f, 0xad, 0x36, 0xe4, 0x5e, 0xd1, 0x57, 0x28, 0x75, 0x38, 0xed, 0xaa, 0xd9, 0xac, 0xd3, 0xaa,
+	0x8f, 0x0f, 0xbe, 0x02, 0xe6, 0x63, 0xda, 0x90, 0xa6, 0xc7, 0x73, 0x49, 0x74, 0xc6, 0x45, 0xdc,
+	0xdc, 0x1a, 0x43, 0x5e, 0x25, 0xfa, 0x4b, 0xa9, 0xa6, 0x13, 0x2e, 0x55, 0xf1, 0x33, 0x32, 0x9e,
+	0x33, 0x3c, 0x2b, 0xcf, 0x09, 0x66, 0xe9, 0x39, 0x9b, 0x1e, 0xcc, 0x73, 0x36, 0x57, 0xe9, 0x39,
+	0x85, 0x77, 0x6a, 0x40, 0xbb, 0xa3, 0xf9, 0x44, 0xb4, 0x8f, 0xbc, 0x7b, 0x59, 0xc7, 0x68, 0xc4,
+	0xb2, 0x60, 0x8d, 0x28, 0x97, 0xcf, 0xd6, 0x57, 0xec, 0x93, 0x48, 0x32, 0x1f, 0x24, 0x63, 0x89,
+	0xa7, 0xad, 0xeb, 0xa9, 0x93, 0x85, 0xd1, 0x5f, 0xbb, 0xae, 0xe9, 0xef, 0x43, 0xa0, 0xc3, 0x5f,
+	0x67, 0xee, 0x7b, 0xa8, 0x1f, 0x33, 0x1e, 0x6a, 0xb9, 0xdf, 0x16, 0x93, 0xb9, 0x38, 0x8e, 0xaa,
+	0xd7, 0x90, 0x57, 0x12, 0xd3, 0xbc, 0xc4, 0xe7, 0xa8, 0xb0, 0x54, 0x18, 0x77, 0xf5, 0xb4, 0x21,
+	0xaf, 0x07, 0x92, 0x18, 0x28, 0x9c, 0x60, 0x89, 0x12, 0xaf, 0xf5, 0x9f, 0x1c, 0xe8, 0x08, 0x1a,
+	0xc1, 0x97, 0xec, 0xbc, 0x76, 0x32, 0xce, 0x6b, 0x61, 0x49, 0x81, 0xc5, 0x56, 0x1a, 0xb2, 0x40,
+	0x04, 0xc5, 0x7b, 0x04, 0x85, 0x66, 0x4a, 0x12, 0x73, 0xec, 0xc8, 0xcc, 0x1a, 0xd0, 0x4c, 0xd7,
+	0x86, 0xab, 0x08, 0xe4, 0x39, 0x37, 0x75, 0xc0, 0x90, 0x07, 0xd8, 0x7a, 0x5b, 0xff, 0x09, 0xd0,
+	0x9f, 0x04, 0x0d, 0xc4, 0xea, 0x12, 0xc4, 0x2c, 0x32, 0xe4, 0x88, 0x68, 0xd3, 0xa4, 0x16, 0xd6,
+	0xdc, 0xdb, 0x74, 0x18, 0x03, 0x40, 0x75, 0x4d, 0x7c, 0x8d, 0x9b, 0x19, 0x51, 0x64, 0x69, 0x3e,
+	0xae, 0x4c, 0x5b, 0x76, 0xaa, 0x18, 0x6e, 0x05, 0x4d, 0x94, 0x65, 0x26, 0x1a, 0xbe, 0xc2, 0x90,
+	0xbb, 0x45, 0x9a, 0x6e, 0xb7, 0x42, 0x91, 0x14, 0xba, 0x9c, 0x31, 0xdf, 0x75, 0xb3, 0x32, 0xdf,
+	0xf5, 0xb3, 0x34, 0xdf, 0x0d, 0x0f, 0x66, 0xbe, 0x1b, 0xab, 0x35, 0xdf, 0x17, 0x6a, 0xd0, 0x39,
+	0x01, 0x5a, 0xea, 0x21, 0x2d, 0xaf, 0x26, 0x53, 0xbe, 0xbd, 0xa0, 0xaf, 0x9c, 0xa1, 0x7d, 0xc6,
+	0xc5, 0x5f, 0xbd, 0x8d, 0xa2, 0x2e, 0x17, 0x7f, 0x4d, 0x14, 0xe6, 0xdd, 0xaa, 0x0e, 0x0c, 0x69,
+	0x33, 0xdd, 0x50, 0xb1, 0x99, 0x7e, 0xc1, 0x90, 0x77, 0x80, 0xe7, 0x44, 0xbf, 0x2c, 0x25, 0x09,
+	0xb3, 0xe3, 0xdd, 0x7d, 0xc7, 0xa6, 0xe0, 0xe1, 0x9f, 0x3e, 0x36, 0x35, 0xf6, 0x61, 0x71, 0xe2,
+	0x38, 0x36, 0x50, 0xae, 0xf1, 0x2e, 0x84, 0x00, 0xf4, 0xb6, 0x34, 0xf7, 0x4d, 0xf7, 0x6e, 0xc6,
+	0x74, 0x47, 0xfc, 0x96, 0x08, 0x4f, 0x25, 0xb6, 0xde, 0x90, 0xd7, 0x12, 0x43, 0xb4, 0xaa, 0x62,
+	0xe1, 0x10, 0xeb, 0xfd, 0xa2, 0x21, 0x3f, 0x0f, 0xb6, 0x8b, 0x01, 0xd2, 0xa9, 0x4e, 0xd0, 0xc4,
+	0xa6, 0x7f, 0x50, 0x03, 0x9a, 0xa8, 0x76, 0x9c, 0x50, 0x97, 0xab, 0x20, 0xd4, 0x5d, 0xe7, 0x35,
+	0x73, 0x0b, 0x4b, 0xc2, 0xcc, 0x45, 0xd7, 0x80, 0x25, 0x56, 0x35, 0xb1, 0x5f, 0xa3, 0xb7, 0xb4,
+	0x09, 0x89, 0x54, 0x99, 0x3a, 0xf1, 0xcb, 0xa9, 0xfb, 0xb7, 0x15, 0x42, 0x84, 0x5b, 0x00, 0x38,
+	0xa0, 0xa6, 0x46, 0x34, 0x7d, 0x5b, 0x32, 0xa5, 0x21, 0x2b, 0x4f, 0x2c, 0x1a, 0x45, 0x96, 0x20,
+	0xfe, 0x5f, 0x78, 0xf7, 0xc4, 0xe4, 0xc4, 0x1d, 0x62, 0xc8, 0xa9, 0x72, 0x38, 0x0c, 0x1a, 0xe3,
+	0x99, 0x74, 0x5e, 0x4b, 0xe7, 0xf5, 0x48, 0x1d, 0x72, 0x14, 0x3d, 0xa5, 0x96, 0xa7, 0x7f, 0x0b,
+	0x61, 0xdc, 0x9a, 0xce, 0xe7, 0x0e, 0xe2, 0xae, 0x9c, 0xca, 0x52, 0x87, 0x2d, 0xcf, 0xc2, 0xa9,
+	0x23, 0xe6, 0xf9, 0x5f, 0xe1, 0x0e, 0x15, 0xa7, 0x9c, 0xdf, 0x05, 0x5a, 0x98, 0xfa, 0xb0, 0x0d,
+	0xe7, 0x18, 0x48, 0xa0, 0x38, 0x9d, 0xe8, 0x07, 0x75, 0x68, 0x64, 0x91, 0x50, 0x00, 0x4e, 0xac,
+	0xd1, 0x92, 0x06, 0x14, 0xcc, 0x36, 0x18, 0xfa, 0x26, 0x27, 0x7c, 0xc4, 0x81, 0x26, 0xaa, 0x08,
+	0xf6, 0x32, 0xeb, 0xd4, 0x61, 0xc8, 0xf3, 0xc9, 0x3a, 0x85, 0x89, 0x08, 0xce, 0x9f, 0x21, 0xab,
+	0x14, 0xb3, 0x9c, 0x73, 0x7e, 0x2f, 0x59, 0x22, 0x74, 0xcf, 0x06, 0x11, 0xa4, 0x15, 0x98, 0xb3,
+	0x78, 0xe5, 0x33, 0x73, 0xec, 0xae, 0x3d, 0x2b, 0xf3, 0xd8, 0x07, 0xe6, 0xb9, 0x3f, 0xb1, 0xf0,
+	0xf1, 0xe9, 0x98, 0xf9, 0xf9, 0x51, 0x05, 0xb1, 0xc2, 0xf5, 0xa0, 0x81, 0x4c, 0x90, 0xac, 0x1a,
+	0x52, 0x3d, 0x9b, 0x26, 0x35, 0x93, 0x3e, 0x8f, 0x1f, 0x33, 0x6f, 0xdd, 0x55, 0x6c, 0xb2, 0x70,
+	0xa2, 0x06, 0xb4, 0xe2, 0x23, 0x98, 0x94, 0xa6, 0xea, 0xda, 0x17, 0x18, 0x14, 0xc7, 0x53, 0x23,
+	0x7a, 0x5e, 0xcb, 0x79, 0x6c, 0xb5, 0x43, 0x95, 0x1a, 0xa7, 0xaf, 0x1c, 0x2f, 0x7e, 0x7e, 0x7d,
+	0xc7, 0x10, 0x65, 0xab, 0x9d, 0x52, 0xb8, 0x13, 0x84, 0xd1, 0x5e, 0x7c, 0x56, 0x8d, 0x6b, 0xc4,
+	0x54, 0x5b, 0x41, 0xa7, 0xe8, 0x52, 0xa5, 0x1e, 0x12, 0x2f, 0x5c, 0xb9, 0x55, 0x78, 0xff, 0x84,
+	0x43, 0x5e, 0xd3, 0x3d, 0x3d, 0x71, 0x69, 0xea, 0xd6, 0x75, 0x6b, 0xbf, 0xf3, 0xd8, 0xcd, 0xe9,
+	0x37, 0x6f, 0x2a, 0x6e, 0x15, 0xb8, 0x99, 0xb1, 0xdc, 0x28, 0xa1, 0xc2, 0x2b, 0xb4, 0xdc, 0xdb,
+	0x96, 0xbf, 0x19, 0x7c, 0xe4, 0xbd, 0xdd, 0x90, 0xb7, 0x82, 0x2d, 0xa2, 0x47, 0x86, 0xd2, 0x5a,
+	0x5a, 0xcd, 0xf1, 0xc4, 0x9c, 0x14, 0x32, 0x87, 0xd9, 0xbc, 0xe6, 0xf4, 0x5f, 0x42, 0x60, 0x1e,
+	0xd3, 0xca, 0x57, 0x2d, 0x0c, 0x5e, 0xec, 0xd9, 0x51, 0x47, 0xd3, 0x70, 0x22, 0xe0, 0x3e, 0x43,
+	0x16, 0x89, 0x3d, 0x15, 0x66, 0x16, 0x0b, 0x31, 0xa4, 0x3b, 0x0c, 0x79, 0x1b, 0x18, 0x12, 0xbd,
+	0x72, 0xa9, 0x4a, 0xbc, 0xc4, 0x88, 0xfe, 0x65, 0x08, 0xb4, 0xa3, 0x33, 0x76, 0x54, 0xca, 0x44,
+	0x11, 0x0f, 0x86, 0xcc, 0x3f, 0xf2, 0x23, 0x73, 0xd3, 0xec, 0x90, 0x49, 0x35, 0xef, 0x42, 0x74,
+	0x07, 0x03, 0xd1, 0x27, 0xab, 0x81, 0xa8, 0xf7, 0x7a, 0xc6, 0xb3, 0x86, 0xbc, 0x01, 0x7c, 0x4b,
+	0x0c, 0x12, 0x83, 0x24, 0xd0, 0x12, 0x2d, 0x81, 0xd0, 0xbf, 0x0e, 0x81, 0x0e, 0x7f, 0xdd, 0xb9,
+	0x0f, 0xd3, 0xef, 0x94, 0xd9, 0x4f, 0x64, 0x26, 0x13, 0x5b, 0x6a, 0xc8, 0x8b, 0x08, 0x48, 0xdb,
+	0x03, 0x44, 0x41, 0x50, 0x49, 0xae, 0x09, 0x07, 0xca, 0xa2, 0xbc, 0x20, 0x09, 0x16, 0xff, 0x83,
+	0x03, 0xf3, 0x3c, 0xfa, 0xf1, 0x25, 0xe7, 0x67, 0xdf, 0x63, 0xf2, 0xb3, 0x8e, 0x20, 0x11, 0xc5,
+	0x9e, 0x30, 0xe4, 0x6f, 0x10, 0xe1, 0x2c, 0x66, 0x53, 0x33, 0x5b, 0xe7, 0x98, 0xe4, 0xec, 0xb7,
+	0x35, 0xa0, 0x81, 0x54, 0x85, 0x12, 0xe3, 0x09, 0xb1, 0x94, 0x11, 0x88, 0xdb, 0xd9, 0xfa, 0x74,
+	0xe4, 0xb2, 0x99, 0xd6, 0xab, 0x10, 0x75, 0x04, 0xe5, 0xea, 0x15, 0xb4, 0xfc, 0x3b, 0xba, 0x6f,
+	0xea, 0xd0, 0x68, 0xd5, 0xd9, 0x08, 0x1a, 0x73, 0xda, 0x81, 0xa4, 0x93, 0xa9, 0xb5, 0x58, 0x9a,
+	0xbd, 0x54, 0x74, 0x88, 0xb8, 0xfe, 0xf5, 0xa3, 0x93, 0xe3, 0x67, 0x9d, 0x2c, 0x5c, 0x71, 0x4a,
+	0xe1, 0x7a, 0x50, 0xaf, 0xe7, 0xd5, 0xfc, 0x88, 0x4e, 0xb4, 0x7a, 0xb1, 0x21, 0x2f, 0x14, 0x09,
+	0x49, 0x9a, 0x87, 0x8f, 0x31, 0xac, 0x7a, 0x6f, 0xdd, 0x29, 0x1c, 0x79, 0x5d, 0x21, 0x05, 0xb0,
+	0x0f, 0xd4, 0xa1, 0x39, 0x11, 0x85, 0x45, 0x2b, 0x84, 0x29, 0x6c, 0x78, 0x86, 0x69, 0x70, 0x3b,
+	0x93, 0x4f, 0xe2, 0x4c, 0x00, 0x6d, 0x16, 0x50, 0x64, 0x69, 0x01, 0x3d, 0xce, 0x52, 0x59, 0x25,
+	0x9b, 0x63, 0x35, 0x54, 0x7b, 0xb8, 0xb4, 0x15, 0x34, 0xc7, 0xa9, 0x54, 0x9a, 0x24, 0x69, 0x48,
+	0x60, 0x4c, 0x81, 0xd4, 0xca, 0x6e, 0x5b, 0x28, 0x4c, 0xa9, 0x70, 0xba, 0x0e, 0xb4, 0x30, 0x4a,
+	0xf1, 0x78, 0xed, 0xbf, 0x2e, 0x6b, 0x0f, 0xbf, 0x0d, 0xea, 0x71, 0x34, 0x1f, 0x09, 0xa3, 0x04,
+	0x60, 0x9d, 0x21, 0x0f, 0x88, 0x84, 0x24, 0xf5, 0x60, 0xa1, 0xd9, 0x12, 0x9e, 0x7e, 0xf3, 0x66,
+	0xf1, 0xde, 0xc7, 0x85, 0x8b, 0x77, 0x8a, 0x97, 0x8f, 0xd2, 0x69, 0x81, 0x42, 0xf8, 0x61, 0x04,
+	0x34, 0x24, 0x72, 0xc9, 0x3d, 0x79, 0x2d, 0x81, 0x76, 0x99, 0x1b, 0x15, 0xfb, 0x11, 0x8a, 0xa0,
+	0x8d, 0xfc, 0x55, 0x34, 0x3d, 0x33, 0x92, 0x8b, 0x6b, 0x7a, 0xa4, 0xc9, 0xea, 0x50, 0xf1, 0xd1,
+	0x85, 0x8f, 0x1a, 0xc0, 0xfc, 0x1d, 0x69, 0x3d, 0xaf, 0xa6, 0x52, 0x54, 0x84, 0xbb, 0x81, 0x81,
+	0xe4, 0x13, 0xe5, 0x21, 0xe9, 0x4d, 0xdc, 0x77, 0xf8, 0xb1, 0xb9, 0x7a, 0x66, 0x6c, 0x06, 0xfa,
+	0xf6, 0xed, 0xfe, 0xd8, 0x43, 0xb4, 0x84, 0xee, 0x52, 0xa5, 0xf9, 0x4e, 0x53, 0x33, 0x85, 0xc7,
+	0xee, 0x5e, 0x88, 0x13, 0x1e, 0x8b, 0xf4, 0x5e, 0xc8, 0x12, 0x34, 0x37, 0xab, 0xb9, 0x8f, 0xae,
+	0x3a, 0xa7, 0xaf, 0x4e, 0x71, 0xe0, 0x06, 0xc9, 0x77, 0x98, 0x0d, 0x92, 0x3a, 0x3b, 0x39, 0x11,
+	0x98, 0x0d, 0x92, 0x0e, 0xaa, 0x5d, 0xe7, 0xbc, 0x29, 0x78, 0xa3, 0xe4, 0x29, 0x5b, 0x3f, 0xea,
+	0xdd, 0x3b, 0x56, 0x01, 0xfa, 0xe1, 0x56, 0x26, 0x8a, 0x42, 0xed, 0x95, 0x34, 0x3c, 0xd8, 0x5e,
+	0x49, 0xb9, 0x5b, 0xcc, 0x93, 0xe3, 0xe3, 0xd4, 0xeb, 0x0f, 0xe5, 0xc1, 0x4c, 0x43, 0x76, 0x4d,
+	0xf7, 0xd4, 0x9d, 0xb7, 0xa6, 0xdf, 0x7b, 0xdf, 0x3c, 0x7f, 0x76, 0xf2, 0x2f, 0x2e, 0x99, 0xc7,
+	0x4e, 0x16, 0xc7, 0x6f, 0x58, 0x94, 0x5f, 0x5d, 0x72, 0xc0, 0xdc, 0x0f, 0x6a, 0xd5, 0xdc, 0xb0,
+	0x1e, 0x01, 0xa8, 0x29, 0x1c, 0x20, 0x59, 0x04, 0x69, 0xde, 0xf4, 0x2f, 0xde, 0x33, 0xaf, 0xbf,
+	0xeb, 0xc4, 0x58, 0x0a, 0x22, 0xc3, 0x1c, 0x08, 0xef, 0x8e, 0xeb, 0xdf, 0x3f, 0xa8, 0xbf, 0xac,
+	0xe6, 0x10, 0xb6, 0x9b, 0xa4, 0x3e, 0xc6, 0x27, 0xfb, 0x30, 0xdd, 0x1f, 0xb3, 0xf9, 0x71, 0x46,
+	0x8c, 0xf6, 0x51, 0xdd, 0x46, 0xa4, 0xce, 0xdd, 0x71, 0xbd, 0x70, 0xee, 0xfc, 0xe4, 0xe7, 0x57,
+	0x8a, 0x97, 0x8f, 0xea, 0x07, 0x75, 0xd2, 0x9d, 0xcb, 0xc1, 0x3f, 0x03, 0x5a, 0xd9, 0x36, 0x02,
+	0xb2, 0xe2, 0x0e, 0x3a, 0x2b, 0x0e, 0x53, 0xb9, 0xaf, 0x1d, 0xa9, 0xfb, 0x95, 0x4d, 0x8a, 0x12,
+	0x55, 0xc7, 0x3b, 0x1e, 0x9e, 0x73, 0x34, 0xf3, 0xe4, 0x6d, 0xa2, 0x6c, 0x6e, 0x98, 0xf9, 0x9b,
+	0x10, 0x80, 0xde, 0x66, 0xe6, 0x7e, 0x90, 0xf9, 0xfd, 0x8a, 0x83, 0x4c, 0xb4, 0x59, 0x81, 0x98,
+	0xa5, 0x48, 0x12, 0xcf, 0x13, 0xbd, 0x31, 0x83, 0x78, 0xba, 0x13, 0x88, 0x89, 0x44, 0x9a, 0xcf,
+	0x19, 0xf2, 0x10, 0x88, 0xf9, 0xf3, 0x9f, 0xca, 0x65, 0x6a, 0x1f, 0x0b, 0x84, 0x40, 0xfb, 0xae,
+	0x74, 0xf2, 0x6b, 0x60, 0x09, 0x69, 0x6d, 0xaf, 0xad, 0x54, 0xdb, 0x07, 0xbf, 0x6d, 0xc8, 0xdb,
+	0xc1, 0x36, 0x31, 0x48, 0x46, 0x52, 0x74, 0x72, 0xe2, 0xac, 0x2d, 0x87, 0x33, 0x9f, 0x4d, 0xdd,
+	0xbb, 0x17, 0x2c, 0x76, 0x17, 0xc0, 0x6f, 0x5a, 0x6f, 0x33, 0xa4, 0x93, 0x5f, 0x35, 0x08, 0xdb,
+	0xdb, 0xc3, 0x81, 0x63, 0xaf, 0x42, 0x0a, 0x04, 0x72, 0x96, 0xeb, 0xdd, 0x95, 0x1d, 0xce, 0xa9,
+	0x09, 0xed, 0xb1, 0xeb, 0x7d, 0xec, 0x7a, 0x1f, 0x19, 0xd7, 0xeb, 0xc3, 0xf4, 0x5c, 0x71, 0xbd,
+	0xbe, 0x81, 0xb1, 0x3a, 0x7b, 0xa2, 0x38, 0x7e, 0x63, 0x26, 0xcb, 0xf5, 0x4f, 0x21, 0x00, 0xbd,
+	0xcd, 0x3c, 0xaa, 0xae, 0x77, 0x04, 0xcf, 0xb3, 0x94, 0xeb, 0x7d, 0xde, 0x90, 0x9f, 0x03, 0x5b,
+	0xc5, 0x00, 0x71, 0x54, 0x21, 0x56, 0x62, 0x0a, 0x2f, 0xd4, 0x00, 0xa8, 0x64, 0x52, 0xa9, 0xdd,
+	0x6a, 0x7c, 0xdf, 0x23, 0x6d, 0x0b, 0x63, 0x54, 0xd6, 0x8d, 0x5f, 0xf2, 0xe9, 0x31, 0xe4, 0x15,
+	0x54, 0xd6, 0xbd, 0x60, 0xea, 0xc3, 0xd7, 0xcd, 0x2b, 0x57, 0x0b, 0x13, 0x97, 0xcd, 0x93, 0xb7,
+	0x91, 0xe8, 0x71, 0x01, 0x93, 0x7a, 0xcf, 0x78, 0x2b, 0x32, 0xc0, 0x81, 0x93, 0x37, 0x2a, 0x02,
+	0xc4, 0x2c, 0x75, 0xd9, 0x72, 0x44, 0xfd, 0xba, 0x60, 0x9f, 0xe2, 0x40, 0xbb, 0x8f, 0xfd, 0x2b,
+	0xf3, 0x42, 0x45, 0xd0, 0xd0, 0xfd, 0x53, 0x25, 0x00, 0xfc, 0xb8, 0x06, 0x44, 0x5e, 0xb2, 0x24,
+	0xac, 0xbd, 0xea, 0xcf, 0x86, 0x21, 0x0d, 0x43, 0x82, 0xae, 0xc5, 0x3e, 0x74, 0xd1, 0x80, 0x59,
+	0xec, 0x03, 0x0c, 0x0d, 0x82, 0xc5, 0x3e, 0x87, 0x48, 0x3b, 0xb6, 0xa5, 0x7e, 0xc7, 0xc6, 0x38,
+	0xaa, 0x0e, 0xc6, 0x51, 0xd9, 0x6e, 0x28, 0xe2, 0x71, 0x43, 0xae, 0x8b, 0xe1, 0xbd, 0x2e, 0x86,
+	0x72, 0x24, 0x5d, 0xac, 0x23, 0x71, 0x7c, 0x02, 0xa4, 0x7d, 0x02, 0xb1, 0xfb, 0x8a, 0xdf, 0xee,
+	0xaf, 0x67, 0x2c, 0x49, 0x29, 0xf9, 0x79, 0xcc, 0xff, 0x43, 0xb3, 0xeb, 0x82, 0xc1, 0x81, 0x85,
+	0x25, 0x3a, 0xd5, 0xb3, 0xd6, 0x1c, 0x5c, 0x94, 0x12, 0x24, 0x46, 0x3c, 0x48, 0x74, 0x71, 0xd6,
+	0xc5, 0xe2, 0xcc, 0x31, 0x9d, 0x51, 0xc6, 0x74, 0x2e, 0x0a, 0x32, 0x9d, 0x64, 0x08, 0x64, 0x57,
+	0x97, 0x42, 0x92, 0x3f, 0xb8, 0x7b, 0x8c, 0xa4, 0x6a, 0x90, 0x34, 0x53, 0x20, 0xf1, 0x85, 0x20,
+	0x29, 0xc0, 0xbb, 0x7f, 0x49, 0x48, 0xfa, 0x57, 0x0e, 0xb4, 0xb2, 0x05, 0xb3, 0xc0, 0x0f, 0xef,
+	0xdd, 0xe6, 0xa5, 0x1c, 0x49, 0x2f, 0x98, 0x17, 0x1f, 0xc9, 0xe5, 0xac, 0x43, 0x7a, 0xc6, 0x27,
+	0x29, 0x5e, 0xb2, 0xd5, 0xca, 0x7e, 0x35, 0x9d, 0xdc, 0xa3, 0xe9, 0x64, 0xeb, 0x56, 0x71, 0x9e,
+	0xe1, 0xd3, 0xd6, 0x9b, 0x30, 0xf6, 0x36, 0x63, 0x7d, 0xc0, 0xf5, 0x35, 0x7b, 0xb3, 0x71, 0x28,
+	0xb9, 0x67, 0x8f, 0xe2, 0xf2, 0x0a, 0x57, 0x43, 0xa0, 0x99, 0x2e, 0xb3, 0x66, 0xb7, 0x2f, 0x99,
+	0x4e, 0xd8, 0xb3, 0xb3, 0xfe, 0x5b, 0x18, 0x56, 0xb3, 0xc9, 0x97, 0xe9, 0x1b, 0x1a, 0x0a, 0x45,
+	0x71, 0x24, 0x52, 0x53, 0x4a, 0x22, 0xb5, 0x5e, 0x89, 0x74, 0x81, 0x7a, 0x35, 0x8e, 0xee, 0x95,
+	0xe1, 0x99, 0x90, 0x27, 0xbc, 0x6e, 0xaa, 0x6e, 0x6f, 0x34, 0x2b, 0xe4, 0x89, 0xde, 0x67, 0x6d,
+	0x60, 0xf7, 0x59, 0x79, 0xd0, 0x98, 0xd0, 0xb2, 0xa9, 0xcc, 0x41, 0x2d, 0x61, 0xeb, 0x83, 0xfd,
+	0x8c, 0xe5, 0x9e, 0x4e, 0x68, 0x39, 0x2d, 0x81, 0xaf, 0x0e, 0x2b, 0xce, 0xb3, 0x35, 0xe6, 0x54,
+	0xf2, 0x00, 0x79, 0x3d, 0x46, 0x41, 0xff, 0x2d, 0x5a, 0x22, 0xb9, 0x67, 0x0f, 0xbe, 0xf8, 0xab,
+	0xa0, 0xff, 0xc2, 0x7d, 0x0e, 0x74, 0x52, 0x1b, 0x1b, 0xdb, 0x93, 0xba, 0xfd, 0x2e, 0x27, 0x63,
+	0x15, 0xb8, 0x00, 0xab, 0x50, 0x06, 0x11, 0x41, 0x12, 0x83, 0xe4, 0x6c, 0x0d, 0x2f, 0x3f, 0xfa,
+	0x6f, 0xd1, 0xd0, 0x41, 0x5a, 0x1d, 0xa6, 0x59, 0xff, 0x61, 0x3f, 0x80, 0xc9, 0x74, 0x3c, 0x35,
+	0x92, 0xd0, 0x9c, 0x14, 0x58, 0x4b, 0x20, 0x79, 0x35, 0x2a, 0x01, 0x25, 0xc2, 0x45, 0x0e, 0x74,
+	0x05, 0xcd, 0xe0, 0xa1, 0x29, 0xd5, 0xd3, 0x8c, 0x52, 0xad, 0x08, 0x52, 0x2a, 0xd2, 0xa9, 0x7d,
+	0x8e, 0x48, 0x94, 0xeb, 0x4d, 0x0e, 0x74, 0xba, 0xc7, 0x93, 0x36, 0xd0, 0xbf, 0x08, 0xd9, 0xf2,
+	0xde, 0x90, 0xcf, 0xd5, 0x40, 0xe1, 0x3c, 0x07, 0xba, 0x82, 0x46, 0xf1, 0xd0, 0xe4, 0x33, 0x30,
+	0xf3, 0x05, 0x04, 0xa7, 0x57, 0x2c, 0x98, 0x93, 0x1c, 0xe8, 0x0a, 0x96, 0x9c, 0x83, 0x12, 0x2e,
+	0x00, 0x25, 0x21, 0x0a, 0x25, 0x1d, 0xcc, 0xa9, 0xaa, 0x7d, 0x82, 0x3a, 0xc0, 0x9c, 0xa0, 0x56,
+	0x32, 0x94, 0x13, 0xb5, 0x60, 0x9e, 0xa7, 0xe4, 0xa1, 0x7b, 0xd0, 0x32, 0xab, 0x53, 0xce, 0x52,
+	0x90, 0xb3, 0x2f, 0x62, 0x29, 0xf0, 0x93, 0xeb, 0x4f, 0x1b, 0x68, 0x7f, 0x2a, 0x04, 0x9d, 0x1d,
+	0x79, 0x0e, 0x86, 0x4a, 0x79, 0x4f, 0xda, 0xee, 0x02, 0x8f, 0xdd, 0xb5, 0xbc, 0x3b, 0x89, 0x70,
+	0x77, 0x66, 0x90, 0xdd, 0x68, 0x51, 0x28, 0x0a, 0xe3, 0xad, 0x9b, 0x3d, 0xde, 0x7a, 0x29, 0x73,
+	0x5b, 0x15, 0xbd, 0xd1, 0xc6, 0x5c, 0x47, 0xa5, 0x6c, 0x5e, 0xeb, 0xcc, 0x67, 0x4b, 0xf3, 0x82,
+	0xcf, 0x96, 0x60, 0x0f, 0x68, 0x45, 0xb4, 0x2d, 0x7b, 0xb5, 0xf8, 0x3e, 0xd4, 0x53, 0x1b, 0xea,
+	0xc9, 0x43, 0x85, 0xdd, 0xa0, 0x69, 0x84, 0x32, 0x27, 0xf3, 0x51, 0x8f, 0x34, 0x49, 0xfa, 0xdb,
+	0x25, 0xa0, 0x69, 0xbb, 0x96, 0xda, 0xff, 0x22, 0xc6, 0x0b, 0xfc, 0x84, 0x03, 0x61, 0xe7, 0x8b,
+	0x5c, 0x90, 0x75, 0x37, 0xf4, 0xb7, 0xc9, 0x78, 0xbe, 0x54, 0x91, 0x9e, 0x15, 0xb2, 0x86, 0xfc,
+	0x02, 0xfc, 0x46, 0x25, 0x5f, 0xf2, 0xe2, 0x2b, 0xe2, 0x7a, 0xe3, 0x1f, 0x27, 0x2f, 0x84, 0x16,
+	0xc1, 0x85, 0x51, 0xaa, 0xcb, 0xe8, 0x81, 0xb5, 0x51, 0xd5, 0x19, 0xe8, 0x6d, 0x0e, 0xb4, 0x79,
+	0xbf, 0x00, 0x04, 0xbb, 0xd9, 0x2b, 0x7c, 0xfe, 0x4f, 0x2b, 0xf1, 0xcb, 0x67, 0xe0, 0xd0, 0xb3,
+	0xc2, 0x0f, 0x0c, 0x79, 0x31, 0x6c, 0xa6, 0xbf, 0x23, 0xc4, 0x33, 0x4f, 0x68, 0x6c, 0x92, 0xd0,
+	0xe7, 0x1d, 0x9b, 0x1b, 0x0d, 0x46, 0x0f, 0x39, 0x81, 0xe3, 0xe1, 0xe8, 0x21, 0x4b, 0x7d, 0x0e,
+	0x0f, 0x72, 0x22, 0x1a, 0xb2, 0xf7, 0x6b, 0x2b, 0x9e, 0x21, 0x07, 0x7c, 0xc6, 0x86, 0x5f, 0x3e,
+	0x03, 0x87, 0x33, 0x64, 0xfa, 0x9b, 0x2d, 0x3c, 0xf3, 0x84, 0x87, 0xcc, 0x57, 0x3f, 0xe4, 0xeb,
+	0x1c, 0x68, 0x61, 0x3e, 0x16, 0x02, 0x97, 0x30, 0xa3, 0xf1, 0x7e, 0x7c, 0x85, 0x5f, 0x5a, 0xae,
+	0x58, 0xcf, 0x0a, 0x3b, 0xf1, 0x48, 0xa9, 0xaf, 0x2d, 0xf0, 0xcc, 0x13, 0x1a, 0x69, 0x14, 0x56,
+	0x37, 0x52, 0x24, 0x59, 0xef, 0x3b, 0xff, 0x1e, 0xc9, 0x06, 0x7c, 0xfd, 0x80, 0x5f, 0x3e, 0x03,
+	0x07, 0x05, 0x06, 0xf7, 0xc5, 0x73, 0x9e, 0x79, 0xc2, 0x92, 0x15, 0xab, 0x97, 0xec, 0xaf, 0x39,
+	0xfb, 0x2e, 0xa4, 0x33, 0x60, 0x56, 0x76, 0xbe, 0xef, 0x7d, 0xf0, 0xcb, 0xca, 0x96, 0xeb, 0x59,
+	0xe1, 0x87, 0x86, 0xdc, 0x0b, 0x21, 0x2d, 0x4e, 0x7c, 0x49, 0x86, 0x0f, 0xa0, 0xa1, 0x81, 0xf7,
+	0xc2, 0x9e, 0xca, 0x06, 0x0e, 0x27, 0x38, 0x00, 0xfd, 0x2f, 0xe7, 0x43, 0xa1, 0x9c, 0x04, 0xf1,
+	0x27, 0x0b, 0xf8, 0x15, 0x33, 0xf2, 0xe8, 0x59, 0xe1, 0x47, 0x78, 0xe8, 0xbe, 0xd7, 0xfc, 0xf9,
+	0x00, 0x1a, 0x1a, 0xfa, 0x6a, 0xb1, 0xc2, 0xa1, 0x5b, 0xc2, 0xfe, 0x53, 0x0e, 0x84, 0x9d, 0xb7,
+	0x5b, 0x3c, 0x36, 0x8e, 0x7e, 0xb5, 0x95, 0xe7, 0x4b, 0x15, 0xe9, 0x59, 0x41, 0x33, 0xe4, 0x35,
+	0xb0, 0xc3, 0xf7, 0x12, 0xa7, 0x79, 0xfa, 0x18, 0x1f, 0x48, 0x45, 0xc3, 0x1c, 0x80, 0xfd, 0xde,
+	0x61, 0xa2, 0x72, 0x16, 0x15, 0xee, 0xc8, 0x0f, 0xc3, 0x7f, 0xe3, 0x40, 0x9b, 0xf7, 0x4d, 0x1c,
+	0x0f, 0x96, 0x03, 0xde, 0x7c, 0xe2, 0x97, 0xcf, 0xc0, 0xa1, 0x67, 0x85, 0xe3, 0x9c, 0x21, 0x3f,
+	0x0b, 0x97, 0xb0, 0xa3, 0x74, 0xee, 0x57, 0x10, 0xa8, 0x94, 0x2f, 0x46, 0x73, 0xda, 0x04, 0x37,
+	0x56, 0x37, 0x27, 0x02, 0xfb, 0xa8, 0x9d, 0xfc, 0xfe, 0x8e, 0x03, 0xad, 0xec, 0x75, 0x75, 0xe8,
+	0xb3, 0x1b, 0xec, 0x3b, 0x03, 0xfc, 0xb2, 0xb2, 0xe5, 0x7a, 0x56, 0xb8, 0xc1, 0x19, 0xf2, 0xcb,
+	0xb0, 0xf2, 0xdb, 0xf3, 0x7c, 0xe5, 0xac, 0x68, 0xd2, 0xdb, 0xe1, 0xb6, 0xd9, 0x4d, 0x1a, 0x6f,
+	0xf4, 0x46, 0x0f, 0x91, 0xc9, 0x1f, 0x86, 0x7f, 0xc7, 0x81, 0x26, 0x2a, 0x90, 0x87, 0x8b, 0x02,
+	0xd4, 0xda, 0xce, 0xd2, 0xf9, 0xc5, 0xa5, 0x0b, 0xf5, 0xac, 0xf0, 0x06, 0x67, 0xc8, 0x3b, 0x61,
+	0x6f, 0xa5, 0x17, 0x54, 0xf9, 0x8a, 0x39, 0xd1, 0x94, 0x57, 0xc2, 0x15, 0x7e, 0x15, 0x43, 0x4c,
+	0xd1, 0x43, 0x4e, 0xd4, 0x77, 0x18, 0x16, 0xed, 0x8f, 0x82, 0xd1, 0x17, 0xb1, 0xba, 0xfd, 0x7e,
+	0x80, 0xbd, 0x04, 0xca, 0x2f, 0x9f, 0x81, 0x43, 0xcf, 0x0a, 0x67, 0x38, 0x43, 0xde, 0x0a, 0x97,
+	0x05, 0x5c, 0x72, 0x64, 0x56, 0x32, 0xe8, 0x16, 0xa4, 0xb5, 0x1d, 0xe9, 0x5d, 0xc2, 0x00, 0xdc,
+	0x06, 0xcc, 0x27, 0x7a, 0xc8, 0x89, 0x77, 0x3d, 0x6b, 0x08, 0x7f, 0x6c, 0x7f, 0x52, 0x89, 0x0e,
+	0xe9, 0x3d, 0x36, 0x30, 0x30, 0xc9, 0xe4, 0x57, 0xcc, 0xc8, 0xa3, 0x67, 0x85, 0x3f, 0xb0, 0x3a,
+	0xf0, 0xa7, 0x30, 0x9e, 0x0e, 0x02, 0x33, 0x2d, 0x7e, 0xc5, 0x8c, 0x3c, 0xa8, 0x83, 0xff, 0xe5,
+	0x40, 0x2b, 0xbb, 0xbf, 0xe7, 0xd1, 0x3c, 0xdf, 0x8e, 0x23, 0xbf, 0xac, 0x6c, 0xb9, 0x9e, 0x15,
+	0xae, 0x73, 0x86, 0xfc, 0x87, 0x50, 0x2c, 0x9c, 0xba, 0x31, 0x75, 0xed, 0x74, 0x39, 0x68, 0x75,
+	0x93, 0x20, 0x94, 0xaf, 0x82, 0x17, 0x2d, 0xdc, 0x16, 0xe1, 0xd9, 0x59, 0x2e, 0x1c, 0x69, 0xc5,
+	0xf2, 0x01, 0x47, 0x43, 0xa0, 0xcd, 0x7b, 0xd8, 0xec, 0x8d, 0xbe, 0xfc, 0x07, 0xf2, 0xfc, 0xf2,
+	0x19, 0x38, 0xf4, 0xac, 0xf0, 0x4b, 0xce, 0x90, 0x7f, 0x04, 0xd7, 0x54, 0x30, 0x29, 0x27, 0x0e,
+	0xe7, 0xab, 0xe2, 0x46, 0x42, 0xd8, 0x2a, 0x6c, 0x9e, 0xa5, 0x10, 0x46, 0xd2, 0x94, 0x18, 0x2c,
+	0x04, 0xb0, 0xfb, 0x72, 0x1e, 0x04, 0xf8, 0x76, 0x0a, 0xf9, 0x65, 0x65, 0xcb, 0xab, 0x42, 0x00,
+	0x39, 0x07, 0xe3, 0xab, 0xe0, 0x7d, 0x40, 0x04, 0x90, 0x56, 0xac, 0xa9, 0xff, 0xbf, 0x75, 0x13,
+	0x9a, 0x3d, 0xc8, 0x80, 0xec, 0xdc, 0xfc, 0x07, 0x3a, 0x7c, 0x77, 0x79, 0x06, 0xe2, 0x79, 0x5e,
+	0x81, 0xab, 0x2b, 0x98, 0x91, 0x9d, 0x51, 0xf2, 0xd5, 0x30, 0xa3, 0xf9, 0x0f, 0x09, 0x9b, 0x66,
+	0x39, 0x7f, 0xbb, 0x19, 0x4b, 0x00, 0x7b, 0x41, 0x67, 0xe0, 0x1e, 0x3f, 0x5c, 0x59, 0xd1, 0xe1,
+	0x03, 0xdf, 0x53, 0x09, 0x1b, 0xb2, 0x33, 0x6e, 0x4f, 0x1e, 0xac, 0xad, 0xac, 0x68, 0x73, 0x9a,
+	0xef, 0xa9, 0x84, 0xcd, 0xea, 0x29, 0xf6, 0xc3, 0x57, 0x5a, 0xfb, 0xa3, 0x1b, 0x28, 0x6e, 0x43,
+	0xde, 0x06, 0x97, 0x80, 0x0e, 0x2b, 0xc5, 0xed, 0x26, 0x39, 0x6e, 0xb7, 0xfc, 0xd2, 0x8e, 0xee,
+	0xa1, 0x4c, 0x5c, 0xaa, 0x1b, 0xe8, 0x5f, 0xdb, 0x3f, 0x20, 0x72, 0x9c, 0xd4, 0xa6, 0x66, 0xb3,
+	0xa9, 0x64, 0x1c, 0x7d, 0xba, 0x3b, 0xfa, 0x13, 0x3d, 0x93, 0x1e, 0xf4, 0x51, 0x7e, 0x3f, 0x00,
+	0x60, 0x41, 0xee, 0xc8, 0x3c, 0x5c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3477,10 +4609,14 @@ type HelmManagerClient interface {
 	//* release service
 	ListRelease(ctx context.Context, in *ListReleaseReq, opts ...grpc.CallOption) (*ListReleaseResp, error)
 	GetReleaseDetail(ctx context.Context, in *GetReleaseDetailReq, opts ...grpc.CallOption) (*GetReleaseDetailResp, error)
+	ListReleaseHistory(ctx context.Context, in *ListReleaseHistoryReq, opts ...grpc.CallOption) (*ListReleaseHistoryResp, error)
+	GetReleaseRevision(ctx context.Context, in *GetReleaseRevisionReq, opts ...grpc.CallOption) (*GetReleaseRevisionResp, error)
 	InstallRelease(ctx context.Context, in *InstallReleaseReq, opts ...grpc.CallOption) (*InstallReleaseResp, error)
 	UninstallRelease(ctx context.Context, in *UninstallReleaseReq, opts ...grpc.CallOption) (*UninstallReleaseResp, error)
 	UpgradeRelease(ctx context.Context, in *UpgradeReleaseReq, opts ...grpc.CallOption) (*UpgradeReleaseResp, error)
 	RollbackRelease(ctx context.Context, in *RollbackReleaseReq, opts ...grpc.CallOption) (*RollbackReleaseResp, error)
+	PreviewInstallRelease(ctx context.Context, in *PreviewInstallReleaseReq, opts ...grpc.CallOption) (*PreviewInstallReleaseResp, error)
+	PreviewUpgradeRelease(ctx context.Context, in *PreviewUpgradeReleaseReq, opts ...grpc.CallOption) (*PreviewUpgradeReleaseResp, error)
 }
 
 type helmManagerClient struct {
@@ -3599,6 +4735,24 @@ func (c *helmManagerClient) GetReleaseDetail(ctx context.Context, in *GetRelease
 	return out, nil
 }
 
+func (c *helmManagerClient) ListReleaseHistory(ctx context.Context, in *ListReleaseHistoryReq, opts ...grpc.CallOption) (*ListReleaseHistoryResp, error) {
+	out := new(ListReleaseHistoryResp)
+	err := c.cc.Invoke(ctx, "/helmmanager.HelmManager/ListReleaseHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *helmManagerClient) GetReleaseRevision(ctx context.Context, in *GetReleaseRevisionReq, opts ...grpc.CallOption) (*GetReleaseRevisionResp, error) {
+	out := new(GetReleaseRevisionResp)
+	err := c.cc.Invoke(ctx, "/helmmanager.HelmManager/GetReleaseRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *helmManagerClient) InstallRelease(ctx context.Context, in *InstallReleaseReq, opts ...grpc.CallOption) (*InstallReleaseResp, error) {
 	out := new(InstallReleaseResp)
 	err := c.cc.Invoke(ctx, "/helmmanager.HelmManager/InstallRelease", in, out, opts...)
@@ -3635,6 +4789,24 @@ func (c *helmManagerClient) RollbackRelease(ctx context.Context, in *RollbackRel
 	return out, nil
 }
 
+func (c *helmManagerClient) PreviewInstallRelease(ctx context.Context, in *PreviewInstallReleaseReq, opts ...grpc.CallOption) (*PreviewInstallReleaseResp, error) {
+	out := new(PreviewInstallReleaseResp)
+	err := c.cc.Invoke(ctx, "/helmmanager.HelmManager/PreviewInstallRelease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *helmManagerClient) PreviewUpgradeRelease(ctx context.Context, in *PreviewUpgradeReleaseReq, opts ...grpc.CallOption) (*PreviewUpgradeReleaseResp, error) {
+	out := new(PreviewUpgradeReleaseResp)
+	err := c.cc.Invoke(ctx, "/helmmanager.HelmManager/PreviewUpgradeRelease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HelmManagerServer is the server API for HelmManager service.
 type HelmManagerServer interface {
 	//* common service
@@ -3653,10 +4825,14 @@ type HelmManagerServer interface {
 	//* release service
 	ListRelease(context.Context, *ListReleaseReq) (*ListReleaseResp, error)
 	GetReleaseDetail(context.Context, *GetReleaseDetailReq) (*GetReleaseDetailResp, error)
+	ListReleaseHistory(context.Context, *ListReleaseHistoryReq) (*ListReleaseHistoryResp, error)
+	GetReleaseRevision(context.Context, *GetReleaseRevisionReq) (*GetReleaseRevisionResp, error)
 	InstallRelease(context.Context, *InstallReleaseReq) (*InstallReleaseResp, error)
 	UninstallRelease(context.Context, *UninstallReleaseReq) (*UninstallReleaseResp, error)
 	UpgradeRelease(context.Context, *UpgradeReleaseReq) (*UpgradeReleaseResp, error)
 	RollbackRelease(context.Context, *RollbackReleaseReq) (*RollbackReleaseResp, error)
+	PreviewInstallRelease(context.Context, *PreviewInstallReleaseReq) (*PreviewInstallReleaseResp, error)
+	PreviewUpgradeRelease(context.Context, *PreviewUpgradeReleaseReq) (*PreviewUpgradeReleaseResp, error)
 }
 
 // UnimplementedHelmManagerServer can be embedded to have forward compatible implementations.
@@ -3699,6 +4875,12 @@ func (*UnimplementedHelmManagerServer) ListRelease(ctx context.Context, req *Lis
 func (*UnimplementedHelmManagerServer) GetReleaseDetail(ctx context.Context, req *GetReleaseDetailReq) (*GetReleaseDetailResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetReleaseDetail not implemented")
 }
+func (*UnimplementedHelmManagerServer) ListReleaseHistory(ctx context.Context, req *ListReleaseHistoryReq) (*ListReleaseHistoryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReleaseHistory not implemented")
+}
+func (*UnimplementedHelmManagerServer) GetReleaseRevision(ctx context.Context, req *GetReleaseRevisionReq) (*GetReleaseRevisionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReleaseRevision not implemented")
+}
 func (*UnimplementedHelmManagerServer) InstallRelease(ctx context.Context, req *InstallReleaseReq) (*InstallReleaseResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InstallRelease not implemented")
 }
@@ -3711,6 +4893,12 @@ func (*UnimplementedHelmManagerServer) UpgradeRelease(ctx context.Context, req *
 func (*UnimplementedHelmManagerServer) RollbackRelease(ctx context.Context, req *RollbackReleaseReq) (*RollbackReleaseResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RollbackRelease not implemented")
 }
+func (*UnimplementedHelmManagerServer) PreviewInstallRelease(ctx context.Context, req *PreviewInstallReleaseReq) (*PreviewInstallReleaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewInstallRelease not implemented")
+}
+func (*UnimplementedHelmManagerServer) PreviewUpgradeRelease(ctx context.Context, req *PreviewUpgradeReleaseReq) (*PreviewUpgradeReleaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewUpgradeRelease not implemented")
+}
 
 func RegisterHelmManagerServer(s *grpc.Server, srv HelmManagerServer) {
 	s.RegisterService(&_HelmManager_serviceDesc, srv)
@@ -3932,6 +5120,42 @@ func _HelmManager_GetReleaseDetail_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HelmManager_ListReleaseHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReleaseHistoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelmManagerServer).ListReleaseHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helmmanager.HelmManager/ListReleaseHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelmManagerServer).ListReleaseHistory(ctx, req.(*ListReleaseHistoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HelmManager_GetReleaseRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReleaseRevisionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelmManagerServer).GetReleaseRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helmmanager.HelmManager/GetReleaseRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelmManagerServer).GetReleaseRevision(ctx, req.(*GetReleaseRevisionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HelmManager_InstallRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InstallReleaseReq)
 	if err := dec(in); err != nil {
@@ -4004,6 +5228,42 @@ func _HelmManager_RollbackRelease_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HelmManager_PreviewInstallRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewInstallReleaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelmManagerServer).PreviewInstallRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helmmanager.HelmManager/PreviewInstallRelease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelmManagerServer).PreviewInstallRelease(ctx, req.(*PreviewInstallReleaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HelmManager_PreviewUpgradeRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewUpgradeReleaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelmManagerServer).PreviewUpgradeRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helmmanager.HelmManager/PreviewUpgradeRelease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelmManagerServer).PreviewUpgradeRelease(ctx, req.(*PreviewUpgradeReleaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _HelmManager_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "helmmanager.HelmManager",
 	HandlerType: (*HelmManagerServer)(nil),
@@ -4056,6 +5316,14 @@ var _HelmManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetReleaseDetail",
 			Handler:    _HelmManager_GetReleaseDetail_Handler,
 		},
+		{
+			MethodName: "ListReleaseHistory",
+			Handler:    _HelmManager_ListReleaseHistory_Handler,
+		},
+		{
+			MethodName: "GetReleaseRevision",
+			Handler:    _HelmManager_GetReleaseRevision_Handler,
+		},
 		{
 			MethodName: "InstallRelease",
 			Handler:    _HelmManager_InstallRelease_Handler,
@@ -4072,6 +5340,14 @@ var _HelmManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RollbackRelease",
 			Handler:    _HelmManager_RollbackRelease_Handler,
 		},
+		{
+			MethodName: "PreviewInstallRelease",
+			Handler:    _HelmManager_PreviewInstallRelease_Handler,
+		},
+		{
+			MethodName: "PreviewUpgradeRelease",
+			Handler:    _HelmManager_PreviewUpgradeRelease_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "bcs-helm-manager.proto",
@@ -116,6 +116,20 @@ func NewHelmManagerEndpoints() []*api.Endpoint {
 			Method:  []string{"GET"},
 			Handler: "rpc",
 		},
+		&api.Endpoint{
+			Name:    "HelmManager.ListReleaseHistory",
+			Path:    []string{"/helmmanager/v1/release/{clusterID}/{namespace}/{name}/history"},
+			Method:  []string{"GET"},
+			Body:    "",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "HelmManager.GetReleaseRevision",
+			Path:    []string{"/helmmanager/v1/release/{clusterID}/{namespace}/{name}/revision/{revision}"},
+			Method:  []string{"GET"},
+			Body:    "",
+			Handler: "rpc",
+		},
 		&api.Endpoint{
 			Name:    "HelmManager.InstallRelease",
 			Path:    []string{"/helmmanager/v1/release/{clusterID}/{namespace}/{name}/install"},
@@ -144,6 +158,20 @@ func NewHelmManagerEndpoints() []*api.Endpoint {
 			Body:    "*",
 			Handler: "rpc",
 		},
+		&api.Endpoint{
+			Name:    "HelmManager.PreviewInstallRelease",
+			Path:    []string{"/helmmanager/v1/release/{clusterID}/{namespace}/{name}/install/preview"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "HelmManager.PreviewUpgradeRelease",
+			Path:    []string{"/helmmanager/v1/release/{clusterID}/{namespace}/{name}/upgrade/preview"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
 	}
 }
 
@@ -166,10 +194,14 @@ type HelmManagerService interface {
 	//* release service
 	ListRelease(ctx context.Context, in *ListReleaseReq, opts ...client.CallOption) (*ListReleaseResp, error)
 	GetReleaseDetail(ctx context.Context, in *GetReleaseDetailReq, opts ...client.CallOption) (*GetReleaseDetailResp, error)
+	ListReleaseHistory(ctx context.Context, in *ListReleaseHistoryReq, opts ...client.CallOption) (*ListReleaseHistoryResp, error)
+	GetReleaseRevision(ctx context.Context, in *GetReleaseRevisionReq, opts ...client.CallOption) (*GetReleaseRevisionResp, error)
 	InstallRelease(ctx context.Context, in *InstallReleaseReq, opts ...client.CallOption) (*InstallReleaseResp, error)
 	UninstallRelease(ctx context.Context, in *UninstallReleaseReq, opts ...client.CallOption) (*UninstallReleaseResp, error)
 	UpgradeRelease(ctx context.Context, in *UpgradeReleaseReq, opts ...client.CallOption) (*UpgradeReleaseResp, error)
 	RollbackRelease(ctx context.Context, in *RollbackReleaseReq, opts ...client.CallOption) (*RollbackReleaseResp, error)
+	PreviewInstallRelease(ctx context.Context, in *PreviewInstallReleaseReq, opts ...client.CallOption) (*PreviewInstallReleaseResp, error)
+	PreviewUpgradeRelease(ctx context.Context, in *PreviewUpgradeReleaseReq, opts ...client.CallOption) (*PreviewUpgradeReleaseResp, error)
 }
 
 type helmManagerService struct {
@@ -304,6 +336,26 @@ func (c *helmManagerService) GetReleaseDetail(ctx context.Context, in *GetReleas
 	return out, nil
 }
 
+func (c *helmManagerService) ListReleaseHistory(ctx context.Context, in *ListReleaseHistoryReq, opts ...client.CallOption) (*ListReleaseHistoryResp, error) {
+	req := c.c.NewRequest(c.name, "HelmManager.ListReleaseHistory", in)
+	out := new(ListReleaseHistoryResp)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *helmManagerService) GetReleaseRevision(ctx context.Context, in *GetReleaseRevisionReq, opts ...client.CallOption) (*GetReleaseRevisionResp, error) {
+	req := c.c.NewRequest(c.name, "HelmManager.GetReleaseRevision", in)
+	out := new(GetReleaseRevisionResp)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *helmManagerService) InstallRelease(ctx context.Context, in *InstallReleaseReq, opts ...client.CallOption) (*InstallReleaseResp, error) {
 	req := c.c.NewRequest(c.name, "HelmManager.InstallRelease", in)
 	out := new(InstallReleaseResp)
@@ -344,6 +396,26 @@ func (c *helmManagerService) RollbackRelease(ctx context.Context, in *RollbackRe
 	return out, nil
 }
 
+func (c *helmManagerService) PreviewInstallRelease(ctx context.Context, in *PreviewInstallReleaseReq, opts ...client.CallOption) (*PreviewInstallReleaseResp, error) {
+	req := c.c.NewRequest(c.name, "HelmManager.PreviewInstallRelease", in)
+	out := new(PreviewInstallReleaseResp)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *helmManagerService) PreviewUpgradeRelease(ctx context.Context, in *PreviewUpgradeReleaseReq, opts ...client.CallOption) (*PreviewUpgradeReleaseResp, error) {
+	req := c.c.NewRequest(c.name, "HelmManager.PreviewUpgradeRelease", in)
+	out := new(PreviewUpgradeReleaseResp)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for HelmManager service
 
 type HelmManagerHandler interface {
@@ -363,10 +435,14 @@ type HelmManagerHandler interface {
 	//* release service
 	ListRelease(context.Context, *ListReleaseReq, *ListReleaseResp) error
 	GetReleaseDetail(context.Context, *GetReleaseDetailReq, *GetReleaseDetailResp) error
+	ListReleaseHistory(context.Context, *ListReleaseHistoryReq, *ListReleaseHistoryResp) error
+	GetReleaseRevision(context.Context, *GetReleaseRevisionReq, *GetReleaseRevisionResp) error
 	InstallRelease(context.Context, *InstallReleaseReq, *InstallReleaseResp) error
 	UninstallRelease(context.Context, *UninstallReleaseReq, *UninstallReleaseResp) error
 	UpgradeRelease(context.Context, *UpgradeReleaseReq, *UpgradeReleaseResp) error
 	RollbackRelease(context.Context, *RollbackReleaseReq, *RollbackReleaseResp) error
+	PreviewInstallRelease(context.Context, *PreviewInstallReleaseReq, *PreviewInstallReleaseResp) error
+	PreviewUpgradeRelease(context.Context, *PreviewUpgradeReleaseReq, *PreviewUpgradeReleaseResp) error
 }
 
 func RegisterHelmManagerHandler(s server.Server, hdlr HelmManagerHandler, opts ...server.HandlerOption) error {
@@ -383,10 +459,14 @@ func RegisterHelmManagerHandler(s server.Server, hdlr HelmManagerHandler, opts .
 		GetChartDetail(ctx context.Context, in *GetChartDetailReq, out *GetChartDetailResp) error
 		ListRelease(ctx context.Context, in *ListReleaseReq, out *ListReleaseResp) error
 		GetReleaseDetail(ctx context.Context, in *GetReleaseDetailReq, out *GetReleaseDetailResp) error
+		ListReleaseHistory(ctx context.Context, in *ListReleaseHistoryReq, out *ListReleaseHistoryResp) error
+		GetReleaseRevision(ctx context.Context, in *GetReleaseRevisionReq, out *GetReleaseRevisionResp) error
 		InstallRelease(ctx context.Context, in *InstallReleaseReq, out *InstallReleaseResp) error
 		UninstallRelease(ctx context.Context, in *UninstallReleaseReq, out *UninstallReleaseResp) error
 		UpgradeRelease(ctx context.Context, in *UpgradeReleaseReq, out *UpgradeReleaseResp) error
 		RollbackRelease(ctx context.Context, in *RollbackReleaseReq, out *RollbackReleaseResp) error
+		PreviewInstallRelease(ctx context.Context, in *PreviewInstallReleaseReq, out *PreviewInstallReleaseResp) error
+		PreviewUpgradeRelease(ctx context.Context, in *PreviewUpgradeReleaseReq, out *PreviewUpgradeReleaseResp) error
 	}
 	type HelmManager struct {
 		helmManager
@@ -468,6 +548,20 @@ func RegisterHelmManagerHandler(s server.Server, hdlr HelmManagerHandler, opts .
 		Method:  []string{"GET"},
 		Handler: "rpc",
 	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "HelmManager.ListReleaseHistory",
+		Path:    []string{"/helmmanager/v1/release/{clusterID}/{namespace}/{name}/history"},
+		Method:  []string{"GET"},
+		Body:    "",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "HelmManager.GetReleaseRevision",
+		Path:    []string{"/helmmanager/v1/release/{clusterID}/{namespace}/{name}/revision/{revision}"},
+		Method:  []string{"GET"},
+		Body:    "",
+		Handler: "rpc",
+	}))
 	opts = append(opts, api.WithEndpoint(&api.Endpoint{
 		Name:    "HelmManager.InstallRelease",
 		Path:    []string{"/helmmanager/v1/release/{clusterID}/{namespace}/{name}/install"},
@@ -496,6 +590,20 @@ func RegisterHelmManagerHandler(s server.Server, hdlr HelmManagerHandler, opts .
 		Body:    "*",
 		Handler: "rpc",
 	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "HelmManager.PreviewInstallRelease",
+		Path:    []string{"/helmmanager/v1/release/{clusterID}/{namespace}/{name}/install/preview"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "HelmManager.PreviewUpgradeRelease",
+		Path:    []string{"/helmmanager/v1/release/{clusterID}/{namespace}/{name}/upgrade/preview"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
 	return s.Handle(s.NewHandler(&HelmManager{h}, opts...))
 }
 
@@ -551,6 +659,14 @@ func (h *helmManagerHandler) GetReleaseDetail(ctx context.Context, in *GetReleas
 	return h.HelmManagerHandler.GetReleaseDetail(ctx, in, out)
 }
 
+func (h *helmManagerHandler) ListReleaseHistory(ctx context.Context, in *ListReleaseHistoryReq, out *ListReleaseHistoryResp) error {
+	return h.HelmManagerHandler.ListReleaseHistory(ctx, in, out)
+}
+
+func (h *helmManagerHandler) GetReleaseRevision(ctx context.Context, in *GetReleaseRevisionReq, out *GetReleaseRevisionResp) error {
+	return h.HelmManagerHandler.GetReleaseRevision(ctx, in, out)
+}
+
 func (h *helmManagerHandler) InstallRelease(ctx context.Context, in *InstallReleaseReq, out *InstallReleaseResp) error {
 	return h.HelmManagerHandler.InstallRelease(ctx, in, out)
 }
@@ -566,3 +682,11 @@ func (h *helmManagerHandler) UpgradeRelease(ctx context.Context, in *UpgradeRele
 func (h *helmManagerHandler) RollbackRelease(ctx context.Context, in *RollbackReleaseReq, out *RollbackReleaseResp) error {
 	return h.HelmManagerHandler.RollbackRelease(ctx, in, out)
 }
+
+func (h *helmManagerHandler) PreviewInstallRelease(ctx context.Context, in *PreviewInstallReleaseReq, out *PreviewInstallReleaseResp) error {
+	return h.HelmManagerHandler.PreviewInstallRelease(ctx, in, out)
+}
+
+func (h *helmManagerHandler) PreviewUpgradeRelease(ctx context.Context, in *PreviewUpgradeReleaseReq, out *PreviewUpgradeReleaseResp) error {
+	return h.HelmManagerHandler.PreviewUpgradeRelease(ctx, in, out)
+}
@@ -2888,6 +2888,8 @@ func (m *ReleaseDetail) Validate() error {
 
 	// no validation rules for ChartVersion
 
+	// no validation rules for Drifted
+
 	return nil
 }
 
@@ -3678,3 +3680,1125 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = RollbackReleaseRespValidationError{}
+
+// Validate checks the field values on PreviewInstallReleaseReq with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// an error is returned.
+func (m *PreviewInstallReleaseReq) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
+		return PreviewInstallReleaseReqValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+	}
+
+	if l := utf8.RuneCountInString(m.GetNamespace()); l < 1 || l > 64 {
+		return PreviewInstallReleaseReqValidationError{
+			field:  "Namespace",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+	}
+
+	if l := utf8.RuneCountInString(m.GetClusterID()); l < 1 || l > 64 {
+		return PreviewInstallReleaseReqValidationError{
+			field:  "ClusterID",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+	}
+
+	if l := utf8.RuneCountInString(m.GetProjectID()); l < 1 || l > 64 {
+		return PreviewInstallReleaseReqValidationError{
+			field:  "ProjectID",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+	}
+
+	if l := utf8.RuneCountInString(m.GetRepository()); l < 1 || l > 64 {
+		return PreviewInstallReleaseReqValidationError{
+			field:  "Repository",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+	}
+
+	if l := utf8.RuneCountInString(m.GetChart()); l < 1 || l > 64 {
+		return PreviewInstallReleaseReqValidationError{
+			field:  "Chart",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+	}
+
+	if l := utf8.RuneCountInString(m.GetVersion()); l < 1 || l > 64 {
+		return PreviewInstallReleaseReqValidationError{
+			field:  "Version",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+	}
+
+	// no validation rules for Operator
+
+	// no validation rules for BcsSysVar
+
+	return nil
+}
+
+// PreviewInstallReleaseReqValidationError is the validation error returned by
+// PreviewInstallReleaseReq.Validate if the designated constraints aren't met.
+type PreviewInstallReleaseReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PreviewInstallReleaseReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PreviewInstallReleaseReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PreviewInstallReleaseReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PreviewInstallReleaseReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PreviewInstallReleaseReqValidationError) ErrorName() string {
+	return "PreviewInstallReleaseReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PreviewInstallReleaseReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPreviewInstallReleaseReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PreviewInstallReleaseReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PreviewInstallReleaseReqValidationError{}
+
+// Validate checks the field values on PreviewInstallReleaseResp with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// an error is returned.
+func (m *PreviewInstallReleaseResp) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Result
+
+	if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PreviewInstallReleaseRespValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// PreviewInstallReleaseRespValidationError is the validation error returned by
+// PreviewInstallReleaseResp.Validate if the designated constraints aren't met.
+type PreviewInstallReleaseRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PreviewInstallReleaseRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PreviewInstallReleaseRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PreviewInstallReleaseRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PreviewInstallReleaseRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PreviewInstallReleaseRespValidationError) ErrorName() string {
+	return "PreviewInstallReleaseRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PreviewInstallReleaseRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPreviewInstallReleaseResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PreviewInstallReleaseRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PreviewInstallReleaseRespValidationError{}
+
+// Validate checks the field values on PreviewUpgradeReleaseReq with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// an error is returned.
+func (m *PreviewUpgradeReleaseReq) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
+		return PreviewUpgradeReleaseReqValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+	}
+
+	if l := utf8.RuneCountInString(m.GetNamespace()); l < 1 || l > 64 {
+		return PreviewUpgradeReleaseReqValidationError{
+			field:  "Namespace",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+	}
+
+	if l := utf8.RuneCountInString(m.GetClusterID()); l < 1 || l > 64 {
+		return PreviewUpgradeReleaseReqValidationError{
+			field:  "ClusterID",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+	}
+
+	if l := utf8.RuneCountInString(m.GetProjectID()); l < 1 || l > 64 {
+		return PreviewUpgradeReleaseReqValidationError{
+			field:  "ProjectID",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+	}
+
+	if l := utf8.RuneCountInString(m.GetRepository()); l < 1 || l > 64 {
+		return PreviewUpgradeReleaseReqValidationError{
+			field:  "Repository",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+	}
+
+	if l := utf8.RuneCountInString(m.GetChart()); l < 1 || l > 64 {
+		return PreviewUpgradeReleaseReqValidationError{
+			field:  "Chart",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+	}
+
+	if l := utf8.RuneCountInString(m.GetVersion()); l < 1 || l > 64 {
+		return PreviewUpgradeReleaseReqValidationError{
+			field:  "Version",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+	}
+
+	// no validation rules for Operator
+
+	// no validation rules for BcsSysVar
+
+	return nil
+}
+
+// PreviewUpgradeReleaseReqValidationError is the validation error returned by
+// PreviewUpgradeReleaseReq.Validate if the designated constraints aren't met.
+type PreviewUpgradeReleaseReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PreviewUpgradeReleaseReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PreviewUpgradeReleaseReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PreviewUpgradeReleaseReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PreviewUpgradeReleaseReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PreviewUpgradeReleaseReqValidationError) ErrorName() string {
+	return "PreviewUpgradeReleaseReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PreviewUpgradeReleaseReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPreviewUpgradeReleaseReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PreviewUpgradeReleaseReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PreviewUpgradeReleaseReqValidationError{}
+
+// Validate checks the field values on PreviewUpgradeReleaseResp with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// an error is returned.
+func (m *PreviewUpgradeReleaseResp) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Result
+
+	if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PreviewUpgradeReleaseRespValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// PreviewUpgradeReleaseRespValidationError is the validation error returned by
+// PreviewUpgradeReleaseResp.Validate if the designated constraints aren't met.
+type PreviewUpgradeReleaseRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PreviewUpgradeReleaseRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PreviewUpgradeReleaseRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PreviewUpgradeReleaseRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PreviewUpgradeReleaseRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PreviewUpgradeReleaseRespValidationError) ErrorName() string {
+	return "PreviewUpgradeReleaseRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PreviewUpgradeReleaseRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPreviewUpgradeReleaseResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PreviewUpgradeReleaseRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PreviewUpgradeReleaseRespValidationError{}
+
+// Validate checks the field values on ReleasePreview with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *ReleasePreview) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Name
+
+	// no validation rules for Namespace
+
+	// no validation rules for Revision
+
+	// no validation rules for CurrentRevision
+
+	// no validation rules for Manifest
+
+	for idx, item := range m.GetResources() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReleasePreviewValidationError{
+					field:  fmt.Sprintf("Resources[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ReleasePreviewValidationError is the validation error returned by
+// ReleasePreview.Validate if the designated constraints aren't met.
+type ReleasePreviewValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReleasePreviewValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReleasePreviewValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReleasePreviewValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReleasePreviewValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReleasePreviewValidationError) ErrorName() string {
+	return "ReleasePreviewValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReleasePreviewValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReleasePreview.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReleasePreviewValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReleasePreviewValidationError{}
+
+// Validate checks the field values on ResourceDiff with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *ResourceDiff) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Kind
+
+	// no validation rules for ApiVersion
+
+	// no validation rules for Name
+
+	// no validation rules for Namespace
+
+	// no validation rules for Action
+
+	// no validation rules for Reason
+
+	// no validation rules for Drifted
+
+	// no validation rules for Deployed
+
+	// no validation rules for Rendered
+
+	// no validation rules for Live
+
+	// no validation rules for Diff
+
+	return nil
+}
+
+// ResourceDiffValidationError is the validation error returned by
+// ResourceDiff.Validate if the designated constraints aren't met.
+type ResourceDiffValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResourceDiffValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResourceDiffValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResourceDiffValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResourceDiffValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResourceDiffValidationError) ErrorName() string {
+	return "ResourceDiffValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResourceDiffValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResourceDiff.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResourceDiffValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResourceDiffValidationError{}
+
+// Validate checks the field values on ListReleaseHistoryReq with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// an error is returned.
+func (m *ListReleaseHistoryReq) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if l := utf8.RuneCountInString(m.GetClusterID()); l < 1 || l > 64 {
+		return ListReleaseHistoryReqValidationError{
+			field:  "ClusterID",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+	}
+
+	if l := utf8.RuneCountInString(m.GetNamespace()); l < 1 || l > 64 {
+		return ListReleaseHistoryReqValidationError{
+			field:  "Namespace",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
+		return ListReleaseHistoryReqValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+	}
+
+	// no validation rules for Page
+
+	// no validation rules for Size
+
+	// no validation rules for IncludeUninstalled
+
+	return nil
+}
+
+// ListReleaseHistoryReqValidationError is the validation error returned by
+// ListReleaseHistoryReq.Validate if the designated constraints aren't met.
+type ListReleaseHistoryReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReleaseHistoryReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReleaseHistoryReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReleaseHistoryReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReleaseHistoryReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReleaseHistoryReqValidationError) ErrorName() string {
+	return "ListReleaseHistoryReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReleaseHistoryReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReleaseHistoryReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReleaseHistoryReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReleaseHistoryReqValidationError{}
+
+// Validate checks the field values on ListReleaseHistoryResp with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// an error is returned.
+func (m *ListReleaseHistoryResp) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Result
+
+	if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListReleaseHistoryRespValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ListReleaseHistoryRespValidationError is the validation error returned by
+// ListReleaseHistoryResp.Validate if the designated constraints aren't met.
+type ListReleaseHistoryRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReleaseHistoryRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReleaseHistoryRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReleaseHistoryRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReleaseHistoryRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReleaseHistoryRespValidationError) ErrorName() string {
+	return "ListReleaseHistoryRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReleaseHistoryRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReleaseHistoryResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReleaseHistoryRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReleaseHistoryRespValidationError{}
+
+// Validate checks the field values on GetReleaseRevisionReq with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// an error is returned.
+func (m *GetReleaseRevisionReq) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if l := utf8.RuneCountInString(m.GetClusterID()); l < 1 || l > 64 {
+		return GetReleaseRevisionReqValidationError{
+			field:  "ClusterID",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+	}
+
+	if l := utf8.RuneCountInString(m.GetNamespace()); l < 1 || l > 64 {
+		return GetReleaseRevisionReqValidationError{
+			field:  "Namespace",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
+		return GetReleaseRevisionReqValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+	}
+
+	// no validation rules for Revision
+
+	return nil
+}
+
+// GetReleaseRevisionReqValidationError is the validation error returned by
+// GetReleaseRevisionReq.Validate if the designated constraints aren't met.
+type GetReleaseRevisionReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReleaseRevisionReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReleaseRevisionReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReleaseRevisionReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReleaseRevisionReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReleaseRevisionReqValidationError) ErrorName() string {
+	return "GetReleaseRevisionReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReleaseRevisionReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReleaseRevisionReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReleaseRevisionReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReleaseRevisionReqValidationError{}
+
+// Validate checks the field values on GetReleaseRevisionResp with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// an error is returned.
+func (m *GetReleaseRevisionResp) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Result
+
+	if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetReleaseRevisionRespValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetReleaseRevisionRespValidationError is the validation error returned by
+// GetReleaseRevisionResp.Validate if the designated constraints aren't met.
+type GetReleaseRevisionRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReleaseRevisionRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReleaseRevisionRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReleaseRevisionRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReleaseRevisionRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReleaseRevisionRespValidationError) ErrorName() string {
+	return "GetReleaseRevisionRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReleaseRevisionRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReleaseRevisionResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReleaseRevisionRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReleaseRevisionRespValidationError{}
+
+// Validate checks the field values on ReleaseHistoryListData with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// an error is returned.
+func (m *ReleaseHistoryListData) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Page
+
+	// no validation rules for Size
+
+	// no validation rules for Total
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReleaseHistoryListDataValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ReleaseHistoryListDataValidationError is the validation error returned by
+// ReleaseHistoryListData.Validate if the designated constraints aren't met.
+type ReleaseHistoryListDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReleaseHistoryListDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReleaseHistoryListDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReleaseHistoryListDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReleaseHistoryListDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReleaseHistoryListDataValidationError) ErrorName() string {
+	return "ReleaseHistoryListDataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReleaseHistoryListDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReleaseHistoryListData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReleaseHistoryListDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReleaseHistoryListDataValidationError{}
+
+// Validate checks the field values on ReleaseRevision with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ReleaseRevision) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Name
+
+	// no validation rules for Namespace
+
+	// no validation rules for ClusterID
+
+	// no validation rules for Revision
+
+	// no validation rules for Action
+
+	// no validation rules for Status
+
+	// no validation rules for Chart
+
+	// no validation rules for ChartVersion
+
+	// no validation rules for Manifest
+
+	// no validation rules for RollbackTo
+
+	// no validation rules for Operator
+
+	// no validation rules for CreateTime
+
+	// no validation rules for Drifted
+
+	// no validation rules for DriftCheckTime
+
+	// no validation rules for Uninstalled
+
+	return nil
+}
+
+// ReleaseRevisionValidationError is the validation error returned by
+// ReleaseRevision.Validate if the designated constraints aren't met.
+type ReleaseRevisionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReleaseRevisionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReleaseRevisionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReleaseRevisionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReleaseRevisionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReleaseRevisionValidationError) ErrorName() string {
+	return "ReleaseRevisionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReleaseRevisionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReleaseRevision.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReleaseRevisionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReleaseRevisionValidationError{}
@@ -0,0 +1,132 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package api
+
+import (
+	"net/http"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/config"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/i18n"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/recorder"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/types"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/route"
+
+	logger "github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	// 回放时每次读取的分片数量
+	replayChunkBatch = 100
+)
+
+// recordQuery 录制列表查询参数
+type recordQuery struct {
+	Namespace string `form:"namespace"`
+	PodName   string `form:"pod_name"`
+	Username  string `form:"username"`
+	Limit     int    `form:"limit"`
+}
+
+// ListRecords 录制列表, 管理员可以查看集群下所有录制, 其他用户只能查看自己的录制
+func (s *service) ListRecords(c *gin.Context) {
+	authCtx := route.MustGetAuthContext(c)
+
+	query := &recordQuery{}
+	if err := c.BindQuery(query); err != nil {
+		APIError(c, i18n.GetMessage("请求参数错误{}", err))
+		return
+	}
+
+	filter := &recorder.ListFilter{
+		ProjectId: authCtx.ProjectId,
+		ClusterId: authCtx.ClusterId,
+		Namespace: query.Namespace,
+		PodName:   query.PodName,
+		Username:  query.Username,
+		Limit:     query.Limit,
+	}
+	if !config.G.IsManager(authCtx.Username, authCtx.ClusterId) {
+		filter.Username = authCtx.Username
+	}
+
+	records, err := recorder.NewStore().List(c.Request.Context(), filter)
+	if err != nil {
+		APIError(c, i18n.GetMessage("获取录制失败{}", err))
+		return
+	}
+
+	data := types.APIResponse{
+		Data:      records,
+		Code:      types.NoError,
+		Message:   i18n.GetMessage("获取录制成功"),
+		RequestID: authCtx.RequestId,
+	}
+	c.JSON(http.StatusOK, data)
+}
+
+// ReplayRecord 按 asciicast v2 格式流式返回录制内容, 可直接使用 asciinema-player 回放
+func (s *service) ReplayRecord(c *gin.Context) {
+	authCtx := route.MustGetAuthContext(c)
+
+	store := recorder.NewStore()
+	meta, err := store.Get(c.Request.Context(), c.Param("recordId"))
+	if err == redis.Nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, types.APIResponse{
+			Code:      types.ApiErrorCode,
+			Message:   i18n.GetMessage("录制不存在或已经过期"),
+			RequestID: authCtx.RequestId,
+		})
+		return
+	}
+	if err != nil {
+		APIError(c, i18n.GetMessage("获取录制失败{}", err))
+		return
+	}
+
+	// 录制必须属于当前项目集群, 非管理员只能回放自己的录制
+	if meta.ProjectId != authCtx.ProjectId || meta.ClusterId != authCtx.ClusterId ||
+		(!config.G.IsManager(authCtx.Username, authCtx.ClusterId) && meta.Username != authCtx.Username) {
+		c.AbortWithStatusJSON(http.StatusForbidden, types.APIResponse{
+			Code:      types.ApiErrorCode,
+			Message:   i18n.GetMessage("没有权限"),
+			RequestID: authCtx.RequestId,
+		})
+		return
+	}
+
+	c.Header("Content-Type", recorder.ContentType)
+	c.Header("Content-Disposition", "attachment; filename="+meta.Id+".cast")
+	c.Status(http.StatusOK)
+
+	for start := int64(0); ; start += replayChunkBatch {
+		chunks, err := store.ReadChunks(c.Request.Context(), meta.Id, start, start+replayChunkBatch-1)
+		if err != nil {
+			// 已经开始返回数据, 只能中断连接
+			logger.Errorf("replay record %s failed, err: %s", meta.Id, err)
+			return
+		}
+		for _, chunk := range chunks {
+			if _, err := c.Writer.WriteString(chunk); err != nil {
+				return
+			}
+		}
+		c.Writer.Flush()
+
+		if len(chunks) < replayChunkBatch {
+			return
+		}
+	}
+}
@@ -54,6 +54,24 @@ func (s service) RegisterRoute(router gin.IRoutes) {
 	api.GET("/api/projects/:projectId/clusters/",
 		metrics.RequestCollect("ListClusters"), s.ListClusters)
 
+	// 终端录制, 用户登入态鉴权
+	api.GET("/api/projects/:projectId/clusters/:clusterId/records/",
+		metrics.RequestCollect("ListRecords"), route.PermissionRequired(), s.ListRecords)
+	api.GET("/api/projects/:projectId/clusters/:clusterId/records/:recordId/replay/",
+		metrics.RequestCollect("ReplayRecord"), route.PermissionRequired(), s.ReplayRecord)
+
+	// 共享终端, 用户登入态鉴权
+	api.POST("/api/projects/:projectId/clusters/:clusterId/shares/",
+		metrics.RequestCollect("CreateShare"), route.PermissionRequired(), s.CreateShare)
+	api.GET("/api/projects/:projectId/clusters/:clusterId/shares/:shareId/",
+		metrics.RequestCollect("GetShare"), route.PermissionRequired(), s.GetShare)
+	api.DELETE("/api/projects/:projectId/clusters/:clusterId/shares/:shareId/",
+		metrics.RequestCollect("DeleteShare"), route.PermissionRequired(), s.DeleteShare)
+	api.PUT("/api/projects/:projectId/clusters/:clusterId/shares/:shareId/control/",
+		metrics.RequestCollect("GrantShareControl"), route.PermissionRequired(), s.GrantShareControl)
+	api.GET("/api/projects/:projectId/clusters/:clusterId/shares/:shareId/session/",
+		metrics.RequestCollect("CreateShareSession"), route.PermissionRequired(), s.CreateShareSession)
+
 	// 蓝鲸API网关鉴权 & App鉴权
 	api.GET("/api/portal/sessions/:sessionId/",
 		metrics.RequestCollect("CreatePortalSession"), s.CreatePortalSession)
@@ -64,6 +82,7 @@ func (s service) RegisterRoute(router gin.IRoutes) {
 
 	// websocket协议, session鉴权
 	api.GET("/ws/sessions/:sessionId/", metrics.RequestCollect("BCSWebSocket"), s.BCSWebSocketHandler)
+	api.GET("/ws/shares/:sessionId/", metrics.RequestCollect("BCSShareWebSocket"), s.BCSShareWebSocketHandler)
 }
 
 // ListClusters 集群列表
@@ -265,8 +284,18 @@ func makeWebConsoleURL(sessionId string, podCtx *types.PodContext) string {
 
 // makeWebSocketURL http 转换为 ws 协议链接
 func makeWebSocketURL(sessionId, lang string, withScheme bool) string {
+	return makeWebSocketURLWithPath(path.Join("/ws/sessions/", sessionId), lang, withScheme)
+}
+
+// makeShareWebSocketURL 共享终端观看者 ws 链接
+func makeShareWebSocketURL(sessionId, lang string) string {
+	return makeWebSocketURLWithPath(path.Join("/ws/shares/", sessionId), lang, false)
+}
+
+// makeWebSocketURLWithPath http 转换为 ws 协议链接
+func makeWebSocketURLWithPath(wsPath, lang string, withScheme bool) string {
 	u := *config.G.Web.BaseURL
-	u.Path = path.Join(u.Path, "/ws/sessions/", sessionId) + "/"
+	u.Path = path.Join(u.Path, wsPath) + "/"
 
 	query := url.Values{}
 	if lang != "" {
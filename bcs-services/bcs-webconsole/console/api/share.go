@@ -0,0 +1,299 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/components/iam"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/config"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/i18n"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/manager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/metrics"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/rest"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/sessions"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/share"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/types"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/route"
+
+	logger "github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis/v8"
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+)
+
+// createShareForm 共享终端参数
+type createShareForm struct {
+	SessionId string `json:"session_id" binding:"required"`
+}
+
+// shareControlForm 控制权参数
+type shareControlForm struct {
+	Username string `json:"username"` // 获得输入控制权的观看者, 为空收回控制权
+}
+
+// CreateShare 所有者共享自己正在使用的终端
+func (s *service) CreateShare(c *gin.Context) {
+	authCtx := route.MustGetAuthContext(c)
+
+	form := &createShareForm{}
+	if err := c.BindJSON(form); err != nil {
+		APIError(c, i18n.GetMessage("请求参数错误{}", err))
+		return
+	}
+
+	podCtx, err := sessions.NewStore().WebSocketScope().Get(c.Request.Context(), form.SessionId)
+	if err != nil {
+		APIError(c, i18n.GetMessage("sessin_id不正确"))
+		return
+	}
+
+	// 只能共享自己在当前项目集群下的终端
+	if podCtx.Username != authCtx.Username || podCtx.ProjectId != authCtx.ProjectId ||
+		podCtx.ClusterId != authCtx.ClusterId {
+		shareForbidden(c, authCtx)
+		return
+	}
+
+	meta, err := share.NewStore().Share(c.Request.Context(), form.SessionId, podCtx)
+	if err != nil {
+		APIError(c, i18n.GetMessage("共享终端失败{}", err))
+		return
+	}
+
+	data := types.APIResponse{
+		Data:      meta,
+		Code:      types.NoError,
+		Message:   i18n.GetMessage("共享终端成功"),
+		RequestID: authCtx.RequestId,
+	}
+	c.JSON(http.StatusOK, data)
+}
+
+// GetShare 共享终端信息, 包含正在观看的用户和拥有输入控制权的用户
+func (s *service) GetShare(c *gin.Context) {
+	authCtx := route.MustGetAuthContext(c)
+
+	meta, ok := mustGetShare(c, authCtx)
+	if !ok {
+		return
+	}
+
+	data := types.APIResponse{
+		Data:      meta,
+		Code:      types.NoError,
+		Message:   i18n.GetMessage("获取共享终端成功"),
+		RequestID: authCtx.RequestId,
+	}
+	c.JSON(http.StatusOK, data)
+}
+
+// DeleteShare 所有者结束共享, 断开所有观看者
+func (s *service) DeleteShare(c *gin.Context) {
+	authCtx := route.MustGetAuthContext(c)
+
+	meta, ok := mustGetShare(c, authCtx)
+	if !ok {
+		return
+	}
+	if meta.Owner != authCtx.Username {
+		shareForbidden(c, authCtx)
+		return
+	}
+
+	if err := share.NewStore().Close(c.Request.Context(), meta, authCtx.Username); err != nil {
+		APIError(c, i18n.GetMessage("结束共享终端失败{}", err))
+		return
+	}
+
+	data := types.APIResponse{
+		Code:      types.NoError,
+		Message:   i18n.GetMessage("结束共享终端成功"),
+		RequestID: authCtx.RequestId,
+	}
+	c.JSON(http.StatusOK, data)
+}
+
+// GrantShareControl 所有者把输入控制权交给一个观看者, 或者收回控制权
+func (s *service) GrantShareControl(c *gin.Context) {
+	authCtx := route.MustGetAuthContext(c)
+
+	form := &shareControlForm{}
+	if err := c.BindJSON(form); err != nil {
+		APIError(c, i18n.GetMessage("请求参数错误{}", err))
+		return
+	}
+
+	meta, ok := mustGetShare(c, authCtx)
+	if !ok {
+		return
+	}
+	if meta.Owner != authCtx.Username {
+		shareForbidden(c, authCtx)
+		return
+	}
+
+	if err := share.NewStore().GrantControl(c.Request.Context(), meta, authCtx.Username, form.Username); err != nil {
+		APIError(c, i18n.GetMessage("设置输入控制权失败{}", err))
+		return
+	}
+
+	data := types.APIResponse{
+		Data:      meta,
+		Code:      types.NoError,
+		Message:   i18n.GetMessage("设置输入控制权成功"),
+		RequestID: authCtx.RequestId,
+	}
+	c.JSON(http.StatusOK, data)
+}
+
+// CreateShareSession 观看者通过权限校验后, 获取一次性的 websocket session
+func (s *service) CreateShareSession(c *gin.Context) {
+	authCtx := route.MustGetAuthContext(c)
+
+	meta, ok := mustGetShare(c, authCtx)
+	if !ok {
+		return
+	}
+
+	sessionId, err := share.NewStore().CreateViewerSession(c.Request.Context(), meta.Id, authCtx.Username)
+	if err != nil {
+		APIError(c, i18n.GetMessage("获取session失败{}", err))
+		return
+	}
+
+	data := types.APIResponse{
+		Data: map[string]string{
+			"session_id": sessionId,
+			"ws_url":     makeShareWebSocketURL(sessionId, c.Query("lang")),
+		},
+		Code:      types.NoError,
+		Message:   i18n.GetMessage("获取session成功"),
+		RequestID: authCtx.RequestId,
+	}
+	c.JSON(http.StatusOK, data)
+}
+
+// mustGetShare 查询共享终端, 观看者需要和所有者一样通过项目, 集群和命名空间的权限校验
+func mustGetShare(c *gin.Context, authCtx *route.AuthContext) (*share.ShareMeta, bool) {
+	meta, err := share.NewStore().Get(c.Request.Context(), c.Param("shareId"))
+	if err == redis.Nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, types.APIResponse{
+			Code:      types.ApiErrorCode,
+			Message:   i18n.GetMessage("共享终端不存在或已经结束"),
+			RequestID: authCtx.RequestId,
+		})
+		return nil, false
+	}
+	if err != nil {
+		APIError(c, i18n.GetMessage("获取共享终端失败{}", err))
+		return nil, false
+	}
+
+	if meta.ProjectId != authCtx.ProjectId || meta.ClusterId != authCtx.ClusterId {
+		shareForbidden(c, authCtx)
+		return nil, false
+	}
+
+	// 直连容器模式, 所有者创建 session 时校验了命名空间权限, 观看者同样需要
+	if meta.Mode == types.ContainerDirectMode && !config.G.IsManager(authCtx.Username, authCtx.ClusterId) {
+		allow, err := iam.IsAllowedWithResource(c.Request.Context(), meta.ProjectId, meta.ClusterId, meta.Namespace,
+			authCtx.Username)
+		if err != nil {
+			APIError(c, i18n.GetMessage("获取共享终端失败{}", err))
+			return nil, false
+		}
+		if !allow {
+			shareForbidden(c, authCtx)
+			return nil, false
+		}
+	}
+
+	return meta, true
+}
+
+// shareForbidden 没有共享终端权限
+func shareForbidden(c *gin.Context, authCtx *route.AuthContext) {
+	c.AbortWithStatusJSON(http.StatusForbidden, types.APIResponse{
+		Code:      types.ApiErrorCode,
+		Message:   i18n.GetMessage("没有权限"),
+		RequestID: authCtx.RequestId,
+	})
+}
+
+// BCSShareWebSocketHandler 共享终端观看者 WebSocket 连接处理函数
+func (s *service) BCSShareWebSocketHandler(c *gin.Context) {
+	// 还未建立 WebSocket 连接, 使用 Json 返回
+	if !websocket.IsWebSocketUpgrade(c.Request) {
+		rest.AbortWithBadRequestError(c, errors.New("invalid websocket connection"))
+		return
+	}
+
+	ws, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		rest.AbortWithBadRequestError(c, errors.Wrap(err, "upgrade websocket connection"))
+		return
+	}
+	defer ws.Close()
+
+	// 已经建立 WebSocket 连接, 下面所有的错误返回, 需要使用 GracefulCloseWebSocket 返回
+	ctx, stop := context.WithCancel(c.Request.Context())
+	defer stop()
+
+	connected := false
+
+	store := share.NewStore()
+	session, err := store.PopViewerSession(ctx, route.GetSessionId(c))
+	if err != nil {
+		manager.GracefulCloseWebSocket(ctx, ws, connected, errors.Wrap(err, "session不合法"))
+		return
+	}
+
+	meta, err := store.Get(ctx, session.ShareId)
+	if err != nil {
+		manager.GracefulCloseWebSocket(ctx, ws, connected, errors.Wrap(err, "共享终端不存在或已经结束"))
+		return
+	}
+
+	viewer, err := share.GetHub().RegisterViewer(ctx, meta, session.Username)
+	if err != nil {
+		manager.GracefulCloseWebSocket(ctx, ws, connected, errors.Wrap(err, "加入共享终端失败"))
+		return
+	}
+	connected = true
+
+	start := time.Now()
+	metrics.CollectWsConnectionOnline(1)
+
+	err = manager.NewViewerConn(ctx, ws, viewer, meta).Run()
+
+	// 过滤掉 ws 长链接时间
+	metrics.SetRequestIgnoreDuration(c, time.Since(start))
+	metrics.CollectWsConnectionOnline(-1)
+
+	// 会话已经断开, 不能使用会话的 context
+	if closeErr := viewer.Close(context.Background()); closeErr != nil {
+		logger.Warnf("close viewer %s of share %s failed, err: %s", session.Username, meta.Id, closeErr)
+	}
+
+	// 连接已经结束, 不需要再等待
+	stop()
+	if err == nil {
+		err = errors.New("BCS Console 服务端连接断开，请重新登录")
+	}
+	manager.GracefulCloseWebSocket(ctx, ws, connected, err)
+}
@@ -91,6 +91,8 @@ func (s *service) BCSWebSocketHandler(c *gin.Context) {
 	}
 
 	consoleMgr := manager.NewConsoleManager(ctx, podCtx)
+	consoleMgr.StartRecord(query.GetTerminalSize())
+	consoleMgr.StartShare(sessionId)
 	remoteStreamConn := manager.NewRemoteStreamConn(ctx, ws, consoleMgr, query.GetTerminalSize(), query.HideBanner)
 	connected = true
 
@@ -0,0 +1,186 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package config
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// CommandAction 命令策略动作
+type CommandAction string
+
+const (
+	// CommandActionDeny 禁止执行
+	CommandActionDeny CommandAction = "deny"
+	// CommandActionRequireReason 填写原因后才能执行
+	CommandActionRequireReason CommandAction = "require_reason"
+	// CommandActionWarn 提示风险, 允许执行
+	CommandActionWarn CommandAction = "warn"
+)
+
+// priority 多个规则同时命中时, 使用最严格的动作
+func (a CommandAction) priority() int {
+	switch a {
+	case CommandActionDeny:
+		return 3
+	case CommandActionRequireReason:
+		return 2
+	case CommandActionWarn:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// 命令分隔符, 按子命令分别匹配, 如 ls && rm -rf /
+var commandSeparator = regexp.MustCompile(`\|\||&&|[;|&\n]`)
+
+// CommandPolicyConf 命令策略配置
+type CommandPolicyConf struct {
+	Enabled  bool             `yaml:"enabled"`
+	Policies []*CommandPolicy `yaml:"policies"`
+}
+
+// CommandPolicy 命令策略, 按项目和 webconsole 类型生效
+type CommandPolicy struct {
+	Name       string         `yaml:"name"`
+	ProjectIds []string       `yaml:"project_ids"` // 生效的项目Id, 为空对所有项目生效
+	Modes      []string       `yaml:"modes"`       // 生效的 webconsole 类型, 如 cluster_external, 为空对所有类型生效
+	Rules      []*CommandRule `yaml:"rules"`
+}
+
+// CommandRule 命令规则
+type CommandRule struct {
+	Pattern string         `yaml:"pattern"` // 正则表达式, 匹配整行命令或者单个子命令
+	Action  CommandAction  `yaml:"action"`  // deny, require_reason, warn
+	Message string         `yaml:"message"` // 提示信息
+	regexp  *regexp.Regexp `yaml:"-"`
+}
+
+// CommandMatch 命中的策略规则
+type CommandMatch struct {
+	Policy string
+	Rule   *CommandRule
+}
+
+// Init
+func (c *CommandPolicyConf) Init() error {
+	c.Enabled = false
+	c.Policies = []*CommandPolicy{}
+
+	return nil
+}
+
+// InitRules 校验并编译规则
+func (c *CommandPolicyConf) InitRules() error {
+	for _, policy := range c.Policies {
+		for _, rule := range policy.Rules {
+			switch rule.Action {
+			case CommandActionDeny, CommandActionRequireReason, CommandActionWarn:
+			default:
+				return errors.Errorf("command policy %s has invalid action %s", policy.Name, rule.Action)
+			}
+
+			r, err := regexp.Compile(rule.Pattern)
+			if err != nil {
+				return errors.Wrapf(err, "command policy %s has invalid pattern", policy.Name)
+			}
+			rule.regexp = r
+		}
+	}
+
+	return nil
+}
+
+// matchScope 策略是否对项目和 webconsole 类型生效
+func (p *CommandPolicy) matchScope(projectId, mode string) bool {
+	if len(p.ProjectIds) > 0 && !stringInSlice(projectId, p.ProjectIds) {
+		return false
+	}
+	if len(p.Modes) > 0 && !stringInSlice(mode, p.Modes) {
+		return false
+	}
+	return true
+}
+
+// Match 匹配命令, 未命中返回 nil, 多个规则命中时返回动作最严格的规则
+func (c *CommandPolicyConf) Match(projectId, mode, command string) *CommandMatch {
+	if !c.Enabled {
+		return nil
+	}
+
+	commands := splitCommand(command)
+	if len(commands) == 0 {
+		return nil
+	}
+
+	var match *CommandMatch
+	for _, policy := range c.Policies {
+		if !policy.matchScope(projectId, mode) {
+			continue
+		}
+		for _, rule := range policy.Rules {
+			if rule.regexp == nil || !rule.matchAny(commands) {
+				continue
+			}
+			if match == nil || rule.Action.priority() > match.Rule.Action.priority() {
+				match = &CommandMatch{Policy: policy.Name, Rule: rule}
+			}
+		}
+	}
+	return match
+}
+
+// matchAny 任意一个命令命中规则
+func (r *CommandRule) matchAny(commands []string) bool {
+	for _, cmd := range commands {
+		if r.regexp.MatchString(cmd) {
+			return true
+		}
+	}
+	return false
+}
+
+// splitCommand 返回整行命令和各个子命令, 多个空白字符合并为一个空格
+func splitCommand(command string) []string {
+	line := strings.Join(strings.Fields(command), " ")
+	if line == "" {
+		return nil
+	}
+
+	commands := []string{line}
+	parts := commandSeparator.Split(command, -1)
+	if len(parts) == 1 {
+		return commands
+	}
+	for _, part := range parts {
+		part = strings.Join(strings.Fields(part), " ")
+		if part != "" {
+			commands = append(commands, part)
+		}
+	}
+	return commands
+}
+
+func stringInSlice(s string, list []string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,88 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package config
+
+import "testing"
+
+func TestCommandPolicyMatch(t *testing.T) {
+	c := &CommandPolicyConf{
+		Enabled: true,
+		Policies: []*CommandPolicy{
+			{
+				Name:  "kubectld",
+				Modes: []string{"cluster_internal", "cluster_external"},
+				Rules: []*CommandRule{
+					{Pattern: `^kubectl\s+.*delete\s+(ns|namespaces?)\b`, Action: CommandActionRequireReason},
+					{Pattern: `^kubectl\s+.*delete\b`, Action: CommandActionWarn},
+				},
+			},
+			{
+				Name:       "project",
+				ProjectIds: []string{"p1"},
+				Rules: []*CommandRule{
+					{Pattern: `^rm\s+-rf\s+/$`, Action: CommandActionDeny},
+				},
+			},
+		},
+	}
+	if err := c.InitRules(); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		projectId string
+		mode      string
+		command   string
+		want      CommandAction
+	}{
+		{name: "deny", projectId: "p1", mode: "container_direct", command: "rm -rf /", want: CommandActionDeny},
+		{name: "deny sub command", projectId: "p1", mode: "container_direct", command: "cd /tmp &&  rm  -rf /",
+			want: CommandActionDeny},
+		{name: "other project", projectId: "p2", mode: "container_direct", command: "rm -rf /"},
+		{name: "strictest action", projectId: "p2", mode: "cluster_external", command: "kubectl delete ns default",
+			want: CommandActionRequireReason},
+		{name: "warn", projectId: "p2", mode: "cluster_internal", command: "kubectl -n default delete pod nginx",
+			want: CommandActionWarn},
+		{name: "other mode", projectId: "p2", mode: "container_direct", command: "kubectl delete ns default"},
+		{name: "empty", projectId: "p1", mode: "cluster_internal", command: "  "},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got CommandAction
+			if match := c.Match(tt.projectId, tt.mode, tt.command); match != nil {
+				got = match.Rule.Action
+			}
+			if got != tt.want {
+				t.Errorf("Match() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCommandPolicyInitRules(t *testing.T) {
+	invalidAction := &CommandPolicyConf{Policies: []*CommandPolicy{
+		{Name: "invalid", Rules: []*CommandRule{{Pattern: "rm", Action: "block"}}},
+	}}
+	if err := invalidAction.InitRules(); err == nil {
+		t.Error("expect error with invalid action")
+	}
+
+	invalidPattern := &CommandPolicyConf{Policies: []*CommandPolicy{
+		{Name: "invalid", Rules: []*CommandRule{{Pattern: "rm (", Action: CommandActionDeny}}},
+	}}
+	if err := invalidPattern.InitRules(); err == nil {
+		t.Error("expect error with invalid pattern")
+	}
+}
@@ -36,6 +36,8 @@ type Configurations struct {
 	Redis       *RedisConf                 `yaml:"redis"`
 	WebConsole  *WebConsoleConf            `yaml:"webconsole"`
 	Web         *WebConf                   `yaml:"web"`
+	Record      *RecordConf                `yaml:"record"`
+	Command     *CommandPolicyConf         `yaml:"command_policy"`
 }
 
 // newConfigurations 新增配置
@@ -81,6 +83,12 @@ func newConfigurations() (*Configurations, error) {
 
 	c.Web = defaultWebConf()
 
+	c.Record = &RecordConf{}
+	c.Record.Init()
+
+	c.Command = &CommandPolicyConf{}
+	c.Command.Init()
+
 	return c, nil
 }
 
@@ -188,6 +196,10 @@ func (c *Configurations) ReadFrom(content []byte) error {
 		return err
 	}
 
+	if err := c.Command.InitRules(); err != nil {
+		return err
+	}
+
 	if err := c.BCS.InitJWTPubKey(); err != nil {
 		return err
 	}
@@ -0,0 +1,29 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package config
+
+// RecordConf 终端会话录制配置
+type RecordConf struct {
+	Enabled       bool `yaml:"enabled"`        // 是否开启录制
+	RetentionDays int  `yaml:"retention_days"` // 录制保留天数
+	ChunkSize     int  `yaml:"chunk_size"`     // 单个分片大小, 单位字节, 超过后写入存储
+}
+
+// Init
+func (c *RecordConf) Init() error {
+	c.Enabled = true
+	c.RetentionDays = 30
+	c.ChunkSize = 32 * 1024
+	return nil
+}
@@ -0,0 +1,229 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package manager
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/config"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/i18n"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/types"
+
+	logger "github.com/Tencent/bk-bcs/bcs-common/common/blog"
+)
+
+// 终端控制字符
+const (
+	keyCtrlC     = 0x03
+	keyBackspace = 0x08
+	keyCtrlU     = 0x15
+	keyCtrlW     = 0x17
+	keyEscape    = 0x1b
+	keyDelete    = 0x7f
+
+	ansiRed    = "\x1b[31m"
+	ansiYellow = "\x1b[33m"
+	ansiReset  = "\x1b[0m"
+)
+
+type escapeState int
+
+const (
+	escapeNone escapeState = iota
+	escapeStart
+	escapeCSI // ESC [ ... 方向键, 括号粘贴等
+	escapeSS3 // ESC O x 小键盘等
+)
+
+// commandLine 根据按键还原当前输入的命令行
+// 只能尽力而为, 历史命令, Tab 补全, 移动光标后的编辑等由 shell 处理, 无法准确还原
+type commandLine struct {
+	buf    []byte
+	escape escapeState
+}
+
+// feed 处理单个按键字节, 遇到回车返回 true
+func (l *commandLine) feed(b byte) bool {
+	switch l.escape {
+	case escapeStart:
+		switch b {
+		case '[':
+			l.escape = escapeCSI
+		case 'O':
+			l.escape = escapeSS3
+		default:
+			l.escape = escapeNone
+		}
+		return false
+	case escapeCSI:
+		if b >= 0x40 && b <= 0x7e {
+			l.escape = escapeNone
+		}
+		return false
+	case escapeSS3:
+		l.escape = escapeNone
+		return false
+	}
+
+	switch b {
+	case '\r', '\n':
+		return true
+	case keyEscape:
+		l.escape = escapeStart
+	case keyCtrlC, keyCtrlU:
+		l.reset()
+	case keyBackspace, keyDelete:
+		_, size := utf8.DecodeLastRune(l.buf)
+		l.buf = l.buf[:len(l.buf)-size]
+	case keyCtrlW:
+		line := strings.TrimRight(string(l.buf), " ")
+		l.buf = l.buf[:strings.LastIndex(line, " ")+1]
+	default:
+		if b >= 0x20 {
+			l.buf = append(l.buf, b)
+		}
+	}
+	return false
+}
+
+// String 当前命令行
+func (l *commandLine) String() string {
+	return string(l.buf)
+}
+
+// reset 清空命令行
+func (l *commandLine) reset() {
+	l.buf = l.buf[:0]
+}
+
+// pendingCommand 等待填写原因的命令
+type pendingCommand struct {
+	command string
+	match   *config.CommandMatch
+	reason  commandLine
+}
+
+// interceptCommand 按行检查命令策略, 返回实际发送到容器的输入, 以及需要输出到终端的提示信息
+func (c *ConsoleManager) interceptCommand(username string, msg []byte) ([]byte, []byte) {
+	if c.pendingCmd != nil {
+		return c.handleReasonInput(username, msg)
+	}
+
+	var echo []byte
+	for i, b := range msg {
+		if !c.cmdLine.feed(b) {
+			continue
+		}
+
+		command := c.cmdLine.String()
+		c.cmdLine.reset()
+		match := config.G.Command.Match(c.PodCtx.ProjectId, string(c.PodCtx.Mode), command)
+		if match == nil {
+			continue
+		}
+
+		switch match.Rule.Action {
+		case config.CommandActionDeny:
+			c.emitCommandAudit(username, command, match, true, "")
+			echo = append(echo, commandNotice(ansiRed, "命令被禁止执行{}", match)...)
+			// 丢弃后续输入, 使用 Ctrl-C 取消 shell 中已经输入的命令
+			return append(msg[:i:i], keyCtrlC), echo
+		case config.CommandActionRequireReason:
+			c.pendingCmd = &pendingCommand{command: command, match: match}
+			echo = append(echo, commandNotice(ansiYellow, "执行该命令需要填写原因{}", match)...)
+			echo = append(echo, i18n.GetMessage("请输入原因后回车执行, Ctrl-C取消")+": "...)
+			// 暂不发送回车, 填写原因后再执行
+			return msg[:i], echo
+		case config.CommandActionWarn:
+			c.emitCommandAudit(username, command, match, false, "")
+			echo = append(echo, commandNotice(ansiYellow, "命令存在风险{}", match)...)
+		}
+	}
+	return msg, echo
+}
+
+// handleReasonInput 处理原因输入, 输入不发送到容器, 由服务端回显
+func (c *ConsoleManager) handleReasonInput(username string, msg []byte) ([]byte, []byte) {
+	pending := c.pendingCmd
+
+	var echo []byte
+	for _, b := range msg {
+		if b == keyCtrlC {
+			c.pendingCmd = nil
+			c.emitCommandAudit(username, pending.command, pending.match, true, "")
+			echo = append(echo, "\r\n"+i18n.GetMessage("已取消执行")+"\r\n"...)
+			return []byte{keyCtrlC}, echo
+		}
+
+		count := utf8.RuneCount(pending.reason.buf)
+		size := len(pending.reason.buf)
+		if !pending.reason.feed(b) {
+			if newCount := utf8.RuneCount(pending.reason.buf); newCount < count {
+				echo = append(echo, strings.Repeat("\b \b", count-newCount)...)
+			} else if len(pending.reason.buf) > size {
+				echo = append(echo, pending.reason.buf[size:]...)
+			}
+			continue
+		}
+
+		reason := strings.TrimSpace(pending.reason.String())
+		if reason == "" {
+			pending.reason.reset()
+			echo = append(echo, "\r\n"+i18n.GetMessage("请输入原因后回车执行, Ctrl-C取消")+": "...)
+			continue
+		}
+
+		// 发送之前暂存的回车, 执行命令, 后续输入丢弃
+		c.pendingCmd = nil
+		c.emitCommandAudit(username, pending.command, pending.match, false, reason)
+		echo = append(echo, "\r\n"...)
+		return []byte{'\r'}, echo
+	}
+	return nil, echo
+}
+
+// commandNotice 命令策略提示信息, 单独一行显示
+func commandNotice(color, messageID string, match *config.CommandMatch) []byte {
+	message := match.Rule.Message
+	if message == "" {
+		message = match.Rule.Pattern
+	}
+	return notice(color, i18n.GetMessage(messageID, map[string]string{"message": message}))
+}
+
+// notice 服务端提示信息, 单独一行显示
+func notice(color, msg string) []byte {
+	return []byte("\r\n" + color + "[BCS] " + msg + ansiReset + "\r\n")
+}
+
+// emitCommandAudit 命令策略审计, username 为执行或取消命令的用户
+func (c *ConsoleManager) emitCommandAudit(username, command string, match *config.CommandMatch, blocked bool,
+	reason string) {
+	logger.Infof("command policy %s matched, action: %s, blocked: %t, user: %s, pod: %s, command: %s",
+		match.Policy, match.Rule.Action, blocked, username, c.PodCtx.PodName, command)
+
+	record := c.newAuditRecord(username)
+	record.CommandRecord = &types.CommandRecord{
+		Command:   command,
+		Policy:    match.Policy,
+		Pattern:   match.Rule.Pattern,
+		Action:    string(match.Rule.Action),
+		Blocked:   blocked,
+		Reason:    reason,
+		Timestamp: time.Now().Unix(),
+	}
+	c.emit(record)
+}
@@ -82,11 +82,16 @@ func NewRemoteStreamConn(ctx context.Context, wsConn *websocket.Conn, mgr *Conso
 
 // ReadInputMsg
 func (r *RemoteStreamConn) ReadInputMsg() <-chan wsMessage {
+	return readWsMessage(r.wsConn)
+}
+
+// readWsMessage 读取 web 端发送的消息
+func readWsMessage(wsConn *websocket.Conn) <-chan wsMessage {
 	inputMsgChan := make(chan wsMessage)
 	go func() {
 		defer close(inputMsgChan)
 		for {
-			msgType, msg, err := r.wsConn.ReadMessage()
+			msgType, msg, err := wsConn.ReadMessage()
 			inputMsgChan <- wsMessage{
 				msgType: msgType,
 				msg:     msg,
@@ -125,11 +130,21 @@ func (r *RemoteStreamConn) HandleMsg(msgType int, msg []byte) ([]byte, error) {
 		return nil, nil
 	}
 
-	inputMsg, err := r.bindMgr.HandleInputMsg(decodeMsg)
+	return r.handleInputMsg(r.bindMgr.PodCtx.Username, decodeMsg), nil
+}
+
+// handleInputMsg 处理用户输入, 包含共享终端中观看者的输入, username 为输入的用户
+func (r *RemoteStreamConn) handleInputMsg(username string, msg []byte) []byte {
+	inputMsg, echoMsg, err := r.bindMgr.HandleInputMsg(username, msg)
 	if err != nil {
-		return nil, nil
+		return nil
+	}
+
+	// 命令策略等提示信息, 不经过容器直接输出到 web 端
+	if len(echoMsg) > 0 {
+		r.Write(echoMsg)
 	}
-	return inputMsg, nil
+	return inputMsg
 }
 
 // Read : executor 回调读取 web 端的输入, 主动断开链接逻辑
@@ -148,6 +163,9 @@ func (r *RemoteStreamConn) Read(p []byte) (int, error) {
 			return copy(p, EndOfTransmission), err
 		}
 		return copy(p, out), nil
+
+	case input := <-r.bindMgr.SharedInputs():
+		return copy(p, r.handleInputMsg(input.Operator, input.Data)), nil
 	}
 }
 
@@ -19,6 +19,9 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/config"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/recorder"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/share"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/storage"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/types"
 
@@ -44,6 +47,10 @@ type ConsoleManager struct {
 	PodCtx        *types.PodContext
 	redisClient   *redis.Client
 	managerFuncs  []ManagerFunc
+	recorder      *recorder.Recorder // 终端录制, 未开启录制时为空
+	cmdLine       commandLine        // 当前输入的命令行, 用于命令策略检查
+	pendingCmd    *pendingCommand    // 等待填写原因的命令
+	share         *share.Owner       // 共享终端, 注册失败时为空
 }
 
 // NewConsoleManager :
@@ -66,11 +73,18 @@ func (c *ConsoleManager) AddMgrFunc(mgrFunc ManagerFunc) {
 	c.managerFuncs = append(c.managerFuncs, mgrFunc)
 }
 
-// HandleInputMsg : 处理输入数据流
-func (c *ConsoleManager) HandleInputMsg(msg []byte) ([]byte, error) {
+// HandleInputMsg : 处理输入数据流, 返回发送到容器的输入, 以及需要直接输出到终端的提示信息
+// username 为输入的用户, 共享终端中可能是拥有控制权的观看者
+func (c *ConsoleManager) HandleInputMsg(username string, msg []byte) ([]byte, []byte, error) {
 	// 更新ws时间
 	c.LastInputTime = time.Now()
-	return msg, nil
+	c.recorder.Input(username, msg)
+
+	if !config.G.Command.Enabled {
+		return msg, nil, nil
+	}
+	input, echo := c.interceptCommand(username, msg)
+	return input, echo, nil
 }
 
 // HandleInputMsg : 处理 Resize 数据流
@@ -82,29 +96,42 @@ func (c *ConsoleManager) HandleResizeMsg(msg []byte) (*TerminalSize, error) {
 	if err != nil {
 		return nil, err
 	}
+	c.recorder.Resize(resizeMsg.Cols, resizeMsg.Rows)
 
 	return &resizeMsg, nil
 }
 
 // HandleOutputMsg: 处理输出数据流
 func (c *ConsoleManager) HandleOutputMsg(msg []byte) ([]byte, error) {
+	c.recorder.Output(msg)
+	c.share.Publish(msg)
 	return msg, nil
 }
 
+// SharedInputs 共享终端中拥有控制权的观看者的输入
+func (c *ConsoleManager) SharedInputs() <-chan *share.Message {
+	return c.share.Inputs()
+}
+
 // Run: Manager 后台任务等
 func (c *ConsoleManager) Run() error {
-	interval := time.NewTicker(10 * time.Second)
+	interval := time.NewTicker(recordInterval * time.Second)
 	defer interval.Stop()
+	defer c.stopRecord()
+	defer c.stopShare()
 
 	for {
 		select {
 		case <-c.ctx.Done():
 			logger.Infof("close %s ConsoleManager done", c.PodCtx.PodName)
 			return nil
+		case event := <-c.share.Events():
+			c.emitShareAudit(event.ShareId, string(event.Type), event.Operator, event.Username)
 		case <-interval.C:
 			if err := c.tickTimeout(); err != nil {
 				return err
 			}
+			c.flushRecord()
 			// 自定义函数
 			for _, managerFunc := range c.managerFuncs {
 				if err := managerFunc(c.PodCtx); err != nil {
@@ -141,7 +168,109 @@ func (c *ConsoleManager) emit(data *types.AuditRecord) {
 	c.redisClient.RPush(context.Background(), queueName, dataByte)
 }
 
+// StartRecord 开始录制终端会话, 录制失败不影响会话
+func (c *ConsoleManager) StartRecord(initTerminalSize *TerminalSize) {
+	if !config.G.Record.Enabled {
+		return
+	}
+
+	cols, rows := uint16(DefaultCols), uint16(DefaultRows)
+	if initTerminalSize != nil {
+		cols, rows = initTerminalSize.Cols, initTerminalSize.Rows
+	}
+
+	r, err := recorder.NewRecorder(c.ctx, c.PodCtx, cols, rows)
+	if err != nil {
+		logger.Warnf("start record %s failed, err: %s", c.PodCtx.PodName, err)
+		return
+	}
+	c.recorder = r
+	logger.Infof("start record %s, record id: %s", c.PodCtx.PodName, r.Id())
+}
+
+// flushRecord 写入录制分片, 并上报审计, 录制写入失败不影响审计
+func (c *ConsoleManager) flushRecord() {
+	auditData, err := c.recorder.Flush(c.ctx)
+	if err != nil {
+		logger.Warnf("flush record %s failed, err: %s", c.PodCtx.PodName, err)
+	}
+	c.emitAudit(auditData)
+}
+
+// stopRecord 结束录制, 会话已经断开, 不能使用会话的 context
+func (c *ConsoleManager) stopRecord() {
+	auditData, err := c.recorder.Close(context.Background())
+	if err != nil {
+		logger.Warnf("close record %s failed, err: %s", c.PodCtx.PodName, err)
+	}
+	c.emitAudit(auditData)
+}
+
 // 审计
-func (c *ConsoleManager) startRecord() {
-	c.emit(nil)
+func (c *ConsoleManager) emitAudit(auditData *recorder.AuditData) {
+	if auditData == nil {
+		return
+	}
+
+	if auditData.Input != "" || auditData.Output != "" {
+		record := c.newAuditRecord(c.PodCtx.Username)
+		record.InputRecord = auditData.Input
+		record.OutputRecord = auditData.Output
+		c.emit(record)
+	}
+
+	// 观看者的输入, 记录为观看者的操作
+	for username, input := range auditData.SharedInputs {
+		record := c.newAuditRecord(username)
+		record.InputRecord = input
+		c.emit(record)
+	}
+}
+
+// newAuditRecord 审计记录, 包含操作用户和 Pod 信息
+func (c *ConsoleManager) newAuditRecord(username string) *types.AuditRecord {
+	return &types.AuditRecord{
+		SessionID:   c.recorder.Id(),
+		Context:     c.PodCtx,
+		ProjectID:   c.PodCtx.ProjectId,
+		ClusterID:   c.PodCtx.ClusterId,
+		UserPodName: c.PodCtx.PodName,
+		Username:    username,
+	}
+}
+
+// StartShare 注册为可共享的终端, 所有者通过 API 开启共享后, 终端输出会转发给观看者
+func (c *ConsoleManager) StartShare(sessionId string) {
+	owner, err := share.GetHub().RegisterOwner(c.ctx, sessionId)
+	if err != nil {
+		logger.Warnf("register share owner %s failed, err: %s", c.PodCtx.PodName, err)
+		return
+	}
+	c.share = owner
+}
+
+// stopShare 终端断开, 结束共享, 会话已经断开, 不能使用会话的 context
+func (c *ConsoleManager) stopShare() {
+	shareId, err := c.share.Close(context.Background())
+	if err != nil {
+		logger.Warnf("close share %s failed, err: %s", shareId, err)
+	}
+	if shareId != "" {
+		c.emitShareAudit(shareId, string(share.CloseMessage), c.PodCtx.Username, "")
+	}
+}
+
+// emitShareAudit 共享终端审计
+func (c *ConsoleManager) emitShareAudit(shareId, event, operator, username string) {
+	logger.Infof("share %s of %s %s by %s", shareId, c.PodCtx.PodName, event, operator)
+
+	record := c.newAuditRecord(operator)
+	record.ShareRecord = &types.ShareRecord{
+		ShareId:   shareId,
+		Event:     event,
+		Operator:  operator,
+		Username:  username,
+		Timestamp: time.Now().Unix(),
+	}
+	c.emit(record)
 }
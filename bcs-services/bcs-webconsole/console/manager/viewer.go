@@ -0,0 +1,127 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package manager
+
+import (
+	"context"
+	"encoding/base64"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/i18n"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/share"
+
+	logger "github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+)
+
+// ViewerConn 共享终端观看者的 websocket 连接, 只读观看所有者的终端输出, 获得控制权后可以输入
+type ViewerConn struct {
+	ctx    context.Context
+	wsConn *websocket.Conn
+	viewer *share.Viewer
+	meta   *share.ShareMeta
+}
+
+// NewViewerConn :
+func NewViewerConn(ctx context.Context, wsConn *websocket.Conn, viewer *share.Viewer, meta *share.ShareMeta) *ViewerConn {
+	return &ViewerConn{
+		ctx:    ctx,
+		wsConn: wsConn,
+		viewer: viewer,
+		meta:   meta,
+	}
+}
+
+// Run 转发终端输出和观看者输入, 共享结束或者连接断开时返回
+func (v *ViewerConn) Run() error {
+	pingInterval := time.NewTicker(WebsocketPingInterval * time.Second)
+	defer pingInterval.Stop()
+
+	inputMsgChan := readWsMessage(v.wsConn)
+
+	msg := i18n.GetMessage("正在观看{}的共享终端, 获得输入控制权前只读", map[string]string{"owner": v.meta.Owner})
+	if err := v.write(notice(ansiYellow, msg)); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-v.ctx.Done():
+			logger.Infof("close %s ViewerConn of share %s done", v.viewer.Username(), v.meta.Id)
+			return nil
+		case m, ok := <-inputMsgChan:
+			if !ok {
+				return nil
+			}
+			if m.err != nil {
+				return m.err
+			}
+			if err := v.handleInputMsg(m.msgType, m.msg); err != nil {
+				logger.Warnf("forward input of %s to share %s failed, err: %s", v.viewer.Username(), v.meta.Id, err)
+			}
+		case m := <-v.viewer.Messages():
+			switch m.Type {
+			case share.OutputMessage:
+				if err := v.write(m.Data); err != nil {
+					return err
+				}
+			case share.ControlMessage:
+				if err := v.write(notice(ansiYellow, v.controlMessage(m.Username))); err != nil {
+					return err
+				}
+			case share.CloseMessage:
+				return errors.New(i18n.GetMessage("共享终端已经结束"))
+			}
+		case <-pingInterval.C: // 定时主动发送 ping
+			if err := v.wsConn.WriteMessage(websocket.PingMessage, []byte{}); err != nil {
+				return errors.Wrap(err, "ping")
+			}
+		}
+	}
+}
+
+// handleInputMsg 观看者输入, 只在拥有控制权时转发, 忽略 resize
+func (v *ViewerConn) handleInputMsg(msgType int, msg []byte) error {
+	if msgType != websocket.TextMessage || len(msg) == 0 || string(msg[0]) == ResizeChannel {
+		return nil
+	}
+	if !v.viewer.Writable() {
+		return nil
+	}
+
+	decodeMsg, err := base64.StdEncoding.DecodeString(string(msg[1:]))
+	if err != nil {
+		return nil
+	}
+	return v.viewer.Input(v.ctx, decodeMsg)
+}
+
+// controlMessage 控制权变更提示
+func (v *ViewerConn) controlMessage(writer string) string {
+	switch writer {
+	case "":
+		return i18n.GetMessage("输入控制权已交还给{}", map[string]string{"owner": v.meta.Owner})
+	case v.viewer.Username():
+		return i18n.GetMessage("你已获得输入控制权")
+	default:
+		return i18n.GetMessage("用户{}获得了输入控制权", map[string]string{"username": writer})
+	}
+}
+
+// write 输出到 web 端
+func (v *ViewerConn) write(data []byte) error {
+	output := []byte(base64.StdEncoding.EncodeToString(data))
+	return v.wsConn.WriteMessage(websocket.TextMessage, output)
+}
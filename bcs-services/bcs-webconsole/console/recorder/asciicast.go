@@ -0,0 +1,91 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+// Package recorder 终端会话录制, 使用 asciicast v2 格式, 参考 https://github.com/asciinema/asciinema/blob/develop/doc/asciicast-v2.md
+package recorder
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// asciicastVersion asciicast 格式版本
+	asciicastVersion = 2
+	// ContentType asciicast 文件类型
+	ContentType = "application/x-asciicast"
+)
+
+// EventType asciicast 事件类型
+type EventType string
+
+const (
+	// InputEvent 输入事件
+	InputEvent EventType = "i"
+	// OutputEvent 输出事件
+	OutputEvent EventType = "o"
+	// ResizeEvent 窗口大小调整事件
+	ResizeEvent EventType = "r"
+)
+
+// Header asciicast 文件头, 即文件第一行
+type Header struct {
+	Version   int               `json:"version"`
+	Width     uint16            `json:"width"`
+	Height    uint16            `json:"height"`
+	Timestamp int64             `json:"timestamp"`
+	Title     string            `json:"title,omitempty"`
+	Env       map[string]string `json:"env,omitempty"`
+}
+
+// encodeHeader 编码文件头, 以换行结尾
+func encodeHeader(header *Header) ([]byte, error) {
+	line, err := json.Marshal(header)
+	if err != nil {
+		return nil, err
+	}
+	return append(line, '\n'), nil
+}
+
+// encodeEvent 编码单个事件 [time, code, data], 以换行结尾, time 为距离开始录制的秒数
+func encodeEvent(elapsed time.Duration, eventType EventType, data string) ([]byte, error) {
+	seconds := math.Round(elapsed.Seconds()*1e6) / 1e6
+	line, err := json.Marshal([]interface{}{seconds, eventType, data})
+	if err != nil {
+		return nil, err
+	}
+	return append(line, '\n'), nil
+}
+
+// resizeData resize 事件数据, 格式 {cols}x{rows}
+func resizeData(cols, rows uint16) string {
+	return fmt.Sprintf("%dx%d", cols, rows)
+}
+
+// splitIncompleteUTF8 拆分出末尾不完整的 utf8 字符, 终端输出可能在多字节字符中间截断,
+// 不完整的部分需要和下一次输出拼接后再编码, 否则 json 编码会替换为 U+FFFD
+func splitIncompleteUTF8(p []byte) ([]byte, []byte) {
+	for i := len(p) - 1; i >= 0 && i > len(p)-utf8.UTFMax; i-- {
+		if !utf8.RuneStart(p[i]) {
+			continue
+		}
+		if utf8.FullRune(p[i:]) {
+			return p, nil
+		}
+		return p[:i], p[i:]
+	}
+	return p, nil
+}
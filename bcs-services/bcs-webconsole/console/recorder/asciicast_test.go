@@ -0,0 +1,93 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package recorder
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestEncodeEvent(t *testing.T) {
+	tests := []struct {
+		name      string
+		elapsed   time.Duration
+		eventType EventType
+		data      string
+		want      string
+	}{
+		{name: "output", elapsed: 1500 * time.Millisecond, eventType: OutputEvent, data: "ls\r\n",
+			want: "[1.5,\"o\",\"ls\\r\\n\"]\n"},
+		{name: "round", elapsed: 1234567 * time.Nanosecond, eventType: InputEvent, data: "a",
+			want: "[0.001235,\"i\",\"a\"]\n"},
+		{name: "resize", elapsed: 0, eventType: ResizeEvent, data: resizeData(80, 24),
+			want: "[0,\"r\",\"80x24\"]\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := encodeEvent(tt.elapsed, tt.eventType, tt.data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("encodeEvent() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitIncompleteUTF8(t *testing.T) {
+	full := []byte("终端")
+	tests := []struct {
+		name     string
+		p        []byte
+		wantHead []byte
+		wantTail []byte
+	}{
+		{name: "ascii", p: []byte("abc"), wantHead: []byte("abc")},
+		{name: "complete", p: full, wantHead: full},
+		{name: "incomplete", p: full[:4], wantHead: full[:3], wantTail: full[3:4]},
+		{name: "only tail", p: full[:2], wantHead: []byte{}, wantTail: full[:2]},
+		{name: "empty", p: []byte{}, wantHead: []byte{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			head, tail := splitIncompleteUTF8(tt.p)
+			if !bytes.Equal(head, tt.wantHead) || !bytes.Equal(tail, tt.wantTail) {
+				t.Errorf("splitIncompleteUTF8() = %q, %q, want %q, %q", head, tail, tt.wantHead, tt.wantTail)
+			}
+		})
+	}
+}
+
+func TestListFilterMatch(t *testing.T) {
+	meta := &RecordMeta{ProjectId: "p1", ClusterId: "c1", Namespace: "default", PodName: "pod", Username: "admin"}
+	tests := []struct {
+		name   string
+		filter *ListFilter
+		want   bool
+	}{
+		{name: "empty", filter: &ListFilter{}, want: true},
+		{name: "match", filter: &ListFilter{ProjectId: "p1", ClusterId: "c1", Username: "admin"}, want: true},
+		{name: "other cluster", filter: &ListFilter{ProjectId: "p1", ClusterId: "c2"}, want: false},
+		{name: "other user", filter: &ListFilter{Username: "guest"}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.Match(meta); got != tt.want {
+				t.Errorf("Match() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
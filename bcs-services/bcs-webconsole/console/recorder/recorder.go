@@ -0,0 +1,282 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package recorder
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/config"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/types"
+
+	logger "github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/google/uuid"
+)
+
+const (
+	// 写入存储超时时间
+	flushTimeout = 5 * time.Second
+)
+
+// AuditData 两次写入之间的输入输出, 用于上报审计
+type AuditData struct {
+	Input  string
+	Output string
+	// SharedInputs 共享终端中观看者的输入, key 为观看者
+	SharedInputs map[string]string
+}
+
+// Recorder 终端会话录制, 输入, 输出, resize 事件编码为 asciicast v2 格式, 按分片写入存储
+type Recorder struct {
+	store     *Store
+	meta      *RecordMeta
+	startTime time.Time
+	chunkSize int
+
+	mu sync.Mutex
+	// flushMu 保证分片按顺序写入
+	flushMu sync.Mutex
+	buf     bytes.Buffer
+	// pendingOutput 不完整的 utf8 输出, 和下一次输出拼接
+	pendingOutput []byte
+	auditInput    strings.Builder
+	auditOutput   strings.Builder
+	// auditSharedInputs 共享终端中观看者的输入, key 为观看者
+	auditSharedInputs map[string]*strings.Builder
+	// flushing 已满的分片正在异步写入
+	flushing bool
+	closed   bool
+}
+
+// NewRecorder 新建录制并写入文件头
+func NewRecorder(ctx context.Context, podCtx *types.PodContext, cols, rows uint16) (*Recorder, error) {
+	now := time.Now()
+	r := &Recorder{
+		store:     NewStore(),
+		startTime: now,
+		chunkSize: config.G.Record.ChunkSize,
+		meta: &RecordMeta{
+			Id:            strings.Replace(uuid.New().String(), "-", "", -1),
+			ProjectId:     podCtx.ProjectId,
+			ClusterId:     podCtx.ClusterId,
+			Namespace:     podCtx.Namespace,
+			PodName:       podCtx.PodName,
+			ContainerName: podCtx.ContainerName,
+			Username:      podCtx.Username,
+			Mode:          podCtx.Mode,
+			Width:         cols,
+			Height:        rows,
+			StartTime:     now,
+		},
+	}
+
+	header, err := encodeHeader(&Header{
+		Version:   asciicastVersion,
+		Width:     cols,
+		Height:    rows,
+		Timestamp: now.Unix(),
+		Title:     podCtx.PodName + "/" + podCtx.ContainerName,
+		Env:       map[string]string{"TERM": "xterm-256color"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, flushTimeout)
+	defer cancel()
+	if err := r.store.Append(ctx, r.meta, header); err != nil {
+		return nil, err
+	}
+	r.meta.Size = len(header)
+	return r, nil
+}
+
+// Id 录制Id, 未开启录制时为空
+func (r *Recorder) Id() string {
+	if r == nil {
+		return ""
+	}
+	return r.meta.Id
+}
+
+// Input 记录输入, username 为输入的用户, 共享终端中可能是拥有控制权的观看者
+func (r *Recorder) Input(username string, p []byte) {
+	if r == nil {
+		return
+	}
+	r.record(InputEvent, username, string(p))
+}
+
+// Output 记录输出
+func (r *Recorder) Output(p []byte) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	data := append(r.pendingOutput, p...)
+	data, r.pendingOutput = splitIncompleteUTF8(data)
+	r.pendingOutput = append([]byte(nil), r.pendingOutput...)
+	r.mu.Unlock()
+
+	if len(data) == 0 {
+		return
+	}
+	r.record(OutputEvent, "", string(data))
+}
+
+// Resize 记录窗口大小调整
+func (r *Recorder) Resize(cols, rows uint16) {
+	if r == nil {
+		return
+	}
+	r.record(ResizeEvent, "", resizeData(cols, rows))
+}
+
+func (r *Recorder) record(eventType EventType, username, data string) {
+	line, err := encodeEvent(time.Since(r.startTime), eventType, data)
+	if err != nil {
+		logger.Warnf("encode record event failed, record %s, err: %s", r.meta.Id, err)
+		return
+	}
+
+	r.mu.Lock()
+	if r.closed {
+		r.mu.Unlock()
+		return
+	}
+	r.buf.Write(line)
+	switch eventType {
+	case InputEvent:
+		r.auditInputOf(username).WriteString(data)
+	case OutputEvent:
+		r.auditOutput.WriteString(data)
+	}
+	full := r.buf.Len() >= r.chunkSize && !r.flushing
+	if full {
+		r.flushing = true
+	}
+	r.mu.Unlock()
+
+	// 分片已满, 异步写入存储, 避免占用过多内存, 也不阻塞输入输出
+	// 审计数据保留到下一次 Flush 上报
+	if full {
+		go r.flushFullChunk()
+	}
+}
+
+// auditInputOf 用户的审计输入, 需要持有 mu
+func (r *Recorder) auditInputOf(username string) *strings.Builder {
+	if username == "" || username == r.meta.Username {
+		return &r.auditInput
+	}
+	if r.auditSharedInputs == nil {
+		r.auditSharedInputs = map[string]*strings.Builder{}
+	}
+	input, ok := r.auditSharedInputs[username]
+	if !ok {
+		input = &strings.Builder{}
+		r.auditSharedInputs[username] = input
+	}
+	return input
+}
+
+// flushFullChunk 异步写入已满的分片
+func (r *Recorder) flushFullChunk() {
+	defer func() {
+		r.mu.Lock()
+		r.flushing = false
+		r.mu.Unlock()
+	}()
+
+	if err := r.flushChunk(context.Background()); err != nil {
+		logger.Warnf("flush record %s failed, err: %s", r.meta.Id, err)
+	}
+}
+
+// Flush 把缓存的事件作为一个分片写入存储, 返回上一次 Flush 之后的审计数据
+// 写入失败时分片会被丢弃, 避免存储异常时内存持续增长, 审计数据仍然返回
+func (r *Recorder) Flush(ctx context.Context) (*AuditData, error) {
+	if r == nil {
+		return nil, nil
+	}
+
+	r.mu.Lock()
+	audit := &AuditData{Input: r.auditInput.String(), Output: r.auditOutput.String()}
+	for username, input := range r.auditSharedInputs {
+		if audit.SharedInputs == nil {
+			audit.SharedInputs = map[string]string{}
+		}
+		audit.SharedInputs[username] = input.String()
+	}
+	r.auditInput.Reset()
+	r.auditOutput.Reset()
+	r.auditSharedInputs = nil
+	r.mu.Unlock()
+
+	return audit, r.flushChunk(ctx)
+}
+
+// flushChunk 把缓存的事件作为一个分片写入存储
+func (r *Recorder) flushChunk(ctx context.Context) error {
+	r.flushMu.Lock()
+	defer r.flushMu.Unlock()
+
+	r.mu.Lock()
+	chunk := append([]byte(nil), r.buf.Bytes()...)
+	r.buf.Reset()
+	closed := r.closed
+	r.mu.Unlock()
+
+	now := time.Now()
+	r.meta.Duration = now.Sub(r.startTime).Seconds()
+	r.meta.Size += len(chunk)
+	if closed {
+		r.meta.EndTime = &now
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, flushTimeout)
+	defer cancel()
+	return r.store.Append(ctx, r.meta, chunk)
+}
+
+// Close 结束录制, 写入剩余的事件
+func (r *Recorder) Close(ctx context.Context) (*AuditData, error) {
+	if r == nil {
+		return nil, nil
+	}
+
+	r.mu.Lock()
+	if r.closed {
+		r.mu.Unlock()
+		return nil, nil
+	}
+	pending := r.pendingOutput
+	r.pendingOutput = nil
+	r.mu.Unlock()
+
+	// 剩余的不完整输出直接记录
+	if len(pending) > 0 {
+		r.record(OutputEvent, "", string(pending))
+	}
+
+	r.mu.Lock()
+	r.closed = true
+	r.mu.Unlock()
+
+	return r.Flush(ctx)
+}
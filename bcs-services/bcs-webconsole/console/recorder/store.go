@@ -0,0 +1,231 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package recorder
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/config"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/storage"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/types"
+
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	// bcs::webconsole::records::{run_env} 格式, 录制索引, zset 结构, score 为开始时间
+	recordIndexKey = "bcs::webconsole::records::%s"
+	// bcs::webconsole::records::{run_env}::meta::{record_id} 格式, 录制元数据
+	recordMetaKey = "bcs::webconsole::records::%s::meta::%s"
+	// bcs::webconsole::records::{run_env}::chunks::{record_id} 格式, 录制分片, list 结构, 第一个分片为文件头
+	recordChunkKey = "bcs::webconsole::records::%s::chunks::%s"
+
+	// 分页查询索引时每页数量
+	listPageSize = 500
+	// DefaultListLimit 默认返回数量
+	DefaultListLimit = 100
+	// MaxListLimit 最大返回数量
+	MaxListLimit = 1000
+)
+
+// RecordMeta 录制元数据
+type RecordMeta struct {
+	Id            string               `json:"id"`
+	ProjectId     string               `json:"project_id"`
+	ClusterId     string               `json:"cluster_id"`
+	Namespace     string               `json:"namespace"`
+	PodName       string               `json:"pod_name"`
+	ContainerName string               `json:"container_name"`
+	Username      string               `json:"username"`
+	Mode          types.WebConsoleMode `json:"mode"`
+	Width         uint16               `json:"width"`
+	Height        uint16               `json:"height"`
+	StartTime     time.Time            `json:"start_time"`
+	EndTime       *time.Time           `json:"end_time"` // 为空表示会话未结束
+	Duration      float64              `json:"duration"` // 录制时长, 单位秒
+	Size          int                  `json:"size"`     // 录制大小, 单位字节
+	ChunkCount    int64                `json:"chunk_count"`
+}
+
+// ListFilter 录制查询条件, 空值不过滤
+type ListFilter struct {
+	ProjectId string
+	ClusterId string
+	Namespace string
+	PodName   string
+	Username  string
+	Limit     int
+}
+
+// Match 是否满足查询条件
+func (f *ListFilter) Match(meta *RecordMeta) bool {
+	if f.ProjectId != "" && f.ProjectId != meta.ProjectId {
+		return false
+	}
+	if f.ClusterId != "" && f.ClusterId != meta.ClusterId {
+		return false
+	}
+	if f.Namespace != "" && f.Namespace != meta.Namespace {
+		return false
+	}
+	if f.PodName != "" && f.PodName != meta.PodName {
+		return false
+	}
+	if f.Username != "" && f.Username != meta.Username {
+		return false
+	}
+	return true
+}
+
+// Store 录制存储, 使用 redis, 数据按保留时间过期
+type Store struct {
+	client    *redis.Client
+	env       string
+	retention time.Duration
+}
+
+// NewStore 新建录制存储
+func NewStore() *Store {
+	return &Store{
+		client:    storage.GetDefaultRedisSession().Client,
+		env:       config.G.Base.RunEnv,
+		retention: time.Duration(config.G.Record.RetentionDays) * 24 * time.Hour,
+	}
+}
+
+func (s *Store) indexKey() string {
+	return fmt.Sprintf(recordIndexKey, s.env)
+}
+
+func (s *Store) metaKey(id string) string {
+	return fmt.Sprintf(recordMetaKey, s.env, id)
+}
+
+func (s *Store) chunkKey(id string) string {
+	return fmt.Sprintf(recordChunkKey, s.env, id)
+}
+
+// Append 追加分片并更新元数据和索引
+func (s *Store) Append(ctx context.Context, meta *RecordMeta, chunk []byte) error {
+	if len(chunk) > 0 {
+		meta.ChunkCount++
+	}
+	payload, err := json.Marshal(meta)
+	if err != nil {
+		return err
+	}
+
+	pipe := s.client.TxPipeline()
+	pipe.ZAdd(ctx, s.indexKey(), &redis.Z{Score: float64(meta.StartTime.Unix()), Member: meta.Id})
+	if len(chunk) > 0 {
+		pipe.RPush(ctx, s.chunkKey(meta.Id), chunk)
+		pipe.Expire(ctx, s.chunkKey(meta.Id), s.retention)
+	}
+	pipe.Set(ctx, s.metaKey(meta.Id), payload, s.retention)
+	if _, err := pipe.Exec(ctx); err != nil {
+		if len(chunk) > 0 {
+			meta.ChunkCount--
+		}
+		return err
+	}
+	return nil
+}
+
+// Get 查询录制元数据
+func (s *Store) Get(ctx context.Context, id string) (*RecordMeta, error) {
+	value, err := s.client.Get(ctx, s.metaKey(id)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	meta := &RecordMeta{}
+	if err := json.Unmarshal([]byte(value), meta); err != nil {
+		return nil, err
+	}
+	return meta, nil
+}
+
+// List 按开始时间倒序查询录制, 同时清理索引中已过期的数据
+func (s *Store) List(ctx context.Context, filter *ListFilter) ([]*RecordMeta, error) {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = DefaultListLimit
+	}
+	if limit > MaxListLimit {
+		limit = MaxListLimit
+	}
+
+	expireScore := strconv.FormatInt(time.Now().Add(-s.retention).Unix(), 10)
+	if err := s.client.ZRemRangeByScore(ctx, s.indexKey(), "-inf", "("+expireScore).Err(); err != nil {
+		return nil, err
+	}
+
+	results := make([]*RecordMeta, 0)
+	for offset := int64(0); len(results) < limit; offset += listPageSize {
+		ids, err := s.client.ZRevRangeByScore(ctx, s.indexKey(), &redis.ZRangeBy{
+			Min:    expireScore,
+			Max:    "+inf",
+			Offset: offset,
+			Count:  listPageSize,
+		}).Result()
+		if err != nil {
+			return nil, err
+		}
+		if len(ids) == 0 {
+			break
+		}
+
+		keys := make([]string, 0, len(ids))
+		for _, id := range ids {
+			keys = append(keys, s.metaKey(id))
+		}
+		values, err := s.client.MGet(ctx, keys...).Result()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, value := range values {
+			// 元数据已过期
+			str, ok := value.(string)
+			if !ok {
+				continue
+			}
+			meta := &RecordMeta{}
+			if err := json.Unmarshal([]byte(str), meta); err != nil {
+				continue
+			}
+			if !filter.Match(meta) {
+				continue
+			}
+			results = append(results, meta)
+			if len(results) >= limit {
+				break
+			}
+		}
+
+		if len(ids) < listPageSize {
+			break
+		}
+	}
+	return results, nil
+}
+
+// ReadChunks 读取分片, start, stop 含义同 LRANGE
+func (s *Store) ReadChunks(ctx context.Context, id string, start, stop int64) ([]string, error) {
+	return s.client.LRange(ctx, s.chunkKey(id), start, stop).Result()
+}
@@ -0,0 +1,435 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package share
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	logger "github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/go-redis/redis/v8"
+	"github.com/pkg/errors"
+)
+
+// MessageType 共享终端消息类型
+type MessageType string
+
+const (
+	// ShareMessage 开启共享, 发送给所有者
+	ShareMessage MessageType = "share"
+	// UnshareMessage 关闭共享, 发送给所有者
+	UnshareMessage MessageType = "unshare"
+	// JoinMessage 观看者加入, 发送给所有者
+	JoinMessage MessageType = "join"
+	// LeaveMessage 观看者离开, 发送给所有者
+	LeaveMessage MessageType = "leave"
+	// InputMessage 观看者输入, 发送给所有者
+	InputMessage MessageType = "input"
+	// ControlMessage 输入控制权变更, 发送给所有者和观看者
+	ControlMessage MessageType = "control"
+	// OutputMessage 终端输出, 发送给观看者
+	OutputMessage MessageType = "output"
+	// CloseMessage 共享结束, 发送给观看者
+	CloseMessage MessageType = "close"
+)
+
+const (
+	// 消息缓存数量, 观看者处理不及时会丢弃输出
+	msgBufferSize = 256
+	// 单条消息发布超时时间
+	publishTimeout = time.Second * 5
+)
+
+// Message 共享终端消息
+type Message struct {
+	Type     MessageType `json:"type"`
+	ShareId  string      `json:"share_id"`
+	Operator string      `json:"operator,omitempty"` // 操作人
+	Username string      `json:"username,omitempty"` // 控制权变更时, 获得控制权的用户, 为空表示收回
+	Data     []byte      `json:"data,omitempty"`
+}
+
+// Hub 进程内的共享终端消息分发, 所有者和观看者的频道按需订阅
+type Hub struct {
+	store   *Store
+	pubsub  *redis.PubSub
+	mu      sync.Mutex
+	owners  map[string]*Owner               // key 为所有者 sessionId
+	viewers map[string]map[*Viewer]struct{} // key 为 shareId
+}
+
+var (
+	defaultHub *Hub
+	hubOnce    sync.Once
+)
+
+// GetHub 第一次调用时订阅 redis 并开始分发消息
+func GetHub() *Hub {
+	hubOnce.Do(func() {
+		store := NewStore()
+		defaultHub = &Hub{
+			store:   store,
+			pubsub:  store.client.Subscribe(context.Background(), store.hubChannel()),
+			owners:  map[string]*Owner{},
+			viewers: map[string]map[*Viewer]struct{}{},
+		}
+		go defaultHub.run()
+	})
+	return defaultHub
+}
+
+// run 分发 redis 消息, 断线后 go-redis 会自动重连并重新订阅
+func (h *Hub) run() {
+	for msg := range h.pubsub.Channel() {
+		m := &Message{}
+		if err := json.Unmarshal([]byte(msg.Payload), m); err != nil {
+			logger.Warnf("unmarshal share message from %s failed, err: %s", msg.Channel, err)
+			continue
+		}
+
+		kind, id := h.store.parseChannel(msg.Channel)
+		h.mu.Lock()
+		switch kind {
+		case "owner":
+			if owner, ok := h.owners[id]; ok {
+				owner.handle(m)
+			}
+		case "viewers":
+			for viewer := range h.viewers[id] {
+				viewer.handle(m)
+			}
+		}
+		h.mu.Unlock()
+	}
+}
+
+// Owner 终端所有者, 随 websocket 连接注册, 共享后把终端输出转发给观看者
+type Owner struct {
+	hub       *Hub
+	sessionId string
+	outputs   chan *Message
+	inputs    chan *Message
+	events    chan *Message
+	done      chan struct{}
+	mu        sync.RWMutex
+	shareId   string
+	writer    string
+}
+
+// RegisterOwner 注册终端所有者
+func (h *Hub) RegisterOwner(ctx context.Context, sessionId string) (*Owner, error) {
+	o := &Owner{
+		hub:       h,
+		sessionId: sessionId,
+		outputs:   make(chan *Message, msgBufferSize),
+		inputs:    make(chan *Message, msgBufferSize),
+		events:    make(chan *Message, msgBufferSize),
+		done:      make(chan struct{}),
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if err := h.pubsub.Subscribe(ctx, h.store.ownerChannel(sessionId)); err != nil {
+		return nil, err
+	}
+	h.owners[sessionId] = o
+
+	go o.publishOutputs()
+	return o, nil
+}
+
+// ShareId 共享Id, 未共享时为空
+func (o *Owner) ShareId() string {
+	if o == nil {
+		return ""
+	}
+
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return o.shareId
+}
+
+// Inputs 拥有控制权的观看者的输入, Operator 为输入的观看者
+func (o *Owner) Inputs() <-chan *Message {
+	if o == nil {
+		return nil
+	}
+	return o.inputs
+}
+
+// Events 共享开启, 关闭, 观看者加入离开, 控制权变更等事件, 用于审计
+func (o *Owner) Events() <-chan *Message {
+	if o == nil {
+		return nil
+	}
+	return o.events
+}
+
+// Publish 转发终端输出给观看者, 未共享时忽略
+func (o *Owner) Publish(data []byte) {
+	shareId := o.ShareId()
+	if shareId == "" {
+		return
+	}
+
+	msg := &Message{Type: OutputMessage, ShareId: shareId, Data: append([]byte(nil), data...)}
+	select {
+	case o.outputs <- msg:
+	default:
+		logger.Warnf("share %s output buffer is full, drop %d bytes", shareId, len(data))
+	}
+}
+
+// publishOutputs 异步发布终端输出, 不阻塞终端
+func (o *Owner) publishOutputs() {
+	for {
+		select {
+		case <-o.done:
+			return
+		case msg := <-o.outputs:
+			ctx, cancel := context.WithTimeout(context.Background(), publishTimeout)
+			if err := o.hub.store.PublishViewers(ctx, msg.ShareId, msg); err != nil {
+				logger.Warnf("publish share %s output failed, err: %s", msg.ShareId, err)
+			}
+			cancel()
+		}
+	}
+}
+
+// handle 处理发送给所有者的消息
+func (o *Owner) handle(m *Message) {
+	o.mu.Lock()
+	switch m.Type {
+	case ShareMessage:
+		o.shareId = m.ShareId
+		o.writer = ""
+	case UnshareMessage:
+		if m.ShareId != o.shareId {
+			o.mu.Unlock()
+			return
+		}
+		o.shareId = ""
+		o.writer = ""
+	case ControlMessage:
+		if m.ShareId != o.shareId {
+			o.mu.Unlock()
+			return
+		}
+		o.writer = m.Username
+	case InputMessage:
+		// 只接受拥有控制权的观看者的输入
+		allowed := m.ShareId == o.shareId && o.writer != "" && m.Operator == o.writer
+		o.mu.Unlock()
+		if allowed {
+			select {
+			case o.inputs <- m:
+			default:
+				logger.Warnf("share %s input buffer is full, drop input from %s", m.ShareId, m.Operator)
+			}
+		}
+		return
+	case JoinMessage, LeaveMessage:
+		if m.ShareId != o.shareId {
+			o.mu.Unlock()
+			return
+		}
+	default:
+		o.mu.Unlock()
+		return
+	}
+	o.mu.Unlock()
+
+	select {
+	case o.events <- m:
+	default:
+		logger.Warnf("share %s event buffer is full, drop %s event", m.ShareId, m.Type)
+	}
+}
+
+// Close 所有者断开连接, 结束共享, 返回结束的共享Id
+func (o *Owner) Close(ctx context.Context) (string, error) {
+	if o == nil {
+		return "", nil
+	}
+
+	h := o.hub
+	h.mu.Lock()
+	if h.owners[o.sessionId] == o {
+		delete(h.owners, o.sessionId)
+		if err := h.pubsub.Unsubscribe(ctx, h.store.ownerChannel(o.sessionId)); err != nil {
+			logger.Warnf("unsubscribe share owner channel failed, err: %s", err)
+		}
+	}
+	h.mu.Unlock()
+	close(o.done)
+
+	shareId := o.ShareId()
+	if shareId == "" {
+		return "", nil
+	}
+
+	meta, err := h.store.Get(ctx, shareId)
+	if err == redis.Nil {
+		return shareId, nil
+	}
+	if err != nil {
+		return shareId, err
+	}
+	return shareId, h.store.Close(ctx, meta, meta.Owner)
+}
+
+// Viewer 观看者, 只读观看终端输出, 获得控制权后可以输入
+type Viewer struct {
+	hub      *Hub
+	meta     *ShareMeta
+	username string
+	msgs     chan *Message
+	mu       sync.RWMutex
+	writer   string
+}
+
+// RegisterViewer 注册观看者, 终端所有者已经断开时返回 ErrOwnerOffline
+func (h *Hub) RegisterViewer(ctx context.Context, meta *ShareMeta, username string) (*Viewer, error) {
+	v := &Viewer{
+		hub:      h,
+		meta:     meta,
+		username: username,
+		msgs:     make(chan *Message, msgBufferSize),
+		writer:   meta.Writer,
+	}
+
+	h.mu.Lock()
+	viewers, ok := h.viewers[meta.Id]
+	if !ok {
+		if err := h.pubsub.Subscribe(ctx, h.store.viewersChannel(meta.Id)); err != nil {
+			h.mu.Unlock()
+			return nil, err
+		}
+		viewers = map[*Viewer]struct{}{}
+		h.viewers[meta.Id] = viewers
+	}
+	viewers[v] = struct{}{}
+	h.mu.Unlock()
+
+	if err := h.store.addViewer(ctx, meta.Id, username); err != nil {
+		v.unregister(ctx)
+		return nil, err
+	}
+	if err := h.store.PublishOwner(ctx, meta.SessionId, &Message{
+		Type: JoinMessage, ShareId: meta.Id, Operator: username,
+	}); err != nil {
+		v.Close(ctx)
+		return nil, err
+	}
+	return v, nil
+}
+
+// Messages 终端输出, 控制权变更, 共享结束等消息
+func (v *Viewer) Messages() <-chan *Message {
+	return v.msgs
+}
+
+// Username 观看者
+func (v *Viewer) Username() string {
+	return v.username
+}
+
+// Writer 拥有输入控制权的用户
+func (v *Viewer) Writer() string {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	return v.writer
+}
+
+// Writable 是否拥有输入控制权
+func (v *Viewer) Writable() bool {
+	return v.Writer() == v.username
+}
+
+// handle 处理发送给观看者的消息
+func (v *Viewer) handle(m *Message) {
+	if m.Type == ControlMessage {
+		v.mu.Lock()
+		v.writer = m.Username
+		v.mu.Unlock()
+	}
+
+	select {
+	case v.msgs <- m:
+	default:
+		logger.Warnf("share %s viewer %s buffer is full, drop %s message", m.ShareId, v.username, m.Type)
+	}
+}
+
+// Input 转发输入给所有者, 没有控制权时忽略
+func (v *Viewer) Input(ctx context.Context, data []byte) error {
+	if !v.Writable() {
+		return nil
+	}
+	return v.hub.store.PublishOwner(ctx, v.meta.SessionId, &Message{
+		Type: InputMessage, ShareId: v.meta.Id, Operator: v.username, Data: data,
+	})
+}
+
+// unregister 取消注册, 最后一个观看者离开时取消订阅
+func (v *Viewer) unregister(ctx context.Context) {
+	h := v.hub
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	viewers := h.viewers[v.meta.Id]
+	delete(viewers, v)
+	if len(viewers) > 0 {
+		return
+	}
+	delete(h.viewers, v.meta.Id)
+	if err := h.pubsub.Unsubscribe(ctx, h.store.viewersChannel(v.meta.Id)); err != nil {
+		logger.Warnf("unsubscribe share viewers channel failed, err: %s", err)
+	}
+}
+
+// Close 观看者断开连接, 如果拥有控制权, 控制权交还所有者
+func (v *Viewer) Close(ctx context.Context) error {
+	v.unregister(ctx)
+
+	left, err := v.hub.store.removeViewer(ctx, v.meta.Id, v.username)
+	if err != nil || !left {
+		return err
+	}
+
+	meta, err := v.hub.store.Get(ctx, v.meta.Id)
+	if err == redis.Nil {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if meta.Writer == v.username {
+		err := v.hub.store.GrantControl(ctx, meta, v.username, "")
+		if err != nil && !errors.Is(err, ErrOwnerOffline) {
+			return err
+		}
+	}
+
+	err = v.hub.store.PublishOwner(ctx, v.meta.SessionId, &Message{
+		Type: LeaveMessage, ShareId: v.meta.Id, Operator: v.username,
+	})
+	if errors.Is(err, ErrOwnerOffline) {
+		return nil
+	}
+	return err
+}
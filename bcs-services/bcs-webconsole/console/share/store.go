@@ -0,0 +1,347 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+// Package share 共享终端, 多个用户只读观看同一个终端, 所有者可以把输入控制权交给其中一个观看者
+// 所有者和观看者可能连接到不同的 webconsole 实例, 数据通过 redis 发布订阅转发
+package share
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/config"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/storage"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-webconsole/console/types"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const (
+	// bcs::webconsole::shares::{run_env} 格式
+	keyPrefix = "bcs::webconsole::shares::%s"
+	// 共享最长时间, 和终端最长登录时间一致
+	shareExpiration = time.Hour * 24
+	// 观看者 websocket session 有效期, 只能使用一次
+	viewerSessionExpiration = time.Minute * 5
+)
+
+var (
+	// ErrOwnerOffline 终端所有者已经断开连接
+	ErrOwnerOffline = errors.New("终端未连接")
+)
+
+// ShareMeta 共享终端信息
+type ShareMeta struct {
+	Id            string               `json:"id"`
+	SessionId     string               `json:"-"` // 所有者的 websocket session, 不能返回给其他用户
+	Owner         string               `json:"owner"`
+	ProjectId     string               `json:"project_id"`
+	ClusterId     string               `json:"cluster_id"`
+	Namespace     string               `json:"namespace"`
+	PodName       string               `json:"pod_name"`
+	ContainerName string               `json:"container_name"`
+	Mode          types.WebConsoleMode `json:"mode"`
+	Writer        string               `json:"writer"`  // 拥有输入控制权的观看者, 为空时只有所有者可以输入
+	Viewers       []string             `json:"viewers"` // 正在观看的用户
+	CreatedAt     time.Time            `json:"created_at"`
+}
+
+// shareRecord redis 中保存的共享信息
+type shareRecord struct {
+	ShareMeta
+	SessionId string `json:"session_id"`
+}
+
+// ViewerSession 观看者 websocket session
+type ViewerSession struct {
+	ShareId  string `json:"share_id"`
+	Username string `json:"username"`
+}
+
+// Store 共享终端存储
+type Store struct {
+	client *redis.Client
+	prefix string
+}
+
+// NewStore 新建共享终端存储
+func NewStore() *Store {
+	return &Store{
+		client: storage.GetDefaultRedisSession().Client,
+		prefix: fmt.Sprintf(keyPrefix, config.G.Base.RunEnv),
+	}
+}
+
+func (s *Store) shareKey(id string) string {
+	return s.prefix + "::" + id
+}
+
+func (s *Store) viewersKey(id string) string {
+	return s.prefix + "::" + id + "::viewers"
+}
+
+func (s *Store) sessionKey(sessionId string) string {
+	return s.prefix + "::sessions::" + sessionId
+}
+
+func (s *Store) viewerSessionKey(id string) string {
+	return s.prefix + "::viewer_sessions::" + id
+}
+
+// Create 共享所有者的终端, 同一个终端只会共享一次
+func (s *Store) Create(ctx context.Context, sessionId string, podCtx *types.PodContext) (*ShareMeta, error) {
+	shareId, err := s.client.Get(ctx, s.sessionKey(sessionId)).Result()
+	if err != nil && err != redis.Nil {
+		return nil, err
+	}
+	if shareId != "" {
+		meta, err := s.Get(ctx, shareId)
+		if err == nil {
+			return meta, nil
+		}
+		if err != redis.Nil {
+			return nil, err
+		}
+	}
+
+	meta := &ShareMeta{
+		Id:            idGenerator(),
+		SessionId:     sessionId,
+		Owner:         podCtx.Username,
+		ProjectId:     podCtx.ProjectId,
+		ClusterId:     podCtx.ClusterId,
+		Namespace:     podCtx.Namespace,
+		PodName:       podCtx.PodName,
+		ContainerName: podCtx.ContainerName,
+		Mode:          podCtx.Mode,
+		Viewers:       []string{},
+		CreatedAt:     time.Now(),
+	}
+	if err := s.save(ctx, meta); err != nil {
+		return nil, err
+	}
+	if err := s.client.Set(ctx, s.sessionKey(sessionId), meta.Id, shareExpiration).Err(); err != nil {
+		return nil, err
+	}
+	return meta, nil
+}
+
+// save 保存共享信息
+func (s *Store) save(ctx context.Context, meta *ShareMeta) error {
+	payload, err := json.Marshal(&shareRecord{ShareMeta: *meta, SessionId: meta.SessionId})
+	if err != nil {
+		return err
+	}
+	return s.client.Set(ctx, s.shareKey(meta.Id), payload, shareExpiration).Err()
+}
+
+// Get 查询共享信息, 不存在返回 redis.Nil
+func (s *Store) Get(ctx context.Context, id string) (*ShareMeta, error) {
+	value, err := s.client.Get(ctx, s.shareKey(id)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	record := &shareRecord{}
+	if err := json.Unmarshal([]byte(value), record); err != nil {
+		return nil, err
+	}
+	meta := &record.ShareMeta
+	meta.SessionId = record.SessionId
+
+	viewers, err := s.client.HGetAll(ctx, s.viewersKey(id)).Result()
+	if err != nil {
+		return nil, err
+	}
+	meta.Viewers = make([]string, 0, len(viewers))
+	for username := range viewers {
+		meta.Viewers = append(meta.Viewers, username)
+	}
+	return meta, nil
+}
+
+// SetWriter 设置拥有输入控制权的观看者
+func (s *Store) SetWriter(ctx context.Context, meta *ShareMeta, writer string) error {
+	meta.Writer = writer
+	return s.save(ctx, meta)
+}
+
+// Delete 删除共享信息
+func (s *Store) Delete(ctx context.Context, meta *ShareMeta) error {
+	return s.client.Del(ctx, s.shareKey(meta.Id), s.viewersKey(meta.Id), s.sessionKey(meta.SessionId)).Err()
+}
+
+// addViewer 记录观看者, 同一个用户可能打开多个窗口, 按连接数计数
+func (s *Store) addViewer(ctx context.Context, id, username string) error {
+	pipe := s.client.TxPipeline()
+	pipe.HIncrBy(ctx, s.viewersKey(id), username, 1)
+	pipe.Expire(ctx, s.viewersKey(id), shareExpiration)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// removeViewer 观看者断开连接, 返回该用户是否已经没有其他连接
+func (s *Store) removeViewer(ctx context.Context, id, username string) (bool, error) {
+	count, err := s.client.HIncrBy(ctx, s.viewersKey(id), username, -1).Result()
+	if err != nil {
+		return false, err
+	}
+	if count > 0 {
+		return false, nil
+	}
+	return true, s.client.HDel(ctx, s.viewersKey(id), username).Err()
+}
+
+// CreateViewerSession 观看者通过权限校验后, 生成一次性的 websocket session
+func (s *Store) CreateViewerSession(ctx context.Context, shareId, username string) (string, error) {
+	payload, err := json.Marshal(&ViewerSession{ShareId: shareId, Username: username})
+	if err != nil {
+		return "", err
+	}
+	id := idGenerator()
+	if err := s.client.Set(ctx, s.viewerSessionKey(id), payload, viewerSessionExpiration).Err(); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// PopViewerSession 读取并删除观看者 websocket session
+func (s *Store) PopViewerSession(ctx context.Context, id string) (*ViewerSession, error) {
+	pipe := s.client.TxPipeline()
+	get := pipe.Get(ctx, s.viewerSessionKey(id))
+	pipe.Del(ctx, s.viewerSessionKey(id))
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	session := &ViewerSession{}
+	if err := json.Unmarshal([]byte(get.Val()), session); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// hubChannel 实例启动时订阅的默认频道, 所有者和观看者的频道按需订阅
+func (s *Store) hubChannel() string {
+	return s.prefix + "::channels::hub::" + idGenerator()
+}
+
+func (s *Store) ownerChannel(sessionId string) string {
+	return s.prefix + "::channels::owner::" + sessionId
+}
+
+func (s *Store) viewersChannel(shareId string) string {
+	return s.prefix + "::channels::viewers::" + shareId
+}
+
+// parseChannel 解析消息接收方, 返回 owner 或 viewers, 以及对应的 sessionId 或 shareId
+func (s *Store) parseChannel(channel string) (string, string) {
+	parts := strings.SplitN(strings.TrimPrefix(channel, s.prefix+"::channels::"), "::", 2)
+	if len(parts) != 2 {
+		return "", ""
+	}
+	return parts[0], parts[1]
+}
+
+// PublishOwner 发送消息给终端所有者, 所有者没有连接时返回 ErrOwnerOffline
+func (s *Store) PublishOwner(ctx context.Context, sessionId string, msg *Message) error {
+	return s.publish(ctx, s.ownerChannel(sessionId), msg, true)
+}
+
+// PublishViewers 发送消息给所有观看者
+func (s *Store) PublishViewers(ctx context.Context, shareId string, msg *Message) error {
+	return s.publish(ctx, s.viewersChannel(shareId), msg, false)
+}
+
+func (s *Store) publish(ctx context.Context, channel string, msg *Message, mustReceive bool) error {
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	receivers, err := s.client.Publish(ctx, channel, payload).Result()
+	if err != nil {
+		return err
+	}
+	if mustReceive && receivers == 0 {
+		return ErrOwnerOffline
+	}
+	return nil
+}
+
+// idGenerator
+func idGenerator() string {
+	return strings.Replace(uuid.New().String(), "-", "", -1)
+}
+
+// Share 开启共享并通知所有者开始转发终端输出
+func (s *Store) Share(ctx context.Context, sessionId string, podCtx *types.PodContext) (*ShareMeta, error) {
+	meta, err := s.Create(ctx, sessionId, podCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.PublishOwner(ctx, sessionId, &Message{Type: ShareMessage, ShareId: meta.Id, Operator: meta.Owner})
+	if errors.Is(err, ErrOwnerOffline) {
+		if delErr := s.Delete(ctx, meta); delErr != nil {
+			return nil, delErr
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return meta, nil
+}
+
+// GrantControl 把输入控制权交给观看者, username 为空时收回控制权
+func (s *Store) GrantControl(ctx context.Context, meta *ShareMeta, operator, username string) error {
+	if username != "" && !stringInSlice(username, meta.Viewers) {
+		return errors.Errorf("%s is not viewing share %s", username, meta.Id)
+	}
+	if err := s.SetWriter(ctx, meta, username); err != nil {
+		return err
+	}
+
+	msg := &Message{Type: ControlMessage, ShareId: meta.Id, Operator: operator, Username: username}
+	if err := s.PublishOwner(ctx, meta.SessionId, msg); err != nil {
+		return err
+	}
+	return s.PublishViewers(ctx, meta.Id, msg)
+}
+
+// Close 结束共享, 断开所有观看者
+func (s *Store) Close(ctx context.Context, meta *ShareMeta, operator string) error {
+	if err := s.Delete(ctx, meta); err != nil {
+		return err
+	}
+
+	// 所有者可能已经断开连接
+	err := s.PublishOwner(ctx, meta.SessionId, &Message{Type: UnshareMessage, ShareId: meta.Id, Operator: operator})
+	if err != nil && !errors.Is(err, ErrOwnerOffline) {
+		return err
+	}
+	return s.PublishViewers(ctx, meta.Id, &Message{Type: CloseMessage, ShareId: meta.Id, Operator: operator})
+}
+
+func stringInSlice(s string, list []string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
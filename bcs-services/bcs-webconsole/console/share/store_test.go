@@ -0,0 +1,69 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package share
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestParseChannel(t *testing.T) {
+	s := &Store{prefix: "bcs::webconsole::shares::dev"}
+
+	tests := []struct {
+		name     string
+		channel  string
+		wantKind string
+		wantId   string
+	}{
+		{name: "owner", channel: s.ownerChannel("session1"), wantKind: "owner", wantId: "session1"},
+		{name: "viewers", channel: s.viewersChannel("share1"), wantKind: "viewers", wantId: "share1"},
+		{name: "invalid", channel: "bcs::webconsole::shares::dev::channels::hub", wantKind: "", wantId: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kind, id := s.parseChannel(tt.channel)
+			if kind != tt.wantKind || id != tt.wantId {
+				t.Errorf("parseChannel() = %s, %s, want %s, %s", kind, id, tt.wantKind, tt.wantId)
+			}
+		})
+	}
+}
+
+func TestShareRecordSessionId(t *testing.T) {
+	meta := &ShareMeta{Id: "share1", SessionId: "session1", Owner: "admin"}
+
+	// 返回给用户的共享信息不能包含所有者的 session
+	payload, err := json.Marshal(meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(payload), "session1") {
+		t.Errorf("share meta should not contain session id, got %s", payload)
+	}
+
+	// redis 中保存 session, 用于转发消息给所有者
+	payload, err = json.Marshal(&shareRecord{ShareMeta: *meta, SessionId: meta.SessionId})
+	if err != nil {
+		t.Fatal(err)
+	}
+	record := &shareRecord{}
+	if err := json.Unmarshal(payload, record); err != nil {
+		t.Fatal(err)
+	}
+	if record.SessionId != "session1" || record.Id != "share1" || record.Owner != "admin" {
+		t.Errorf("unexpected share record %+v", record)
+	}
+}
@@ -40,6 +40,30 @@ type AuditRecord struct {
 	ClusterID    string      `json:"cluster_id"`
 	UserPodName  string      `json:"user_pod_name"`
 	Username     string      `json:"username"`
+	// 命中命令策略的审计事件, 普通输入输出审计为空
+	CommandRecord *CommandRecord `json:"command_record,omitempty"`
+	// 共享终端的审计事件, 普通输入输出审计为空
+	ShareRecord *ShareRecord `json:"share_record,omitempty"`
+}
+
+// CommandRecord 命令策略审计
+type CommandRecord struct {
+	Command   string `json:"command"`
+	Policy    string `json:"policy"`
+	Pattern   string `json:"pattern"`
+	Action    string `json:"action"`
+	Blocked   bool   `json:"blocked"`
+	Reason    string `json:"reason,omitempty"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// ShareRecord 共享终端审计, 包含共享开启关闭, 观看者加入离开, 输入控制权变更
+type ShareRecord struct {
+	ShareId   string `json:"share_id"`
+	Event     string `json:"event"`
+	Operator  string `json:"operator"`
+	Username  string `json:"username,omitempty"` // 控制权变更时, 获得控制权的用户, 为空表示交还所有者
+	Timestamp int64  `json:"timestamp"`
 }
 
 // Container webconsole 连接三要素